@@ -125,7 +125,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "CreateBookedAppointment - Api for create booked appointment",
+                "description": "CreateBookedAppointment - Api for create booked appointment, a promo_code takes its discount off payment_amount",
                 "consumes": [
                     "application/json"
                 ],
@@ -137,6 +137,12 @@ const docTemplate = `{
                 ],
                 "summary": "CreateBookedAppointment",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "retries with the same key return the appointment created by the first request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
                     {
                         "description": "CreateAppointmentReq",
                         "name": "CreateAppointmentReq",
@@ -160,6 +166,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/model_common.StandardErrorModel"
                         }
                     },
+                    "409": {
+                        "description": "booking limit reached, status is one of BOOKING_LIMIT_ACTIVE_PER_PATIENT, BOOKING_LIMIT_ACTIVE_PER_DOCTOR, BOOKING_LIMIT_PER_DAY, BOOKING_DOCTOR_LICENSE_EXPIRED, BOOKING_RESOURCE_UNAVAILABLE, BOOKING_PROMO_CODE_INVALID, BOOKING_PROMO_CODE_EXHAUSTED, or the idempotency key is used by another patient",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -211,6 +223,78 @@ const docTemplate = `{
                 }
             }
         },
+        "/v1/appointment/export": {
+            "get": {
+                "description": "ExportBookedAppointments - API to export booked appointments as csv or xlsx, accepts the same filters as the list api",
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Appointment"
+                ],
+                "summary": "ExportBookedAppointments",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "description": "format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated list of columns, all columns by default",
+                        "name": "columns",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "key"
+                        ],
+                        "type": "string",
+                        "description": "search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "value",
+                        "name": "value",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "orderBy",
+                        "name": "orderBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/appointment/get": {
             "get": {
                 "description": "GetBookedAppointment - API to get Booked appointment by ID",
@@ -255,9 +339,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/customer/forget-password": {
+        "/v1/appointment/reschedule": {
             "post": {
-                "description": "ForgetPassword - Api for registering users",
+                "description": "ApplyReschedule - Api for moving every appointment affected by an unavailable doctor to a proposed slot and notifying the patients",
                 "consumes": [
                     "application/json"
                 ],
@@ -265,17 +349,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customer"
+                    "Reschedule"
                 ],
-                "summary": "ForgetPassword",
+                "summary": "ApplyReschedule",
                 "parameters": [
                     {
-                        "description": "RegisterModelReq",
-                        "name": "ForgetPassword",
+                        "description": "RescheduleReq",
+                        "name": "RescheduleReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.PhoneNumberReq"
+                            "$ref": "#/definitions/model_booking_service.RescheduleReq"
                         }
                     }
                 ],
@@ -283,7 +367,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.MessageRes"
+                            "$ref": "#/definitions/model_booking_service.RescheduleProposals"
                         }
                     },
                     "400": {
@@ -301,9 +385,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/customer/login": {
+        "/v1/appointment/reschedule/propose": {
             "post": {
-                "description": "Login - Api for registering users",
+                "description": "ProposeReschedule - Api for finding the appointments affected by an unavailable doctor and proposing new slots, nothing is changed",
                 "consumes": [
                     "application/json"
                 ],
@@ -311,17 +395,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customer"
+                    "Reschedule"
                 ],
-                "summary": "Login",
+                "summary": "ProposeReschedule",
                 "parameters": [
                     {
-                        "description": "Login Req",
-                        "name": "Login",
+                        "description": "RescheduleReq",
+                        "name": "RescheduleReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.LoginReq"
+                            "$ref": "#/definitions/model_booking_service.RescheduleReq"
                         }
                     }
                 ],
@@ -329,7 +413,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.Response"
+                            "$ref": "#/definitions/model_booking_service.RescheduleProposals"
                         }
                     },
                     "400": {
@@ -347,14 +431,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/customer/logout": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "LogOut - Api for registering users",
+        "/v1/booking-rules": {
+            "get": {
+                "description": "GetBookingRules - Api for get booking limits of patients, zero means no limit, block_expired_license rejects bookings with a doctor whose license has expired",
                 "consumes": [
                     "application/json"
                 ],
@@ -362,14 +441,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customer"
+                    "Booking Rules"
                 ],
-                "summary": "LogOut",
+                "summary": "GetBookingRules",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.MessageRes"
+                            "$ref": "#/definitions/model_booking_service.BookingRules"
                         }
                     },
                     "400": {
@@ -385,11 +464,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/customer/register": {
-            "post": {
-                "description": "Register - Api for registering users",
+            },
+            "put": {
+                "description": "UpdateBookingRules - Api for update booking limits of patients, zero disables a limit, block_expired_license rejects bookings with a doctor whose license has expired",
                 "consumes": [
                     "application/json"
                 ],
@@ -397,17 +474,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customer"
+                    "Booking Rules"
                 ],
-                "summary": "Register",
+                "summary": "UpdateBookingRules",
                 "parameters": [
                     {
-                        "description": "RegisterRequest",
-                        "name": "Register",
+                        "description": "UpdateBookingRulesReq",
+                        "name": "UpdateBookingRulesReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.RegisterRequest"
+                            "$ref": "#/definitions/model_booking_service.UpdateBookingRulesReq"
                         }
                     }
                 ],
@@ -415,7 +492,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.MessageRes"
+                            "$ref": "#/definitions/model_booking_service.BookingRules"
                         }
                     },
                     "400": {
@@ -433,9 +510,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/customer/send-otp": {
-            "post": {
-                "description": "SenOtpCode - Api for sen otp code users",
+        "/v1/branch": {
+            "get": {
+                "description": "ListBranches - API to list branches ordered by name, city selects the branches of a city",
                 "consumes": [
                     "application/json"
                 ],
@@ -443,25 +520,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customer"
+                    "Branch"
                 ],
-                "summary": "SenOtpCode",
+                "summary": "ListBranches",
                 "parameters": [
                     {
-                        "description": "RegisterModelReq",
-                        "name": "SenOtpCode",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model_user_service.PhoneNumberReq"
-                        }
+                        "type": "string",
+                        "description": "city",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.MessageRes"
+                            "$ref": "#/definitions/model_healthcare_service.ListBranches"
                         }
                     },
                     "400": {
@@ -477,16 +563,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/customer/update-password": {
+            },
             "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Api for UpdatePassword",
+                "description": "UpdateBranch - API to update a branch",
                 "consumes": [
                     "application/json"
                 ],
@@ -494,23 +573,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customer"
+                    "Branch"
                 ],
-                "summary": "UpdatePassword",
+                "summary": "UpdateBranch",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "NewPassword",
-                        "name": "NewPassword",
-                        "in": "query",
-                        "required": true
+                        "description": "UpdateBranchReq",
+                        "name": "UpdateBranchReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.UpdateBranchReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.GetUserResp"
+                            "$ref": "#/definitions/model_healthcare_service.Branch"
                         }
                     },
                     "400": {
@@ -526,11 +607,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/customer/verify": {
+            },
             "post": {
-                "description": "customer - Api for registering users",
+                "description": "CreateBranch - Api for create a branch of the clinic",
                 "consumes": [
                     "application/json"
                 ],
@@ -538,17 +617,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customer"
+                    "Branch"
                 ],
-                "summary": "Verify",
+                "summary": "CreateBranch",
                 "parameters": [
                     {
-                        "description": "RegisterModelReq",
-                        "name": "Verify",
+                        "description": "BranchReq",
+                        "name": "BranchReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.Verify"
+                            "$ref": "#/definitions/model_healthcare_service.BranchReq"
                         }
                     }
                 ],
@@ -556,7 +635,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.Response"
+                            "$ref": "#/definitions/model_healthcare_service.Branch"
                         }
                     },
                     "400": {
@@ -572,11 +651,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/customer/verify-otp-code": {
-            "post": {
-                "description": "VerifyOtpCode - Api for Verify Otp Code users",
+            },
+            "delete": {
+                "description": "DeleteBranch - API to delete a branch",
                 "consumes": [
                     "application/json"
                 ],
@@ -584,28 +661,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customer"
+                    "Branch"
                 ],
-                "summary": "VerifyOtpCode",
+                "summary": "DeleteBranch",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "example": 7777,
-                        "name": "code",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "example": "+998950230605",
-                        "name": "phone_number",
-                        "in": "query"
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.MessageRes"
+                            "$ref": "#/definitions/models.StatusRes"
                         }
                     },
                     "400": {
@@ -623,9 +695,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/department": {
+        "/v1/branch/get": {
             "get": {
-                "description": "ListDepartments - Api for list department",
+                "description": "GetBranch - API to get branch by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -633,46 +705,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Department"
+                    "Branch"
                 ],
-                "summary": "ListDepartments",
+                "summary": "GetBranch",
                 "parameters": [
-                    {
-                        "enum": [
-                            "name",
-                            "description"
-                        ],
-                        "type": "string",
-                        "description": "search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "order_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "page",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "name": "value",
-                        "in": "query"
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.ListDepartments"
+                            "$ref": "#/definitions/model_healthcare_service.Branch"
                         }
                     },
                     "400": {
@@ -688,9 +737,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "UpdateDepartment - Api for update department",
+            }
+        },
+        "/v1/branch/nearby": {
+            "get": {
+                "description": "ListBranchesNearby - API to list branches ordered by the distance from the coordinates, nearest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -698,25 +749,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Department"
+                    "Branch"
                 ],
-                "summary": "UpdateDepartment",
+                "summary": "ListBranchesNearby",
                 "parameters": [
                     {
-                        "description": "UpdateDepartmentReq",
-                        "name": "UpdateDepartmentReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DepartmentReq"
-                        }
+                        "type": "number",
+                        "example": 41.2995,
+                        "description": "latitude",
+                        "name": "latitude",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "example": 69.2401,
+                        "description": "longitude",
+                        "name": "longitude",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "radius_km, no limit when empty",
+                        "name": "radius_km",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, every branch when empty",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DepartmentReq"
+                            "$ref": "#/definitions/model_healthcare_service.ListNearbyBranches"
                         }
                     },
                     "400": {
@@ -732,9 +802,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "CreateDepartment - Api for crete department",
+            }
+        },
+        "/v1/cache/catalogue": {
+            "delete": {
+                "description": "PurgeCatalogueCache - Api for drop the cached catalogue responses of the resource, of all the resources when none is given",
                 "consumes": [
                     "application/json"
                 ],
@@ -742,25 +814,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Department"
+                    "CatalogueCache"
                 ],
-                "summary": "CreateDepartment",
+                "summary": "PurgeCatalogueCache",
                 "parameters": [
                     {
-                        "description": "DepartmentReq",
-                        "name": "DepartmentReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DepartmentReq"
-                        }
+                        "enum": [
+                            "departments",
+                            "specializations",
+                            "reasons",
+                            "doctor"
+                        ],
+                        "type": "string",
+                        "description": "resource",
+                        "name": "resource",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DepartmentRes"
+                            "$ref": "#/definitions/models.StatusRes"
                         }
                     },
                     "400": {
@@ -776,9 +851,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "DeleteDepartment - Api for delete department",
+            }
+        },
+        "/v1/cache/catalogue/stats": {
+            "get": {
+                "description": "CatalogueCacheStats - Api for get the hits and misses of the cached catalogue resources",
                 "consumes": [
                     "application/json"
                 ],
@@ -786,23 +863,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Department"
+                    "CatalogueCache"
                 ],
-                "summary": "DeleteDepartment",
+                "summary": "CatalogueCacheStats",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ListCatalogueCacheStats"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/customer/forget-password": {
+            "post": {
+                "description": "ForgetPassword - Api for registering users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customer"
+                ],
+                "summary": "ForgetPassword",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "description": "RegisterModelReq",
+                        "name": "ForgetPassword",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_user_service.PhoneNumberReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.StatusRes"
+                            "$ref": "#/definitions/model_user_service.MessageRes"
                         }
                     },
                     "400": {
@@ -820,9 +928,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/department/get": {
-            "get": {
-                "description": "GetDepartment - Api for get department",
+        "/v1/customer/login": {
+            "post": {
+                "description": "Login - Api for registering users",
                 "consumes": [
                     "application/json"
                 ],
@@ -830,23 +938,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Department"
+                    "customer"
                 ],
-                "summary": "GetDepartment",
+                "summary": "Login",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "description": "Login Req",
+                        "name": "Login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_user_service.LoginReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DepartmentRes"
+                            "$ref": "#/definitions/model_user_service.Response"
                         }
                     },
                     "400": {
@@ -864,9 +974,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/doctor": {
-            "get": {
-                "description": "ListDoctors - Api for list doctor",
+        "/v1/customer/logout": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "LogOut - Api for registering users",
                 "consumes": [
                     "application/json"
                 ],
@@ -874,53 +989,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor"
-                ],
-                "summary": "ListDoctors",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "order_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "value",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "first_name",
-                            "last_name",
-                            "gender",
-                            "phone_number",
-                            "email",
-                            "address",
-                            "city",
-                            "country",
-                            "biography"
-                        ],
-                        "type": "string",
-                        "description": "search",
-                        "name": "search",
-                        "in": "query"
-                    }
+                    "customer"
                 ],
+                "summary": "LogOut",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorAndDoctorHours"
+                            "$ref": "#/definitions/model_user_service.MessageRes"
                         }
                     },
                     "400": {
@@ -936,9 +1012,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "UpdateDoctor - Api for update doctor",
+            }
+        },
+        "/v1/customer/register": {
+            "post": {
+                "description": "Register - Api for registering users",
                 "consumes": [
                     "application/json"
                 ],
@@ -946,17 +1024,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor"
+                    "customer"
                 ],
-                "summary": "UpdateDoctor",
+                "summary": "Register",
                 "parameters": [
                     {
-                        "description": "UpdateDoctorReq",
-                        "name": "UpdateDoctorReq",
+                        "description": "RegisterRequest",
+                        "name": "Register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorUpdateReq"
+                            "$ref": "#/definitions/model_user_service.RegisterRequest"
                         }
                     }
                 ],
@@ -964,7 +1042,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorRes"
+                            "$ref": "#/definitions/model_user_service.MessageRes"
                         }
                     },
                     "400": {
@@ -980,9 +1058,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/customer/send-otp": {
             "post": {
-                "description": "CreateDoctor - Api for crete doctor",
+                "description": "SenOtpCode - Api for sen otp code users",
                 "consumes": [
                     "application/json"
                 ],
@@ -990,17 +1070,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor"
+                    "customer"
                 ],
-                "summary": "CreateDoctor",
+                "summary": "SenOtpCode",
                 "parameters": [
                     {
-                        "description": "DoctorReq",
-                        "name": "DoctorReq",
+                        "description": "RegisterModelReq",
+                        "name": "SenOtpCode",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorReq"
+                            "$ref": "#/definitions/model_user_service.PhoneNumberReq"
                         }
                     }
                 ],
@@ -1008,7 +1088,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorRes"
+                            "$ref": "#/definitions/model_user_service.MessageRes"
                         }
                     },
                     "400": {
@@ -1024,9 +1104,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "DeleteDoctor - Api for delete doctor",
+            }
+        },
+        "/v1/customer/update-password": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Api for UpdatePassword",
                 "consumes": [
                     "application/json"
                 ],
@@ -1034,14 +1121,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor"
+                    "customer"
                 ],
-                "summary": "DeleteDoctor",
+                "summary": "UpdatePassword",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "id",
-                        "name": "id",
+                        "description": "NewPassword",
+                        "name": "NewPassword",
                         "in": "query",
                         "required": true
                     }
@@ -1050,7 +1137,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.StatusRes"
+                            "$ref": "#/definitions/model_user_service.GetUserResp"
                         }
                     },
                     "400": {
@@ -1068,9 +1155,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/doctor-notes": {
-            "get": {
-                "description": "ListDoctorNotes - API to list doctor notes",
+        "/v1/customer/verify": {
+            "post": {
+                "description": "customer - Api for registering users",
                 "consumes": [
                     "application/json"
                 ],
@@ -1078,45 +1165,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Note"
+                    "customer"
                 ],
-                "summary": "ListDoctorNotes",
+                "summary": "Verify",
                 "parameters": [
                     {
-                        "enum": [
-                            "prescription"
-                        ],
-                        "type": "string",
-                        "description": "search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "order_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "value",
-                        "in": "query"
+                        "description": "RegisterModelReq",
+                        "name": "Verify",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_user_service.Verify"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_booking_service.DoctorNotesType"
+                            "$ref": "#/definitions/model_user_service.Response"
                         }
                     },
                     "400": {
@@ -1132,9 +1199,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "UpdateDoctorNote - API to update a doctor note",
+            }
+        },
+        "/v1/customer/verify-otp-code": {
+            "post": {
+                "description": "VerifyOtpCode - Api for Verify Otp Code users",
                 "consumes": [
                     "application/json"
                 ],
@@ -1142,25 +1211,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Note"
+                    "customer"
                 ],
-                "summary": "UpdateDoctorNote",
+                "summary": "VerifyOtpCode",
                 "parameters": [
                     {
-                        "description": "UpdateDoctorNoteReq",
-                        "name": "UpdateDoctorNoteReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model_booking_service.UpdateDoctorNoteReq"
-                        }
+                        "type": "integer",
+                        "example": 7777,
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "+998950230605",
+                        "name": "phone_number",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_booking_service.DoctorNote"
+                            "$ref": "#/definitions/model_user_service.MessageRes"
                         }
                     },
                     "400": {
@@ -1176,9 +1248,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "CreateDoctorNote - Api for create doctor note",
+            }
+        },
+        "/v1/department": {
+            "get": {
+                "description": "ListDepartments - Api for list department",
                 "consumes": [
                     "application/json"
                 ],
@@ -1186,114 +1260,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Note"
+                    "Department"
                 ],
-                "summary": "CreateDoctorNote",
+                "summary": "ListDepartments",
                 "parameters": [
                     {
-                        "description": "CreateDoctorNotesReq",
-                        "name": "CreateDoctorNotesReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model_booking_service.CreateDoctorNotesReq"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model_booking_service.DoctorNote"
-                        }
+                        "enum": [
+                            "name",
+                            "description"
+                        ],
+                        "type": "string",
+                        "description": "search",
+                        "name": "search",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/model_common.StandardErrorModel"
-                        }
+                    {
+                        "type": "string",
+                        "name": "limit",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/model_common.StandardErrorModel"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "description": "DeleteDoctorNote - API to delete a doctor note",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Doctor Note"
-                ],
-                "summary": "DeleteDoctorNote",
-                "parameters": [
                     {
                         "type": "string",
-                        "name": "field",
+                        "name": "order_by",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "name": "value",
+                        "name": "page",
                         "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.StatusRes"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/model_common.StandardErrorModel"
-                        }
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/model_common.StandardErrorModel"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/doctor-notes/get": {
-            "get": {
-                "description": "GetDoctorNote - API to get doctor note by ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Doctor Note"
-                ],
-                "summary": "GetDoctorNote",
-                "parameters": [
                     {
                         "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "description": "uz, ru or en",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_booking_service.DoctorNote"
+                            "$ref": "#/definitions/model_healthcare_service.ListDepartments"
                         }
                     },
                     "400": {
@@ -1309,11 +1321,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/doctor-services": {
-            "get": {
-                "description": "ListDoctorServices - Api for list doctor_services",
+            },
+            "put": {
+                "description": "UpdateDepartment - Api for update department",
                 "consumes": [
                     "application/json"
                 ],
@@ -1321,31 +1331,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Services"
+                    "Department"
                 ],
-                "summary": "ListDoctorServices",
+                "summary": "UpdateDepartment",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "order_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "page",
-                        "in": "query"
+                        "description": "UpdateDepartmentReq",
+                        "name": "UpdateDepartmentReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.DepartmentReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.ListDoctorServices"
+                            "$ref": "#/definitions/model_healthcare_service.DepartmentReq"
                         }
                     },
                     "400": {
@@ -1362,8 +1366,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "UpdateDoctorServices - Api for update doctor_services",
+            "post": {
+                "description": "CreateDepartment - Api for crete department",
                 "consumes": [
                     "application/json"
                 ],
@@ -1371,17 +1375,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Services"
+                    "Department"
                 ],
-                "summary": "UpdateDoctorServices",
+                "summary": "CreateDepartment",
                 "parameters": [
                     {
-                        "description": "UpdateDoctorServicesReq",
-                        "name": "UpdateDoctorServicesReq",
+                        "description": "DepartmentReq",
+                        "name": "DepartmentReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorServicesReq"
+                            "$ref": "#/definitions/model_healthcare_service.DepartmentReq"
                         }
                     }
                 ],
@@ -1389,7 +1393,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorServicesRes"
+                            "$ref": "#/definitions/model_healthcare_service.DepartmentRes"
                         }
                     },
                     "400": {
@@ -1406,8 +1410,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "CreateDoctorService - Api for crete doctor_services",
+            "delete": {
+                "description": "DeleteDepartment - Api for delete department",
                 "consumes": [
                     "application/json"
                 ],
@@ -1415,25 +1419,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Services"
+                    "Department"
                 ],
-                "summary": "CreateDoctorService",
+                "summary": "DeleteDepartment",
                 "parameters": [
                     {
-                        "description": "DoctorServiceReq",
-                        "name": "DoctorServiceReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorServicesReq"
-                        }
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorServicesRes"
+                            "$ref": "#/definitions/models.StatusRes"
                         }
                     },
                     "400": {
@@ -1449,9 +1451,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "DeleteDoctorService - Api for delete doctorServices",
+            }
+        },
+        "/v1/department/get": {
+            "get": {
+                "description": "GetDepartment - Api for get department",
                 "consumes": [
                     "application/json"
                 ],
@@ -1459,9 +1463,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Services"
+                    "Department"
                 ],
-                "summary": "DeleteDoctorService",
+                "summary": "GetDepartment",
                 "parameters": [
                     {
                         "type": "string",
@@ -1469,13 +1473,19 @@ const docTemplate = `{
                         "name": "id",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uz, ru or en",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.StatusRes"
+                            "$ref": "#/definitions/model_healthcare_service.DepartmentRes"
                         }
                     },
                     "400": {
@@ -1493,9 +1503,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/doctor-services/get": {
-            "get": {
-                "description": "GetDoctorService - Api for get doctor_services",
+        "/v1/department/restore": {
+            "put": {
+                "description": "RestoreDepartment - Api for restore a deleted department together with the records its delete cascaded to",
                 "consumes": [
                     "application/json"
                 ],
@@ -1503,9 +1513,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Services"
+                    "Department"
                 ],
-                "summary": "GetDoctorService",
+                "summary": "RestoreDepartment",
                 "parameters": [
                     {
                         "type": "string",
@@ -1519,7 +1529,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorServicesRes"
+                            "$ref": "#/definitions/models.StatusRes"
                         }
                     },
                     "400": {
@@ -1537,9 +1547,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/doctor-time": {
+        "/v1/doctor": {
             "get": {
-                "description": "ListDoctorTimes - Api for list doctor time",
+                "description": "ListDoctors - Api for list doctor, orderBy=rating desc lists the best rated doctors first",
                 "consumes": [
                     "application/json"
                 ],
@@ -1547,19 +1557,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Time"
+                    "Doctor"
                 ],
-                "summary": "ListDoctorTimes",
+                "summary": "ListDoctors",
                 "parameters": [
-                    {
-                        "enum": [
-                            "status"
-                        ],
-                        "type": "string",
-                        "description": "search",
-                        "name": "search",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
                         "name": "limit",
@@ -1579,13 +1580,30 @@ const docTemplate = `{
                         "type": "string",
                         "name": "value",
                         "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "first_name",
+                            "last_name",
+                            "gender",
+                            "phone_number",
+                            "email",
+                            "address",
+                            "city",
+                            "country",
+                            "biography"
+                        ],
+                        "type": "string",
+                        "description": "search",
+                        "name": "search",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_booking_service.DoctorTimesType"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorAndDoctorHours"
                         }
                     },
                     "400": {
@@ -1603,7 +1621,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "UpdateDoctorTimes - Api for update doctor time",
+                "description": "UpdateDoctor - Api for update doctor",
                 "consumes": [
                     "application/json"
                 ],
@@ -1611,17 +1629,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Time"
+                    "Doctor"
                 ],
-                "summary": "UpdateDoctorTimes",
+                "summary": "UpdateDoctor",
                 "parameters": [
                     {
-                        "description": "UpdateDoctorTimeReq",
-                        "name": "UpdateDoctorTimeReq",
+                        "description": "UpdateDoctorReq",
+                        "name": "UpdateDoctorReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_booking_service.UpdateDoctorTimeReq"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorUpdateReq"
                         }
                     }
                 ],
@@ -1629,7 +1647,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_booking_service.DoctorTime"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorRes"
                         }
                     },
                     "400": {
@@ -1647,7 +1665,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "CreateDoctorTimes - Api for crete doctor time",
+                "description": "CreateDoctor - Api for crete doctor",
                 "consumes": [
                     "application/json"
                 ],
@@ -1655,17 +1673,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Time"
+                    "Doctor"
                 ],
-                "summary": "CreateDoctorTimes",
+                "summary": "CreateDoctor",
                 "parameters": [
                     {
-                        "description": "CreateDoctorTimeReq",
-                        "name": "CreateDoctorTimeReq",
+                        "description": "DoctorReq",
+                        "name": "DoctorReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_booking_service.CreateDoctorTimeReq"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorReq"
                         }
                     }
                 ],
@@ -1673,7 +1691,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_booking_service.DoctorTime"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorRes"
                         }
                     },
                     "400": {
@@ -1691,7 +1709,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "DeleteDoctorTimes - Api for delete Doctor time",
+                "description": "DeleteDoctor - Api for delete doctor",
                 "consumes": [
                     "application/json"
                 ],
@@ -1699,9 +1717,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Time"
+                    "Doctor"
                 ],
-                "summary": "DeleteDoctorTimes",
+                "summary": "DeleteDoctor",
                 "parameters": [
                     {
                         "type": "string",
@@ -1733,9 +1751,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/doctor-time/get": {
+        "/v1/doctor-credential": {
             "get": {
-                "description": "GetDoctorTimes - Api for get doctor time",
+                "description": "ListDoctorCredentials - API to list credentials, expiring selects the flagged licenses not expired yet, expired the licenses expired already",
                 "consumes": [
                     "application/json"
                 ],
@@ -1743,81 +1761,50 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Time"
+                    "Doctor Credential"
                 ],
-                "summary": "GetDoctorTimes",
+                "summary": "ListDoctorCredentials",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model_booking_service.DoctorTime"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/model_common.StandardErrorModel"
-                        }
+                        "description": "doctor_id",
+                        "name": "doctor_id",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/model_common.StandardErrorModel"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/doctor-working-hours": {
-            "get": {
-                "description": "ListDoctorWorkingHours - Api for list doctor_working_hours",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Doctor Working Hours"
-                ],
-                "summary": "ListDoctorWorkingHours",
-                "parameters": [
                     {
+                        "enum": [
+                            "diploma",
+                            "license",
+                            "certificate",
+                            "other"
+                        ],
                         "type": "string",
-                        "name": "limit",
+                        "description": "type",
+                        "name": "type",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "name": "order_by",
+                        "type": "boolean",
+                        "description": "expiring",
+                        "name": "expiring",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "name": "page",
+                        "type": "boolean",
+                        "description": "expired",
+                        "name": "expired",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "name": "value",
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
                         "in": "query"
                     },
                     {
-                        "enum": [
-                            "day_of_week"
-                        ],
-                        "type": "string",
-                        "description": "search",
-                        "name": "search",
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
@@ -1825,7 +1812,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.ListDoctorWorkingHours"
+                            "$ref": "#/definitions/model_healthcare_service.ListDoctorCredentials"
                         }
                     },
                     "400": {
@@ -1843,7 +1830,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "UpdateDoctorWorkingHours - Api for update doctor_working_hours",
+                "description": "UpdateDoctorCredential - API to update a credential, a changed expiry date clears the expiry flag",
                 "consumes": [
                     "application/json"
                 ],
@@ -1851,17 +1838,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Working Hours"
+                    "Doctor Credential"
                 ],
-                "summary": "UpdateDoctorWorkingHours",
+                "summary": "UpdateDoctorCredential",
                 "parameters": [
                     {
-                        "description": "UpdateDoctorWorkingHoursReq",
-                        "name": "UpdateDoctorWorkingHoursReq",
+                        "description": "UpdateDoctorCredentialReq",
+                        "name": "UpdateDoctorCredentialReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorWorkingHoursReq"
+                            "$ref": "#/definitions/model_healthcare_service.UpdateDoctorCredentialReq"
                         }
                     }
                 ],
@@ -1869,7 +1856,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorWorkingHoursRes"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorCredential"
                         }
                     },
                     "400": {
@@ -1887,7 +1874,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "CreateDoctorWorkingHours - Api for crete doctor_working_hours",
+                "description": "CreateDoctorCredential - Api for add a credential to a doctor, the document is uploaded before through /v1/doctor-credential/document",
                 "consumes": [
                     "application/json"
                 ],
@@ -1895,17 +1882,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Working Hours"
+                    "Doctor Credential"
                 ],
-                "summary": "CreateDoctorWorkingHours",
+                "summary": "CreateDoctorCredential",
                 "parameters": [
                     {
-                        "description": "DoctorServiceReq",
-                        "name": "DoctorWorkingHoursReq",
+                        "description": "CreateDoctorCredentialReq",
+                        "name": "CreateDoctorCredentialReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorWorkingHoursReq"
+                            "$ref": "#/definitions/model_healthcare_service.CreateDoctorCredentialReq"
                         }
                     }
                 ],
@@ -1913,7 +1900,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorWorkingHoursRes"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorCredential"
                         }
                     },
                     "400": {
@@ -1931,7 +1918,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "DeleteDoctorWorkingHours - Api for delete doctor_working_hours",
+                "description": "DeleteDoctorCredential - API to delete a credential",
                 "consumes": [
                     "application/json"
                 ],
@@ -1939,12 +1926,12 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Working Hours"
+                    "Doctor Credential"
                 ],
-                "summary": "DeleteDoctorWorkingHours",
+                "summary": "DeleteDoctorCredential",
                 "parameters": [
                     {
-                        "type": "string",
+                        "type": "integer",
                         "description": "id",
                         "name": "id",
                         "in": "query",
@@ -1973,9 +1960,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/doctor-working-hours/get": {
+        "/v1/doctor-credential/document": {
             "get": {
-                "description": "GetDoctorWorkingHours - Api for get doctor_working_hours",
+                "description": "GetDoctorCredentialDocument - Api for get a short-lived url of the document of a credential",
                 "consumes": [
                     "application/json"
                 ],
@@ -1983,29 +1970,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor Working Hours"
+                    "Doctor Credential"
                 ],
-                "summary": "GetDoctorWorkingHours",
+                "summary": "GetDoctorCredentialDocument",
                 "parameters": [
                     {
-                        "type": "string",
+                        "type": "integer",
                         "description": "id",
                         "name": "id",
                         "in": "query",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "day_of_week",
-                        "name": "day_of_week",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorWorkingHoursRes"
+                            "$ref": "#/definitions/model_minio.DocumentURL"
                         }
                     },
                     "400": {
@@ -2014,6 +1995,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/model_common.StandardErrorModel"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2021,35 +2008,40 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/doctor/get": {
-            "get": {
-                "description": "GetDoctor - Api for get doctor",
+            },
+            "post": {
+                "description": "UploadDoctorCredentialDocument - Api for upload the pdf, jpeg or png copy of a credential to the private document bucket, the returned object name is the document_object_name of the credential",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor"
+                    "Doctor Credential"
                 ],
-                "summary": "GetDoctor",
+                "summary": "UploadDoctorCredentialDocument",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "id",
-                        "name": "id",
+                        "description": "doctor_id",
+                        "name": "doctor_id",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.DoctorAndDoctorHours"
+                            "$ref": "#/definitions/model_minio.UploadedDocument"
                         }
                     },
                     "400": {
@@ -2058,6 +2050,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/model_common.StandardErrorModel"
                         }
                     },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2067,9 +2071,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/doctor/spec": {
+        "/v1/doctor-credential/get": {
             "get": {
-                "description": "ListDoctorsBySpecializationId - Api for list doctors by specialization id",
+                "description": "GetDoctorCredential - API to get credential by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2077,34 +2081,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor"
+                    "Doctor Credential"
                 ],
-                "summary": "ListDoctorsBySpecializationId",
+                "summary": "GetDoctorCredential",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "order_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "value",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "specialization_id",
-                        "name": "specialization_id",
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
                         "in": "query",
                         "required": true
                     }
@@ -2113,7 +2097,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.ListDoctors"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorCredential"
                         }
                     },
                     "400": {
@@ -2131,38 +2115,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/file-upload": {
-            "post": {
-                "description": "Upload image",
+        "/v1/doctor-credential/license-status": {
+            "get": {
+                "description": "GetDoctorLicenseStatus - API to check whether a doctor has a license valid on a date",
                 "consumes": [
-                    "image/png"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "upload-file"
+                    "Doctor Credential"
                 ],
-                "summary": "Upload image",
+                "summary": "GetDoctorLicenseStatus",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "file",
-                        "name": "file",
-                        "in": "formData",
+                        "type": "string",
+                        "description": "doctor_id",
+                        "name": "doctor_id",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "enum": [
-                            "department",
-                            "reasons",
-                            "specialization",
-                            "doctor",
-                            "user"
-                        ],
                         "type": "string",
-                        "description": "bucket",
-                        "name": "bucketName",
+                        "example": "2024-07-05",
+                        "description": "date, today when empty",
+                        "name": "date",
                         "in": "query"
                     }
                 ],
@@ -2170,7 +2148,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_minio.MinioURL"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorLicenseStatus"
                         }
                     },
                     "400": {
@@ -2188,9 +2166,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/patient": {
+        "/v1/doctor-leave": {
             "get": {
-                "description": "ListPatient - Api for list patient",
+                "description": "ListDoctorLeaves - API to list leaves, from and to select the leaves touching the range",
                 "consumes": [
                     "application/json"
                 ],
@@ -2198,43 +2176,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Patient"
+                    "Doctor Leave"
                 ],
-                "summary": "ListPatient",
+                "summary": "ListDoctorLeaves",
                 "parameters": [
                     {
-                        "enum": [
-                            "first_name",
-                            "last_name",
-                            "blood_group",
-                            "phone_number",
-                            "address",
-                            "city",
-                            "country"
-                        ],
                         "type": "string",
-                        "description": "searchField",
-                        "name": "searchField",
+                        "description": "doctor_id",
+                        "name": "doctor_id",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "name": "limit",
+                        "enum": [
+                            "pending",
+                            "approved",
+                            "rejected",
+                            "cancelled"
+                        ],
+                        "type": "string",
+                        "description": "status",
+                        "name": "status",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "name": "order_by",
+                        "example": "2024-07-01",
+                        "description": "from",
+                        "name": "from",
                         "in": "query"
                     },
                     {
                         "type": "string",
+                        "example": "2024-07-31",
+                        "description": "to",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
                         "name": "page",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "name": "value",
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
@@ -2242,7 +2229,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_booking_service.PatientsType"
+                            "$ref": "#/definitions/model_healthcare_service.ListDoctorLeaves"
                         }
                     },
                     "400": {
@@ -2259,8 +2246,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "UpdatePatient - Api for update patient",
+            "post": {
+                "description": "CreateDoctorLeave - Api for request a leave of a doctor, the leave waits for the review of an admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -2268,17 +2255,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Patient"
+                    "Doctor Leave"
                 ],
-                "summary": "UpdatePatient",
+                "summary": "CreateDoctorLeave",
                 "parameters": [
                     {
-                        "description": "UpdatePatientReq",
-                        "name": "UpdatePatientReq",
+                        "description": "CreateDoctorLeaveReq",
+                        "name": "CreateDoctorLeaveReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_booking_service.UpdatePatientReq"
+                            "$ref": "#/definitions/model_healthcare_service.CreateDoctorLeaveReq"
                         }
                     }
                 ],
@@ -2286,7 +2273,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_booking_service.Patient"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorLeave"
                         }
                     },
                     "400": {
@@ -2302,9 +2289,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "CreatePatient - Api for crete patient",
+            }
+        },
+        "/v1/doctor-leave/away": {
+            "get": {
+                "description": "ListAwayDoctors - API to list the doctors on an approved leave on a date, per department",
                 "consumes": [
                     "application/json"
                 ],
@@ -2312,25 +2301,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Patient"
+                    "Doctor Leave"
                 ],
-                "summary": "CreatePatient",
+                "summary": "ListAwayDoctors",
                 "parameters": [
                     {
-                        "description": "CreatePatientReq",
-                        "name": "CreatePatientReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model_booking_service.CreatePatientReq"
-                        }
+                        "type": "string",
+                        "example": "2024-07-05",
+                        "description": "date, today when empty",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "department_id, every department when empty",
+                        "name": "department_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_booking_service.Patient"
+                            "$ref": "#/definitions/model_healthcare_service.ListAwayDoctors"
                         }
                     },
                     "400": {
@@ -2346,9 +2339,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "DeletePatient - Api for delete patient",
+            }
+        },
+        "/v1/doctor-leave/cancel": {
+            "put": {
+                "description": "CancelDoctorLeave - API to cancel a pending or approved leave, the days of an approved leave are available again",
                 "consumes": [
                     "application/json"
                 ],
@@ -2356,12 +2351,12 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Patient"
+                    "Doctor Leave"
                 ],
-                "summary": "DeletePatient",
+                "summary": "CancelDoctorLeave",
                 "parameters": [
                     {
-                        "type": "string",
+                        "type": "integer",
                         "description": "id",
                         "name": "id",
                         "in": "query",
@@ -2372,7 +2367,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.StatusRes"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorLeave"
                         }
                     },
                     "400": {
@@ -2390,9 +2385,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/patient/get": {
+        "/v1/doctor-leave/get": {
             "get": {
-                "description": "GetPatient - Api for get patient",
+                "description": "GetDoctorLeave - API to get leave by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2400,12 +2395,12 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Patient"
+                    "Doctor Leave"
                 ],
-                "summary": "GetPatient",
+                "summary": "GetDoctorLeave",
                 "parameters": [
                     {
-                        "type": "string",
+                        "type": "integer",
                         "description": "id",
                         "name": "id",
                         "in": "query",
@@ -2416,7 +2411,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_booking_service.Patient"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorLeave"
                         }
                     },
                     "400": {
@@ -2434,9 +2429,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/patient/phone": {
+        "/v1/doctor-leave/review": {
             "put": {
-                "description": "UpdatePhonePatient - Api for update phone patient",
+                "description": "ReviewDoctorLeave - API to approve or reject a pending leave, the days of an approved leave are unavailable for booking",
                 "consumes": [
                     "application/json"
                 ],
@@ -2444,30 +2439,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Patient"
+                    "Doctor Leave"
                 ],
-                "summary": "UpdatePhonePatient",
+                "summary": "ReviewDoctorLeave",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "phone_number",
-                        "name": "phone_number",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "new_phone_number",
-                        "name": "new_phone_number",
-                        "in": "query",
-                        "required": true
+                        "description": "ReviewDoctorLeaveReq",
+                        "name": "ReviewDoctorLeaveReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ReviewDoctorLeaveReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_booking_service.Patient"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorLeave"
                         }
                     },
                     "400": {
@@ -2485,9 +2475,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/reasons": {
+        "/v1/doctor-notes": {
             "get": {
-                "description": "ListReasons - Api for list reasons",
+                "description": "ListDoctorNotes - API to list doctor notes",
                 "consumes": [
                     "application/json"
                 ],
@@ -2495,10 +2485,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Reasons"
+                    "Doctor Note"
                 ],
-                "summary": "ListReasons",
+                "summary": "ListDoctorNotes",
                 "parameters": [
+                    {
+                        "enum": [
+                            "prescription"
+                        ],
+                        "type": "string",
+                        "description": "search",
+                        "name": "search",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "name": "limit",
@@ -2518,22 +2517,13 @@ const docTemplate = `{
                         "type": "string",
                         "name": "value",
                         "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "name"
-                        ],
-                        "type": "string",
-                        "description": "search",
-                        "name": "search",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.ListReasons"
+                            "$ref": "#/definitions/model_booking_service.DoctorNotesType"
                         }
                     },
                     "400": {
@@ -2551,7 +2541,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "UpdateReasons - Api for update reasons",
+                "description": "UpdateDoctorNote - API to update a doctor note",
                 "consumes": [
                     "application/json"
                 ],
@@ -2559,17 +2549,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Reasons"
+                    "Doctor Note"
                 ],
-                "summary": "UpdateReasons",
+                "summary": "UpdateDoctorNote",
                 "parameters": [
                     {
-                        "description": "UpdateReasonsReq",
-                        "name": "UpdateReasonsReq",
+                        "description": "UpdateDoctorNoteReq",
+                        "name": "UpdateDoctorNoteReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.ReasonsReq"
+                            "$ref": "#/definitions/model_booking_service.UpdateDoctorNoteReq"
                         }
                     }
                 ],
@@ -2577,7 +2567,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.ReasonsRes"
+                            "$ref": "#/definitions/model_booking_service.DoctorNote"
                         }
                     },
                     "400": {
@@ -2595,7 +2585,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "CreateReasons - Api for crete reasons",
+                "description": "CreateDoctorNote - Api for create doctor note",
                 "consumes": [
                     "application/json"
                 ],
@@ -2603,17 +2593,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Reasons"
+                    "Doctor Note"
                 ],
-                "summary": "CreateReasons",
+                "summary": "CreateDoctorNote",
                 "parameters": [
                     {
-                        "description": "DoctorServiceReq",
-                        "name": "DoctorWorkingHoursReq",
+                        "description": "CreateDoctorNotesReq",
+                        "name": "CreateDoctorNotesReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.ReasonsReq"
+                            "$ref": "#/definitions/model_booking_service.CreateDoctorNotesReq"
                         }
                     }
                 ],
@@ -2621,7 +2611,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.ReasonsRes"
+                            "$ref": "#/definitions/model_booking_service.DoctorNote"
                         }
                     },
                     "400": {
@@ -2639,7 +2629,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "DeleteReasons - Api for delete reasons",
+                "description": "DeleteDoctorNote - API to delete a doctor note",
                 "consumes": [
                     "application/json"
                 ],
@@ -2647,16 +2637,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Reasons"
+                    "Doctor Note"
                 ],
-                "summary": "DeleteReasons",
+                "summary": "DeleteDoctorNote",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "name": "field",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2681,9 +2674,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/reasons/get": {
+        "/v1/doctor-notes/get": {
             "get": {
-                "description": "GetReasons - Api for get reasons",
+                "description": "GetDoctorNote - API to get doctor note by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2691,9 +2684,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Reasons"
+                    "Doctor Note"
                 ],
-                "summary": "GetReasons",
+                "summary": "GetDoctorNote",
                 "parameters": [
                     {
                         "type": "string",
@@ -2707,7 +2700,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.ReasonsRes"
+                            "$ref": "#/definitions/model_booking_service.DoctorNote"
                         }
                     },
                     "400": {
@@ -2725,9 +2718,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/session": {
+        "/v1/doctor-portal/agenda": {
             "get": {
-                "description": "GetUserSessions - Api for get session",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "DoctorAgenda - API to list the appointments of the logged doctor on a day, today when date is empty",
                 "consumes": [
                     "application/json"
                 ],
@@ -2735,23 +2733,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Session"
+                    "Doctor Portal"
                 ],
-                "summary": "GetUserSessions",
+                "summary": "DoctorAgenda",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "user_id",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "example": "2024-01-15",
+                        "description": "date",
+                        "name": "date",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_session_service.ListSessions"
+                            "$ref": "#/definitions/model_booking_service.AppointmentsType"
                         }
                     },
                     "400": {
@@ -2767,9 +2765,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "DeleteSessionById - Api for delete session",
+            }
+        },
+        "/v1/doctor-portal/attendance": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "DoctorMarkAttendance - API to mark an appointment of the logged doctor attended or no_show",
                 "consumes": [
                     "application/json"
                 ],
@@ -2777,23 +2782,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Session"
+                    "Doctor Portal"
                 ],
-                "summary": "DeleteSessionById",
+                "summary": "DoctorMarkAttendance",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "description": "AttendanceReq",
+                        "name": "AttendanceReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.AttendanceReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.StatusRes"
+                            "$ref": "#/definitions/model_booking_service.Appointment"
                         }
                     },
                     "400": {
@@ -2802,6 +2809,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/model_common.StandardErrorModel"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2811,9 +2830,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/specialization": {
-            "get": {
-                "description": "ListSpecializations - Api for list specialization",
+        "/v1/doctor-portal/login": {
+            "post": {
+                "description": "DoctorLogin - Api for login a doctor with the email and password, the tokens have the role doctor",
                 "consumes": [
                     "application/json"
                 ],
@@ -2821,52 +2840,194 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Specialization"
+                    "Doctor Portal"
                 ],
-                "summary": "ListSpecializations",
+                "summary": "DoctorLogin",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "DoctorLoginReq",
+                        "name": "DoctorLoginReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.DoctorLoginReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.DoctorLoginRes"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "name": "order_by",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/doctor-portal/note": {
+            "post": {
+                "security": [
                     {
-                        "type": "string",
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "DoctorWriteNote - API to write the note of an appointment of the logged doctor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor Portal"
+                ],
+                "summary": "DoctorWriteNote",
+                "parameters": [
+                    {
+                        "description": "DoctorPortalNoteReq",
+                        "name": "DoctorPortalNoteReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.DoctorPortalNoteReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.DoctorNote"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/doctor-portal/upcoming": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "DoctorUpcomingAppointments - API to list the waiting appointments of the logged doctor from now on",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor Portal"
+                ],
+                "summary": "DoctorUpcomingAppointments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page",
                         "name": "page",
                         "in": "query"
                     },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.AppointmentsType"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/doctor-services": {
+            "get": {
+                "description": "ListDoctorServices - Api for list doctor_services",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor Services"
+                ],
+                "summary": "ListDoctorServices",
+                "parameters": [
                     {
                         "type": "string",
-                        "name": "value",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "department_id",
-                        "name": "department_id",
+                        "name": "order_by",
                         "in": "query"
                     },
                     {
-                        "enum": [
-                            "name",
-                            "description"
-                        ],
                         "type": "string",
-                        "description": "search",
-                        "name": "search",
+                        "name": "page",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "uz, ru or en",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.ListSpecializations"
+                            "$ref": "#/definitions/model_healthcare_service.ListDoctorServices"
                         }
                     },
                     "400": {
@@ -2884,7 +3045,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "UpdateSpecialization - Api for update specialization",
+                "description": "UpdateDoctorServices - Api for update doctor_services",
                 "consumes": [
                     "application/json"
                 ],
@@ -2892,32 +3053,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Specialization"
+                    "Doctor Services"
                 ],
-                "summary": "UpdateSpecialization",
+                "summary": "UpdateDoctorServices",
                 "parameters": [
                     {
-                        "description": "UpdateSpecializationReq",
-                        "name": "UpdateSpecializationReq",
+                        "description": "UpdateDoctorServicesReq",
+                        "name": "UpdateDoctorServicesReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.SpecializationReq"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorServicesReq"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.SpecializationRes"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorServicesRes"
                         }
                     },
                     "400": {
@@ -2935,7 +3089,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "CreateSpecialization - Api for crete specialization",
+                "description": "CreateDoctorService - Api for crete doctor_services",
                 "consumes": [
                     "application/json"
                 ],
@@ -2943,17 +3097,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Specialization"
+                    "Doctor Services"
                 ],
-                "summary": "CreateSpecialization",
+                "summary": "CreateDoctorService",
                 "parameters": [
                     {
-                        "description": "SpecializationReq",
-                        "name": "SpecializationReq",
+                        "description": "DoctorServiceReq",
+                        "name": "DoctorServiceReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.SpecializationReq"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorServicesReq"
                         }
                     }
                 ],
@@ -2961,7 +3115,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.SpecializationRes"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorServicesRes"
                         }
                     },
                     "400": {
@@ -2979,7 +3133,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "DeleteSpecialization - Api for delete specialization",
+                "description": "DeleteDoctorService - Api for delete doctorServices",
                 "consumes": [
                     "application/json"
                 ],
@@ -2987,9 +3141,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Specialization"
+                    "Doctor Services"
                 ],
-                "summary": "DeleteSpecialization",
+                "summary": "DeleteDoctorService",
                 "parameters": [
                     {
                         "type": "string",
@@ -3021,9 +3175,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/specialization/get": {
+        "/v1/doctor-services/get": {
             "get": {
-                "description": "GetSpecialization - Api for get specialization",
+                "description": "GetDoctorService - Api for get doctor_services",
                 "consumes": [
                     "application/json"
                 ],
@@ -3031,9 +3185,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Specialization"
+                    "Doctor Services"
                 ],
-                "summary": "GetSpecialization",
+                "summary": "GetDoctorService",
                 "parameters": [
                     {
                         "type": "string",
@@ -3041,13 +3195,19 @@ const docTemplate = `{
                         "name": "id",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uz, ru or en",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_healthcare_service.SpecializationRes"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorServicesRes"
                         }
                     },
                     "400": {
@@ -3065,9 +3225,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/token/get-token": {
-            "get": {
-                "description": "GetTokens",
+        "/v1/doctor-services/restore": {
+            "put": {
+                "description": "RestoreDoctorService - Api for restore a deleted doctor service together with the records its delete cascaded to",
                 "consumes": [
                     "application/json"
                 ],
@@ -3075,14 +3235,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Token"
+                    "Doctor Services"
+                ],
+                "summary": "RestoreDoctorService",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "GetTokens",
                 "responses": {
                     "200": {
-                        "description": "Successful response",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.Tokens"
+                            "$ref": "#/definitions/models.StatusRes"
                         }
                     },
                     "400": {
@@ -3100,9 +3269,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/user": {
+        "/v1/doctor-time": {
             "get": {
-                "description": "Api for ListUsers",
+                "description": "ListDoctorTimes - Api for list doctor time",
                 "consumes": [
                     "application/json"
                 ],
@@ -3110,38 +3279,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Doctor Time"
                 ],
-                "summary": "ListUsers",
+                "summary": "ListDoctorTimes",
                 "parameters": [
                     {
+                        "enum": [
+                            "status"
+                        ],
                         "type": "string",
-                        "description": "Page",
-                        "name": "Page",
+                        "description": "search",
+                        "name": "search",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Limit",
-                        "name": "Limit",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Field",
-                        "name": "Field",
+                        "name": "order_by",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Value",
-                        "name": "Value",
+                        "name": "page",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "OrderBy",
-                        "name": "OrderBy",
+                        "name": "value",
                         "in": "query"
                     }
                 ],
@@ -3149,7 +3317,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.GetUserResp"
+                            "$ref": "#/definitions/model_booking_service.DoctorTimesType"
                         }
                     },
                     "400": {
@@ -3167,7 +3335,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Api for UpdateUser",
+                "description": "UpdateDoctorTimes - Api for update doctor time",
                 "consumes": [
                     "application/json"
                 ],
@@ -3175,17 +3343,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Doctor Time"
                 ],
-                "summary": "UpdateUser",
+                "summary": "UpdateDoctorTimes",
                 "parameters": [
                     {
-                        "description": "UpdUserReq",
-                        "name": "UpdUserReq",
+                        "description": "UpdateDoctorTimeReq",
+                        "name": "UpdateDoctorTimeReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.UpdUserReq"
+                            "$ref": "#/definitions/model_booking_service.UpdateDoctorTimeReq"
                         }
                     }
                 ],
@@ -3193,7 +3361,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.GetUserResp"
+                            "$ref": "#/definitions/model_booking_service.DoctorTime"
                         }
                     },
                     "400": {
@@ -3210,8 +3378,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Api for DeleteUser",
+            "post": {
+                "description": "CreateDoctorTimes - Api for crete doctor time",
                 "consumes": [
                     "application/json"
                 ],
@@ -3219,23 +3387,67 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Doctor Time"
                 ],
-                "summary": "DeleteUser",
+                "summary": "CreateDoctorTimes",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
+                        "description": "CreateDoctorTimeReq",
+                        "name": "CreateDoctorTimeReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.CreateDoctorTimeReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.CheckUserFieldResp"
+                            "$ref": "#/definitions/model_booking_service.DoctorTime"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "DeleteDoctorTimes - Api for delete Doctor time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor Time"
+                ],
+                "summary": "DeleteDoctorTimes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
                         }
                     },
                     "400": {
@@ -3253,14 +3465,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/user/get": {
+        "/v1/doctor-time/get": {
             "get": {
-                "security": [
+                "description": "GetDoctorTimes - Api for get doctor time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor Time"
+                ],
+                "summary": "GetDoctorTimes",
+                "parameters": [
                     {
-                        "ApiKeyAuth": []
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
-                "description": "Api for GetUserByID",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.DoctorTime"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/doctor-working-hours": {
+            "get": {
+                "description": "ListDoctorWorkingHours - Api for list doctor_working_hours",
                 "consumes": [
                     "application/json"
                 ],
@@ -3268,14 +3519,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Doctor Working Hours"
+                ],
+                "summary": "ListDoctorWorkingHours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "day_of_week"
+                        ],
+                        "type": "string",
+                        "description": "search",
+                        "name": "search",
+                        "in": "query"
+                    }
                 ],
-                "summary": "GetUserByID",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.GetUserResp"
+                            "$ref": "#/definitions/model_healthcare_service.ListDoctorWorkingHours"
                         }
                     },
                     "400": {
@@ -3291,11 +3573,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/user/update-refresh-token": {
+            },
             "put": {
-                "description": "Update the refresh token of the user",
+                "description": "UpdateDoctorWorkingHours - Api for update doctor_working_hours",
                 "consumes": [
                     "application/json"
                 ],
@@ -3303,25 +3583,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Doctor Working Hours"
                 ],
-                "summary": "Update Refresh Token",
+                "summary": "UpdateDoctorWorkingHours",
                 "parameters": [
                     {
-                        "description": "RefreshToken",
-                        "name": "RefreshToken",
+                        "description": "UpdateDoctorWorkingHoursReq",
+                        "name": "UpdateDoctorWorkingHoursReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.RefreshToken"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorWorkingHoursReq"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful response",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_user_service.UpdateRefreshTokenUserResp"
+                            "$ref": "#/definitions/model_healthcare_service.DoctorWorkingHoursRes"
                         }
                     },
                     "400": {
@@ -3337,744 +3617,6478 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "model_booking_service.Appointment": {
-            "type": "object",
-            "properties": {
-                "appointment_date": {
-                    "type": "string"
-                },
-                "appointment_time": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "department_id": {
-                    "type": "string"
-                },
-                "doctor_id": {
-                    "type": "string"
-                },
-                "doctor_service_id": {
-                    "type": "string"
-                },
-                "duration": {
-                    "type": "integer"
-                },
-                "expires_at": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "key": {
-                    "type": "string"
-                },
-                "patient_id": {
-                    "type": "string"
-                },
-                "patient_problem": {
-                    "type": "string"
-                },
-                "patient_status": {
-                    "type": "string"
-                },
-                "payment_amount": {
-                    "type": "number"
-                },
-                "payment_type": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
-                }
-            }
-        },
-        "model_booking_service.AppointmentsType": {
-            "type": "object",
-            "properties": {
-                "appointments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model_booking_service.Appointment"
+            },
+            "post": {
+                "description": "CreateDoctorWorkingHours - Api for crete doctor_working_hours, a shift or a lunch break of a weekday, shifts of a day may not overlap and breaks have to lie within a shift",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor Working Hours"
+                ],
+                "summary": "CreateDoctorWorkingHours",
+                "parameters": [
+                    {
+                        "description": "DoctorServiceReq",
+                        "name": "DoctorWorkingHoursReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.DoctorWorkingHoursReq"
+                        }
                     }
-                },
-                "count": {
-                    "type": "integer"
-                }
-            }
-        },
-        "model_booking_service.CreateAppointmentReq": {
-            "type": "object",
-            "properties": {
-                "appointment_date": {
-                    "type": "string"
-                },
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.DoctorWorkingHoursRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "DeleteDoctorWorkingHours - Api for delete doctor_working_hours",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor Working Hours"
+                ],
+                "summary": "DeleteDoctorWorkingHours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/doctor-working-hours/get": {
+            "get": {
+                "description": "GetDoctorWorkingHours - Api for get doctor_working_hours",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor Working Hours"
+                ],
+                "summary": "GetDoctorWorkingHours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "day_of_week",
+                        "name": "day_of_week",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.DoctorWorkingHoursRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/doctor/department": {
+            "get": {
+                "description": "ListDoctorsByDepartmentId - Api for list the doctors working in a department, the main one or any other of their departments",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor"
+                ],
+                "summary": "ListDoctorsByDepartmentId",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "department_id",
+                        "name": "department_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ListDoctors"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/doctor/export": {
+            "get": {
+                "description": "ExportDoctors - Api for export doctors as csv or xlsx, accepts the same filters as the list api",
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Doctor"
+                ],
+                "summary": "ExportDoctors",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "description": "format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated list of columns, all columns by default",
+                        "name": "columns",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "first_name",
+                            "last_name",
+                            "gender",
+                            "phone_number",
+                            "email",
+                            "address",
+                            "city",
+                            "country",
+                            "biography"
+                        ],
+                        "type": "string",
+                        "description": "search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "value",
+                        "name": "value",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "orderBy",
+                        "name": "orderBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/doctor/get": {
+            "get": {
+                "description": "GetDoctor - Api for get doctor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor"
+                ],
+                "summary": "GetDoctor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.DoctorAndDoctorHours"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/doctor/restore": {
+            "put": {
+                "description": "RestoreDoctor - Api for restore a deleted doctor together with the records its delete cascaded to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor"
+                ],
+                "summary": "RestoreDoctor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/doctor/spec": {
+            "get": {
+                "description": "ListDoctorsBySpecializationId - Api for list doctors by specialization id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor"
+                ],
+                "summary": "ListDoctorsBySpecializationId",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "specialization_id",
+                        "name": "specialization_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ListDoctors"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/file-upload": {
+            "post": {
+                "description": "Upload image - Api for upload a jpeg or png image, it is stored without its metadata together with its thumbnail and medium renditions",
+                "consumes": [
+                    "image/png"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "upload-file"
+                ],
+                "summary": "Upload image",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "department",
+                            "reasons",
+                            "specialization",
+                            "doctor",
+                            "user"
+                        ],
+                        "type": "string",
+                        "description": "bucket",
+                        "name": "bucketName",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_minio.MinioURL"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/file-upload/document": {
+            "get": {
+                "description": "GetDocument - Api for get a short-lived url of a document of the private document bucket, only the patient the document belongs to gets it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "upload-file"
+                ],
+                "summary": "GetDocument",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "object_name",
+                        "name": "object_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_minio.DocumentURL"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/file-upload/presign": {
+            "post": {
+                "description": "PresignUpload - Api for presign the direct upload of a document of the logged patient to the private document bucket, the PUT must carry the returned headers. The pictures of the public buckets are uploaded through /v1/file-upload, which strips their metadata",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "upload-file"
+                ],
+                "summary": "PresignUpload",
+                "parameters": [
+                    {
+                        "description": "PresignReq",
+                        "name": "PresignReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_minio.PresignReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_minio.PresignRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient": {
+            "get": {
+                "description": "ListPatient - Api for list patient",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient"
+                ],
+                "summary": "ListPatient",
+                "parameters": [
+                    {
+                        "enum": [
+                            "first_name",
+                            "last_name",
+                            "blood_group",
+                            "phone_number",
+                            "address",
+                            "city",
+                            "country"
+                        ],
+                        "type": "string",
+                        "description": "searchField",
+                        "name": "searchField",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.PatientsType"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "UpdatePatient - Api for update patient",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient"
+                ],
+                "summary": "UpdatePatient",
+                "parameters": [
+                    {
+                        "description": "UpdatePatientReq",
+                        "name": "UpdatePatientReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.UpdatePatientReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.Patient"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "CreatePatient - Api for crete patient",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient"
+                ],
+                "summary": "CreatePatient",
+                "parameters": [
+                    {
+                        "description": "CreatePatientReq",
+                        "name": "CreatePatientReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.CreatePatientReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.Patient"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "DeletePatient - Api for delete patient",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient"
+                ],
+                "summary": "DeletePatient",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient-document": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "ListPatientDocuments - API to list the documents of a patient or of an appointment, newest first. A user lists the documents they attached, a doctor the documents of the patients they treat",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient Document"
+                ],
+                "summary": "ListPatientDocuments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "patient_id",
+                        "name": "patient_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "appointment_id",
+                        "name": "appointment_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.PatientDocumentsType"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "CreatePatientDocument - Api for attach a file, uploaded to the document bucket through a presigned url, to a patient and optionally to one of their appointments or doctor notes. The patient must have the phone number of the logged user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient Document"
+                ],
+                "summary": "CreatePatientDocument",
+                "parameters": [
+                    {
+                        "description": "CreatePatientDocumentReq",
+                        "name": "CreatePatientDocumentReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.CreatePatientDocumentReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.PatientDocument"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "DeletePatientDocument - API to delete a document, only the user who attached it deletes it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient Document"
+                ],
+                "summary": "DeletePatientDocument",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient-document/download": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "DownloadPatientDocument - API to get a short-lived url of the file of a document, the user who attached it and the doctors treating the patient get it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient Document"
+                ],
+                "summary": "DownloadPatientDocument",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_minio.DocumentURL"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient-document/get": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "GetPatientDocument - API to get a document of a patient, the user who attached it and the doctors treating the patient get it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient Document"
+                ],
+                "summary": "GetPatientDocument",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.PatientDocument"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient/export": {
+            "get": {
+                "description": "ExportPatients - Api for export patients as csv or xlsx, accepts the same filters as the list api",
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Patient"
+                ],
+                "summary": "ExportPatients",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "description": "format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated list of columns, all columns by default",
+                        "name": "columns",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "first_name",
+                            "last_name",
+                            "blood_group",
+                            "phone_number",
+                            "address",
+                            "city",
+                            "country"
+                        ],
+                        "type": "string",
+                        "description": "searchField",
+                        "name": "searchField",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "value",
+                        "name": "value",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "orderBy",
+                        "name": "orderBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient/get": {
+            "get": {
+                "description": "GetPatient - Api for get patient",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient"
+                ],
+                "summary": "GetPatient",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.Patient"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient/notifications": {
+            "get": {
+                "description": "ListPatientNotifications - Api for listing the notifications of a patient",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient"
+                ],
+                "summary": "ListPatientNotifications",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "patient_id",
+                        "name": "patient_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.PatientNotifications"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient/phone": {
+            "put": {
+                "description": "UpdatePhonePatient - Api for update phone patient",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient"
+                ],
+                "summary": "UpdatePhonePatient",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "phone_number",
+                        "name": "phone_number",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "new_phone_number",
+                        "name": "new_phone_number",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.Patient"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/price-history": {
+            "get": {
+                "description": "ListPriceHistory - API to list the prices of a doctor service newest first, the scheduled changes included",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price History"
+                ],
+                "summary": "ListPriceHistory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "doctor_service_id",
+                        "name": "doctor_service_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.PriceHistory"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "SchedulePriceChange - Api for schedule the prices of a doctor service from a future effective_from",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price History"
+                ],
+                "summary": "SchedulePriceChange",
+                "parameters": [
+                    {
+                        "description": "PriceChangeReq",
+                        "name": "PriceChangeReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.PriceChangeReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.PriceChange"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "CancelPriceChange - API to cancel a scheduled price change, the prices already in effect are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price History"
+                ],
+                "summary": "CancelPriceChange",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/price-history/at": {
+            "get": {
+                "description": "GetPriceAt - API to get the prices of a doctor service valid at a moment, now when at is empty",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price History"
+                ],
+                "summary": "GetPriceAt",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "doctor_service_id",
+                        "name": "doctor_service_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-01-15 10:00:00",
+                        "description": "at",
+                        "name": "at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.PriceChange"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/promo-code": {
+            "get": {
+                "description": "ListPromoCodes - API to list promo codes, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promo Code"
+                ],
+                "summary": "ListPromoCodes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.PromoCodesType"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "UpdatePromoCode - API to update a promo code, the code and its usage count are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promo Code"
+                ],
+                "summary": "UpdatePromoCode",
+                "parameters": [
+                    {
+                        "description": "UpdatePromoCodeReq",
+                        "name": "UpdatePromoCodeReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.UpdatePromoCodeReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.PromoCode"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "CreatePromoCode - Api for create a promo code applied at booking, codes are unique whatever the case, max_uses 0 is unlimited and an empty valid_until has no end",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promo Code"
+                ],
+                "summary": "CreatePromoCode",
+                "parameters": [
+                    {
+                        "description": "CreatePromoCodeReq",
+                        "name": "CreatePromoCodeReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.CreatePromoCodeReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.PromoCode"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "DeletePromoCode - API to delete a promo code",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promo Code"
+                ],
+                "summary": "DeletePromoCode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/promo-code/get": {
+            "get": {
+                "description": "GetPromoCode - API to get promo code by code",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promo Code"
+                ],
+                "summary": "GetPromoCode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "code",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.PromoCode"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reasons": {
+            "get": {
+                "description": "ListReasons - Api for list reasons",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reasons"
+                ],
+                "summary": "ListReasons",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name"
+                        ],
+                        "type": "string",
+                        "description": "search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "uz, ru or en",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ListReasons"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "UpdateReasons - Api for update reasons",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reasons"
+                ],
+                "summary": "UpdateReasons",
+                "parameters": [
+                    {
+                        "description": "UpdateReasonsReq",
+                        "name": "UpdateReasonsReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ReasonsReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ReasonsRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "CreateReasons - Api for crete reasons",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reasons"
+                ],
+                "summary": "CreateReasons",
+                "parameters": [
+                    {
+                        "description": "DoctorServiceReq",
+                        "name": "DoctorWorkingHoursReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ReasonsReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ReasonsRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "DeleteReasons - Api for delete reasons",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reasons"
+                ],
+                "summary": "DeleteReasons",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reasons/get": {
+            "get": {
+                "description": "GetReasons - Api for get reasons",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reasons"
+                ],
+                "summary": "GetReasons",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uz, ru or en",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ReasonsRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reasons/restore": {
+            "put": {
+                "description": "RestoreReasons - Api for restore a deleted reason together with the records its delete cascaded to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reasons"
+                ],
+                "summary": "RestoreReasons",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/recommendation": {
+            "get": {
+                "description": "RecommendDoctors - Api for doctors of the specialization of a reason ranked by the earliest free slot, price and rating",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recommendation"
+                ],
+                "summary": "RecommendDoctors",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "reason_id",
+                        "name": "reason_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "free text matched against reasons when reason_id is empty",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "rank by the online price",
+                        "name": "online",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "days searched for a free slot, 14 by default",
+                        "name": "days_ahead",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, 10 by default",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.DoctorRecommendations"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resource": {
+            "get": {
+                "description": "ListResources - API to list resources ordered by type and name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource"
+                ],
+                "summary": "ListResources",
+                "parameters": [
+                    {
+                        "enum": [
+                            "room",
+                            "equipment"
+                        ],
+                        "type": "string",
+                        "description": "kind",
+                        "name": "kind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "resource_type",
+                        "name": "resource_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "branch_id",
+                        "name": "branch_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ListResources"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "UpdateResource - API to update a resource",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource"
+                ],
+                "summary": "UpdateResource",
+                "parameters": [
+                    {
+                        "description": "UpdateResourceReq",
+                        "name": "UpdateResourceReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.UpdateResourceReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.Resource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "CreateResource - Api for create a room or equipment, a resource without branch_id may be used in every branch",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource"
+                ],
+                "summary": "CreateResource",
+                "parameters": [
+                    {
+                        "description": "ResourceReq",
+                        "name": "ResourceReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ResourceReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.Resource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "DeleteResource - API to delete a resource",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource"
+                ],
+                "summary": "DeleteResource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resource/get": {
+            "get": {
+                "description": "GetResource - API to get resource by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource"
+                ],
+                "summary": "GetResource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.Resource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/review": {
+            "get": {
+                "description": "ListReviews - API to list reviews, patients see approved reviews of a doctor with status=approved",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor Review"
+                ],
+                "summary": "ListReviews",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "doctor_id",
+                        "name": "doctor_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "patient_id",
+                        "name": "patient_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "approved",
+                            "rejected"
+                        ],
+                        "type": "string",
+                        "description": "status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.ReviewsType"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "CreateReview - Api for create a review of an attended appointment, the review waits for moderation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor Review"
+                ],
+                "summary": "CreateReview",
+                "parameters": [
+                    {
+                        "description": "CreateReviewReq",
+                        "name": "CreateReviewReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.CreateReviewReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.Review"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "DeleteReview - API to delete a review",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor Review"
+                ],
+                "summary": "DeleteReview",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/review/get": {
+            "get": {
+                "description": "GetReview - API to get review by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor Review"
+                ],
+                "summary": "GetReview",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.Review"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/review/moderate": {
+            "put": {
+                "description": "ModerateReview - API to approve or reject a review, the doctor rating is recalculated from the approved reviews",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctor Review"
+                ],
+                "summary": "ModerateReview",
+                "parameters": [
+                    {
+                        "description": "ModerateReviewReq",
+                        "name": "ModerateReviewReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.ModerateReviewReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_booking_service.Review"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/search": {
+            "get": {
+                "description": "Search - Api for ranked search over doctors, specializations, reasons and departments",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "query",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated hit types: doctor, specialization, reason, department",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.SearchRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/service-discount": {
+            "get": {
+                "description": "ListServiceDiscounts - API to list service discounts, active_on selects the discounts running on the day",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service Discount"
+                ],
+                "summary": "ListServiceDiscounts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "doctor_service_id",
+                        "name": "doctor_service_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-01-15",
+                        "description": "active_on",
+                        "name": "active_on",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ListServiceDiscounts"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "UpdateServiceDiscount - API to update a service discount, the doctor service of a discount is kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service Discount"
+                ],
+                "summary": "UpdateServiceDiscount",
+                "parameters": [
+                    {
+                        "description": "UpdateServiceDiscountReq",
+                        "name": "UpdateServiceDiscountReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.UpdateServiceDiscountReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ServiceDiscount"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "CreateServiceDiscount - Api for create a percent or fixed discount on a doctor service, an empty ends_on has no end",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service Discount"
+                ],
+                "summary": "CreateServiceDiscount",
+                "parameters": [
+                    {
+                        "description": "ServiceDiscountReq",
+                        "name": "ServiceDiscountReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ServiceDiscountReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ServiceDiscount"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "DeleteServiceDiscount - API to delete a service discount",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service Discount"
+                ],
+                "summary": "DeleteServiceDiscount",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/service-discount/get": {
+            "get": {
+                "description": "GetServiceDiscount - API to get service discount by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service Discount"
+                ],
+                "summary": "GetServiceDiscount",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ServiceDiscount"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/service-discount/price": {
+            "get": {
+                "description": "GetServicePrice - API to get the price of a doctor service on a day after the largest discount running on it, today when date is empty",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service Discount"
+                ],
+                "summary": "GetServicePrice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "doctor_service_id",
+                        "name": "doctor_service_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "online, the offline price when empty",
+                        "name": "online",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-01-15",
+                        "description": "date",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ServicePrice"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/service-package": {
+            "get": {
+                "description": "ListServicePackages - API to list service packages, doctor_service_id selects the packages containing the service",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service Package"
+                ],
+                "summary": "ListServicePackages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "doctor_service_id",
+                        "name": "doctor_service_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ListServicePackages"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "UpdateServicePackage - API to update a service package, doctor_service_ids replaces the services of the package",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service Package"
+                ],
+                "summary": "UpdateServicePackage",
+                "parameters": [
+                    {
+                        "description": "UpdateServicePackageReq",
+                        "name": "UpdateServicePackageReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.UpdateServicePackageReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ServicePackage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "CreateServicePackage - Api for create a check-up bundle of at least two doctor services at a package price",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service Package"
+                ],
+                "summary": "CreateServicePackage",
+                "parameters": [
+                    {
+                        "description": "ServicePackageReq",
+                        "name": "ServicePackageReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ServicePackageReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ServicePackage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "DeleteServicePackage - API to delete a service package",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service Package"
+                ],
+                "summary": "DeleteServicePackage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/service-package/get": {
+            "get": {
+                "description": "GetServicePackage - API to get service package by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service Package"
+                ],
+                "summary": "GetServicePackage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ServicePackage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/session": {
+            "get": {
+                "description": "GetUserSessions - Api for get session",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Session"
+                ],
+                "summary": "GetUserSessions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "user_id",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_session_service.ListSessions"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "DeleteSessionById - Api for delete session",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Session"
+                ],
+                "summary": "DeleteSessionById",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/specialization": {
+            "get": {
+                "description": "ListSpecializations - Api for list specialization",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Specialization"
+                ],
+                "summary": "ListSpecializations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "department_id",
+                        "name": "department_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "parent_id, lists the direct sub-specializations",
+                        "name": "parent_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "description"
+                        ],
+                        "type": "string",
+                        "description": "search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "uz, ru or en",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ListSpecializations"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "UpdateSpecialization - Api for update specialization",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Specialization"
+                ],
+                "summary": "UpdateSpecialization",
+                "parameters": [
+                    {
+                        "description": "UpdateSpecializationReq",
+                        "name": "UpdateSpecializationReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.SpecializationReq"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.SpecializationRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "CreateSpecialization - Api for crete specialization",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Specialization"
+                ],
+                "summary": "CreateSpecialization",
+                "parameters": [
+                    {
+                        "description": "SpecializationReq",
+                        "name": "SpecializationReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.SpecializationReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.SpecializationRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "DeleteSpecialization - Api for delete specialization",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Specialization"
+                ],
+                "summary": "DeleteSpecialization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/specialization/get": {
+            "get": {
+                "description": "GetSpecialization - Api for get specialization",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Specialization"
+                ],
+                "summary": "GetSpecialization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uz, ru or en",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.SpecializationRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/specialization/restore": {
+            "put": {
+                "description": "RestoreSpecialization - Api for restore a deleted specialization together with the records its delete cascaded to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Specialization"
+                ],
+                "summary": "RestoreSpecialization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/specialization/tree": {
+            "get": {
+                "description": "GetSpecializationTree - Api for get a specialization with all its sub-specializations, the whole tree when root_id is empty, parents are listed before their children",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Specialization"
+                ],
+                "summary": "GetSpecializationTree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "root_id",
+                        "name": "root_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "uz, ru or en",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ListSpecializations"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/token/get-token": {
+            "get": {
+                "description": "GetTokens",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Token"
+                ],
+                "summary": "GetTokens",
+                "responses": {
+                    "200": {
+                        "description": "Successful response",
+                        "schema": {
+                            "$ref": "#/definitions/model_user_service.Tokens"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/translation": {
+            "get": {
+                "description": "ListTranslations - Api for list translations of an entity",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "ListTranslations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "department, specialization, reason or doctor_service",
+                        "name": "entity_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "entity_id",
+                        "name": "entity_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uz, ru or en, all languages when empty",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.ListTranslations"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "SetTranslation - Api for create or replace a translation of a department, specialization, reason or doctor service field",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "SetTranslation",
+                "parameters": [
+                    {
+                        "description": "TranslationReq",
+                        "name": "TranslationReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.TranslationReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_healthcare_service.TranslationRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "DeleteTranslation - Api for delete a translation, every field of the language when field is empty",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "DeleteTranslation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "department, specialization, reason or doctor_service",
+                        "name": "entity_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "entity_id",
+                        "name": "entity_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uz, ru or en",
+                        "name": "lang",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "field",
+                        "name": "field",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user": {
+            "get": {
+                "description": "Api for ListUsers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "ListUsers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page",
+                        "name": "Page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Limit",
+                        "name": "Limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field",
+                        "name": "Field",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Value",
+                        "name": "Value",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "OrderBy",
+                        "name": "OrderBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_user_service.GetUserResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Api for UpdateUser",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "UpdateUser",
+                "parameters": [
+                    {
+                        "description": "UpdUserReq",
+                        "name": "UpdUserReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_user_service.UpdUserReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_user_service.GetUserResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Api for DeleteUser",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "DeleteUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_user_service.CheckUserFieldResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/get": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Api for GetUserByID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "GetUserByID",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_user_service.GetUserResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/update-refresh-token": {
+            "put": {
+                "description": "Update the refresh token of the user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update Refresh Token",
+                "parameters": [
+                    {
+                        "description": "RefreshToken",
+                        "name": "RefreshToken",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model_user_service.RefreshToken"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful response",
+                        "schema": {
+                            "$ref": "#/definitions/model_user_service.UpdateRefreshTokenUserResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model_common.StandardErrorModel"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "model_booking_service.Appointment": {
+            "type": "object",
+            "properties": {
+                "appointment_date": {
+                    "type": "string"
+                },
+                "appointment_time": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "department_id": {
+                    "type": "string"
+                },
+                "discount_amount": {
+                    "type": "number"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "doctor_service_id": {
+                    "type": "string"
+                },
+                "duration": {
+                    "type": "integer"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "patient_id": {
+                    "type": "string"
+                },
+                "patient_problem": {
+                    "type": "string"
+                },
+                "patient_status": {
+                    "type": "string"
+                },
+                "payment_amount": {
+                    "type": "number"
+                },
+                "payment_type": {
+                    "type": "string"
+                },
+                "promo_code": {
+                    "type": "string"
+                },
+                "resource_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.AppointmentsType": {
+            "type": "object",
+            "properties": {
+                "appointments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_booking_service.Appointment"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model_booking_service.AttendanceReq": {
+            "type": "object",
+            "properties": {
+                "appointment_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "attended"
+                }
+            }
+        },
+        "model_booking_service.BookingRules": {
+            "type": "object",
+            "properties": {
+                "block_expired_license": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "max_active_per_doctor": {
+                    "type": "integer"
+                },
+                "max_active_per_patient": {
+                    "type": "integer"
+                },
+                "max_per_day": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.CreateAppointmentReq": {
+            "type": "object",
+            "properties": {
+                "appointment_date": {
+                    "type": "string"
+                },
+                "appointment_time": {
+                    "type": "string"
+                },
+                "department_id": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "doctor_service_id": {
+                    "type": "string"
+                },
+                "duration": {
+                    "type": "integer"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "patient_id": {
+                    "type": "string"
+                },
+                "patient_problem": {
+                    "type": "string"
+                },
+                "patient_status": {
+                    "type": "string"
+                },
+                "payment_amount": {
+                    "type": "number"
+                },
+                "payment_type": {
+                    "type": "string"
+                },
+                "promo_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.CreateDoctorNotesReq": {
+            "type": "object",
+            "properties": {
+                "appointment_id": {
+                    "type": "integer"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "patient_id": {
+                    "type": "string"
+                },
+                "prescription": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.CreateDoctorTimeReq": {
+            "type": "object",
+            "properties": {
+                "department_id": {
+                    "type": "string"
+                },
+                "doctor_date": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.CreatePatientDocumentReq": {
+            "type": "object",
+            "properties": {
+                "appointment_id": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "doctor_note_id": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "document_type": {
+                    "type": "string",
+                    "enum": [
+                        "lab_result",
+                        "scan",
+                        "prescription",
+                        "other"
+                    ],
+                    "example": "lab_result"
+                },
+                "object_name": {
+                    "type": "string"
+                },
+                "patient_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Blood test"
+                }
+            }
+        },
+        "model_booking_service.CreatePatientReq": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "birth_date": {
+                    "type": "string"
+                },
+                "blood_group": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "patient_problem": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.CreatePromoCodeReq": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "SPRING10"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "percent",
+                        "fixed"
+                    ],
+                    "example": "percent"
+                },
+                "max_uses": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "valid_from": {
+                    "type": "string",
+                    "example": "2024-03-01 00:00:00"
+                },
+                "valid_until": {
+                    "type": "string",
+                    "example": "2024-06-01 00:00:00"
+                },
+                "value": {
+                    "type": "number",
+                    "example": 10
+                }
+            }
+        },
+        "model_booking_service.CreateReviewReq": {
+            "type": "object",
+            "properties": {
+                "appointment_id": {
+                    "type": "integer"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "patient_id": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "model_booking_service.DoctorNote": {
+            "type": "object",
+            "properties": {
+                "appointment_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "patient_id": {
+                    "type": "string"
+                },
+                "prescription": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.DoctorNotesType": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "doctor_notes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_booking_service.DoctorNote"
+                    }
+                }
+            }
+        },
+        "model_booking_service.DoctorPortalNoteReq": {
+            "type": "object",
+            "properties": {
+                "appointment_id": {
+                    "type": "integer"
+                },
+                "prescription": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.DoctorRecommendation": {
+            "type": "object",
+            "properties": {
+                "department_id": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "doctor_service_id": {
+                    "type": "string"
+                },
+                "duration": {
+                    "type": "integer"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "review_count": {
+                    "type": "integer"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "slot_date": {
+                    "type": "string"
+                },
+                "slot_found": {
+                    "type": "boolean"
+                },
+                "slot_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.DoctorRecommendations": {
+            "type": "object",
+            "properties": {
+                "reason_id": {
+                    "type": "string"
+                },
+                "reason_name": {
+                    "type": "string"
+                },
+                "recommendations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_booking_service.DoctorRecommendation"
+                    }
+                },
+                "specialization_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.DoctorTime": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "department_id": {
+                    "type": "string"
+                },
+                "doctor_date": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.DoctorTimesType": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "doctor_times": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_booking_service.DoctorTime"
+                    }
+                }
+            }
+        },
+        "model_booking_service.ModerateReviewReq": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "moderation_note": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "approved"
+                }
+            }
+        },
+        "model_booking_service.Patient": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "birth_date": {
+                    "type": "string"
+                },
+                "blood_group": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "patient_problem": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.PatientDocument": {
+            "type": "object",
+            "properties": {
+                "appointment_id": {
+                    "type": "integer"
+                },
+                "content_type": {
+                    "type": "string"
+                },
+                "doctor_note_id": {
+                    "type": "integer"
+                },
+                "document_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "object_name": {
+                    "type": "string"
+                },
+                "patient_id": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "uploaded_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.PatientDocumentsType": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "patient_documents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_booking_service.PatientDocument"
+                    }
+                }
+            }
+        },
+        "model_booking_service.PatientNotification": {
+            "type": "object",
+            "properties": {
+                "appointment_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "patient_id": {
+                    "type": "string"
+                },
+                "read_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.PatientNotifications": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "notifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_booking_service.PatientNotification"
+                    }
+                }
+            }
+        },
+        "model_booking_service.PatientsType": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "patients": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_booking_service.Patient"
+                    }
+                }
+            }
+        },
+        "model_booking_service.PromoCode": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "max_uses": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "used_count": {
+                    "type": "integer"
+                },
+                "valid_from": {
+                    "type": "string"
+                },
+                "valid_until": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "model_booking_service.PromoCodesType": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "promo_codes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_booking_service.PromoCode"
+                    }
+                }
+            }
+        },
+        "model_booking_service.RescheduleProposal": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "boolean"
+                },
+                "appointment_date": {
+                    "type": "string"
+                },
+                "appointment_id": {
+                    "type": "integer"
+                },
+                "appointment_time": {
+                    "type": "string"
+                },
+                "department_id": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "doctor_service_id": {
+                    "type": "string"
+                },
+                "duration": {
+                    "type": "integer"
+                },
+                "found": {
+                    "type": "boolean"
+                },
+                "new_appointment_date": {
+                    "type": "string"
+                },
+                "new_appointment_time": {
+                    "type": "string"
+                },
+                "new_doctor_id": {
+                    "type": "string"
+                },
+                "new_doctor_service_id": {
+                    "type": "string"
+                },
+                "patient_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.RescheduleProposals": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "proposals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_booking_service.RescheduleProposal"
+                    }
+                }
+            }
+        },
+        "model_booking_service.RescheduleReq": {
+            "type": "object",
+            "properties": {
+                "appointment_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "days_ahead": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2024-05-03"
+                },
+                "end_time": {
+                    "type": "string",
+                    "example": "18:00:00"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2024-05-01"
+                },
+                "start_time": {
+                    "type": "string",
+                    "example": "09:00:00"
+                },
+                "strategy": {
+                    "type": "string",
+                    "example": "other_doctor"
+                }
+            }
+        },
+        "model_booking_service.Review": {
+            "type": "object",
+            "properties": {
+                "appointment_id": {
+                    "type": "integer"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "moderated_at": {
+                    "type": "string"
+                },
+                "moderation_note": {
+                    "type": "string"
+                },
+                "patient_id": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.ReviewsType": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "reviews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_booking_service.Review"
+                    }
+                }
+            }
+        },
+        "model_booking_service.UpdateAppointmentReq": {
+            "type": "object",
+            "properties": {
+                "appointment_date": {
+                    "type": "string"
+                },
                 "appointment_time": {
                     "type": "string"
                 },
+                "booked_appointment_id": {
+                    "type": "string"
+                },
+                "doctor_service_id": {
+                    "type": "string"
+                },
+                "duration": {
+                    "type": "integer"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "patient_problem": {
+                    "type": "string"
+                },
+                "patient_status": {
+                    "type": "string"
+                },
+                "payment_amount": {
+                    "type": "number"
+                },
+                "payment_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.UpdateBookingRulesReq": {
+            "type": "object",
+            "properties": {
+                "block_expired_license": {
+                    "type": "boolean"
+                },
+                "max_active_per_doctor": {
+                    "type": "integer"
+                },
+                "max_active_per_patient": {
+                    "type": "integer"
+                },
+                "max_per_day": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model_booking_service.UpdateDoctorNoteReq": {
+            "type": "object",
+            "properties": {
+                "appointment_id": {
+                    "type": "integer"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "doctor_notes_id": {
+                    "type": "string"
+                },
+                "patient_id": {
+                    "type": "string"
+                },
+                "prescription": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.UpdateDoctorTimeReq": {
+            "type": "object",
+            "properties": {
+                "department_id": {
+                    "type": "string"
+                },
+                "doctor_date": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "doctor_time_id": {
+                    "type": "string"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.UpdatePatientReq": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "birth_date": {
+                    "type": "string"
+                },
+                "blood_group": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "patient_id": {
+                    "type": "string"
+                },
+                "patient_problem": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_booking_service.UpdatePromoCodeReq": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "percent",
+                        "fixed"
+                    ],
+                    "example": "percent"
+                },
+                "max_uses": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "valid_from": {
+                    "type": "string",
+                    "example": "2024-03-01 00:00:00"
+                },
+                "valid_until": {
+                    "type": "string",
+                    "example": "2024-06-01 00:00:00"
+                },
+                "value": {
+                    "type": "number",
+                    "example": 10
+                }
+            }
+        },
+        "model_common.ResponseError": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_common.StandardErrorModel": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/model_common.ResponseError"
+                }
+            }
+        },
+        "model_healthcare_service.AwayDoctor": {
+            "type": "object",
+            "properties": {
+                "department_id": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "leave_id": {
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_healthcare_service.Branch": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "closes_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opens_at": {
+                    "type": "string"
+                },
+                "phone_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_healthcare_service.BranchReq": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "Bunyodkor avenue, 1"
+                },
+                "city": {
+                    "type": "string",
+                    "example": "Tashkent"
+                },
+                "closes_at": {
+                    "type": "string",
+                    "example": "20:00"
+                },
+                "latitude": {
+                    "type": "number",
+                    "example": 41.2995
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": 69.2401
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chilonzor branch"
+                },
+                "opens_at": {
+                    "type": "string",
+                    "example": "08:00"
+                },
+                "phone_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model_healthcare_service.CatalogueCacheStats": {
+            "type": "object",
+            "properties": {
+                "hits": {
+                    "type": "integer"
+                },
+                "misses": {
+                    "type": "integer"
+                },
+                "resource": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_healthcare_service.CreateDoctorCredentialReq": {
+            "type": "object",
+            "properties": {
+                "doctor_id": {
+                    "type": "string"
+                },
+                "document_object_name": {
+                    "type": "string"
+                },
+                "expiry_date": {
+                    "type": "string",
+                    "example": "2025-09-01"
+                },
+                "issue_date": {
+                    "type": "string",
+                    "example": "2020-09-01"
+                },
+                "issuing_body": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "license"
+                }
+            }
+        },
+        "model_healthcare_service.CreateDoctorLeaveReq": {
+            "type": "object",
+            "properties": {
+                "doctor_id": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2024-07-10"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "vacation"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2024-07-01"
+                }
+            }
+        },
+        "model_healthcare_service.DepartmentReq": {
+            "type": "object",
+            "properties": {
+                "branch_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614274002"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "description"
+                },
+                "floor_number": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614274001"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "http://example.com/image.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "name"
+                },
+                "short_description": {
+                    "type": "string",
+                    "example": "short_description"
+                }
+            }
+        },
+        "model_healthcare_service.DepartmentRes": {
+            "type": "object",
+            "properties": {
+                "branch_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "floor_number": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "order": {
+                    "type": "integer"
+                },
+                "short_description": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_healthcare_service.DoctorAndDoctorHours": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "birth_date": {
+                    "type": "string"
+                },
+                "branch_id": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "day_of_week": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "department_id": {
+                    "type": "string"
+                },
+                "department_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "email": {
+                    "type": "string"
+                },
+                "end_work_date": {
+                    "type": "string"
+                },
+                "finish_time": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "order": {
+                    "type": "integer"
+                },
+                "patient_count": {
+                    "type": "integer"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "review_count": {
+                    "type": "integer"
+                },
+                "room_number": {
+                    "type": "integer"
+                },
+                "salary": {
+                    "type": "number"
+                },
+                "specializations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.DoctorSpec"
+                    }
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "start_work_date": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "work_years": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model_healthcare_service.DoctorCredential": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "document_object_name": {
+                    "type": "string"
+                },
+                "expiry_date": {
+                    "type": "string"
+                },
+                "expiry_flagged_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "issue_date": {
+                    "type": "string"
+                },
+                "issuing_body": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_healthcare_service.DoctorLeave": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "department_id": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "review_note": {
+                    "type": "string"
+                },
+                "reviewed_at": {
+                    "type": "string"
+                },
+                "reviewed_by": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_healthcare_service.DoctorLicenseStatus": {
+            "type": "object",
+            "properties": {
+                "doctor_id": {
+                    "type": "string"
+                },
+                "expired": {
+                    "type": "boolean"
+                },
+                "expiry_date": {
+                    "type": "string"
+                },
+                "has_license": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model_healthcare_service.DoctorLoginReq": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "doctor@dennic.uz"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "password"
+                }
+            }
+        },
+        "model_healthcare_service.DoctorLoginRes": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_healthcare_service.DoctorReq": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "Addres"
+                },
+                "bio": {
+                    "type": "string",
+                    "example": "Biography"
+                },
+                "birth_date": {
+                    "type": "string",
+                    "example": "2012-12-12"
+                },
+                "branch_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614274002"
+                },
+                "city": {
+                    "type": "string",
+                    "example": "City"
+                },
+                "country": {
+                    "type": "string",
+                    "example": "Country"
+                },
+                "department_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174001"
+                },
+                "department_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "123e4567-e89b-12d3-a456-426614174002"
+                    ]
+                },
+                "email": {
+                    "type": "string",
+                    "example": "email@gmail.com"
+                },
+                "first_name": {
+                    "type": "string",
+                    "example": "First Name"
+                },
+                "gender": {
+                    "type": "string",
+                    "example": "male"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "http://example.com/image.png"
+                },
+                "last_name": {
+                    "type": "string",
+                    "example": "Last Name"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "password"
+                },
+                "phone_number": {
+                    "type": "string",
+                    "example": "+998901234567"
+                },
+                "room_number": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "salary": {
+                    "type": "number",
+                    "example": 10
+                },
+                "start_work_date": {
+                    "type": "string",
+                    "example": "2012-12-12"
+                },
+                "work_years": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "model_healthcare_service.DoctorRes": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "birth_date": {
+                    "type": "string"
+                },
+                "branch_id": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
                 "department_id": {
                     "type": "string"
                 },
+                "department_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "email": {
+                    "type": "string"
+                },
+                "end_work_date": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "order": {
+                    "type": "integer"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "room_number": {
+                    "type": "integer"
+                },
+                "salary": {
+                    "type": "number"
+                },
+                "start_work_date": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "work_years": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model_healthcare_service.DoctorServicesReq": {
+            "type": "object",
+            "properties": {
                 "doctor_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614274001"
+                },
+                "duration": {
+                    "type": "string",
+                    "example": "12:12"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614274001"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "name"
+                },
+                "offline_price": {
+                    "type": "number",
+                    "example": 1.1
+                },
+                "online_price": {
+                    "type": "number",
+                    "example": 1.1
+                },
+                "required_resource_type": {
+                    "description": "RequiredResourceType is reserved for every appointment of the service, empty when none is needed",
+                    "type": "string",
+                    "example": "ultrasound"
+                },
+                "specialization_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614375001"
+                }
+            }
+        },
+        "model_healthcare_service.DoctorServicesRes": {
+            "type": "object",
+            "properties": {
+                "created_at": {
                     "type": "string"
                 },
-                "doctor_service_id": {
+                "doctor_id": {
                     "type": "string"
                 },
                 "duration": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "offline_price": {
+                    "type": "number"
+                },
+                "online_price": {
+                    "type": "number"
+                },
+                "order": {
                     "type": "integer"
                 },
-                "expires_at": {
+                "required_resource_type": {
                     "type": "string"
                 },
-                "key": {
+                "specialization_id": {
                     "type": "string"
                 },
-                "patient_id": {
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_healthcare_service.DoctorSpec": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "string"
                 },
-                "patient_problem": {
+                "name": {
                     "type": "string"
+                }
+            }
+        },
+        "model_healthcare_service.DoctorUpdateReq": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "Addres"
+                },
+                "bio": {
+                    "type": "string",
+                    "example": "Biography"
+                },
+                "birth_date": {
+                    "type": "string",
+                    "example": "2012-12-12"
+                },
+                "branch_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614274002"
+                },
+                "city": {
+                    "type": "string",
+                    "example": "City"
+                },
+                "country": {
+                    "type": "string",
+                    "example": "Country"
+                },
+                "department_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174001"
+                },
+                "department_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "123e4567-e89b-12d3-a456-426614174002"
+                    ]
+                },
+                "email": {
+                    "type": "string",
+                    "example": "email@gmail.com"
+                },
+                "end-work-date": {
+                    "type": "string",
+                    "example": "2022-12-12"
+                },
+                "first_name": {
+                    "type": "string",
+                    "example": "First Name"
+                },
+                "gender": {
+                    "type": "string",
+                    "example": "male"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614274001"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "http://example.com/image.png"
+                },
+                "last_name": {
+                    "type": "string",
+                    "example": "Last Name"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "password"
+                },
+                "phone_number": {
+                    "type": "string",
+                    "example": "+998901234567"
+                },
+                "room_number": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "patient_status": {
-                    "type": "string"
+                "salary": {
+                    "type": "number",
+                    "example": 10
                 },
-                "payment_amount": {
-                    "type": "number"
+                "start_work_date": {
+                    "type": "string",
+                    "example": "2012-12-12"
                 },
-                "payment_type": {
-                    "type": "string"
+                "work_years": {
+                    "type": "integer",
+                    "example": 4
                 }
             }
         },
-        "model_booking_service.CreateDoctorNotesReq": {
+        "model_healthcare_service.DoctorWorkingHoursReq": {
             "type": "object",
             "properties": {
-                "appointment_id": {
-                    "type": "integer"
+                "day_of_week": {
+                    "type": "string",
+                    "example": "Monday"
                 },
                 "doctor_id": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614274001"
                 },
-                "patient_id": {
-                    "type": "string"
+                "effective_from": {
+                    "type": "string",
+                    "example": "2024-06-01"
                 },
-                "prescription": {
-                    "type": "string"
-                }
-            }
-        },
-        "model_booking_service.CreateDoctorTimeReq": {
-            "type": "object",
-            "properties": {
-                "department_id": {
-                    "type": "string"
+                "effective_to": {
+                    "type": "string",
+                    "example": ""
                 },
-                "doctor_date": {
-                    "type": "string"
+                "finish_time": {
+                    "type": "string",
+                    "example": "13:00:00"
                 },
-                "doctor_id": {
-                    "type": "string"
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614274001"
                 },
-                "end_time": {
-                    "type": "string"
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "shift",
+                        "break"
+                    ],
+                    "example": "shift"
                 },
                 "start_time": {
-                    "type": "string"
-                },
-                "status": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "09:00:00"
                 }
             }
         },
-        "model_booking_service.CreatePatientReq": {
+        "model_healthcare_service.DoctorWorkingHoursRes": {
             "type": "object",
             "properties": {
-                "address": {
+                "created_at": {
                     "type": "string"
                 },
-                "birth_date": {
+                "day_of_week": {
                     "type": "string"
                 },
-                "blood_group": {
+                "doctor_id": {
                     "type": "string"
                 },
-                "city": {
+                "effective_from": {
                     "type": "string"
                 },
-                "country": {
+                "effective_to": {
                     "type": "string"
                 },
-                "first_name": {
+                "finish_time": {
                     "type": "string"
                 },
-                "gender": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 },
-                "last_name": {
+                "kind": {
                     "type": "string"
                 },
-                "patient_problem": {
+                "start_time": {
                     "type": "string"
                 },
-                "phone_number": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "model_booking_service.DoctorNote": {
+        "model_healthcare_service.ListAwayDoctors": {
             "type": "object",
             "properties": {
-                "appointment_id": {
-                    "type": "integer"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "doctor_id": {
-                    "type": "string"
+                "doctors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.AwayDoctor"
+                    }
+                }
+            }
+        },
+        "model_healthcare_service.ListBranches": {
+            "type": "object",
+            "properties": {
+                "branches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.Branch"
+                    }
                 },
-                "id": {
+                "count": {
                     "type": "integer"
-                },
-                "patient_id": {
-                    "type": "string"
-                },
-                "prescription": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
                 }
             }
         },
-        "model_booking_service.DoctorNotesType": {
+        "model_healthcare_service.ListCatalogueCacheStats": {
+            "type": "object",
+            "properties": {
+                "stats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.CatalogueCacheStats"
+                    }
+                }
+            }
+        },
+        "model_healthcare_service.ListDepartments": {
             "type": "object",
             "properties": {
                 "count": {
                     "type": "integer"
                 },
-                "doctor_notes": {
+                "departments": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model_booking_service.DoctorNote"
+                        "$ref": "#/definitions/model_healthcare_service.DepartmentRes"
                     }
                 }
             }
         },
-        "model_booking_service.DoctorTime": {
+        "model_healthcare_service.ListDoctorCredentials": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "department_id": {
-                    "type": "string"
-                },
-                "doctor_date": {
-                    "type": "string"
-                },
-                "doctor_id": {
-                    "type": "string"
-                },
-                "end_time": {
-                    "type": "string"
-                },
-                "id": {
+                "count": {
                     "type": "integer"
                 },
-                "start_time": {
-                    "type": "string"
-                },
-                "status": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
+                "credentials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.DoctorCredential"
+                    }
                 }
             }
         },
-        "model_booking_service.DoctorTimesType": {
+        "model_healthcare_service.ListDoctorLeaves": {
             "type": "object",
             "properties": {
                 "count": {
                     "type": "integer"
                 },
-                "doctor_times": {
+                "leaves": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model_booking_service.DoctorTime"
+                        "$ref": "#/definitions/model_healthcare_service.DoctorLeave"
                     }
                 }
             }
         },
-        "model_booking_service.Patient": {
+        "model_healthcare_service.ListDoctorServices": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "birth_date": {
-                    "type": "string"
-                },
-                "blood_group": {
-                    "type": "string"
-                },
-                "city": {
-                    "type": "string"
-                },
-                "country": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "first_name": {
-                    "type": "string"
-                },
-                "gender": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "last_name": {
-                    "type": "string"
-                },
-                "patient_problem": {
-                    "type": "string"
-                },
-                "phone_number": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 },
-                "updated_at": {
-                    "type": "string"
+                "doctor_services": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.DoctorServicesRes"
+                    }
                 }
             }
         },
-        "model_booking_service.PatientsType": {
+        "model_healthcare_service.ListDoctorWorkingHours": {
             "type": "object",
             "properties": {
                 "count": {
                     "type": "integer"
                 },
-                "patients": {
+                "doctor_working_hours": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model_booking_service.Patient"
+                        "$ref": "#/definitions/model_healthcare_service.DoctorWorkingHoursRes"
                     }
                 }
             }
         },
-        "model_booking_service.UpdateAppointmentReq": {
+        "model_healthcare_service.ListDoctors": {
             "type": "object",
             "properties": {
-                "appointment_date": {
-                    "type": "string"
-                },
-                "appointment_time": {
-                    "type": "string"
-                },
-                "booked_appointment_id": {
-                    "type": "string"
-                },
-                "doctor_service_id": {
-                    "type": "string"
-                },
-                "duration": {
+                "count": {
                     "type": "integer"
                 },
-                "expires_at": {
-                    "type": "string"
-                },
-                "key": {
-                    "type": "string"
-                },
-                "patient_problem": {
-                    "type": "string"
-                },
-                "patient_status": {
-                    "type": "string"
-                },
-                "payment_amount": {
-                    "type": "number"
-                },
-                "payment_type": {
-                    "type": "string"
+                "doctors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.DoctorRes"
+                    }
                 }
             }
         },
-        "model_booking_service.UpdateDoctorNoteReq": {
+        "model_healthcare_service.ListNearbyBranches": {
+            "type": "object",
+            "properties": {
+                "branches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.NearbyBranch"
+                    }
+                }
+            }
+        },
+        "model_healthcare_service.ListReasons": {
             "type": "object",
             "properties": {
-                "appointment_id": {
+                "count": {
                     "type": "integer"
                 },
-                "doctor_id": {
-                    "type": "string"
-                },
-                "doctor_notes_id": {
-                    "type": "string"
-                },
-                "patient_id": {
-                    "type": "string"
-                },
-                "prescription": {
-                    "type": "string"
+                "reasons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.ReasonsRes"
+                    }
                 }
             }
         },
-        "model_booking_service.UpdateDoctorTimeReq": {
+        "model_healthcare_service.ListResources": {
             "type": "object",
             "properties": {
-                "department_id": {
-                    "type": "string"
-                },
-                "doctor_date": {
-                    "type": "string"
-                },
-                "doctor_id": {
-                    "type": "string"
-                },
-                "doctor_time_id": {
-                    "type": "string"
-                },
-                "end_time": {
-                    "type": "string"
-                },
-                "start_time": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 },
-                "status": {
-                    "type": "string"
+                "resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.Resource"
+                    }
                 }
             }
         },
-        "model_booking_service.UpdatePatientReq": {
+        "model_healthcare_service.ListServiceDiscounts": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "birth_date": {
-                    "type": "string"
-                },
-                "blood_group": {
-                    "type": "string"
-                },
-                "city": {
-                    "type": "string"
-                },
-                "country": {
-                    "type": "string"
-                },
-                "first_name": {
-                    "type": "string"
-                },
-                "gender": {
-                    "type": "string"
-                },
-                "last_name": {
-                    "type": "string"
-                },
-                "patient_id": {
-                    "type": "string"
-                },
-                "patient_problem": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 },
-                "phone_number": {
-                    "type": "string"
+                "service_discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.ServiceDiscount"
+                    }
                 }
             }
         },
-        "model_common.ResponseError": {
+        "model_healthcare_service.ListServicePackages": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 },
-                "status": {
-                    "type": "string"
+                "service_packages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.ServicePackage"
+                    }
                 }
             }
         },
-        "model_common.StandardErrorModel": {
+        "model_healthcare_service.ListSpecializations": {
             "type": "object",
             "properties": {
-                "error": {
-                    "$ref": "#/definitions/model_common.ResponseError"
+                "count": {
+                    "type": "integer"
+                },
+                "specializations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.SpecializationRes"
+                    }
                 }
             }
         },
-        "model_healthcare_service.DepartmentReq": {
+        "model_healthcare_service.ListTranslations": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string",
-                    "example": "description"
-                },
-                "floor_number": {
-                    "type": "integer",
-                    "example": 2
-                },
-                "id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614274001"
-                },
-                "image_url": {
-                    "type": "string",
-                    "example": "http://example.com/image.png"
-                },
-                "name": {
-                    "type": "string",
-                    "example": "name"
-                },
-                "short_description": {
-                    "type": "string",
-                    "example": "short_description"
+                "translations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.TranslationRes"
+                    }
                 }
             }
         },
-        "model_healthcare_service.DepartmentRes": {
+        "model_healthcare_service.NearbyBranch": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "floor_number": {
-                    "type": "integer"
-                },
-                "id": {
-                    "type": "string"
+                "branch": {
+                    "$ref": "#/definitions/model_healthcare_service.Branch"
                 },
-                "image_url": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "order": {
-                    "type": "integer"
-                },
-                "short_description": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
+                "distance_km": {
+                    "type": "number"
                 }
             }
         },
-        "model_healthcare_service.DoctorAndDoctorHours": {
+        "model_healthcare_service.PriceChange": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "bio": {
-                    "type": "string"
-                },
-                "birth_date": {
-                    "type": "string"
-                },
-                "city": {
-                    "type": "string"
-                },
-                "country": {
-                    "type": "string"
-                },
                 "created_at": {
                     "type": "string"
                 },
-                "day_of_week": {
-                    "type": "string"
-                },
-                "deleted_at": {
-                    "type": "string"
-                },
-                "department_id": {
-                    "type": "string"
-                },
-                "email": {
-                    "type": "string"
-                },
-                "end_work_date": {
-                    "type": "string"
-                },
-                "finish_time": {
-                    "type": "string"
-                },
-                "first_name": {
+                "doctor_service_id": {
                     "type": "string"
                 },
-                "gender": {
+                "effective_from": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "image_url": {
-                    "type": "string"
-                },
-                "last_name": {
-                    "type": "string"
-                },
-                "order": {
-                    "type": "integer"
-                },
-                "patient_count": {
-                    "type": "integer"
-                },
-                "phone_number": {
-                    "type": "string"
-                },
-                "room_number": {
-                    "type": "integer"
-                },
-                "salary": {
+                "offline_price": {
                     "type": "number"
                 },
-                "specializations": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model_healthcare_service.DoctorSpec"
-                    }
-                },
-                "start_time": {
-                    "type": "string"
-                },
-                "start_work_date": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
-                },
-                "work_years": {
-                    "type": "integer"
+                "online_price": {
+                    "type": "number"
                 }
             }
         },
-        "model_healthcare_service.DoctorReq": {
+        "model_healthcare_service.PriceChangeReq": {
             "type": "object",
-            "properties": {
-                "address": {
-                    "type": "string",
-                    "example": "Addres"
-                },
-                "bio": {
-                    "type": "string",
-                    "example": "Biography"
-                },
-                "birth_date": {
-                    "type": "string",
-                    "example": "2012-12-12"
-                },
-                "city": {
-                    "type": "string",
-                    "example": "City"
-                },
-                "country": {
-                    "type": "string",
-                    "example": "Country"
+            "properties": {
+                "doctor_service_id": {
+                    "type": "string"
                 },
-                "department_id": {
+                "effective_from": {
                     "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174001"
+                    "example": "2024-01-01 00:00:00"
                 },
-                "email": {
-                    "type": "string",
-                    "example": "email@gmail.com"
+                "offline_price": {
+                    "type": "number",
+                    "example": 150
                 },
-                "first_name": {
-                    "type": "string",
-                    "example": "First Name"
+                "online_price": {
+                    "type": "number",
+                    "example": 120
+                }
+            }
+        },
+        "model_healthcare_service.PriceHistory": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
                 },
-                "gender": {
+                "price_changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.PriceChange"
+                    }
+                }
+            }
+        },
+        "model_healthcare_service.ReasonsReq": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "string",
-                    "example": "male"
+                    "example": "123e4567-e89b-12d3-a456-426614375001"
                 },
                 "image_url": {
                     "type": "string",
                     "example": "http://example.com/image.png"
                 },
-                "last_name": {
+                "name": {
                     "type": "string",
-                    "example": "Last Name"
+                    "example": "name"
                 },
-                "password": {
+                "specialization_id": {
                     "type": "string",
-                    "example": "password"
+                    "example": "123e4567-e89b-12d3-a456-426614375001"
+                }
+            }
+        },
+        "model_healthcare_service.ReasonsRes": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
                 },
-                "phone_number": {
-                    "type": "string",
-                    "example": "+998901234567"
+                "id": {
+                    "type": "string"
                 },
-                "room_number": {
-                    "type": "integer",
-                    "example": 1
+                "image_url": {
+                    "type": "string"
                 },
-                "salary": {
-                    "type": "number",
-                    "example": 10
+                "name": {
+                    "type": "string"
                 },
-                "start_work_date": {
-                    "type": "string",
-                    "example": "2012-12-12"
+                "specialization_id": {
+                    "type": "string"
                 },
-                "work_years": {
-                    "type": "integer",
-                    "example": 4
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "model_healthcare_service.DoctorRes": {
+        "model_healthcare_service.Resource": {
             "type": "object",
             "properties": {
-                "address": {
+                "branch_id": {
                     "type": "string"
                 },
-                "bio": {
+                "created_at": {
                     "type": "string"
                 },
-                "birth_date": {
+                "id": {
                     "type": "string"
                 },
-                "city": {
+                "kind": {
                     "type": "string"
                 },
-                "country": {
+                "name": {
                     "type": "string"
                 },
-                "created_at": {
+                "resource_type": {
                     "type": "string"
                 },
-                "deleted_at": {
+                "updated_at": {
                     "type": "string"
-                },
-                "department_id": {
+                }
+            }
+        },
+        "model_healthcare_service.ResourceReq": {
+            "type": "object",
+            "properties": {
+                "branch_id": {
                     "type": "string"
                 },
-                "email": {
-                    "type": "string"
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "room",
+                        "equipment"
+                    ],
+                    "example": "room"
                 },
-                "end_work_date": {
-                    "type": "string"
+                "name": {
+                    "type": "string",
+                    "example": "Ultrasound room 1"
                 },
-                "first_name": {
+                "resource_type": {
+                    "type": "string",
+                    "example": "ultrasound"
+                }
+            }
+        },
+        "model_healthcare_service.ReviewDoctorLeaveReq": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "review_note": {
                     "type": "string"
                 },
-                "gender": {
+                "status": {
+                    "type": "string",
+                    "example": "approved"
+                }
+            }
+        },
+        "model_healthcare_service.SearchHit": {
+            "type": "object",
+            "properties": {
+                "description": {
                     "type": "string"
                 },
                 "id": {
@@ -4083,429 +10097,495 @@ const docTemplate = `{
                 "image_url": {
                     "type": "string"
                 },
-                "last_name": {
+                "parent_id": {
                     "type": "string"
                 },
-                "order": {
-                    "type": "integer"
+                "score": {
+                    "type": "number"
                 },
-                "phone_number": {
+                "title": {
                     "type": "string"
                 },
-                "room_number": {
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_healthcare_service.SearchRes": {
+            "type": "object",
+            "properties": {
+                "count": {
                     "type": "integer"
                 },
-                "salary": {
-                    "type": "number"
+                "hits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_healthcare_service.SearchHit"
+                    }
+                }
+            }
+        },
+        "model_healthcare_service.ServiceDiscount": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
                 },
-                "start_work_date": {
+                "doctor_service_id": {
+                    "type": "string"
+                },
+                "ends_on": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "starts_on": {
                     "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
                 },
-                "work_years": {
-                    "type": "integer"
+                "value": {
+                    "type": "number"
                 }
             }
         },
-        "model_healthcare_service.DoctorServicesReq": {
+        "model_healthcare_service.ServiceDiscountReq": {
             "type": "object",
             "properties": {
-                "doctor_id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614274001"
+                "doctor_service_id": {
+                    "type": "string"
                 },
-                "duration": {
+                "ends_on": {
                     "type": "string",
-                    "example": "12:12"
+                    "example": "2024-01-31"
                 },
-                "id": {
+                "kind": {
                     "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614274001"
+                    "enum": [
+                        "percent",
+                        "fixed"
+                    ],
+                    "example": "percent"
                 },
-                "name": {
+                "starts_on": {
                     "type": "string",
-                    "example": "name"
-                },
-                "offline_price": {
-                    "type": "number",
-                    "example": 1.1
+                    "example": "2024-01-01"
                 },
-                "online_price": {
+                "value": {
                     "type": "number",
-                    "example": 1.1
-                },
-                "specialization_id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614375001"
+                    "example": 10
                 }
             }
         },
-        "model_healthcare_service.DoctorServicesRes": {
+        "model_healthcare_service.ServicePackage": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "doctor_id": {
+                "description": {
                     "type": "string"
                 },
-                "duration": {
-                    "type": "string"
+                "doctor_service_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "id": {
                     "type": "string"
                 },
+                "items_price": {
+                    "type": "number"
+                },
                 "name": {
                     "type": "string"
                 },
-                "offline_price": {
-                    "type": "number"
-                },
-                "online_price": {
+                "price": {
                     "type": "number"
                 },
-                "order": {
-                    "type": "integer"
-                },
-                "specialization_id": {
-                    "type": "string"
-                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "model_healthcare_service.DoctorSpec": {
+        "model_healthcare_service.ServicePackageReq": {
             "type": "object",
             "properties": {
-                "id": {
+                "description": {
                     "type": "string"
                 },
+                "doctor_service_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Annual check-up"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 500000
                 }
             }
         },
-        "model_healthcare_service.DoctorUpdateReq": {
+        "model_healthcare_service.ServicePrice": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string",
-                    "example": "Addres"
-                },
-                "bio": {
-                    "type": "string",
-                    "example": "Biography"
-                },
-                "birth_date": {
-                    "type": "string",
-                    "example": "2012-12-12"
-                },
-                "city": {
-                    "type": "string",
-                    "example": "City"
-                },
-                "country": {
-                    "type": "string",
-                    "example": "Country"
+                "base_price": {
+                    "type": "number"
                 },
-                "department_id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174001"
+                "discount": {
+                    "type": "number"
                 },
-                "email": {
-                    "type": "string",
-                    "example": "email@gmail.com"
+                "discount_id": {
+                    "type": "string"
                 },
-                "end-work-date": {
-                    "type": "string",
-                    "example": "2022-12-12"
+                "doctor_service_id": {
+                    "type": "string"
                 },
-                "first_name": {
+                "price": {
+                    "type": "number"
+                }
+            }
+        },
+        "model_healthcare_service.SpecializationReq": {
+            "type": "object",
+            "properties": {
+                "department_id": {
                     "type": "string",
-                    "example": "First Name"
+                    "example": "123e4567-e89b-12d3-a456-426614174003"
                 },
-                "gender": {
+                "description": {
                     "type": "string",
-                    "example": "male"
+                    "example": "Specialization description"
                 },
                 "id": {
                     "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614274001"
+                    "example": "123e4567-e89b-12d3-a456-426614174003"
                 },
                 "image_url": {
                     "type": "string",
                     "example": "http://example.com/image.png"
                 },
-                "last_name": {
+                "name": {
                     "type": "string",
-                    "example": "Last Name"
+                    "example": "Specialization"
                 },
-                "password": {
+                "parent_id": {
                     "type": "string",
-                    "example": "password"
+                    "example": "123e4567-e89b-12d3-a456-426614174004"
+                }
+            }
+        },
+        "model_healthcare_service.SpecializationRes": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
                 },
-                "phone_number": {
-                    "type": "string",
-                    "example": "+998901234567"
+                "department_id": {
+                    "type": "string"
                 },
-                "room_number": {
-                    "type": "integer",
-                    "example": 1
+                "description": {
+                    "type": "string"
                 },
-                "salary": {
-                    "type": "number",
-                    "example": 10
+                "id": {
+                    "type": "string"
                 },
-                "start_work_date": {
-                    "type": "string",
-                    "example": "2012-12-12"
+                "image_url": {
+                    "type": "string"
                 },
-                "work_years": {
-                    "type": "integer",
-                    "example": 4
+                "name": {
+                    "type": "string"
+                },
+                "order": {
+                    "type": "integer"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "model_healthcare_service.DoctorWorkingHoursReq": {
+        "model_healthcare_service.TranslationReq": {
             "type": "object",
             "properties": {
-                "day_of_week": {
-                    "type": "string",
-                    "example": "Monday"
+                "entity_id": {
+                    "type": "string"
                 },
-                "doctor_id": {
+                "entity_type": {
                     "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614274001"
+                    "example": "department"
                 },
-                "finish_time": {
+                "field": {
                     "type": "string",
-                    "example": "12:00:00"
+                    "example": "name"
                 },
-                "id": {
+                "lang": {
                     "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614274001"
+                    "example": "ru"
                 },
-                "start_time": {
-                    "type": "string",
-                    "example": "12:00:00"
+                "value": {
+                    "type": "string"
                 }
             }
         },
-        "model_healthcare_service.DoctorWorkingHoursRes": {
+        "model_healthcare_service.TranslationRes": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "day_of_week": {
+                "entity_id": {
                     "type": "string"
                 },
-                "doctor_id": {
+                "entity_type": {
                     "type": "string"
                 },
-                "finish_time": {
+                "field": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
-                },
-                "start_time": {
+                "lang": {
                     "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
-                }
-            }
-        },
-        "model_healthcare_service.ListDepartments": {
-            "type": "object",
-            "properties": {
-                "count": {
-                    "type": "integer"
                 },
-                "departments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model_healthcare_service.DepartmentRes"
-                    }
+                "value": {
+                    "type": "string"
                 }
             }
         },
-        "model_healthcare_service.ListDoctorServices": {
+        "model_healthcare_service.UpdateBranchReq": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "address": {
+                    "type": "string",
+                    "example": "Bunyodkor avenue, 1"
                 },
-                "doctor_services": {
+                "city": {
+                    "type": "string",
+                    "example": "Tashkent"
+                },
+                "closes_at": {
+                    "type": "string",
+                    "example": "20:00"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number",
+                    "example": 41.2995
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": 69.2401
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chilonzor branch"
+                },
+                "opens_at": {
+                    "type": "string",
+                    "example": "08:00"
+                },
+                "phone_numbers": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model_healthcare_service.DoctorServicesRes"
+                        "type": "string"
                     }
                 }
             }
         },
-        "model_healthcare_service.ListDoctorWorkingHours": {
+        "model_healthcare_service.UpdateDoctorCredentialReq": {
             "type": "object",
             "properties": {
-                "count": {
+                "document_object_name": {
+                    "type": "string"
+                },
+                "expiry_date": {
+                    "type": "string",
+                    "example": "2025-09-01"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "doctor_working_hours": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model_healthcare_service.DoctorWorkingHoursRes"
-                    }
+                "issue_date": {
+                    "type": "string",
+                    "example": "2020-09-01"
+                },
+                "issuing_body": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "license"
                 }
             }
         },
-        "model_healthcare_service.ListDoctors": {
+        "model_healthcare_service.UpdateResourceReq": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "branch_id": {
+                    "type": "string"
                 },
-                "doctors": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model_healthcare_service.DoctorRes"
-                    }
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "room",
+                        "equipment"
+                    ],
+                    "example": "room"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ultrasound room 1"
+                },
+                "resource_type": {
+                    "type": "string",
+                    "example": "ultrasound"
                 }
             }
         },
-        "model_healthcare_service.ListReasons": {
+        "model_healthcare_service.UpdateServiceDiscountReq": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "ends_on": {
+                    "type": "string",
+                    "example": "2024-01-31"
                 },
-                "reasons": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model_healthcare_service.ReasonsRes"
-                    }
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "percent",
+                        "fixed"
+                    ],
+                    "example": "percent"
+                },
+                "starts_on": {
+                    "type": "string",
+                    "example": "2024-01-01"
+                },
+                "value": {
+                    "type": "number",
+                    "example": 10
                 }
             }
         },
-        "model_healthcare_service.ListSpecializations": {
+        "model_healthcare_service.UpdateServicePackageReq": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "description": {
+                    "type": "string"
                 },
-                "specializations": {
+                "doctor_service_ids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model_healthcare_service.SpecializationRes"
+                        "type": "string"
                     }
-                }
-            }
-        },
-        "model_healthcare_service.ReasonsReq": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614375001"
                 },
-                "image_url": {
-                    "type": "string",
-                    "example": "http://example.com/image.png"
+                "id": {
+                    "type": "string"
                 },
                 "name": {
                     "type": "string",
-                    "example": "name"
+                    "example": "Annual check-up"
                 },
-                "specialization_id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614375001"
+                "price": {
+                    "type": "number",
+                    "example": 500000
                 }
             }
         },
-        "model_healthcare_service.ReasonsRes": {
+        "model_minio.DocumentURL": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "id": {
+                "expires_at": {
                     "type": "string"
                 },
-                "image_url": {
+                "url": {
                     "type": "string"
-                },
-                "name": {
+                }
+            }
+        },
+        "model_minio.MinioURL": {
+            "type": "object",
+            "properties": {
+                "medium_url": {
                     "type": "string"
                 },
-                "specialization_id": {
+                "thumbnail_url": {
                     "type": "string"
                 },
-                "updated_at": {
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "model_healthcare_service.SpecializationReq": {
+        "model_minio.PresignReq": {
             "type": "object",
             "properties": {
-                "department_id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174003"
-                },
-                "description": {
-                    "type": "string",
-                    "example": "Specialization description"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174003"
-                },
-                "image_url": {
-                    "type": "string",
-                    "example": "http://example.com/image.png"
-                },
-                "name": {
+                "content_type": {
                     "type": "string",
-                    "example": "Specialization"
+                    "enum": [
+                        "application/pdf",
+                        "image/jpeg",
+                        "image/png"
+                    ],
+                    "example": "application/pdf"
+                },
+                "size": {
+                    "type": "integer"
                 }
             }
         },
-        "model_healthcare_service.SpecializationRes": {
+        "model_minio.PresignRes": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "department_id": {
-                    "type": "string"
-                },
-                "description": {
+                "expires_at": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "string"
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
-                "image_url": {
+                "method": {
                     "type": "string"
                 },
-                "name": {
+                "object_name": {
                     "type": "string"
                 },
-                "order": {
-                    "type": "integer"
-                },
-                "updated_at": {
+                "upload_url": {
                     "type": "string"
                 }
             }
         },
-        "model_minio.MinioURL": {
+        "model_minio.UploadedDocument": {
             "type": "object",
             "properties": {
-                "url": {
+                "object_name": {
                     "type": "string"
                 }
             }
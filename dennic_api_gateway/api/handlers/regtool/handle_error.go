@@ -6,6 +6,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func HandleError(c *gin.Context, err error, l *zap.Logger, statusCode int, msg string) bool {
@@ -21,3 +24,25 @@ func HandleError(c *gin.Context, err error, l *zap.Logger, statusCode int, msg s
 	l.Log(1, err.Error())
 	return true
 }
+
+// HandleBookingLimitError responds with 409 and the violated rule code as status
+// when the booking service rejected an appointment because of a booking limit or a blocking rule
+func HandleBookingLimitError(c *gin.Context, err error, l *zap.Logger, msg string) bool {
+	st, ok := status.FromError(err)
+	if !ok || (st.Code() != codes.ResourceExhausted && st.Code() != codes.FailedPrecondition) {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if errorInfo, ok := detail.(*epb.ErrorInfo); ok {
+			c.JSON(http.StatusConflict,
+				&model_common.ResponseError{
+					Code:    errorInfo.Reason,
+					Data:    msg,
+					Message: st.Message(),
+				})
+			l.Log(1, err.Error())
+			return true
+		}
+	}
+	return false
+}
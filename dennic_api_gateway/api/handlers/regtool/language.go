@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/metadata"
+)
+
+// languages are the languages the healthcare service translates the catalogue into
+var languages = []string{"uz", "ru", "en"}
+
+// LanguageContext forwards the Accept-Language header of the request to the services,
+// the healthcare service answers with translated content when it has one
+func LanguageContext(ctx context.Context, c *gin.Context) context.Context {
+	if lang := c.GetHeader("Accept-Language"); lang != "" {
+		return metadata.AppendToOutgoingContext(ctx, "accept-language", lang)
+	}
+	return ctx
+}
+
+// Language is the language the healthcare service answers the Accept-Language header of the request in, it picks
+// the supported language with the highest weight as the service does, empty when none is supported
+func Language(c *gin.Context) string {
+	var (
+		best       string
+		bestWeight float64
+	)
+	for _, part := range strings.Split(c.GetHeader("Accept-Language"), ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.ToLower(strings.TrimSpace(fields[0]))
+		if i := strings.IndexAny(tag, "-_"); i >= 0 {
+			tag = tag[:i]
+		}
+		if !slices.Contains(languages, tag) {
+			continue
+		}
+
+		weight := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+				if err != nil {
+					q = 0
+				}
+				weight = q
+			}
+		}
+		if weight > bestWeight {
+			best, bestWeight = tag, weight
+		}
+	}
+	return best
+}
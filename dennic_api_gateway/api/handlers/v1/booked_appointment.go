@@ -6,22 +6,29 @@ import (
 	"dennic_admin_api_gateway/api/models"
 	"dennic_admin_api_gateway/api/models/model_booking_service"
 	pb "dennic_admin_api_gateway/genproto/booking_service"
+	"dennic_admin_api_gateway/internal/pkg/export"
+	"errors"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // CreateBookedAppointment ...
 // @Summary CreateBookedAppointment
-// @Description CreateBookedAppointment - Api for create booked appointment
+// @Description CreateBookedAppointment - Api for create booked appointment, a promo_code takes its discount off payment_amount
 // @Tags Appointment
 // @Accept json
 // @Produce json
+// @Param Idempotency-Key header string false "retries with the same key return the appointment created by the first request"
 // @Param CreateAppointmentReq body model_booking_service.CreateAppointmentReq true "CreateAppointmentReq"
 // @Success 200 {object} model_booking_service.Appointment
 // @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel "booking limit reached, status is one of BOOKING_LIMIT_ACTIVE_PER_PATIENT, BOOKING_LIMIT_ACTIVE_PER_DOCTOR, BOOKING_LIMIT_PER_DAY, BOOKING_DOCTOR_LICENSE_EXPIRED, BOOKING_RESOURCE_UNAVAILABLE, BOOKING_PROMO_CODE_INVALID, BOOKING_PROMO_CODE_EXHAUSTED, or the idempotency key is used by another patient"
 // @Failure 500 {object} model_common.StandardErrorModel
 // @Router /v1/appointment [post]
 func (h *HandlerV1) CreateBookedAppointment(c *gin.Context) {
@@ -37,6 +44,12 @@ func (h *HandlerV1) CreateBookedAppointment(c *gin.Context) {
 		return
 	}
 
+	idempotencyKey := c.GetHeader("Idempotency-Key")
+	if len(idempotencyKey) > 255 {
+		e.HandleError(c, errors.New("idempotency key is too long"), h.log, http.StatusBadRequest, "CreateBookedAppointment")
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
@@ -54,8 +67,17 @@ func (h *HandlerV1) CreateBookedAppointment(c *gin.Context) {
 		Key:             body.Key,
 		ExpiresAt:       body.ExpiresAt,
 		Status:          "waiting",
+		IdempotencyKey:  idempotencyKey,
+		PromoCode:       body.PromoCode,
 	})
 
+	if e.HandleBookingLimitError(c, err, h.log, "CreateBookedAppointment") {
+		return
+	}
+	if status.Code(err) == codes.AlreadyExists {
+		e.HandleError(c, err, h.log, http.StatusConflict, "CreateBookedAppointment")
+		return
+	}
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateBookedAppointment") {
 		return
 	}
@@ -75,6 +97,9 @@ func (h *HandlerV1) CreateBookedAppointment(c *gin.Context) {
 		DoctorServiceId: res.DoctorServiceId,
 		PaymentType:     res.PaymentType,
 		PaymentAmount:   float64(res.PaymentAmount),
+		ResourceId:      res.ResourceId,
+		PromoCode:       res.PromoCode,
+		DiscountAmount:  float64(res.DiscountAmount),
 		CreatedAt:       res.CreatedAt,
 		UpdatedAt:       e.UpdateTimeFilter(res.UpdatedAt),
 	})
@@ -289,3 +314,70 @@ func (h *HandlerV1) DeleteBookedAppointment(c *gin.Context) {
 
 	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
 }
+
+var appointmentExportColumns = export.Columns[*pb.Appointment]{
+	{Name: "id", Value: func(a *pb.Appointment) string { return strconv.FormatInt(a.Id, 10) }},
+	{Name: "department_id", Value: func(a *pb.Appointment) string { return a.DepartmentId }},
+	{Name: "doctor_id", Value: func(a *pb.Appointment) string { return a.DoctorId }},
+	{Name: "patient_id", Value: func(a *pb.Appointment) string { return a.PatientId }},
+	{Name: "doctor_service_id", Value: func(a *pb.Appointment) string { return a.DoctorServiceId }},
+	{Name: "appointment_date", Value: func(a *pb.Appointment) string { return a.AppointmentDate }},
+	{Name: "appointment_time", Value: func(a *pb.Appointment) string { return a.AppointmentTime }},
+	{Name: "duration", Value: func(a *pb.Appointment) string { return strconv.FormatInt(a.Duration, 10) }},
+	{Name: "key", Value: func(a *pb.Appointment) string { return a.Key }},
+	{Name: "expires_at", Value: func(a *pb.Appointment) string { return a.ExpiresAt }},
+	{Name: "patient_status", Value: func(a *pb.Appointment) string { return a.Status }},
+	{Name: "patient_problem", Value: func(a *pb.Appointment) string { return a.PatientProblem }},
+	{Name: "payment_type", Value: func(a *pb.Appointment) string { return a.PaymentType }},
+	{Name: "payment_amount", Value: func(a *pb.Appointment) string {
+		return strconv.FormatFloat(float64(a.PaymentAmount), 'f', 2, 32)
+	}},
+	{Name: "created_at", Value: func(a *pb.Appointment) string { return a.CreatedAt }},
+	{Name: "updated_at", Value: func(a *pb.Appointment) string { return e.UpdateTimeFilter(a.UpdatedAt) }},
+}
+
+// ExportBookedAppointments ...
+// @Summary ExportBookedAppointments
+// @Description ExportBookedAppointments - API to export booked appointments as csv or xlsx, accepts the same filters as the list api
+// @Tags Appointment
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string false "format" Enums(csv, xlsx)
+// @Param columns query string false "comma separated list of columns, all columns by default"
+// @Param search query string false "search" Enums(key)
+// @Param value query string false "value"
+// @Param orderBy query string false "orderBy"
+// @Success 200 {file} file
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/appointment/export [get]
+func (h *HandlerV1) ExportBookedAppointments(c *gin.Context) {
+	field := c.Query("search")
+	value := c.Query("value")
+	orderBy := c.Query("orderBy")
+
+	columns, err := appointmentExportColumns.Select(c.Query("columns"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ExportBookedAppointments") {
+		return
+	}
+
+	h.streamExport(c, "appointments", columns.Header(), func(ctx context.Context, page, limit uint64) ([][]string, error) {
+		res, err := h.serviceManager.BookingService().BookedAppointment().GetAllAppointment(ctx, &pb.GetAllAppointmentsReq{
+			Field:    field,
+			Value:    value,
+			IsActive: false,
+			Page:     page,
+			Limit:    limit,
+			OrderBy:  orderBy,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		rows := make([][]string, 0, len(res.Appointments))
+		for _, appointment := range res.Appointments {
+			rows = append(rows, columns.Row(appointment))
+		}
+		return rows, nil
+	})
+}
@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models/model_booking_service"
+	pb "dennic_admin_api_gateway/genproto/booking_service"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetBookingRules ...
+// @Summary GetBookingRules
+// @Description GetBookingRules - Api for get booking limits of patients, zero means no limit, block_expired_license rejects bookings with a doctor whose license has expired
+// @Tags Booking Rules
+// @Accept json
+// @Produce json
+// @Success 200 {object} model_booking_service.BookingRules
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/booking-rules [get]
+func (h *HandlerV1) GetBookingRules(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().BookingRules().GetBookingRules(ctx, &pb.GetBookingRulesReq{})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetBookingRules") {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_booking_service.BookingRules{
+		MaxActivePerPatient: res.MaxActivePerPatient,
+		MaxActivePerDoctor:  res.MaxActivePerDoctor,
+		MaxPerDay:           res.MaxPerDay,
+		BlockExpiredLicense: res.BlockExpiredLicense,
+		CreatedAt:           res.CreatedAt,
+		UpdatedAt:           e.UpdateTimeFilter(res.UpdatedAt),
+	})
+}
+
+// UpdateBookingRules ...
+// @Summary UpdateBookingRules
+// @Description UpdateBookingRules - Api for update booking limits of patients, zero disables a limit, block_expired_license rejects bookings with a doctor whose license has expired
+// @Tags Booking Rules
+// @Accept json
+// @Produce json
+// @Param UpdateBookingRulesReq body model_booking_service.UpdateBookingRulesReq true "UpdateBookingRulesReq"
+// @Success 200 {object} model_booking_service.BookingRules
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/booking-rules [put]
+func (h *HandlerV1) UpdateBookingRules(c *gin.Context) {
+	var body model_booking_service.UpdateBookingRulesReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateBookingRules") {
+		return
+	}
+	if body.MaxActivePerPatient < 0 || body.MaxActivePerDoctor < 0 || body.MaxPerDay < 0 {
+		e.HandleError(c, errors.New("booking limits cannot be negative"), h.log, http.StatusBadRequest, "UpdateBookingRules")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().BookingRules().UpdateBookingRules(ctx, &pb.UpdateBookingRulesReq{
+		MaxActivePerPatient: body.MaxActivePerPatient,
+		MaxActivePerDoctor:  body.MaxActivePerDoctor,
+		MaxPerDay:           body.MaxPerDay,
+		BlockExpiredLicense: body.BlockExpiredLicense,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateBookingRules") {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_booking_service.BookingRules{
+		MaxActivePerPatient: res.MaxActivePerPatient,
+		MaxActivePerDoctor:  res.MaxActivePerDoctor,
+		MaxPerDay:           res.MaxPerDay,
+		BlockExpiredLicense: res.BlockExpiredLicense,
+		CreatedAt:           res.CreatedAt,
+		UpdatedAt:           e.UpdateTimeFilter(res.UpdatedAt),
+	})
+}
@@ -0,0 +1,271 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models"
+	"dennic_admin_api_gateway/api/models/model_healthcare_service"
+	pb "dennic_admin_api_gateway/genproto/healthcare-service"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func branchRes(branch *pb.Branch) *model_healthcare_service.Branch {
+	return &model_healthcare_service.Branch{
+		Id:           branch.Id,
+		Name:         branch.Name,
+		Address:      branch.Address,
+		City:         branch.City,
+		Latitude:     branch.Latitude,
+		Longitude:    branch.Longitude,
+		OpensAt:      branch.OpensAt,
+		ClosesAt:     branch.ClosesAt,
+		PhoneNumbers: branch.PhoneNumbers,
+		CreatedAt:    branch.CreatedAt,
+		UpdatedAt:    e.UpdateTimeFilter(branch.UpdatedAt),
+	}
+}
+
+// CreateBranch ...
+// @Summary CreateBranch
+// @Description CreateBranch - Api for create a branch of the clinic
+// @Tags Branch
+// @Accept json
+// @Produce json
+// @Param BranchReq body model_healthcare_service.BranchReq true "BranchReq"
+// @Success 200 {object} model_healthcare_service.Branch
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/branch [post]
+func (h *HandlerV1) CreateBranch(c *gin.Context) {
+	var body model_healthcare_service.BranchReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateBranch") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	branch, err := h.serviceManager.HealthcareService().BranchService().CreateBranch(ctx, &pb.Branch{
+		Id:           uuid.NewString(),
+		Name:         body.Name,
+		Address:      body.Address,
+		City:         body.City,
+		Latitude:     body.Latitude,
+		Longitude:    body.Longitude,
+		OpensAt:      body.OpensAt,
+		ClosesAt:     body.ClosesAt,
+		PhoneNumbers: body.PhoneNumbers,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateBranch") {
+		return
+	}
+
+	c.JSON(http.StatusOK, branchRes(branch))
+}
+
+// GetBranch ...
+// @Summary GetBranch
+// @Description GetBranch - API to get branch by ID
+// @Tags Branch
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_healthcare_service.Branch
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/branch/get [get]
+func (h *HandlerV1) GetBranch(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	branch, err := h.serviceManager.HealthcareService().BranchService().GetBranch(ctx, &pb.BranchId{
+		Id: c.Query("id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetBranch") {
+		return
+	}
+
+	c.JSON(http.StatusOK, branchRes(branch))
+}
+
+// ListBranches ...
+// @Summary ListBranches
+// @Description ListBranches - API to list branches ordered by name, city selects the branches of a city
+// @Tags Branch
+// @Accept json
+// @Produce json
+// @Param city query string false "city"
+// @Param page query uint64 false "page"
+// @Param limit query uint64 false "limit"
+// @Success 200 {object} model_healthcare_service.ListBranches
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/branch [get]
+func (h *HandlerV1) ListBranches(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListBranches") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	branches, err := h.serviceManager.HealthcareService().BranchService().ListBranches(ctx, &pb.ListBranchesReq{
+		Page:  int64(pageInt),
+		Limit: int64(limitInt),
+		City:  c.Query("city"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListBranches") {
+		return
+	}
+
+	var branchesRes model_healthcare_service.ListBranches
+	for _, branch := range branches.Branches {
+		branchesRes.Branches = append(branchesRes.Branches, branchRes(branch))
+	}
+	branchesRes.Count = branches.Count
+
+	c.JSON(http.StatusOK, branchesRes)
+}
+
+// UpdateBranch ...
+// @Summary UpdateBranch
+// @Description UpdateBranch - API to update a branch
+// @Tags Branch
+// @Accept json
+// @Produce json
+// @Param UpdateBranchReq body model_healthcare_service.UpdateBranchReq true "UpdateBranchReq"
+// @Success 200 {object} model_healthcare_service.Branch
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/branch [put]
+func (h *HandlerV1) UpdateBranch(c *gin.Context) {
+	var body model_healthcare_service.UpdateBranchReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateBranch") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	branch, err := h.serviceManager.HealthcareService().BranchService().UpdateBranch(ctx, &pb.Branch{
+		Id:           body.Id,
+		Name:         body.Name,
+		Address:      body.Address,
+		City:         body.City,
+		Latitude:     body.Latitude,
+		Longitude:    body.Longitude,
+		OpensAt:      body.OpensAt,
+		ClosesAt:     body.ClosesAt,
+		PhoneNumbers: body.PhoneNumbers,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateBranch") {
+		return
+	}
+
+	c.JSON(http.StatusOK, branchRes(branch))
+}
+
+// DeleteBranch ...
+// @Summary DeleteBranch
+// @Description DeleteBranch - API to delete a branch
+// @Tags Branch
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/branch [delete]
+func (h *HandlerV1) DeleteBranch(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().BranchService().DeleteBranch(ctx, &pb.BranchId{
+		Id: c.Query("id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DeleteBranch") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
+
+// ListBranchesNearby ...
+// @Summary ListBranchesNearby
+// @Description ListBranchesNearby - API to list branches ordered by the distance from the coordinates, nearest first
+// @Tags Branch
+// @Accept json
+// @Produce json
+// @Param latitude query number true "latitude" example(41.2995)
+// @Param longitude query number true "longitude" example(69.2401)
+// @Param radius_km query number false "radius_km, no limit when empty"
+// @Param limit query int64 false "limit, every branch when empty"
+// @Success 200 {object} model_healthcare_service.ListNearbyBranches
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/branch/nearby [get]
+func (h *HandlerV1) ListBranchesNearby(c *gin.Context) {
+	latitude, err := strconv.ParseFloat(c.Query("latitude"), 64)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListBranchesNearby") {
+		return
+	}
+	longitude, err := strconv.ParseFloat(c.Query("longitude"), 64)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListBranchesNearby") {
+		return
+	}
+	var radiusKm float64
+	if value := c.Query("radius_km"); value != "" {
+		radiusKm, err = strconv.ParseFloat(value, 64)
+		if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListBranchesNearby") {
+			return
+		}
+	}
+	var limit int64
+	if value := c.Query("limit"); value != "" {
+		limit, err = strconv.ParseInt(value, 10, 64)
+		if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListBranchesNearby") {
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	branches, err := h.serviceManager.HealthcareService().BranchService().ListBranchesNearby(ctx, &pb.BranchesNearbyReq{
+		Latitude:  latitude,
+		Longitude: longitude,
+		RadiusKm:  radiusKm,
+		Limit:     limit,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListBranchesNearby") {
+		return
+	}
+
+	var branchesRes model_healthcare_service.ListNearbyBranches
+	for _, nearby := range branches.Branches {
+		branchesRes.Branches = append(branchesRes.Branches, &model_healthcare_service.NearbyBranch{
+			Branch:     branchRes(nearby.Branch),
+			DistanceKm: nearby.DistanceKm,
+		})
+	}
+
+	c.JSON(http.StatusOK, branchesRes)
+}
@@ -0,0 +1,129 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models"
+	"dennic_admin_api_gateway/api/models/model_healthcare_service"
+	cache "dennic_admin_api_gateway/internal/infrastructure/redis"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// cachedCatalogue writes the cached response of the catalogue resource to the request and reports the hit,
+// on a miss it returns the key to cache the response under, empty when redis is unavailable
+func (h *HandlerV1) cachedCatalogue(c *gin.Context, resource string) (string, bool) {
+	key, data, hit := h.lookupCatalogue(c, resource)
+	if hit {
+		c.Data(http.StatusOK, "application/json; charset=utf-8", data)
+	}
+	return key, hit
+}
+
+// lookupCatalogue reads the cached response of the catalogue resource to the request, on a miss it returns
+// the key to cache the response under, empty when redis is unavailable
+func (h *HandlerV1) lookupCatalogue(c *gin.Context, resource string) (string, []byte, bool) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	// the responses differ by the language they are translated into, not by the spelling of the header
+	key, err := h.catalogue.Key(ctx, resource, c.Request.URL.Query(), e.Language(c))
+	if err != nil {
+		h.log.Error("catalogue cache key", zap.String("resource", resource), zap.Error(err))
+		return "", nil, false
+	}
+	data, hit := h.catalogue.Get(ctx, resource, key)
+	if !hit {
+		c.Header("X-Cache", "MISS")
+		return key, nil, false
+	}
+	c.Header("X-Cache", "HIT")
+	return key, data, true
+}
+
+// cacheCatalogue writes the response and caches it under the key
+func (h *HandlerV1) cacheCatalogue(c *gin.Context, key string, response any) {
+	c.JSON(http.StatusOK, response)
+	h.storeCatalogue(c, key, response)
+}
+
+// storeCatalogue caches the response under the key, nothing when the key is empty
+func (h *HandlerV1) storeCatalogue(c *gin.Context, key string, response any) {
+	if key == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	if err := h.catalogue.Set(ctx, key, response); err != nil {
+		h.log.Error("catalogue cache set", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// PurgeCatalogueCache ...
+// @Summary PurgeCatalogueCache
+// @Description PurgeCatalogueCache - Api for drop the cached catalogue responses of the resource, of all the resources when none is given
+// @Tags CatalogueCache
+// @Accept json
+// @Produce json
+// @Param resource query string false "resource" Enums(departments, specializations, reasons, doctor)
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/cache/catalogue [delete]
+func (h *HandlerV1) PurgeCatalogueCache(c *gin.Context) {
+	var resources []string
+	if resource := c.Query("resource"); resource != "" {
+		if !slices.Contains(cache.CatalogueResources, resource) {
+			e.HandleError(c, errors.New("unknown resource "+resource), h.log, http.StatusBadRequest, "PurgeCatalogueCache")
+			return
+		}
+		resources = append(resources, resource)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	err := h.catalogue.Invalidate(ctx, resources...)
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "PurgeCatalogueCache") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: true})
+}
+
+// CatalogueCacheStats ...
+// @Summary CatalogueCacheStats
+// @Description CatalogueCacheStats - Api for get the hits and misses of the cached catalogue resources
+// @Tags CatalogueCache
+// @Accept json
+// @Produce json
+// @Success 200 {object} model_healthcare_service.ListCatalogueCacheStats
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/cache/catalogue/stats [get]
+func (h *HandlerV1) CatalogueCacheStats(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	stats, err := h.catalogue.Stats(ctx)
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CatalogueCacheStats") {
+		return
+	}
+
+	var statsRes model_healthcare_service.ListCatalogueCacheStats
+	for _, stat := range stats {
+		statsRes.Stats = append(statsRes.Stats, model_healthcare_service.CatalogueCacheStats{
+			Resource: stat.Resource,
+			Hits:     stat.Hits,
+			Misses:   stat.Misses,
+		})
+	}
+
+	c.JSON(http.StatusOK, statsRes)
+}
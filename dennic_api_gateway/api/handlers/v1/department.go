@@ -6,6 +6,7 @@ import (
 	"dennic_admin_api_gateway/api/models"
 	"dennic_admin_api_gateway/api/models/model_healthcare_service"
 	pb "dennic_admin_api_gateway/genproto/healthcare-service"
+	cache "dennic_admin_api_gateway/internal/infrastructure/redis"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -47,6 +48,7 @@ func (h *HandlerV1) CreateDepartment(c *gin.Context) {
 		ImageUrl:         body.ImageUrl,
 		FloorNumber:      body.FloorNumber,
 		ShortDescription: body.ShortDescription,
+		BranchId:         body.BranchId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateDepartment") {
@@ -61,6 +63,7 @@ func (h *HandlerV1) CreateDepartment(c *gin.Context) {
 		ImageUrl:         department.ImageUrl,
 		FloorNumber:      department.FloorNumber,
 		ShortDescription: department.ShortDescription,
+		BranchId:         department.BranchId,
 		CreatedAt:        department.CreatedAt,
 		UpdatedAt:        e.UpdateTimeFilter(department.UpdatedAt),
 	})
@@ -73,13 +76,14 @@ func (h *HandlerV1) CreateDepartment(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id query string true "id"
+// @Param Accept-Language header string false "uz, ru or en"
 // @Success 200 {object} model_healthcare_service.DepartmentRes
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
 // @Router /v1/department/get [get]
 func (h *HandlerV1) GetDepartment(c *gin.Context) {
 	id := c.Query("id")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	ctx, cancel := context.WithTimeout(e.LanguageContext(context.Background(), c), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
 	department, err := h.serviceManager.HealthcareService().DepartmentService().GetDepartmentById(ctx, &pb.GetReqStrDepartment{
@@ -100,6 +104,7 @@ func (h *HandlerV1) GetDepartment(c *gin.Context) {
 		ImageUrl:         department.ImageUrl,
 		FloorNumber:      department.FloorNumber,
 		ShortDescription: department.ShortDescription,
+		BranchId:         department.BranchId,
 		CreatedAt:        department.CreatedAt,
 		UpdatedAt:        e.UpdateTimeFilter(department.UpdatedAt),
 	})
@@ -113,6 +118,7 @@ func (h *HandlerV1) GetDepartment(c *gin.Context) {
 // @Produce json
 // @Param search query string false "search" Enums(name, description) "search"
 // @Param ListReq query models.ListReq false "ListReq"
+// @Param Accept-Language header string false "uz, ru or en"
 // @Success 200 {object} model_healthcare_service.ListDepartments
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -128,8 +134,12 @@ func (h *HandlerV1) ListDepartments(c *gin.Context) {
 	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListDepartments") {
 		return
 	}
+	cacheKey, hit := h.cachedCatalogue(c, cache.CatalogueDepartments)
+	if hit {
+		return
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	ctx, cancel := context.WithTimeout(e.LanguageContext(context.Background(), c), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
 	departments, err := h.serviceManager.HealthcareService().DepartmentService().GetAllDepartments(ctx, &pb.GetAllDepartment{
@@ -153,12 +163,13 @@ func (h *HandlerV1) ListDepartments(c *gin.Context) {
 			ImageUrl:         departmentRes.ImageUrl,
 			FloorNumber:      departmentRes.FloorNumber,
 			ShortDescription: departmentRes.ShortDescription,
+			BranchId:         departmentRes.BranchId,
 			CreatedAt:        departmentRes.CreatedAt,
 			UpdatedAt:        e.UpdateTimeFilter(departmentRes.UpdatedAt),
 		})
 	}
 
-	c.JSON(http.StatusOK, model_healthcare_service.ListDepartments{
+	h.cacheCatalogue(c, cacheKey, model_healthcare_service.ListDepartments{
 		Count:       int32(departments.Count),
 		Departments: departmentsRes.Departments,
 	})
@@ -198,6 +209,7 @@ func (h *HandlerV1) UpdateDepartment(c *gin.Context) {
 		ImageUrl:         body.ImageUrl,
 		FloorNumber:      body.FloorNumber,
 		ShortDescription: body.ShortDescription,
+		BranchId:         body.BranchId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateDepartment") {
@@ -212,6 +224,7 @@ func (h *HandlerV1) UpdateDepartment(c *gin.Context) {
 		ImageUrl:         department.ImageUrl,
 		FloorNumber:      department.FloorNumber,
 		ShortDescription: department.ShortDescription,
+		BranchId:         department.BranchId,
 		CreatedAt:        department.CreatedAt,
 		UpdatedAt:        e.UpdateTimeFilter(department.UpdatedAt),
 	})
@@ -246,3 +259,29 @@ func (h *HandlerV1) DeleteDepartment(c *gin.Context) {
 
 	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
 }
+
+// RestoreDepartment ...
+// @Summary RestoreDepartment
+// @Description RestoreDepartment - Api for restore a deleted department together with the records its delete cascaded to
+// @Tags Department
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/department/restore [put]
+func (h *HandlerV1) RestoreDepartment(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().DepartmentService().RestoreDepartment(ctx, &pb.RestoreDepartmentReq{
+		Id: c.Query("id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "RestoreDepartment") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
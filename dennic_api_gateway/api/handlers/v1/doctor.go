@@ -7,7 +7,12 @@ import (
 	"dennic_admin_api_gateway/api/models/model_healthcare_service"
 	"dennic_admin_api_gateway/genproto/booking_service"
 	pb "dennic_admin_api_gateway/genproto/healthcare-service"
+	cache "dennic_admin_api_gateway/internal/infrastructure/redis"
+	"dennic_admin_api_gateway/internal/pkg/export"
+	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -39,6 +44,12 @@ func (h *HandlerV1) CreateDoctor(c *gin.Context) {
 		return
 	}
 
+	// the password is checked on the doctor portal login
+	password, err := e.HashPassword(body.Password)
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateDoctor") {
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
@@ -51,7 +62,7 @@ func (h *HandlerV1) CreateDoctor(c *gin.Context) {
 		BirthDate:     body.BirthDate,
 		PhoneNumber:   body.PhoneNumber,
 		Email:         body.Email,
-		Password:      body.Password,
+		Password:      password,
 		Address:       body.Address,
 		City:          body.City,
 		Country:       body.Country,
@@ -60,7 +71,9 @@ func (h *HandlerV1) CreateDoctor(c *gin.Context) {
 		StartWorkDate: body.StartWorkDate,
 		WorkYears:     body.WorkYears,
 		DepartmentId:  body.DepartmentId,
+		DepartmentIds: body.DepartmentIds,
 		RoomNumber:    body.RoomNumber,
+		BranchId:      body.BranchId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateDoctor") {
@@ -86,8 +99,9 @@ func (h *HandlerV1) CreateDoctor(c *gin.Context) {
 		EndWorkDate:   doctor.EndWorkDate,
 		WorkYears:     doctor.WorkYears,
 		DepartmentId:  doctor.DepartmentId,
+		DepartmentIds: doctor.DepartmentIds,
 		RoomNumber:    doctor.RoomNumber,
-		Password:      doctor.Password,
+		BranchId:      doctor.BranchId,
 		CreatedAt:     doctor.CreatedAt,
 		UpdatedAt:     e.UpdateTimeFilter(doctor.UpdatedAt),
 		DeletedAt:     e.UpdateTimeFilter(doctor.DeletedAt),
@@ -110,22 +124,61 @@ func (h *HandlerV1) GetDoctor(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
-	doctor, err := h.serviceManager.HealthcareService().DoctorService().GetDoctorById(ctx, &pb.GetReqStrDoctor{
-		Field:    "id",
-		Value:    id,
-		IsActive: false,
-	})
+	// the patient count follows the bookings, which publish no catalogue events, so it is never cached
+	var doctorRes model_healthcare_service.DoctorAndDoctorHours
+	cacheKey, cached, hit := h.lookupCatalogue(c, cache.CatalogueDoctor)
+	if !hit || json.Unmarshal(cached, &doctorRes) != nil {
+		doctor, err := h.serviceManager.HealthcareService().DoctorService().GetDoctorById(ctx, &pb.GetReqStrDoctor{
+			Field:    "id",
+			Value:    id,
+			IsActive: false,
+		})
 
-	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetDoctor") {
-		return
-	}
+		if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetDoctor") {
+			return
+		}
 
-	var doctorSpec = []model_healthcare_service.DoctorSpec{}
-	for _, specialization := range doctor.Specializations {
-		doctorSpec = append(doctorSpec, model_healthcare_service.DoctorSpec{
-			Id:   specialization.Id,
-			Name: specialization.Name,
-		})
+		var doctorSpec = []model_healthcare_service.DoctorSpec{}
+		for _, specialization := range doctor.Specializations {
+			doctorSpec = append(doctorSpec, model_healthcare_service.DoctorSpec{
+				Id:   specialization.Id,
+				Name: specialization.Name,
+			})
+		}
+
+		doctorRes = model_healthcare_service.DoctorAndDoctorHours{
+			Id:              doctor.Id,
+			Order:           doctor.Order,
+			FirstName:       doctor.FirstName,
+			LastName:        doctor.LastName,
+			ImageUrl:        doctor.ImageUrl,
+			Gender:          doctor.Gender,
+			BirthDate:       doctor.BirthDate,
+			PhoneNumber:     doctor.PhoneNumber,
+			Email:           doctor.Email,
+			Address:         doctor.Address,
+			City:            doctor.City,
+			Country:         doctor.Country,
+			Salary:          doctor.Salary,
+			StartTime:       doctor.StartTime,
+			FinishTime:      doctor.FinishTime,
+			DayOfWeek:       doctor.DayOfWeek,
+			Bio:             doctor.Bio,
+			StartWorkDate:   doctor.StartWorkDate,
+			EndWorkDate:     doctor.EndWorkDate,
+			WorkYears:       doctor.WorkYears,
+			DepartmentId:    doctor.DepartmentId,
+			DepartmentIds:   doctor.DepartmentIds,
+			RoomNumber:      doctor.RoomNumber,
+			BranchId:        doctor.BranchId,
+			CreatedAt:       doctor.CreatedAt,
+			UpdatedAt:       e.UpdateTimeFilter(doctor.UpdatedAt),
+			DeletedAt:       e.UpdateTimeFilter(doctor.DeletedAt),
+			Rating:          doctor.Rating,
+			ReviewCount:     doctor.ReviewCount,
+			Specializations: doctorSpec,
+		}
+		h.storeCatalogue(c, cacheKey, doctorRes)
 	}
 
 	appointments, err := h.serviceManager.BookingService().BookedAppointment().GetFilteredAppointments(ctx, &booking_service.GetFilteredRequest{
@@ -140,41 +193,13 @@ func (h *HandlerV1) GetDoctor(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, model_healthcare_service.DoctorAndDoctorHours{
-		Id:              doctor.Id,
-		Order:           doctor.Order,
-		FirstName:       doctor.FirstName,
-		LastName:        doctor.LastName,
-		ImageUrl:        doctor.ImageUrl,
-		Gender:          doctor.Gender,
-		BirthDate:       doctor.BirthDate,
-		PhoneNumber:     doctor.PhoneNumber,
-		Email:           doctor.Email,
-		Address:         doctor.Address,
-		City:            doctor.City,
-		Country:         doctor.Country,
-		Salary:          doctor.Salary,
-		StartTime:       doctor.StartTime,
-		FinishTime:      doctor.FinishTime,
-		DayOfWeek:       doctor.DayOfWeek,
-		Bio:             doctor.Bio,
-		StartWorkDate:   doctor.StartWorkDate,
-		EndWorkDate:     doctor.EndWorkDate,
-		WorkYears:       doctor.WorkYears,
-		DepartmentId:    doctor.DepartmentId,
-		RoomNumber:      doctor.RoomNumber,
-		Password:        doctor.Password,
-		CreatedAt:       doctor.CreatedAt,
-		UpdatedAt:       e.UpdateTimeFilter(doctor.UpdatedAt),
-		DeletedAt:       e.UpdateTimeFilter(doctor.DeletedAt),
-		Specializations: doctorSpec,
-		PatientCount:    appointments.Count,
-	})
+	doctorRes.PatientCount = appointments.Count
+	c.JSON(http.StatusOK, doctorRes)
 }
 
 // ListDoctors ...
 // @Summary ListDoctors
-// @Description ListDoctors - Api for list doctor
+// @Description ListDoctors - Api for list doctor, orderBy=rating desc lists the best rated doctors first
 // @Tags Doctor
 // @Accept json
 // @Produce json
@@ -244,10 +269,12 @@ func (h *HandlerV1) ListDoctors(c *gin.Context) {
 			WorkYears:       doctorRes.WorkYears,
 			DepartmentId:    doctorRes.DepartmentId,
 			RoomNumber:      doctorRes.RoomNumber,
-			Password:        doctorRes.Password,
+			BranchId:        doctorRes.BranchId,
 			CreatedAt:       doctorRes.CreatedAt,
 			UpdatedAt:       e.UpdateTimeFilter(doctorRes.UpdatedAt),
 			DeletedAt:       e.UpdateTimeFilter(doctorRes.DeletedAt),
+			Rating:          doctorRes.Rating,
+			ReviewCount:     doctorRes.ReviewCount,
 			Specializations: doctorSpec,
 		})
 	}
@@ -327,11 +354,14 @@ func (h *HandlerV1) ListDoctorsBySpecializationId(c *gin.Context) {
 			EndWorkDate:     doctorRes.EndWorkDate,
 			WorkYears:       doctorRes.WorkYears,
 			DepartmentId:    doctorRes.DepartmentId,
+			DepartmentIds:   doctorRes.DepartmentIds,
 			RoomNumber:      doctorRes.RoomNumber,
-			Password:        doctorRes.Password,
+			BranchId:        doctorRes.BranchId,
 			CreatedAt:       doctorRes.CreatedAt,
 			UpdatedAt:       e.UpdateTimeFilter(doctorRes.UpdatedAt),
 			DeletedAt:       e.UpdateTimeFilter(doctorRes.DeletedAt),
+			Rating:          doctorRes.Rating,
+			ReviewCount:     doctorRes.ReviewCount,
 			Specializations: doctorSpec,
 		})
 	}
@@ -341,6 +371,75 @@ func (h *HandlerV1) ListDoctorsBySpecializationId(c *gin.Context) {
 	})
 }
 
+// ListDoctorsByDepartmentId ...
+// @Summary ListDoctorsByDepartmentId
+// @Description ListDoctorsByDepartmentId - Api for list the doctors working in a department, the main one or any other of their departments
+// @Tags Doctor
+// @Accept json
+// @Produce json
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param department_id query string true "department_id"
+// @Success 200 {object} model_healthcare_service.ListDoctors
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor/department [get]
+func (h *HandlerV1) ListDoctorsByDepartmentId(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListDoctorsByDepartmentId") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	doctors, err := h.serviceManager.HealthcareService().DoctorService().ListDoctorsByDepartmentId(ctx, &pb.GetReqStrDep{
+		Field:        c.Query("field"),
+		Value:        c.Query("value"),
+		IsActive:     false,
+		Page:         int32(pageInt),
+		Limit:        int32(limitInt),
+		OrderBy:      c.Query("orderBy"),
+		DepartmentId: c.Query("department_id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListDoctorsByDepartmentId") {
+		return
+	}
+
+	var doctorsRes model_healthcare_service.ListDoctors
+	for _, doctor := range doctors.Doctors {
+		doctorsRes.Doctors = append(doctorsRes.Doctors, &model_healthcare_service.DoctorRes{
+			Id:            doctor.Id,
+			Order:         doctor.Order,
+			FirstName:     doctor.FirstName,
+			LastName:      doctor.LastName,
+			ImageUrl:      doctor.ImageUrl,
+			Gender:        doctor.Gender,
+			BirthDate:     doctor.BirthDate,
+			PhoneNumber:   doctor.PhoneNumber,
+			Email:         doctor.Email,
+			Address:       doctor.Address,
+			City:          doctor.City,
+			Country:       doctor.Country,
+			Salary:        doctor.Salary,
+			Bio:           doctor.Bio,
+			StartWorkDate: doctor.StartWorkDate,
+			EndWorkDate:   doctor.EndWorkDate,
+			WorkYears:     doctor.WorkYears,
+			DepartmentId:  doctor.DepartmentId,
+			DepartmentIds: doctor.DepartmentIds,
+			RoomNumber:    doctor.RoomNumber,
+			BranchId:      doctor.BranchId,
+			CreatedAt:     doctor.CreatedAt,
+			UpdatedAt:     e.UpdateTimeFilter(doctor.UpdatedAt),
+			DeletedAt:     e.UpdateTimeFilter(doctor.DeletedAt),
+		})
+	}
+	doctorsRes.Count = doctors.Count
+
+	c.JSON(http.StatusOK, doctorsRes)
+}
+
 // UpdateDoctor ...
 // @Summary UpdateDoctor
 // @Description UpdateDoctor - Api for update doctor
@@ -365,6 +464,15 @@ func (h *HandlerV1) UpdateDoctor(c *gin.Context) {
 		return
 	}
 
+	// an empty password is stored as sent, like the other fields of the update
+	password := body.Password
+	if password != "" {
+		password, err = e.HashPassword(body.Password)
+		if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateDoctor") {
+			return
+		}
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
@@ -377,7 +485,7 @@ func (h *HandlerV1) UpdateDoctor(c *gin.Context) {
 		BirthDate:     body.BirthDate,
 		PhoneNumber:   body.PhoneNumber,
 		Email:         body.Email,
-		Password:      body.Password,
+		Password:      password,
 		Address:       body.Address,
 		City:          body.City,
 		Country:       body.Country,
@@ -387,7 +495,9 @@ func (h *HandlerV1) UpdateDoctor(c *gin.Context) {
 		EndWorkDate:   body.EndWorkDate,
 		WorkYears:     body.WorkYears,
 		DepartmentId:  body.DepartmentId,
+		DepartmentIds: body.DepartmentIds,
 		RoomNumber:    body.RoomNumber,
+		BranchId:      body.BranchId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateDoctor") {
@@ -403,7 +513,6 @@ func (h *HandlerV1) UpdateDoctor(c *gin.Context) {
 		BirthDate:     doctor.BirthDate,
 		PhoneNumber:   doctor.PhoneNumber,
 		Email:         doctor.Email,
-		Password:      doctor.Password,
 		Address:       doctor.Address,
 		City:          doctor.City,
 		Country:       doctor.Country,
@@ -413,7 +522,9 @@ func (h *HandlerV1) UpdateDoctor(c *gin.Context) {
 		EndWorkDate:   doctor.EndWorkDate,
 		WorkYears:     doctor.WorkYears,
 		DepartmentId:  doctor.DepartmentId,
+		DepartmentIds: doctor.DepartmentIds,
 		RoomNumber:    doctor.RoomNumber,
+		BranchId:      doctor.BranchId,
 		CreatedAt:     doctor.CreatedAt,
 		UpdatedAt:     e.UpdateTimeFilter(doctor.UpdatedAt),
 		DeletedAt:     e.UpdateTimeFilter(doctor.DeletedAt),
@@ -449,3 +560,116 @@ func (h *HandlerV1) DeleteDoctor(c *gin.Context) {
 
 	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
 }
+
+// RestoreDoctor ...
+// @Summary RestoreDoctor
+// @Description RestoreDoctor - Api for restore a deleted doctor together with the records its delete cascaded to
+// @Tags Doctor
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor/restore [put]
+func (h *HandlerV1) RestoreDoctor(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().DoctorService().RestoreDoctor(ctx, &pb.RestoreDoctorReq{
+		Id: c.Query("id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "RestoreDoctor") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
+
+var doctorExportColumns = export.Columns[*pb.DoctorAndDoctorHours]{
+	{Name: "id", Value: func(d *pb.DoctorAndDoctorHours) string { return d.Id }},
+	{Name: "order", Value: func(d *pb.DoctorAndDoctorHours) string { return strconv.Itoa(int(d.Order)) }},
+	{Name: "first_name", Value: func(d *pb.DoctorAndDoctorHours) string { return d.FirstName }},
+	{Name: "last_name", Value: func(d *pb.DoctorAndDoctorHours) string { return d.LastName }},
+	{Name: "gender", Value: func(d *pb.DoctorAndDoctorHours) string { return d.Gender }},
+	{Name: "birth_date", Value: func(d *pb.DoctorAndDoctorHours) string { return d.BirthDate }},
+	{Name: "phone_number", Value: func(d *pb.DoctorAndDoctorHours) string { return d.PhoneNumber }},
+	{Name: "email", Value: func(d *pb.DoctorAndDoctorHours) string { return d.Email }},
+	{Name: "address", Value: func(d *pb.DoctorAndDoctorHours) string { return d.Address }},
+	{Name: "city", Value: func(d *pb.DoctorAndDoctorHours) string { return d.City }},
+	{Name: "country", Value: func(d *pb.DoctorAndDoctorHours) string { return d.Country }},
+	{Name: "salary", Value: func(d *pb.DoctorAndDoctorHours) string {
+		return strconv.FormatFloat(float64(d.Salary), 'f', 2, 32)
+	}},
+	{Name: "start_time", Value: func(d *pb.DoctorAndDoctorHours) string { return d.StartTime }},
+	{Name: "finish_time", Value: func(d *pb.DoctorAndDoctorHours) string { return d.FinishTime }},
+	{Name: "day_of_week", Value: func(d *pb.DoctorAndDoctorHours) string { return d.DayOfWeek }},
+	{Name: "bio", Value: func(d *pb.DoctorAndDoctorHours) string { return d.Bio }},
+	{Name: "start_work_date", Value: func(d *pb.DoctorAndDoctorHours) string { return d.StartWorkDate }},
+	{Name: "end_work_date", Value: func(d *pb.DoctorAndDoctorHours) string { return d.EndWorkDate }},
+	{Name: "work_years", Value: func(d *pb.DoctorAndDoctorHours) string { return strconv.Itoa(int(d.WorkYears)) }},
+	{Name: "department_id", Value: func(d *pb.DoctorAndDoctorHours) string { return d.DepartmentId }},
+	{Name: "room_number", Value: func(d *pb.DoctorAndDoctorHours) string { return strconv.Itoa(int(d.RoomNumber)) }},
+	{Name: "branch_id", Value: func(d *pb.DoctorAndDoctorHours) string { return d.BranchId }},
+	{Name: "rating", Value: func(d *pb.DoctorAndDoctorHours) string {
+		return strconv.FormatFloat(float64(d.Rating), 'f', 2, 32)
+	}},
+	{Name: "review_count", Value: func(d *pb.DoctorAndDoctorHours) string { return strconv.FormatInt(d.ReviewCount, 10) }},
+	{Name: "specializations", Value: func(d *pb.DoctorAndDoctorHours) string {
+		names := make([]string, 0, len(d.Specializations))
+		for _, specialization := range d.Specializations {
+			names = append(names, specialization.Name)
+		}
+		return strings.Join(names, ", ")
+	}},
+	{Name: "image_url", Value: func(d *pb.DoctorAndDoctorHours) string { return d.ImageUrl }},
+	{Name: "created_at", Value: func(d *pb.DoctorAndDoctorHours) string { return d.CreatedAt }},
+	{Name: "updated_at", Value: func(d *pb.DoctorAndDoctorHours) string { return e.UpdateTimeFilter(d.UpdatedAt) }},
+}
+
+// ExportDoctors ...
+// @Summary ExportDoctors
+// @Description ExportDoctors - Api for export doctors as csv or xlsx, accepts the same filters as the list api
+// @Tags Doctor
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string false "format" Enums(csv, xlsx)
+// @Param columns query string false "comma separated list of columns, all columns by default"
+// @Param search query string false "search" Enums(first_name, last_name, gender, phone_number, email, address, city, country, biography) "search"
+// @Param value query string false "value"
+// @Param orderBy query string false "orderBy"
+// @Success 200 {file} file
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor/export [get]
+func (h *HandlerV1) ExportDoctors(c *gin.Context) {
+	search := c.Query("search")
+	value := c.Query("value")
+	orderBy := c.Query("orderBy")
+
+	columns, err := doctorExportColumns.Select(c.Query("columns"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ExportDoctors") {
+		return
+	}
+
+	h.streamExport(c, "doctors", columns.Header(), func(ctx context.Context, page, limit uint64) ([][]string, error) {
+		res, err := h.serviceManager.HealthcareService().DoctorService().GetAllDoctors(ctx, &pb.GetAllDoctorS{
+			Field:    search,
+			Value:    value,
+			IsActive: false,
+			Page:     int64(page),
+			Limit:    int64(limit),
+			OrderBy:  orderBy,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		rows := make([][]string, 0, len(res.DoctorHours))
+		for _, doctor := range res.DoctorHours {
+			rows = append(rows, columns.Row(doctor))
+		}
+		return rows, nil
+	})
+}
@@ -0,0 +1,397 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models"
+	"dennic_admin_api_gateway/api/models/model_healthcare_service"
+	m "dennic_admin_api_gateway/api/models/model_minio"
+	pb "dennic_admin_api_gateway/genproto/healthcare-service"
+	"dennic_admin_api_gateway/internal/pkg/minio"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// credentialDocumentPrefix is the folder of the credential documents of the doctor in the private document bucket
+func credentialDocumentPrefix(doctorId string) string {
+	return "credentials/" + doctorId + "/"
+}
+
+// validCredentialDocument tells whether the object is empty or a credential document of the doctor
+func validCredentialDocument(objectName, doctorId string) bool {
+	return objectName == "" || strings.HasPrefix(objectName, credentialDocumentPrefix(doctorId)) && !strings.Contains(objectName, "..")
+}
+
+func doctorCredentialRes(credential *pb.DoctorCredential) *model_healthcare_service.DoctorCredential {
+	return &model_healthcare_service.DoctorCredential{
+		Id:                 credential.Id,
+		DoctorId:           credential.DoctorId,
+		Type:               credential.Type,
+		IssuingBody:        credential.IssuingBody,
+		Number:             credential.Number,
+		IssueDate:          credential.IssueDate,
+		ExpiryDate:         credential.ExpiryDate,
+		DocumentObjectName: credential.DocumentObjectName,
+		ExpiryFlaggedAt:    credential.ExpiryFlaggedAt,
+		CreatedAt:          credential.CreatedAt,
+		UpdatedAt:          e.UpdateTimeFilter(credential.UpdatedAt),
+	}
+}
+
+// CreateDoctorCredential ...
+// @Summary CreateDoctorCredential
+// @Description CreateDoctorCredential - Api for add a credential to a doctor, the document is uploaded before through /v1/doctor-credential/document
+// @Tags Doctor Credential
+// @Accept json
+// @Produce json
+// @Param CreateDoctorCredentialReq body model_healthcare_service.CreateDoctorCredentialReq true "CreateDoctorCredentialReq"
+// @Success 200 {object} model_healthcare_service.DoctorCredential
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-credential [post]
+func (h *HandlerV1) CreateDoctorCredential(c *gin.Context) {
+	var body model_healthcare_service.CreateDoctorCredentialReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateDoctorCredential") {
+		return
+	}
+	if !validCredentialDocument(body.DocumentObjectName, body.DoctorId) {
+		e.HandleError(c, errors.New("the document is not a credential document of the doctor"), h.log, http.StatusBadRequest, "CreateDoctorCredential")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	credential, err := h.serviceManager.HealthcareService().DoctorCredentialService().CreateCredential(ctx, &pb.DoctorCredential{
+		DoctorId:           body.DoctorId,
+		Type:               body.Type,
+		IssuingBody:        body.IssuingBody,
+		Number:             body.Number,
+		IssueDate:          body.IssueDate,
+		ExpiryDate:         body.ExpiryDate,
+		DocumentObjectName: body.DocumentObjectName,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateDoctorCredential") {
+		return
+	}
+
+	c.JSON(http.StatusOK, doctorCredentialRes(credential))
+}
+
+// GetDoctorCredential ...
+// @Summary GetDoctorCredential
+// @Description GetDoctorCredential - API to get credential by ID
+// @Tags Doctor Credential
+// @Accept json
+// @Produce json
+// @Param id query int64 true "id"
+// @Success 200 {object} model_healthcare_service.DoctorCredential
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-credential/get [get]
+func (h *HandlerV1) GetDoctorCredential(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Query("id"), 10, 64)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "GetDoctorCredential") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	credential, err := h.serviceManager.HealthcareService().DoctorCredentialService().GetCredential(ctx, &pb.DoctorCredentialId{
+		Id: id,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetDoctorCredential") {
+		return
+	}
+
+	c.JSON(http.StatusOK, doctorCredentialRes(credential))
+}
+
+// ListDoctorCredentials ...
+// @Summary ListDoctorCredentials
+// @Description ListDoctorCredentials - API to list credentials, expiring selects the flagged licenses not expired yet, expired the licenses expired already
+// @Tags Doctor Credential
+// @Accept json
+// @Produce json
+// @Param doctor_id query string false "doctor_id"
+// @Param type query string false "type" Enums(diploma, license, certificate, other)
+// @Param expiring query bool false "expiring"
+// @Param expired query bool false "expired"
+// @Param page query uint64 false "page"
+// @Param limit query uint64 false "limit"
+// @Success 200 {object} model_healthcare_service.ListDoctorCredentials
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-credential [get]
+func (h *HandlerV1) ListDoctorCredentials(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListDoctorCredentials") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	credentials, err := h.serviceManager.HealthcareService().DoctorCredentialService().ListCredentials(ctx, &pb.ListDoctorCredentialsReq{
+		Page:     int64(pageInt),
+		Limit:    int64(limitInt),
+		DoctorId: c.Query("doctor_id"),
+		Type:     c.Query("type"),
+		Expiring: c.Query("expiring") == "true",
+		Expired:  c.Query("expired") == "true",
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListDoctorCredentials") {
+		return
+	}
+
+	var credentialsRes model_healthcare_service.ListDoctorCredentials
+	for _, credential := range credentials.Credentials {
+		credentialsRes.Credentials = append(credentialsRes.Credentials, doctorCredentialRes(credential))
+	}
+	credentialsRes.Count = credentials.Count
+
+	c.JSON(http.StatusOK, credentialsRes)
+}
+
+// UpdateDoctorCredential ...
+// @Summary UpdateDoctorCredential
+// @Description UpdateDoctorCredential - API to update a credential, a changed expiry date clears the expiry flag
+// @Tags Doctor Credential
+// @Accept json
+// @Produce json
+// @Param UpdateDoctorCredentialReq body model_healthcare_service.UpdateDoctorCredentialReq true "UpdateDoctorCredentialReq"
+// @Success 200 {object} model_healthcare_service.DoctorCredential
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-credential [put]
+func (h *HandlerV1) UpdateDoctorCredential(c *gin.Context) {
+	var body model_healthcare_service.UpdateDoctorCredentialReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateDoctorCredential") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	if body.DocumentObjectName != "" {
+		current, err := h.serviceManager.HealthcareService().DoctorCredentialService().GetCredential(ctx, &pb.DoctorCredentialId{
+			Id: body.Id,
+		})
+		if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateDoctorCredential") {
+			return
+		}
+		if !validCredentialDocument(body.DocumentObjectName, current.DoctorId) {
+			e.HandleError(c, errors.New("the document is not a credential document of the doctor"), h.log, http.StatusBadRequest, "UpdateDoctorCredential")
+			return
+		}
+	}
+
+	credential, err := h.serviceManager.HealthcareService().DoctorCredentialService().UpdateCredential(ctx, &pb.DoctorCredential{
+		Id:                 body.Id,
+		Type:               body.Type,
+		IssuingBody:        body.IssuingBody,
+		Number:             body.Number,
+		IssueDate:          body.IssueDate,
+		ExpiryDate:         body.ExpiryDate,
+		DocumentObjectName: body.DocumentObjectName,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateDoctorCredential") {
+		return
+	}
+
+	c.JSON(http.StatusOK, doctorCredentialRes(credential))
+}
+
+// DeleteDoctorCredential ...
+// @Summary DeleteDoctorCredential
+// @Description DeleteDoctorCredential - API to delete a credential
+// @Tags Doctor Credential
+// @Accept json
+// @Produce json
+// @Param id query int64 true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-credential [delete]
+func (h *HandlerV1) DeleteDoctorCredential(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Query("id"), 10, 64)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "DeleteDoctorCredential") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().DoctorCredentialService().DeleteCredential(ctx, &pb.DoctorCredentialId{
+		Id: id,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DeleteDoctorCredential") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
+
+// GetDoctorLicenseStatus ...
+// @Summary GetDoctorLicenseStatus
+// @Description GetDoctorLicenseStatus - API to check whether a doctor has a license valid on a date
+// @Tags Doctor Credential
+// @Accept json
+// @Produce json
+// @Param doctor_id query string true "doctor_id"
+// @Param date query string false "date, today when empty" example(2024-07-05)
+// @Success 200 {object} model_healthcare_service.DoctorLicenseStatus
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-credential/license-status [get]
+func (h *HandlerV1) GetDoctorLicenseStatus(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	licenseStatus, err := h.serviceManager.HealthcareService().DoctorCredentialService().GetDoctorLicenseStatus(ctx, &pb.DoctorLicenseStatusReq{
+		DoctorId: c.Query("doctor_id"),
+		Date:     c.Query("date"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetDoctorLicenseStatus") {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_healthcare_service.DoctorLicenseStatus{
+		DoctorId:   licenseStatus.DoctorId,
+		HasLicense: licenseStatus.HasLicense,
+		Expired:    licenseStatus.Expired,
+		ExpiryDate: licenseStatus.ExpiryDate,
+	})
+}
+
+// UploadDoctorCredentialDocument ...
+// @Summary UploadDoctorCredentialDocument
+// @Description UploadDoctorCredentialDocument - Api for upload the pdf, jpeg or png copy of a credential to the private document bucket, the returned object name is the document_object_name of the credential
+// @Tags Doctor Credential
+// @Accept multipart/form-data
+// @Produce json
+// @Param doctor_id query string true "doctor_id"
+// @Param file formData file true "file"
+// @Success 200 {object} model_minio.UploadedDocument
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 413 {object} model_common.StandardErrorModel
+// @Failure 415 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-credential/document [post]
+func (h *HandlerV1) UploadDoctorCredentialDocument(c *gin.Context) {
+	doctorId := c.Query("doctor_id")
+	_, err := uuid.Parse(doctorId)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UploadDoctorCredentialDocument") {
+		return
+	}
+
+	file, header, err := c.Request.FormFile("file")
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UploadDoctorCredentialDocument") {
+		return
+	}
+	defer file.Close()
+
+	maxSize := h.cfg.MinioService.DocumentMaxSize
+	if header.Size > maxSize {
+		e.HandleError(c, errors.New("the document is too large"), h.log, http.StatusRequestEntityTooLarge, "UploadDoctorCredentialDocument")
+		return
+	}
+	content, err := io.ReadAll(io.LimitReader(file, maxSize+1))
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UploadDoctorCredentialDocument") {
+		return
+	}
+	if int64(len(content)) > maxSize {
+		e.HandleError(c, errors.New("the document is too large"), h.log, http.StatusRequestEntityTooLarge, "UploadDoctorCredentialDocument")
+		return
+	}
+	// the type is the one of the content, not the one the client claims
+	contentType := http.DetectContentType(content)
+	extension, ok := documentContentTypes[contentType]
+	if !ok {
+		e.HandleError(c, errors.New("content type "+contentType+" is not accepted"), h.log, http.StatusUnsupportedMediaType, "UploadDoctorCredentialDocument")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	objectName := credentialDocumentPrefix(doctorId) + uuid.NewString() + extension
+	err = minio.PutObject(ctx, h.cfg, h.cfg.MinioService.DocumentBucket, objectName, content, contentType)
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UploadDoctorCredentialDocument") {
+		return
+	}
+
+	c.JSON(http.StatusOK, m.UploadedDocument{ObjectName: objectName})
+}
+
+// GetDoctorCredentialDocument ...
+// @Summary GetDoctorCredentialDocument
+// @Description GetDoctorCredentialDocument - Api for get a short-lived url of the document of a credential
+// @Tags Doctor Credential
+// @Accept json
+// @Produce json
+// @Param id query int64 true "id"
+// @Success 200 {object} model_minio.DocumentURL
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-credential/document [get]
+func (h *HandlerV1) GetDoctorCredentialDocument(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Query("id"), 10, 64)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "GetDoctorCredentialDocument") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	credential, err := h.serviceManager.HealthcareService().DoctorCredentialService().GetCredential(ctx, &pb.DoctorCredentialId{
+		Id: id,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetDoctorCredentialDocument") {
+		return
+	}
+	if credential.DocumentObjectName == "" {
+		e.HandleError(c, errors.New("the credential has no document"), h.log, http.StatusNotFound, "GetDoctorCredentialDocument")
+		return
+	}
+
+	expiresAt := time.Now().Add(h.cfg.MinioService.DocumentURLExpiry)
+	documentURL, err := minio.PresignDownload(ctx, h.cfg, h.cfg.MinioService.DocumentBucket, credential.DocumentObjectName, h.cfg.MinioService.DocumentURLExpiry)
+	if errors.Is(err, minio.ErrObjectNotFound) {
+		e.HandleError(c, err, h.log, http.StatusNotFound, "GetDoctorCredentialDocument")
+		return
+	}
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetDoctorCredentialDocument") {
+		return
+	}
+
+	c.JSON(http.StatusOK, m.DocumentURL{
+		URL:       documentURL,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+	})
+}
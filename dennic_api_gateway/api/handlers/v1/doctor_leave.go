@@ -0,0 +1,265 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models/model_healthcare_service"
+	pb "dennic_admin_api_gateway/genproto/healthcare-service"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func doctorLeaveRes(leave *pb.DoctorLeave) *model_healthcare_service.DoctorLeave {
+	return &model_healthcare_service.DoctorLeave{
+		Id:           leave.Id,
+		DoctorId:     leave.DoctorId,
+		DepartmentId: leave.DepartmentId,
+		Kind:         leave.Kind,
+		StartDate:    leave.StartDate,
+		EndDate:      leave.EndDate,
+		Reason:       leave.Reason,
+		Status:       leave.Status,
+		ReviewedBy:   leave.ReviewedBy,
+		ReviewNote:   leave.ReviewNote,
+		ReviewedAt:   leave.ReviewedAt,
+		CreatedAt:    leave.CreatedAt,
+		UpdatedAt:    e.UpdateTimeFilter(leave.UpdatedAt),
+	}
+}
+
+// CreateDoctorLeave ...
+// @Summary CreateDoctorLeave
+// @Description CreateDoctorLeave - Api for request a leave of a doctor, the leave waits for the review of an admin
+// @Tags Doctor Leave
+// @Accept json
+// @Produce json
+// @Param CreateDoctorLeaveReq body model_healthcare_service.CreateDoctorLeaveReq true "CreateDoctorLeaveReq"
+// @Success 200 {object} model_healthcare_service.DoctorLeave
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-leave [post]
+func (h *HandlerV1) CreateDoctorLeave(c *gin.Context) {
+	var body model_healthcare_service.CreateDoctorLeaveReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateDoctorLeave") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	leave, err := h.serviceManager.HealthcareService().DoctorLeaveService().CreateLeave(ctx, &pb.DoctorLeave{
+		DoctorId:  body.DoctorId,
+		Kind:      body.Kind,
+		StartDate: body.StartDate,
+		EndDate:   body.EndDate,
+		Reason:    body.Reason,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateDoctorLeave") {
+		return
+	}
+
+	c.JSON(http.StatusOK, doctorLeaveRes(leave))
+}
+
+// GetDoctorLeave ...
+// @Summary GetDoctorLeave
+// @Description GetDoctorLeave - API to get leave by ID
+// @Tags Doctor Leave
+// @Accept json
+// @Produce json
+// @Param id query int64 true "id"
+// @Success 200 {object} model_healthcare_service.DoctorLeave
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-leave/get [get]
+func (h *HandlerV1) GetDoctorLeave(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Query("id"), 10, 64)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "GetDoctorLeave") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	leave, err := h.serviceManager.HealthcareService().DoctorLeaveService().GetLeave(ctx, &pb.DoctorLeaveId{
+		Id: id,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetDoctorLeave") {
+		return
+	}
+
+	c.JSON(http.StatusOK, doctorLeaveRes(leave))
+}
+
+// ListDoctorLeaves ...
+// @Summary ListDoctorLeaves
+// @Description ListDoctorLeaves - API to list leaves, from and to select the leaves touching the range
+// @Tags Doctor Leave
+// @Accept json
+// @Produce json
+// @Param doctor_id query string false "doctor_id"
+// @Param status query string false "status" Enums(pending, approved, rejected, cancelled)
+// @Param from query string false "from" example(2024-07-01)
+// @Param to query string false "to" example(2024-07-31)
+// @Param page query uint64 false "page"
+// @Param limit query uint64 false "limit"
+// @Success 200 {object} model_healthcare_service.ListDoctorLeaves
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-leave [get]
+func (h *HandlerV1) ListDoctorLeaves(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListDoctorLeaves") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	leaves, err := h.serviceManager.HealthcareService().DoctorLeaveService().ListLeaves(ctx, &pb.ListDoctorLeavesReq{
+		Page:     int64(pageInt),
+		Limit:    int64(limitInt),
+		DoctorId: c.Query("doctor_id"),
+		Status:   c.Query("status"),
+		From:     c.Query("from"),
+		To:       c.Query("to"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListDoctorLeaves") {
+		return
+	}
+
+	var leavesRes model_healthcare_service.ListDoctorLeaves
+	for _, leave := range leaves.Leaves {
+		leavesRes.Leaves = append(leavesRes.Leaves, doctorLeaveRes(leave))
+	}
+	leavesRes.Count = leaves.Count
+
+	c.JSON(http.StatusOK, leavesRes)
+}
+
+// ReviewDoctorLeave ...
+// @Summary ReviewDoctorLeave
+// @Description ReviewDoctorLeave - API to approve or reject a pending leave, the days of an approved leave are unavailable for booking
+// @Tags Doctor Leave
+// @Accept json
+// @Produce json
+// @Param ReviewDoctorLeaveReq body model_healthcare_service.ReviewDoctorLeaveReq true "ReviewDoctorLeaveReq"
+// @Success 200 {object} model_healthcare_service.DoctorLeave
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-leave/review [put]
+func (h *HandlerV1) ReviewDoctorLeave(c *gin.Context) {
+	var body model_healthcare_service.ReviewDoctorLeaveReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ReviewDoctorLeave") {
+		return
+	}
+
+	// the reviewer is known only when the admin is logged in
+	var reviewedBy string
+	if userInfo, err := e.GetUserInfo(c); err == nil {
+		reviewedBy = userInfo.UserId
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	leave, err := h.serviceManager.HealthcareService().DoctorLeaveService().ReviewLeave(ctx, &pb.ReviewDoctorLeaveReq{
+		Id:         body.Id,
+		Status:     body.Status,
+		ReviewedBy: reviewedBy,
+		ReviewNote: body.ReviewNote,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ReviewDoctorLeave") {
+		return
+	}
+
+	c.JSON(http.StatusOK, doctorLeaveRes(leave))
+}
+
+// CancelDoctorLeave ...
+// @Summary CancelDoctorLeave
+// @Description CancelDoctorLeave - API to cancel a pending or approved leave, the days of an approved leave are available again
+// @Tags Doctor Leave
+// @Accept json
+// @Produce json
+// @Param id query int64 true "id"
+// @Success 200 {object} model_healthcare_service.DoctorLeave
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-leave/cancel [put]
+func (h *HandlerV1) CancelDoctorLeave(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Query("id"), 10, 64)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CancelDoctorLeave") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	leave, err := h.serviceManager.HealthcareService().DoctorLeaveService().CancelLeave(ctx, &pb.DoctorLeaveId{
+		Id: id,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CancelDoctorLeave") {
+		return
+	}
+
+	c.JSON(http.StatusOK, doctorLeaveRes(leave))
+}
+
+// ListAwayDoctors ...
+// @Summary ListAwayDoctors
+// @Description ListAwayDoctors - API to list the doctors on an approved leave on a date, per department
+// @Tags Doctor Leave
+// @Accept json
+// @Produce json
+// @Param date query string false "date, today when empty" example(2024-07-05)
+// @Param department_id query string false "department_id, every department when empty"
+// @Success 200 {object} model_healthcare_service.ListAwayDoctors
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-leave/away [get]
+func (h *HandlerV1) ListAwayDoctors(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	doctors, err := h.serviceManager.HealthcareService().DoctorLeaveService().ListAwayDoctors(ctx, &pb.AwayDoctorsReq{
+		Date:         c.Query("date"),
+		DepartmentId: c.Query("department_id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListAwayDoctors") {
+		return
+	}
+
+	var doctorsRes model_healthcare_service.ListAwayDoctors
+	for _, doctor := range doctors.Doctors {
+		doctorsRes.Doctors = append(doctorsRes.Doctors, &model_healthcare_service.AwayDoctor{
+			DoctorId:     doctor.DoctorId,
+			FirstName:    doctor.FirstName,
+			LastName:     doctor.LastName,
+			DepartmentId: doctor.DepartmentId,
+			LeaveId:      doctor.LeaveId,
+			Kind:         doctor.Kind,
+			StartDate:    doctor.StartDate,
+			EndDate:      doctor.EndDate,
+		})
+	}
+
+	c.JSON(http.StatusOK, doctorsRes)
+}
@@ -0,0 +1,315 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models/model_booking_service"
+	"dennic_admin_api_gateway/api/models/model_healthcare_service"
+	pb "dennic_admin_api_gateway/genproto/booking_service"
+	"dennic_admin_api_gateway/genproto/healthcare-service"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const doctorRole = "doctor"
+
+func appointmentRes(appointment *pb.Appointment) *model_booking_service.Appointment {
+	return &model_booking_service.Appointment{
+		Id:              appointment.Id,
+		DepartmentId:    appointment.DepartmentId,
+		DoctorId:        appointment.DoctorId,
+		PatientId:       appointment.PatientId,
+		AppointmentDate: appointment.AppointmentDate,
+		AppointmentTime: appointment.AppointmentTime,
+		Duration:        appointment.Duration,
+		Key:             appointment.Key,
+		ExpiresAt:       appointment.ExpiresAt,
+		PatientStatus:   appointment.Status,
+		PatientProblem:  appointment.PatientProblem,
+		DoctorServiceId: appointment.DoctorServiceId,
+		PaymentType:     appointment.PaymentType,
+		PaymentAmount:   float64(appointment.PaymentAmount),
+		ResourceId:      appointment.ResourceId,
+		PromoCode:       appointment.PromoCode,
+		DiscountAmount:  float64(appointment.DiscountAmount),
+		CreatedAt:       appointment.CreatedAt,
+		UpdatedAt:       e.UpdateTimeFilter(appointment.UpdatedAt),
+	}
+}
+
+// loggedDoctorId returns the id of the doctor the token was issued to
+func loggedDoctorId(c *gin.Context) (string, error) {
+	userInfo, err := e.GetUserInfo(c)
+	if err != nil {
+		return "", err
+	}
+	if userInfo.Role != doctorRole || userInfo.UserId == "" {
+		return "", errors.New("the token was not issued to a doctor")
+	}
+	return userInfo.UserId, nil
+}
+
+// DoctorLogin ...
+// @Summary DoctorLogin
+// @Description DoctorLogin - Api for login a doctor with the email and password, the tokens have the role doctor
+// @Tags Doctor Portal
+// @Accept json
+// @Produce json
+// @Param DoctorLoginReq body model_healthcare_service.DoctorLoginReq true "DoctorLoginReq"
+// @Success 200 {object} model_healthcare_service.DoctorLoginRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-portal/login [post]
+func (h *HandlerV1) DoctorLogin(c *gin.Context) {
+	var body model_healthcare_service.DoctorLoginReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "DoctorLogin") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	doctor, err := h.serviceManager.HealthcareService().DoctorService().GetDoctorById(ctx, &healthcare.GetReqStrDoctor{
+		Field:    "email",
+		Value:    body.Email,
+		IsActive: false,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, NOT_REGISTERED) {
+		return
+	}
+
+	if !e.CheckHashPassword(doctor.Password, body.Password) {
+		err = errors.New("incorrect password")
+		_ = e.HandleError(c, err, h.log, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	access, refresh, err := h.jwthandler.GenerateAuthJWT(doctor.PhoneNumber, doctor.Id, uuid.NewString(), doctorRole)
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, SERVICE_ERROR) {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_healthcare_service.DoctorLoginRes{
+		Id:           doctor.Id,
+		FirstName:    doctor.FirstName,
+		LastName:     doctor.LastName,
+		Email:        doctor.Email,
+		AccessToken:  access,
+		RefreshToken: refresh,
+	})
+}
+
+// DoctorAgenda ...
+// @Summary DoctorAgenda
+// @Description DoctorAgenda - API to list the appointments of the logged doctor on a day, today when date is empty
+// @Tags Doctor Portal
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param date query string false "date" example(2024-01-15)
+// @Success 200 {object} model_booking_service.AppointmentsType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-portal/agenda [get]
+func (h *HandlerV1) DoctorAgenda(c *gin.Context) {
+	doctorId, err := loggedDoctorId(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "DoctorAgenda") {
+		return
+	}
+
+	day := time.Now()
+	if c.Query("date") != "" {
+		day, err = time.Parse("2006-01-02", c.Query("date"))
+		if e.HandleError(c, err, h.log, http.StatusBadRequest, "DoctorAgenda") {
+			return
+		}
+	}
+	from := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().BookedAppointment().ListDoctorAppointments(ctx, &pb.DoctorAppointmentsReq{
+		DoctorId: doctorId,
+		From:     from.Format("2006-01-02 15:04:05"),
+		To:       from.AddDate(0, 0, 1).Format("2006-01-02 15:04:05"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DoctorAgenda") {
+		return
+	}
+
+	var response model_booking_service.AppointmentsType
+	for _, appointment := range res.Appointments {
+		response.Appointments = append(response.Appointments, appointmentRes(appointment))
+	}
+	response.Count = res.Count
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DoctorUpcomingAppointments ...
+// @Summary DoctorUpcomingAppointments
+// @Description DoctorUpcomingAppointments - API to list the waiting appointments of the logged doctor from now on
+// @Tags Doctor Portal
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param page query uint64 false "page"
+// @Param limit query uint64 false "limit"
+// @Success 200 {object} model_booking_service.AppointmentsType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-portal/upcoming [get]
+func (h *HandlerV1) DoctorUpcomingAppointments(c *gin.Context) {
+	doctorId, err := loggedDoctorId(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "DoctorUpcomingAppointments") {
+		return
+	}
+
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "DoctorUpcomingAppointments") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().BookedAppointment().ListDoctorAppointments(ctx, &pb.DoctorAppointmentsReq{
+		DoctorId: doctorId,
+		From:     time.Now().Format("2006-01-02 15:04:05"),
+		Status:   "waiting",
+		Page:     pageInt,
+		Limit:    limitInt,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DoctorUpcomingAppointments") {
+		return
+	}
+
+	var response model_booking_service.AppointmentsType
+	for _, appointment := range res.Appointments {
+		response.Appointments = append(response.Appointments, appointmentRes(appointment))
+	}
+	response.Count = res.Count
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DoctorWriteNote ...
+// @Summary DoctorWriteNote
+// @Description DoctorWriteNote - API to write the note of an appointment of the logged doctor
+// @Tags Doctor Portal
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param DoctorPortalNoteReq body model_booking_service.DoctorPortalNoteReq true "DoctorPortalNoteReq"
+// @Success 200 {object} model_booking_service.DoctorNote
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-portal/note [post]
+func (h *HandlerV1) DoctorWriteNote(c *gin.Context) {
+	doctorId, err := loggedDoctorId(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "DoctorWriteNote") {
+		return
+	}
+
+	var body model_booking_service.DoctorPortalNoteReq
+
+	err = c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "DoctorWriteNote") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	appointment, err := h.serviceManager.BookingService().BookedAppointment().GetAppointment(ctx, &pb.AppointmentFieldValueReq{
+		Field: "id",
+		Value: strconv.FormatInt(body.AppointmentId, 10),
+	})
+
+	// the appointment of another doctor is reported as missing
+	if err == nil && appointment.DoctorId != doctorId {
+		err = errors.New("appointment not found")
+	}
+	if e.HandleError(c, err, h.log, http.StatusNotFound, "DoctorWriteNote") {
+		return
+	}
+
+	doctorNote, err := h.serviceManager.BookingService().DoctorNotes().CreateDoctorNote(ctx, &pb.CreateDoctorNoteReq{
+		AppointmentId: appointment.Id,
+		DoctorId:      doctorId,
+		PatientId:     appointment.PatientId,
+		Prescription:  body.Prescription,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DoctorWriteNote") {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_booking_service.DoctorNote{
+		Id:            doctorNote.Id,
+		AppointmentId: doctorNote.AppointmentId,
+		DoctorId:      doctorNote.DoctorId,
+		PatientId:     doctorNote.PatientId,
+		Prescription:  doctorNote.Prescription,
+		CreatedAt:     doctorNote.CreatedAt,
+		UpdatedAt:     e.UpdateTimeFilter(doctorNote.UpdatedAt),
+	})
+}
+
+// DoctorMarkAttendance ...
+// @Summary DoctorMarkAttendance
+// @Description DoctorMarkAttendance - API to mark an appointment of the logged doctor attended or no_show
+// @Tags Doctor Portal
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param AttendanceReq body model_booking_service.AttendanceReq true "AttendanceReq"
+// @Success 200 {object} model_booking_service.Appointment
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-portal/attendance [put]
+func (h *HandlerV1) DoctorMarkAttendance(c *gin.Context) {
+	doctorId, err := loggedDoctorId(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "DoctorMarkAttendance") {
+		return
+	}
+
+	var body model_booking_service.AttendanceReq
+
+	err = c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "DoctorMarkAttendance") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().BookedAppointment().MarkAttendance(ctx, &pb.MarkAttendanceReq{
+		Id:       body.AppointmentId,
+		DoctorId: doctorId,
+		Status:   body.Status,
+	})
+
+	if e.HandleError(c, err, h.log, bookingErrorStatus(err), "DoctorMarkAttendance") {
+		return
+	}
+
+	c.JSON(http.StatusOK, appointmentRes(res))
+}
@@ -0,0 +1,208 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models"
+	"dennic_admin_api_gateway/api/models/model_booking_service"
+	pb "dennic_admin_api_gateway/genproto/booking_service"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func reviewRes(review *pb.Review) *model_booking_service.Review {
+	return &model_booking_service.Review{
+		Id:             review.Id,
+		AppointmentId:  review.AppointmentId,
+		DoctorId:       review.DoctorId,
+		PatientId:      review.PatientId,
+		Rating:         review.Rating,
+		Comment:        review.Comment,
+		Status:         review.Status,
+		ModerationNote: review.ModerationNote,
+		ModeratedAt:    e.UpdateTimeFilter(review.ModeratedAt),
+		CreatedAt:      review.CreatedAt,
+		UpdatedAt:      e.UpdateTimeFilter(review.UpdatedAt),
+	}
+}
+
+// CreateReview ...
+// @Summary CreateReview
+// @Description CreateReview - Api for create a review of an attended appointment, the review waits for moderation
+// @Tags Doctor Review
+// @Accept json
+// @Produce json
+// @Param CreateReviewReq body model_booking_service.CreateReviewReq true "CreateReviewReq"
+// @Success 200 {object} model_booking_service.Review
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/review [post]
+func (h *HandlerV1) CreateReview(c *gin.Context) {
+	var body model_booking_service.CreateReviewReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateReview") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	review, err := h.serviceManager.BookingService().DoctorReviews().CreateReview(ctx, &pb.CreateReviewReq{
+		AppointmentId: body.AppointmentId,
+		PatientId:     body.PatientId,
+		Rating:        body.Rating,
+		Comment:       body.Comment,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateReview") {
+		return
+	}
+
+	c.JSON(http.StatusOK, reviewRes(review))
+}
+
+// GetReview ...
+// @Summary GetReview
+// @Description GetReview - API to get review by ID
+// @Tags Doctor Review
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.Review
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/review/get [get]
+func (h *HandlerV1) GetReview(c *gin.Context) {
+	id := c.Query("id")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	review, err := h.serviceManager.BookingService().DoctorReviews().GetReview(ctx, &pb.ReviewFieldValueReq{
+		Field:    "id",
+		Value:    id,
+		IsActive: false,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetReview") {
+		return
+	}
+
+	c.JSON(http.StatusOK, reviewRes(review))
+}
+
+// ListReviews ...
+// @Summary ListReviews
+// @Description ListReviews - API to list reviews, patients see approved reviews of a doctor with status=approved
+// @Tags Doctor Review
+// @Accept json
+// @Produce json
+// @Param doctor_id query string false "doctor_id"
+// @Param patient_id query string false "patient_id"
+// @Param status query string false "status" Enums(pending, approved, rejected)
+// @Param page query uint64 false "page"
+// @Param limit query uint64 false "limit"
+// @Success 200 {object} model_booking_service.ReviewsType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/review [get]
+func (h *HandlerV1) ListReviews(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListReviews") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	reviews, err := h.serviceManager.BookingService().DoctorReviews().GetAllReviews(ctx, &pb.GetAllReviewsReq{
+		DoctorId:  c.Query("doctor_id"),
+		PatientId: c.Query("patient_id"),
+		Status:    c.Query("status"),
+		IsActive:  false,
+		Page:      pageInt,
+		Limit:     limitInt,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListReviews") {
+		return
+	}
+
+	var reviewsRes model_booking_service.ReviewsType
+	for _, review := range reviews.Reviews {
+		reviewsRes.Reviews = append(reviewsRes.Reviews, reviewRes(review))
+	}
+	reviewsRes.Count = reviews.Count
+
+	c.JSON(http.StatusOK, reviewsRes)
+}
+
+// ModerateReview ...
+// @Summary ModerateReview
+// @Description ModerateReview - API to approve or reject a review, the doctor rating is recalculated from the approved reviews
+// @Tags Doctor Review
+// @Accept json
+// @Produce json
+// @Param ModerateReviewReq body model_booking_service.ModerateReviewReq true "ModerateReviewReq"
+// @Success 200 {object} model_booking_service.Review
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/review/moderate [put]
+func (h *HandlerV1) ModerateReview(c *gin.Context) {
+	var body model_booking_service.ModerateReviewReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ModerateReview") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	review, err := h.serviceManager.BookingService().DoctorReviews().ModerateReview(ctx, &pb.ModerateReviewReq{
+		Id:             body.Id,
+		Status:         body.Status,
+		ModerationNote: body.ModerationNote,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ModerateReview") {
+		return
+	}
+
+	c.JSON(http.StatusOK, reviewRes(review))
+}
+
+// DeleteReview ...
+// @Summary DeleteReview
+// @Description DeleteReview - API to delete a review
+// @Tags Doctor Review
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/review [delete]
+func (h *HandlerV1) DeleteReview(c *gin.Context) {
+	id := c.Query("id")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.BookingService().DoctorReviews().DeleteReview(ctx, &pb.ReviewFieldValueReq{
+		Field:    "id",
+		Value:    id,
+		IsActive: false,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DeleteReview") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
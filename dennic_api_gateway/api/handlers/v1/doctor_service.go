@@ -41,13 +41,14 @@ func (h *HandlerV1) CreateDoctorService(c *gin.Context) {
 	defer cancel()
 
 	doctorServices, err := h.serviceManager.HealthcareService().DoctorsService().CreateDoctorServices(ctx, &pb.DoctorServices{
-		Id:               uuid.NewString(),
-		DoctorId:         body.DoctorId,
-		SpecializationId: body.SpecializationId,
-		OnlinePrice:      body.OnlinePrice,
-		OfflinePrice:     body.OfflinePrice,
-		Name:             body.Name,
-		Duration:         body.Duration,
+		Id:                   uuid.NewString(),
+		DoctorId:             body.DoctorId,
+		SpecializationId:     body.SpecializationId,
+		OnlinePrice:          body.OnlinePrice,
+		OfflinePrice:         body.OfflinePrice,
+		Name:                 body.Name,
+		Duration:             body.Duration,
+		RequiredResourceType: body.RequiredResourceType,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateDoctorService") {
@@ -55,16 +56,17 @@ func (h *HandlerV1) CreateDoctorService(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, model_healthcare_service.DoctorServicesRes{
-		Id:               doctorServices.Id,
-		Order:            doctorServices.DoctorServiceOrder,
-		DoctorId:         doctorServices.DoctorId,
-		SpecializationId: doctorServices.SpecializationId,
-		OnlinePrice:      doctorServices.OnlinePrice,
-		OfflinePrice:     doctorServices.OfflinePrice,
-		Name:             doctorServices.Name,
-		Duration:         doctorServices.Duration,
-		CreatedAt:        doctorServices.CreatedAt,
-		UpdatedAt:        e.UpdateTimeFilter(doctorServices.UpdatedAt),
+		Id:                   doctorServices.Id,
+		Order:                doctorServices.DoctorServiceOrder,
+		DoctorId:             doctorServices.DoctorId,
+		SpecializationId:     doctorServices.SpecializationId,
+		OnlinePrice:          doctorServices.OnlinePrice,
+		OfflinePrice:         doctorServices.OfflinePrice,
+		Name:                 doctorServices.Name,
+		Duration:             doctorServices.Duration,
+		RequiredResourceType: doctorServices.RequiredResourceType,
+		CreatedAt:            doctorServices.CreatedAt,
+		UpdatedAt:            e.UpdateTimeFilter(doctorServices.UpdatedAt),
 	})
 }
 
@@ -75,13 +77,14 @@ func (h *HandlerV1) CreateDoctorService(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id query string true "id"
+// @Param Accept-Language header string false "uz, ru or en"
 // @Success 200 {object} model_healthcare_service.DoctorServicesRes
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
 // @Router /v1/doctor-services/get [get]
 func (h *HandlerV1) GetDoctorService(c *gin.Context) {
 	id := c.Query("id")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	ctx, cancel := context.WithTimeout(e.LanguageContext(context.Background(), c), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
 	doctorServices, err := h.serviceManager.HealthcareService().DoctorsService().GetDoctorServiceByID(ctx, &pb.GetReqStr{
@@ -95,16 +98,17 @@ func (h *HandlerV1) GetDoctorService(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, model_healthcare_service.DoctorServicesRes{
-		Id:               doctorServices.Id,
-		Order:            doctorServices.DoctorServiceOrder,
-		DoctorId:         doctorServices.DoctorId,
-		SpecializationId: doctorServices.SpecializationId,
-		OnlinePrice:      doctorServices.OnlinePrice,
-		OfflinePrice:     doctorServices.OfflinePrice,
-		Name:             doctorServices.Name,
-		Duration:         doctorServices.Duration,
-		CreatedAt:        doctorServices.CreatedAt,
-		UpdatedAt:        e.UpdateTimeFilter(doctorServices.UpdatedAt),
+		Id:                   doctorServices.Id,
+		Order:                doctorServices.DoctorServiceOrder,
+		DoctorId:             doctorServices.DoctorId,
+		SpecializationId:     doctorServices.SpecializationId,
+		OnlinePrice:          doctorServices.OnlinePrice,
+		OfflinePrice:         doctorServices.OfflinePrice,
+		Name:                 doctorServices.Name,
+		Duration:             doctorServices.Duration,
+		RequiredResourceType: doctorServices.RequiredResourceType,
+		CreatedAt:            doctorServices.CreatedAt,
+		UpdatedAt:            e.UpdateTimeFilter(doctorServices.UpdatedAt),
 	})
 }
 
@@ -115,6 +119,7 @@ func (h *HandlerV1) GetDoctorService(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param ListReq query model_healthcare_service.ListReqDoctorServices false "ListReq"
+// @Param Accept-Language header string false "uz, ru or en"
 // @Success 200 {object} model_healthcare_service.ListDoctorServices
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -129,7 +134,7 @@ func (h *HandlerV1) ListDoctorServices(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	ctx, cancel := context.WithTimeout(e.LanguageContext(context.Background(), c), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
 	doctorServicess, err := h.serviceManager.HealthcareService().DoctorsService().GetAllDoctorServices(ctx, &pb.GetAllDoctorServiceS{
@@ -147,16 +152,17 @@ func (h *HandlerV1) ListDoctorServices(c *gin.Context) {
 	var doctorServicessRes model_healthcare_service.ListDoctorServices
 	for _, doctorServicesRes := range doctorServicess.DoctorServices {
 		doctorServicessRes.DoctorServices = append(doctorServicessRes.DoctorServices, &model_healthcare_service.DoctorServicesRes{
-			Id:               doctorServicesRes.Id,
-			Order:            doctorServicesRes.DoctorServiceOrder,
-			DoctorId:         doctorServicesRes.DoctorId,
-			SpecializationId: doctorServicesRes.SpecializationId,
-			OnlinePrice:      doctorServicesRes.OnlinePrice,
-			OfflinePrice:     doctorServicesRes.OfflinePrice,
-			Name:             doctorServicesRes.Name,
-			Duration:         doctorServicesRes.Duration,
-			CreatedAt:        doctorServicesRes.CreatedAt,
-			UpdatedAt:        e.UpdateTimeFilter(doctorServicesRes.UpdatedAt),
+			Id:                   doctorServicesRes.Id,
+			Order:                doctorServicesRes.DoctorServiceOrder,
+			DoctorId:             doctorServicesRes.DoctorId,
+			SpecializationId:     doctorServicesRes.SpecializationId,
+			OnlinePrice:          doctorServicesRes.OnlinePrice,
+			OfflinePrice:         doctorServicesRes.OfflinePrice,
+			Name:                 doctorServicesRes.Name,
+			Duration:             doctorServicesRes.Duration,
+			RequiredResourceType: doctorServicesRes.RequiredResourceType,
+			CreatedAt:            doctorServicesRes.CreatedAt,
+			UpdatedAt:            e.UpdateTimeFilter(doctorServicesRes.UpdatedAt),
 		})
 	}
 
@@ -194,13 +200,14 @@ func (h *HandlerV1) UpdateDoctorServices(c *gin.Context) {
 	defer cancel()
 
 	doctorServices, err := h.serviceManager.HealthcareService().DoctorsService().UpdateDoctorServices(ctx, &pb.DoctorServices{
-		Id:               body.Id,
-		DoctorId:         body.DoctorId,
-		SpecializationId: body.SpecializationId,
-		OnlinePrice:      body.OnlinePrice,
-		OfflinePrice:     body.OfflinePrice,
-		Name:             body.Name,
-		Duration:         body.Duration,
+		Id:                   body.Id,
+		DoctorId:             body.DoctorId,
+		SpecializationId:     body.SpecializationId,
+		OnlinePrice:          body.OnlinePrice,
+		OfflinePrice:         body.OfflinePrice,
+		Name:                 body.Name,
+		Duration:             body.Duration,
+		RequiredResourceType: body.RequiredResourceType,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateDoctorServices") {
@@ -208,16 +215,17 @@ func (h *HandlerV1) UpdateDoctorServices(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, model_healthcare_service.DoctorServicesRes{
-		Id:               doctorServices.Id,
-		Order:            doctorServices.DoctorServiceOrder,
-		DoctorId:         doctorServices.DoctorId,
-		SpecializationId: doctorServices.SpecializationId,
-		OnlinePrice:      doctorServices.OnlinePrice,
-		OfflinePrice:     doctorServices.OfflinePrice,
-		Name:             doctorServices.Name,
-		Duration:         doctorServices.Duration,
-		CreatedAt:        doctorServices.CreatedAt,
-		UpdatedAt:        e.UpdateTimeFilter(doctorServices.UpdatedAt),
+		Id:                   doctorServices.Id,
+		Order:                doctorServices.DoctorServiceOrder,
+		DoctorId:             doctorServices.DoctorId,
+		SpecializationId:     doctorServices.SpecializationId,
+		OnlinePrice:          doctorServices.OnlinePrice,
+		OfflinePrice:         doctorServices.OfflinePrice,
+		Name:                 doctorServices.Name,
+		Duration:             doctorServices.Duration,
+		RequiredResourceType: doctorServices.RequiredResourceType,
+		CreatedAt:            doctorServices.CreatedAt,
+		UpdatedAt:            e.UpdateTimeFilter(doctorServices.UpdatedAt),
 	})
 }
 
@@ -250,3 +258,29 @@ func (h *HandlerV1) DeleteDoctorService(c *gin.Context) {
 
 	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
 }
+
+// RestoreDoctorService ...
+// @Summary RestoreDoctorService
+// @Description RestoreDoctorService - Api for restore a deleted doctor service together with the records its delete cascaded to
+// @Tags Doctor Services
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-services/restore [put]
+func (h *HandlerV1) RestoreDoctorService(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().DoctorsService().RestoreDoctorService(ctx, &pb.RestoreDoctorServiceReq{
+		Id: c.Query("id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "RestoreDoctorService") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
@@ -16,7 +16,7 @@ import (
 
 // CreateDoctorWorkingHours ...
 // @Summary CreateDoctorWorkingHours
-// @Description CreateDoctorWorkingHours - Api for crete doctor_working_hours
+// @Description CreateDoctorWorkingHours - Api for crete doctor_working_hours, a shift or a lunch break of a weekday, shifts of a day may not overlap and breaks have to lie within a shift
 // @Tags Doctor Working Hours
 // @Accept json
 // @Produce json
@@ -42,10 +42,13 @@ func (h *HandlerV1) CreateDoctorWorkingHours(c *gin.Context) {
 	defer cancel()
 
 	dwh, err := h.serviceManager.HealthcareService().DoctorWorkingHoursService().CreateDoctorWorkingHours(ctx, &pb.DoctorWorkingHours{
-		DoctorId:   body.DoctorId,
-		DayOfWeek:  body.DayOfWeek,
-		StartTime:  body.StartTime,
-		FinishTime: body.FinishTime,
+		DoctorId:      body.DoctorId,
+		DayOfWeek:     body.DayOfWeek,
+		Kind:          body.Kind,
+		StartTime:     body.StartTime,
+		FinishTime:    body.FinishTime,
+		EffectiveFrom: body.EffectiveFrom,
+		EffectiveTo:   body.EffectiveTo,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateDoctorWorkingHours") {
@@ -53,13 +56,16 @@ func (h *HandlerV1) CreateDoctorWorkingHours(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, model_healthcare_service.DoctorWorkingHoursRes{
-		Id:         dwh.Id,
-		DoctorId:   dwh.DoctorId,
-		DayOfWeek:  dwh.DayOfWeek,
-		StartTime:  dwh.StartTime,
-		FinishTime: dwh.FinishTime,
-		CreatedAt:  dwh.CreatedAt,
-		UpdatedAt:  e.UpdateTimeFilter(dwh.UpdatedAt),
+		Id:            dwh.Id,
+		DoctorId:      dwh.DoctorId,
+		DayOfWeek:     dwh.DayOfWeek,
+		Kind:          dwh.Kind,
+		StartTime:     dwh.StartTime,
+		FinishTime:    dwh.FinishTime,
+		EffectiveFrom: dwh.EffectiveFrom,
+		EffectiveTo:   dwh.EffectiveTo,
+		CreatedAt:     dwh.CreatedAt,
+		UpdatedAt:     e.UpdateTimeFilter(dwh.UpdatedAt),
 	})
 }
 
@@ -94,13 +100,16 @@ func (h *HandlerV1) GetDoctorWorkingHours(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, model_healthcare_service.DoctorWorkingHoursRes{
-		Id:         dwh.Id,
-		DoctorId:   dwh.DoctorId,
-		DayOfWeek:  dwh.DayOfWeek,
-		StartTime:  dwh.StartTime,
-		FinishTime: dwh.FinishTime,
-		CreatedAt:  dwh.CreatedAt,
-		UpdatedAt:  e.UpdateTimeFilter(dwh.UpdatedAt),
+		Id:            dwh.Id,
+		DoctorId:      dwh.DoctorId,
+		DayOfWeek:     dwh.DayOfWeek,
+		Kind:          dwh.Kind,
+		StartTime:     dwh.StartTime,
+		FinishTime:    dwh.FinishTime,
+		EffectiveFrom: dwh.EffectiveFrom,
+		EffectiveTo:   dwh.EffectiveTo,
+		CreatedAt:     dwh.CreatedAt,
+		UpdatedAt:     e.UpdateTimeFilter(dwh.UpdatedAt),
 	})
 }
 
@@ -146,13 +155,16 @@ func (h *HandlerV1) ListDoctorWorkingHours(c *gin.Context) {
 	var dwhsRes model_healthcare_service.ListDoctorWorkingHours
 	for _, dwhRes := range dwhs.Dwh {
 		dwhsRes.ListDWH = append(dwhsRes.ListDWH, &model_healthcare_service.DoctorWorkingHoursRes{
-			Id:         dwhRes.Id,
-			DoctorId:   dwhRes.DoctorId,
-			DayOfWeek:  dwhRes.DayOfWeek,
-			StartTime:  dwhRes.StartTime,
-			FinishTime: dwhRes.FinishTime,
-			CreatedAt:  dwhRes.CreatedAt,
-			UpdatedAt:  e.UpdateTimeFilter(dwhRes.UpdatedAt),
+			Id:            dwhRes.Id,
+			DoctorId:      dwhRes.DoctorId,
+			DayOfWeek:     dwhRes.DayOfWeek,
+			Kind:          dwhRes.Kind,
+			StartTime:     dwhRes.StartTime,
+			FinishTime:    dwhRes.FinishTime,
+			EffectiveFrom: dwhRes.EffectiveFrom,
+			EffectiveTo:   dwhRes.EffectiveTo,
+			CreatedAt:     dwhRes.CreatedAt,
+			UpdatedAt:     e.UpdateTimeFilter(dwhRes.UpdatedAt),
 		})
 	}
 
@@ -192,11 +204,14 @@ func (h *HandlerV1) UpdateDoctorWorkingHours(c *gin.Context) {
 	defer cancel()
 
 	dwh, err := h.serviceManager.HealthcareService().DoctorWorkingHoursService().UpdateDoctorWorkingHours(ctx, &pb.DoctorWorkingHours{
-		Id:         cast.ToInt32(id),
-		DoctorId:   body.DoctorId,
-		DayOfWeek:  body.DayOfWeek,
-		StartTime:  body.StartTime,
-		FinishTime: body.FinishTime,
+		Id:            cast.ToInt32(id),
+		DoctorId:      body.DoctorId,
+		DayOfWeek:     body.DayOfWeek,
+		Kind:          body.Kind,
+		StartTime:     body.StartTime,
+		FinishTime:    body.FinishTime,
+		EffectiveFrom: body.EffectiveFrom,
+		EffectiveTo:   body.EffectiveTo,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateDoctorWorkingHours") {
@@ -204,13 +219,16 @@ func (h *HandlerV1) UpdateDoctorWorkingHours(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, model_healthcare_service.DoctorWorkingHoursRes{
-		Id:         dwh.Id,
-		DoctorId:   dwh.DoctorId,
-		DayOfWeek:  dwh.DayOfWeek,
-		StartTime:  dwh.StartTime,
-		FinishTime: dwh.FinishTime,
-		CreatedAt:  dwh.CreatedAt,
-		UpdatedAt:  e.UpdateTimeFilter(dwh.UpdatedAt),
+		Id:            dwh.Id,
+		DoctorId:      dwh.DoctorId,
+		DayOfWeek:     dwh.DayOfWeek,
+		Kind:          dwh.Kind,
+		StartTime:     dwh.StartTime,
+		FinishTime:    dwh.FinishTime,
+		EffectiveFrom: dwh.EffectiveFrom,
+		EffectiveTo:   dwh.EffectiveTo,
+		CreatedAt:     dwh.CreatedAt,
+		UpdatedAt:     e.UpdateTimeFilter(dwh.UpdatedAt),
 	})
 }
 
@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/internal/pkg/export"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// exportBatchSize is the number of rows requested from a service per call while exporting
+const exportBatchSize = 100
+
+// exportPageFunc loads a single page of rendered rows
+type exportPageFunc func(ctx context.Context, page, limit uint64) ([][]string, error)
+
+// streamExport writes the header and every page returned by next into the response
+// in the format requested by the "format" query param, stopping on the first short page
+func (h *HandlerV1) streamExport(c *gin.Context, name string, header []string, next exportPageFunc) {
+	format := c.DefaultQuery("format", export.FormatCSV)
+	if format != export.FormatCSV && format != export.FormatXLSX {
+		e.HandleError(c, fmt.Errorf("unsupported export format: %s", format), h.log, http.StatusBadRequest, name)
+		return
+	}
+
+	// the first page is loaded before any byte is written so that errors can still be reported as json
+	rows, err := h.exportPage(next, 1)
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, name) {
+		return
+	}
+
+	c.Header("Content-Type", export.ContentType(format))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s_%s.%s", name, time.Now().Format("2006-01-02"), format))
+	c.Status(http.StatusOK)
+
+	writer, err := export.New(format, c.Writer)
+	if err != nil {
+		h.log.Error("failed to create export writer", zap.String("export", name), zap.Error(err))
+		return
+	}
+	defer func() {
+		if err := writer.Close(); err != nil {
+			h.log.Error("failed to close export writer", zap.String("export", name), zap.Error(err))
+		}
+	}()
+
+	if err := writer.Write(header); err != nil {
+		h.log.Error("failed to write export header", zap.String("export", name), zap.Error(err))
+		return
+	}
+
+	for page := uint64(1); ; page++ {
+		if page > 1 {
+			rows, err = h.exportPage(next, page)
+			if err != nil {
+				h.log.Error("failed to load export page", zap.String("export", name), zap.Uint64("page", page), zap.Error(err))
+				return
+			}
+		}
+
+		for _, row := range rows {
+			if err := writer.Write(row); err != nil {
+				h.log.Error("failed to write export row", zap.String("export", name), zap.Error(err))
+				return
+			}
+		}
+		if err := writer.Flush(); err != nil {
+			h.log.Error("failed to flush export", zap.String("export", name), zap.Error(err))
+			return
+		}
+
+		if len(rows) < exportBatchSize {
+			return
+		}
+	}
+}
+
+func (h *HandlerV1) exportPage(next exportPageFunc, page uint64) ([][]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	return next(ctx, page, exportBatchSize)
+}
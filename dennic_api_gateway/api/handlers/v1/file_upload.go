@@ -1,19 +1,34 @@
 package v1
 
 import (
+	"context"
 	e "dennic_admin_api_gateway/api/handlers/regtool"
 	m "dennic_admin_api_gateway/api/models/model_minio"
+	"dennic_admin_api_gateway/internal/pkg/imaging"
 	"dennic_admin_api_gateway/internal/pkg/minio"
+	"errors"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"io"
 	"net/http"
-	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 )
 
+// publicBuckets are the anonymous-read buckets of the catalogue pictures
+var publicBuckets = []string{"department", "reasons", "specialization", "doctor", "user"}
+
+// documentContentTypes are the types of the patient documents
+var documentContentTypes = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
 // UploadFile ...
 // @Summary Upload image
-// @Description Upload image
+// @Description Upload image - Api for upload a jpeg or png image, it is stored without its metadata together with its thumbnail and medium renditions
 // @Tags upload-file
 // @Accept image/png
 // @Produce json
@@ -21,6 +36,8 @@ import (
 // @Param bucketName query string false "bucket" Enums(department, reasons, specialization, doctor, user) "bucket name"
 // @Success 200 {object} model_minio.MinioURL
 // @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 413 {object} model_common.StandardErrorModel
+// @Failure 415 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
 // @Router /v1/file-upload [post]
 func (h *HandlerV1) UploadFile(c *gin.Context) {
@@ -33,20 +50,153 @@ func (h *HandlerV1) UploadFile(c *gin.Context) {
 
 	defer file.Close()
 
-	fileBytes, err := io.ReadAll(file)
-	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UploadFile") {
+	// the documents are uploaded through a presigned url, only the pictures of the public buckets come here
+	if !slices.Contains(publicBuckets, bucketName) {
+		e.HandleError(c, errors.New("unknown bucket "+bucketName), h.log, http.StatusBadRequest, "UploadFile")
 		return
 	}
 
-	generatedFileName := uuid.New().String() + filepath.Ext(header.Filename)
-
-	objectURL, err := minio.UploadToMinio(h.cfg, generatedFileName, fileBytes, bucketName)
+	if header.Size > h.cfg.MinioService.MaxUploadSize {
+		e.HandleError(c, imaging.ErrTooLarge, h.log, http.StatusRequestEntityTooLarge, "UploadFile")
+		return
+	}
+	// a part may be longer than its declared size, one byte over the limit is enough to reject it
+	fileBytes, err := io.ReadAll(io.LimitReader(file, h.cfg.MinioService.MaxUploadSize+1))
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UploadFile") {
+		return
+	}
 
+	renditions, err := imaging.Process(fileBytes, h.cfg.MinioService.MaxUploadSize)
+	if errors.Is(err, imaging.ErrTooLarge) {
+		e.HandleError(c, err, h.log, http.StatusRequestEntityTooLarge, "UploadFile")
+		return
+	}
+	if errors.Is(err, imaging.ErrUnsupportedType) {
+		e.HandleError(c, err, h.log, http.StatusUnsupportedMediaType, "UploadFile")
+		return
+	}
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UploadFile") {
 		return
 	}
 
+	urls := make(map[string]string, len(renditions))
+	for _, rendition := range renditions {
+		objectURL, err := minio.UploadToMinio(h.cfg, rendition.ObjectName, rendition.Content, bucketName, rendition.ContentType)
+		if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UploadFile") {
+			return
+		}
+		urls[rendition.Name] = objectURL
+	}
+
 	c.JSON(http.StatusCreated, m.MinioURL{
-		URL: objectURL,
+		URL:          urls[imaging.RenditionOriginal],
+		ThumbnailURL: urls[imaging.RenditionThumbnail],
+		MediumURL:    urls[imaging.RenditionMedium],
+	})
+}
+
+// PresignUpload ...
+// @Summary PresignUpload
+// @Description PresignUpload - Api for presign the direct upload of a document of the logged patient to the private document bucket, the PUT must carry the returned headers. The pictures of the public buckets are uploaded through /v1/file-upload, which strips their metadata
+// @Tags upload-file
+// @Accept json
+// @Produce json
+// @Param PresignReq body model_minio.PresignReq true "PresignReq"
+// @Success 200 {object} model_minio.PresignRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 413 {object} model_common.StandardErrorModel
+// @Failure 415 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/file-upload/presign [post]
+func (h *HandlerV1) PresignUpload(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if err == nil && userInfo.UserId == "" {
+		err = errors.New("the token has no user")
+	}
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "PresignUpload") {
+		return
+	}
+
+	var body m.PresignReq
+	err = c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "PresignUpload") {
+		return
+	}
+	if body.Size <= 0 {
+		e.HandleError(c, errors.New("size must be positive"), h.log, http.StatusBadRequest, "PresignUpload")
+		return
+	}
+	extension, ok := documentContentTypes[body.ContentType]
+	if !ok {
+		e.HandleError(c, errors.New("content type "+body.ContentType+" is not accepted"), h.log, http.StatusUnsupportedMediaType, "PresignUpload")
+		return
+	}
+	if body.Size > h.cfg.MinioService.DocumentMaxSize {
+		e.HandleError(c, imaging.ErrTooLarge, h.log, http.StatusRequestEntityTooLarge, "PresignUpload")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	// the documents of a patient are kept under the id of the user
+	objectName := userInfo.UserId + "/" + uuid.NewString() + extension
+	upload, err := minio.PresignUpload(ctx, h.cfg, h.cfg.MinioService.DocumentBucket, objectName, body.ContentType, body.Size)
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "PresignUpload") {
+		return
+	}
+
+	c.JSON(http.StatusOK, m.PresignRes{
+		UploadURL:  upload.URL,
+		Method:     http.MethodPut,
+		Headers:    upload.Headers,
+		ObjectName: objectName,
+		ExpiresAt:  upload.ExpiresAt.Format(time.RFC3339),
+	})
+}
+
+// GetDocument ...
+// @Summary GetDocument
+// @Description GetDocument - Api for get a short-lived url of a document of the private document bucket, only the patient the document belongs to gets it
+// @Tags upload-file
+// @Accept json
+// @Produce json
+// @Param object_name query string true "object_name"
+// @Success 200 {object} model_minio.DocumentURL
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/file-upload/document [get]
+func (h *HandlerV1) GetDocument(c *gin.Context) {
+	objectName := c.Query("object_name")
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "GetDocument") {
+		return
+	}
+	owner, _, found := strings.Cut(objectName, "/")
+	if !found || owner == "" || owner != userInfo.UserId || strings.Contains(objectName, "..") {
+		e.HandleError(c, errors.New("the document does not belong to the user"), h.log, http.StatusForbidden, "GetDocument")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	expiresAt := time.Now().Add(h.cfg.MinioService.DocumentURLExpiry)
+	documentURL, err := minio.PresignDownload(ctx, h.cfg, h.cfg.MinioService.DocumentBucket, objectName, h.cfg.MinioService.DocumentURLExpiry)
+	if errors.Is(err, minio.ErrObjectNotFound) {
+		e.HandleError(c, err, h.log, http.StatusNotFound, "GetDocument")
+		return
+	}
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetDocument") {
+		return
+	}
+
+	c.JSON(http.StatusOK, m.DocumentURL{
+		URL:       documentURL,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
 	})
 }
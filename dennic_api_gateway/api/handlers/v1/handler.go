@@ -2,6 +2,7 @@ package v1
 
 import (
 	grpc_service_clients "dennic_admin_api_gateway/internal/infrastructure/grpc_service_client"
+	cache "dennic_admin_api_gateway/internal/infrastructure/redis"
 	"dennic_admin_api_gateway/internal/pkg/config"
 	"dennic_admin_api_gateway/internal/pkg/redis"
 	token "dennic_admin_api_gateway/internal/pkg/tokens"
@@ -27,6 +28,7 @@ type HandlerV1 struct {
 	serviceManager grpc_service_clients.ServiceClient
 	cfg            *config.Config
 	redis          *redis.RedisDB
+	catalogue      *cache.CatalogueCache
 	//BrokerProducer event.BrokerProducer
 	//kafka          *kafka.Produce
 }
@@ -40,6 +42,7 @@ type HandlerV1Config struct {
 	Config         *config.Config
 	Enforcer       casbin.Enforcer
 	Redis          *redis.RedisDB
+	CatalogueCache *cache.CatalogueCache
 
 	//BrokerProducer event.BrokerProducer
 	//Kafka          *kafka.Produce
@@ -53,6 +56,7 @@ func New(c *HandlerV1Config) *HandlerV1 {
 		serviceManager: c.Service,
 		cfg:            c.Config,
 		redis:          c.Redis,
+		catalogue:      c.CatalogueCache,
 		ContextTimeout: c.ContextTimeout,
 
 		//BrokerProducer: c.BrokerProducer,
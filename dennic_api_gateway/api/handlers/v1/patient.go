@@ -6,6 +6,7 @@ import (
 	"dennic_admin_api_gateway/api/models"
 	"dennic_admin_api_gateway/api/models/model_booking_service"
 	pb "dennic_admin_api_gateway/genproto/booking_service"
+	"dennic_admin_api_gateway/internal/pkg/export"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -307,3 +308,65 @@ func (h *HandlerV1) DeletePatient(c *gin.Context) {
 
 	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
 }
+
+var patientExportColumns = export.Columns[*pb.Patient]{
+	{Name: "id", Value: func(p *pb.Patient) string { return p.Id }},
+	{Name: "first_name", Value: func(p *pb.Patient) string { return p.FirstName }},
+	{Name: "last_name", Value: func(p *pb.Patient) string { return p.LastName }},
+	{Name: "birth_date", Value: func(p *pb.Patient) string { return p.BirthDate }},
+	{Name: "gender", Value: func(p *pb.Patient) string { return p.Gender }},
+	{Name: "address", Value: func(p *pb.Patient) string { return p.Address }},
+	{Name: "blood_group", Value: func(p *pb.Patient) string { return p.BloodGroup }},
+	{Name: "phone_number", Value: func(p *pb.Patient) string { return p.PhoneNumber }},
+	{Name: "city", Value: func(p *pb.Patient) string { return p.City }},
+	{Name: "country", Value: func(p *pb.Patient) string { return p.Country }},
+	{Name: "patient_problem", Value: func(p *pb.Patient) string { return p.PatientProblem }},
+	{Name: "created_at", Value: func(p *pb.Patient) string { return p.CreatedAt }},
+	{Name: "updated_at", Value: func(p *pb.Patient) string { return e.UpdateTimeFilter(p.UpdatedAt) }},
+}
+
+// ExportPatients ...
+// @Summary ExportPatients
+// @Description ExportPatients - Api for export patients as csv or xlsx, accepts the same filters as the list api
+// @Tags Patient
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string false "format" Enums(csv, xlsx)
+// @Param columns query string false "comma separated list of columns, all columns by default"
+// @Param searchField query string false "searchField" Enums(first_name, last_name,blood_group,phone_number,address,city,country)
+// @Param value query string false "value"
+// @Param orderBy query string false "orderBy"
+// @Success 200 {file} file
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/patient/export [get]
+func (h *HandlerV1) ExportPatients(c *gin.Context) {
+	field := c.Query("searchField")
+	value := c.Query("value")
+	orderBy := c.Query("orderBy")
+
+	columns, err := patientExportColumns.Select(c.Query("columns"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ExportPatients") {
+		return
+	}
+
+	h.streamExport(c, "patients", columns.Header(), func(ctx context.Context, page, limit uint64) ([][]string, error) {
+		res, err := h.serviceManager.BookingService().PatientService().GetAllPatients(ctx, &pb.GetAllPatientsReq{
+			Field:    field,
+			Value:    value,
+			IsActive: false,
+			Page:     page,
+			Limit:    limit,
+			OrderBy:  orderBy,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		rows := make([][]string, 0, len(res.Patients))
+		for _, patient := range res.Patients {
+			rows = append(rows, columns.Row(patient))
+		}
+		return rows, nil
+	})
+}
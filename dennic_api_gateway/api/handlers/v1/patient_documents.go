@@ -0,0 +1,337 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models"
+	"dennic_admin_api_gateway/api/models/model_booking_service"
+	m "dennic_admin_api_gateway/api/models/model_minio"
+	pb "dennic_admin_api_gateway/genproto/booking_service"
+	"dennic_admin_api_gateway/internal/pkg/minio"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func patientDocumentRes(document *pb.PatientDocument) *model_booking_service.PatientDocument {
+	return &model_booking_service.PatientDocument{
+		Id:            document.Id,
+		PatientId:     document.PatientId,
+		UserId:        document.UserId,
+		AppointmentId: document.AppointmentId,
+		DoctorNoteId:  document.DoctorNoteId,
+		DocumentType:  document.DocumentType,
+		Title:         document.Title,
+		ObjectName:    document.ObjectName,
+		ContentType:   document.ContentType,
+		Size:          document.SizeBytes,
+		UploadedAt:    document.UploadedAt,
+	}
+}
+
+// documentRequester returns the logged user, or the logged doctor, the documents are asked for
+func documentRequester(c *gin.Context) (*pb.PatientDocumentRequester, error) {
+	userInfo, err := e.GetUserInfo(c)
+	if err != nil {
+		return nil, err
+	}
+	if userInfo.UserId == "" {
+		return nil, errors.New("the token has no user")
+	}
+	if userInfo.Role == doctorRole {
+		return &pb.PatientDocumentRequester{DoctorId: userInfo.UserId}, nil
+	}
+	return &pb.PatientDocumentRequester{UserId: userInfo.UserId}, nil
+}
+
+// bookingErrorStatus is the http status of an error of the booking service
+func bookingErrorStatus(err error) int {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.AlreadyExists:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// CreatePatientDocument ...
+// @Summary CreatePatientDocument
+// @Description CreatePatientDocument - Api for attach a file, uploaded to the document bucket through a presigned url, to a patient and optionally to one of their appointments or doctor notes. The patient must have the phone number of the logged user
+// @Tags Patient Document
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param CreatePatientDocumentReq body model_booking_service.CreatePatientDocumentReq true "CreatePatientDocumentReq"
+// @Success 200 {object} model_booking_service.PatientDocument
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 415 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/patient-document [post]
+func (h *HandlerV1) CreatePatientDocument(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if err == nil && userInfo.UserId == "" {
+		err = errors.New("the token has no user")
+	}
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "CreatePatientDocument") {
+		return
+	}
+	// a user is one of the patients of their phone number, the patient records carry no user
+	if userInfo.Role == doctorRole || userInfo.PhoneNumber == "" {
+		e.HandleError(c, errors.New("the documents are attached by the users of the patient"), h.log, http.StatusForbidden, "CreatePatientDocument")
+		return
+	}
+
+	var body model_booking_service.CreatePatientDocumentReq
+
+	err = c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreatePatientDocument") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	// the content type and the size are the ones of the stored object, not the ones the client claims
+	object, err := minio.StatObject(ctx, h.cfg, h.cfg.MinioService.DocumentBucket, body.ObjectName)
+	if errors.Is(err, minio.ErrObjectNotFound) {
+		e.HandleError(c, errors.New("the document has not been uploaded"), h.log, http.StatusBadRequest, "CreatePatientDocument")
+		return
+	}
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreatePatientDocument") {
+		return
+	}
+	if _, ok := documentContentTypes[object.ContentType]; !ok {
+		e.HandleError(c, errors.New("content type "+object.ContentType+" is not accepted"), h.log, http.StatusUnsupportedMediaType, "CreatePatientDocument")
+		return
+	}
+
+	document, err := h.serviceManager.BookingService().PatientDocuments().CreatePatientDocument(ctx, &pb.CreatePatientDocumentReq{
+		PatientId:     body.PatientId,
+		UserId:        userInfo.UserId,
+		PhoneNumber:   userInfo.PhoneNumber,
+		AppointmentId: body.AppointmentId,
+		DoctorNoteId:  body.DoctorNoteId,
+		DocumentType:  body.DocumentType,
+		Title:         body.Title,
+		ObjectName:    body.ObjectName,
+		ContentType:   object.ContentType,
+		SizeBytes:     object.Size,
+	})
+
+	if e.HandleError(c, err, h.log, bookingErrorStatus(err), "CreatePatientDocument") {
+		return
+	}
+
+	c.JSON(http.StatusOK, patientDocumentRes(document))
+}
+
+// GetPatientDocument ...
+// @Summary GetPatientDocument
+// @Description GetPatientDocument - API to get a document of a patient, the user who attached it and the doctors treating the patient get it
+// @Tags Patient Document
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id query int64 true "id"
+// @Success 200 {object} model_booking_service.PatientDocument
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/patient-document/get [get]
+func (h *HandlerV1) GetPatientDocument(c *gin.Context) {
+	requester, err := documentRequester(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "GetPatientDocument") {
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Query("id"), 10, 64)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "GetPatientDocument") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	document, err := h.serviceManager.BookingService().PatientDocuments().GetPatientDocument(ctx, &pb.PatientDocumentReq{
+		Id:        id,
+		Requester: requester,
+	})
+
+	if e.HandleError(c, err, h.log, bookingErrorStatus(err), "GetPatientDocument") {
+		return
+	}
+
+	c.JSON(http.StatusOK, patientDocumentRes(document))
+}
+
+// ListPatientDocuments ...
+// @Summary ListPatientDocuments
+// @Description ListPatientDocuments - API to list the documents of a patient or of an appointment, newest first. A user lists the documents they attached, a doctor the documents of the patients they treat
+// @Tags Patient Document
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param patient_id query string false "patient_id"
+// @Param appointment_id query int64 false "appointment_id"
+// @Param page query uint64 false "page"
+// @Param limit query uint64 false "limit"
+// @Success 200 {object} model_booking_service.PatientDocumentsType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/patient-document [get]
+func (h *HandlerV1) ListPatientDocuments(c *gin.Context) {
+	requester, err := documentRequester(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "ListPatientDocuments") {
+		return
+	}
+
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListPatientDocuments") {
+		return
+	}
+
+	var appointmentId int64
+	if c.Query("appointment_id") != "" {
+		appointmentId, err = strconv.ParseInt(c.Query("appointment_id"), 10, 64)
+		if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListPatientDocuments") {
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	documents, err := h.serviceManager.BookingService().PatientDocuments().GetAllPatientDocuments(ctx, &pb.GetAllPatientDocumentsReq{
+		PatientId:     c.Query("patient_id"),
+		AppointmentId: appointmentId,
+		Page:          pageInt,
+		Limit:         limitInt,
+		Requester:     requester,
+	})
+
+	if e.HandleError(c, err, h.log, bookingErrorStatus(err), "ListPatientDocuments") {
+		return
+	}
+
+	var documentsRes model_booking_service.PatientDocumentsType
+	for _, document := range documents.PatientDocuments {
+		documentsRes.PatientDocuments = append(documentsRes.PatientDocuments, patientDocumentRes(document))
+	}
+	documentsRes.Count = documents.Count
+
+	c.JSON(http.StatusOK, documentsRes)
+}
+
+// DownloadPatientDocument ...
+// @Summary DownloadPatientDocument
+// @Description DownloadPatientDocument - API to get a short-lived url of the file of a document, the user who attached it and the doctors treating the patient get it
+// @Tags Patient Document
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id query int64 true "id"
+// @Success 200 {object} model_minio.DocumentURL
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/patient-document/download [get]
+func (h *HandlerV1) DownloadPatientDocument(c *gin.Context) {
+	requester, err := documentRequester(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "DownloadPatientDocument") {
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Query("id"), 10, 64)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "DownloadPatientDocument") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	document, err := h.serviceManager.BookingService().PatientDocuments().GetPatientDocument(ctx, &pb.PatientDocumentReq{
+		Id:        id,
+		Requester: requester,
+	})
+
+	if e.HandleError(c, err, h.log, bookingErrorStatus(err), "DownloadPatientDocument") {
+		return
+	}
+
+	expiresAt := time.Now().Add(h.cfg.MinioService.DocumentURLExpiry)
+	documentURL, err := minio.PresignDownload(ctx, h.cfg, h.cfg.MinioService.DocumentBucket, document.ObjectName, h.cfg.MinioService.DocumentURLExpiry)
+	if errors.Is(err, minio.ErrObjectNotFound) {
+		e.HandleError(c, err, h.log, http.StatusNotFound, "DownloadPatientDocument")
+		return
+	}
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DownloadPatientDocument") {
+		return
+	}
+
+	c.JSON(http.StatusOK, m.DocumentURL{
+		URL:       documentURL,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+	})
+}
+
+// DeletePatientDocument ...
+// @Summary DeletePatientDocument
+// @Description DeletePatientDocument - API to delete a document, only the user who attached it deletes it
+// @Tags Patient Document
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id query int64 true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/patient-document [delete]
+func (h *HandlerV1) DeletePatientDocument(c *gin.Context) {
+	requester, err := documentRequester(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "DeletePatientDocument") {
+		return
+	}
+	if requester.UserId == "" {
+		e.HandleError(c, errors.New("only the user who attached the document deletes it"), h.log, http.StatusForbidden, "DeletePatientDocument")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Query("id"), 10, 64)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "DeletePatientDocument") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().PatientDocuments().DeletePatientDocument(ctx, &pb.PatientDocumentReq{
+		Id:        id,
+		Requester: requester,
+	})
+
+	if e.HandleError(c, err, h.log, bookingErrorStatus(err), "DeletePatientDocument") {
+		return
+	}
+	c.JSON(http.StatusOK, models.StatusRes{Status: res.Status})
+}
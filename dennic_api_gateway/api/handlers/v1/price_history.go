@@ -0,0 +1,158 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models"
+	"dennic_admin_api_gateway/api/models/model_healthcare_service"
+	pb "dennic_admin_api_gateway/genproto/healthcare-service"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func priceChangeRes(change *pb.PriceChange) *model_healthcare_service.PriceChange {
+	return &model_healthcare_service.PriceChange{
+		Id:              change.Id,
+		DoctorServiceId: change.DoctorServiceId,
+		OnlinePrice:     change.OnlinePrice,
+		OfflinePrice:    change.OfflinePrice,
+		EffectiveFrom:   change.EffectiveFrom,
+		CreatedAt:       change.CreatedAt,
+	}
+}
+
+// SchedulePriceChange ...
+// @Summary SchedulePriceChange
+// @Description SchedulePriceChange - Api for schedule the prices of a doctor service from a future effective_from
+// @Tags Price History
+// @Accept json
+// @Produce json
+// @Param PriceChangeReq body model_healthcare_service.PriceChangeReq true "PriceChangeReq"
+// @Success 200 {object} model_healthcare_service.PriceChange
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/price-history [post]
+func (h *HandlerV1) SchedulePriceChange(c *gin.Context) {
+	var body model_healthcare_service.PriceChangeReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "SchedulePriceChange") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	change, err := h.serviceManager.HealthcareService().PriceHistoryService().SchedulePriceChange(ctx, &pb.PriceChange{
+		Id:              uuid.NewString(),
+		DoctorServiceId: body.DoctorServiceId,
+		OnlinePrice:     body.OnlinePrice,
+		OfflinePrice:    body.OfflinePrice,
+		EffectiveFrom:   body.EffectiveFrom,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "SchedulePriceChange") {
+		return
+	}
+
+	c.JSON(http.StatusOK, priceChangeRes(change))
+}
+
+// ListPriceHistory ...
+// @Summary ListPriceHistory
+// @Description ListPriceHistory - API to list the prices of a doctor service newest first, the scheduled changes included
+// @Tags Price History
+// @Accept json
+// @Produce json
+// @Param doctor_service_id query string true "doctor_service_id"
+// @Param page query uint64 false "page"
+// @Param limit query uint64 false "limit"
+// @Success 200 {object} model_healthcare_service.PriceHistory
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/price-history [get]
+func (h *HandlerV1) ListPriceHistory(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListPriceHistory") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	history, err := h.serviceManager.HealthcareService().PriceHistoryService().ListPriceHistory(ctx, &pb.PriceHistoryReq{
+		DoctorServiceId: c.Query("doctor_service_id"),
+		Page:            int64(pageInt),
+		Limit:           int64(limitInt),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListPriceHistory") {
+		return
+	}
+
+	var historyRes model_healthcare_service.PriceHistory
+	for _, change := range history.PriceChanges {
+		historyRes.PriceChanges = append(historyRes.PriceChanges, priceChangeRes(change))
+	}
+	historyRes.Count = history.Count
+
+	c.JSON(http.StatusOK, historyRes)
+}
+
+// GetPriceAt ...
+// @Summary GetPriceAt
+// @Description GetPriceAt - API to get the prices of a doctor service valid at a moment, now when at is empty
+// @Tags Price History
+// @Accept json
+// @Produce json
+// @Param doctor_service_id query string true "doctor_service_id"
+// @Param at query string false "at" example(2024-01-15 10:00:00)
+// @Success 200 {object} model_healthcare_service.PriceChange
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/price-history/at [get]
+func (h *HandlerV1) GetPriceAt(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	change, err := h.serviceManager.HealthcareService().PriceHistoryService().GetPriceAt(ctx, &pb.PriceAtReq{
+		DoctorServiceId: c.Query("doctor_service_id"),
+		At:              c.Query("at"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetPriceAt") {
+		return
+	}
+
+	c.JSON(http.StatusOK, priceChangeRes(change))
+}
+
+// CancelPriceChange ...
+// @Summary CancelPriceChange
+// @Description CancelPriceChange - API to cancel a scheduled price change, the prices already in effect are kept
+// @Tags Price History
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/price-history [delete]
+func (h *HandlerV1) CancelPriceChange(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().PriceHistoryService().CancelPriceChange(ctx, &pb.PriceChangeId{
+		Id: c.Query("id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CancelPriceChange") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
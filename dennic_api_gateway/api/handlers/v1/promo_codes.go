@@ -0,0 +1,202 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models"
+	"dennic_admin_api_gateway/api/models/model_booking_service"
+	pb "dennic_admin_api_gateway/genproto/booking_service"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func promoCodeRes(promoCode *pb.PromoCode) *model_booking_service.PromoCode {
+	return &model_booking_service.PromoCode{
+		Id:         promoCode.Id,
+		Code:       promoCode.Code,
+		Kind:       promoCode.Kind,
+		Value:      promoCode.Value,
+		MaxUses:    promoCode.MaxUses,
+		UsedCount:  promoCode.UsedCount,
+		ValidFrom:  promoCode.ValidFrom,
+		ValidUntil: promoCode.ValidUntil,
+		CreatedAt:  promoCode.CreatedAt,
+		UpdatedAt:  e.UpdateTimeFilter(promoCode.UpdatedAt),
+	}
+}
+
+// CreatePromoCode ...
+// @Summary CreatePromoCode
+// @Description CreatePromoCode - Api for create a promo code applied at booking, codes are unique whatever the case, max_uses 0 is unlimited and an empty valid_until has no end
+// @Tags Promo Code
+// @Accept json
+// @Produce json
+// @Param CreatePromoCodeReq body model_booking_service.CreatePromoCodeReq true "CreatePromoCodeReq"
+// @Success 200 {object} model_booking_service.PromoCode
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/promo-code [post]
+func (h *HandlerV1) CreatePromoCode(c *gin.Context) {
+	var body model_booking_service.CreatePromoCodeReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreatePromoCode") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	promoCode, err := h.serviceManager.BookingService().PromoCodes().CreatePromoCode(ctx, &pb.CreatePromoCodeReq{
+		Code:       body.Code,
+		Kind:       body.Kind,
+		Value:      body.Value,
+		MaxUses:    body.MaxUses,
+		ValidFrom:  body.ValidFrom,
+		ValidUntil: body.ValidUntil,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreatePromoCode") {
+		return
+	}
+
+	c.JSON(http.StatusOK, promoCodeRes(promoCode))
+}
+
+// GetPromoCode ...
+// @Summary GetPromoCode
+// @Description GetPromoCode - API to get promo code by code
+// @Tags Promo Code
+// @Accept json
+// @Produce json
+// @Param code query string true "code"
+// @Success 200 {object} model_booking_service.PromoCode
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/promo-code/get [get]
+func (h *HandlerV1) GetPromoCode(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	promoCode, err := h.serviceManager.BookingService().PromoCodes().GetPromoCode(ctx, &pb.PromoCodeFieldValueReq{
+		Field:    "code",
+		Value:    c.Query("code"),
+		IsActive: false,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetPromoCode") {
+		return
+	}
+
+	c.JSON(http.StatusOK, promoCodeRes(promoCode))
+}
+
+// ListPromoCodes ...
+// @Summary ListPromoCodes
+// @Description ListPromoCodes - API to list promo codes, newest first
+// @Tags Promo Code
+// @Accept json
+// @Produce json
+// @Param page query uint64 false "page"
+// @Param limit query uint64 false "limit"
+// @Success 200 {object} model_booking_service.PromoCodesType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/promo-code [get]
+func (h *HandlerV1) ListPromoCodes(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListPromoCodes") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	promoCodes, err := h.serviceManager.BookingService().PromoCodes().GetAllPromoCodes(ctx, &pb.GetAllPromoCodesReq{
+		IsActive: false,
+		Page:     pageInt,
+		Limit:    limitInt,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListPromoCodes") {
+		return
+	}
+
+	var promoCodesRes model_booking_service.PromoCodesType
+	for _, promoCode := range promoCodes.PromoCodes {
+		promoCodesRes.PromoCodes = append(promoCodesRes.PromoCodes, promoCodeRes(promoCode))
+	}
+	promoCodesRes.Count = promoCodes.Count
+
+	c.JSON(http.StatusOK, promoCodesRes)
+}
+
+// UpdatePromoCode ...
+// @Summary UpdatePromoCode
+// @Description UpdatePromoCode - API to update a promo code, the code and its usage count are kept
+// @Tags Promo Code
+// @Accept json
+// @Produce json
+// @Param UpdatePromoCodeReq body model_booking_service.UpdatePromoCodeReq true "UpdatePromoCodeReq"
+// @Success 200 {object} model_booking_service.PromoCode
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/promo-code [put]
+func (h *HandlerV1) UpdatePromoCode(c *gin.Context) {
+	var body model_booking_service.UpdatePromoCodeReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdatePromoCode") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	promoCode, err := h.serviceManager.BookingService().PromoCodes().UpdatePromoCode(ctx, &pb.UpdatePromoCodeReq{
+		Id:         body.Id,
+		Kind:       body.Kind,
+		Value:      body.Value,
+		MaxUses:    body.MaxUses,
+		ValidFrom:  body.ValidFrom,
+		ValidUntil: body.ValidUntil,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdatePromoCode") {
+		return
+	}
+
+	c.JSON(http.StatusOK, promoCodeRes(promoCode))
+}
+
+// DeletePromoCode ...
+// @Summary DeletePromoCode
+// @Description DeletePromoCode - API to delete a promo code
+// @Tags Promo Code
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/promo-code [delete]
+func (h *HandlerV1) DeletePromoCode(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.BookingService().PromoCodes().DeletePromoCode(ctx, &pb.PromoCodeFieldValueReq{
+		Field:    "id",
+		Value:    c.Query("id"),
+		IsActive: false,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DeletePromoCode") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
@@ -6,6 +6,7 @@ import (
 	"dennic_admin_api_gateway/api/models"
 	"dennic_admin_api_gateway/api/models/model_healthcare_service"
 	pb "dennic_admin_api_gateway/genproto/healthcare-service"
+	cache "dennic_admin_api_gateway/internal/infrastructure/redis"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -68,13 +69,14 @@ func (h *HandlerV1) CreateReasons(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id query string true "id"
+// @Param Accept-Language header string false "uz, ru or en"
 // @Success 200 {object} model_healthcare_service.ReasonsRes
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
 // @Router /v1/reasons/get [get]
 func (h *HandlerV1) GetReasons(c *gin.Context) {
 	id := c.Query("id")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	ctx, cancel := context.WithTimeout(e.LanguageContext(context.Background(), c), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
 	reasons, err := h.serviceManager.HealthcareService().ReasonsService().GetReasonsById(ctx, &pb.GetReqStrReasons{
@@ -105,6 +107,7 @@ func (h *HandlerV1) GetReasons(c *gin.Context) {
 // @Produce json
 // @Param ListReq query models.ListReq false "ListReq"
 // @Param search query string false "search" Enums(name) "search"
+// @Param Accept-Language header string false "uz, ru or en"
 // @Success 200 {object} model_healthcare_service.ListReasons
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -120,8 +123,12 @@ func (h *HandlerV1) ListReasons(c *gin.Context) {
 	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListReasons") {
 		return
 	}
+	cacheKey, hit := h.cachedCatalogue(c, cache.CatalogueReasons)
+	if hit {
+		return
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	ctx, cancel := context.WithTimeout(e.LanguageContext(context.Background(), c), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
 	reasons, err := h.serviceManager.HealthcareService().ReasonsService().GetAllReasons(ctx, &pb.GetAllReas{
@@ -148,7 +155,7 @@ func (h *HandlerV1) ListReasons(c *gin.Context) {
 		})
 	}
 
-	c.JSON(http.StatusOK, model_healthcare_service.ListReasons{
+	h.cacheCatalogue(c, cacheKey, model_healthcare_service.ListReasons{
 		Count:   reasons.Count,
 		Reasons: reasonsRes.Reasons,
 	})
@@ -231,3 +238,29 @@ func (h *HandlerV1) DeleteReasons(c *gin.Context) {
 
 	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
 }
+
+// RestoreReasons ...
+// @Summary RestoreReasons
+// @Description RestoreReasons - Api for restore a deleted reason together with the records its delete cascaded to
+// @Tags Reasons
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/reasons/restore [put]
+func (h *HandlerV1) RestoreReasons(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().ReasonsService().RestoreReasons(ctx, &pb.RestoreReasonsReq{
+		Id: c.Query("id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "RestoreReasons") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
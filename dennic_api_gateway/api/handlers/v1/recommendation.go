@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models/model_booking_service"
+	pb "dennic_admin_api_gateway/genproto/booking_service"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RecommendDoctors ...
+// @Summary RecommendDoctors
+// @Description RecommendDoctors - Api for doctors of the specialization of a reason ranked by the earliest free slot, price and rating
+// @Tags Recommendation
+// @Accept json
+// @Produce json
+// @Param reason_id query string false "reason_id"
+// @Param query query string false "free text matched against reasons when reason_id is empty"
+// @Param online query bool false "rank by the online price"
+// @Param days_ahead query int64 false "days searched for a free slot, 14 by default"
+// @Param limit query int64 false "limit, 10 by default"
+// @Success 200 {object} model_booking_service.DoctorRecommendations
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/recommendation [get]
+func (h *HandlerV1) RecommendDoctors(c *gin.Context) {
+	reasonId := strings.TrimSpace(c.Query("reason_id"))
+	query := strings.TrimSpace(c.Query("query"))
+	if reasonId == "" && query == "" {
+		e.HandleError(c, errors.New("reason_id or query is required"), h.log, http.StatusBadRequest, "RecommendDoctors")
+		return
+	}
+
+	online := c.Query("online") == "true"
+
+	var (
+		daysAhead, limit int64
+		err              error
+	)
+	if value := c.Query("days_ahead"); value != "" {
+		daysAhead, err = strconv.ParseInt(value, 10, 64)
+		if e.HandleError(c, err, h.log, http.StatusBadRequest, "RecommendDoctors") {
+			return
+		}
+	}
+	if value := c.Query("limit"); value != "" {
+		limit, err = strconv.ParseInt(value, 10, 64)
+		if e.HandleError(c, err, h.log, http.StatusBadRequest, "RecommendDoctors") {
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Recommendation().RecommendDoctors(ctx, &pb.RecommendDoctorsReq{
+		ReasonId:  reasonId,
+		Query:     query,
+		Online:    online,
+		DaysAhead: daysAhead,
+		Limit:     limit,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "RecommendDoctors") {
+		return
+	}
+
+	response := model_booking_service.DoctorRecommendations{
+		ReasonId:         res.ReasonId,
+		ReasonName:       res.ReasonName,
+		SpecializationId: res.SpecializationId,
+	}
+	for _, rec := range res.Recommendations {
+		response.Recommendations = append(response.Recommendations, &model_booking_service.DoctorRecommendation{
+			DoctorId:        rec.DoctorId,
+			FirstName:       rec.FirstName,
+			LastName:        rec.LastName,
+			ImageUrl:        rec.ImageUrl,
+			DepartmentId:    rec.DepartmentId,
+			DoctorServiceId: rec.DoctorServiceId,
+			Price:           rec.Price,
+			Duration:        rec.Duration,
+			Rating:          rec.Rating,
+			ReviewCount:     rec.ReviewCount,
+			SlotFound:       rec.SlotFound,
+			SlotDate:        rec.SlotDate,
+			SlotTime:        rec.SlotTime,
+			Score:           rec.Score,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,184 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models/model_booking_service"
+	pb "dennic_admin_api_gateway/genproto/booking_service"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProposeReschedule ...
+// @Summary ProposeReschedule
+// @Description ProposeReschedule - Api for finding the appointments affected by an unavailable doctor and proposing new slots, nothing is changed
+// @Tags Reschedule
+// @Accept json
+// @Produce json
+// @Param RescheduleReq body model_booking_service.RescheduleReq true "RescheduleReq"
+// @Success 200 {object} model_booking_service.RescheduleProposals
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/appointment/reschedule/propose [post]
+func (h *HandlerV1) ProposeReschedule(c *gin.Context) {
+	req, ok := h.bindRescheduleReq(c, "ProposeReschedule")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Reschedule().ProposeReschedule(ctx, req)
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ProposeReschedule") {
+		return
+	}
+
+	c.JSON(http.StatusOK, rescheduleProposalsRes(res))
+}
+
+// ApplyReschedule ...
+// @Summary ApplyReschedule
+// @Description ApplyReschedule - Api for moving every appointment affected by an unavailable doctor to a proposed slot and notifying the patients
+// @Tags Reschedule
+// @Accept json
+// @Produce json
+// @Param RescheduleReq body model_booking_service.RescheduleReq true "RescheduleReq"
+// @Success 200 {object} model_booking_service.RescheduleProposals
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/appointment/reschedule [post]
+func (h *HandlerV1) ApplyReschedule(c *gin.Context) {
+	req, ok := h.bindRescheduleReq(c, "ApplyReschedule")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Reschedule().ApplyReschedule(ctx, req)
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ApplyReschedule") {
+		return
+	}
+
+	c.JSON(http.StatusOK, rescheduleProposalsRes(res))
+}
+
+// ListPatientNotifications ...
+// @Summary ListPatientNotifications
+// @Description ListPatientNotifications - Api for listing the notifications of a patient
+// @Tags Patient
+// @Accept json
+// @Produce json
+// @Param patient_id query string true "patient_id"
+// @Param page query uint64 false "page"
+// @Param limit query uint64 false "limit"
+// @Success 200 {object} model_booking_service.PatientNotifications
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/patient/notifications [get]
+func (h *HandlerV1) ListPatientNotifications(c *gin.Context) {
+	patientId := c.Query("patient_id")
+	if patientId == "" {
+		e.HandleError(c, errors.New("patient_id is required"), h.log, http.StatusBadRequest, "ListPatientNotifications")
+		return
+	}
+
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListPatientNotifications") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Reschedule().GetPatientNotifications(ctx, &pb.GetPatientNotificationsReq{
+		PatientId: patientId,
+		Page:      int64(pageInt),
+		Limit:     int64(limitInt),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListPatientNotifications") {
+		return
+	}
+
+	var notificationsRes model_booking_service.PatientNotifications
+	for _, notification := range res.Notifications {
+		notificationsRes.Notifications = append(notificationsRes.Notifications, &model_booking_service.PatientNotification{
+			Id:            notification.Id,
+			PatientId:     notification.PatientId,
+			AppointmentId: notification.AppointmentId,
+			Kind:          notification.Kind,
+			Message:       notification.Message,
+			CreatedAt:     notification.CreatedAt,
+			ReadAt:        notification.ReadAt,
+		})
+	}
+	notificationsRes.Count = res.Count
+
+	c.JSON(http.StatusOK, notificationsRes)
+}
+
+func (h *HandlerV1) bindRescheduleReq(c *gin.Context, name string) (*pb.RescheduleReq, bool) {
+	var body model_booking_service.RescheduleReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, name) {
+		return nil, false
+	}
+
+	switch {
+	case body.DoctorId == "" || body.StartDate == "" || body.EndDate == "":
+		err = errors.New("doctor_id, start_date and end_date are required")
+	case body.Strategy != "same_doctor" && body.Strategy != "other_doctor":
+		err = errors.New("strategy must be same_doctor or other_doctor")
+	case body.DaysAhead < 0:
+		err = errors.New("days_ahead cannot be negative")
+	}
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, name) {
+		return nil, false
+	}
+
+	return &pb.RescheduleReq{
+		DoctorId:       body.DoctorId,
+		StartDate:      body.StartDate,
+		StartTime:      body.StartTime,
+		EndDate:        body.EndDate,
+		EndTime:        body.EndTime,
+		Strategy:       body.Strategy,
+		DaysAhead:      body.DaysAhead,
+		AppointmentIds: body.AppointmentIds,
+	}, true
+}
+
+func rescheduleProposalsRes(res *pb.RescheduleProposals) model_booking_service.RescheduleProposals {
+	response := model_booking_service.RescheduleProposals{
+		Count:   res.Count,
+		Applied: res.Applied,
+	}
+	for _, proposal := range res.Proposals {
+		response.Proposals = append(response.Proposals, &model_booking_service.RescheduleProposal{
+			AppointmentId:      proposal.AppointmentId,
+			PatientId:          proposal.PatientId,
+			DepartmentId:       proposal.DepartmentId,
+			DoctorId:           proposal.DoctorId,
+			DoctorServiceId:    proposal.DoctorServiceId,
+			AppointmentDate:    proposal.AppointmentDate,
+			AppointmentTime:    proposal.AppointmentTime,
+			Duration:           proposal.Duration,
+			NewDoctorId:        proposal.NewDoctorId,
+			NewDoctorServiceId: proposal.NewDoctorServiceId,
+			NewAppointmentDate: proposal.NewAppointmentDate,
+			NewAppointmentTime: proposal.NewAppointmentTime,
+			Found:              proposal.Found,
+			Applied:            proposal.Applied,
+		})
+	}
+	return response
+}
@@ -0,0 +1,199 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models"
+	"dennic_admin_api_gateway/api/models/model_healthcare_service"
+	pb "dennic_admin_api_gateway/genproto/healthcare-service"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func resourceRes(resource *pb.Resource) *model_healthcare_service.Resource {
+	return &model_healthcare_service.Resource{
+		Id:           resource.Id,
+		Name:         resource.Name,
+		Kind:         resource.Kind,
+		ResourceType: resource.ResourceType,
+		BranchId:     resource.BranchId,
+		CreatedAt:    resource.CreatedAt,
+		UpdatedAt:    e.UpdateTimeFilter(resource.UpdatedAt),
+	}
+}
+
+// CreateResource ...
+// @Summary CreateResource
+// @Description CreateResource - Api for create a room or equipment, a resource without branch_id may be used in every branch
+// @Tags Resource
+// @Accept json
+// @Produce json
+// @Param ResourceReq body model_healthcare_service.ResourceReq true "ResourceReq"
+// @Success 200 {object} model_healthcare_service.Resource
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/resource [post]
+func (h *HandlerV1) CreateResource(c *gin.Context) {
+	var body model_healthcare_service.ResourceReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateResource") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	resource, err := h.serviceManager.HealthcareService().ResourceService().CreateResource(ctx, &pb.Resource{
+		Id:           uuid.NewString(),
+		Name:         body.Name,
+		Kind:         body.Kind,
+		ResourceType: body.ResourceType,
+		BranchId:     body.BranchId,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateResource") {
+		return
+	}
+
+	c.JSON(http.StatusOK, resourceRes(resource))
+}
+
+// GetResource ...
+// @Summary GetResource
+// @Description GetResource - API to get resource by ID
+// @Tags Resource
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_healthcare_service.Resource
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/resource/get [get]
+func (h *HandlerV1) GetResource(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	resource, err := h.serviceManager.HealthcareService().ResourceService().GetResource(ctx, &pb.ResourceId{
+		Id: c.Query("id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetResource") {
+		return
+	}
+
+	c.JSON(http.StatusOK, resourceRes(resource))
+}
+
+// ListResources ...
+// @Summary ListResources
+// @Description ListResources - API to list resources ordered by type and name
+// @Tags Resource
+// @Accept json
+// @Produce json
+// @Param kind query string false "kind" Enums(room, equipment)
+// @Param resource_type query string false "resource_type"
+// @Param branch_id query string false "branch_id"
+// @Param page query uint64 false "page"
+// @Param limit query uint64 false "limit"
+// @Success 200 {object} model_healthcare_service.ListResources
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/resource [get]
+func (h *HandlerV1) ListResources(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListResources") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	resources, err := h.serviceManager.HealthcareService().ResourceService().ListResources(ctx, &pb.ListResourcesReq{
+		Page:         int64(pageInt),
+		Limit:        int64(limitInt),
+		Kind:         c.Query("kind"),
+		ResourceType: c.Query("resource_type"),
+		BranchId:     c.Query("branch_id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListResources") {
+		return
+	}
+
+	var resourcesRes model_healthcare_service.ListResources
+	for _, resource := range resources.Resources {
+		resourcesRes.Resources = append(resourcesRes.Resources, resourceRes(resource))
+	}
+	resourcesRes.Count = resources.Count
+
+	c.JSON(http.StatusOK, resourcesRes)
+}
+
+// UpdateResource ...
+// @Summary UpdateResource
+// @Description UpdateResource - API to update a resource
+// @Tags Resource
+// @Accept json
+// @Produce json
+// @Param UpdateResourceReq body model_healthcare_service.UpdateResourceReq true "UpdateResourceReq"
+// @Success 200 {object} model_healthcare_service.Resource
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/resource [put]
+func (h *HandlerV1) UpdateResource(c *gin.Context) {
+	var body model_healthcare_service.UpdateResourceReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateResource") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	resource, err := h.serviceManager.HealthcareService().ResourceService().UpdateResource(ctx, &pb.Resource{
+		Id:           body.Id,
+		Name:         body.Name,
+		Kind:         body.Kind,
+		ResourceType: body.ResourceType,
+		BranchId:     body.BranchId,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateResource") {
+		return
+	}
+
+	c.JSON(http.StatusOK, resourceRes(resource))
+}
+
+// DeleteResource ...
+// @Summary DeleteResource
+// @Description DeleteResource - API to delete a resource
+// @Tags Resource
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/resource [delete]
+func (h *HandlerV1) DeleteResource(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().ResourceService().DeleteResource(ctx, &pb.ResourceId{
+		Id: c.Query("id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DeleteResource") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models/model_healthcare_service"
+	pb "dennic_admin_api_gateway/genproto/healthcare-service"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Search ...
+// @Summary Search
+// @Description Search - Api for ranked search over doctors, specializations, reasons and departments
+// @Tags Search
+// @Accept json
+// @Produce json
+// @Param query query string true "query"
+// @Param types query string false "comma separated hit types: doctor, specialization, reason, department"
+// @Param page query uint64 false "page"
+// @Param limit query uint64 false "limit"
+// @Success 200 {object} model_healthcare_service.SearchRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/search [get]
+func (h *HandlerV1) Search(c *gin.Context) {
+	query := strings.TrimSpace(c.Query("query"))
+	if query == "" {
+		e.HandleError(c, errors.New("query is required"), h.log, http.StatusBadRequest, "Search")
+		return
+	}
+
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "Search") {
+		return
+	}
+
+	var types []string
+	for _, hitType := range strings.Split(c.Query("types"), ",") {
+		if hitType = strings.TrimSpace(hitType); hitType != "" {
+			types = append(types, hitType)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.HealthcareService().SearchService().Search(ctx, &pb.SearchReq{
+		Query: query,
+		Types: types,
+		Page:  int32(pageInt),
+		Limit: int32(limitInt),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "Search") {
+		return
+	}
+
+	var searchRes model_healthcare_service.SearchRes
+	for _, hit := range res.Hits {
+		searchRes.Hits = append(searchRes.Hits, &model_healthcare_service.SearchHit{
+			Type:        hit.Type,
+			Id:          hit.Id,
+			Title:       hit.Title,
+			Description: hit.Description,
+			ImageUrl:    hit.ImageUrl,
+			ParentId:    hit.ParentId,
+			Score:       hit.Score,
+		})
+	}
+	searchRes.Count = res.Count
+
+	c.JSON(http.StatusOK, searchRes)
+}
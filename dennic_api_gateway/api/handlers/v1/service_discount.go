@@ -0,0 +1,245 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models"
+	"dennic_admin_api_gateway/api/models/model_healthcare_service"
+	pb "dennic_admin_api_gateway/genproto/healthcare-service"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func serviceDiscountRes(discount *pb.ServiceDiscount) *model_healthcare_service.ServiceDiscount {
+	return &model_healthcare_service.ServiceDiscount{
+		Id:              discount.Id,
+		DoctorServiceId: discount.DoctorServiceId,
+		Kind:            discount.Kind,
+		Value:           discount.Value,
+		StartsOn:        discount.StartsOn,
+		EndsOn:          discount.EndsOn,
+		CreatedAt:       discount.CreatedAt,
+		UpdatedAt:       e.UpdateTimeFilter(discount.UpdatedAt),
+	}
+}
+
+// CreateServiceDiscount ...
+// @Summary CreateServiceDiscount
+// @Description CreateServiceDiscount - Api for create a percent or fixed discount on a doctor service, an empty ends_on has no end
+// @Tags Service Discount
+// @Accept json
+// @Produce json
+// @Param ServiceDiscountReq body model_healthcare_service.ServiceDiscountReq true "ServiceDiscountReq"
+// @Success 200 {object} model_healthcare_service.ServiceDiscount
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/service-discount [post]
+func (h *HandlerV1) CreateServiceDiscount(c *gin.Context) {
+	var body model_healthcare_service.ServiceDiscountReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateServiceDiscount") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	discount, err := h.serviceManager.HealthcareService().ServiceDiscountService().CreateServiceDiscount(ctx, &pb.ServiceDiscount{
+		Id:              uuid.NewString(),
+		DoctorServiceId: body.DoctorServiceId,
+		Kind:            body.Kind,
+		Value:           body.Value,
+		StartsOn:        body.StartsOn,
+		EndsOn:          body.EndsOn,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateServiceDiscount") {
+		return
+	}
+
+	c.JSON(http.StatusOK, serviceDiscountRes(discount))
+}
+
+// GetServiceDiscount ...
+// @Summary GetServiceDiscount
+// @Description GetServiceDiscount - API to get service discount by ID
+// @Tags Service Discount
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_healthcare_service.ServiceDiscount
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/service-discount/get [get]
+func (h *HandlerV1) GetServiceDiscount(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	discount, err := h.serviceManager.HealthcareService().ServiceDiscountService().GetServiceDiscount(ctx, &pb.ServiceDiscountId{
+		Id: c.Query("id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetServiceDiscount") {
+		return
+	}
+
+	c.JSON(http.StatusOK, serviceDiscountRes(discount))
+}
+
+// ListServiceDiscounts ...
+// @Summary ListServiceDiscounts
+// @Description ListServiceDiscounts - API to list service discounts, active_on selects the discounts running on the day
+// @Tags Service Discount
+// @Accept json
+// @Produce json
+// @Param doctor_service_id query string false "doctor_service_id"
+// @Param active_on query string false "active_on" example(2024-01-15)
+// @Param page query uint64 false "page"
+// @Param limit query uint64 false "limit"
+// @Success 200 {object} model_healthcare_service.ListServiceDiscounts
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/service-discount [get]
+func (h *HandlerV1) ListServiceDiscounts(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListServiceDiscounts") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	discounts, err := h.serviceManager.HealthcareService().ServiceDiscountService().ListServiceDiscounts(ctx, &pb.ListServiceDiscountsReq{
+		Page:            int64(pageInt),
+		Limit:           int64(limitInt),
+		DoctorServiceId: c.Query("doctor_service_id"),
+		ActiveOn:        c.Query("active_on"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListServiceDiscounts") {
+		return
+	}
+
+	var discountsRes model_healthcare_service.ListServiceDiscounts
+	for _, discount := range discounts.ServiceDiscounts {
+		discountsRes.ServiceDiscounts = append(discountsRes.ServiceDiscounts, serviceDiscountRes(discount))
+	}
+	discountsRes.Count = discounts.Count
+
+	c.JSON(http.StatusOK, discountsRes)
+}
+
+// UpdateServiceDiscount ...
+// @Summary UpdateServiceDiscount
+// @Description UpdateServiceDiscount - API to update a service discount, the doctor service of a discount is kept
+// @Tags Service Discount
+// @Accept json
+// @Produce json
+// @Param UpdateServiceDiscountReq body model_healthcare_service.UpdateServiceDiscountReq true "UpdateServiceDiscountReq"
+// @Success 200 {object} model_healthcare_service.ServiceDiscount
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/service-discount [put]
+func (h *HandlerV1) UpdateServiceDiscount(c *gin.Context) {
+	var body model_healthcare_service.UpdateServiceDiscountReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateServiceDiscount") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	discount, err := h.serviceManager.HealthcareService().ServiceDiscountService().UpdateServiceDiscount(ctx, &pb.ServiceDiscount{
+		Id:       body.Id,
+		Kind:     body.Kind,
+		Value:    body.Value,
+		StartsOn: body.StartsOn,
+		EndsOn:   body.EndsOn,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateServiceDiscount") {
+		return
+	}
+
+	c.JSON(http.StatusOK, serviceDiscountRes(discount))
+}
+
+// DeleteServiceDiscount ...
+// @Summary DeleteServiceDiscount
+// @Description DeleteServiceDiscount - API to delete a service discount
+// @Tags Service Discount
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/service-discount [delete]
+func (h *HandlerV1) DeleteServiceDiscount(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().ServiceDiscountService().DeleteServiceDiscount(ctx, &pb.ServiceDiscountId{
+		Id: c.Query("id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DeleteServiceDiscount") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
+
+// GetServicePrice ...
+// @Summary GetServicePrice
+// @Description GetServicePrice - API to get the price of a doctor service on a day after the largest discount running on it, today when date is empty
+// @Tags Service Discount
+// @Accept json
+// @Produce json
+// @Param doctor_service_id query string true "doctor_service_id"
+// @Param online query bool false "online, the offline price when empty"
+// @Param date query string false "date" example(2024-01-15)
+// @Success 200 {object} model_healthcare_service.ServicePrice
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/service-discount/price [get]
+func (h *HandlerV1) GetServicePrice(c *gin.Context) {
+	var online bool
+	if value := c.Query("online"); value != "" {
+		var err error
+		online, err = strconv.ParseBool(value)
+		if e.HandleError(c, err, h.log, http.StatusBadRequest, "GetServicePrice") {
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	price, err := h.serviceManager.HealthcareService().ServiceDiscountService().GetServicePrice(ctx, &pb.ServicePriceReq{
+		DoctorServiceId: c.Query("doctor_service_id"),
+		Online:          online,
+		Date:            c.Query("date"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetServicePrice") {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_healthcare_service.ServicePrice{
+		DoctorServiceId: price.DoctorServiceId,
+		BasePrice:       price.BasePrice,
+		Discount:        price.Discount,
+		Price:           price.Price,
+		DiscountId:      price.DiscountId,
+	})
+}
@@ -0,0 +1,196 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models"
+	"dennic_admin_api_gateway/api/models/model_healthcare_service"
+	pb "dennic_admin_api_gateway/genproto/healthcare-service"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func servicePackageRes(servicePackage *pb.ServicePackage) *model_healthcare_service.ServicePackage {
+	return &model_healthcare_service.ServicePackage{
+		Id:               servicePackage.Id,
+		Name:             servicePackage.Name,
+		Description:      servicePackage.Description,
+		Price:            servicePackage.Price,
+		DoctorServiceIds: servicePackage.DoctorServiceIds,
+		ItemsPrice:       servicePackage.ItemsPrice,
+		CreatedAt:        servicePackage.CreatedAt,
+		UpdatedAt:        e.UpdateTimeFilter(servicePackage.UpdatedAt),
+	}
+}
+
+// CreateServicePackage ...
+// @Summary CreateServicePackage
+// @Description CreateServicePackage - Api for create a check-up bundle of at least two doctor services at a package price
+// @Tags Service Package
+// @Accept json
+// @Produce json
+// @Param ServicePackageReq body model_healthcare_service.ServicePackageReq true "ServicePackageReq"
+// @Success 200 {object} model_healthcare_service.ServicePackage
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/service-package [post]
+func (h *HandlerV1) CreateServicePackage(c *gin.Context) {
+	var body model_healthcare_service.ServicePackageReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateServicePackage") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	servicePackage, err := h.serviceManager.HealthcareService().ServicePackageService().CreateServicePackage(ctx, &pb.ServicePackage{
+		Id:               uuid.NewString(),
+		Name:             body.Name,
+		Description:      body.Description,
+		Price:            body.Price,
+		DoctorServiceIds: body.DoctorServiceIds,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateServicePackage") {
+		return
+	}
+
+	c.JSON(http.StatusOK, servicePackageRes(servicePackage))
+}
+
+// GetServicePackage ...
+// @Summary GetServicePackage
+// @Description GetServicePackage - API to get service package by ID
+// @Tags Service Package
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_healthcare_service.ServicePackage
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/service-package/get [get]
+func (h *HandlerV1) GetServicePackage(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	servicePackage, err := h.serviceManager.HealthcareService().ServicePackageService().GetServicePackage(ctx, &pb.ServicePackageId{
+		Id: c.Query("id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetServicePackage") {
+		return
+	}
+
+	c.JSON(http.StatusOK, servicePackageRes(servicePackage))
+}
+
+// ListServicePackages ...
+// @Summary ListServicePackages
+// @Description ListServicePackages - API to list service packages, doctor_service_id selects the packages containing the service
+// @Tags Service Package
+// @Accept json
+// @Produce json
+// @Param doctor_service_id query string false "doctor_service_id"
+// @Param page query uint64 false "page"
+// @Param limit query uint64 false "limit"
+// @Success 200 {object} model_healthcare_service.ListServicePackages
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/service-package [get]
+func (h *HandlerV1) ListServicePackages(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListServicePackages") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	servicePackages, err := h.serviceManager.HealthcareService().ServicePackageService().ListServicePackages(ctx, &pb.ListServicePackagesReq{
+		Page:            int64(pageInt),
+		Limit:           int64(limitInt),
+		DoctorServiceId: c.Query("doctor_service_id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListServicePackages") {
+		return
+	}
+
+	var servicePackagesRes model_healthcare_service.ListServicePackages
+	for _, servicePackage := range servicePackages.ServicePackages {
+		servicePackagesRes.ServicePackages = append(servicePackagesRes.ServicePackages, servicePackageRes(servicePackage))
+	}
+	servicePackagesRes.Count = servicePackages.Count
+
+	c.JSON(http.StatusOK, servicePackagesRes)
+}
+
+// UpdateServicePackage ...
+// @Summary UpdateServicePackage
+// @Description UpdateServicePackage - API to update a service package, doctor_service_ids replaces the services of the package
+// @Tags Service Package
+// @Accept json
+// @Produce json
+// @Param UpdateServicePackageReq body model_healthcare_service.UpdateServicePackageReq true "UpdateServicePackageReq"
+// @Success 200 {object} model_healthcare_service.ServicePackage
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/service-package [put]
+func (h *HandlerV1) UpdateServicePackage(c *gin.Context) {
+	var body model_healthcare_service.UpdateServicePackageReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateServicePackage") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	servicePackage, err := h.serviceManager.HealthcareService().ServicePackageService().UpdateServicePackage(ctx, &pb.ServicePackage{
+		Id:               body.Id,
+		Name:             body.Name,
+		Description:      body.Description,
+		Price:            body.Price,
+		DoctorServiceIds: body.DoctorServiceIds,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateServicePackage") {
+		return
+	}
+
+	c.JSON(http.StatusOK, servicePackageRes(servicePackage))
+}
+
+// DeleteServicePackage ...
+// @Summary DeleteServicePackage
+// @Description DeleteServicePackage - API to delete a service package
+// @Tags Service Package
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/service-package [delete]
+func (h *HandlerV1) DeleteServicePackage(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().ServicePackageService().DeleteServicePackage(ctx, &pb.ServicePackageId{
+		Id: c.Query("id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DeleteServicePackage") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
@@ -6,6 +6,7 @@ import (
 	"dennic_admin_api_gateway/api/models"
 	"dennic_admin_api_gateway/api/models/model_healthcare_service"
 	pb "dennic_admin_api_gateway/genproto/healthcare-service"
+	cache "dennic_admin_api_gateway/internal/infrastructure/redis"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -46,6 +47,7 @@ func (h *HandlerV1) CreateSpecialization(c *gin.Context) {
 		Description:  body.Description,
 		DepartmentId: body.DepartmentId,
 		ImageUrl:     body.ImageUrl,
+		ParentId:     body.ParentId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateSpecialization") {
@@ -59,6 +61,7 @@ func (h *HandlerV1) CreateSpecialization(c *gin.Context) {
 		Description:  specialization.Description,
 		DepartmentId: specialization.DepartmentId,
 		ImageUrl:     specialization.ImageUrl,
+		ParentId:     specialization.ParentId,
 		CreatedAt:    specialization.CreatedAt,
 		UpdatedAt:    e.UpdateTimeFilter(specialization.UpdatedAt),
 	})
@@ -71,13 +74,14 @@ func (h *HandlerV1) CreateSpecialization(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id query string true "id"
+// @Param Accept-Language header string false "uz, ru or en"
 // @Success 200 {object} model_healthcare_service.SpecializationRes
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
 // @Router /v1/specialization/get [get]
 func (h *HandlerV1) GetSpecialization(c *gin.Context) {
 	id := c.Query("id")
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	ctx, cancel := context.WithTimeout(e.LanguageContext(context.Background(), c), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
 	specialization, err := h.serviceManager.HealthcareService().SpecializationService().GetSpecializationById(ctx, &pb.GetReqStrSpecialization{
@@ -97,6 +101,7 @@ func (h *HandlerV1) GetSpecialization(c *gin.Context) {
 		Description:  specialization.Description,
 		DepartmentId: specialization.DepartmentId,
 		ImageUrl:     specialization.ImageUrl,
+		ParentId:     specialization.ParentId,
 		CreatedAt:    specialization.CreatedAt,
 		UpdatedAt:    e.UpdateTimeFilter(specialization.UpdatedAt),
 	})
@@ -110,7 +115,9 @@ func (h *HandlerV1) GetSpecialization(c *gin.Context) {
 // @Produce json
 // @Param ListReq query models.ListReq false "ListReq"
 // @Param department_id query string false "department_id"
+// @Param parent_id query string false "parent_id, lists the direct sub-specializations"
 // @Param search query string false "search" Enums(name, description) "search"
+// @Param Accept-Language header string false "uz, ru or en"
 // @Success 200 {object} model_healthcare_service.ListSpecializations
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -123,12 +130,17 @@ func (h *HandlerV1) ListSpecializations(c *gin.Context) {
 	orderBy := c.Query("orderBy")
 
 	departmentId := c.Query("department_id")
+	parentId := c.Query("parent_id")
 	pageInt, limitInt, err := e.ParseQueryParams(page, limit)
 	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListSpecializations") {
 		return
 	}
+	cacheKey, hit := h.cachedCatalogue(c, cache.CatalogueSpecializations)
+	if hit {
+		return
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	ctx, cancel := context.WithTimeout(e.LanguageContext(context.Background(), c), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
 	specializations, err := h.serviceManager.HealthcareService().SpecializationService().GetAllSpecializations(ctx, &pb.GetAllSpecialization{
@@ -139,6 +151,7 @@ func (h *HandlerV1) ListSpecializations(c *gin.Context) {
 		Limit:        int32(limitInt),
 		OrderBy:      orderBy,
 		DepartmentId: departmentId,
+		ParentId:     parentId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListSpecializations") {
@@ -154,12 +167,13 @@ func (h *HandlerV1) ListSpecializations(c *gin.Context) {
 			Description:  specializationRes.Description,
 			DepartmentId: specializationRes.DepartmentId,
 			ImageUrl:     specializationRes.ImageUrl,
+			ParentId:     specializationRes.ParentId,
 			CreatedAt:    specializationRes.CreatedAt,
 			UpdatedAt:    e.UpdateTimeFilter(specializationRes.UpdatedAt),
 		})
 	}
 
-	c.JSON(http.StatusOK, model_healthcare_service.ListSpecializations{
+	h.cacheCatalogue(c, cacheKey, model_healthcare_service.ListSpecializations{
 		Count:           specializations.Count,
 		Specializations: specializationsRes.Specializations,
 	})
@@ -199,6 +213,7 @@ func (h *HandlerV1) UpdateSpecialization(c *gin.Context) {
 		Description:  body.Description,
 		DepartmentId: body.DepartmentId,
 		ImageUrl:     body.ImageUrl,
+		ParentId:     body.ParentId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateSpecialization") {
@@ -212,6 +227,7 @@ func (h *HandlerV1) UpdateSpecialization(c *gin.Context) {
 		Description:  specialization.Description,
 		DepartmentId: specialization.DepartmentId,
 		ImageUrl:     specialization.ImageUrl,
+		ParentId:     specialization.ParentId,
 		CreatedAt:    specialization.CreatedAt,
 		UpdatedAt:    e.UpdateTimeFilter(specialization.UpdatedAt),
 	})
@@ -246,3 +262,72 @@ func (h *HandlerV1) DeleteSpecialization(c *gin.Context) {
 
 	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
 }
+
+// RestoreSpecialization ...
+// @Summary RestoreSpecialization
+// @Description RestoreSpecialization - Api for restore a deleted specialization together with the records its delete cascaded to
+// @Tags Specialization
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/specialization/restore [put]
+func (h *HandlerV1) RestoreSpecialization(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().SpecializationService().RestoreSpecialization(ctx, &pb.RestoreSpecializationReq{
+		Id: c.Query("id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "RestoreSpecialization") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
+
+// GetSpecializationTree ...
+// @Summary GetSpecializationTree
+// @Description GetSpecializationTree - Api for get a specialization with all its sub-specializations, the whole tree when root_id is empty, parents are listed before their children
+// @Tags Specialization
+// @Accept json
+// @Produce json
+// @Param root_id query string false "root_id"
+// @Param Accept-Language header string false "uz, ru or en"
+// @Success 200 {object} model_healthcare_service.ListSpecializations
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/specialization/tree [get]
+func (h *HandlerV1) GetSpecializationTree(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(e.LanguageContext(context.Background(), c), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	specializations, err := h.serviceManager.HealthcareService().SpecializationService().GetSpecializationTree(ctx, &pb.SpecializationTreeReq{
+		RootId: c.Query("root_id"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetSpecializationTree") {
+		return
+	}
+
+	var specializationsRes model_healthcare_service.ListSpecializations
+	for _, specializationRes := range specializations.Specializations {
+		specializationsRes.Specializations = append(specializationsRes.Specializations, &model_healthcare_service.SpecializationRes{
+			ID:           specializationRes.Id,
+			Order:        specializationRes.Order,
+			Name:         specializationRes.Name,
+			Description:  specializationRes.Description,
+			DepartmentId: specializationRes.DepartmentId,
+			ImageUrl:     specializationRes.ImageUrl,
+			ParentId:     specializationRes.ParentId,
+			CreatedAt:    specializationRes.CreatedAt,
+			UpdatedAt:    e.UpdateTimeFilter(specializationRes.UpdatedAt),
+		})
+	}
+	specializationsRes.Count = specializations.Count
+
+	c.JSON(http.StatusOK, specializationsRes)
+}
@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"context"
+	e "dennic_admin_api_gateway/api/handlers/regtool"
+	"dennic_admin_api_gateway/api/models"
+	"dennic_admin_api_gateway/api/models/model_healthcare_service"
+	pb "dennic_admin_api_gateway/genproto/healthcare-service"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetTranslation ...
+// @Summary SetTranslation
+// @Description SetTranslation - Api for create or replace a translation of a department, specialization, reason or doctor service field
+// @Tags Translation
+// @Accept json
+// @Produce json
+// @Param TranslationReq body model_healthcare_service.TranslationReq true "TranslationReq"
+// @Success 200 {object} model_healthcare_service.TranslationRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/translation [put]
+func (h *HandlerV1) SetTranslation(c *gin.Context) {
+	var body model_healthcare_service.TranslationReq
+
+	err := c.ShouldBindJSON(&body)
+
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "SetTranslation") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	translation, err := h.serviceManager.HealthcareService().TranslationService().SetTranslation(ctx, &pb.Translation{
+		EntityType: body.EntityType,
+		EntityId:   body.EntityId,
+		Lang:       body.Lang,
+		Field:      body.Field,
+		Value:      body.Value,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "SetTranslation") {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_healthcare_service.TranslationRes{
+		EntityType: translation.EntityType,
+		EntityId:   translation.EntityId,
+		Lang:       translation.Lang,
+		Field:      translation.Field,
+		Value:      translation.Value,
+		CreatedAt:  translation.CreatedAt,
+		UpdatedAt:  e.UpdateTimeFilter(translation.UpdatedAt),
+	})
+}
+
+// ListTranslations ...
+// @Summary ListTranslations
+// @Description ListTranslations - Api for list translations of an entity
+// @Tags Translation
+// @Accept json
+// @Produce json
+// @Param entity_type query string true "department, specialization, reason or doctor_service"
+// @Param entity_id query string true "entity_id"
+// @Param lang query string false "uz, ru or en, all languages when empty"
+// @Success 200 {object} model_healthcare_service.ListTranslations
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/translation [get]
+func (h *HandlerV1) ListTranslations(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	translations, err := h.serviceManager.HealthcareService().TranslationService().GetTranslations(ctx, &pb.GetTranslationsReq{
+		EntityType: c.Query("entity_type"),
+		EntityId:   c.Query("entity_id"),
+		Lang:       c.Query("lang"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListTranslations") {
+		return
+	}
+
+	var translationsRes model_healthcare_service.ListTranslations
+	for _, translation := range translations.Translations {
+		translationsRes.Translations = append(translationsRes.Translations, &model_healthcare_service.TranslationRes{
+			EntityType: translation.EntityType,
+			EntityId:   translation.EntityId,
+			Lang:       translation.Lang,
+			Field:      translation.Field,
+			Value:      translation.Value,
+			CreatedAt:  translation.CreatedAt,
+			UpdatedAt:  e.UpdateTimeFilter(translation.UpdatedAt),
+		})
+	}
+
+	c.JSON(http.StatusOK, translationsRes)
+}
+
+// DeleteTranslation ...
+// @Summary DeleteTranslation
+// @Description DeleteTranslation - Api for delete a translation, every field of the language when field is empty
+// @Tags Translation
+// @Accept json
+// @Produce json
+// @Param entity_type query string true "department, specialization, reason or doctor_service"
+// @Param entity_id query string true "entity_id"
+// @Param lang query string true "uz, ru or en"
+// @Param field query string false "field"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/translation [delete]
+func (h *HandlerV1) DeleteTranslation(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().TranslationService().DeleteTranslation(ctx, &pb.DeleteTranslationReq{
+		EntityType: c.Query("entity_type"),
+		EntityId:   c.Query("entity_id"),
+		Lang:       c.Query("lang"),
+		Field:      c.Query("field"),
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DeleteTranslation") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
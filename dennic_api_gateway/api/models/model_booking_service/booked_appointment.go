@@ -15,6 +15,9 @@ type Appointment struct {
 	DoctorServiceId string  `json:"doctor_service_id"`
 	PaymentType     string  `json:"payment_type"`
 	PaymentAmount   float64 `json:"payment_amount"`
+	ResourceId      string  `json:"resource_id"`
+	PromoCode       string  `json:"promo_code"`
+	DiscountAmount  float64 `json:"discount_amount"`
 	CreatedAt       string  `json:"created_at"`
 	UpdatedAt       string  `json:"updated_at"`
 }
@@ -38,6 +41,7 @@ type CreateAppointmentReq struct {
 	DoctorServiceId string  `json:"doctor_service_id"`
 	PaymentType     string  `json:"payment_type"`
 	PaymentAmount   float64 `json:"payment_amount"`
+	PromoCode       string  `json:"promo_code"`
 }
 
 type UpdateAppointmentReq struct {
@@ -0,0 +1,17 @@
+package model_booking_service
+
+type BookingRules struct {
+	MaxActivePerPatient int64  `json:"max_active_per_patient"`
+	MaxActivePerDoctor  int64  `json:"max_active_per_doctor"`
+	MaxPerDay           int64  `json:"max_per_day"`
+	BlockExpiredLicense bool   `json:"block_expired_license"`
+	CreatedAt           string `json:"created_at"`
+	UpdatedAt           string `json:"updated_at"`
+}
+
+type UpdateBookingRulesReq struct {
+	MaxActivePerPatient int64 `json:"max_active_per_patient"`
+	MaxActivePerDoctor  int64 `json:"max_active_per_doctor"`
+	MaxPerDay           int64 `json:"max_per_day"`
+	BlockExpiredLicense bool  `json:"block_expired_license"`
+}
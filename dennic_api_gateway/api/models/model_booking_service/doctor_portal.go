@@ -0,0 +1,11 @@
+package model_booking_service
+
+type DoctorPortalNoteReq struct {
+	AppointmentId int64  `json:"appointment_id"`
+	Prescription  string `json:"prescription"`
+}
+
+type AttendanceReq struct {
+	AppointmentId int64  `json:"appointment_id"`
+	Status        string `json:"status" example:"attended"`
+}
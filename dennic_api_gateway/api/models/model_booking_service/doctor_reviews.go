@@ -0,0 +1,34 @@
+package model_booking_service
+
+// Review is left by a patient for an attended appointment, status is one of pending, approved or rejected
+type Review struct {
+	Id             int64  `json:"id"`
+	AppointmentId  int64  `json:"appointment_id"`
+	DoctorId       string `json:"doctor_id"`
+	PatientId      string `json:"patient_id"`
+	Rating         int64  `json:"rating"`
+	Comment        string `json:"comment"`
+	Status         string `json:"status"`
+	ModerationNote string `json:"moderation_note"`
+	ModeratedAt    string `json:"moderated_at"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+}
+
+type ReviewsType struct {
+	Count   int64     `json:"count"`
+	Reviews []*Review `json:"reviews"`
+}
+
+type CreateReviewReq struct {
+	AppointmentId int64  `json:"appointment_id"`
+	PatientId     string `json:"patient_id"`
+	Rating        int64  `json:"rating" example:"5"`
+	Comment       string `json:"comment"`
+}
+
+type ModerateReviewReq struct {
+	Id             int64  `json:"id"`
+	Status         string `json:"status" example:"approved"`
+	ModerationNote string `json:"moderation_note"`
+}
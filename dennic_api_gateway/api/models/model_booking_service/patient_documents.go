@@ -0,0 +1,32 @@
+package model_booking_service
+
+// PatientDocument is a lab result, scan, prescription or other file of a patient, kept in the private document bucket,
+// appointment_id and doctor_note_id are 0 when it is not attached to them
+type PatientDocument struct {
+	Id            int64  `json:"id"`
+	PatientId     string `json:"patient_id"`
+	UserId        string `json:"user_id"`
+	AppointmentId int64  `json:"appointment_id"`
+	DoctorNoteId  int64  `json:"doctor_note_id"`
+	DocumentType  string `json:"document_type"`
+	Title         string `json:"title"`
+	ObjectName    string `json:"object_name"`
+	ContentType   string `json:"content_type"`
+	Size          int64  `json:"size"`
+	UploadedAt    string `json:"uploaded_at"`
+}
+
+type PatientDocumentsType struct {
+	Count            int64              `json:"count"`
+	PatientDocuments []*PatientDocument `json:"patient_documents"`
+}
+
+// CreatePatientDocumentReq attaches a file uploaded through a presigned url, object_name is the one the presign returned
+type CreatePatientDocumentReq struct {
+	PatientId     string `json:"patient_id"`
+	AppointmentId int64  `json:"appointment_id" example:"0"`
+	DoctorNoteId  int64  `json:"doctor_note_id" example:"0"`
+	DocumentType  string `json:"document_type" example:"lab_result" enums:"lab_result,scan,prescription,other"`
+	Title         string `json:"title" example:"Blood test"`
+	ObjectName    string `json:"object_name"`
+}
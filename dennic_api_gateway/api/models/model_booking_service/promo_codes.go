@@ -0,0 +1,39 @@
+package model_booking_service
+
+// PromoCode takes value percents (kind percent) or value (kind fixed) off the payment amount of an appointment,
+// it is valid from valid_from until valid_until and at most max_uses times, 0 is unlimited
+type PromoCode struct {
+	Id         int64   `json:"id"`
+	Code       string  `json:"code"`
+	Kind       string  `json:"kind"`
+	Value      float64 `json:"value"`
+	MaxUses    int64   `json:"max_uses"`
+	UsedCount  int64   `json:"used_count"`
+	ValidFrom  string  `json:"valid_from"`
+	ValidUntil string  `json:"valid_until"`
+	CreatedAt  string  `json:"created_at"`
+	UpdatedAt  string  `json:"updated_at"`
+}
+
+type PromoCodesType struct {
+	Count      int64        `json:"count"`
+	PromoCodes []*PromoCode `json:"promo_codes"`
+}
+
+type CreatePromoCodeReq struct {
+	Code       string  `json:"code" example:"SPRING10"`
+	Kind       string  `json:"kind" example:"percent" enums:"percent,fixed"`
+	Value      float64 `json:"value" example:"10"`
+	MaxUses    int64   `json:"max_uses" example:"100"`
+	ValidFrom  string  `json:"valid_from" example:"2024-03-01 00:00:00"`
+	ValidUntil string  `json:"valid_until" example:"2024-06-01 00:00:00"`
+}
+
+type UpdatePromoCodeReq struct {
+	Id         int64   `json:"id"`
+	Kind       string  `json:"kind" example:"percent" enums:"percent,fixed"`
+	Value      float64 `json:"value" example:"10"`
+	MaxUses    int64   `json:"max_uses" example:"100"`
+	ValidFrom  string  `json:"valid_from" example:"2024-03-01 00:00:00"`
+	ValidUntil string  `json:"valid_until" example:"2024-06-01 00:00:00"`
+}
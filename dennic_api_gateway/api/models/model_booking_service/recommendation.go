@@ -0,0 +1,26 @@
+package model_booking_service
+
+// DoctorRecommendation is a ranked doctor, slot_date and slot_time are empty when no free slot was found
+type DoctorRecommendation struct {
+	DoctorId        string  `json:"doctor_id"`
+	FirstName       string  `json:"first_name"`
+	LastName        string  `json:"last_name"`
+	ImageUrl        string  `json:"image_url"`
+	DepartmentId    string  `json:"department_id"`
+	DoctorServiceId string  `json:"doctor_service_id"`
+	Price           float64 `json:"price"`
+	Duration        int64   `json:"duration"`
+	Rating          float64 `json:"rating"`
+	ReviewCount     int64   `json:"review_count"`
+	SlotFound       bool    `json:"slot_found"`
+	SlotDate        string  `json:"slot_date"`
+	SlotTime        string  `json:"slot_time"`
+	Score           float64 `json:"score"`
+}
+
+type DoctorRecommendations struct {
+	ReasonId         string                  `json:"reason_id"`
+	ReasonName       string                  `json:"reason_name"`
+	SpecializationId string                  `json:"specialization_id"`
+	Recommendations  []*DoctorRecommendation `json:"recommendations"`
+}
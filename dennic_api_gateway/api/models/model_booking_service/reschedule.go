@@ -0,0 +1,52 @@
+package model_booking_service
+
+// RescheduleReq selects the waiting appointments of a doctor overlapping the unavailable range,
+// empty start_time and end_time mean the start and the end of the day
+type RescheduleReq struct {
+	DoctorId       string  `json:"doctor_id"`
+	StartDate      string  `json:"start_date" example:"2024-05-01"`
+	StartTime      string  `json:"start_time" example:"09:00:00"`
+	EndDate        string  `json:"end_date" example:"2024-05-03"`
+	EndTime        string  `json:"end_time" example:"18:00:00"`
+	Strategy       string  `json:"strategy" example:"other_doctor"`
+	DaysAhead      int64   `json:"days_ahead" example:"14"`
+	AppointmentIds []int64 `json:"appointment_ids"`
+}
+
+type RescheduleProposal struct {
+	AppointmentId      int64  `json:"appointment_id"`
+	PatientId          string `json:"patient_id"`
+	DepartmentId       string `json:"department_id"`
+	DoctorId           string `json:"doctor_id"`
+	DoctorServiceId    string `json:"doctor_service_id"`
+	AppointmentDate    string `json:"appointment_date"`
+	AppointmentTime    string `json:"appointment_time"`
+	Duration           int64  `json:"duration"`
+	NewDoctorId        string `json:"new_doctor_id"`
+	NewDoctorServiceId string `json:"new_doctor_service_id"`
+	NewAppointmentDate string `json:"new_appointment_date"`
+	NewAppointmentTime string `json:"new_appointment_time"`
+	Found              bool   `json:"found"`
+	Applied            bool   `json:"applied"`
+}
+
+type RescheduleProposals struct {
+	Count     int64                 `json:"count"`
+	Applied   int64                 `json:"applied"`
+	Proposals []*RescheduleProposal `json:"proposals"`
+}
+
+type PatientNotification struct {
+	Id            int64  `json:"id"`
+	PatientId     string `json:"patient_id"`
+	AppointmentId int64  `json:"appointment_id"`
+	Kind          string `json:"kind"`
+	Message       string `json:"message"`
+	CreatedAt     string `json:"created_at"`
+	ReadAt        string `json:"read_at"`
+}
+
+type PatientNotifications struct {
+	Count         int64                  `json:"count"`
+	Notifications []*PatientNotification `json:"notifications"`
+}
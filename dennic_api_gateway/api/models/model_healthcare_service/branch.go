@@ -0,0 +1,53 @@
+package model_healthcare_service
+
+// Branch is an address of the clinic, opens_at and closes_at are times of day
+type Branch struct {
+	Id           string   `json:"id"`
+	Name         string   `json:"name"`
+	Address      string   `json:"address"`
+	City         string   `json:"city"`
+	Latitude     float64  `json:"latitude"`
+	Longitude    float64  `json:"longitude"`
+	OpensAt      string   `json:"opens_at"`
+	ClosesAt     string   `json:"closes_at"`
+	PhoneNumbers []string `json:"phone_numbers"`
+	CreatedAt    string   `json:"created_at"`
+	UpdatedAt    string   `json:"updated_at"`
+}
+
+type BranchReq struct {
+	Name         string   `json:"name" example:"Chilonzor branch"`
+	Address      string   `json:"address" example:"Bunyodkor avenue, 1"`
+	City         string   `json:"city" example:"Tashkent"`
+	Latitude     float64  `json:"latitude" example:"41.2995"`
+	Longitude    float64  `json:"longitude" example:"69.2401"`
+	OpensAt      string   `json:"opens_at" example:"08:00"`
+	ClosesAt     string   `json:"closes_at" example:"20:00"`
+	PhoneNumbers []string `json:"phone_numbers"`
+}
+
+type UpdateBranchReq struct {
+	Id           string   `json:"id"`
+	Name         string   `json:"name" example:"Chilonzor branch"`
+	Address      string   `json:"address" example:"Bunyodkor avenue, 1"`
+	City         string   `json:"city" example:"Tashkent"`
+	Latitude     float64  `json:"latitude" example:"41.2995"`
+	Longitude    float64  `json:"longitude" example:"69.2401"`
+	OpensAt      string   `json:"opens_at" example:"08:00"`
+	ClosesAt     string   `json:"closes_at" example:"20:00"`
+	PhoneNumbers []string `json:"phone_numbers"`
+}
+
+type ListBranches struct {
+	Count    int64     `json:"count"`
+	Branches []*Branch `json:"branches"`
+}
+
+type NearbyBranch struct {
+	Branch     *Branch `json:"branch"`
+	DistanceKm float64 `json:"distance_km"`
+}
+
+type ListNearbyBranches struct {
+	Branches []*NearbyBranch `json:"branches"`
+}
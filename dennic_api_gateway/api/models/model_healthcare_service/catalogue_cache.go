@@ -0,0 +1,11 @@
+package model_healthcare_service
+
+type CatalogueCacheStats struct {
+	Resource string `json:"resource"`
+	Hits     int64  `json:"hits"`
+	Misses   int64  `json:"misses"`
+}
+
+type ListCatalogueCacheStats struct {
+	Stats []CatalogueCacheStats `json:"stats"`
+}
@@ -1,45 +1,49 @@
 package model_healthcare_service
 
 type DoctorReq struct {
-	FirstName     string  `json:"first_name" example:"First Name"`
-	LastName      string  `json:"last_name" example:"Last Name"`
-	ImageUrl      string  `json:"image_url" example:"http://example.com/image.png"`
-	Gender        string  `json:"gender" example:"male"`
-	BirthDate     string  `json:"birth_date" example:"2012-12-12"`
-	PhoneNumber   string  `json:"phone_number" example:"+998901234567"`
-	Email         string  `json:"email" example:"email@gmail.com"`
-	Address       string  `json:"address" example:"Addres"`
-	City          string  `json:"city" example:"City"`
-	Country       string  `json:"country" example:"Country"`
-	Salary        float32 `json:"salary" example:"10"`
-	Bio           string  `json:"bio" example:"Biography"`
-	StartWorkDate string  `json:"start_work_date" example:"2012-12-12"`
-	WorkYears     int32   `json:"work_years" example:"4"`
-	DepartmentId  string  `json:"department_id" example:"123e4567-e89b-12d3-a456-426614174001"`
-	RoomNumber    int32   `json:"room_number" example:"1"`
-	Password      string  `json:"password" example:"password"`
+	FirstName     string   `json:"first_name" example:"First Name"`
+	LastName      string   `json:"last_name" example:"Last Name"`
+	ImageUrl      string   `json:"image_url" example:"http://example.com/image.png"`
+	Gender        string   `json:"gender" example:"male"`
+	BirthDate     string   `json:"birth_date" example:"2012-12-12"`
+	PhoneNumber   string   `json:"phone_number" example:"+998901234567"`
+	Email         string   `json:"email" example:"email@gmail.com"`
+	Address       string   `json:"address" example:"Addres"`
+	City          string   `json:"city" example:"City"`
+	Country       string   `json:"country" example:"Country"`
+	Salary        float32  `json:"salary" example:"10"`
+	Bio           string   `json:"bio" example:"Biography"`
+	StartWorkDate string   `json:"start_work_date" example:"2012-12-12"`
+	WorkYears     int32    `json:"work_years" example:"4"`
+	DepartmentId  string   `json:"department_id" example:"123e4567-e89b-12d3-a456-426614174001"`
+	DepartmentIds []string `json:"department_ids" example:"123e4567-e89b-12d3-a456-426614174002"`
+	RoomNumber    int32    `json:"room_number" example:"1"`
+	BranchId      string   `json:"branch_id" example:"123e4567-e89b-12d3-a456-426614274002"`
+	Password      string   `json:"password" example:"password"`
 }
 
 type DoctorUpdateReq struct {
-	Id            string  `json:"id" example:"123e4567-e89b-12d3-a456-426614274001"`
-	FirstName     string  `json:"first_name" example:"First Name"`
-	LastName      string  `json:"last_name" example:"Last Name"`
-	ImageUrl      string  `json:"image_url" example:"http://example.com/image.png"`
-	Gender        string  `json:"gender" example:"male"`
-	BirthDate     string  `json:"birth_date" example:"2012-12-12"`
-	PhoneNumber   string  `json:"phone_number" example:"+998901234567"`
-	Email         string  `json:"email" example:"email@gmail.com"`
-	Address       string  `json:"address" example:"Addres"`
-	City          string  `json:"city" example:"City"`
-	Country       string  `json:"country" example:"Country"`
-	Salary        float32 `json:"salary" example:"10"`
-	Bio           string  `json:"bio" example:"Biography"`
-	StartWorkDate string  `json:"start_work_date" example:"2012-12-12"`
-	EndWorkDate   string  `json:"end-work-date" example:"2022-12-12"`
-	WorkYears     int32   `json:"work_years" example:"4"`
-	DepartmentId  string  `json:"department_id" example:"123e4567-e89b-12d3-a456-426614174001"`
-	RoomNumber    int32   `json:"room_number" example:"1"`
-	Password      string  `json:"password" example:"password"`
+	Id            string   `json:"id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	FirstName     string   `json:"first_name" example:"First Name"`
+	LastName      string   `json:"last_name" example:"Last Name"`
+	ImageUrl      string   `json:"image_url" example:"http://example.com/image.png"`
+	Gender        string   `json:"gender" example:"male"`
+	BirthDate     string   `json:"birth_date" example:"2012-12-12"`
+	PhoneNumber   string   `json:"phone_number" example:"+998901234567"`
+	Email         string   `json:"email" example:"email@gmail.com"`
+	Address       string   `json:"address" example:"Addres"`
+	City          string   `json:"city" example:"City"`
+	Country       string   `json:"country" example:"Country"`
+	Salary        float32  `json:"salary" example:"10"`
+	Bio           string   `json:"bio" example:"Biography"`
+	StartWorkDate string   `json:"start_work_date" example:"2012-12-12"`
+	EndWorkDate   string   `json:"end-work-date" example:"2022-12-12"`
+	WorkYears     int32    `json:"work_years" example:"4"`
+	DepartmentId  string   `json:"department_id" example:"123e4567-e89b-12d3-a456-426614174001"`
+	DepartmentIds []string `json:"department_ids" example:"123e4567-e89b-12d3-a456-426614174002"`
+	RoomNumber    int32    `json:"room_number" example:"1"`
+	BranchId      string   `json:"branch_id" example:"123e4567-e89b-12d3-a456-426614274002"`
+	Password      string   `json:"password" example:"password"`
 }
 
 type DoctorSpec struct {
@@ -48,29 +52,30 @@ type DoctorSpec struct {
 }
 
 type DoctorRes struct {
-	Id            string  `json:"id"`
-	Order         int32   `json:"order"`
-	FirstName     string  `json:"first_name"`
-	LastName      string  `json:"last_name"`
-	ImageUrl      string  `json:"image_url"`
-	Gender        string  `json:"gender"`
-	BirthDate     string  `json:"birth_date"`
-	PhoneNumber   string  `json:"phone_number"`
-	Email         string  `json:"email"`
-	Address       string  `json:"address"`
-	City          string  `json:"city"`
-	Country       string  `json:"country"`
-	Salary        float32 `json:"salary"`
-	Bio           string  `json:"bio"`
-	StartWorkDate string  `json:"start_work_date"`
-	EndWorkDate   string  `json:"end_work_date"`
-	WorkYears     int32   `json:"work_years"`
-	DepartmentId  string  `json:"department_id"`
-	RoomNumber    int32   `json:"room_number"`
-	Password      string  `json:"-"`
-	CreatedAt     string  `json:"created_at"`
-	UpdatedAt     string  `json:"updated_at"`
-	DeletedAt     string  `json:"deleted_at"`
+	Id            string   `json:"id"`
+	Order         int32    `json:"order"`
+	FirstName     string   `json:"first_name"`
+	LastName      string   `json:"last_name"`
+	ImageUrl      string   `json:"image_url"`
+	Gender        string   `json:"gender"`
+	BirthDate     string   `json:"birth_date"`
+	PhoneNumber   string   `json:"phone_number"`
+	Email         string   `json:"email"`
+	Address       string   `json:"address"`
+	City          string   `json:"city"`
+	Country       string   `json:"country"`
+	Salary        float32  `json:"salary"`
+	Bio           string   `json:"bio"`
+	StartWorkDate string   `json:"start_work_date"`
+	EndWorkDate   string   `json:"end_work_date"`
+	WorkYears     int32    `json:"work_years"`
+	DepartmentId  string   `json:"department_id"`
+	DepartmentIds []string `json:"department_ids"`
+	RoomNumber    int32    `json:"room_number"`
+	BranchId      string   `json:"branch_id"`
+	CreatedAt     string   `json:"created_at"`
+	UpdatedAt     string   `json:"updated_at"`
+	DeletedAt     string   `json:"deleted_at"`
 }
 
 type ListDoctors struct {
@@ -100,12 +105,15 @@ type DoctorAndDoctorHours struct {
 	EndWorkDate     string       `json:"end_work_date"`
 	WorkYears       int32        `json:"work_years"`
 	DepartmentId    string       `json:"department_id"`
+	DepartmentIds   []string     `json:"department_ids"`
 	RoomNumber      int32        `json:"room_number"`
-	Password        string       `json:"-"`
+	BranchId        string       `json:"branch_id"`
 	CreatedAt       string       `json:"created_at"`
 	UpdatedAt       string       `json:"updated_at"`
 	DeletedAt       string       `json:"deleted_at"`
 	PatientCount    int64        `json:"patient_count"`
+	Rating          float32      `json:"rating"`
+	ReviewCount     int64        `json:"review_count"`
 	Specializations []DoctorSpec `json:"specializations"`
 }
 
@@ -113,3 +121,17 @@ type ListDoctorsAndHours struct {
 	Count   int64                   `json:"count"`
 	Doctors []*DoctorAndDoctorHours `json:"doctors"`
 }
+
+type DoctorLoginReq struct {
+	Email    string `json:"email" example:"doctor@dennic.uz"`
+	Password string `json:"password" example:"password"`
+}
+
+type DoctorLoginRes struct {
+	Id           string `json:"id"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name"`
+	Email        string `json:"email"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
@@ -0,0 +1,52 @@
+package model_healthcare_service
+
+// DoctorCredential is a diploma, license, certificate or other document of a doctor,
+// document_object_name is the copy in the private document bucket, /v1/doctor-credential/document presigns its url,
+// expiry_flagged_at is set by the daily job once a license expires within the warning period
+type DoctorCredential struct {
+	Id                 int64  `json:"id"`
+	DoctorId           string `json:"doctor_id"`
+	Type               string `json:"type"`
+	IssuingBody        string `json:"issuing_body"`
+	Number             string `json:"number"`
+	IssueDate          string `json:"issue_date"`
+	ExpiryDate         string `json:"expiry_date"`
+	DocumentObjectName string `json:"document_object_name"`
+	ExpiryFlaggedAt    string `json:"expiry_flagged_at"`
+	CreatedAt          string `json:"created_at"`
+	UpdatedAt          string `json:"updated_at"`
+}
+
+type ListDoctorCredentials struct {
+	Count       int64               `json:"count"`
+	Credentials []*DoctorCredential `json:"credentials"`
+}
+
+type CreateDoctorCredentialReq struct {
+	DoctorId           string `json:"doctor_id"`
+	Type               string `json:"type" example:"license"`
+	IssuingBody        string `json:"issuing_body"`
+	Number             string `json:"number"`
+	IssueDate          string `json:"issue_date" example:"2020-09-01"`
+	ExpiryDate         string `json:"expiry_date" example:"2025-09-01"`
+	DocumentObjectName string `json:"document_object_name"`
+}
+
+type UpdateDoctorCredentialReq struct {
+	Id                 int64  `json:"id"`
+	Type               string `json:"type" example:"license"`
+	IssuingBody        string `json:"issuing_body"`
+	Number             string `json:"number"`
+	IssueDate          string `json:"issue_date" example:"2020-09-01"`
+	ExpiryDate         string `json:"expiry_date" example:"2025-09-01"`
+	DocumentObjectName string `json:"document_object_name"`
+}
+
+// DoctorLicenseStatus tells whether the doctor has a license valid on the date,
+// expired is set when the doctor has licenses and none of them is valid
+type DoctorLicenseStatus struct {
+	DoctorId   string `json:"doctor_id"`
+	HasLicense bool   `json:"has_license"`
+	Expired    bool   `json:"expired"`
+	ExpiryDate string `json:"expiry_date"`
+}
@@ -0,0 +1,53 @@
+package model_healthcare_service
+
+// DoctorLeave is a period a doctor is away, kind is one of vacation, sick_leave, conference or other,
+// status is one of pending, approved, rejected or cancelled
+type DoctorLeave struct {
+	Id           int64  `json:"id"`
+	DoctorId     string `json:"doctor_id"`
+	DepartmentId string `json:"department_id"`
+	Kind         string `json:"kind"`
+	StartDate    string `json:"start_date"`
+	EndDate      string `json:"end_date"`
+	Reason       string `json:"reason"`
+	Status       string `json:"status"`
+	ReviewedBy   string `json:"reviewed_by"`
+	ReviewNote   string `json:"review_note"`
+	ReviewedAt   string `json:"reviewed_at"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+type ListDoctorLeaves struct {
+	Count  int64          `json:"count"`
+	Leaves []*DoctorLeave `json:"leaves"`
+}
+
+type CreateDoctorLeaveReq struct {
+	DoctorId  string `json:"doctor_id"`
+	Kind      string `json:"kind" example:"vacation"`
+	StartDate string `json:"start_date" example:"2024-07-01"`
+	EndDate   string `json:"end_date" example:"2024-07-10"`
+	Reason    string `json:"reason"`
+}
+
+type ReviewDoctorLeaveReq struct {
+	Id         int64  `json:"id"`
+	Status     string `json:"status" example:"approved"`
+	ReviewNote string `json:"review_note"`
+}
+
+type AwayDoctor struct {
+	DoctorId     string `json:"doctor_id"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name"`
+	DepartmentId string `json:"department_id"`
+	LeaveId      int64  `json:"leave_id"`
+	Kind         string `json:"kind"`
+	StartDate    string `json:"start_date"`
+	EndDate      string `json:"end_date"`
+}
+
+type ListAwayDoctors struct {
+	Doctors []*AwayDoctor `json:"doctors"`
+}
@@ -8,19 +8,22 @@ type DoctorServicesReq struct {
 	OfflinePrice     float32 `json:"offline_price" example:"1.1"`
 	Name             string  `json:"name" example:"name"`
 	Duration         string  `json:"duration" example:"12:12"`
+	// RequiredResourceType is reserved for every appointment of the service, empty when none is needed
+	RequiredResourceType string `json:"required_resource_type" example:"ultrasound"`
 }
 
 type DoctorServicesRes struct {
-	Id               string  `json:"id"`
-	Order            int32   `json:"order"`
-	DoctorId         string  `json:"doctor_id"`
-	SpecializationId string  `json:"specialization_id"`
-	OnlinePrice      float32 `json:"online_price"`
-	OfflinePrice     float32 `json:"offline_price"`
-	Name             string  `json:"name"`
-	Duration         string  `json:"duration"`
-	CreatedAt        string  `json:"created_at"`
-	UpdatedAt        string  `json:"updated_at"`
+	Id                   string  `json:"id"`
+	Order                int32   `json:"order"`
+	DoctorId             string  `json:"doctor_id"`
+	SpecializationId     string  `json:"specialization_id"`
+	OnlinePrice          float32 `json:"online_price"`
+	OfflinePrice         float32 `json:"offline_price"`
+	Name                 string  `json:"name"`
+	Duration             string  `json:"duration"`
+	RequiredResourceType string  `json:"required_resource_type"`
+	CreatedAt            string  `json:"created_at"`
+	UpdatedAt            string  `json:"updated_at"`
 }
 
 type ListDoctorServices struct {
@@ -1,21 +1,29 @@
 package model_healthcare_service
 
 type DoctorWorkingHoursRes struct {
-	Id         int32  `json:"id"`
-	DoctorId   string `json:"doctor_id"`
-	DayOfWeek  string `json:"day_of_week"`
-	StartTime  string `json:"start_time"`
-	FinishTime string `json:"finish_time"`
-	CreatedAt  string `json:"created_at"`
-	UpdatedAt  string `json:"updated_at"`
+	Id            int32  `json:"id"`
+	DoctorId      string `json:"doctor_id"`
+	DayOfWeek     string `json:"day_of_week"`
+	Kind          string `json:"kind"`
+	StartTime     string `json:"start_time"`
+	FinishTime    string `json:"finish_time"`
+	EffectiveFrom string `json:"effective_from"`
+	EffectiveTo   string `json:"effective_to"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
 }
 
+// DoctorWorkingHoursReq is a shift or a break of a doctor on a weekday, shift when kind is empty,
+// the empty effective_from/effective_to leave the range in which the hours apply open on that side
 type DoctorWorkingHoursReq struct {
-	Id         string `json:"id" example:"123e4567-e89b-12d3-a456-426614274001"`
-	DoctorId   string `json:"doctor_id" example:"123e4567-e89b-12d3-a456-426614274001"`
-	DayOfWeek  string `json:"day_of_week" example:"Monday"`
-	StartTime  string `json:"start_time" example:"12:00:00"`
-	FinishTime string `json:"finish_time" example:"12:00:00"`
+	Id            string `json:"id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	DoctorId      string `json:"doctor_id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	DayOfWeek     string `json:"day_of_week" example:"Monday"`
+	Kind          string `json:"kind" example:"shift" enums:"shift,break"`
+	StartTime     string `json:"start_time" example:"09:00:00"`
+	FinishTime    string `json:"finish_time" example:"13:00:00"`
+	EffectiveFrom string `json:"effective_from" example:"2024-06-01"`
+	EffectiveTo   string `json:"effective_to" example:""`
 }
 
 type ListDoctorWorkingHours struct {
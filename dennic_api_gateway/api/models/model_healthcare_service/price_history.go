@@ -0,0 +1,23 @@
+package model_healthcare_service
+
+// PriceChange is a price of a doctor service taking effect at effective_from, a future effective_from is a scheduled change
+type PriceChange struct {
+	Id              string  `json:"id"`
+	DoctorServiceId string  `json:"doctor_service_id"`
+	OnlinePrice     float32 `json:"online_price"`
+	OfflinePrice    float32 `json:"offline_price"`
+	EffectiveFrom   string  `json:"effective_from"`
+	CreatedAt       string  `json:"created_at"`
+}
+
+type PriceChangeReq struct {
+	DoctorServiceId string  `json:"doctor_service_id"`
+	OnlinePrice     float32 `json:"online_price" example:"120"`
+	OfflinePrice    float32 `json:"offline_price" example:"150"`
+	EffectiveFrom   string  `json:"effective_from" example:"2024-01-01 00:00:00"`
+}
+
+type PriceHistory struct {
+	Count        int64          `json:"count"`
+	PriceChanges []*PriceChange `json:"price_changes"`
+}
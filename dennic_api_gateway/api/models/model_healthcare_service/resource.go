@@ -0,0 +1,32 @@
+package model_healthcare_service
+
+// Resource is a shared room or piece of equipment, resource_type groups interchangeable resources
+type Resource struct {
+	Id           string `json:"id"`
+	Name         string `json:"name"`
+	Kind         string `json:"kind"`
+	ResourceType string `json:"resource_type"`
+	BranchId     string `json:"branch_id"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+type ResourceReq struct {
+	Name         string `json:"name" example:"Ultrasound room 1"`
+	Kind         string `json:"kind" example:"room" enums:"room,equipment"`
+	ResourceType string `json:"resource_type" example:"ultrasound"`
+	BranchId     string `json:"branch_id"`
+}
+
+type UpdateResourceReq struct {
+	Id           string `json:"id"`
+	Name         string `json:"name" example:"Ultrasound room 1"`
+	Kind         string `json:"kind" example:"room" enums:"room,equipment"`
+	ResourceType string `json:"resource_type" example:"ultrasound"`
+	BranchId     string `json:"branch_id"`
+}
+
+type ListResources struct {
+	Count     int64       `json:"count"`
+	Resources []*Resource `json:"resources"`
+}
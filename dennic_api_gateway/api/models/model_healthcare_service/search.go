@@ -0,0 +1,16 @@
+package model_healthcare_service
+
+type SearchHit struct {
+	Type        string  `json:"type"`
+	Id          string  `json:"id"`
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	ImageUrl    string  `json:"image_url"`
+	ParentId    string  `json:"parent_id"`
+	Score       float32 `json:"score"`
+}
+
+type SearchRes struct {
+	Count int32        `json:"count"`
+	Hits  []*SearchHit `json:"hits"`
+}
@@ -0,0 +1,44 @@
+package model_healthcare_service
+
+// ServiceDiscount takes value percents (kind percent) or value (kind fixed) off the price of a doctor service
+// from starts_on until ends_on inclusive, an empty ends_on has no end
+type ServiceDiscount struct {
+	Id              string  `json:"id"`
+	DoctorServiceId string  `json:"doctor_service_id"`
+	Kind            string  `json:"kind"`
+	Value           float64 `json:"value"`
+	StartsOn        string  `json:"starts_on"`
+	EndsOn          string  `json:"ends_on"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+type ServiceDiscountReq struct {
+	DoctorServiceId string  `json:"doctor_service_id"`
+	Kind            string  `json:"kind" example:"percent" enums:"percent,fixed"`
+	Value           float64 `json:"value" example:"10"`
+	StartsOn        string  `json:"starts_on" example:"2024-01-01"`
+	EndsOn          string  `json:"ends_on" example:"2024-01-31"`
+}
+
+type UpdateServiceDiscountReq struct {
+	Id       string  `json:"id"`
+	Kind     string  `json:"kind" example:"percent" enums:"percent,fixed"`
+	Value    float64 `json:"value" example:"10"`
+	StartsOn string  `json:"starts_on" example:"2024-01-01"`
+	EndsOn   string  `json:"ends_on" example:"2024-01-31"`
+}
+
+type ListServiceDiscounts struct {
+	Count            int64              `json:"count"`
+	ServiceDiscounts []*ServiceDiscount `json:"service_discounts"`
+}
+
+// ServicePrice is the price of a doctor service on a day after the largest discount running on it
+type ServicePrice struct {
+	DoctorServiceId string  `json:"doctor_service_id"`
+	BasePrice       float64 `json:"base_price"`
+	Discount        float64 `json:"discount"`
+	Price           float64 `json:"price"`
+	DiscountId      string  `json:"discount_id"`
+}
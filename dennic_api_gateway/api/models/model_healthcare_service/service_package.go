@@ -0,0 +1,33 @@
+package model_healthcare_service
+
+// ServicePackage is a check-up bundle of doctor services sold at price, items_price is the sum of their offline prices
+type ServicePackage struct {
+	Id               string   `json:"id"`
+	Name             string   `json:"name"`
+	Description      string   `json:"description"`
+	Price            float64  `json:"price"`
+	DoctorServiceIds []string `json:"doctor_service_ids"`
+	ItemsPrice       float64  `json:"items_price"`
+	CreatedAt        string   `json:"created_at"`
+	UpdatedAt        string   `json:"updated_at"`
+}
+
+type ServicePackageReq struct {
+	Name             string   `json:"name" example:"Annual check-up"`
+	Description      string   `json:"description"`
+	Price            float64  `json:"price" example:"500000"`
+	DoctorServiceIds []string `json:"doctor_service_ids"`
+}
+
+type UpdateServicePackageReq struct {
+	Id               string   `json:"id"`
+	Name             string   `json:"name" example:"Annual check-up"`
+	Description      string   `json:"description"`
+	Price            float64  `json:"price" example:"500000"`
+	DoctorServiceIds []string `json:"doctor_service_ids"`
+}
+
+type ListServicePackages struct {
+	Count           int64             `json:"count"`
+	ServicePackages []*ServicePackage `json:"service_packages"`
+}
@@ -7,6 +7,7 @@ type SpecializationRes struct {
 	Description  string `json:"description"`
 	DepartmentId string `json:"department_id"`
 	ImageUrl     string `json:"image_url"`
+	ParentId     string `json:"parent_id"`
 	CreatedAt    string `json:"created_at"`
 	UpdatedAt    string `json:"updated_at"`
 }
@@ -17,6 +18,7 @@ type SpecializationReq struct {
 	Description  string `json:"description" example:"Specialization description"`
 	DepartmentId string `json:"department_id" example:"123e4567-e89b-12d3-a456-426614174003"`
 	ImageUrl     string `json:"image_url" example:"http://example.com/image.png"`
+	ParentId     string `json:"parent_id" example:"123e4567-e89b-12d3-a456-426614174004"`
 }
 
 type ListSpecializations struct {
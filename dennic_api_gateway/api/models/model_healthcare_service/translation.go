@@ -0,0 +1,23 @@
+package model_healthcare_service
+
+type TranslationReq struct {
+	EntityType string `json:"entity_type" example:"department"`
+	EntityId   string `json:"entity_id"`
+	Lang       string `json:"lang" example:"ru"`
+	Field      string `json:"field" example:"name"`
+	Value      string `json:"value"`
+}
+
+type TranslationRes struct {
+	EntityType string `json:"entity_type"`
+	EntityId   string `json:"entity_id"`
+	Lang       string `json:"lang"`
+	Field      string `json:"field"`
+	Value      string `json:"value"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+type ListTranslations struct {
+	Translations []*TranslationRes `json:"translations"`
+}
@@ -1,5 +1,29 @@
 package model_minio
 
 type MinioURL struct {
-	URL string `json:"url"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	MediumURL    string `json:"medium_url"`
+}
+
+type PresignReq struct {
+	ContentType string `json:"content_type" example:"application/pdf" enums:"application/pdf,image/jpeg,image/png"`
+	Size        int64  `json:"size"`
+}
+
+type PresignRes struct {
+	UploadURL  string            `json:"upload_url"`
+	Method     string            `json:"method"`
+	Headers    map[string]string `json:"headers"`
+	ObjectName string            `json:"object_name"`
+	ExpiresAt  string            `json:"expires_at"`
+}
+
+type DocumentURL struct {
+	URL       string `json:"url"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type UploadedDocument struct {
+	ObjectName string `json:"object_name"`
 }
@@ -4,6 +4,7 @@ import (
 	// "github.com/casbin/casbin/v2"
 	_ "dennic_admin_api_gateway/api/docs"
 	"dennic_admin_api_gateway/api/middleware/casbin"
+	cache "dennic_admin_api_gateway/internal/infrastructure/redis"
 	"dennic_admin_api_gateway/internal/pkg/redis"
 	"time"
 
@@ -26,6 +27,7 @@ type RouteOption struct {
 	ContextTimeout time.Duration
 	Service        grpcClients.ServiceClient
 	Redis          *redis.RedisDB
+	CatalogueCache *cache.CatalogueCache
 	//BrokerProducer event.BrokerProducer
 
 }
@@ -49,6 +51,7 @@ func NewRoute(option RouteOption) *gin.Engine {
 		ContextTimeout: option.ContextTimeout,
 		Service:        option.Service,
 		Redis:          option.Redis,
+		CatalogueCache: option.CatalogueCache,
 
 		//BrokerProducer: option.BrokerProducer,
 	})
@@ -68,6 +71,8 @@ func NewRoute(option RouteOption) *gin.Engine {
 	api := router.Group("/v1")
 
 	api.POST("/file-upload", HandlerV1.UploadFile)
+	api.POST("/file-upload/presign", HandlerV1.PresignUpload)
+	api.GET("/file-upload/document", HandlerV1.GetDocument)
 
 	// customer
 	customer := api.Group("/customer")
@@ -107,6 +112,94 @@ func NewRoute(option RouteOption) *gin.Engine {
 	doctorNote.PUT("/", HandlerV1.UpdateDoctorNote)
 	doctorNote.DELETE("/", HandlerV1.DeleteDoctorNote)
 
+	// doctor reviews
+	review := api.Group("/review")
+	review.POST("/", HandlerV1.CreateReview)
+	review.GET("/get", HandlerV1.GetReview)
+	review.GET("/", HandlerV1.ListReviews)
+	review.PUT("/moderate", HandlerV1.ModerateReview)
+	review.DELETE("/", HandlerV1.DeleteReview)
+
+	// doctor leaves
+	leave := api.Group("/doctor-leave")
+	leave.POST("/", HandlerV1.CreateDoctorLeave)
+	leave.GET("/get", HandlerV1.GetDoctorLeave)
+	leave.GET("/", HandlerV1.ListDoctorLeaves)
+	leave.PUT("/review", HandlerV1.ReviewDoctorLeave)
+	leave.PUT("/cancel", HandlerV1.CancelDoctorLeave)
+	leave.GET("/away", HandlerV1.ListAwayDoctors)
+
+	// doctor credentials
+	credential := api.Group("/doctor-credential")
+	credential.POST("/", HandlerV1.CreateDoctorCredential)
+	credential.GET("/get", HandlerV1.GetDoctorCredential)
+	credential.GET("/", HandlerV1.ListDoctorCredentials)
+	credential.PUT("/", HandlerV1.UpdateDoctorCredential)
+	credential.DELETE("/", HandlerV1.DeleteDoctorCredential)
+	credential.GET("/license-status", HandlerV1.GetDoctorLicenseStatus)
+	credential.POST("/document", HandlerV1.UploadDoctorCredentialDocument)
+	credential.GET("/document", HandlerV1.GetDoctorCredentialDocument)
+
+	// branch
+	branch := api.Group("/branch")
+	branch.POST("/", HandlerV1.CreateBranch)
+	branch.GET("/get", HandlerV1.GetBranch)
+	branch.GET("/", HandlerV1.ListBranches)
+	branch.PUT("/", HandlerV1.UpdateBranch)
+	branch.DELETE("/", HandlerV1.DeleteBranch)
+	branch.GET("/nearby", HandlerV1.ListBranchesNearby)
+
+	// resource
+	resource := api.Group("/resource")
+	resource.POST("/", HandlerV1.CreateResource)
+	resource.GET("/get", HandlerV1.GetResource)
+	resource.GET("/", HandlerV1.ListResources)
+	resource.PUT("/", HandlerV1.UpdateResource)
+	resource.DELETE("/", HandlerV1.DeleteResource)
+
+	// service package
+	servicePackage := api.Group("/service-package")
+	servicePackage.POST("/", HandlerV1.CreateServicePackage)
+	servicePackage.GET("/get", HandlerV1.GetServicePackage)
+	servicePackage.GET("/", HandlerV1.ListServicePackages)
+	servicePackage.PUT("/", HandlerV1.UpdateServicePackage)
+	servicePackage.DELETE("/", HandlerV1.DeleteServicePackage)
+
+	// service discount
+	serviceDiscount := api.Group("/service-discount")
+	serviceDiscount.POST("/", HandlerV1.CreateServiceDiscount)
+	serviceDiscount.GET("/get", HandlerV1.GetServiceDiscount)
+	serviceDiscount.GET("/", HandlerV1.ListServiceDiscounts)
+	serviceDiscount.PUT("/", HandlerV1.UpdateServiceDiscount)
+	serviceDiscount.DELETE("/", HandlerV1.DeleteServiceDiscount)
+	serviceDiscount.GET("/price", HandlerV1.GetServicePrice)
+
+	// price history
+	priceHistory := api.Group("/price-history")
+	priceHistory.POST("/", HandlerV1.SchedulePriceChange)
+	priceHistory.GET("/", HandlerV1.ListPriceHistory)
+	priceHistory.GET("/at", HandlerV1.GetPriceAt)
+	priceHistory.DELETE("/", HandlerV1.CancelPriceChange)
+
+	// promo code
+	promoCode := api.Group("/promo-code")
+	promoCode.POST("/", HandlerV1.CreatePromoCode)
+	promoCode.GET("/get", HandlerV1.GetPromoCode)
+	promoCode.GET("/", HandlerV1.ListPromoCodes)
+	promoCode.PUT("/", HandlerV1.UpdatePromoCode)
+	promoCode.DELETE("/", HandlerV1.DeletePromoCode)
+
+	// patient document
+	patientDocument := api.Group("/patient-document")
+	patientDocument.POST("/", HandlerV1.CreatePatientDocument)
+	patientDocument.GET("/get", HandlerV1.GetPatientDocument)
+	patientDocument.GET("/", HandlerV1.ListPatientDocuments)
+	patientDocument.GET("/download", HandlerV1.DownloadPatientDocument)
+	patientDocument.DELETE("/", HandlerV1.DeletePatientDocument)
+
+	// recommendation
+	api.GET("/recommendation", HandlerV1.RecommendDoctors)
+
 	// appointment
 	appointment := api.Group("/appointment")
 	appointment.POST("/", HandlerV1.CreateBookedAppointment)
@@ -114,6 +207,14 @@ func NewRoute(option RouteOption) *gin.Engine {
 	appointment.GET("/", HandlerV1.ListBookedAppointments)
 	appointment.PUT("/", HandlerV1.UpdateBookedAppointment)
 	appointment.DELETE("/", HandlerV1.DeleteBookedAppointment)
+	appointment.GET("/export", HandlerV1.ExportBookedAppointments)
+	appointment.POST("/reschedule/propose", HandlerV1.ProposeReschedule)
+	appointment.POST("/reschedule", HandlerV1.ApplyReschedule)
+
+	// booking rules
+	bookingRules := api.Group("/booking-rules")
+	bookingRules.GET("/", HandlerV1.GetBookingRules)
+	bookingRules.PUT("/", HandlerV1.UpdateBookingRules)
 
 	// doctorTime
 	doctorTime := api.Group("/doctor-time")
@@ -131,6 +232,8 @@ func NewRoute(option RouteOption) *gin.Engine {
 	patient.PUT("/", HandlerV1.UpdatePatient)
 	patient.PUT("/phone", HandlerV1.UpdatePhonePatient)
 	patient.DELETE("/", HandlerV1.DeletePatient)
+	patient.GET("/export", HandlerV1.ExportPatients)
+	patient.GET("/notifications", HandlerV1.ListPatientNotifications)
 
 	// department
 	department := api.Group("/department")
@@ -139,6 +242,7 @@ func NewRoute(option RouteOption) *gin.Engine {
 	department.GET("/", HandlerV1.ListDepartments)
 	department.PUT("/", HandlerV1.UpdateDepartment)
 	department.DELETE("/", HandlerV1.DeleteDepartment)
+	department.PUT("/restore", HandlerV1.RestoreDepartment)
 
 	// doctor
 	doctor := api.Group("/doctor")
@@ -146,8 +250,19 @@ func NewRoute(option RouteOption) *gin.Engine {
 	doctor.GET("/get", HandlerV1.GetDoctor)
 	doctor.GET("/", HandlerV1.ListDoctors)
 	doctor.GET("/spec", HandlerV1.ListDoctorsBySpecializationId)
+	doctor.GET("/department", HandlerV1.ListDoctorsByDepartmentId)
 	doctor.PUT("/", HandlerV1.UpdateDoctor)
 	doctor.DELETE("/", HandlerV1.DeleteDoctor)
+	doctor.PUT("/restore", HandlerV1.RestoreDoctor)
+	doctor.GET("/export", HandlerV1.ExportDoctors)
+
+	// doctor portal
+	doctorPortal := api.Group("/doctor-portal")
+	doctorPortal.POST("/login", HandlerV1.DoctorLogin)
+	doctorPortal.GET("/agenda", HandlerV1.DoctorAgenda)
+	doctorPortal.GET("/upcoming", HandlerV1.DoctorUpcomingAppointments)
+	doctorPortal.POST("/note", HandlerV1.DoctorWriteNote)
+	doctorPortal.PUT("/attendance", HandlerV1.DoctorMarkAttendance)
 
 	// specialization
 	specialization := api.Group("/specialization")
@@ -156,6 +271,8 @@ func NewRoute(option RouteOption) *gin.Engine {
 	specialization.GET("/", HandlerV1.ListSpecializations)
 	specialization.PUT("/", HandlerV1.UpdateSpecialization)
 	specialization.DELETE("/", HandlerV1.DeleteSpecialization)
+	specialization.PUT("/restore", HandlerV1.RestoreSpecialization)
+	specialization.GET("/tree", HandlerV1.GetSpecializationTree)
 
 	// doctorServices
 	doctorServices := api.Group("/doctor-services")
@@ -164,6 +281,7 @@ func NewRoute(option RouteOption) *gin.Engine {
 	doctorServices.GET("/", HandlerV1.ListDoctorServices)
 	doctorServices.PUT("/", HandlerV1.UpdateDoctorServices)
 	doctorServices.DELETE("/", HandlerV1.DeleteDoctorService)
+	doctorServices.PUT("/restore", HandlerV1.RestoreDoctorService)
 
 	// doctorWorkingHours
 
@@ -181,6 +299,21 @@ func NewRoute(option RouteOption) *gin.Engine {
 	reasons.GET("/", HandlerV1.ListReasons)
 	reasons.PUT("/", HandlerV1.UpdateReasons)
 	reasons.DELETE("/", HandlerV1.DeleteReasons)
+	reasons.PUT("/restore", HandlerV1.RestoreReasons)
+
+	// search
+	api.GET("/search", HandlerV1.Search)
+
+	// translation
+	translation := api.Group("/translation")
+	translation.PUT("/", HandlerV1.SetTranslation)
+	translation.GET("/", HandlerV1.ListTranslations)
+	translation.DELETE("/", HandlerV1.DeleteTranslation)
+
+	// catalogue cache
+	catalogueCache := api.Group("/cache/catalogue")
+	catalogueCache.DELETE("/", HandlerV1.PurgeCatalogueCache)
+	catalogueCache.GET("/stats", HandlerV1.CatalogueCacheStats)
 
 	// session
 	session := api.Group("session")
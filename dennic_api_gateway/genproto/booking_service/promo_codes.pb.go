@@ -0,0 +1,2713 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: booking_service/promo_codes.proto
+
+package booking_service
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// PromoCode takes value percents (kind "percent") or value (kind "fixed") off the payment amount of an appointment,
+// it is valid from valid_from until valid_until "2006-01-02 15:04:05" and at most max_uses times, 0 is unlimited
+type PromoCode struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code"`
+	Kind                 string   `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind"`
+	Value                float64  `protobuf:"fixed64,4,opt,name=value,proto3" json:"value"`
+	MaxUses              int64    `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3" json:"max_uses"`
+	UsedCount            int64    `protobuf:"varint,6,opt,name=used_count,json=usedCount,proto3" json:"used_count"`
+	ValidFrom            string   `protobuf:"bytes,7,opt,name=valid_from,json=validFrom,proto3" json:"valid_from"`
+	ValidUntil           string   `protobuf:"bytes,8,opt,name=valid_until,json=validUntil,proto3" json:"valid_until"`
+	CreatedAt            string   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt            string   `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PromoCode) Reset()         { *m = PromoCode{} }
+func (m *PromoCode) String() string { return proto.CompactTextString(m) }
+func (*PromoCode) ProtoMessage()    {}
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3c85ad8dd4d0145, []int{0}
+}
+func (m *PromoCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromoCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PromoCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PromoCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoCode.Merge(m, src)
+}
+func (m *PromoCode) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromoCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromoCode proto.InternalMessageInfo
+
+func (m *PromoCode) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PromoCode) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *PromoCode) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *PromoCode) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *PromoCode) GetMaxUses() int64 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *PromoCode) GetUsedCount() int64 {
+	if m != nil {
+		return m.UsedCount
+	}
+	return 0
+}
+
+func (m *PromoCode) GetValidFrom() string {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return ""
+}
+
+func (m *PromoCode) GetValidUntil() string {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return ""
+}
+
+func (m *PromoCode) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *PromoCode) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *PromoCode) GetDeletedAt() string {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return ""
+}
+
+type PromoCodes struct {
+	Count                int64        `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	PromoCodes           []*PromoCode `protobuf:"bytes,2,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PromoCodes) Reset()         { *m = PromoCodes{} }
+func (m *PromoCodes) String() string { return proto.CompactTextString(m) }
+func (*PromoCodes) ProtoMessage()    {}
+func (*PromoCodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3c85ad8dd4d0145, []int{1}
+}
+func (m *PromoCodes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromoCodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PromoCodes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PromoCodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoCodes.Merge(m, src)
+}
+func (m *PromoCodes) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromoCodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoCodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromoCodes proto.InternalMessageInfo
+
+func (m *PromoCodes) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PromoCodes) GetPromoCodes() []*PromoCode {
+	if m != nil {
+		return m.PromoCodes
+	}
+	return nil
+}
+
+type CreatePromoCodeReq struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind"`
+	Value                float64  `protobuf:"fixed64,3,opt,name=value,proto3" json:"value"`
+	MaxUses              int64    `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses"`
+	ValidFrom            string   `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from"`
+	ValidUntil           string   `protobuf:"bytes,6,opt,name=valid_until,json=validUntil,proto3" json:"valid_until"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreatePromoCodeReq) Reset()         { *m = CreatePromoCodeReq{} }
+func (m *CreatePromoCodeReq) String() string { return proto.CompactTextString(m) }
+func (*CreatePromoCodeReq) ProtoMessage()    {}
+func (*CreatePromoCodeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3c85ad8dd4d0145, []int{2}
+}
+func (m *CreatePromoCodeReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreatePromoCodeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreatePromoCodeReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreatePromoCodeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePromoCodeReq.Merge(m, src)
+}
+func (m *CreatePromoCodeReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreatePromoCodeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePromoCodeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePromoCodeReq proto.InternalMessageInfo
+
+func (m *CreatePromoCodeReq) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *CreatePromoCodeReq) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *CreatePromoCodeReq) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *CreatePromoCodeReq) GetMaxUses() int64 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *CreatePromoCodeReq) GetValidFrom() string {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return ""
+}
+
+func (m *CreatePromoCodeReq) GetValidUntil() string {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return ""
+}
+
+type UpdatePromoCodeReq struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind"`
+	Value                float64  `protobuf:"fixed64,3,opt,name=value,proto3" json:"value"`
+	MaxUses              int64    `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses"`
+	ValidFrom            string   `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from"`
+	ValidUntil           string   `protobuf:"bytes,6,opt,name=valid_until,json=validUntil,proto3" json:"valid_until"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdatePromoCodeReq) Reset()         { *m = UpdatePromoCodeReq{} }
+func (m *UpdatePromoCodeReq) String() string { return proto.CompactTextString(m) }
+func (*UpdatePromoCodeReq) ProtoMessage()    {}
+func (*UpdatePromoCodeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3c85ad8dd4d0145, []int{3}
+}
+func (m *UpdatePromoCodeReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePromoCodeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePromoCodeReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdatePromoCodeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePromoCodeReq.Merge(m, src)
+}
+func (m *UpdatePromoCodeReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePromoCodeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePromoCodeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePromoCodeReq proto.InternalMessageInfo
+
+func (m *UpdatePromoCodeReq) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UpdatePromoCodeReq) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *UpdatePromoCodeReq) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *UpdatePromoCodeReq) GetMaxUses() int64 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *UpdatePromoCodeReq) GetValidFrom() string {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return ""
+}
+
+func (m *UpdatePromoCodeReq) GetValidUntil() string {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return ""
+}
+
+type GetAllPromoCodesReq struct {
+	IsActive             bool     `protobuf:"varint,1,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	Page                 uint64   `protobuf:"varint,2,opt,name=page,proto3" json:"page"`
+	Limit                uint64   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAllPromoCodesReq) Reset()         { *m = GetAllPromoCodesReq{} }
+func (m *GetAllPromoCodesReq) String() string { return proto.CompactTextString(m) }
+func (*GetAllPromoCodesReq) ProtoMessage()    {}
+func (*GetAllPromoCodesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3c85ad8dd4d0145, []int{4}
+}
+func (m *GetAllPromoCodesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllPromoCodesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllPromoCodesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAllPromoCodesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllPromoCodesReq.Merge(m, src)
+}
+func (m *GetAllPromoCodesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllPromoCodesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllPromoCodesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllPromoCodesReq proto.InternalMessageInfo
+
+func (m *GetAllPromoCodesReq) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+func (m *GetAllPromoCodesReq) GetPage() uint64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *GetAllPromoCodesReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type PromoCodeFieldValueReq struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+	IsActive             bool     `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PromoCodeFieldValueReq) Reset()         { *m = PromoCodeFieldValueReq{} }
+func (m *PromoCodeFieldValueReq) String() string { return proto.CompactTextString(m) }
+func (*PromoCodeFieldValueReq) ProtoMessage()    {}
+func (*PromoCodeFieldValueReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3c85ad8dd4d0145, []int{5}
+}
+func (m *PromoCodeFieldValueReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromoCodeFieldValueReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PromoCodeFieldValueReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PromoCodeFieldValueReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoCodeFieldValueReq.Merge(m, src)
+}
+func (m *PromoCodeFieldValueReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromoCodeFieldValueReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoCodeFieldValueReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromoCodeFieldValueReq proto.InternalMessageInfo
+
+func (m *PromoCodeFieldValueReq) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *PromoCodeFieldValueReq) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *PromoCodeFieldValueReq) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+type DeletePromoCodeStatus struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePromoCodeStatus) Reset()         { *m = DeletePromoCodeStatus{} }
+func (m *DeletePromoCodeStatus) String() string { return proto.CompactTextString(m) }
+func (*DeletePromoCodeStatus) ProtoMessage()    {}
+func (*DeletePromoCodeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3c85ad8dd4d0145, []int{6}
+}
+func (m *DeletePromoCodeStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletePromoCodeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletePromoCodeStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeletePromoCodeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePromoCodeStatus.Merge(m, src)
+}
+func (m *DeletePromoCodeStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletePromoCodeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePromoCodeStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePromoCodeStatus proto.InternalMessageInfo
+
+func (m *DeletePromoCodeStatus) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*PromoCode)(nil), "booking_service.PromoCode")
+	proto.RegisterType((*PromoCodes)(nil), "booking_service.PromoCodes")
+	proto.RegisterType((*CreatePromoCodeReq)(nil), "booking_service.CreatePromoCodeReq")
+	proto.RegisterType((*UpdatePromoCodeReq)(nil), "booking_service.UpdatePromoCodeReq")
+	proto.RegisterType((*GetAllPromoCodesReq)(nil), "booking_service.GetAllPromoCodesReq")
+	proto.RegisterType((*PromoCodeFieldValueReq)(nil), "booking_service.PromoCodeFieldValueReq")
+	proto.RegisterType((*DeletePromoCodeStatus)(nil), "booking_service.DeletePromoCodeStatus")
+}
+
+func init() { proto.RegisterFile("booking_service/promo_codes.proto", fileDescriptor_d3c85ad8dd4d0145) }
+
+var fileDescriptor_d3c85ad8dd4d0145 = []byte{
+	// 564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0xc5, 0x49, 0xdb, 0x35, 0xb7, 0x88, 0x0e, 0x33, 0xa6, 0xd0, 0x89, 0x52, 0x02, 0x82, 0x3e,
+	0x75, 0xd2, 0x78, 0xe4, 0xa9, 0x14, 0x6d, 0xaf, 0x28, 0x55, 0x79, 0x42, 0x8a, 0xd2, 0xda, 0x9b,
+	0xac, 0x25, 0x75, 0x88, 0x9d, 0x6a, 0x9f, 0xc2, 0x2f, 0xf0, 0x80, 0xc4, 0x67, 0x20, 0xf1, 0xc2,
+	0x27, 0xa0, 0xf2, 0x23, 0xc8, 0x76, 0x48, 0xd7, 0x24, 0x2d, 0xbc, 0xf1, 0xe6, 0x7b, 0x8e, 0x7d,
+	0x73, 0xee, 0x39, 0xb7, 0x85, 0xa7, 0x73, 0xce, 0xaf, 0xd9, 0xf2, 0x2a, 0x10, 0x34, 0x5d, 0xb1,
+	0x05, 0x3d, 0x4d, 0x52, 0x1e, 0xf3, 0x60, 0xc1, 0x09, 0x15, 0xa3, 0x24, 0xe5, 0x92, 0xe3, 0x6e,
+	0xe9, 0x8a, 0xf7, 0xd5, 0x02, 0xe7, 0x9d, 0xba, 0x36, 0xe1, 0x84, 0xe2, 0x7b, 0x60, 0x31, 0xe2,
+	0xa2, 0x01, 0x1a, 0xda, 0xbe, 0xc5, 0x08, 0xc6, 0xd0, 0x50, 0xaf, 0x5d, 0x6b, 0x80, 0x86, 0x8e,
+	0xaf, 0xcf, 0x0a, 0xbb, 0x66, 0x4b, 0xe2, 0xda, 0x06, 0x53, 0x67, 0x7c, 0x04, 0xcd, 0x55, 0x18,
+	0x65, 0xd4, 0x6d, 0x0c, 0xd0, 0x10, 0xf9, 0xa6, 0xc0, 0x8f, 0xa0, 0x1d, 0x87, 0x37, 0x41, 0x26,
+	0xa8, 0x70, 0x9b, 0xba, 0xe7, 0x41, 0x1c, 0xde, 0xcc, 0x04, 0x15, 0xf8, 0x31, 0x40, 0x26, 0x28,
+	0x09, 0x16, 0x3c, 0x5b, 0x4a, 0xb7, 0xa5, 0x49, 0x47, 0x21, 0x13, 0x05, 0x28, 0x7a, 0x15, 0x46,
+	0x8c, 0x04, 0x97, 0x29, 0x8f, 0xdd, 0x03, 0xfd, 0x25, 0x47, 0x23, 0xe7, 0x29, 0x8f, 0xf1, 0x13,
+	0xe8, 0x18, 0x3a, 0x5b, 0x4a, 0x16, 0xb9, 0x6d, 0xcd, 0x9b, 0x17, 0x33, 0x85, 0xa8, 0xf7, 0x8b,
+	0x94, 0x86, 0x92, 0x92, 0x20, 0x94, 0xae, 0x63, 0xde, 0xe7, 0xc8, 0x58, 0xb7, 0xcf, 0x12, 0xf2,
+	0x87, 0x06, 0x43, 0xe7, 0x88, 0xa1, 0x09, 0x8d, 0x68, 0x4e, 0x77, 0x0c, 0x9d, 0x23, 0x63, 0xe9,
+	0x05, 0x00, 0x85, 0x63, 0x42, 0x8d, 0x6e, 0x86, 0x30, 0xae, 0x99, 0x02, 0xbf, 0x86, 0xce, 0x2d,
+	0xf3, 0x5d, 0x6b, 0x60, 0x0f, 0x3b, 0x67, 0xbd, 0x51, 0xc9, 0xfd, 0x51, 0xd1, 0xc7, 0x87, 0xa4,
+	0x68, 0xe9, 0x7d, 0x41, 0x80, 0x27, 0x5a, 0xec, 0x86, 0xa7, 0x1f, 0x8b, 0x30, 0x50, 0x4d, 0x18,
+	0x56, 0x5d, 0x18, 0xf6, 0xae, 0x30, 0x1a, 0x95, 0x30, 0x6e, 0xb9, 0xdd, 0xfc, 0x8b, 0xdb, 0xad,
+	0xb2, 0xdb, 0xde, 0x67, 0x04, 0x78, 0x96, 0x90, 0xb2, 0xde, 0x9a, 0x65, 0xfa, 0xff, 0x5a, 0x3f,
+	0xc0, 0x83, 0x0b, 0x2a, 0xc7, 0x51, 0xb4, 0x89, 0x50, 0x69, 0x3d, 0x01, 0x87, 0x89, 0x20, 0x5c,
+	0x48, 0xb6, 0x32, 0x06, 0xb7, 0xfd, 0x36, 0x13, 0x63, 0x5d, 0x2b, 0xe1, 0x49, 0x78, 0x65, 0x7e,
+	0x05, 0x0d, 0x5f, 0x9f, 0x95, 0xf0, 0x88, 0xc5, 0x4c, 0x6a, 0xe1, 0x0d, 0xdf, 0x14, 0x5e, 0x08,
+	0xc7, 0x45, 0xdf, 0x73, 0x46, 0x23, 0xf2, 0x5e, 0xcd, 0xa3, 0x3e, 0x70, 0x04, 0xcd, 0x4b, 0x05,
+	0xe4, 0xe9, 0x99, 0x62, 0x33, 0xbe, 0xf1, 0x24, 0x1f, 0x7f, 0x4b, 0x8c, 0xbd, 0x2d, 0xc6, 0x3b,
+	0x85, 0x87, 0x6f, 0xf5, 0x2a, 0x16, 0x1f, 0x9a, 0xca, 0x50, 0x66, 0x02, 0x1f, 0x43, 0x4b, 0xe8,
+	0x53, 0xae, 0x3f, 0xaf, 0xce, 0xbe, 0xdb, 0x70, 0x7f, 0x33, 0xec, 0xd4, 0x6c, 0x1e, 0xf6, 0xa1,
+	0x5b, 0x5a, 0x31, 0xfc, 0xac, 0xb2, 0x9e, 0xd5, 0x25, 0xec, 0xed, 0xd9, 0x61, 0x3c, 0x85, 0xbb,
+	0x17, 0x54, 0x6e, 0xea, 0x97, 0xbb, 0xef, 0x6e, 0x99, 0xb3, 0xb7, 0xe9, 0x0c, 0x0e, 0xcb, 0x81,
+	0xe1, 0xe7, 0x95, 0xfb, 0x35, 0x99, 0xf6, 0x4e, 0x76, 0x77, 0x15, 0x6a, 0xfe, 0xd2, 0xca, 0xd6,
+	0xcc, 0x5f, 0x5d, 0xea, 0xbd, 0x52, 0xe7, 0xd0, 0x2d, 0x45, 0xf3, 0xef, 0x16, 0xbc, 0xa8, 0x5c,
+	0xac, 0x4d, 0xf9, 0xcd, 0xe1, 0xb7, 0x75, 0x1f, 0xfd, 0x58, 0xf7, 0xd1, 0xcf, 0x75, 0x1f, 0x7d,
+	0xfa, 0xd5, 0xbf, 0x33, 0x6f, 0xe9, 0x7f, 0xf6, 0x57, 0xbf, 0x07, 0x00, 0xdd, 0xcd, 0x62, 0xe9,
+	0xfe, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PromoCodesServiceClient is the client API for PromoCodesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PromoCodesServiceClient interface {
+	// promoCodes
+	CreatePromoCode(ctx context.Context, in *CreatePromoCodeReq, opts ...grpc.CallOption) (*PromoCode, error)
+	GetPromoCode(ctx context.Context, in *PromoCodeFieldValueReq, opts ...grpc.CallOption) (*PromoCode, error)
+	GetAllPromoCodes(ctx context.Context, in *GetAllPromoCodesReq, opts ...grpc.CallOption) (*PromoCodes, error)
+	UpdatePromoCode(ctx context.Context, in *UpdatePromoCodeReq, opts ...grpc.CallOption) (*PromoCode, error)
+	DeletePromoCode(ctx context.Context, in *PromoCodeFieldValueReq, opts ...grpc.CallOption) (*DeletePromoCodeStatus, error)
+}
+
+type promoCodesServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPromoCodesServiceClient(cc *grpc.ClientConn) PromoCodesServiceClient {
+	return &promoCodesServiceClient{cc}
+}
+
+func (c *promoCodesServiceClient) CreatePromoCode(ctx context.Context, in *CreatePromoCodeReq, opts ...grpc.CallOption) (*PromoCode, error) {
+	out := new(PromoCode)
+	err := c.cc.Invoke(ctx, "/booking_service.PromoCodesService/CreatePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoCodesServiceClient) GetPromoCode(ctx context.Context, in *PromoCodeFieldValueReq, opts ...grpc.CallOption) (*PromoCode, error) {
+	out := new(PromoCode)
+	err := c.cc.Invoke(ctx, "/booking_service.PromoCodesService/GetPromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoCodesServiceClient) GetAllPromoCodes(ctx context.Context, in *GetAllPromoCodesReq, opts ...grpc.CallOption) (*PromoCodes, error) {
+	out := new(PromoCodes)
+	err := c.cc.Invoke(ctx, "/booking_service.PromoCodesService/GetAllPromoCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoCodesServiceClient) UpdatePromoCode(ctx context.Context, in *UpdatePromoCodeReq, opts ...grpc.CallOption) (*PromoCode, error) {
+	out := new(PromoCode)
+	err := c.cc.Invoke(ctx, "/booking_service.PromoCodesService/UpdatePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoCodesServiceClient) DeletePromoCode(ctx context.Context, in *PromoCodeFieldValueReq, opts ...grpc.CallOption) (*DeletePromoCodeStatus, error) {
+	out := new(DeletePromoCodeStatus)
+	err := c.cc.Invoke(ctx, "/booking_service.PromoCodesService/DeletePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PromoCodesServiceServer is the server API for PromoCodesService service.
+type PromoCodesServiceServer interface {
+	// promoCodes
+	CreatePromoCode(context.Context, *CreatePromoCodeReq) (*PromoCode, error)
+	GetPromoCode(context.Context, *PromoCodeFieldValueReq) (*PromoCode, error)
+	GetAllPromoCodes(context.Context, *GetAllPromoCodesReq) (*PromoCodes, error)
+	UpdatePromoCode(context.Context, *UpdatePromoCodeReq) (*PromoCode, error)
+	DeletePromoCode(context.Context, *PromoCodeFieldValueReq) (*DeletePromoCodeStatus, error)
+}
+
+// UnimplementedPromoCodesServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPromoCodesServiceServer struct {
+}
+
+func (*UnimplementedPromoCodesServiceServer) CreatePromoCode(ctx context.Context, req *CreatePromoCodeReq) (*PromoCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (*UnimplementedPromoCodesServiceServer) GetPromoCode(ctx context.Context, req *PromoCodeFieldValueReq) (*PromoCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromoCode not implemented")
+}
+func (*UnimplementedPromoCodesServiceServer) GetAllPromoCodes(ctx context.Context, req *GetAllPromoCodesReq) (*PromoCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPromoCodes not implemented")
+}
+func (*UnimplementedPromoCodesServiceServer) UpdatePromoCode(ctx context.Context, req *UpdatePromoCodeReq) (*PromoCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePromoCode not implemented")
+}
+func (*UnimplementedPromoCodesServiceServer) DeletePromoCode(ctx context.Context, req *PromoCodeFieldValueReq) (*DeletePromoCodeStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePromoCode not implemented")
+}
+
+func RegisterPromoCodesServiceServer(s *grpc.Server, srv PromoCodesServiceServer) {
+	s.RegisterService(&_PromoCodesService_serviceDesc, srv)
+}
+
+func _PromoCodesService_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoCodesServiceServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PromoCodesService/CreatePromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoCodesServiceServer).CreatePromoCode(ctx, req.(*CreatePromoCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoCodesService_GetPromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoCodeFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoCodesServiceServer).GetPromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PromoCodesService/GetPromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoCodesServiceServer).GetPromoCode(ctx, req.(*PromoCodeFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoCodesService_GetAllPromoCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllPromoCodesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoCodesServiceServer).GetAllPromoCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PromoCodesService/GetAllPromoCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoCodesServiceServer).GetAllPromoCodes(ctx, req.(*GetAllPromoCodesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoCodesService_UpdatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePromoCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoCodesServiceServer).UpdatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PromoCodesService/UpdatePromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoCodesServiceServer).UpdatePromoCode(ctx, req.(*UpdatePromoCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoCodesService_DeletePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoCodeFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoCodesServiceServer).DeletePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PromoCodesService/DeletePromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoCodesServiceServer).DeletePromoCode(ctx, req.(*PromoCodeFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PromoCodesService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "booking_service.PromoCodesService",
+	HandlerType: (*PromoCodesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _PromoCodesService_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "GetPromoCode",
+			Handler:    _PromoCodesService_GetPromoCode_Handler,
+		},
+		{
+			MethodName: "GetAllPromoCodes",
+			Handler:    _PromoCodesService_GetAllPromoCodes_Handler,
+		},
+		{
+			MethodName: "UpdatePromoCode",
+			Handler:    _PromoCodesService_UpdatePromoCode_Handler,
+		},
+		{
+			MethodName: "DeletePromoCode",
+			Handler:    _PromoCodesService_DeletePromoCode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking_service/promo_codes.proto",
+}
+
+func (m *PromoCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PromoCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromoCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ValidUntil) > 0 {
+		i -= len(m.ValidUntil)
+		copy(dAtA[i:], m.ValidUntil)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.ValidUntil)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ValidFrom) > 0 {
+		i -= len(m.ValidFrom)
+		copy(dAtA[i:], m.ValidFrom)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.ValidFrom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.UsedCount != 0 {
+		i = encodeVarintPromoCodes(dAtA, i, uint64(m.UsedCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxUses != 0 {
+		i = encodeVarintPromoCodes(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPromoCodes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PromoCodes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PromoCodes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromoCodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PromoCodes) > 0 {
+		for iNdEx := len(m.PromoCodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PromoCodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPromoCodes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintPromoCodes(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreatePromoCodeReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePromoCodeReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatePromoCodeReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ValidUntil) > 0 {
+		i -= len(m.ValidUntil)
+		copy(dAtA[i:], m.ValidUntil)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.ValidUntil)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ValidFrom) > 0 {
+		i -= len(m.ValidFrom)
+		copy(dAtA[i:], m.ValidFrom)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.ValidFrom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxUses != 0 {
+		i = encodeVarintPromoCodes(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatePromoCodeReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePromoCodeReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePromoCodeReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ValidUntil) > 0 {
+		i -= len(m.ValidUntil)
+		copy(dAtA[i:], m.ValidUntil)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.ValidUntil)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ValidFrom) > 0 {
+		i -= len(m.ValidFrom)
+		copy(dAtA[i:], m.ValidFrom)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.ValidFrom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxUses != 0 {
+		i = encodeVarintPromoCodes(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPromoCodes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllPromoCodesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllPromoCodesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllPromoCodesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintPromoCodes(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Page != 0 {
+		i = encodeVarintPromoCodes(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PromoCodeFieldValueReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PromoCodeFieldValueReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromoCodeFieldValueReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintPromoCodes(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeletePromoCodeStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePromoCodeStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePromoCodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPromoCodes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPromoCodes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PromoCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPromoCodes(uint64(m.Id))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.MaxUses != 0 {
+		n += 1 + sovPromoCodes(uint64(m.MaxUses))
+	}
+	if m.UsedCount != 0 {
+		n += 1 + sovPromoCodes(uint64(m.UsedCount))
+	}
+	l = len(m.ValidFrom)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	l = len(m.ValidUntil)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PromoCodes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovPromoCodes(uint64(m.Count))
+	}
+	if len(m.PromoCodes) > 0 {
+		for _, e := range m.PromoCodes {
+			l = e.Size()
+			n += 1 + l + sovPromoCodes(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreatePromoCodeReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.MaxUses != 0 {
+		n += 1 + sovPromoCodes(uint64(m.MaxUses))
+	}
+	l = len(m.ValidFrom)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	l = len(m.ValidUntil)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatePromoCodeReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPromoCodes(uint64(m.Id))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.MaxUses != 0 {
+		n += 1 + sovPromoCodes(uint64(m.MaxUses))
+	}
+	l = len(m.ValidFrom)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	l = len(m.ValidUntil)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAllPromoCodesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsActive {
+		n += 2
+	}
+	if m.Page != 0 {
+		n += 1 + sovPromoCodes(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPromoCodes(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PromoCodeFieldValueReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPromoCodes(uint64(l))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletePromoCodeStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPromoCodes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPromoCodes(x uint64) (n int) {
+	return sovPromoCodes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PromoCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromoCodes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromoCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromoCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedCount", wireType)
+			}
+			m.UsedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidUntil = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromoCodes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromoCodes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromoCodes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromoCodes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromoCodes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoCodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromoCodes = append(m.PromoCodes, &PromoCode{})
+			if err := m.PromoCodes[len(m.PromoCodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromoCodes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreatePromoCodeReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromoCodes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePromoCodeReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePromoCodeReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidUntil = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromoCodes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdatePromoCodeReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromoCodes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePromoCodeReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePromoCodeReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidUntil = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromoCodes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllPromoCodesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromoCodes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllPromoCodesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllPromoCodesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromoCodes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromoCodeFieldValueReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromoCodes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromoCodeFieldValueReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromoCodeFieldValueReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromoCodes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePromoCodeStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromoCodes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePromoCodeStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePromoCodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromoCodes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPromoCodes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPromoCodes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPromoCodes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPromoCodes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPromoCodes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPromoCodes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPromoCodes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPromoCodes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPromoCodes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPromoCodes = fmt.Errorf("proto: unexpected end of group")
+)
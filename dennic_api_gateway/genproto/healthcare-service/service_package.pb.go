@@ -0,0 +1,1721 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcare-service/service_package.proto
+
+package healthcare
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// items_price is the sum of the offline prices of the doctor services bought one by one
+type ServicePackage struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
+	Price                float64  `protobuf:"fixed64,4,opt,name=price,proto3" json:"price"`
+	DoctorServiceIds     []string `protobuf:"bytes,5,rep,name=doctor_service_ids,json=doctorServiceIds,proto3" json:"doctor_service_ids"`
+	ItemsPrice           float64  `protobuf:"fixed64,6,opt,name=items_price,json=itemsPrice,proto3" json:"items_price"`
+	CreatedAt            string   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServicePackage) Reset()         { *m = ServicePackage{} }
+func (m *ServicePackage) String() string { return proto.CompactTextString(m) }
+func (*ServicePackage) ProtoMessage()    {}
+func (*ServicePackage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0caadc9b32f4fbf0, []int{0}
+}
+func (m *ServicePackage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServicePackage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServicePackage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServicePackage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServicePackage.Merge(m, src)
+}
+func (m *ServicePackage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServicePackage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServicePackage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServicePackage proto.InternalMessageInfo
+
+func (m *ServicePackage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ServicePackage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServicePackage) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ServicePackage) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ServicePackage) GetDoctorServiceIds() []string {
+	if m != nil {
+		return m.DoctorServiceIds
+	}
+	return nil
+}
+
+func (m *ServicePackage) GetItemsPrice() float64 {
+	if m != nil {
+		return m.ItemsPrice
+	}
+	return 0
+}
+
+func (m *ServicePackage) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *ServicePackage) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type ServicePackageId struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServicePackageId) Reset()         { *m = ServicePackageId{} }
+func (m *ServicePackageId) String() string { return proto.CompactTextString(m) }
+func (*ServicePackageId) ProtoMessage()    {}
+func (*ServicePackageId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0caadc9b32f4fbf0, []int{1}
+}
+func (m *ServicePackageId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServicePackageId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServicePackageId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServicePackageId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServicePackageId.Merge(m, src)
+}
+func (m *ServicePackageId) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServicePackageId) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServicePackageId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServicePackageId proto.InternalMessageInfo
+
+func (m *ServicePackageId) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// doctor_service_id selects the packages including the doctor service
+type ListServicePackagesReq struct {
+	Page                 int64    `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	DoctorServiceId      string   `protobuf:"bytes,3,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListServicePackagesReq) Reset()         { *m = ListServicePackagesReq{} }
+func (m *ListServicePackagesReq) String() string { return proto.CompactTextString(m) }
+func (*ListServicePackagesReq) ProtoMessage()    {}
+func (*ListServicePackagesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0caadc9b32f4fbf0, []int{2}
+}
+func (m *ListServicePackagesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListServicePackagesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListServicePackagesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListServicePackagesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServicePackagesReq.Merge(m, src)
+}
+func (m *ListServicePackagesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListServicePackagesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServicePackagesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListServicePackagesReq proto.InternalMessageInfo
+
+func (m *ListServicePackagesReq) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListServicePackagesReq) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListServicePackagesReq) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+type ListServicePackagesRes struct {
+	ServicePackages      []*ServicePackage `protobuf:"bytes,1,rep,name=service_packages,json=servicePackages,proto3" json:"service_packages"`
+	Count                int64             `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListServicePackagesRes) Reset()         { *m = ListServicePackagesRes{} }
+func (m *ListServicePackagesRes) String() string { return proto.CompactTextString(m) }
+func (*ListServicePackagesRes) ProtoMessage()    {}
+func (*ListServicePackagesRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0caadc9b32f4fbf0, []int{3}
+}
+func (m *ListServicePackagesRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListServicePackagesRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListServicePackagesRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListServicePackagesRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServicePackagesRes.Merge(m, src)
+}
+func (m *ListServicePackagesRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListServicePackagesRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServicePackagesRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListServicePackagesRes proto.InternalMessageInfo
+
+func (m *ListServicePackagesRes) GetServicePackages() []*ServicePackage {
+	if m != nil {
+		return m.ServicePackages
+	}
+	return nil
+}
+
+func (m *ListServicePackagesRes) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type StatusServicePackage struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusServicePackage) Reset()         { *m = StatusServicePackage{} }
+func (m *StatusServicePackage) String() string { return proto.CompactTextString(m) }
+func (*StatusServicePackage) ProtoMessage()    {}
+func (*StatusServicePackage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0caadc9b32f4fbf0, []int{4}
+}
+func (m *StatusServicePackage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusServicePackage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusServicePackage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusServicePackage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusServicePackage.Merge(m, src)
+}
+func (m *StatusServicePackage) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusServicePackage) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusServicePackage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusServicePackage proto.InternalMessageInfo
+
+func (m *StatusServicePackage) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*ServicePackage)(nil), "healthcare.ServicePackage")
+	proto.RegisterType((*ServicePackageId)(nil), "healthcare.ServicePackageId")
+	proto.RegisterType((*ListServicePackagesReq)(nil), "healthcare.ListServicePackagesReq")
+	proto.RegisterType((*ListServicePackagesRes)(nil), "healthcare.ListServicePackagesRes")
+	proto.RegisterType((*StatusServicePackage)(nil), "healthcare.StatusServicePackage")
+}
+
+func init() {
+	proto.RegisterFile("healthcare-service/service_package.proto", fileDescriptor_0caadc9b32f4fbf0)
+}
+
+var fileDescriptor_0caadc9b32f4fbf0 = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x66, 0xe3, 0x36, 0x34, 0x13, 0xa9, 0x4d, 0x97, 0x50, 0x59, 0x11, 0x18, 0xcb, 0x27, 0x0b,
+	0x81, 0x91, 0xca, 0x13, 0x94, 0x1f, 0xa1, 0x48, 0x80, 0xaa, 0xad, 0xe0, 0x86, 0xac, 0x65, 0x77,
+	0xd4, 0xae, 0x48, 0x6c, 0xe3, 0x5d, 0xf3, 0x2c, 0xbc, 0x0c, 0x77, 0x8e, 0x3c, 0x02, 0x0a, 0x4f,
+	0xc1, 0x0d, 0x79, 0xec, 0xd2, 0xda, 0x24, 0x41, 0x48, 0x9c, 0xb2, 0x33, 0xdf, 0xb7, 0x93, 0xef,
+	0xfb, 0x66, 0x65, 0x88, 0x2f, 0x50, 0x2e, 0xdc, 0x85, 0x92, 0x25, 0x3e, 0xb4, 0x58, 0x7e, 0x32,
+	0x0a, 0x1f, 0xb5, 0xbf, 0x69, 0x21, 0xd5, 0x07, 0x79, 0x8e, 0x49, 0x51, 0xe6, 0x2e, 0xe7, 0x70,
+	0xc5, 0x8c, 0x7e, 0x32, 0xd8, 0x3f, 0x6b, 0x58, 0xa7, 0x0d, 0x89, 0xef, 0xc3, 0xc0, 0x68, 0x9f,
+	0x85, 0x2c, 0x1e, 0x89, 0x81, 0xd1, 0x9c, 0xc3, 0x4e, 0x26, 0x97, 0xe8, 0x0f, 0xa8, 0x43, 0x67,
+	0x1e, 0xc2, 0x58, 0xa3, 0x55, 0xa5, 0x29, 0x9c, 0xc9, 0x33, 0xdf, 0x23, 0xe8, 0x7a, 0x8b, 0x4f,
+	0x61, 0xb7, 0x28, 0x8d, 0x42, 0x7f, 0x27, 0x64, 0x31, 0x13, 0x4d, 0xc1, 0x1f, 0x00, 0xd7, 0xb9,
+	0x72, 0x79, 0x99, 0x5e, 0x4a, 0x33, 0xda, 0xfa, 0xbb, 0xa1, 0x17, 0x8f, 0xc4, 0xa4, 0x41, 0x5a,
+	0x35, 0x73, 0x6d, 0xf9, 0x3d, 0x18, 0x1b, 0x87, 0x4b, 0x9b, 0x36, 0x93, 0x86, 0x34, 0x09, 0xa8,
+	0x75, 0x4a, 0xe3, 0xee, 0x02, 0xa8, 0x12, 0xa5, 0x43, 0x9d, 0x4a, 0xe7, 0xdf, 0x24, 0x15, 0xa3,
+	0xb6, 0x73, 0xe2, 0x6a, 0xb8, 0x2a, 0xf4, 0x25, 0xbc, 0xd7, 0xc0, 0x6d, 0xe7, 0xc4, 0x45, 0x11,
+	0x4c, 0xba, 0xd6, 0xe7, 0xba, 0x6f, 0x3e, 0xca, 0xe0, 0xe8, 0xa5, 0xb1, 0xae, 0xcb, 0xb3, 0x02,
+	0x3f, 0xd6, 0xb1, 0x14, 0xf2, 0x1c, 0x89, 0xeb, 0x09, 0x3a, 0xd7, 0xa6, 0x17, 0x66, 0x69, 0x1c,
+	0x65, 0xe5, 0x89, 0xa6, 0xe0, 0xf7, 0xe1, 0xf0, 0x0f, 0xd3, 0x6d, 0x64, 0x07, 0x3d, 0xcf, 0x51,
+	0xb5, 0xe1, 0xff, 0x2c, 0x7f, 0x0e, 0x93, 0xde, 0x3a, 0xad, 0xcf, 0x42, 0x2f, 0x1e, 0x1f, 0xcf,
+	0x92, 0xab, 0x85, 0x26, 0xdd, 0x9b, 0xe2, 0xc0, 0x76, 0x27, 0xd5, 0x12, 0x55, 0x5e, 0x65, 0xbf,
+	0x25, 0x52, 0x11, 0x25, 0x30, 0x3d, 0x73, 0xd2, 0x55, 0xb6, 0xf7, 0x16, 0x8e, 0x60, 0x68, 0xa9,
+	0x4f, 0x36, 0xf7, 0x44, 0x5b, 0x1d, 0x7f, 0xf1, 0xe0, 0x76, 0x97, 0xda, 0x56, 0xfc, 0x35, 0x4c,
+	0x9f, 0xd2, 0x02, 0x7a, 0x93, 0xb6, 0x88, 0x9c, 0x6d, 0xc1, 0xf8, 0x2b, 0x38, 0x7c, 0x81, 0xbd,
+	0x3c, 0xf8, 0x9d, 0xcd, 0x17, 0xe6, 0x7a, 0xeb, 0xb8, 0x77, 0x70, 0x6b, 0x4d, 0xbe, 0x3c, 0xba,
+	0x7e, 0x65, 0xfd, 0xc2, 0x67, 0x7f, 0xe7, 0xd8, 0xda, 0xfd, 0x1b, 0x7a, 0x5f, 0xff, 0xc9, 0xfd,
+	0x5b, 0x98, 0x3e, 0xc3, 0x05, 0x3a, 0xfc, 0xa7, 0x00, 0xc2, 0x0e, 0xba, 0x66, 0xaf, 0x4f, 0x26,
+	0x5f, 0x57, 0x01, 0xfb, 0xb6, 0x0a, 0xd8, 0xf7, 0x55, 0xc0, 0x3e, 0xff, 0x08, 0x6e, 0xbc, 0x1f,
+	0xd2, 0xb7, 0xe1, 0xf1, 0xaf, 0x01, 0x00, 0x53, 0x02, 0x05, 0xd9, 0x47, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServicePackageServiceClient is the client API for ServicePackageService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServicePackageServiceClient interface {
+	CreateServicePackage(ctx context.Context, in *ServicePackage, opts ...grpc.CallOption) (*ServicePackage, error)
+	GetServicePackage(ctx context.Context, in *ServicePackageId, opts ...grpc.CallOption) (*ServicePackage, error)
+	ListServicePackages(ctx context.Context, in *ListServicePackagesReq, opts ...grpc.CallOption) (*ListServicePackagesRes, error)
+	UpdateServicePackage(ctx context.Context, in *ServicePackage, opts ...grpc.CallOption) (*ServicePackage, error)
+	DeleteServicePackage(ctx context.Context, in *ServicePackageId, opts ...grpc.CallOption) (*StatusServicePackage, error)
+}
+
+type servicePackageServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewServicePackageServiceClient(cc *grpc.ClientConn) ServicePackageServiceClient {
+	return &servicePackageServiceClient{cc}
+}
+
+func (c *servicePackageServiceClient) CreateServicePackage(ctx context.Context, in *ServicePackage, opts ...grpc.CallOption) (*ServicePackage, error) {
+	out := new(ServicePackage)
+	err := c.cc.Invoke(ctx, "/healthcare.ServicePackageService/CreateServicePackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *servicePackageServiceClient) GetServicePackage(ctx context.Context, in *ServicePackageId, opts ...grpc.CallOption) (*ServicePackage, error) {
+	out := new(ServicePackage)
+	err := c.cc.Invoke(ctx, "/healthcare.ServicePackageService/GetServicePackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *servicePackageServiceClient) ListServicePackages(ctx context.Context, in *ListServicePackagesReq, opts ...grpc.CallOption) (*ListServicePackagesRes, error) {
+	out := new(ListServicePackagesRes)
+	err := c.cc.Invoke(ctx, "/healthcare.ServicePackageService/ListServicePackages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *servicePackageServiceClient) UpdateServicePackage(ctx context.Context, in *ServicePackage, opts ...grpc.CallOption) (*ServicePackage, error) {
+	out := new(ServicePackage)
+	err := c.cc.Invoke(ctx, "/healthcare.ServicePackageService/UpdateServicePackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *servicePackageServiceClient) DeleteServicePackage(ctx context.Context, in *ServicePackageId, opts ...grpc.CallOption) (*StatusServicePackage, error) {
+	out := new(StatusServicePackage)
+	err := c.cc.Invoke(ctx, "/healthcare.ServicePackageService/DeleteServicePackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServicePackageServiceServer is the server API for ServicePackageService service.
+type ServicePackageServiceServer interface {
+	CreateServicePackage(context.Context, *ServicePackage) (*ServicePackage, error)
+	GetServicePackage(context.Context, *ServicePackageId) (*ServicePackage, error)
+	ListServicePackages(context.Context, *ListServicePackagesReq) (*ListServicePackagesRes, error)
+	UpdateServicePackage(context.Context, *ServicePackage) (*ServicePackage, error)
+	DeleteServicePackage(context.Context, *ServicePackageId) (*StatusServicePackage, error)
+}
+
+// UnimplementedServicePackageServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServicePackageServiceServer struct {
+}
+
+func (*UnimplementedServicePackageServiceServer) CreateServicePackage(ctx context.Context, req *ServicePackage) (*ServicePackage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServicePackage not implemented")
+}
+func (*UnimplementedServicePackageServiceServer) GetServicePackage(ctx context.Context, req *ServicePackageId) (*ServicePackage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServicePackage not implemented")
+}
+func (*UnimplementedServicePackageServiceServer) ListServicePackages(ctx context.Context, req *ListServicePackagesReq) (*ListServicePackagesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServicePackages not implemented")
+}
+func (*UnimplementedServicePackageServiceServer) UpdateServicePackage(ctx context.Context, req *ServicePackage) (*ServicePackage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServicePackage not implemented")
+}
+func (*UnimplementedServicePackageServiceServer) DeleteServicePackage(ctx context.Context, req *ServicePackageId) (*StatusServicePackage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServicePackage not implemented")
+}
+
+func RegisterServicePackageServiceServer(s *grpc.Server, srv ServicePackageServiceServer) {
+	s.RegisterService(&_ServicePackageService_serviceDesc, srv)
+}
+
+func _ServicePackageService_CreateServicePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServicePackage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServicePackageServiceServer).CreateServicePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.ServicePackageService/CreateServicePackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServicePackageServiceServer).CreateServicePackage(ctx, req.(*ServicePackage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServicePackageService_GetServicePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServicePackageId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServicePackageServiceServer).GetServicePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.ServicePackageService/GetServicePackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServicePackageServiceServer).GetServicePackage(ctx, req.(*ServicePackageId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServicePackageService_ListServicePackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServicePackagesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServicePackageServiceServer).ListServicePackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.ServicePackageService/ListServicePackages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServicePackageServiceServer).ListServicePackages(ctx, req.(*ListServicePackagesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServicePackageService_UpdateServicePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServicePackage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServicePackageServiceServer).UpdateServicePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.ServicePackageService/UpdateServicePackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServicePackageServiceServer).UpdateServicePackage(ctx, req.(*ServicePackage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServicePackageService_DeleteServicePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServicePackageId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServicePackageServiceServer).DeleteServicePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.ServicePackageService/DeleteServicePackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServicePackageServiceServer).DeleteServicePackage(ctx, req.(*ServicePackageId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ServicePackageService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "healthcare.ServicePackageService",
+	HandlerType: (*ServicePackageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateServicePackage",
+			Handler:    _ServicePackageService_CreateServicePackage_Handler,
+		},
+		{
+			MethodName: "GetServicePackage",
+			Handler:    _ServicePackageService_GetServicePackage_Handler,
+		},
+		{
+			MethodName: "ListServicePackages",
+			Handler:    _ServicePackageService_ListServicePackages_Handler,
+		},
+		{
+			MethodName: "UpdateServicePackage",
+			Handler:    _ServicePackageService_UpdateServicePackage_Handler,
+		},
+		{
+			MethodName: "DeleteServicePackage",
+			Handler:    _ServicePackageService_DeleteServicePackage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "healthcare-service/service_package.proto",
+}
+
+func (m *ServicePackage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServicePackage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServicePackage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintServicePackage(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintServicePackage(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ItemsPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ItemsPrice))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if len(m.DoctorServiceIds) > 0 {
+		for iNdEx := len(m.DoctorServiceIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DoctorServiceIds[iNdEx])
+			copy(dAtA[i:], m.DoctorServiceIds[iNdEx])
+			i = encodeVarintServicePackage(dAtA, i, uint64(len(m.DoctorServiceIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintServicePackage(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintServicePackage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintServicePackage(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServicePackageId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServicePackageId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServicePackageId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintServicePackage(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServicePackagesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServicePackagesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServicePackagesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintServicePackage(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintServicePackage(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintServicePackage(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServicePackagesRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServicePackagesRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServicePackagesRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintServicePackage(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ServicePackages) > 0 {
+		for iNdEx := len(m.ServicePackages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServicePackages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServicePackage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusServicePackage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusServicePackage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusServicePackage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintServicePackage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovServicePackage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ServicePackage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovServicePackage(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovServicePackage(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovServicePackage(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 9
+	}
+	if len(m.DoctorServiceIds) > 0 {
+		for _, s := range m.DoctorServiceIds {
+			l = len(s)
+			n += 1 + l + sovServicePackage(uint64(l))
+		}
+	}
+	if m.ItemsPrice != 0 {
+		n += 9
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovServicePackage(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovServicePackage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServicePackageId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovServicePackage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListServicePackagesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovServicePackage(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovServicePackage(uint64(m.Limit))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovServicePackage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListServicePackagesRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ServicePackages) > 0 {
+		for _, e := range m.ServicePackages {
+			l = e.Size()
+			n += 1 + l + sovServicePackage(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovServicePackage(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusServicePackage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovServicePackage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozServicePackage(x uint64) (n int) {
+	return sovServicePackage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ServicePackage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServicePackage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServicePackage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServicePackage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceIds = append(m.DoctorServiceIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemsPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ItemsPrice = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServicePackage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServicePackageId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServicePackage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServicePackageId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServicePackageId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServicePackage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListServicePackagesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServicePackage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListServicePackagesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListServicePackagesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServicePackage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListServicePackagesRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServicePackage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListServicePackagesRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListServicePackagesRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServicePackages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServicePackages = append(m.ServicePackages, &ServicePackage{})
+			if err := m.ServicePackages[len(m.ServicePackages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServicePackage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusServicePackage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServicePackage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusServicePackage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusServicePackage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServicePackage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServicePackage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipServicePackage(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowServicePackage
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowServicePackage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthServicePackage
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupServicePackage
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthServicePackage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthServicePackage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowServicePackage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupServicePackage = fmt.Errorf("proto: unexpected end of group")
+)
@@ -4,6 +4,8 @@ import (
 	"context"
 	"dennic_admin_api_gateway/api"
 	grpcService "dennic_admin_api_gateway/internal/infrastructure/grpc_service_client"
+	"dennic_admin_api_gateway/internal/infrastructure/kafka"
+	cache "dennic_admin_api_gateway/internal/infrastructure/redis"
 	"dennic_admin_api_gateway/internal/pkg/config"
 	"dennic_admin_api_gateway/internal/pkg/logger"
 	"dennic_admin_api_gateway/internal/pkg/otlp"
@@ -23,6 +25,7 @@ type App struct {
 	server       *http.Server
 	Clients      grpcService.ServiceClient
 	ShutdownOTLP func() error
+	StopConsumer context.CancelFunc
 	//BrokerProducer event.BrokerProducer
 }
 
@@ -74,6 +77,16 @@ func (a *App) Run() error {
 	}
 
 	// initialize cache
+	catalogueCache := cache.NewCatalogueCache(a.RedisDB, a.Config.CatalogueCache.TTL)
+
+	// the healthcare change events invalidate the cached catalogue
+	consumerCtx, stopConsumer := context.WithCancel(context.Background())
+	a.StopConsumer = stopConsumer
+	healthcareEvents := kafka.NewConsumer(a.Config.Kafka.Address, a.Config.Kafka.Topic.HealthcareEvents, a.Config.Kafka.GroupId, a.Logger)
+	go func() {
+		healthcareEvents.Run(consumerCtx, catalogueCache.HandleEvent)
+		healthcareEvents.Close()
+	}()
 
 	clients, err := grpcService.New(a.Config)
 	if err != nil {
@@ -88,6 +101,7 @@ func (a *App) Run() error {
 		ContextTimeout: contextTimeout,
 		Service:        clients,
 		Redis:          a.RedisDB,
+		CatalogueCache: catalogueCache,
 		//BrokerProducer: a.BrokerProducer,
 	})
 
@@ -102,6 +116,11 @@ func (a *App) Run() error {
 
 func (a *App) Stop() {
 
+	// stop the healthcare events consumer
+	if a.StopConsumer != nil {
+		a.StopConsumer()
+	}
+
 	// close database
 	a.DB.Close()
 
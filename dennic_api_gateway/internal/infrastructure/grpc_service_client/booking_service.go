@@ -12,6 +12,12 @@ type BookingServiceI interface {
 	BookedAppointment() booking_service.BookedAppointmentsServiceClient
 	DoctorTimes() booking_service.DoctorTimeServiceClient
 	DoctorNotes() booking_service.DoctorNotesServiceClient
+	BookingRules() booking_service.BookingRulesServiceClient
+	Reschedule() booking_service.RescheduleServiceClient
+	DoctorReviews() booking_service.DoctorReviewsServiceClient
+	Recommendation() booking_service.RecommendationServiceClient
+	PromoCodes() booking_service.PromoCodesServiceClient
+	PatientDocuments() booking_service.PatientDocumentsServiceClient
 }
 
 type BookingService struct {
@@ -20,6 +26,12 @@ type BookingService struct {
 	bookedAppointment booking_service.BookedAppointmentsServiceClient
 	doctorTimes       booking_service.DoctorTimeServiceClient
 	doctorNotes       booking_service.DoctorNotesServiceClient
+	bookingRules      booking_service.BookingRulesServiceClient
+	reschedule        booking_service.RescheduleServiceClient
+	doctorReviews     booking_service.DoctorReviewsServiceClient
+	recommendation    booking_service.RecommendationServiceClient
+	promoCodes        booking_service.PromoCodesServiceClient
+	patientDocuments  booking_service.PatientDocumentsServiceClient
 }
 
 func NewBookingService(conn *grpc.ClientConn) *BookingService {
@@ -29,6 +41,12 @@ func NewBookingService(conn *grpc.ClientConn) *BookingService {
 		bookedAppointment: booking_service.NewBookedAppointmentsServiceClient(conn),
 		doctorTimes:       booking_service.NewDoctorTimeServiceClient(conn),
 		doctorNotes:       booking_service.NewDoctorNotesServiceClient(conn),
+		bookingRules:      booking_service.NewBookingRulesServiceClient(conn),
+		reschedule:        booking_service.NewRescheduleServiceClient(conn),
+		doctorReviews:     booking_service.NewDoctorReviewsServiceClient(conn),
+		recommendation:    booking_service.NewRecommendationServiceClient(conn),
+		promoCodes:        booking_service.NewPromoCodesServiceClient(conn),
+		patientDocuments:  booking_service.NewPatientDocumentsServiceClient(conn),
 	}
 }
 
@@ -51,3 +69,27 @@ func (s *BookingService) DoctorTimes() booking_service.DoctorTimeServiceClient {
 func (s *BookingService) DoctorNotes() booking_service.DoctorNotesServiceClient {
 	return s.doctorNotes
 }
+
+func (s *BookingService) BookingRules() booking_service.BookingRulesServiceClient {
+	return s.bookingRules
+}
+
+func (s *BookingService) Reschedule() booking_service.RescheduleServiceClient {
+	return s.reschedule
+}
+
+func (s *BookingService) DoctorReviews() booking_service.DoctorReviewsServiceClient {
+	return s.doctorReviews
+}
+
+func (s *BookingService) Recommendation() booking_service.RecommendationServiceClient {
+	return s.recommendation
+}
+
+func (s *BookingService) PromoCodes() booking_service.PromoCodesServiceClient {
+	return s.promoCodes
+}
+
+func (s *BookingService) PatientDocuments() booking_service.PatientDocumentsServiceClient {
+	return s.patientDocuments
+}
@@ -13,6 +13,15 @@ type HealthcareServiceI interface {
 	DoctorWorkingHoursService() healthcare.DoctorWorkingHoursServiceClient
 	SpecializationService() healthcare.SpecializationServiceClient
 	ReasonsService() healthcare.ReasonsServiceClient
+	SearchService() healthcare.SearchServiceClient
+	TranslationService() healthcare.TranslationServiceClient
+	DoctorLeaveService() healthcare.DoctorLeaveServiceClient
+	DoctorCredentialService() healthcare.DoctorCredentialServiceClient
+	BranchService() healthcare.BranchServiceClient
+	ResourceService() healthcare.ResourceServiceClient
+	ServicePackageService() healthcare.ServicePackageServiceClient
+	ServiceDiscountService() healthcare.ServiceDiscountServiceClient
+	PriceHistoryService() healthcare.PriceHistoryServiceClient
 }
 
 type HealthcareService struct {
@@ -22,6 +31,15 @@ type HealthcareService struct {
 	doctorWorkingHoursService healthcare.DoctorWorkingHoursServiceClient
 	specializationService     healthcare.SpecializationServiceClient
 	reasonsService            healthcare.ReasonsServiceClient
+	searchService             healthcare.SearchServiceClient
+	translationService        healthcare.TranslationServiceClient
+	doctorLeaveService        healthcare.DoctorLeaveServiceClient
+	doctorCredentialService   healthcare.DoctorCredentialServiceClient
+	branchService             healthcare.BranchServiceClient
+	resourceService           healthcare.ResourceServiceClient
+	servicePackageService     healthcare.ServicePackageServiceClient
+	serviceDiscountService    healthcare.ServiceDiscountServiceClient
+	priceHistoryService       healthcare.PriceHistoryServiceClient
 }
 
 func NewHealthcareService(conn *grpc.ClientConn) *HealthcareService {
@@ -32,6 +50,15 @@ func NewHealthcareService(conn *grpc.ClientConn) *HealthcareService {
 		doctorWorkingHoursService: healthcare.NewDoctorWorkingHoursServiceClient(conn),
 		specializationService:     healthcare.NewSpecializationServiceClient(conn),
 		reasonsService:            healthcare.NewReasonsServiceClient(conn),
+		searchService:             healthcare.NewSearchServiceClient(conn),
+		translationService:        healthcare.NewTranslationServiceClient(conn),
+		doctorLeaveService:        healthcare.NewDoctorLeaveServiceClient(conn),
+		doctorCredentialService:   healthcare.NewDoctorCredentialServiceClient(conn),
+		branchService:             healthcare.NewBranchServiceClient(conn),
+		resourceService:           healthcare.NewResourceServiceClient(conn),
+		servicePackageService:     healthcare.NewServicePackageServiceClient(conn),
+		serviceDiscountService:    healthcare.NewServiceDiscountServiceClient(conn),
+		priceHistoryService:       healthcare.NewPriceHistoryServiceClient(conn),
 	}
 }
 
@@ -58,3 +85,39 @@ func (s *HealthcareService) SpecializationService() healthcare.SpecializationSer
 func (s *HealthcareService) ReasonsService() healthcare.ReasonsServiceClient {
 	return s.reasonsService
 }
+
+func (s *HealthcareService) SearchService() healthcare.SearchServiceClient {
+	return s.searchService
+}
+
+func (s *HealthcareService) TranslationService() healthcare.TranslationServiceClient {
+	return s.translationService
+}
+
+func (s *HealthcareService) DoctorLeaveService() healthcare.DoctorLeaveServiceClient {
+	return s.doctorLeaveService
+}
+
+func (s *HealthcareService) DoctorCredentialService() healthcare.DoctorCredentialServiceClient {
+	return s.doctorCredentialService
+}
+
+func (s *HealthcareService) BranchService() healthcare.BranchServiceClient {
+	return s.branchService
+}
+
+func (s *HealthcareService) ResourceService() healthcare.ResourceServiceClient {
+	return s.resourceService
+}
+
+func (s *HealthcareService) ServicePackageService() healthcare.ServicePackageServiceClient {
+	return s.servicePackageService
+}
+
+func (s *HealthcareService) ServiceDiscountService() healthcare.ServiceDiscountServiceClient {
+	return s.serviceDiscountService
+}
+
+func (s *HealthcareService) PriceHistoryService() healthcare.PriceHistoryServiceClient {
+	return s.priceHistoryService
+}
@@ -0,0 +1,99 @@
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+const (
+	MinBytes = 10e3 // 10KB
+	MaxBytes = 10e6 // 10MB
+
+	// minBackoff and maxBackoff bound the wait before a failed fetch or handling is retried
+	minBackoff = time.Second
+	maxBackoff = 30 * time.Second
+)
+
+type HandlerFunc func(ctx context.Context, key, value []byte) error
+
+type consumer struct {
+	logger *zap.Logger
+	reader *kafka.Reader
+}
+
+func NewConsumer(brokers []string, topic, groupID string, logger *zap.Logger) *consumer {
+	return &consumer{
+		logger: logger,
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers:  brokers,
+			Topic:    topic,
+			GroupID:  groupID,
+			MinBytes: MinBytes,
+			MaxBytes: MaxBytes,
+		}),
+	}
+}
+
+// Run hands the messages of the topic to the handler until the context is done. A failed fetch is retried,
+// and so is a message the handler failed on, it is committed only once handled, the messages after it wait
+func (c *consumer) Run(ctx context.Context, handler HandlerFunc) {
+	topic := c.reader.Config().Topic
+	backoff := minBackoff
+	for {
+		m, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			c.logger.Error("consumer failed to fetch message:", zap.String("topic", topic), zap.Duration("retry_in", backoff), zap.Error(err))
+			if !wait(ctx, backoff) {
+				return
+			}
+			backoff = nextBackoff(backoff)
+			continue
+		}
+		backoff = minBackoff
+
+		for {
+			err = handler(ctx, m.Key, m.Value)
+			if err == nil {
+				break
+			}
+			c.logger.Error("consumer failed to handler message:", zap.ByteString("value", m.Value), zap.String("topic", topic), zap.Duration("retry_in", backoff), zap.Error(err))
+			if !wait(ctx, backoff) {
+				return
+			}
+			backoff = nextBackoff(backoff)
+		}
+		backoff = minBackoff
+
+		if err := c.reader.CommitMessages(ctx, m); err != nil {
+			c.logger.Error("consumer failed to commit messages:", zap.String("topic", topic), zap.Error(err))
+		}
+	}
+}
+
+// wait sleeps for the duration, false when the context is done before
+func wait(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func nextBackoff(backoff time.Duration) time.Duration {
+	return min(backoff*2, maxBackoff)
+}
+
+func (c *consumer) Close() {
+	if err := c.reader.Close(); err != nil {
+		c.logger.Error("consumer reader close", zap.Error(err))
+	}
+}
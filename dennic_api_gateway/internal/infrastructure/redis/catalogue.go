@@ -0,0 +1,169 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel/attribute"
+
+	otlp_pkg "dennic_admin_api_gateway/internal/pkg/otlp"
+	"dennic_admin_api_gateway/internal/pkg/redis"
+)
+
+// catalogue resources cached by the gateway
+const (
+	CatalogueDepartments     = "departments"
+	CatalogueSpecializations = "specializations"
+	CatalogueReasons         = "reasons"
+	CatalogueDoctor          = "doctor"
+)
+
+const (
+	cataloguePrefix   = "catalogue"
+	catalogueStatsKey = cataloguePrefix + ":stats"
+)
+
+var CatalogueResources = []string{
+	CatalogueDepartments,
+	CatalogueSpecializations,
+	CatalogueReasons,
+	CatalogueDoctor,
+}
+
+// catalogueInvalidations are the cached resources a change of the healthcare entity makes stale, the responses
+// embed the records of the related entities
+var catalogueInvalidations = map[string][]string{
+	"department":     {CatalogueDepartments, CatalogueSpecializations, CatalogueDoctor},
+	"specialization": {CatalogueSpecializations, CatalogueReasons, CatalogueDoctor},
+	"reason":         {CatalogueReasons},
+	"doctor":         {CatalogueDoctor},
+	"doctor_service": {CatalogueDoctor},
+	"working_hours":  {CatalogueDoctor},
+	"translation":    {CatalogueDepartments, CatalogueSpecializations, CatalogueReasons, CatalogueDoctor},
+}
+
+// CatalogueStats are the lookups of a cached resource since the counters were reset
+type CatalogueStats struct {
+	Resource string `json:"resource"`
+	Hits     int64  `json:"hits"`
+	Misses   int64  `json:"misses"`
+}
+
+// healthcareEvent is the part of a healthcare change event the cache reads
+type healthcareEvent struct {
+	Type        string `json:"type"`
+	AggregateId string `json:"aggregate_id"`
+}
+
+// CatalogueCache is a read-through cache of the catalogue responses. Every resource has a version in its keys,
+// invalidating a resource bumps the version so the stale entries are no longer read and expire with their ttl
+type CatalogueCache struct {
+	rdb *redis.RedisDB
+	ttl time.Duration
+}
+
+func NewCatalogueCache(rdb *redis.RedisDB, ttl time.Duration) *CatalogueCache {
+	return &CatalogueCache{
+		rdb: rdb,
+		ttl: ttl,
+	}
+}
+
+// Key is the key of the response of the resource to the query in the language the response is translated into
+func (c *CatalogueCache) Key(ctx context.Context, resource string, query url.Values, language string) (string, error) {
+	version, err := c.rdb.Client.Get(ctx, c.versionKey(resource)).Int64()
+	if err != nil && !errors.Is(err, goredis.Nil) {
+		return "", err
+	}
+	return fmt.Sprintf("%s:%s:v%d:%s:%s", cataloguePrefix, resource, version, language, query.Encode()), nil
+}
+
+// Get reads the cached response counting the hit or the miss of the resource, a failing redis is a miss
+func (c *CatalogueCache) Get(ctx context.Context, resource, key string) ([]byte, bool) {
+	ctx, span := otlp_pkg.Start(ctx, "cecheService", "CatalogueCacheGet")
+	span.SetAttributes(attribute.Key("key").String(key))
+	defer span.End()
+
+	data, err := c.rdb.Client.Get(ctx, key).Bytes()
+	hit := err == nil
+	counter := resource + ":misses"
+	if hit {
+		counter = resource + ":hits"
+	}
+	c.rdb.Client.HIncrBy(ctx, catalogueStatsKey, counter, 1)
+	return data, hit
+}
+
+func (c *CatalogueCache) Set(ctx context.Context, key string, value interface{}) error {
+	ctx, span := otlp_pkg.Start(ctx, "cecheService", "CatalogueCacheSet")
+	span.SetAttributes(attribute.Key("key").String(key))
+	defer span.End()
+
+	byteData, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return c.rdb.Client.Set(ctx, key, byteData, c.ttl).Err()
+}
+
+// Invalidate makes the cached responses of the resources stale, all the resources when none is given
+func (c *CatalogueCache) Invalidate(ctx context.Context, resources ...string) error {
+	if len(resources) == 0 {
+		resources = CatalogueResources
+	}
+	for _, resource := range resources {
+		if err := c.rdb.Client.Incr(ctx, c.versionKey(resource)).Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stats are the hits and misses of every cached resource
+func (c *CatalogueCache) Stats(ctx context.Context) ([]CatalogueStats, error) {
+	counters, err := c.rdb.Client.HGetAll(ctx, catalogueStatsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]CatalogueStats, 0, len(CatalogueResources))
+	for _, resource := range CatalogueResources {
+		hits, _ := strconv.ParseInt(counters[resource+":hits"], 10, 64)
+		misses, _ := strconv.ParseInt(counters[resource+":misses"], 10, 64)
+		stats = append(stats, CatalogueStats{
+			Resource: resource,
+			Hits:     hits,
+			Misses:   misses,
+		})
+	}
+	return stats, nil
+}
+
+// HandleEvent invalidates the resources the healthcare change event makes stale,
+// the type of the event is healthcare.<entity>.<change>. An event it cannot read invalidates
+// every resource, so the consumer retries only on the failures of redis
+func (c *CatalogueCache) HandleEvent(ctx context.Context, key, value []byte) error {
+	var event healthcareEvent
+	if err := json.Unmarshal(value, &event); err != nil {
+		return c.Invalidate(ctx)
+	}
+	parts := strings.Split(event.Type, ".")
+	if len(parts) != 3 {
+		return c.Invalidate(ctx)
+	}
+	resources, ok := catalogueInvalidations[parts[1]]
+	if !ok {
+		return nil
+	}
+	return c.Invalidate(ctx, resources...)
+}
+
+func (c *CatalogueCache) versionKey(resource string) string {
+	return fmt.Sprintf("%s:%s:version", cataloguePrefix, resource)
+}
@@ -19,10 +19,15 @@ type webAddress struct {
 }
 
 type minio struct {
-	Endpoint  string
-	ImageURL  string
-	AccessKey string
-	SecretKey string
+	Endpoint          string
+	ImageURL          string
+	AccessKey         string
+	SecretKey         string
+	MaxUploadSize     int64
+	DocumentBucket    string
+	DocumentMaxSize   int64
+	PresignExpiry     time.Duration
+	DocumentURLExpiry time.Duration
 }
 
 type Config struct {
@@ -70,7 +75,12 @@ type Config struct {
 		Address []string
 		Topic   struct {
 			InvestmentPaymentTransaction string
+			HealthcareEvents             string
 		}
+		GroupId string
+	}
+	CatalogueCache struct {
+		TTL time.Duration
 	}
 	BookingService    webAddress
 	HealthcareService webAddress
@@ -147,12 +157,38 @@ func NewConfig() (*Config, error) {
 	// kafka configuration
 	config.Kafka.Address = strings.Split(getEnv("KAFKA_ADDRESS", "localhost:29092"), ",")
 	config.Kafka.Topic.InvestmentPaymentTransaction = getEnv("KAFKA_TOPIC_INVESTMENT_PAYMENT_TRANSACTION", "investment.payment.transaction")
+	config.Kafka.Topic.HealthcareEvents = getEnv("KAFKA_TOPIC_HEALTHCARE_EVENTS", "healthcare.events")
+	config.Kafka.GroupId = getEnv("KAFKA_GROUP_ID", "api_gateway")
+
+	// catalogue cache ttl parse
+	catalogueCacheTTL, err := time.ParseDuration(getEnv("CATALOGUE_CACHE_TTL", "10m"))
+	if err != nil {
+		return nil, err
+	}
+	config.CatalogueCache.TTL = catalogueCacheTTL
 
 	// model_minio configuration
 	config.MinioService.ImageURL = getEnv("MINIO_SERVICE_ENDPOINT", "https://minio.dennic.uz")
 	config.MinioService.Endpoint = getEnv("MINIO_SERVICE_ENDPOINT", "minio:9000")
 	config.MinioService.AccessKey = getEnv("MINIO_SERVICE_ACCESS_KEY", "dennic")
 	config.MinioService.SecretKey = getEnv("MINIO_SERVICE_SECRET_KEY", "dennic_service")
+	config.MinioService.MaxUploadSize = cast.ToInt64(getEnv("MINIO_SERVICE_MAX_UPLOAD_SIZE", "10485760"))
+
+	// the private bucket of the patient documents, served only through presigned urls
+	config.MinioService.DocumentBucket = getEnv("MINIO_SERVICE_DOCUMENT_BUCKET", "patient-documents")
+	config.MinioService.DocumentMaxSize = cast.ToInt64(getEnv("MINIO_SERVICE_DOCUMENT_MAX_SIZE", "20971520"))
+
+	// presign expiry parse
+	presignExpiry, err := time.ParseDuration(getEnv("MINIO_SERVICE_PRESIGN_EXPIRY", "15m"))
+	if err != nil {
+		return nil, err
+	}
+	documentURLExpiry, err := time.ParseDuration(getEnv("MINIO_SERVICE_DOCUMENT_URL_EXPIRY", "5m"))
+	if err != nil {
+		return nil, err
+	}
+	config.MinioService.PresignExpiry = presignExpiry
+	config.MinioService.DocumentURLExpiry = documentURLExpiry
 
 	return &config, nil
 }
@@ -0,0 +1,250 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"compress/flate"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+
+	sheetName = "Sheet1"
+)
+
+// Writer streams rows into a spreadsheet file
+type Writer interface {
+	Write(record []string) error
+	Flush() error
+	Close() error
+}
+
+// New returns a Writer for the given format which writes into w
+func New(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w), nil
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	}
+	return nil, fmt.Errorf("unsupported export format: %s", format)
+}
+
+// ContentType returns the mime type of the given format
+func ContentType(format string) string {
+	if format == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Column describes how a single column is rendered from an item
+type Column[T any] struct {
+	Name  string
+	Value func(item T) string
+}
+
+// Columns is the ordered set of exportable columns of an entity
+type Columns[T any] []Column[T]
+
+// Select returns the columns listed in raw (comma separated) in the given order,
+// or all columns when raw is empty
+func (cs Columns[T]) Select(raw string) (Columns[T], error) {
+	if strings.TrimSpace(raw) == "" {
+		return cs, nil
+	}
+
+	byName := make(map[string]Column[T], len(cs))
+	for _, column := range cs {
+		byName[column.Name] = column
+	}
+
+	var selected Columns[T]
+	for _, name := range strings.Split(raw, ",") {
+		column, ok := byName[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown export column: %s", strings.TrimSpace(name))
+		}
+		selected = append(selected, column)
+	}
+	return selected, nil
+}
+
+// Header returns the column names
+func (cs Columns[T]) Header() []string {
+	header := make([]string, 0, len(cs))
+	for _, column := range cs {
+		header = append(header, column.Name)
+	}
+	return header
+}
+
+// Row renders item into a record
+func (cs Columns[T]) Row(item T) []string {
+	row := make([]string, 0, len(cs))
+	for _, column := range cs {
+		row = append(row, column.Value(item))
+	}
+	return row
+}
+
+type csvWriter struct {
+	w   io.Writer
+	csv *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{
+		w:   w,
+		csv: csv.NewWriter(w),
+	}
+}
+
+func (c *csvWriter) Write(record []string) error {
+	return c.csv.Write(record)
+}
+
+func (c *csvWriter) Flush() error {
+	c.csv.Flush()
+	if err := c.csv.Error(); err != nil {
+		return err
+	}
+	if flusher, ok := c.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
+
+func (c *csvWriter) Close() error {
+	return c.Flush()
+}
+
+// the parts of a workbook with a single sheet, the sheet itself is streamed row by row
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+	xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="` + sheetName + `" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+	xlsxSheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetEnd = `</sheetData></worksheet>`
+)
+
+// xlsxWriter writes the workbook as a zip straight into w, the rows of the sheet are compressed as they come
+// and Flush pushes them out, so nothing but the current batch is held in memory
+type xlsxWriter struct {
+	w       io.Writer
+	zip     *zip.Writer
+	deflate *flate.Writer
+	sheet   *bufio.Writer
+	row     int
+}
+
+func newXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	x := &xlsxWriter{
+		w:   w,
+		zip: zip.NewWriter(w),
+	}
+	// the compressor of the sheet is kept to flush the rows compressed so far
+	x.zip.RegisterCompressor(zip.Deflate, func(out io.Writer) (io.WriteCloser, error) {
+		fw, err := flate.NewWriter(out, flate.DefaultCompression)
+		x.deflate = fw
+		return fw, err
+	})
+
+	for _, part := range []struct{ name, content string }{
+		{name: "[Content_Types].xml", content: xlsxContentTypes},
+		{name: "_rels/.rels", content: xlsxRels},
+		{name: "xl/workbook.xml", content: xlsxWorkbook},
+		{name: "xl/_rels/workbook.xml.rels", content: xlsxWorkbookRels},
+	} {
+		fw, err := x.zip.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = io.WriteString(fw, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	sheet, err := x.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	x.sheet = bufio.NewWriter(sheet)
+	if _, err = x.sheet.WriteString(xlsxSheetStart); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// Write adds the record as a row of inline strings
+func (x *xlsxWriter) Write(record []string) error {
+	x.row++
+	fmt.Fprintf(x.sheet, `<row r="%d">`, x.row)
+	for _, value := range record {
+		x.sheet.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`)
+		if err := xml.EscapeText(x.sheet, []byte(value)); err != nil {
+			return err
+		}
+		x.sheet.WriteString(`</t></is></c>`)
+	}
+	_, err := x.sheet.WriteString(`</row>`)
+	return err
+}
+
+// Flush pushes the rows written so far through the compressor and the zip into w
+func (x *xlsxWriter) Flush() error {
+	if err := x.sheet.Flush(); err != nil {
+		return err
+	}
+	if err := x.deflate.Flush(); err != nil {
+		return err
+	}
+	if err := x.zip.Flush(); err != nil {
+		return err
+	}
+	if flusher, ok := x.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
+
+// Close ends the sheet and writes the central directory of the zip
+func (x *xlsxWriter) Close() error {
+	if _, err := x.sheet.WriteString(xlsxSheetEnd); err != nil {
+		return err
+	}
+	if err := x.sheet.Flush(); err != nil {
+		return err
+	}
+	if err := x.zip.Close(); err != nil {
+		return err
+	}
+	if flusher, ok := x.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// sheet is the part of a worksheet the xlsx writer fills
+type sheet struct {
+	Rows []struct {
+		Cells []struct {
+			Text string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+func TestXLSXWriter(t *testing.T) {
+	batches := [][][]string{
+		{{"id", "name"}, {"1", "Ali <Valiyev> & co"}},
+		{{"2", "line\nbreak"}, {"3", ""}},
+	}
+
+	var buf bytes.Buffer
+	writer, err := New(FormatXLSX, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var want [][]string
+	for n, batch := range batches {
+		before := buf.Len()
+		for _, record := range batch {
+			if err = writer.Write(record); err != nil {
+				t.Fatal(err)
+			}
+			want = append(want, record)
+		}
+		if err = writer.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		// every batch reaches the output on its flush, not only on close
+		if buf.Len() <= before {
+			t.Errorf("batch %d: flush wrote nothing", n)
+		}
+	}
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := map[string][]byte{}
+	for _, file := range archive.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts[file.Name] = content
+	}
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/worksheets/sheet1.xml"} {
+		if _, ok := parts[name]; !ok {
+			t.Errorf("the workbook has no %s", name)
+		}
+	}
+
+	var got sheet
+	if err = xml.Unmarshal(parts["xl/worksheets/sheet1.xml"], &got); err != nil {
+		t.Fatal(err)
+	}
+	var rows [][]string
+	for _, row := range got.Rows {
+		var record []string
+		for _, cell := range row.Cells {
+			record = append(record, cell.Text)
+		}
+		rows = append(rows, record)
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("got rows %q, want %q", rows, want)
+	}
+}
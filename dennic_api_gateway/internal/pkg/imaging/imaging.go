@@ -0,0 +1,334 @@
+package imaging
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"golang.org/x/image/draw"
+)
+
+const (
+	RenditionOriginal  = "original"
+	RenditionThumbnail = "thumbnail"
+	RenditionMedium    = "medium"
+
+	// maxPixels bounds the decoded size of an image, a small file may decode to a huge bitmap
+	maxPixels   = 50_000_000
+	jpegQuality = 90
+)
+
+var (
+	ErrTooLarge        = errors.New("the file is too large")
+	ErrUnsupportedType = errors.New("the file is not a jpeg or png image")
+)
+
+// renditionSizes are the largest sides of the renditions, a smaller image is not enlarged
+var renditionSizes = []struct {
+	name string
+	side int
+}{
+	{name: RenditionThumbnail, side: 256},
+	{name: RenditionMedium, side: 1024},
+}
+
+// Rendition is an encoded image stored under ObjectName
+type Rendition struct {
+	Name        string
+	ObjectName  string
+	ContentType string
+	Content     []byte
+}
+
+// Process checks the uploaded content is a jpeg or png image of at most maxSize bytes and keeps it as the original
+// without its metadata, the thumbnail and medium renditions are re-encoded turned upright after the exif orientation.
+// The object names derive from the content, uploading the same image again yields the same names
+func Process(content []byte, maxSize int64) ([]Rendition, error) {
+	if int64(len(content)) > maxSize {
+		return nil, ErrTooLarge
+	}
+
+	contentType := http.DetectContentType(content)
+	var extension string
+	switch contentType {
+	case "image/jpeg":
+		extension = ".jpg"
+	case "image/png":
+		extension = ".png"
+	default:
+		return nil, ErrUnsupportedType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, ErrUnsupportedType
+	}
+	if config.Width*config.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, ErrUnsupportedType
+	}
+	if contentType == "image/jpeg" {
+		img = orient(img, jpegOrientation(content))
+	}
+
+	sum := sha256.Sum256(content)
+	prefix := hex.EncodeToString(sum[:16])
+
+	renditions := make([]Rendition, 0, len(renditionSizes)+1)
+	add := func(name string, img image.Image) error {
+		encoded, err := encode(img, contentType)
+		if err != nil {
+			return err
+		}
+		renditions = append(renditions, Rendition{
+			Name:        name,
+			ObjectName:  fmt.Sprintf("%s_%s%s", prefix, name, extension),
+			ContentType: contentType,
+			Content:     encoded,
+		})
+		return nil
+	}
+	// the original keeps its bytes, only a file whose structure is not understood is re-encoded
+	if original, ok := stripMetadata(content, contentType); ok {
+		renditions = append(renditions, Rendition{
+			Name:        RenditionOriginal,
+			ObjectName:  fmt.Sprintf("%s_%s%s", prefix, RenditionOriginal, extension),
+			ContentType: contentType,
+			Content:     original,
+		})
+	} else if err = add(RenditionOriginal, img); err != nil {
+		return nil, err
+	}
+	for _, size := range renditionSizes {
+		if err = add(size.name, fit(img, size.side)); err != nil {
+			return nil, err
+		}
+	}
+	return renditions, nil
+}
+
+// pngMetadata are the png chunks dropped from the original, they hold exif, text and the modification time
+var pngMetadata = map[string]bool{
+	"eXIf": true,
+	"tEXt": true,
+	"zTXt": true,
+	"iTXt": true,
+	"tIME": true,
+}
+
+// stripMetadata drops the metadata of the content keeping the image data byte for byte,
+// ok is false when the structure of the file is not understood
+func stripMetadata(content []byte, contentType string) ([]byte, bool) {
+	if contentType == "image/png" {
+		return stripPNG(content)
+	}
+	return stripJPEG(content)
+}
+
+// stripJPEG drops the app1 (exif, xmp), app13 (iptc) and comment segments before the start of scan,
+// a non upright exif orientation is kept in a segment of its own so the original still shows upright
+func stripJPEG(content []byte) ([]byte, bool) {
+	if len(content) < 4 || content[0] != 0xFF || content[1] != 0xD8 {
+		return nil, false
+	}
+	orientation := jpegOrientation(content)
+	out := make([]byte, 0, len(content))
+	out = append(out, content[:2]...)
+	for i := 2; i+4 <= len(content); {
+		if content[i] != 0xFF {
+			return nil, false
+		}
+		marker := content[i+1]
+		if marker == 0xDA {
+			return append(out, content[i:]...), true
+		}
+		length := int(binary.BigEndian.Uint16(content[i+2 : i+4]))
+		if length < 2 || i+2+length > len(content) {
+			return nil, false
+		}
+		switch marker {
+		case 0xE1:
+			// the orientation takes the place of the first exif segment
+			if orientation >= 2 && orientation <= 8 {
+				out = append(out, orientationSegment(orientation)...)
+				orientation = 0
+			}
+		case 0xED, 0xFE:
+			// dropped
+		default:
+			out = append(out, content[i:i+2+length]...)
+		}
+		i += 2 + length
+	}
+	return nil, false
+}
+
+// orientationSegment is an app1 exif segment holding the orientation tag only
+func orientationSegment(orientation int) []byte {
+	const length = 2 + 6 + 8 + 2 + 12 + 4
+	segment := make([]byte, 0, 2+length)
+	segment = append(segment, 0xFF, 0xE1, 0, length)
+	segment = append(segment, "Exif\x00\x00MM\x00\x2a\x00\x00\x00\x08"...)
+	// one ifd entry: the orientation tag, a short, one value
+	segment = append(segment, 0x00, 0x01, 0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, byte(orientation), 0x00, 0x00)
+	// no next ifd
+	return append(segment, 0x00, 0x00, 0x00, 0x00)
+}
+
+// stripPNG drops the metadata chunks of the png, the others are kept as they are
+func stripPNG(content []byte) ([]byte, bool) {
+	const signature = "\x89PNG\r\n\x1a\n"
+	if !bytes.HasPrefix(content, []byte(signature)) {
+		return nil, false
+	}
+	out := make([]byte, 0, len(content))
+	out = append(out, signature...)
+	for i := len(signature); i+12 <= len(content); {
+		// length, type, data and crc
+		end := i + 12 + int(binary.BigEndian.Uint32(content[i:i+4]))
+		if end > len(content) {
+			return nil, false
+		}
+		chunkType := string(content[i+4 : i+8])
+		if !pngMetadata[chunkType] {
+			out = append(out, content[i:end]...)
+		}
+		if chunkType == "IEND" {
+			return out, true
+		}
+		i = end
+	}
+	return nil, false
+}
+
+// encode writes the image in the format of the content type, the encoders write no metadata
+func encode(img image.Image, contentType string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	if contentType == "image/png" {
+		err = png.Encode(&buf, img)
+	} else {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	}
+	return buf.Bytes(), err
+}
+
+// fit scales the image down to fit a side x side square keeping its aspect ratio
+func fit(img image.Image, side int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= side && height <= side {
+		return img
+	}
+	if width >= height {
+		height = max(1, height*side/width)
+		width = side
+	} else {
+		width = max(1, width*side/height)
+		height = side
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+	return dst
+}
+
+// orient turns the image upright after its exif orientation, 1 is upright and 2-8 mirror and rotate it
+func orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	swap := orientation >= 5
+	dstWidth, dstHeight := width, height
+	if swap {
+		dstWidth, dstHeight = height, width
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = width-1-x, y
+			case 3:
+				dx, dy = width-1-x, height-1-y
+			case 4:
+				dx, dy = x, height-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = height-1-y, x
+			case 7:
+				dx, dy = height-1-y, width-1-x
+			case 8:
+				dx, dy = y, width-1-x
+			}
+			dst.Set(dx, dy, img.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+	return dst
+}
+
+// jpegOrientation reads the orientation tag of the exif segment of the jpeg, 0 when there is none
+func jpegOrientation(content []byte) int {
+	if len(content) < 4 || content[0] != 0xFF || content[1] != 0xD8 {
+		return 0
+	}
+	for i := 2; i+4 <= len(content); {
+		if content[i] != 0xFF {
+			return 0
+		}
+		marker := content[i+1]
+		length := int(binary.BigEndian.Uint16(content[i+2 : i+4]))
+		if marker == 0xDA || length < 2 || i+2+length > len(content) {
+			return 0
+		}
+		segment := content[i+4 : i+2+length]
+		if marker == 0xE1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return exifOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+	return 0
+}
+
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 0
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset+2 > len(tiff) {
+		return 0
+	}
+	entries := int(order.Uint16(tiff[offset : offset+2]))
+	for n := 0; n < entries; n++ {
+		entry := offset + 2 + n*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+		if order.Uint16(tiff[entry:entry+2]) == 0x0112 {
+			return int(order.Uint16(tiff[entry+8 : entry+10]))
+		}
+	}
+	return 0
+}
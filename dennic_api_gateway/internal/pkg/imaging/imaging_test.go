@@ -0,0 +1,347 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+// grid is a width x height image whose pixels are numbered 1, 2, 3... row after row
+func grid(width, height int) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetGray(x, y, color.Gray{Y: uint8(y*width + x + 1)})
+		}
+	}
+	return img
+}
+
+// pixels lists the pixel numbers of the image row after row
+func pixels(img image.Image) [][]uint8 {
+	bounds := img.Bounds()
+	rows := make([][]uint8, 0, bounds.Dy())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		row := make([]uint8, 0, bounds.Dx())
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			row = append(row, color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// tiff is an exif tiff header with a single ifd holding the orientation tag
+func tiff(order binary.ByteOrder, orientation uint16) []byte {
+	buf := make([]byte, 8+2+12+4)
+	if order == binary.LittleEndian {
+		copy(buf, "II")
+	} else {
+		copy(buf, "MM")
+	}
+	order.PutUint16(buf[2:4], 42)
+	order.PutUint32(buf[4:8], 8)
+	order.PutUint16(buf[8:10], 1)
+	entry := buf[10:22]
+	order.PutUint16(entry[0:2], 0x0112)
+	order.PutUint16(entry[2:4], 3)
+	order.PutUint32(entry[4:8], 1)
+	order.PutUint16(entry[8:10], orientation)
+	return buf
+}
+
+// segment is a jpeg marker segment with its length
+func segment(marker byte, payload []byte) []byte {
+	buf := []byte{0xFF, marker, 0, 0}
+	binary.BigEndian.PutUint16(buf[2:4], uint16(len(payload)+2))
+	return append(buf, payload...)
+}
+
+// app1 is an exif segment holding the tiff
+func app1(tiff []byte) []byte {
+	return segment(0xE1, append([]byte("Exif\x00\x00"), tiff...))
+}
+
+// withSegments inserts the segments right after the start of image marker of the jpeg
+func withSegments(content []byte, segments ...[]byte) []byte {
+	out := append([]byte{}, content[:2]...)
+	for _, s := range segments {
+		out = append(out, s...)
+	}
+	return append(out, content[2:]...)
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestOrient(t *testing.T) {
+	// 1 2
+	// 3 4
+	// 5 6
+	src := grid(2, 3)
+
+	tests := []struct {
+		orientation int
+		want        [][]uint8
+	}{
+		{orientation: 0, want: [][]uint8{{1, 2}, {3, 4}, {5, 6}}},
+		{orientation: 1, want: [][]uint8{{1, 2}, {3, 4}, {5, 6}}},
+		{orientation: 2, want: [][]uint8{{2, 1}, {4, 3}, {6, 5}}},
+		{orientation: 3, want: [][]uint8{{6, 5}, {4, 3}, {2, 1}}},
+		{orientation: 4, want: [][]uint8{{5, 6}, {3, 4}, {1, 2}}},
+		{orientation: 5, want: [][]uint8{{1, 3, 5}, {2, 4, 6}}},
+		{orientation: 6, want: [][]uint8{{5, 3, 1}, {6, 4, 2}}},
+		{orientation: 7, want: [][]uint8{{6, 4, 2}, {5, 3, 1}}},
+		{orientation: 8, want: [][]uint8{{2, 4, 6}, {1, 3, 5}}},
+		{orientation: 9, want: [][]uint8{{1, 2}, {3, 4}, {5, 6}}},
+	}
+
+	for _, tt := range tests {
+		got := pixels(orient(src, tt.orientation))
+		if !equalRows(got, tt.want) {
+			t.Errorf("orientation %d: got %v, want %v", tt.orientation, got, tt.want)
+		}
+	}
+}
+
+func equalRows(a, b [][]uint8) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	plain := encodeJPEG(t, grid(2, 3))
+
+	type testCase struct {
+		name    string
+		content []byte
+		want    int
+	}
+	var tests []testCase
+	for orientation := uint16(1); orientation <= 8; orientation++ {
+		tests = append(tests,
+			testCase{
+				name:    "little endian " + string(rune('0'+orientation)),
+				content: withSegments(plain, app1(tiff(binary.LittleEndian, orientation))),
+				want:    int(orientation),
+			},
+			testCase{
+				name:    "big endian " + string(rune('0'+orientation)),
+				content: withSegments(plain, app1(tiff(binary.BigEndian, orientation))),
+				want:    int(orientation),
+			},
+		)
+	}
+
+	unknownOrder := tiff(binary.LittleEndian, 6)
+	copy(unknownOrder, "XX")
+	farOffset := tiff(binary.LittleEndian, 6)
+	binary.LittleEndian.PutUint32(farOffset[4:8], 0xFFFFFFFF)
+	manyEntries := tiff(binary.LittleEndian, 6)
+	binary.LittleEndian.PutUint16(manyEntries[8:10], 0xFFFF)
+	binary.LittleEndian.PutUint16(manyEntries[10:12], 0x0100)
+	overlongSegment := app1(tiff(binary.LittleEndian, 6))
+	binary.BigEndian.PutUint16(overlongSegment[2:4], 0xFFFF)
+	shortSegment := app1(tiff(binary.LittleEndian, 6))
+	binary.BigEndian.PutUint16(shortSegment[2:4], 1)
+
+	tests = append(tests, []testCase{
+		{name: "no exif", content: plain, want: 0},
+		{name: "exif after another segment", content: withSegments(plain, segment(0xE0, []byte("JFIF\x00")), app1(tiff(binary.BigEndian, 3))), want: 3},
+		{name: "app1 that is not exif", content: withSegments(plain, segment(0xE1, []byte("http://ns.adobe.com/xap/1.0/\x00"))), want: 0},
+		{name: "exif after the start of scan", content: append(append([]byte{}, plain[:len(plain)-2]...), app1(tiff(binary.LittleEndian, 6))...), want: 0},
+		{name: "not a jpeg", content: encodePNG(t, grid(2, 3)), want: 0},
+		{name: "empty", content: nil, want: 0},
+		{name: "start of image only", content: []byte{0xFF, 0xD8}, want: 0},
+		{name: "truncated segment header", content: []byte{0xFF, 0xD8, 0xFF, 0xE1, 0x00}, want: 0},
+		{name: "segment longer than the file", content: withSegments(plain[:2], overlongSegment), want: 0},
+		{name: "segment length below its own size", content: withSegments(plain, shortSegment), want: 0},
+		{name: "garbage instead of a marker", content: []byte{0xFF, 0xD8, 0x00, 0xE1, 0x00, 0x10}, want: 0},
+		{name: "truncated tiff header", content: withSegments(plain, app1([]byte("II*\x00"))), want: 0},
+		{name: "unknown byte order", content: withSegments(plain, app1(unknownOrder)), want: 0},
+		{name: "ifd offset past the segment", content: withSegments(plain, app1(farOffset)), want: 0},
+		{name: "more entries than the segment holds", content: withSegments(plain, app1(manyEntries)), want: 0},
+		{name: "truncated ifd", content: withSegments(plain, app1(tiff(binary.LittleEndian, 6)[:16])), want: 0},
+	}...)
+
+	for _, tt := range tests {
+		if got := jpegOrientation(tt.content); got != tt.want {
+			t.Errorf("%s: got %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+// hugePNG is a valid png whose header claims width x height pixels
+func hugePNG(t *testing.T, width, height uint32) []byte {
+	t.Helper()
+	content := encodePNG(t, grid(1, 1))
+	// the IHDR chunk follows the 8 byte signature: length, type, 13 bytes of data and its crc
+	ihdr := content[8+4 : 8+4+4+13]
+	binary.BigEndian.PutUint32(ihdr[4:8], width)
+	binary.BigEndian.PutUint32(ihdr[8:12], height)
+	binary.BigEndian.PutUint32(content[8+4+4+13:], crc32.ChecksumIEEE(ihdr))
+	return content
+}
+
+// chunk is a png chunk with its length and crc
+func chunk(chunkType string, data []byte) []byte {
+	buf := make([]byte, 4, 12+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	buf = append(buf, chunkType...)
+	buf = append(buf, data...)
+	return binary.BigEndian.AppendUint32(buf, crc32.ChecksumIEEE(buf[4:]))
+}
+
+// withChunks inserts the chunks right after the IHDR chunk of the png
+func withChunks(content []byte, chunks ...[]byte) []byte {
+	const ihdrEnd = 8 + 4 + 4 + 13 + 4
+	out := append([]byte{}, content[:ihdrEnd]...)
+	for _, c := range chunks {
+		out = append(out, c...)
+	}
+	return append(out, content[ihdrEnd:]...)
+}
+
+func TestStripMetadata(t *testing.T) {
+	plainJPEG := encodeJPEG(t, grid(2, 3))
+	plainPNG := encodePNG(t, grid(2, 3))
+	jfif := segment(0xE0, []byte("JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00"))
+	icc := segment(0xE2, []byte("ICC_PROFILE\x00\x01\x01"))
+	xmp := segment(0xE1, []byte("http://ns.adobe.com/xap/1.0/\x00<x:xmpmeta/>"))
+	iptc := segment(0xED, []byte("Photoshop 3.0\x00"))
+	comment := segment(0xFE, []byte("taken at home"))
+	truncated := withSegments(plainJPEG, segment(0xE0, []byte("JFIF\x00")))
+
+	tests := []struct {
+		name        string
+		content     []byte
+		contentType string
+		want        []byte
+		ok          bool
+	}{
+		{name: "plain jpeg", content: plainJPEG, contentType: "image/jpeg", want: plainJPEG, ok: true},
+		{name: "upright exif", content: withSegments(plainJPEG, jfif, app1(tiff(binary.LittleEndian, 1)), icc), contentType: "image/jpeg", want: withSegments(plainJPEG, jfif, icc), ok: true},
+		{name: "turned exif", content: withSegments(plainJPEG, jfif, app1(tiff(binary.LittleEndian, 6)), xmp), contentType: "image/jpeg", want: withSegments(plainJPEG, jfif, orientationSegment(6)), ok: true},
+		{name: "xmp, iptc and comment", content: withSegments(plainJPEG, xmp, iptc, comment, icc), contentType: "image/jpeg", want: withSegments(plainJPEG, icc), ok: true},
+		{name: "truncated jpeg", content: truncated[:len(jfif)], contentType: "image/jpeg", ok: false},
+		{name: "jpeg without a start of image", content: plainJPEG[2:], contentType: "image/jpeg", ok: false},
+		{name: "plain png", content: plainPNG, contentType: "image/png", want: plainPNG, ok: true},
+		{name: "png with metadata", content: withChunks(plainPNG, chunk("eXIf", tiff(binary.BigEndian, 6)), chunk("tEXt", []byte("Author\x00someone")), chunk("tIME", make([]byte, 7))), contentType: "image/png", want: plainPNG, ok: true},
+		{name: "png with a gamma", content: withChunks(plainPNG, chunk("gAMA", []byte{0, 0, 0xB1, 0x8F})), contentType: "image/png", want: withChunks(plainPNG, chunk("gAMA", []byte{0, 0, 0xB1, 0x8F})), ok: true},
+		{name: "truncated png", content: plainPNG[:len(plainPNG)-6], contentType: "image/png", ok: false},
+		{name: "png without a signature", content: plainPNG[8:], contentType: "image/png", ok: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := stripMetadata(tt.content, tt.contentType)
+		if ok != tt.ok {
+			t.Errorf("%s: got ok %v, want %v", tt.name, ok, tt.ok)
+			continue
+		}
+		if ok && !bytes.Equal(got, tt.want) {
+			t.Errorf("%s: got % x, want % x", tt.name, got, tt.want)
+		}
+	}
+
+	for orientation := 2; orientation <= 8; orientation++ {
+		if got := jpegOrientation(withSegments(plainJPEG, orientationSegment(orientation))); got != orientation {
+			t.Errorf("orientation segment: got orientation %d, want %d", got, orientation)
+		}
+	}
+}
+
+func TestProcess(t *testing.T) {
+	src := grid(2, 3)
+	upright := encodeJPEG(t, src)
+	withAlpha := image.NewNRGBA(image.Rect(0, 0, 2, 3))
+	withAlpha.Set(1, 1, color.NRGBA{R: 10, A: 128})
+	transparent := encodePNG(t, withAlpha)
+
+	tests := []struct {
+		name        string
+		content     []byte
+		maxSize     int64
+		err         error
+		contentType string
+		extension   string
+		// original is the content kept for the original rendition
+		original []byte
+		// width and height are the size of the thumbnail, turned upright
+		width  int
+		height int
+	}{
+		{name: "png", content: encodePNG(t, src), maxSize: 1 << 20, contentType: "image/png", extension: ".png", original: encodePNG(t, src), width: 2, height: 3},
+		{name: "transparent png", content: transparent, maxSize: 1 << 20, contentType: "image/png", extension: ".png", original: transparent, width: 2, height: 3},
+		{name: "jpeg", content: upright, maxSize: 1 << 20, contentType: "image/jpeg", extension: ".jpg", original: upright, width: 2, height: 3},
+		{name: "jpeg turned upright", content: withSegments(upright, app1(tiff(binary.BigEndian, 6))), maxSize: 1 << 20, contentType: "image/jpeg", extension: ".jpg", original: withSegments(upright, orientationSegment(6)), width: 3, height: 2},
+		{name: "jpeg with a malicious exif", content: withSegments(upright, app1([]byte("MM\x00\x2a\xff\xff\xff\xff"))), maxSize: 1 << 20, contentType: "image/jpeg", extension: ".jpg", original: upright, width: 2, height: 3},
+		{name: "over the size limit", content: encodePNG(t, src), maxSize: 10, err: ErrTooLarge},
+		{name: "over the pixel limit", content: hugePNG(t, 10_000, maxPixels/10_000+1), maxSize: 1 << 20, err: ErrTooLarge},
+		{name: "text", content: []byte("not an image at all"), maxSize: 1 << 20, err: ErrUnsupportedType},
+		{name: "gif", content: []byte("GIF89a\x01\x00\x01\x00\x00\x00\x00;"), maxSize: 1 << 20, err: ErrUnsupportedType},
+		{name: "truncated png", content: encodePNG(t, src)[:40], maxSize: 1 << 20, err: ErrUnsupportedType},
+	}
+
+	for _, tt := range tests {
+		renditions, err := Process(tt.content, tt.maxSize)
+		if tt.err != nil {
+			if !errors.Is(err, tt.err) {
+				t.Errorf("%s: got error %v, want %v", tt.name, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+			continue
+		}
+		if len(renditions) != len(renditionSizes)+1 {
+			t.Errorf("%s: got %d renditions, want %d", tt.name, len(renditions), len(renditionSizes)+1)
+			continue
+		}
+		for _, rendition := range renditions {
+			if rendition.ContentType != tt.contentType || !strings.HasSuffix(rendition.ObjectName, "_"+rendition.Name+tt.extension) {
+				t.Errorf("%s: rendition %s is %s named %s", tt.name, rendition.Name, rendition.ContentType, rendition.ObjectName)
+			}
+		}
+		if renditions[0].Name != RenditionOriginal || !bytes.Equal(renditions[0].Content, tt.original) {
+			t.Errorf("%s: the original rendition %s does not keep the original bytes", tt.name, renditions[0].Name)
+		}
+		config, _, err := image.DecodeConfig(bytes.NewReader(renditions[1].Content))
+		if err != nil {
+			t.Errorf("%s: decode the thumbnail rendition: %v", tt.name, err)
+			continue
+		}
+		if config.Width != tt.width || config.Height != tt.height {
+			t.Errorf("%s: got a %dx%d thumbnail, want %dx%d", tt.name, config.Width, config.Height, tt.width, tt.height)
+		}
+	}
+}
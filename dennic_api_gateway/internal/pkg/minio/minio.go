@@ -10,7 +10,7 @@ import (
 	"github.com/minio/minio-go/v7/pkg/credentials"
 )
 
-func UploadToMinio(cfg *config.Config, objectName string, content []byte, bucketName string) (string, error) {
+func UploadToMinio(cfg *config.Config, objectName string, content []byte, bucketName, contentType string) (string, error) {
 	minioClient, err := minio.New(cfg.MinioService.Endpoint, &minio.Options{
 		Creds:  credentials.NewStaticV4(cfg.MinioService.AccessKey, cfg.MinioService.SecretKey, ""),
 		Secure: false,
@@ -33,7 +33,7 @@ func UploadToMinio(cfg *config.Config, objectName string, content []byte, bucket
 		fmt.Println("Bucket already exists.")
 	}
 
-	opts := minio.PutObjectOptions{ContentType: "png/jpeg/zip/pdf/text/dock/csv/rar/xml", UserMetadata: map[string]string{"x-amz-acl": "public-read"}}
+	opts := minio.PutObjectOptions{ContentType: contentType, UserMetadata: map[string]string{"x-amz-acl": "public-read"}}
 
 	_, err = minioClient.PutObject(context.Background(), bucketName, objectName, bytes.NewReader(content), int64(len(content)), opts)
 
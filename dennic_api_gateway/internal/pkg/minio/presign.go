@@ -0,0 +1,149 @@
+package minio
+
+import (
+	"bytes"
+	"context"
+	"dennic_admin_api_gateway/internal/pkg/config"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// presignRegion is set on the presigning client, so it signs without asking minio for the bucket location
+const presignRegion = "us-east-1"
+
+var ErrObjectNotFound = errors.New("object not found")
+
+// ObjectInfo is what minio knows of a stored object
+type ObjectInfo struct {
+	ContentType string
+	Size        int64
+}
+
+// PresignedUpload is a presigned PUT of one object, the request must carry Headers as they are
+type PresignedUpload struct {
+	URL       string
+	Headers   map[string]string
+	ExpiresAt time.Time
+}
+
+func newClient(cfg *config.Config) (*minio.Client, error) {
+	return minio.New(cfg.MinioService.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.MinioService.AccessKey, cfg.MinioService.SecretKey, ""),
+		Secure: false,
+	})
+}
+
+// newPresignClient signs for the public address of minio, the clients upload and download there directly
+func newPresignClient(cfg *config.Config) (*minio.Client, error) {
+	endpoint, secure := cfg.MinioService.ImageURL, false
+	if publicURL, err := url.Parse(cfg.MinioService.ImageURL); err == nil && publicURL.Host != "" {
+		endpoint, secure = publicURL.Host, publicURL.Scheme == "https"
+	}
+	return minio.New(strings.TrimSuffix(endpoint, "/"), &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.MinioService.AccessKey, cfg.MinioService.SecretKey, ""),
+		Secure: secure,
+		Region: presignRegion,
+	})
+}
+
+// ensureBucket creates the bucket when it is missing, a new bucket has no policy and is private
+func ensureBucket(ctx context.Context, client *minio.Client, bucketName string) error {
+	found, err := client.BucketExists(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	if found {
+		return nil
+	}
+	return client.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{Region: presignRegion})
+}
+
+// PresignUpload presigns the PUT of the object, the content type and the size are signed,
+// so minio rejects an upload of another type or size
+func PresignUpload(ctx context.Context, cfg *config.Config, bucketName, objectName, contentType string, size int64) (*PresignedUpload, error) {
+	client, err := newClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err = ensureBucket(ctx, client, bucketName); err != nil {
+		return nil, err
+	}
+
+	presignClient, err := newPresignClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	headers := http.Header{}
+	headers.Set("Content-Type", contentType)
+	headers.Set("Content-Length", strconv.FormatInt(size, 10))
+	expiresAt := time.Now().Add(cfg.MinioService.PresignExpiry)
+	uploadURL, err := presignClient.PresignHeader(ctx, http.MethodPut, bucketName, objectName, cfg.MinioService.PresignExpiry, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PresignedUpload{
+		URL: uploadURL.String(),
+		Headers: map[string]string{
+			"Content-Type":   contentType,
+			"Content-Length": strconv.FormatInt(size, 10),
+		},
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// PutObject stores the content in the bucket, a bucket created here is private
+func PutObject(ctx context.Context, cfg *config.Config, bucketName, objectName string, content []byte, contentType string) error {
+	client, err := newClient(cfg)
+	if err != nil {
+		return err
+	}
+	if err = ensureBucket(ctx, client, bucketName); err != nil {
+		return err
+	}
+	_, err = client.PutObject(ctx, bucketName, objectName, bytes.NewReader(content), int64(len(content)), minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+// StatObject returns the content type and the size of the object, ErrObjectNotFound when it was not uploaded
+func StatObject(ctx context.Context, cfg *config.Config, bucketName, objectName string) (*ObjectInfo, error) {
+	client, err := newClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	info, err := client.StatObject(ctx, bucketName, objectName, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).StatusCode == http.StatusNotFound {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+	return &ObjectInfo{
+		ContentType: info.ContentType,
+		Size:        info.Size,
+	}, nil
+}
+
+// PresignDownload presigns a GET of the existing object valid for the expiry
+func PresignDownload(ctx context.Context, cfg *config.Config, bucketName, objectName string, expiry time.Duration) (string, error) {
+	if _, err := StatObject(ctx, cfg, bucketName, objectName); err != nil {
+		return "", err
+	}
+
+	presignClient, err := newPresignClient(cfg)
+	if err != nil {
+		return "", err
+	}
+	downloadURL, err := presignClient.PresignedGetObject(ctx, bucketName, objectName, expiry, nil)
+	if err != nil {
+		return "", err
+	}
+	return downloadURL.String(), nil
+}
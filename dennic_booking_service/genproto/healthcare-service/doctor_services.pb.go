@@ -28,17 +28,19 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type DoctorServices struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	DoctorServiceOrder   int32    `protobuf:"varint,2,opt,name=doctor_service_order,json=doctorServiceOrder,proto3" json:"doctor_service_order"`
-	DoctorId             string   `protobuf:"bytes,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
-	SpecializationId     string   `protobuf:"bytes,4,opt,name=specialization_id,json=specializationId,proto3" json:"specialization_id"`
-	OnlinePrice          float32  `protobuf:"fixed32,5,opt,name=online_price,json=onlinePrice,proto3" json:"online_price"`
-	OfflinePrice         float32  `protobuf:"fixed32,6,opt,name=offline_price,json=offlinePrice,proto3" json:"offline_price"`
-	Name                 string   `protobuf:"bytes,7,opt,name=name,proto3" json:"name"`
-	Duration             string   `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration"`
-	CreatedAt            string   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	DeletedAt            string   `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	Id                 string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	DoctorServiceOrder int32   `protobuf:"varint,2,opt,name=doctor_service_order,json=doctorServiceOrder,proto3" json:"doctor_service_order"`
+	DoctorId           string  `protobuf:"bytes,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	SpecializationId   string  `protobuf:"bytes,4,opt,name=specialization_id,json=specializationId,proto3" json:"specialization_id"`
+	OnlinePrice        float32 `protobuf:"fixed32,5,opt,name=online_price,json=onlinePrice,proto3" json:"online_price"`
+	OfflinePrice       float32 `protobuf:"fixed32,6,opt,name=offline_price,json=offlinePrice,proto3" json:"offline_price"`
+	Name               string  `protobuf:"bytes,7,opt,name=name,proto3" json:"name"`
+	Duration           string  `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration"`
+	CreatedAt          string  `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt          string  `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt          string  `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	// the kind of room or equipment reserved for every appointment of the service, empty when none is needed
+	RequiredResourceType string   `protobuf:"bytes,12,opt,name=required_resource_type,json=requiredResourceType,proto3" json:"required_resource_type"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -154,6 +156,13 @@ func (m *DoctorServices) GetDeletedAt() string {
 	return ""
 }
 
+func (m *DoctorServices) GetRequiredResourceType() string {
+	if m != nil {
+		return m.RequiredResourceType
+	}
+	return ""
+}
+
 type ListDoctorServices struct {
 	DoctorServices       []*DoctorServices `protobuf:"bytes,1,rep,name=doctorServices,proto3" json:"doctorServices"`
 	Count                int32             `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
@@ -406,12 +415,60 @@ func (m *Status) GetStatus() bool {
 	return false
 }
 
+type RestoreDoctorServiceReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreDoctorServiceReq) Reset()         { *m = RestoreDoctorServiceReq{} }
+func (m *RestoreDoctorServiceReq) String() string { return proto.CompactTextString(m) }
+func (*RestoreDoctorServiceReq) ProtoMessage()    {}
+func (*RestoreDoctorServiceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05a1dacb2d8172e2, []int{5}
+}
+func (m *RestoreDoctorServiceReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreDoctorServiceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreDoctorServiceReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreDoctorServiceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreDoctorServiceReq.Merge(m, src)
+}
+func (m *RestoreDoctorServiceReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreDoctorServiceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreDoctorServiceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreDoctorServiceReq proto.InternalMessageInfo
+
+func (m *RestoreDoctorServiceReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DoctorServices)(nil), "healthcare.DoctorServices")
 	proto.RegisterType((*ListDoctorServices)(nil), "healthcare.ListDoctorServices")
 	proto.RegisterType((*GetReqStr)(nil), "healthcare.GetReqStr")
 	proto.RegisterType((*GetAllDoctorServiceS)(nil), "healthcare.GetAllDoctorServiceS")
 	proto.RegisterType((*Status)(nil), "healthcare.Status")
+	proto.RegisterType((*RestoreDoctorServiceReq)(nil), "healthcare.RestoreDoctorServiceReq")
 }
 
 func init() {
@@ -419,42 +476,45 @@ func init() {
 }
 
 var fileDescriptor_05a1dacb2d8172e2 = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x51, 0x8e, 0xd3, 0x30,
-	0x10, 0x25, 0xcd, 0xb6, 0x9b, 0x4c, 0x97, 0x6a, 0x31, 0x05, 0x85, 0x22, 0xaa, 0x50, 0x7e, 0x2a,
-	0x21, 0x0a, 0x5a, 0x2e, 0x40, 0x4b, 0xa5, 0x55, 0x25, 0xb4, 0x20, 0x17, 0x24, 0xfe, 0x22, 0x6f,
-	0xec, 0xb2, 0x96, 0xb2, 0x49, 0x89, 0xdd, 0x4a, 0xe5, 0x22, 0x70, 0x00, 0x0e, 0xc3, 0x27, 0x47,
-	0x40, 0xe5, 0x04, 0xdc, 0x00, 0x65, 0xec, 0xd2, 0xa4, 0x94, 0x7e, 0xf1, 0xe7, 0x79, 0xef, 0xcd,
-	0x78, 0x3c, 0x6f, 0x12, 0xe8, 0x5f, 0x09, 0x96, 0xe8, 0xab, 0x98, 0xe5, 0xe2, 0x89, 0x12, 0xf9,
-	0x52, 0xc6, 0xe2, 0x29, 0xcf, 0x62, 0x9d, 0xe5, 0x91, 0x0d, 0xd5, 0x60, 0x9e, 0x67, 0x3a, 0x23,
-	0xb0, 0x55, 0xf6, 0x7e, 0xd5, 0xa0, 0x35, 0x46, 0xd5, 0xd4, 0x8a, 0x48, 0x0b, 0x6a, 0x92, 0x07,
-	0x4e, 0xe8, 0xf4, 0x7d, 0x5a, 0x93, 0x9c, 0x3c, 0x83, 0x76, 0xb5, 0x4e, 0x94, 0xe5, 0x5c, 0xe4,
-	0x41, 0x2d, 0x74, 0xfa, 0x75, 0x4a, 0x78, 0x39, 0xfb, 0x75, 0xc1, 0x90, 0xfb, 0xe0, 0xdb, 0x0c,
-	0xc9, 0x03, 0x17, 0x0b, 0x79, 0x06, 0x98, 0x70, 0xf2, 0x18, 0x6e, 0xa9, 0xb9, 0x88, 0x25, 0x4b,
-	0xe4, 0x27, 0xa6, 0x65, 0x96, 0x16, 0xa2, 0x23, 0x14, 0x9d, 0x56, 0x89, 0x09, 0x27, 0x0f, 0xe1,
-	0x24, 0x4b, 0x13, 0x99, 0x8a, 0x68, 0x9e, 0xcb, 0x58, 0x04, 0xf5, 0xd0, 0xe9, 0xd7, 0x68, 0xd3,
-	0x60, 0x6f, 0x0a, 0x88, 0x3c, 0x82, 0x9b, 0xd9, 0x6c, 0x56, 0xd2, 0x34, 0x50, 0x73, 0x62, 0x41,
-	0x23, 0x22, 0x70, 0x94, 0xb2, 0x6b, 0x11, 0x1c, 0xe3, 0x3d, 0x78, 0x26, 0x1d, 0xf0, 0xf8, 0x22,
-	0xc7, 0x9b, 0x02, 0xcf, 0x36, 0x69, 0x63, 0xf2, 0x00, 0x20, 0xce, 0x05, 0xd3, 0x82, 0x47, 0x4c,
-	0x07, 0x3e, 0xb2, 0xbe, 0x45, 0x86, 0xba, 0xa0, 0x17, 0x73, 0xbe, 0xa1, 0xc1, 0xd0, 0x16, 0x31,
-	0x34, 0x17, 0x89, 0xb0, 0x74, 0xd3, 0xd0, 0x16, 0x19, 0xea, 0x5e, 0x0a, 0xe4, 0x95, 0x54, 0x7a,
-	0x67, 0xec, 0x23, 0x68, 0x55, 0x46, 0xa9, 0x02, 0x27, 0x74, 0xfb, 0xcd, 0xb3, 0xce, 0x60, 0x6b,
-	0xd7, 0xa0, 0x9a, 0x43, 0x77, 0x32, 0x48, 0x1b, 0xea, 0x71, 0xb6, 0x48, 0xb5, 0xf5, 0xc6, 0x04,
-	0xbd, 0xb7, 0xe0, 0x9f, 0x0b, 0x4d, 0xc5, 0xc7, 0xa9, 0xce, 0x0b, 0xc9, 0x4c, 0x8a, 0x64, 0x63,
-	0xb0, 0x09, 0x0a, 0x74, 0xc9, 0x92, 0x85, 0xc0, 0x44, 0x9f, 0x9a, 0xa0, 0xf0, 0x51, 0xaa, 0x88,
-	0xc5, 0x5a, 0x2e, 0x05, 0xfa, 0xe8, 0x51, 0x4f, 0xaa, 0x21, 0xc6, 0xbd, 0xaf, 0x0e, 0xb4, 0xcf,
-	0x85, 0x1e, 0x26, 0x49, 0xa5, 0xa9, 0x69, 0x31, 0xeb, 0x39, 0xfb, 0x20, 0xf0, 0x02, 0x97, 0xe2,
-	0xb9, 0xa8, 0x9f, 0xc8, 0x6b, 0x69, 0x1a, 0x73, 0xa9, 0x09, 0xb6, 0xbd, 0xb8, 0x7b, 0x7b, 0x39,
-	0x2a, 0xf7, 0x72, 0x0f, 0x3c, 0x5c, 0xbb, 0xe8, 0x72, 0x85, 0x5b, 0xe0, 0xd3, 0x63, 0x8c, 0x47,
-	0xab, 0x6a, 0x9b, 0x8d, 0x9d, 0x36, 0x43, 0x68, 0x4c, 0x35, 0xd3, 0x0b, 0x45, 0xee, 0x42, 0x43,
-	0xe1, 0x09, 0x3b, 0xf3, 0xa8, 0x8d, 0xce, 0x3e, 0xbb, 0x9b, 0x4f, 0x40, 0xd9, 0x37, 0x90, 0x0b,
-	0x68, 0xbf, 0x44, 0xb3, 0x77, 0x3c, 0x3a, 0xe0, 0x45, 0xe7, 0x00, 0x47, 0x26, 0x38, 0xaa, 0x0a,
-	0x38, 0x5a, 0x4d, 0xc6, 0xe4, 0x4e, 0x39, 0xe7, 0x8f, 0x47, 0x07, 0x4b, 0xbd, 0xdf, 0x3b, 0x75,
-	0x45, 0xc2, 0x9d, 0x52, 0x7f, 0xf9, 0xd2, 0xe9, 0x96, 0x15, 0x7b, 0x16, 0xf0, 0x02, 0xda, 0xef,
-	0x70, 0x85, 0xff, 0xd3, 0xa3, 0x5f, 0xc0, 0xed, 0x31, 0xee, 0x7c, 0x05, 0xff, 0xd7, 0x9b, 0x49,
-	0x19, 0x36, 0x8e, 0x8d, 0x4e, 0xbf, 0xad, 0xbb, 0xce, 0xf7, 0x75, 0xd7, 0xf9, 0xb1, 0xee, 0x3a,
-	0x5f, 0x7e, 0x76, 0x6f, 0x5c, 0x36, 0xf0, 0x0f, 0xf6, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x3b, 0xf4, 0x47, 0x6e, 0xed, 0x04, 0x00, 0x00,
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x51, 0x6e, 0xd3, 0x40,
+	0x10, 0xc5, 0x49, 0x9b, 0xda, 0xd3, 0x52, 0x95, 0xc5, 0x14, 0x13, 0x44, 0x14, 0xd2, 0x9f, 0x20,
+	0x44, 0x41, 0x85, 0x03, 0x90, 0x50, 0xa9, 0x8a, 0x84, 0x5a, 0xb4, 0x29, 0x12, 0x7f, 0x96, 0xeb,
+	0x9d, 0xd2, 0x95, 0xdc, 0xd8, 0xdd, 0x5d, 0x57, 0x0a, 0x27, 0xe1, 0x00, 0x48, 0x5c, 0x85, 0x4f,
+	0x8e, 0x80, 0xca, 0x0f, 0xc7, 0x40, 0x1e, 0x6f, 0x5b, 0xdb, 0xa4, 0xfd, 0xe2, 0xcf, 0xf3, 0xde,
+	0x9b, 0xd9, 0xb7, 0x79, 0xb3, 0x81, 0xe1, 0x09, 0x46, 0x89, 0x39, 0x89, 0x23, 0x85, 0x2f, 0x34,
+	0xaa, 0x73, 0x19, 0xe3, 0x4b, 0x91, 0xc6, 0x26, 0x55, 0xa1, 0x2d, 0xf5, 0x76, 0xa6, 0x52, 0x93,
+	0x32, 0xb8, 0x56, 0x0e, 0xbe, 0xb7, 0x61, 0x7d, 0x97, 0x54, 0x53, 0x2b, 0x62, 0xeb, 0xd0, 0x92,
+	0x22, 0x70, 0xfa, 0xce, 0xd0, 0xe3, 0x2d, 0x29, 0xd8, 0x2b, 0xf0, 0xeb, 0x73, 0xc2, 0x54, 0x09,
+	0x54, 0x41, 0xab, 0xef, 0x0c, 0x97, 0x39, 0x13, 0xd5, 0xee, 0x83, 0x82, 0x61, 0x8f, 0xc1, 0xb3,
+	0x1d, 0x52, 0x04, 0x6d, 0x1a, 0xe4, 0x96, 0xc0, 0x44, 0xb0, 0xe7, 0x70, 0x4f, 0x67, 0x18, 0xcb,
+	0x28, 0x91, 0x5f, 0x22, 0x23, 0xd3, 0x59, 0x21, 0x5a, 0x22, 0xd1, 0x46, 0x9d, 0x98, 0x08, 0xf6,
+	0x14, 0xd6, 0xd2, 0x59, 0x22, 0x67, 0x18, 0x66, 0x4a, 0xc6, 0x18, 0x2c, 0xf7, 0x9d, 0x61, 0x8b,
+	0xaf, 0x96, 0xd8, 0x87, 0x02, 0x62, 0x5b, 0x70, 0x37, 0x3d, 0x3e, 0xae, 0x68, 0x3a, 0xa4, 0x59,
+	0xb3, 0x60, 0x29, 0x62, 0xb0, 0x34, 0x8b, 0x4e, 0x31, 0x58, 0xa1, 0x73, 0xe8, 0x9b, 0x75, 0xc1,
+	0x15, 0xb9, 0xa2, 0x93, 0x02, 0xd7, 0x9a, 0xb4, 0x35, 0x7b, 0x02, 0x10, 0x2b, 0x8c, 0x0c, 0x8a,
+	0x30, 0x32, 0x81, 0x47, 0xac, 0x67, 0x91, 0x91, 0x29, 0xe8, 0x3c, 0x13, 0x97, 0x34, 0x94, 0xb4,
+	0x45, 0x4a, 0x5a, 0x60, 0x82, 0x96, 0x5e, 0x2d, 0x69, 0x8b, 0x8c, 0x0c, 0x7b, 0x03, 0x9b, 0x0a,
+	0xcf, 0x72, 0xa9, 0x50, 0x84, 0x0a, 0x75, 0x9a, 0xab, 0x18, 0x43, 0x33, 0xcf, 0x30, 0x58, 0x23,
+	0xa9, 0x7f, 0xc9, 0x72, 0x4b, 0x1e, 0xce, 0x33, 0x1c, 0xcc, 0x80, 0xbd, 0x97, 0xda, 0x34, 0xc2,
+	0x1a, 0xc3, 0x7a, 0x2d, 0x00, 0x1d, 0x38, 0xfd, 0xf6, 0x70, 0x75, 0xa7, 0xbb, 0x7d, 0x1d, 0xf2,
+	0x76, 0xbd, 0x87, 0x37, 0x3a, 0x98, 0x0f, 0xcb, 0x71, 0x9a, 0xcf, 0x8c, 0x4d, 0xb4, 0x2c, 0x06,
+	0x87, 0xe0, 0xed, 0xa1, 0xe1, 0x78, 0x36, 0x35, 0xaa, 0x90, 0x1c, 0x4b, 0x4c, 0x2e, 0xd7, 0xa2,
+	0x2c, 0x0a, 0xf4, 0x3c, 0x4a, 0x72, 0xa4, 0x46, 0x8f, 0x97, 0x45, 0x91, 0xbe, 0xd4, 0x61, 0x14,
+	0x1b, 0x79, 0x8e, 0x94, 0xbe, 0xcb, 0x5d, 0xa9, 0x47, 0x54, 0x0f, 0xbe, 0x39, 0xe0, 0xef, 0xa1,
+	0x19, 0x25, 0x49, 0xcd, 0xd4, 0xb4, 0x48, 0x28, 0x8b, 0x3e, 0x23, 0x1d, 0xd0, 0xe6, 0xf4, 0x5d,
+	0xcc, 0x4f, 0xe4, 0xa9, 0x2c, 0x8d, 0xb5, 0x79, 0x59, 0x5c, 0x7b, 0x69, 0x2f, 0xf4, 0xb2, 0x54,
+	0xf5, 0xf2, 0x08, 0x5c, 0x5a, 0xd6, 0xf0, 0x68, 0x4e, 0xbb, 0xe3, 0xf1, 0x15, 0xaa, 0xc7, 0xf3,
+	0xba, 0xcd, 0x4e, 0xc3, 0x66, 0x1f, 0x3a, 0x53, 0x13, 0x99, 0x5c, 0xb3, 0x4d, 0xe8, 0x68, 0xfa,
+	0x22, 0x67, 0x2e, 0xb7, 0xd5, 0xe0, 0x19, 0x3c, 0xe4, 0xa8, 0x4d, 0xaa, 0xb0, 0x76, 0x11, 0x8e,
+	0x67, 0xcd, 0x07, 0xb4, 0xf3, 0xe7, 0xea, 0x8d, 0x69, 0xab, 0x62, 0xfb, 0xe0, 0xbf, 0xa3, 0x6d,
+	0x6a, 0xc4, 0x79, 0x4b, 0x6c, 0xdd, 0x5b, 0x38, 0x36, 0xa1, 0x5f, 0xb5, 0x06, 0x8e, 0xe7, 0x93,
+	0x5d, 0xf6, 0xa0, 0xda, 0x73, 0x15, 0xe7, 0xad, 0xa3, 0x3e, 0x2d, 0x0c, 0x48, 0xb3, 0x7e, 0x63,
+	0xd4, 0x3f, 0x11, 0x76, 0x7b, 0x55, 0xc5, 0x82, 0x5d, 0xdd, 0x07, 0xff, 0x23, 0xbd, 0x91, 0xff,
+	0x74, 0xe9, 0xb7, 0x70, 0x7f, 0x97, 0x1e, 0x55, 0x0d, 0xbf, 0xe9, 0xce, 0xac, 0x0a, 0xdb, 0x70,
+	0x0f, 0xc0, 0x5f, 0x14, 0x22, 0xdb, 0xaa, 0x6a, 0x6f, 0x88, 0x79, 0xd1, 0xc0, 0xf1, 0xc6, 0x8f,
+	0x8b, 0x9e, 0xf3, 0xf3, 0xa2, 0xe7, 0xfc, 0xba, 0xe8, 0x39, 0x5f, 0x7f, 0xf7, 0xee, 0x1c, 0x75,
+	0xe8, 0x3f, 0xf7, 0xf5, 0xdf, 0x01, 0x00, 0x85, 0x1e, 0xe8, 0xe2, 0x9f, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -474,6 +534,7 @@ type DoctorsServiceClient interface {
 	GetAllDoctorServices(ctx context.Context, in *GetAllDoctorServiceS, opts ...grpc.CallOption) (*ListDoctorServices, error)
 	UpdateDoctorServices(ctx context.Context, in *DoctorServices, opts ...grpc.CallOption) (*DoctorServices, error)
 	DeleteDoctorService(ctx context.Context, in *GetReqStr, opts ...grpc.CallOption) (*Status, error)
+	RestoreDoctorService(ctx context.Context, in *RestoreDoctorServiceReq, opts ...grpc.CallOption) (*Status, error)
 }
 
 type doctorsServiceClient struct {
@@ -529,6 +590,15 @@ func (c *doctorsServiceClient) DeleteDoctorService(ctx context.Context, in *GetR
 	return out, nil
 }
 
+func (c *doctorsServiceClient) RestoreDoctorService(ctx context.Context, in *RestoreDoctorServiceReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorsService/RestoreDoctorService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DoctorsServiceServer is the server API for DoctorsService service.
 type DoctorsServiceServer interface {
 	CreateDoctorServices(context.Context, *DoctorServices) (*DoctorServices, error)
@@ -536,6 +606,7 @@ type DoctorsServiceServer interface {
 	GetAllDoctorServices(context.Context, *GetAllDoctorServiceS) (*ListDoctorServices, error)
 	UpdateDoctorServices(context.Context, *DoctorServices) (*DoctorServices, error)
 	DeleteDoctorService(context.Context, *GetReqStr) (*Status, error)
+	RestoreDoctorService(context.Context, *RestoreDoctorServiceReq) (*Status, error)
 }
 
 // UnimplementedDoctorsServiceServer can be embedded to have forward compatible implementations.
@@ -557,6 +628,9 @@ func (*UnimplementedDoctorsServiceServer) UpdateDoctorServices(ctx context.Conte
 func (*UnimplementedDoctorsServiceServer) DeleteDoctorService(ctx context.Context, req *GetReqStr) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDoctorService not implemented")
 }
+func (*UnimplementedDoctorsServiceServer) RestoreDoctorService(ctx context.Context, req *RestoreDoctorServiceReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDoctorService not implemented")
+}
 
 func RegisterDoctorsServiceServer(s *grpc.Server, srv DoctorsServiceServer) {
 	s.RegisterService(&_DoctorsService_serviceDesc, srv)
@@ -652,6 +726,24 @@ func _DoctorsService_DeleteDoctorService_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DoctorsService_RestoreDoctorService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDoctorServiceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorsServiceServer).RestoreDoctorService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorsService/RestoreDoctorService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorsServiceServer).RestoreDoctorService(ctx, req.(*RestoreDoctorServiceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DoctorsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "healthcare.DoctorsService",
 	HandlerType: (*DoctorsServiceServer)(nil),
@@ -676,6 +768,10 @@ var _DoctorsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDoctorService",
 			Handler:    _DoctorsService_DeleteDoctorService_Handler,
 		},
+		{
+			MethodName: "RestoreDoctorService",
+			Handler:    _DoctorsService_RestoreDoctorService_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "healthcare-service/doctor_services.proto",
@@ -705,6 +801,13 @@ func (m *DoctorServices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RequiredResourceType) > 0 {
+		i -= len(m.RequiredResourceType)
+		copy(dAtA[i:], m.RequiredResourceType)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.RequiredResourceType)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.DeletedAt) > 0 {
 		i -= len(m.DeletedAt)
 		copy(dAtA[i:], m.DeletedAt)
@@ -983,6 +1086,40 @@ func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RestoreDoctorServiceReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreDoctorServiceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreDoctorServiceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDoctorServices(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDoctorServices(v)
 	base := offset
@@ -1041,6 +1178,10 @@ func (m *DoctorServices) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDoctorServices(uint64(l))
 	}
+	l = len(m.RequiredResourceType)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1139,6 +1280,22 @@ func (m *Status) Size() (n int) {
 	return n
 }
 
+func (m *RestoreDoctorServiceReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovDoctorServices(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1471,6 +1628,38 @@ func (m *DoctorServices) Unmarshal(dAtA []byte) error {
 			}
 			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredResourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredResourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctorServices(dAtA[iNdEx:])
@@ -2008,6 +2197,89 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RestoreDoctorServiceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreDoctorServiceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreDoctorServiceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDoctorServices(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -294,6 +294,8 @@ func (m *GetAllDoctorWorkingHoursReq) GetIsActive() bool {
 	return false
 }
 
+// Doctor_working_hours is a shift or a break (kind) of a doctor on a weekday, start_time and finish_time are
+// times of day "15:04:05", the empty effective_from/effective_to "2006-01-02" leave the range open on that side
 type DoctorWorkingHours struct {
 	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
 	DoctorId             string   `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
@@ -303,6 +305,9 @@ type DoctorWorkingHours struct {
 	CreatedAt            string   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string   `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	DeletedAt            string   `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	Kind                 string   `protobuf:"bytes,9,opt,name=kind,proto3" json:"kind"`
+	EffectiveFrom        string   `protobuf:"bytes,10,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from"`
+	EffectiveTo          string   `protobuf:"bytes,11,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -397,6 +402,27 @@ func (m *DoctorWorkingHours) GetDeletedAt() string {
 	return ""
 }
 
+func (m *DoctorWorkingHours) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *DoctorWorkingHours) GetEffectiveFrom() string {
+	if m != nil {
+		return m.EffectiveFrom
+	}
+	return ""
+}
+
+func (m *DoctorWorkingHours) GetEffectiveTo() string {
+	if m != nil {
+		return m.EffectiveTo
+	}
+	return ""
+}
+
 type ListDoctorWorkingHours struct {
 	Dwh                  []*DoctorWorkingHours `protobuf:"bytes,1,rep,name=dwh,proto3" json:"dwh"`
 	Count                int32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
@@ -466,44 +492,46 @@ func init() {
 }
 
 var fileDescriptor_2f24b76898b6e348 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x5f, 0x6e, 0xda, 0x4e,
-	0x10, 0xfe, 0x19, 0x07, 0x62, 0x0f, 0xfa, 0xb5, 0xd5, 0x2a, 0x8d, 0x4c, 0xa2, 0x50, 0x64, 0x55,
-	0x2d, 0x2f, 0xa1, 0x12, 0x3d, 0x01, 0x14, 0x89, 0x20, 0x55, 0xaa, 0xe4, 0xa4, 0xe2, 0x2d, 0xee,
-	0xe2, 0x1d, 0xf0, 0x0a, 0x83, 0xc9, 0x7a, 0x01, 0xf1, 0xd6, 0x63, 0xf4, 0x0e, 0xbd, 0x48, 0x1f,
-	0x7b, 0x84, 0x8a, 0x1e, 0xa1, 0x17, 0xa8, 0xbc, 0xeb, 0x94, 0x3f, 0x31, 0x4a, 0x5f, 0xfa, 0xe6,
-	0xf9, 0xbe, 0xd9, 0x99, 0xd9, 0xef, 0xdb, 0x31, 0x5c, 0x86, 0x48, 0x23, 0x19, 0x06, 0x54, 0xe0,
-	0x65, 0x82, 0x62, 0xc1, 0x03, 0x7c, 0xc3, 0xe2, 0x40, 0xc6, 0xc2, 0x5f, 0xc6, 0x62, 0xcc, 0xa7,
-	0x23, 0x3f, 0x8c, 0xe7, 0x22, 0x69, 0xcc, 0x44, 0x2c, 0x63, 0x02, 0x9b, 0x74, 0x57, 0x82, 0xdd,
-	0x45, 0xe9, 0xe1, 0x5d, 0x6f, 0x2a, 0xc9, 0x09, 0x14, 0x87, 0x1c, 0x23, 0xe6, 0x18, 0x35, 0xa3,
-	0x6e, 0x7b, 0x3a, 0x48, 0xd1, 0x05, 0x8d, 0xe6, 0xe8, 0x14, 0x34, 0xaa, 0x02, 0x72, 0x0e, 0x36,
-	0x4f, 0x7c, 0x1a, 0x48, 0xbe, 0x40, 0xc7, 0xac, 0x19, 0x75, 0xcb, 0xb3, 0x78, 0xd2, 0x52, 0x31,
-	0xa9, 0x42, 0x99, 0xd1, 0x95, 0x1f, 0x0f, 0xfd, 0x25, 0xe2, 0xd8, 0x39, 0x52, 0x07, 0x6d, 0x46,
-	0x57, 0x1f, 0x86, 0x7d, 0xc4, 0xb1, 0xfb, 0x09, 0xec, 0x0e, 0x46, 0x59, 0xd7, 0x27, 0x50, 0xe0,
-	0xba, 0x65, 0xd1, 0x2b, 0x70, 0xb6, 0x5b, 0xb9, 0xb0, 0x57, 0xf9, 0x15, 0x3c, 0xe5, 0x89, 0x1f,
-	0x52, 0xc1, 0x7c, 0x86, 0x11, 0x4a, 0x64, 0x59, 0xf3, 0xff, 0x79, 0x72, 0x45, 0x05, 0xeb, 0x68,
-	0xd0, 0x6d, 0x82, 0x73, 0x2d, 0xa9, 0x9c, 0x27, 0x1d, 0xa5, 0x43, 0x5f, 0xcb, 0x70, 0x95, 0xaa,
-	0x40, 0x4e, 0xa1, 0x94, 0x28, 0x4e, 0x35, 0xb5, 0xbc, 0x2c, 0x72, 0xbf, 0x1a, 0x70, 0xde, 0x45,
-	0xd9, 0x8a, 0xa2, 0x87, 0x87, 0x3c, 0xbc, 0x23, 0x04, 0x8e, 0x66, 0x74, 0x84, 0xea, 0x94, 0xe9,
-	0xa9, 0xef, 0x54, 0x9c, 0x88, 0x4f, 0xb8, 0x54, 0x83, 0x9a, 0x9e, 0x0e, 0x36, 0x42, 0x9a, 0xb9,
-	0x42, 0x1e, 0x6d, 0x0b, 0x59, 0x01, 0x2b, 0x16, 0x0c, 0x85, 0x3f, 0x58, 0x39, 0x45, 0x45, 0x1c,
-	0xab, 0xb8, 0xbd, 0xda, 0x55, 0xa2, 0xb4, 0xab, 0x84, 0xfb, 0xb9, 0x00, 0x27, 0x9d, 0x1c, 0x93,
-	0xf3, 0xf4, 0xcc, 0x1e, 0x03, 0x67, 0x99, 0x87, 0x96, 0x06, 0x7a, 0x6c, 0xdf, 0x29, 0x73, 0xcf,
-	0x29, 0x72, 0x01, 0x90, 0x48, 0x2a, 0xa4, 0x2f, 0xf9, 0xe4, 0x7e, 0x70, 0x5b, 0x21, 0x37, 0x7c,
-	0x82, 0xe4, 0x05, 0x94, 0x87, 0x7c, 0xca, 0x93, 0x50, 0xf3, 0x7a, 0x7e, 0xd0, 0x90, 0x4a, 0xb8,
-	0x00, 0x08, 0x04, 0x52, 0x89, 0xcc, 0xa7, 0x52, 0xdd, 0xc1, 0xf6, 0xec, 0x0c, 0x69, 0xc9, 0x94,
-	0x9e, 0xcf, 0xd8, 0x3d, 0x7d, 0xac, 0xe9, 0x0c, 0xd1, 0x74, 0xe6, 0x72, 0x4a, 0x5b, 0xd9, 0x70,
-	0x1a, 0x69, 0x49, 0x77, 0x00, 0xa7, 0xef, 0x79, 0x22, 0x73, 0x2c, 0x6e, 0x82, 0xc9, 0x96, 0xa1,
-	0x63, 0xd4, 0xcc, 0x7a, 0xb9, 0x59, 0x6b, 0x6c, 0x1e, 0x7c, 0x23, 0x4f, 0x32, 0x2f, 0x4d, 0x4e,
-	0xed, 0x09, 0xe2, 0xf9, 0x54, 0x5b, 0x59, 0xf4, 0x74, 0xd0, 0xfc, 0x65, 0x42, 0xe5, 0x61, 0x83,
-	0x6b, 0xbd, 0x65, 0xe4, 0x16, 0x9c, 0x77, 0xea, 0x32, 0x39, 0x33, 0x3c, 0xda, 0xf6, 0xec, 0xd1,
-	0x0c, 0x72, 0x03, 0x95, 0x2e, 0xe6, 0x5c, 0xb0, 0xbd, 0xea, 0x31, 0xf2, 0x7c, 0xfb, 0xf8, 0x9f,
-	0x2d, 0xfe, 0x8b, 0xaa, 0x23, 0x70, 0x0e, 0xbd, 0x73, 0xf2, 0x7a, 0xaf, 0xe8, 0xa1, 0x6d, 0x38,
-	0x73, 0xb7, 0x13, 0x0f, 0xd8, 0x70, 0x0b, 0xce, 0x47, 0x65, 0xe6, 0x3f, 0x92, 0xa7, 0x0f, 0x8e,
-	0x5e, 0xf8, 0x9c, 0xfa, 0x07, 0xd4, 0x79, 0xb9, 0x0d, 0x1f, 0xfa, 0x45, 0xb4, 0x9f, 0x7d, 0x5b,
-	0x57, 0x8d, 0xef, 0xeb, 0xaa, 0xf1, 0x63, 0x5d, 0x35, 0xbe, 0xfc, 0xac, 0xfe, 0x37, 0x28, 0xa9,
-	0x7f, 0xe7, 0xdb, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x8a, 0xea, 0xec, 0x6c, 0x05, 0x00,
-	0x00,
+	// 618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x5d, 0x4e, 0xdb, 0x40,
+	0x10, 0xae, 0x63, 0x02, 0xf6, 0xa4, 0xd0, 0x6a, 0x45, 0xd1, 0x02, 0x22, 0x4d, 0xad, 0xfe, 0xf0,
+	0x02, 0x95, 0xd2, 0x13, 0x84, 0x46, 0x05, 0xa4, 0x4a, 0x95, 0x0c, 0x15, 0x6f, 0xb8, 0x8b, 0x77,
+	0x8c, 0x57, 0x71, 0xb2, 0xb0, 0xde, 0x80, 0x72, 0x93, 0xde, 0xa1, 0x17, 0xe9, 0x63, 0x4f, 0x50,
+	0x55, 0xf4, 0x08, 0xbd, 0x40, 0xe5, 0x5d, 0x43, 0x7e, 0x70, 0x44, 0x5f, 0xfa, 0xe6, 0xf9, 0xbe,
+	0xd9, 0x99, 0xf1, 0xf7, 0xed, 0x0e, 0xec, 0xa4, 0xc8, 0x32, 0x9d, 0xc6, 0x4c, 0xe1, 0x4e, 0x8e,
+	0xea, 0x4a, 0xc4, 0xf8, 0x96, 0xcb, 0x58, 0x4b, 0x15, 0x5d, 0x4b, 0xd5, 0x13, 0x83, 0xf3, 0x28,
+	0x95, 0x43, 0x95, 0xef, 0x5e, 0x28, 0xa9, 0x25, 0x81, 0x71, 0x7a, 0xa0, 0xc1, 0xdf, 0x47, 0x1d,
+	0xe2, 0xe5, 0xe1, 0x40, 0x93, 0x55, 0xa8, 0x27, 0x02, 0x33, 0x4e, 0x9d, 0x96, 0xb3, 0xed, 0x87,
+	0x36, 0x28, 0xd0, 0x2b, 0x96, 0x0d, 0x91, 0xd6, 0x2c, 0x6a, 0x02, 0xb2, 0x09, 0xbe, 0xc8, 0x23,
+	0x16, 0x6b, 0x71, 0x85, 0xd4, 0x6d, 0x39, 0xdb, 0x5e, 0xe8, 0x89, 0xbc, 0x63, 0x62, 0xd2, 0x84,
+	0x06, 0x67, 0xa3, 0x48, 0x26, 0xd1, 0x35, 0x62, 0x8f, 0x2e, 0x98, 0x83, 0x3e, 0x67, 0xa3, 0x4f,
+	0xc9, 0x09, 0x62, 0x2f, 0xf8, 0x02, 0x7e, 0x17, 0xb3, 0xb2, 0xeb, 0x0a, 0xd4, 0x84, 0x6d, 0x59,
+	0x0f, 0x6b, 0x82, 0x4f, 0x57, 0xae, 0xcd, 0x54, 0x7e, 0x0d, 0x4f, 0x44, 0x1e, 0xa5, 0x4c, 0xf1,
+	0x88, 0x63, 0x86, 0x1a, 0x79, 0xd9, 0x7c, 0x59, 0xe4, 0x07, 0x4c, 0xf1, 0xae, 0x05, 0x83, 0x36,
+	0xd0, 0x23, 0xcd, 0xf4, 0x30, 0xef, 0x1a, 0x1d, 0x4e, 0xac, 0x0c, 0x07, 0x85, 0x0a, 0x64, 0x0d,
+	0x16, 0x73, 0xc3, 0x99, 0xa6, 0x5e, 0x58, 0x46, 0xc1, 0x37, 0x07, 0x36, 0xf7, 0x51, 0x77, 0xb2,
+	0xec, 0xfe, 0xa1, 0x10, 0x2f, 0x09, 0x81, 0x85, 0x0b, 0x76, 0x8e, 0xe6, 0x94, 0x1b, 0x9a, 0xef,
+	0x42, 0x9c, 0x4c, 0xf4, 0x85, 0x36, 0x83, 0xba, 0xa1, 0x0d, 0xc6, 0x42, 0xba, 0x95, 0x42, 0x2e,
+	0x4c, 0x0a, 0xb9, 0x0e, 0x9e, 0x54, 0x1c, 0x55, 0x74, 0x36, 0xa2, 0x75, 0x43, 0x2c, 0x99, 0x78,
+	0x6f, 0x34, 0xad, 0xc4, 0xe2, 0xb4, 0x12, 0xc1, 0xcf, 0x1a, 0xac, 0x76, 0x2b, 0x4c, 0xae, 0xd2,
+	0xb3, 0xbc, 0x0c, 0x82, 0x97, 0x1e, 0x7a, 0x16, 0x38, 0xe4, 0xb3, 0x4e, 0xb9, 0x33, 0x4e, 0x91,
+	0x2d, 0x80, 0x5c, 0x33, 0xa5, 0x23, 0x2d, 0xfa, 0xb7, 0x83, 0xfb, 0x06, 0x39, 0x16, 0x7d, 0x24,
+	0xcf, 0xa1, 0x91, 0x88, 0x81, 0xc8, 0x53, 0xcb, 0xdb, 0xf9, 0xc1, 0x42, 0x26, 0x61, 0x0b, 0x20,
+	0x56, 0xc8, 0x34, 0xf2, 0x88, 0x69, 0xf3, 0x0f, 0x7e, 0xe8, 0x97, 0x48, 0x47, 0x17, 0xf4, 0xf0,
+	0x82, 0xdf, 0xd2, 0x4b, 0x96, 0x2e, 0x11, 0x4b, 0x97, 0x2e, 0x17, 0xb4, 0x57, 0x0e, 0x67, 0x91,
+	0x8e, 0x2e, 0x0c, 0xe9, 0x89, 0x01, 0xa7, 0xbe, 0x21, 0xcc, 0x37, 0x79, 0x05, 0x2b, 0x98, 0x24,
+	0x68, 0x34, 0x8a, 0x12, 0x25, 0xfb, 0x14, 0x0c, 0xbb, 0x7c, 0x87, 0x7e, 0x50, 0xb2, 0x4f, 0x5e,
+	0xc0, 0xe3, 0x71, 0x9a, 0x96, 0xb4, 0x61, 0x92, 0x1a, 0x77, 0xd8, 0xb1, 0x0c, 0xce, 0x60, 0xed,
+	0xa3, 0xc8, 0x75, 0xc5, 0x05, 0x6a, 0x83, 0xcb, 0xaf, 0x53, 0xea, 0xb4, 0xdc, 0xed, 0x46, 0xbb,
+	0xb5, 0x3b, 0x7e, 0x4e, 0xbb, 0x55, 0x86, 0x84, 0x45, 0x72, 0x61, 0x7e, 0x2c, 0x87, 0x03, 0x7b,
+	0x51, 0xea, 0xa1, 0x0d, 0xda, 0x7f, 0x5c, 0x58, 0xbf, 0xdf, 0xe0, 0xc8, 0xbe, 0x61, 0x72, 0x0a,
+	0xf4, 0xbd, 0x91, 0xaa, 0x62, 0x86, 0x07, 0xdb, 0x6e, 0x3c, 0x98, 0x41, 0x8e, 0x61, 0x7d, 0x1f,
+	0x2b, 0x7e, 0x70, 0x6f, 0x74, 0xc8, 0xc9, 0xb3, 0xc9, 0xe3, 0x77, 0x3b, 0xe2, 0x1f, 0xaa, 0x9e,
+	0x03, 0x9d, 0xf7, 0x8a, 0xc8, 0x9b, 0x99, 0xa2, 0xf3, 0xde, 0xda, 0x46, 0x30, 0x99, 0x38, 0xc7,
+	0x86, 0x53, 0xa0, 0x9f, 0xcd, 0x55, 0xf9, 0x4f, 0xf2, 0x9c, 0x00, 0xb5, 0xeb, 0xa4, 0xa2, 0xfe,
+	0x1c, 0x75, 0x5e, 0x4e, 0xc2, 0xf3, 0x16, 0xd0, 0xde, 0xd3, 0xef, 0x37, 0x4d, 0xe7, 0xc7, 0x4d,
+	0xd3, 0xf9, 0x75, 0xd3, 0x74, 0xbe, 0xfe, 0x6e, 0x3e, 0x3a, 0x5b, 0x34, 0x9b, 0xf9, 0xdd, 0xdf,
+	0x01, 0x00, 0xab, 0x33, 0x12, 0x32, 0xca, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -969,6 +997,27 @@ func (m *DoctorWorkingHours) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.EffectiveTo) > 0 {
+		i -= len(m.EffectiveTo)
+		copy(dAtA[i:], m.EffectiveTo)
+		i = encodeVarintDoctorWorkingHours(dAtA, i, uint64(len(m.EffectiveTo)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.EffectiveFrom) > 0 {
+		i -= len(m.EffectiveFrom)
+		copy(dAtA[i:], m.EffectiveFrom)
+		i = encodeVarintDoctorWorkingHours(dAtA, i, uint64(len(m.EffectiveFrom)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintDoctorWorkingHours(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DeletedAt) > 0 {
 		i -= len(m.DeletedAt)
 		copy(dAtA[i:], m.DeletedAt)
@@ -1216,6 +1265,18 @@ func (m *DoctorWorkingHours) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDoctorWorkingHours(uint64(l))
 	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovDoctorWorkingHours(uint64(l))
+	}
+	l = len(m.EffectiveFrom)
+	if l > 0 {
+		n += 1 + l + sovDoctorWorkingHours(uint64(l))
+	}
+	l = len(m.EffectiveTo)
+	if l > 0 {
+		n += 1 + l + sovDoctorWorkingHours(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2074,6 +2135,102 @@ func (m *DoctorWorkingHours) Unmarshal(dAtA []byte) error {
 			}
 			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorWorkingHours
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorWorkingHours
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorWorkingHours
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorWorkingHours
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorWorkingHours
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorWorkingHours
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorWorkingHours
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorWorkingHours
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorWorkingHours
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctorWorkingHours(dAtA[iNdEx:])
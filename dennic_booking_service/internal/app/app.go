@@ -11,13 +11,16 @@ import (
 	"booking_service/internal/pkg/otlp"
 	"booking_service/internal/pkg/postgres"
 	"booking_service/internal/usecase"
+	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/rickb777/date"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -29,6 +32,7 @@ type App struct {
 	GrpcServer     *grpc.Server
 	ShutdownOTLP   func() error
 	ServiceClients grpc_service_clients.ServiceClients
+	StopJobs       context.CancelFunc
 	//BrokerProducer event.BrokerProducer
 }
 
@@ -85,6 +89,22 @@ func (a *App) Run() error {
 	// context timeout initialization
 	contextTimeout, err := time.ParseDuration(a.Config.Context.Timeout)
 
+	// idempotency key retention initialization
+	idempotencyKeyTTL, err := time.ParseDuration(a.Config.IdempotencyKey.Retention)
+	if err != nil {
+		return fmt.Errorf("error during parse idempotency key retention: %w", err)
+	}
+
+	// doctor assignment initialization
+	assignmentInterval, err := time.ParseDuration(a.Config.DoctorAssignment.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse doctor assignment interval: %w", err)
+	}
+	assignmentDaysAhead, err := strconv.Atoi(a.Config.DoctorAssignment.DaysAhead)
+	if err != nil {
+		return fmt.Errorf("error during parse doctor assignment days ahead: %w", err)
+	}
+
 	// Initialize Service Clients
 	serviceClients, err := grpc_service_clients.New(a.Config)
 	if err != nil {
@@ -95,6 +115,8 @@ func (a *App) Run() error {
 	// repositories initialization
 	bookingAppointment := repo.NewBookingAppointment(a.DB)
 
+	bookingRules := repo.NewBookingRules(a.DB)
+
 	bookingPatients := repo.NewBookingPatients(a.DB)
 
 	doctorNotes := repo.NewDoctorNotes(a.DB)
@@ -103,9 +125,30 @@ func (a *App) Run() error {
 
 	doctorAvailability := repo.NewDoctorAvailability(a.DB)
 
+	doctorAssignment := repo.NewDoctorAssignment(a.DB)
+
+	rescheduleRepo := repo.NewReschedule(a.DB)
+
+	doctorReviews := repo.NewDoctorReviews(a.DB)
+
+	promoCodes := repo.NewPromoCodes(a.DB)
+
+	patientDocuments := repo.NewPatientDocuments(a.DB)
+
 	// usecase initialization
 
-	appointmentsUseCase := usecase.NewBookedAppointments(bookingAppointment, contextTimeout)
+	doctorDirectory := grpc_service_clients.NewDoctorDirectory(serviceClients.DoctorService(), serviceClients.DoctorsService())
+
+	doctorLicenses := grpc_service_clients.NewDoctorLicenses(serviceClients.DoctorCredentialService())
+	resourceDirectory := grpc_service_clients.NewResourceDirectory(serviceClients.ResourceService())
+
+	doctorAssignmentUseCase := usecase.NewDoctorAssignment(doctorAssignment, doctorDirectory, contextTimeout)
+
+	rescheduleUseCase := usecase.NewReschedule(rescheduleRepo, doctorDirectory, contextTimeout)
+
+	bookingRulesUseCase := usecase.NewBookingRules(bookingRules, contextTimeout)
+
+	appointmentsUseCase := usecase.NewBookedAppointments(bookingAppointment, bookingRules, doctorLicenses, resourceDirectory, promoCodes, doctorAssignmentUseCase, a.Logger, contextTimeout, idempotencyKeyTTL)
 
 	patientUseCase := usecase.NewBookedPatient(bookingPatients, contextTimeout)
 
@@ -115,8 +158,18 @@ func (a *App) Run() error {
 
 	doctorAvailabilityUseCase := usecase.NewBookedDoctorAvailability(doctorAvailability, contextTimeout)
 
+	doctorReviewsUseCase := usecase.NewDoctorReviews(doctorReviews, bookingAppointment, doctorDirectory, contextTimeout)
+
+	recommendationUseCase := usecase.NewRecommendation(doctorDirectory, rescheduleRepo, contextTimeout)
+
+	promoCodesUseCase := usecase.NewPromoCodes(promoCodes, contextTimeout)
+
+	patientDocumentsUseCase := usecase.NewPatientDocuments(patientDocuments, bookingPatients, bookingAppointment, doctorNotes, contextTimeout)
+
 	pb.RegisterBookedAppointmentsServiceServer(a.GrpcServer, invest_grpc.BookingAppointmentsNewRPC(a.Logger, appointmentsUseCase))
 
+	pb.RegisterBookingRulesServiceServer(a.GrpcServer, invest_grpc.BookingRulesNewRPC(a.Logger, bookingRulesUseCase))
+
 	pb.RegisterPatientsServiceServer(a.GrpcServer, invest_grpc.BookingPatientNewRPC(a.Logger, patientUseCase))
 
 	pb.RegisterDoctorNotesServiceServer(a.GrpcServer, invest_grpc.BookingDoctorNotesNewRPC(a.Logger, doctorNotesUseCase))
@@ -124,6 +177,22 @@ func (a *App) Run() error {
 	pb.RegisterArchiveServiceServer(a.GrpcServer, invest_grpc.BookingArchiveNewRPC(a.Logger, archiveUseCase))
 
 	pb.RegisterDoctorTimeServiceServer(a.GrpcServer, invest_grpc.BookingDoctorAvailabilityNewRPC(a.Logger, doctorAvailabilityUseCase))
+
+	pb.RegisterRescheduleServiceServer(a.GrpcServer, invest_grpc.RescheduleNewRPC(a.Logger, rescheduleUseCase))
+
+	pb.RegisterDoctorReviewsServiceServer(a.GrpcServer, invest_grpc.DoctorReviewsNewRPC(a.Logger, doctorReviewsUseCase))
+
+	pb.RegisterRecommendationServiceServer(a.GrpcServer, invest_grpc.RecommendationNewRPC(a.Logger, recommendationUseCase))
+
+	pb.RegisterPromoCodesServiceServer(a.GrpcServer, invest_grpc.PromoCodesNewRPC(a.Logger, promoCodesUseCase))
+
+	pb.RegisterPatientDocumentsServiceServer(a.GrpcServer, invest_grpc.PatientDocumentsNewRPC(a.Logger, patientDocumentsUseCase))
+
+	// background jobs
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	a.StopJobs = stopJobs
+	go a.runDoctorAssignment(jobsCtx, doctorAssignmentUseCase, assignmentInterval, assignmentDaysAhead)
+
 	a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
 
 	if err := grpc_server.Run(a.Config, a.GrpcServer); err != nil {
@@ -133,7 +202,33 @@ func (a *App) Run() error {
 	return nil
 }
 
+// runDoctorAssignment periodically assigns doctors to department-level appointments
+// taking place within the next daysAhead days
+func (a *App) runDoctorAssignment(ctx context.Context, assignment *usecase.DoctorAssignmentUseCase, interval time.Duration, daysAhead int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		assigned, err := assignment.AssignPending(ctx, date.Today().Add(date.PeriodOfDays(daysAhead)))
+		if err != nil {
+			a.Logger.Error("doctor assignment", zap.Error(err))
+		} else if assigned > 0 {
+			a.Logger.Info("doctor assignment", zap.Int("assigned", assigned))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func (a *App) Stop() {
+	// stop background jobs
+	if a.StopJobs != nil {
+		a.StopJobs()
+	}
 	// close broker producer
 	// closing client service connections
 	a.ServiceClients.Close()
@@ -4,6 +4,7 @@ import (
 	"booking_service/internal/entity"
 	"context"
 	"errors"
+	"strconv"
 
 	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
@@ -14,11 +15,14 @@ var (
 	errNotFound   *entity.ErrNotFound
 	errConflict   *entity.ErrConflict
 	errValidation *entity.ErrValidation
+	errForbidden  *entity.ErrForbidden
 )
 
 func ErrorStatus(ctx context.Context, err error) *status.Status {
 	var (
-		st *status.Status
+		st                *status.Status
+		errBookingLimit   *entity.ErrBookingLimit
+		errBookingBlocked *entity.ErrBookingBlocked
 	)
 	switch {
 	// error not found
@@ -27,6 +31,9 @@ func ErrorStatus(ctx context.Context, err error) *status.Status {
 	// error conflict
 	case errors.As(err, &errConflict):
 		st = status.New(codes.AlreadyExists, err.Error())
+	// error forbidden
+	case errors.As(err, &errForbidden):
+		st = status.New(codes.PermissionDenied, err.Error())
 	// error validation errors
 	case errors.As(err, &errValidation):
 		st = status.New(codes.InvalidArgument, codes.InvalidArgument.String())
@@ -38,6 +45,23 @@ func ErrorStatus(ctx context.Context, err error) *status.Status {
 			})
 		}
 		st, _ = st.WithDetails(br)
+	// error booking limit, the rule code is sent as the error reason
+	case errors.As(err, &errBookingLimit):
+		st = status.New(codes.ResourceExhausted, err.Error())
+		st, _ = st.WithDetails(&epb.ErrorInfo{
+			Reason: errBookingLimit.Code,
+			Domain: "booking_service",
+			Metadata: map[string]string{
+				"limit": strconv.FormatInt(errBookingLimit.Limit, 10),
+			},
+		})
+	// error booking blocked, the rule code is sent as the error reason
+	case errors.As(err, &errBookingBlocked):
+		st = status.New(codes.FailedPrecondition, err.Error())
+		st, _ = st.WithDetails(&epb.ErrorInfo{
+			Reason: errBookingBlocked.Code,
+			Domain: "booking_service",
+		})
 	// error internal
 	default:
 		st = status.New(codes.Internal, codes.Internal.String())
@@ -2,7 +2,7 @@ package services
 
 import (
 	pb "booking_service/genproto/booking_service"
-	_ "booking_service/internal/delivery/grpc"
+	rpc "booking_service/internal/delivery/grpc"
 	appointment "booking_service/internal/entity/booked_appointments"
 	"booking_service/internal/pkg/otlp"
 	"booking_service/internal/usecase"
@@ -66,10 +66,14 @@ func (r *BookingAppointments) CreateAppointment(ctx context.Context, req *pb.Cre
 		Status:          req.Status,
 		PaymentType:     req.PaymentType,
 		PaymentAmount:   float64(req.PaymentAmount),
+		IdempotencyKey: appointment.IdempotencyKey{
+			Key: req.IdempotencyKey,
+		},
+		PromoCode: req.PromoCode,
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, rpc.Error(ctx, err)
 	}
 
 	return &pb.Appointment{
@@ -90,6 +94,9 @@ func (r *BookingAppointments) CreateAppointment(ctx context.Context, req *pb.Cre
 		CreatedAt:       res.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:       res.UpdatedAt.Format("2006-01-02 15:04:05"),
 		DeletedAt:       res.DeletedAt.Format("2006-01-02 15:04:05"),
+		ResourceId:      res.ResourceId,
+		PromoCode:       res.PromoCode,
+		DiscountAmount:  float32(res.DiscountAmount),
 	}, nil
 }
 
@@ -304,3 +311,84 @@ func (r *BookingAppointments) DeleteAppointment(ctx context.Context, req *pb.App
 
 	return &pb.DeleteAppointmentStatus{Status: res.Status}, err
 }
+
+func (r *BookingAppointments) ListDoctorAppointments(ctx context.Context, req *pb.DoctorAppointmentsReq) (*pb.Appointments, error) {
+	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointmentsService+"ListDoctor")
+	span.SetAttributes(
+		attribute.Key("doctor_id").String(req.DoctorId),
+	)
+	defer span.End()
+
+	from, err := time.Parse("2006-01-02 15:04:05", req.From)
+	if err != nil {
+		return nil, err
+	}
+	var to time.Time
+	if req.To != "" {
+		if to, err = time.Parse("2006-01-02 15:04:05", req.To); err != nil {
+			return nil, err
+		}
+	}
+
+	allAppointment, err := r.bookedAppointmentUseCase.ListDoctorAppointments(ctx, &appointment.DoctorAppointmentsReq{
+		DoctorId: req.DoctorId,
+		From:     from,
+		To:       to,
+		Status:   req.Status,
+		Page:     req.Page,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	var appointmentsRes pb.Appointments
+	for _, appoint := range allAppointment.Appointments {
+		appointmentsRes.Appointments = append(appointmentsRes.Appointments, appointmentToPb(appoint))
+	}
+	appointmentsRes.Count = allAppointment.Count
+
+	return &appointmentsRes, nil
+}
+
+func (r *BookingAppointments) MarkAttendance(ctx context.Context, req *pb.MarkAttendanceReq) (*pb.Appointment, error) {
+	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointmentsService+"MarkAttendance")
+	span.SetAttributes(
+		attribute.Key("doctor_id").String(req.DoctorId),
+		attribute.Key("status").String(req.Status),
+	)
+	defer span.End()
+
+	res, err := r.bookedAppointmentUseCase.SetAttendance(ctx, &appointment.AttendanceReq{
+		Id:       req.Id,
+		DoctorId: req.DoctorId,
+		Status:   req.Status,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return appointmentToPb(res), nil
+}
+
+func appointmentToPb(res *appointment.Appointment) *pb.Appointment {
+	return &pb.Appointment{
+		Id:              res.Id,
+		DepartmentId:    res.DepartmentId,
+		DoctorId:        res.DoctorId,
+		PatientId:       res.PatientId,
+		DoctorServiceId: res.ServiceId,
+		AppointmentDate: res.AppointmentDate.String(),
+		AppointmentTime: res.AppointmentTime.Format("15:04:05"),
+		Duration:        res.Duration,
+		Key:             res.Key,
+		ExpiresAt:       res.ExpiresAt.Format("2006-01-02 15:04:05"),
+		PatientProblem:  res.PatientProblem,
+		Status:          res.Status,
+		PaymentType:     res.PaymentType,
+		PaymentAmount:   float32(res.PaymentAmount),
+		CreatedAt:       res.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       res.UpdatedAt.Format("2006-01-02 15:04:05"),
+		DeletedAt:       res.DeletedAt.Format("2006-01-02 15:04:05"),
+	}
+}
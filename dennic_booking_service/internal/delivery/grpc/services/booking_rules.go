@@ -0,0 +1,74 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	"booking_service/internal/entity/booking_rules"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	serviceNameBookingRules     = "BookingRulesService"
+	spanNameBookingRulesService = "BookingRulesService"
+)
+
+type BookingRules struct {
+	logger              *zap.Logger
+	bookingRulesUseCase usecase.BookingRules
+}
+
+func BookingRulesNewRPC(logger *zap.Logger, bookingRulesUseCase usecase.BookingRules) *BookingRules {
+	return &BookingRules{
+		logger:              logger,
+		bookingRulesUseCase: bookingRulesUseCase,
+	}
+}
+
+func (r *BookingRules) GetBookingRules(ctx context.Context, req *pb.GetBookingRulesReq) (*pb.BookingRules, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBookingRules, spanNameBookingRulesService+"Get")
+	defer span.End()
+
+	res, err := r.bookingRulesUseCase.GetBookingRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return bookingRulesToPb(res), nil
+}
+
+func (r *BookingRules) UpdateBookingRules(ctx context.Context, req *pb.UpdateBookingRulesReq) (*pb.BookingRules, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBookingRules, spanNameBookingRulesService+"Update")
+	defer span.End()
+
+	if req.MaxActivePerPatient < 0 || req.MaxActivePerDoctor < 0 || req.MaxPerDay < 0 {
+		return nil, status.Error(codes.InvalidArgument, "booking limits cannot be negative")
+	}
+
+	res, err := r.bookingRulesUseCase.UpdateBookingRules(ctx, &booking_rules.UpdateBookingRules{
+		MaxActivePerPatient: req.MaxActivePerPatient,
+		MaxActivePerDoctor:  req.MaxActivePerDoctor,
+		MaxPerDay:           req.MaxPerDay,
+		BlockExpiredLicense: req.BlockExpiredLicense,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bookingRulesToPb(res), nil
+}
+
+func bookingRulesToPb(res *booking_rules.BookingRules) *pb.BookingRules {
+	return &pb.BookingRules{
+		MaxActivePerPatient: res.MaxActivePerPatient,
+		MaxActivePerDoctor:  res.MaxActivePerDoctor,
+		MaxPerDay:           res.MaxPerDay,
+		BlockExpiredLicense: res.BlockExpiredLicense,
+		CreatedAt:           res.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:           res.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
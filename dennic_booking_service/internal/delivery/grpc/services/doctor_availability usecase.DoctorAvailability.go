@@ -2,6 +2,7 @@ package services
 
 import (
 	pb "booking_service/genproto/booking_service"
+	rpc "booking_service/internal/delivery/grpc"
 	"booking_service/internal/entity/doctor_availability"
 	"booking_service/internal/pkg/otlp"
 	"booking_service/internal/usecase"
@@ -9,6 +10,8 @@ import (
 	"github.com/rickb777/date"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"time"
 )
 
@@ -215,3 +218,54 @@ func (r *BookingDoctorAvailability) DeleteDoctorTime(ctx context.Context, req *p
 
 	return &pb.DoctorTimeDeleteStatus{Status: res.Status}, err
 }
+
+func (r *BookingDoctorAvailability) BlockLeave(ctx context.Context, req *pb.LeaveBlocksReq) (*pb.LeaveBlocksRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorAvailability, spanNameDoctorAvailabilityService+"BlockLeave")
+	span.SetAttributes(
+		attribute.Key("leave_id").Int64(req.LeaveId),
+		attribute.Key("doctor_id").String(req.DoctorId),
+	)
+	defer span.End()
+
+	startDate, err := date.AutoParse(req.StartDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "start_date must be a date 2006-01-02")
+	}
+	endDate, err := date.AutoParse(req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "end_date must be a date 2006-01-02")
+	}
+	if endDate.Before(startDate) {
+		return nil, status.Error(codes.InvalidArgument, "end_date must not be before start_date")
+	}
+
+	res, err := r.bookedDoctorAvailabilityUseCase.BlockLeave(ctx, &doctor_availability.LeaveBlocks{
+		LeaveId:      req.LeaveId,
+		DepartmentId: req.DepartmentId,
+		DoctorId:     req.DoctorId,
+		StartDate:    startDate,
+		EndDate:      endDate,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return &pb.LeaveBlocksRes{Count: res.Count}, nil
+}
+
+func (r *BookingDoctorAvailability) ReleaseLeave(ctx context.Context, req *pb.ReleaseLeaveReq) (*pb.LeaveBlocksRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorAvailability, spanNameDoctorAvailabilityService+"ReleaseLeave")
+	span.SetAttributes(
+		attribute.Key("leave_id").Int64(req.LeaveId),
+	)
+	defer span.End()
+
+	res, err := r.bookedDoctorAvailabilityUseCase.ReleaseLeave(ctx, &doctor_availability.LeaveReq{
+		LeaveId: req.LeaveId,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return &pb.LeaveBlocksRes{Count: res.Count}, nil
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	rpc "booking_service/internal/delivery/grpc"
+	"booking_service/internal/entity/doctor_reviews"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	serviceNameDoctorReviews     = "DoctorReviewsService"
+	spanNameDoctorReviewsService = "DoctorReviewsService"
+)
+
+type DoctorReviews struct {
+	logger               *zap.Logger
+	doctorReviewsUseCase *usecase.DoctorReviewsUseCase
+}
+
+func DoctorReviewsNewRPC(logger *zap.Logger, doctorReviewsUseCase *usecase.DoctorReviewsUseCase) *DoctorReviews {
+	return &DoctorReviews{
+		logger:               logger,
+		doctorReviewsUseCase: doctorReviewsUseCase,
+	}
+}
+
+func reviewToPb(review *doctor_reviews.Review) *pb.Review {
+	return &pb.Review{
+		Id:             review.Id,
+		AppointmentId:  review.AppointmentId,
+		DoctorId:       review.DoctorId,
+		PatientId:      review.PatientId,
+		Rating:         review.Rating,
+		Comment:        review.Comment,
+		Status:         review.Status,
+		ModerationNote: review.ModerationNote,
+		ModeratedAt:    review.ModeratedAt.Format("2006-01-02 15:04:05"),
+		CreatedAt:      review.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:      review.UpdatedAt.Format("2006-01-02 15:04:05"),
+		DeletedAt:      review.DeletedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (r *DoctorReviews) CreateReview(ctx context.Context, req *pb.CreateReviewReq) (*pb.Review, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviewsService+"Create")
+	span.SetAttributes(
+		attribute.Key("appointment_id").Int64(req.AppointmentId),
+	)
+	defer span.End()
+
+	res, err := r.doctorReviewsUseCase.CreateReview(ctx, &doctor_reviews.CreateReview{
+		AppointmentId: req.AppointmentId,
+		PatientId:     req.PatientId,
+		Rating:        req.Rating,
+		Comment:       req.Comment,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return reviewToPb(res), nil
+}
+
+func (r *DoctorReviews) GetReview(ctx context.Context, req *pb.ReviewFieldValueReq) (*pb.Review, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviewsService+"Get")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.doctorReviewsUseCase.GetReview(ctx, &doctor_reviews.FieldValueReq{
+		Field:        req.Field,
+		Value:        req.Value,
+		DeleteStatus: req.IsActive,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return reviewToPb(res), nil
+}
+
+func (r *DoctorReviews) GetAllReviews(ctx context.Context, req *pb.GetAllReviewsReq) (*pb.Reviews, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviewsService+"List")
+	defer span.End()
+
+	reviews, err := r.doctorReviewsUseCase.GetAllReviews(ctx, &doctor_reviews.GetAllReviews{
+		Page:         req.Page,
+		Limit:        req.Limit,
+		DoctorId:     req.DoctorId,
+		PatientId:    req.PatientId,
+		Status:       req.Status,
+		DeleteStatus: req.IsActive,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	var response pb.Reviews
+	for _, review := range reviews.Reviews {
+		response.Reviews = append(response.Reviews, reviewToPb(review))
+	}
+	response.Count = reviews.Count
+
+	return &response, nil
+}
+
+func (r *DoctorReviews) ModerateReview(ctx context.Context, req *pb.ModerateReviewReq) (*pb.Review, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviewsService+"Moderate")
+	span.SetAttributes(
+		attribute.Key("id").Int64(req.Id),
+	)
+	defer span.End()
+
+	res, err := r.doctorReviewsUseCase.ModerateReview(ctx, &doctor_reviews.ModerateReview{
+		Id:             req.Id,
+		Status:         req.Status,
+		ModerationNote: req.ModerationNote,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return reviewToPb(res), nil
+}
+
+func (r *DoctorReviews) DeleteReview(ctx context.Context, req *pb.ReviewFieldValueReq) (*pb.DeleteReviewStatus, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviewsService+"Delete")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.doctorReviewsUseCase.DeleteReview(ctx, &doctor_reviews.FieldValueReq{
+		Field:        req.Field,
+		Value:        req.Value,
+		DeleteStatus: req.IsActive,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return &pb.DeleteReviewStatus{Status: res.Status}, nil
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	rpc "booking_service/internal/delivery/grpc"
+	"booking_service/internal/entity/patient_documents"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	serviceNamePatientDocuments     = "PatientDocumentsService"
+	spanNamePatientDocumentsService = "PatientDocumentsService"
+)
+
+type PatientDocuments struct {
+	logger                  *zap.Logger
+	patientDocumentsUseCase *usecase.PatientDocumentsUseCase
+}
+
+func PatientDocumentsNewRPC(logger *zap.Logger, patientDocumentsUseCase *usecase.PatientDocumentsUseCase) *PatientDocuments {
+	return &PatientDocuments{
+		logger:                  logger,
+		patientDocumentsUseCase: patientDocumentsUseCase,
+	}
+}
+
+func patientDocumentToPb(document *patient_documents.Document) *pb.PatientDocument {
+	return &pb.PatientDocument{
+		Id:            document.Id,
+		PatientId:     document.PatientId,
+		UserId:        document.UserId,
+		AppointmentId: document.AppointmentId,
+		DoctorNoteId:  document.DoctorNoteId,
+		DocumentType:  document.DocumentType,
+		Title:         document.Title,
+		ObjectName:    document.ObjectName,
+		ContentType:   document.ContentType,
+		SizeBytes:     document.Size,
+		UploadedAt:    document.UploadedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func requesterFromPb(requester *pb.PatientDocumentRequester) patient_documents.Requester {
+	return patient_documents.Requester{
+		UserId:   requester.GetUserId(),
+		DoctorId: requester.GetDoctorId(),
+	}
+}
+
+func (r *PatientDocuments) CreatePatientDocument(ctx context.Context, req *pb.CreatePatientDocumentReq) (*pb.PatientDocument, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePatientDocuments, spanNamePatientDocumentsService+"Create")
+	span.SetAttributes(
+		attribute.Key("patient_id").String(req.PatientId),
+	)
+	defer span.End()
+
+	res, err := r.patientDocumentsUseCase.CreateDocument(ctx, &patient_documents.CreateDocument{
+		PatientId:     req.PatientId,
+		UserId:        req.UserId,
+		PhoneNumber:   req.PhoneNumber,
+		AppointmentId: req.AppointmentId,
+		DoctorNoteId:  req.DoctorNoteId,
+		DocumentType:  req.DocumentType,
+		Title:         req.Title,
+		ObjectName:    req.ObjectName,
+		ContentType:   req.ContentType,
+		Size:          req.SizeBytes,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return patientDocumentToPb(res), nil
+}
+
+func (r *PatientDocuments) GetPatientDocument(ctx context.Context, req *pb.PatientDocumentReq) (*pb.PatientDocument, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePatientDocuments, spanNamePatientDocumentsService+"Get")
+	span.SetAttributes(
+		attribute.Key("id").Int64(req.Id),
+	)
+	defer span.End()
+
+	res, err := r.patientDocumentsUseCase.GetDocument(ctx, &patient_documents.DocumentReq{
+		Id:        req.Id,
+		Requester: requesterFromPb(req.Requester),
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return patientDocumentToPb(res), nil
+}
+
+func (r *PatientDocuments) GetAllPatientDocuments(ctx context.Context, req *pb.GetAllPatientDocumentsReq) (*pb.PatientDocuments, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePatientDocuments, spanNamePatientDocumentsService+"List")
+	defer span.End()
+
+	documents, err := r.patientDocumentsUseCase.GetAllDocuments(ctx, &patient_documents.GetAllDocuments{
+		PatientId:     req.PatientId,
+		AppointmentId: req.AppointmentId,
+		Page:          req.Page,
+		Limit:         req.Limit,
+		Requester:     requesterFromPb(req.Requester),
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	var response pb.PatientDocuments
+	for _, document := range documents.Documents {
+		response.PatientDocuments = append(response.PatientDocuments, patientDocumentToPb(document))
+	}
+	response.Count = documents.Count
+
+	return &response, nil
+}
+
+func (r *PatientDocuments) DeletePatientDocument(ctx context.Context, req *pb.PatientDocumentReq) (*pb.DeletePatientDocumentStatus, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePatientDocuments, spanNamePatientDocumentsService+"Delete")
+	span.SetAttributes(
+		attribute.Key("id").Int64(req.Id),
+	)
+	defer span.End()
+
+	res, err := r.patientDocumentsUseCase.DeleteDocument(ctx, &patient_documents.DocumentReq{
+		Id:        req.Id,
+		Requester: requesterFromPb(req.Requester),
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return &pb.DeletePatientDocumentStatus{Status: res.Status}, nil
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	rpc "booking_service/internal/delivery/grpc"
+	"booking_service/internal/entity/promo_codes"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	serviceNamePromoCodes     = "PromoCodesService"
+	spanNamePromoCodesService = "PromoCodesService"
+)
+
+type PromoCodes struct {
+	logger            *zap.Logger
+	promoCodesUseCase *usecase.PromoCodesUseCase
+}
+
+func PromoCodesNewRPC(logger *zap.Logger, promoCodesUseCase *usecase.PromoCodesUseCase) *PromoCodes {
+	return &PromoCodes{
+		logger:            logger,
+		promoCodesUseCase: promoCodesUseCase,
+	}
+}
+
+func promoCodeToPb(promoCode *promo_codes.PromoCode) *pb.PromoCode {
+	var validUntil string
+	if !promoCode.ValidUntil.IsZero() {
+		validUntil = promoCode.ValidUntil.Format("2006-01-02 15:04:05")
+	}
+	return &pb.PromoCode{
+		Id:         promoCode.Id,
+		Code:       promoCode.Code,
+		Kind:       promoCode.Kind,
+		Value:      promoCode.Value,
+		MaxUses:    promoCode.MaxUses,
+		UsedCount:  promoCode.UsedCount,
+		ValidFrom:  promoCode.ValidFrom.Format("2006-01-02 15:04:05"),
+		ValidUntil: validUntil,
+		CreatedAt:  promoCode.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:  promoCode.UpdatedAt.Format("2006-01-02 15:04:05"),
+		DeletedAt:  promoCode.DeletedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// parseValidity parses the validity window, an empty valid_until leaves the promo code valid without an end
+func parseValidity(validFrom, validUntil string) (from, until time.Time, err error) {
+	if from, err = time.Parse("2006-01-02 15:04:05", validFrom); err != nil {
+		return from, until, err
+	}
+	if validUntil != "" {
+		until, err = time.Parse("2006-01-02 15:04:05", validUntil)
+	}
+	return from, until, err
+}
+
+func (r *PromoCodes) CreatePromoCode(ctx context.Context, req *pb.CreatePromoCodeReq) (*pb.PromoCode, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodesService+"Create")
+	span.SetAttributes(
+		attribute.Key("code").String(req.Code),
+	)
+	defer span.End()
+
+	validFrom, validUntil, err := parseValidity(req.ValidFrom, req.ValidUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.promoCodesUseCase.CreatePromoCode(ctx, &promo_codes.CreatePromoCode{
+		Code:       req.Code,
+		Kind:       req.Kind,
+		Value:      req.Value,
+		MaxUses:    req.MaxUses,
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return promoCodeToPb(res), nil
+}
+
+func (r *PromoCodes) GetPromoCode(ctx context.Context, req *pb.PromoCodeFieldValueReq) (*pb.PromoCode, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodesService+"Get")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.promoCodesUseCase.GetPromoCode(ctx, &promo_codes.FieldValueReq{
+		Field:        req.Field,
+		Value:        req.Value,
+		DeleteStatus: req.IsActive,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return promoCodeToPb(res), nil
+}
+
+func (r *PromoCodes) GetAllPromoCodes(ctx context.Context, req *pb.GetAllPromoCodesReq) (*pb.PromoCodes, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodesService+"List")
+	defer span.End()
+
+	promoCodes, err := r.promoCodesUseCase.GetAllPromoCodes(ctx, &promo_codes.GetAllPromoCodes{
+		Page:         req.Page,
+		Limit:        req.Limit,
+		DeleteStatus: req.IsActive,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	var response pb.PromoCodes
+	for _, promoCode := range promoCodes.PromoCodes {
+		response.PromoCodes = append(response.PromoCodes, promoCodeToPb(promoCode))
+	}
+	response.Count = promoCodes.Count
+
+	return &response, nil
+}
+
+func (r *PromoCodes) UpdatePromoCode(ctx context.Context, req *pb.UpdatePromoCodeReq) (*pb.PromoCode, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodesService+"Update")
+	span.SetAttributes(
+		attribute.Key("id").Int64(req.Id),
+	)
+	defer span.End()
+
+	validFrom, validUntil, err := parseValidity(req.ValidFrom, req.ValidUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.promoCodesUseCase.UpdatePromoCode(ctx, &promo_codes.UpdatePromoCode{
+		Id:         req.Id,
+		Kind:       req.Kind,
+		Value:      req.Value,
+		MaxUses:    req.MaxUses,
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return promoCodeToPb(res), nil
+}
+
+func (r *PromoCodes) DeletePromoCode(ctx context.Context, req *pb.PromoCodeFieldValueReq) (*pb.DeletePromoCodeStatus, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodesService+"Delete")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.promoCodesUseCase.DeletePromoCode(ctx, &promo_codes.FieldValueReq{
+		Field:        req.Field,
+		Value:        req.Value,
+		DeleteStatus: req.IsActive,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return &pb.DeletePromoCodeStatus{Status: res.Status}, nil
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	rpc "booking_service/internal/delivery/grpc"
+	"booking_service/internal/entity/recommendation"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	serviceNameRecommendation     = "RecommendationService"
+	spanNameRecommendationService = "RecommendationService"
+)
+
+type Recommendation struct {
+	logger                *zap.Logger
+	recommendationUseCase *usecase.RecommendationUseCase
+}
+
+func RecommendationNewRPC(logger *zap.Logger, recommendationUseCase *usecase.RecommendationUseCase) *Recommendation {
+	return &Recommendation{
+		logger:                logger,
+		recommendationUseCase: recommendationUseCase,
+	}
+}
+
+func (r *Recommendation) RecommendDoctors(ctx context.Context, req *pb.RecommendDoctorsReq) (*pb.DoctorRecommendations, error) {
+	ctx, span := otlp.Start(ctx, serviceNameRecommendation, spanNameRecommendationService+"Recommend")
+	span.SetAttributes(
+		attribute.Key("reason_id").String(req.ReasonId),
+		attribute.Key("query").String(req.Query),
+	)
+	defer span.End()
+
+	if strings.TrimSpace(req.ReasonId) == "" && strings.TrimSpace(req.Query) == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason_id or query is required")
+	}
+
+	res, err := r.recommendationUseCase.RecommendDoctors(ctx, &recommendation.RecommendReq{
+		ReasonId:  strings.TrimSpace(req.ReasonId),
+		Query:     strings.TrimSpace(req.Query),
+		Online:    req.Online,
+		DaysAhead: req.DaysAhead,
+		Limit:     req.Limit,
+	})
+	if err != nil {
+		// errors of the healthcare service, e.g. an unknown reason, are passed on as they are
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, rpc.Error(ctx, err)
+	}
+
+	response := pb.DoctorRecommendations{
+		ReasonId:         res.ReasonId,
+		ReasonName:       res.ReasonName,
+		SpecializationId: res.SpecializationId,
+	}
+	for _, rec := range res.Recommendations {
+		recommendationRes := &pb.DoctorRecommendation{
+			DoctorId:        rec.Doctor.DoctorId,
+			FirstName:       rec.Doctor.FirstName,
+			LastName:        rec.Doctor.LastName,
+			ImageUrl:        rec.Doctor.ImageUrl,
+			DepartmentId:    rec.Doctor.DepartmentId,
+			DoctorServiceId: rec.Doctor.DoctorServiceId,
+			Price:           rec.Price,
+			Duration:        rec.Doctor.Duration,
+			Rating:          rec.Doctor.Rating,
+			ReviewCount:     rec.Doctor.ReviewCount,
+			SlotFound:       rec.SlotFound,
+			Score:           rec.Score,
+		}
+		if rec.SlotFound {
+			recommendationRes.SlotDate = rec.SlotDate.String()
+			recommendationRes.SlotTime = rec.SlotTime.Format("15:04:05")
+		}
+		response.Recommendations = append(response.Recommendations, recommendationRes)
+	}
+
+	return &response, nil
+}
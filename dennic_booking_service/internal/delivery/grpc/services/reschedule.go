@@ -0,0 +1,201 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	"booking_service/internal/entity/reschedule"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+	"time"
+
+	"github.com/rickb777/date"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	serviceNameReschedule     = "RescheduleService"
+	spanNameRescheduleService = "RescheduleService"
+)
+
+type Reschedule struct {
+	logger            *zap.Logger
+	rescheduleUseCase *usecase.RescheduleUseCase
+}
+
+func RescheduleNewRPC(logger *zap.Logger, rescheduleUseCase *usecase.RescheduleUseCase) *Reschedule {
+	return &Reschedule{
+		logger:            logger,
+		rescheduleUseCase: rescheduleUseCase,
+	}
+}
+
+func (r *Reschedule) ProposeReschedule(ctx context.Context, req *pb.RescheduleReq) (*pb.RescheduleProposals, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReschedule, spanNameRescheduleService+"Propose")
+	span.SetAttributes(
+		attribute.Key("doctor_id").String(req.DoctorId),
+	)
+	defer span.End()
+
+	rescheduleReq, err := rescheduleReqFromPb(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.rescheduleUseCase.ProposeReschedule(ctx, rescheduleReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return proposalsToPb(res), nil
+}
+
+func (r *Reschedule) ApplyReschedule(ctx context.Context, req *pb.RescheduleReq) (*pb.RescheduleProposals, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReschedule, spanNameRescheduleService+"Apply")
+	span.SetAttributes(
+		attribute.Key("doctor_id").String(req.DoctorId),
+	)
+	defer span.End()
+
+	rescheduleReq, err := rescheduleReqFromPb(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.rescheduleUseCase.ApplyReschedule(ctx, rescheduleReq)
+	if err != nil {
+		return nil, err
+	}
+
+	r.logger.Info("appointments rescheduled",
+		zap.String("doctor_id", req.DoctorId),
+		zap.String("strategy", req.Strategy),
+		zap.Int64("affected", res.Count),
+		zap.Int64("applied", res.Applied),
+	)
+
+	return proposalsToPb(res), nil
+}
+
+func (r *Reschedule) GetPatientNotifications(ctx context.Context, req *pb.GetPatientNotificationsReq) (*pb.PatientNotifications, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReschedule, spanNameRescheduleService+"ListNotifications")
+	span.SetAttributes(
+		attribute.Key("patient_id").String(req.PatientId),
+	)
+	defer span.End()
+
+	res, err := r.rescheduleUseCase.GetPatientNotifications(ctx, &reschedule.NotificationsReq{
+		PatientId: req.PatientId,
+		Page:      uint64(req.Page),
+		Limit:     uint64(req.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var notifications []*pb.PatientNotification
+	for _, notification := range res.Notifications {
+		var readAt string
+		if !notification.ReadAt.IsZero() {
+			readAt = notification.ReadAt.Format("2006-01-02 15:04:05")
+		}
+		notifications = append(notifications, &pb.PatientNotification{
+			Id:            notification.Id,
+			PatientId:     notification.PatientId,
+			AppointmentId: notification.AppointmentId,
+			Kind:          notification.Kind,
+			Message:       notification.Message,
+			CreatedAt:     notification.CreatedAt.Format("2006-01-02 15:04:05"),
+			ReadAt:        readAt,
+		})
+	}
+
+	return &pb.PatientNotifications{
+		Count:         res.Count,
+		Notifications: notifications,
+	}, nil
+}
+
+// rescheduleReqFromPb validates req, an empty start time means the start of the day
+// and an empty end time the end of the day
+func rescheduleReqFromPb(req *pb.RescheduleReq) (*reschedule.RescheduleReq, error) {
+	if req.DoctorId == "" {
+		return nil, status.Error(codes.InvalidArgument, "doctor_id is required")
+	}
+	if req.Strategy != reschedule.StrategySameDoctor && req.Strategy != reschedule.StrategyOtherDoctor {
+		return nil, status.Error(codes.InvalidArgument, "strategy must be same_doctor or other_doctor")
+	}
+
+	startDate, err := date.AutoParse(req.StartDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid start_date")
+	}
+	endDate, err := date.AutoParse(req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid end_date")
+	}
+
+	start := startDate.UTC()
+	if req.StartTime != "" {
+		startTime, err := time.Parse("15:04:05", req.StartTime)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid start_time")
+		}
+		start = start.Add(startTime.Sub(time.Time{}))
+	}
+
+	end := endDate.Add(1).UTC()
+	if req.EndTime != "" {
+		endTime, err := time.Parse("15:04:05", req.EndTime)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid end_time")
+		}
+		end = endDate.UTC().Add(endTime.Sub(time.Time{}))
+	}
+
+	if !end.After(start) {
+		return nil, status.Error(codes.InvalidArgument, "end must be after start")
+	}
+
+	return &reschedule.RescheduleReq{
+		DoctorId:       req.DoctorId,
+		Start:          start,
+		End:            end,
+		Strategy:       req.Strategy,
+		DaysAhead:      req.DaysAhead,
+		AppointmentIds: req.AppointmentIds,
+	}, nil
+}
+
+func proposalsToPb(res *reschedule.Proposals) *pb.RescheduleProposals {
+	var proposals []*pb.RescheduleProposal
+	for _, proposal := range res.Proposals {
+		pbProposal := &pb.RescheduleProposal{
+			AppointmentId:   proposal.AppointmentId,
+			PatientId:       proposal.PatientId,
+			DepartmentId:    proposal.DepartmentId,
+			DoctorId:        proposal.DoctorId,
+			DoctorServiceId: proposal.DoctorServiceId,
+			AppointmentDate: proposal.AppointmentDate.String(),
+			AppointmentTime: proposal.AppointmentTime.Format("15:04:05"),
+			Duration:        proposal.Duration,
+			Found:           proposal.Found,
+			Applied:         proposal.Applied,
+		}
+		if proposal.Found {
+			pbProposal.NewDoctorId = proposal.NewDoctorId
+			pbProposal.NewDoctorServiceId = proposal.NewDoctorServiceId
+			pbProposal.NewAppointmentDate = proposal.NewAppointmentDate.String()
+			pbProposal.NewAppointmentTime = proposal.NewAppointmentTime.Format("15:04:05")
+		}
+		proposals = append(proposals, pbProposal)
+	}
+
+	return &pb.RescheduleProposals{
+		Count:     res.Count,
+		Applied:   res.Applied,
+		Proposals: proposals,
+	}
+}
@@ -6,6 +6,15 @@ import (
 	"github.com/rickb777/date"
 )
 
+// CodeResourceUnavailable is returned when every resource required by the service is reserved for the slot
+const CodeResourceUnavailable = "BOOKING_RESOURCE_UNAVAILABLE"
+
+// the statuses the doctor marks the attendance of the patient with
+const (
+	StatusAttended = "attended"
+	StatusNoShow   = "no_show"
+)
+
 type Appointment struct {
 	Id              int64
 	DepartmentId    string
@@ -21,6 +30,9 @@ type Appointment struct {
 	Status          string
 	PaymentType     string
 	PaymentAmount   float64
+	ResourceId      string
+	PromoCode       string
+	DiscountAmount  float64
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	DeletedAt       time.Time
@@ -45,6 +57,45 @@ type CreateAppointment struct {
 	Status          string
 	PaymentType     string
 	PaymentAmount   float64
+	IdempotencyKey  IdempotencyKey
+	Resource        Resource
+	PromoCode       string
+	Discount        Discount
+	Limits          Limits
+}
+
+// Limits are the booking rules limits the repository checks in the transaction of the appointment,
+// zero disables a limit
+type Limits struct {
+	MaxActivePerPatient int64
+	MaxActivePerDoctor  int64
+	MaxPerDay           int64
+}
+
+// Enabled tells whether any of the limits is set
+func (l Limits) Enabled() bool {
+	return l.MaxActivePerPatient > 0 || l.MaxActivePerDoctor > 0 || l.MaxPerDay > 0
+}
+
+// Discount is the promo code applied to the appointment, PaymentAmount is already reduced by Amount
+type Discount struct {
+	PromoCodeId    int64
+	PromoCode      string
+	OriginalAmount float64
+	Amount         float64
+}
+
+// Resource is the resource type required by the service, empty when it needs none, and the resources
+// of the type usable for the appointment in the order of preference, the first free one is reserved
+type Resource struct {
+	Type       string
+	Candidates []string
+}
+
+// IdempotencyKey is stored together with the created appointment until ExpiresAt
+type IdempotencyKey struct {
+	Key       string
+	ExpiresAt time.Time
 }
 
 type UpdateAppointment struct {
@@ -93,3 +144,20 @@ type FieldValueReq struct {
 type StatusRes struct {
 	Status bool
 }
+
+// DoctorAppointmentsReq lists the appointments of the doctor starting from From, before To when it is set
+type DoctorAppointmentsReq struct {
+	DoctorId string
+	From     time.Time
+	To       time.Time
+	Status   string
+	Page     uint64
+	Limit    uint64
+}
+
+// AttendanceReq marks an appointment of the doctor attended or no_show
+type AttendanceReq struct {
+	Id       int64
+	DoctorId string
+	Status   string
+}
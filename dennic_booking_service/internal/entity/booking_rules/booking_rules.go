@@ -0,0 +1,46 @@
+package booking_rules
+
+import (
+	"time"
+
+	"github.com/rickb777/date"
+)
+
+// error codes returned when a booking rule is violated
+const (
+	CodeActivePerPatient = "BOOKING_LIMIT_ACTIVE_PER_PATIENT"
+	CodeActivePerDoctor  = "BOOKING_LIMIT_ACTIVE_PER_DOCTOR"
+	CodePerDay           = "BOOKING_LIMIT_PER_DAY"
+	CodeLicenseExpired   = "BOOKING_DOCTOR_LICENSE_EXPIRED"
+)
+
+// BookingRules limits how many appointments a patient may hold, zero disables a limit,
+// BlockExpiredLicense rejects appointments with doctors whose license has expired by the appointment date
+type BookingRules struct {
+	MaxActivePerPatient int64
+	MaxActivePerDoctor  int64
+	MaxPerDay           int64
+	BlockExpiredLicense bool
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+type UpdateBookingRules struct {
+	MaxActivePerPatient int64
+	MaxActivePerDoctor  int64
+	MaxPerDay           int64
+	BlockExpiredLicense bool
+}
+
+// CountActiveReq counts active future appointments of a patient and of every patient sharing its phone number
+type CountActiveReq struct {
+	PatientId       string
+	DoctorId        string
+	AppointmentDate date.Date
+}
+
+type ActiveCount struct {
+	Total      int64
+	WithDoctor int64
+	OnDay      int64
+}
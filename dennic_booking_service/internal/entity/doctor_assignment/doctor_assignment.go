@@ -0,0 +1,77 @@
+package doctor_assignment
+
+import (
+	"time"
+
+	"github.com/rickb777/date"
+)
+
+// ServiceDoctorsReq asks the healthcare service for the doctors of a department
+// offering the same service and working on the given day, SpecializationId when set
+// lists the doctors of the specialization in place of the one of the service
+type ServiceDoctorsReq struct {
+	DepartmentId     string
+	DoctorServiceId  string
+	SpecializationId string
+	Date             date.Date
+}
+
+// ServiceDoctor is a shift of a candidate doctor with its own doctor_service, a doctor with several shifts
+// on the day is listed once per shift, Breaks are all the breaks of the doctor on the day
+type ServiceDoctor struct {
+	DoctorId        string
+	DoctorServiceId string
+	StartTime       time.Time
+	FinishTime      time.Time
+	Breaks          []*Break
+}
+
+// Break is a time range of a day in which the doctor takes no appointments
+type Break struct {
+	StartTime  time.Time
+	FinishTime time.Time
+}
+
+type DoctorsLoadReq struct {
+	DoctorIds []string
+	Date      date.Date
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// DoctorLoad describes a doctor on a day, Busy and Unavailable refer to the requested time range
+type DoctorLoad struct {
+	DoctorId    string
+	DayLoad     int64
+	Busy        bool
+	Unavailable bool
+}
+
+// AssignDoctorReq assigns the doctor to the appointment of the slot, which is rechecked against the bookings of the doctor
+type AssignDoctorReq struct {
+	AppointmentId   int64
+	DoctorId        string
+	DoctorServiceId string
+	AppointmentDate date.Date
+	AppointmentTime time.Time
+	Duration        int64
+}
+
+// UnassignedReq lists the unassigned appointments ordered by their slot and id,
+// a page after the first one starts after the appointment the After fields describe
+type UnassignedReq struct {
+	Until     date.Date
+	Limit     uint64
+	AfterId   int64
+	AfterDate date.Date
+	AfterTime time.Time
+}
+
+// PickDoctorReq describes the slot a doctor is picked for
+type PickDoctorReq struct {
+	DepartmentId    string
+	DoctorServiceId string
+	AppointmentDate date.Date
+	AppointmentTime time.Time
+	Duration        int64
+}
@@ -61,3 +61,22 @@ type GetAllReq struct {
 type StatusRes struct {
 	Status bool
 }
+
+// LeaveBlocks asks for a whole day unavailable block of the doctor on every day of an approved leave,
+// the blocks keep the id of the leave of the healthcare service
+type LeaveBlocks struct {
+	LeaveId      int64
+	DepartmentId string
+	DoctorId     string
+	StartDate    date.Date
+	EndDate      date.Date
+}
+
+type LeaveReq struct {
+	LeaveId int64
+}
+
+// LeaveBlocksRes is the number of blocks created or removed
+type LeaveBlocksRes struct {
+	Count int64
+}
@@ -0,0 +1,76 @@
+package doctor_reviews
+
+import (
+	"time"
+)
+
+const (
+	// StatusPending reviews wait for moderation and are not counted in the doctor rating
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+	StatusRejected = "rejected"
+
+	MinRating = 1
+	MaxRating = 5
+)
+
+type Review struct {
+	Id             int64
+	AppointmentId  int64
+	DoctorId       string
+	PatientId      string
+	Rating         int64
+	Comment        string
+	Status         string
+	ModerationNote string
+	ModeratedAt    time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      time.Time
+}
+
+type ReviewsType struct {
+	Count   int64
+	Reviews []*Review
+}
+
+type CreateReview struct {
+	AppointmentId int64
+	DoctorId      string
+	PatientId     string
+	Rating        int64
+	Comment       string
+}
+
+type ModerateReview struct {
+	Id             int64
+	Status         string
+	ModerationNote string
+}
+
+// GetAllReviews filters by every non empty field
+type GetAllReviews struct {
+	Page         uint64
+	Limit        uint64
+	DoctorId     string
+	PatientId    string
+	Status       string
+	DeleteStatus bool
+}
+
+type FieldValueReq struct {
+	Field        string
+	Value        string
+	DeleteStatus bool
+}
+
+type StatusRes struct {
+	Status bool
+}
+
+// DoctorRating is the average rating and the count of the approved reviews of a doctor
+type DoctorRating struct {
+	DoctorId    string
+	Rating      float64
+	ReviewCount int64
+}
@@ -36,6 +36,19 @@ func NewErrConflict(text string) *ErrConflict {
 	return &ErrConflict{text}
 }
 
+// error forbidden, the object exists but the caller may not act on it
+type ErrForbidden struct {
+	reason string
+}
+
+func (e *ErrForbidden) Error() string {
+	return e.reason
+}
+
+func NewErrForbidden(reason string) *ErrForbidden {
+	return &ErrForbidden{reason}
+}
+
 // error validation
 type ErrValidation struct {
 	Err    error
@@ -50,6 +63,34 @@ func NewErrValidation() *ErrValidation {
 	return &ErrValidation{Errors: make(map[string]string)}
 }
 
+// error booking limit
+type ErrBookingLimit struct {
+	Code  string
+	Limit int64
+}
+
+func (e *ErrBookingLimit) Error() string {
+	return fmt.Sprintf("booking limit reached: %s (limit %d)", e.Code, e.Limit)
+}
+
+func NewErrBookingLimit(code string, limit int64) *ErrBookingLimit {
+	return &ErrBookingLimit{Code: code, Limit: limit}
+}
+
+// error booking blocked, an appointment which is not allowed at all whatever the limits
+type ErrBookingBlocked struct {
+	Code   string
+	Reason string
+}
+
+func (e *ErrBookingBlocked) Error() string {
+	return fmt.Sprintf("booking blocked: %s", e.Reason)
+}
+
+func NewErrBookingBlocked(code, reason string) *ErrBookingBlocked {
+	return &ErrBookingBlocked{Code: code, Reason: reason}
+}
+
 type ErrNoRequiredParameter struct {
 	parameters []string
 }
@@ -0,0 +1,77 @@
+package patient_documents
+
+import (
+	"time"
+)
+
+const (
+	TypeLabResult    = "lab_result"
+	TypeScan         = "scan"
+	TypePrescription = "prescription"
+	TypeOther        = "other"
+)
+
+// Types are the accepted document types
+var Types = []string{TypeLabResult, TypeScan, TypePrescription, TypeOther}
+
+// Document is a file of a patient, UserId is the user who uploaded it and owns it.
+// AppointmentId and DoctorNoteId are 0 when the document is not attached to them
+type Document struct {
+	Id            int64
+	PatientId     string
+	UserId        string
+	AppointmentId int64
+	DoctorNoteId  int64
+	DocumentType  string
+	Title         string
+	ObjectName    string
+	ContentType   string
+	Size          int64
+	UploadedAt    time.Time
+	DeletedAt     time.Time
+}
+
+type DocumentsType struct {
+	Count     int64
+	Documents []*Document
+}
+
+// CreateDocument is attached by a user of the patient, a user whose PhoneNumber is the one of the patient
+type CreateDocument struct {
+	PatientId     string
+	UserId        string
+	PhoneNumber   string
+	AppointmentId int64
+	DoctorNoteId  int64
+	DocumentType  string
+	Title         string
+	ObjectName    string
+	ContentType   string
+	Size          int64
+}
+
+// Requester is the one asking for documents, either the owning user or a doctor,
+// a doctor reaches the documents of the appointments and notes of their own and
+// the unattached documents of the patients they have an appointment with
+type Requester struct {
+	UserId   string
+	DoctorId string
+}
+
+type DocumentReq struct {
+	Id int64
+	Requester
+}
+
+// GetAllDocuments lists the documents of the patient, of the appointment only when AppointmentId is set
+type GetAllDocuments struct {
+	PatientId     string
+	AppointmentId int64
+	Page          uint64
+	Limit         uint64
+	Requester
+}
+
+type StatusRes struct {
+	Status bool
+}
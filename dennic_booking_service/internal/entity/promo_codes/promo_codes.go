@@ -0,0 +1,97 @@
+package promo_codes
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// KindPercent takes Value percents off the payment amount, KindFixed takes Value itself
+	KindPercent = "percent"
+	KindFixed   = "fixed"
+
+	// CodePromoCodeInvalid is returned for an unknown, deleted or not yet or no longer valid promo code
+	CodePromoCodeInvalid = "BOOKING_PROMO_CODE_INVALID"
+	// CodePromoCodeExhausted is returned when the promo code has been used max_uses times
+	CodePromoCodeExhausted = "BOOKING_PROMO_CODE_EXHAUSTED"
+)
+
+type PromoCode struct {
+	Id         int64
+	Code       string
+	Kind       string
+	Value      float64
+	MaxUses    int64
+	UsedCount  int64
+	ValidFrom  time.Time
+	ValidUntil time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeletedAt  time.Time
+}
+
+type PromoCodesType struct {
+	Count      int64
+	PromoCodes []*PromoCode
+}
+
+// CreatePromoCode has no ValidUntil when ValidUntil is zero, MaxUses 0 is unlimited
+type CreatePromoCode struct {
+	Code       string
+	Kind       string
+	Value      float64
+	MaxUses    int64
+	ValidFrom  time.Time
+	ValidUntil time.Time
+}
+
+type UpdatePromoCode struct {
+	Id         int64
+	Kind       string
+	Value      float64
+	MaxUses    int64
+	ValidFrom  time.Time
+	ValidUntil time.Time
+}
+
+type GetAllPromoCodes struct {
+	Page         uint64
+	Limit        uint64
+	DeleteStatus bool
+}
+
+type FieldValueReq struct {
+	Field        string
+	Value        string
+	DeleteStatus bool
+}
+
+type StatusRes struct {
+	Status bool
+}
+
+// ValidAt tells whether the promo code is in its validity window at the moment
+func (p *PromoCode) ValidAt(at time.Time) bool {
+	if !p.DeletedAt.IsZero() || at.Before(p.ValidFrom) {
+		return false
+	}
+	return p.ValidUntil.IsZero() || at.Before(p.ValidUntil)
+}
+
+// Exhausted tells whether the promo code has been used as many times as allowed
+func (p *PromoCode) Exhausted() bool {
+	return p.MaxUses > 0 && p.UsedCount >= p.MaxUses
+}
+
+// DiscountAmount is the amount the promo code takes off the payment amount rounded to cents,
+// never more than the amount itself
+func (p *PromoCode) DiscountAmount(amount float64) float64 {
+	discount := p.Value
+	if p.Kind == KindPercent {
+		discount = math.Round(amount*p.Value) / 100
+	}
+	if discount > amount {
+		discount = amount
+	}
+	return discount
+}
@@ -0,0 +1,81 @@
+package recommendation
+
+import (
+	"time"
+
+	"github.com/rickb777/date"
+)
+
+// RecommendReq selects a reason by id or, when the id is empty, the reason best matching the query,
+// Online ranks by the online price instead of the offline one
+type RecommendReq struct {
+	ReasonId  string
+	Query     string
+	Online    bool
+	DaysAhead int64
+	Limit     int64
+}
+
+// ReasonDoctorsReq asks the healthcare service for the doctors of the specialization of a reason
+type ReasonDoctorsReq struct {
+	ReasonId string
+	Query    string
+}
+
+// WorkingHours is a shift or, when Break is set, a break of the doctor on a weekday,
+// the zero EffectiveFrom/EffectiveTo leave the range in which the hours apply open on that side
+type WorkingHours struct {
+	DayOfWeek     time.Weekday
+	Break         bool
+	StartTime     time.Time
+	FinishTime    time.Time
+	EffectiveFrom date.Date
+	EffectiveTo   date.Date
+}
+
+// Leave is an approved leave of the doctor, both dates included
+type Leave struct {
+	StartDate date.Date
+	EndDate   date.Date
+}
+
+// Doctor is a doctor offering a service of the specialization of the reason, Duration is in minutes
+type Doctor struct {
+	DoctorId        string
+	FirstName       string
+	LastName        string
+	ImageUrl        string
+	DepartmentId    string
+	DoctorServiceId string
+	OnlinePrice     float64
+	OfflinePrice    float64
+	Duration        int64
+	Rating          float64
+	ReviewCount     int64
+	WorkingHours    []*WorkingHours
+	Leaves          []*Leave
+}
+
+type ReasonDoctors struct {
+	ReasonId         string
+	ReasonName       string
+	SpecializationId string
+	Doctors          []*Doctor
+}
+
+// Recommendation is a ranked doctor, SlotFound is false when the doctor has no free slot in the searched days
+type Recommendation struct {
+	Doctor    *Doctor
+	Price     float64
+	SlotFound bool
+	SlotDate  date.Date
+	SlotTime  time.Time
+	Score     float64
+}
+
+type Recommendations struct {
+	ReasonId         string
+	ReasonName       string
+	SpecializationId string
+	Recommendations  []*Recommendation
+}
@@ -0,0 +1,94 @@
+package reschedule
+
+import (
+	"time"
+
+	"github.com/rickb777/date"
+)
+
+const (
+	// StrategySameDoctor moves appointments to later free slots of the same doctor
+	StrategySameDoctor = "same_doctor"
+	// StrategyOtherDoctor moves appointments to another doctor with the specialization of the booked service
+	StrategyOtherDoctor = "other_doctor"
+
+	NotificationRescheduled = "appointment_rescheduled"
+)
+
+type RescheduleReq struct {
+	DoctorId       string
+	Start          time.Time
+	End            time.Time
+	Strategy       string
+	DaysAhead      int64
+	AppointmentIds []int64
+}
+
+type AffectedReq struct {
+	DoctorId       string
+	Start          time.Time
+	End            time.Time
+	AppointmentIds []int64
+}
+
+type Proposal struct {
+	AppointmentId      int64
+	PatientId          string
+	DepartmentId       string
+	DoctorId           string
+	DoctorServiceId    string
+	AppointmentDate    date.Date
+	AppointmentTime    time.Time
+	Duration           int64
+	NewDoctorId        string
+	NewDoctorServiceId string
+	NewAppointmentDate date.Date
+	NewAppointmentTime time.Time
+	Found              bool
+	Applied            bool
+}
+
+type Proposals struct {
+	Count     int64
+	Applied   int64
+	Proposals []*Proposal
+}
+
+type BusyIntervalsReq struct {
+	DoctorIds []string
+	Date      date.Date
+}
+
+// BusyInterval is a time range of a day in which a doctor cannot take a new appointment
+type BusyInterval struct {
+	DoctorId  string
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+type Notification struct {
+	Id            int64
+	PatientId     string
+	AppointmentId int64
+	Kind          string
+	Message       string
+	CreatedAt     time.Time
+	ReadAt        time.Time
+}
+
+type NotificationsReq struct {
+	PatientId string
+	Page      uint64
+	Limit     uint64
+}
+
+type Notifications struct {
+	Count         int64
+	Notifications []*Notification
+}
+
+// Move is a proposal to apply together with the notification sent to its patient
+type Move struct {
+	Proposal     *Proposal
+	Notification *Notification
+}
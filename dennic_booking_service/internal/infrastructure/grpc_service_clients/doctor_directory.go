@@ -0,0 +1,188 @@
+package grpc_service_clients
+
+import (
+	"booking_service/genproto/healthcare-service"
+	"booking_service/internal/entity/doctor_assignment"
+	"booking_service/internal/entity/doctor_reviews"
+	"booking_service/internal/entity/recommendation"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/rickb777/date"
+)
+
+// workingHoursBreak is the kind of the working hours which are taken out of the shifts of the day
+const workingHoursBreak = "break"
+
+// DoctorDirectory looks up doctors and their services in the healthcare service
+type DoctorDirectory struct {
+	client   healthcare.DoctorServiceClient
+	services healthcare.DoctorsServiceClient
+}
+
+func NewDoctorDirectory(client healthcare.DoctorServiceClient, services healthcare.DoctorsServiceClient) *DoctorDirectory {
+	return &DoctorDirectory{
+		client:   client,
+		services: services,
+	}
+}
+
+// GetServiceSpecialization returns the specialization of the doctor_service row
+func (d *DoctorDirectory) GetServiceSpecialization(ctx context.Context, doctorServiceId string) (string, error) {
+	res, err := d.services.GetDoctorServiceByID(ctx, &healthcare.GetReqStr{
+		Field: "id",
+		Value: doctorServiceId,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.SpecializationId, nil
+}
+
+func (d *DoctorDirectory) ListServiceDoctors(ctx context.Context, req *doctor_assignment.ServiceDoctorsReq) ([]*doctor_assignment.ServiceDoctor, error) {
+	res, err := d.client.ListDoctorsForService(ctx, &healthcare.GetReqServiceDoctors{
+		DepartmentId:     req.DepartmentId,
+		DoctorServiceId:  req.DoctorServiceId,
+		SpecializationId: req.SpecializationId,
+		DayOfWeek:        req.Date.Weekday().String(),
+		Date:             req.Date.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		response []*doctor_assignment.ServiceDoctor
+		breaks   = map[string][]*doctor_assignment.Break{}
+	)
+	for _, doctor := range res.Doctors {
+		startTime, err := time.Parse("15:04:05", doctor.StartTime)
+		if err != nil {
+			return nil, err
+		}
+		finishTime, err := time.Parse("15:04:05", doctor.FinishTime)
+		if err != nil {
+			return nil, err
+		}
+
+		if doctor.Kind == workingHoursBreak {
+			breaks[doctor.DoctorId] = append(breaks[doctor.DoctorId], &doctor_assignment.Break{
+				StartTime:  startTime,
+				FinishTime: finishTime,
+			})
+			continue
+		}
+		response = append(response, &doctor_assignment.ServiceDoctor{
+			DoctorId:        doctor.DoctorId,
+			DoctorServiceId: doctor.DoctorServiceId,
+			StartTime:       startTime,
+			FinishTime:      finishTime,
+		})
+	}
+	for _, doctor := range response {
+		doctor.Breaks = breaks[doctor.DoctorId]
+	}
+
+	return response, nil
+}
+
+// SetDoctorRating stores the rating shown with the doctor in the healthcare service
+func (d *DoctorDirectory) SetDoctorRating(ctx context.Context, req *doctor_reviews.DoctorRating) error {
+	_, err := d.client.SetDoctorRating(ctx, &healthcare.DoctorRating{
+		DoctorId:    req.DoctorId,
+		Rating:      float32(req.Rating),
+		ReviewCount: req.ReviewCount,
+	})
+	return err
+}
+
+// ListReasonDoctors resolves the reason in the healthcare service and returns the doctors of its specialization
+func (d *DoctorDirectory) ListReasonDoctors(ctx context.Context, req *recommendation.ReasonDoctorsReq) (*recommendation.ReasonDoctors, error) {
+	res, err := d.client.ListDoctorsForReason(ctx, &healthcare.GetReqReasonDoctors{
+		ReasonId: req.ReasonId,
+		Query:    req.Query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := recommendation.ReasonDoctors{
+		ReasonId:         res.ReasonId,
+		ReasonName:       res.ReasonName,
+		SpecializationId: res.SpecializationId,
+	}
+	for _, doctor := range res.Doctors {
+		reasonDoctor := &recommendation.Doctor{
+			DoctorId:        doctor.DoctorId,
+			FirstName:       doctor.FirstName,
+			LastName:        doctor.LastName,
+			ImageUrl:        doctor.ImageUrl,
+			DepartmentId:    doctor.DepartmentId,
+			DoctorServiceId: doctor.DoctorServiceId,
+			OnlinePrice:     float64(doctor.OnlinePrice),
+			OfflinePrice:    float64(doctor.OfflinePrice),
+			Duration:        doctor.Duration,
+			Rating:          float64(doctor.Rating),
+			ReviewCount:     doctor.ReviewCount,
+		}
+		for _, hours := range doctor.WorkingHours {
+			weekday, ok := parseWeekday(hours.DayOfWeek)
+			if !ok {
+				continue
+			}
+			startTime, err := time.Parse("15:04:05", hours.StartTime)
+			if err != nil {
+				return nil, err
+			}
+			finishTime, err := time.Parse("15:04:05", hours.FinishTime)
+			if err != nil {
+				return nil, err
+			}
+			workingHours := &recommendation.WorkingHours{
+				DayOfWeek:  weekday,
+				Break:      hours.Kind == workingHoursBreak,
+				StartTime:  startTime,
+				FinishTime: finishTime,
+			}
+			if hours.EffectiveFrom != "" {
+				if workingHours.EffectiveFrom, err = date.AutoParse(hours.EffectiveFrom); err != nil {
+					return nil, err
+				}
+			}
+			if hours.EffectiveTo != "" {
+				if workingHours.EffectiveTo, err = date.AutoParse(hours.EffectiveTo); err != nil {
+					return nil, err
+				}
+			}
+			reasonDoctor.WorkingHours = append(reasonDoctor.WorkingHours, workingHours)
+		}
+		for _, leave := range doctor.Leaves {
+			startDate, err := date.AutoParse(leave.StartDate)
+			if err != nil {
+				return nil, err
+			}
+			endDate, err := date.AutoParse(leave.EndDate)
+			if err != nil {
+				return nil, err
+			}
+			reasonDoctor.Leaves = append(reasonDoctor.Leaves, &recommendation.Leave{
+				StartDate: startDate,
+				EndDate:   endDate,
+			})
+		}
+		response.Doctors = append(response.Doctors, reasonDoctor)
+	}
+
+	return &response, nil
+}
+
+// parseWeekday reads the day_of_week of the working hours, stored as the english day name
+func parseWeekday(day string) (time.Weekday, bool) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(weekday.String(), strings.TrimSpace(day)) {
+			return weekday, true
+		}
+	}
+	return 0, false
+}
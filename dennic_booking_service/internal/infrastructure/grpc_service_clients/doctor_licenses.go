@@ -0,0 +1,32 @@
+package grpc_service_clients
+
+import (
+	"booking_service/genproto/healthcare-service"
+	"context"
+
+	"github.com/rickb777/date"
+)
+
+// DoctorLicenses checks the licenses of the doctors in the healthcare service
+type DoctorLicenses struct {
+	client healthcare.DoctorCredentialServiceClient
+}
+
+func NewDoctorLicenses(client healthcare.DoctorCredentialServiceClient) *DoctorLicenses {
+	return &DoctorLicenses{
+		client: client,
+	}
+}
+
+// LicenseExpired reports whether none of the licenses of the doctor is valid on the day,
+// a doctor without licenses on record is not expired
+func (d *DoctorLicenses) LicenseExpired(ctx context.Context, doctorId string, day date.Date) (bool, error) {
+	res, err := d.client.GetDoctorLicenseStatus(ctx, &healthcare.DoctorLicenseStatusReq{
+		DoctorId: doctorId,
+		Date:     day.String(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.Expired, nil
+}
@@ -0,0 +1,33 @@
+package grpc_service_clients
+
+import (
+	"booking_service/genproto/healthcare-service"
+	appointment "booking_service/internal/entity/booked_appointments"
+	"context"
+)
+
+// ResourceDirectory looks up the rooms and equipment required by the doctor services in the healthcare service
+type ResourceDirectory struct {
+	client healthcare.ResourceServiceClient
+}
+
+func NewResourceDirectory(client healthcare.ResourceServiceClient) *ResourceDirectory {
+	return &ResourceDirectory{
+		client: client,
+	}
+}
+
+// ServiceResources returns the resource type required by the doctor service and the resources
+// of the type usable by the doctor of the service
+func (d *ResourceDirectory) ServiceResources(ctx context.Context, doctorServiceId string) (*appointment.Resource, error) {
+	res, err := d.client.ListServiceResources(ctx, &healthcare.ServiceResourcesReq{
+		DoctorServiceId: doctorServiceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &appointment.Resource{
+		Type:       res.ResourceType,
+		Candidates: res.ResourceIds,
+	}, nil
+}
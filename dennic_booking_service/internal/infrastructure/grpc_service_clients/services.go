@@ -1,26 +1,66 @@
 package grpc_service_clients
 
 import (
+	"booking_service/genproto/healthcare-service"
 	"booking_service/internal/pkg/config"
+	"fmt"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type ServiceClients interface {
 	// SmsService()
+	DoctorService() healthcare.DoctorServiceClient
+	DoctorsService() healthcare.DoctorsServiceClient
+	DoctorCredentialService() healthcare.DoctorCredentialServiceClient
+	ResourceService() healthcare.ResourceServiceClient
 	Close()
 }
 
 type serviceClients struct {
-	services []*grpc.ClientConn
+	doctorService           healthcare.DoctorServiceClient
+	doctorsService          healthcare.DoctorsServiceClient
+	doctorCredentialService healthcare.DoctorCredentialServiceClient
+	resourceService         healthcare.ResourceServiceClient
+	services                []*grpc.ClientConn
 }
 
 func New(config *config.Config) (ServiceClients, error) {
+	// dial to healthcare service
+	connHealthcareService, err := grpc.Dial(
+		fmt.Sprintf("%s%s", config.HealthcareService.Host, config.HealthcareService.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &serviceClients{
-		services: []*grpc.ClientConn{},
+		doctorService:           healthcare.NewDoctorServiceClient(connHealthcareService),
+		doctorsService:          healthcare.NewDoctorsServiceClient(connHealthcareService),
+		doctorCredentialService: healthcare.NewDoctorCredentialServiceClient(connHealthcareService),
+		resourceService:         healthcare.NewResourceServiceClient(connHealthcareService),
+		services:                []*grpc.ClientConn{connHealthcareService},
 	}, nil
 }
 
+func (s *serviceClients) DoctorService() healthcare.DoctorServiceClient {
+	return s.doctorService
+}
+
+func (s *serviceClients) DoctorsService() healthcare.DoctorsServiceClient {
+	return s.doctorsService
+}
+
+func (s *serviceClients) DoctorCredentialService() healthcare.DoctorCredentialServiceClient {
+	return s.doctorCredentialService
+}
+
+func (s *serviceClients) ResourceService() healthcare.ResourceServiceClient {
+	return s.resourceService
+}
+
 func (s *serviceClients) Close() {
 	// closing investment service
 	for _, conn := range s.services {
@@ -4,9 +4,13 @@ package repository
 import (
 	"booking_service/internal/entity/archive"
 	appointment "booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/booking_rules"
+	"booking_service/internal/entity/doctor_assignment"
 	"booking_service/internal/entity/doctor_availability"
 	"booking_service/internal/entity/doctor_notes"
+	"booking_service/internal/entity/doctor_reviews"
 	"booking_service/internal/entity/patients"
+	"booking_service/internal/entity/reschedule"
 	"context"
 )
 
@@ -27,6 +31,7 @@ type (
 	BookedAppointments interface {
 		CreateAppointment(ctx context.Context, req *appointment.CreateAppointment) (*appointment.Appointment, error)
 		GetAppointment(ctx context.Context, req *appointment.FieldValueReq) (*appointment.Appointment, error)
+		GetAppointmentByIdempotencyKey(ctx context.Context, key string) (*appointment.Appointment, error)
 		GetAllAppointment(ctx context.Context, req *appointment.GetAllAppointment) (*appointment.AppointmentsType, error)
 		GetFilteredAppointments(ctx context.Context, req *appointment.GetFilteredRequest) (*appointment.AppointmentsType, error)
 		UpdateAppointment(ctx context.Context, req *appointment.UpdateAppointment) (*appointment.Appointment, error)
@@ -58,5 +63,39 @@ type (
 		GetAllDoctorAvailability(ctx context.Context, req *doctor_availability.GetAllReq) (*doctor_availability.DoctorAvailabilityType, error)
 		UpdateDoctorAvailability(ctx context.Context, req *doctor_availability.UpdateDoctorAvailability) (*doctor_availability.DoctorAvailability, error)
 		DeleteDoctorAvailability(ctx context.Context, req *doctor_availability.FieldValueReq) (*doctor_availability.StatusRes, error)
+		BlockLeave(ctx context.Context, req *doctor_availability.LeaveBlocks) (*doctor_availability.LeaveBlocksRes, error)
+		ReleaseLeave(ctx context.Context, req *doctor_availability.LeaveReq) (*doctor_availability.LeaveBlocksRes, error)
+	}
+
+	// BookingRules -.
+	BookingRules interface {
+		GetBookingRules(ctx context.Context) (*booking_rules.BookingRules, error)
+		UpdateBookingRules(ctx context.Context, req *booking_rules.UpdateBookingRules) (*booking_rules.BookingRules, error)
+		CountActiveAppointments(ctx context.Context, req *booking_rules.CountActiveReq) (*booking_rules.ActiveCount, error)
+	}
+
+	// DoctorAssignment -.
+	DoctorAssignment interface {
+		ListUnassignedAppointments(ctx context.Context, req *doctor_assignment.UnassignedReq) ([]*appointment.Appointment, error)
+		GetDoctorsLoad(ctx context.Context, req *doctor_assignment.DoctorsLoadReq) ([]*doctor_assignment.DoctorLoad, error)
+		AssignDoctor(ctx context.Context, req *doctor_assignment.AssignDoctorReq) (bool, error)
+	}
+
+	// Reschedule -.
+	Reschedule interface {
+		ListAffectedAppointments(ctx context.Context, req *reschedule.AffectedReq) ([]*appointment.Appointment, error)
+		ListBusyIntervals(ctx context.Context, req *reschedule.BusyIntervalsReq) ([]*reschedule.BusyInterval, error)
+		ApplyReschedule(ctx context.Context, moves []*reschedule.Move) error
+		GetPatientNotifications(ctx context.Context, req *reschedule.NotificationsReq) (*reschedule.Notifications, error)
+	}
+
+	// DoctorReviews -.
+	DoctorReviews interface {
+		CreateReview(ctx context.Context, req *doctor_reviews.CreateReview) (*doctor_reviews.Review, error)
+		GetReview(ctx context.Context, req *doctor_reviews.FieldValueReq) (*doctor_reviews.Review, error)
+		GetAllReviews(ctx context.Context, req *doctor_reviews.GetAllReviews) (*doctor_reviews.ReviewsType, error)
+		ModerateReview(ctx context.Context, req *doctor_reviews.ModerateReview) (*doctor_reviews.Review, error)
+		DeleteReview(ctx context.Context, req *doctor_reviews.FieldValueReq) (*doctor_reviews.StatusRes, error)
+		GetDoctorRating(ctx context.Context, doctorId string) (*doctor_reviews.DoctorRating, error)
 	}
 )
@@ -3,18 +3,28 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
+	"booking_service/internal/entity"
 	appointment "booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/booking_rules"
+	"booking_service/internal/entity/promo_codes"
 	"booking_service/internal/pkg/otlp"
 	"booking_service/internal/pkg/postgres"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/rickb777/date"
 )
 
 const (
-	tableNameAppointment    = "booked_appointments"
-	serviceNameAppointment  = "appointment"
-	spanNameAppointmentRepo = "appointment"
+	tableNameAppointment          = "booked_appointments"
+	tableNameIdempotencyKeys      = "appointment_idempotency_keys"
+	tableNameAppointmentResources = "appointment_resources"
+	tableNameAppointmentDiscounts = "appointment_discounts"
+	serviceNameAppointment        = "appointment"
+	spanNameAppointmentRepo       = "appointment"
 )
 
 type BookingAppointment struct {
@@ -30,7 +40,7 @@ func NewBookingAppointment(db *postgres.PostgresDB) *BookingAppointment {
 func tableColums() string {
 	return `id, 
 			department_id, 
-			doctor_id, 
+			COALESCE(doctor_id::text, '') AS doctor_id, 
 			patient_id, 
 			doctor_service_id,
 			appointment_date, 
@@ -57,6 +67,11 @@ func (r *BookingAppointment) CreateAppointment(
 		response appointment.Appointment
 		upAt     sql.NullTime
 		delAt    sql.NullTime
+		row      pgx.Row
+		reserve  = func(int64) error { return nil }
+		redeem   = func(int64) error { return nil }
+		storeKey = func(int64) error { return nil }
+		commit   = func(context.Context) error { return nil }
 	)
 	toSql, args, err := r.db.Sq.Builder.
 		Insert(tableNameAppointment).
@@ -76,7 +91,7 @@ func (r *BookingAppointment) CreateAppointment(
 				payment_amount`).
 		Values(
 			req.DepartmentId,
-			req.DoctorId,
+			nullString(req.DoctorId),
 			req.PatientId,
 			req.ServiceId,
 			req.AppointmentDate.String(),
@@ -87,14 +102,67 @@ func (r *BookingAppointment) CreateAppointment(
 			req.PatientProblem,
 			req.Status,
 			req.PaymentType,
-			req.PatientProblem).
+			req.PaymentAmount).
 		Suffix(fmt.Sprintf("RETURNING %s", tableColums())).
 		ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	if err = r.db.QueryRow(ctx, toSql, args...).Scan(
+	doctorSlot := req.DoctorId != "" && req.Status == "waiting"
+	if req.IdempotencyKey.Key == "" && req.Resource.Type == "" && req.Discount.PromoCodeId == 0 && !req.Limits.Enabled() && !doctorSlot {
+		row = r.db.QueryRow(ctx, toSql, args...)
+	} else {
+		tx, err := r.db.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		if req.Limits.Enabled() {
+			if err = r.checkLimits(ctx, tx, req); err != nil {
+				return nil, err
+			}
+		}
+
+		if doctorSlot {
+			taken, err := lockDoctorSlot(ctx, tx, req.DoctorId, req.AppointmentDate, req.AppointmentTime, req.Duration)
+			if err != nil {
+				return nil, err
+			}
+			if taken {
+				return nil, entity.NewErrConflict("doctor slot")
+			}
+		}
+
+		if req.Resource.Type != "" {
+			// serializes the reservations of the resource type until the transaction ends
+			if _, err = tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", req.Resource.Type); err != nil {
+				return nil, err
+			}
+		}
+
+		row = tx.QueryRow(ctx, toSql, args...)
+		if req.Resource.Type != "" {
+			reserve = func(appointmentId int64) (err error) {
+				response.ResourceId, err = r.reserveResource(ctx, tx, req, appointmentId)
+				return err
+			}
+		}
+		if req.Discount.PromoCodeId != 0 {
+			redeem = func(appointmentId int64) error {
+				return r.redeemPromoCode(ctx, tx, req.Discount, appointmentId)
+			}
+		}
+		if req.IdempotencyKey.Key != "" {
+			storeKey = func(appointmentId int64) error {
+				return r.storeIdempotencyKey(ctx, tx, req.IdempotencyKey, appointmentId)
+			}
+		}
+		commit = tx.Commit
+	}
+
+	if err = row.Scan(
 		&response.Id,
 		&response.DepartmentId,
 		&response.DoctorId,
@@ -124,9 +192,192 @@ func (r *BookingAppointment) CreateAppointment(
 		response.DeletedAt = delAt.Time
 	}
 
+	if err = reserve(response.Id); err != nil {
+		return nil, err
+	}
+	if err = redeem(response.Id); err != nil {
+		return nil, err
+	}
+	if err = storeKey(response.Id); err != nil {
+		return nil, err
+	}
+	if err = commit(ctx); err != nil {
+		return nil, err
+	}
+	response.PromoCode = req.Discount.PromoCode
+	response.DiscountAmount = req.Discount.Amount
+
 	return &response, nil
 }
 
+// nullString stores an empty string as NULL, e.g. doctor_id of a department level booking
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// checkLimits rejects the appointment when the patient already holds as many active appointments as a limit allows.
+// The advisory lock of the phone number of the patient serializes their bookings until the transaction ends, the
+// counts of the doctor and of the day are ones of the patient too, so no concurrent booking slips past a limit
+func (r *BookingAppointment) checkLimits(
+	ctx context.Context,
+	tx pgx.Tx,
+	req *appointment.CreateAppointment,
+) error {
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`SELECT pg_advisory_xact_lock(hashtext('booking_limits:' || COALESCE(
+		(SELECT phone_number FROM %s WHERE id::text = $1), $1)))`, tableNamePatients), req.PatientId); err != nil {
+		return err
+	}
+
+	count, err := countActiveAppointments(ctx, tx, &booking_rules.CountActiveReq{
+		PatientId:       req.PatientId,
+		DoctorId:        req.DoctorId,
+		AppointmentDate: req.AppointmentDate,
+	})
+	if err != nil {
+		return err
+	}
+
+	limits := req.Limits
+	switch {
+	case limits.MaxActivePerPatient > 0 && count.Total >= limits.MaxActivePerPatient:
+		return entity.NewErrBookingLimit(booking_rules.CodeActivePerPatient, limits.MaxActivePerPatient)
+	case limits.MaxActivePerDoctor > 0 && req.DoctorId != "" && count.WithDoctor >= limits.MaxActivePerDoctor:
+		return entity.NewErrBookingLimit(booking_rules.CodeActivePerDoctor, limits.MaxActivePerDoctor)
+	case limits.MaxPerDay > 0 && count.OnDay >= limits.MaxPerDay:
+		return entity.NewErrBookingLimit(booking_rules.CodePerDay, limits.MaxPerDay)
+	}
+	return nil
+}
+
+// lockDoctorSlot takes the advisory lock of the day of the doctor, which serializes the bookings and the assignments
+// of the doctor on the day until the transaction ends, and reports whether a waiting appointment of the doctor
+// overlaps the slot
+func lockDoctorSlot(ctx context.Context, tx pgx.Tx, doctorId string, day date.Date, start time.Time, duration int64) (bool, error) {
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext('doctor_day:' || $1 || ':' || $2))",
+		doctorId, day.String()); err != nil {
+		return false, err
+	}
+
+	var taken bool
+	err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s
+		WHERE doctor_id::text = $1
+			AND appointment_date = $2::date
+			AND deleted_at IS NULL
+			AND status = 'waiting'
+			AND appointment_time < $4::time
+			AND appointment_time + duration * INTERVAL '1 minute' > $3::time)`, tableNameAppointment),
+		doctorId,
+		day.String(),
+		start.Format("15:04:05"),
+		start.Add(time.Duration(duration)*time.Minute).Format("15:04:05"),
+	).Scan(&taken)
+	return taken, err
+}
+
+// reserveResource reserves for the appointment the first candidate resource not held by another waiting appointment
+// overlapping the slot, the caller holds the advisory lock of the resource type so that two bookings cannot take
+// the same resource
+func (r *BookingAppointment) reserveResource(
+	ctx context.Context,
+	tx pgx.Tx,
+	req *appointment.CreateAppointment,
+	appointmentId int64,
+) (string, error) {
+	query := fmt.Sprintf(`INSERT INTO %[1]s (appointment_id, resource_id, resource_type)
+		SELECT $1, candidate.id::uuid, $2
+		FROM unnest($3::text[]) WITH ORDINALITY AS candidate(id, position)
+		WHERE NOT EXISTS (
+			SELECT 1
+			FROM %[1]s AS ar
+			JOIN %[2]s AS ba ON ba.id = ar.appointment_id
+			WHERE ar.resource_id = candidate.id::uuid
+			  AND ba.status = 'waiting'
+			  AND ba.deleted_at IS NULL
+			  AND ba.appointment_date + ba.appointment_time < $4::timestamp + $5 * INTERVAL '1 minute'
+			  AND ba.appointment_date + ba.appointment_time + ba.duration * INTERVAL '1 minute' > $4::timestamp
+		)
+		ORDER BY candidate.position
+		LIMIT 1
+		RETURNING resource_id::text`, tableNameAppointmentResources, tableNameAppointment)
+
+	start := req.AppointmentDate.String() + " " + req.AppointmentTime.Format("15:04:05")
+	var resourceId string
+	err := tx.QueryRow(ctx, query, appointmentId, req.Resource.Type, req.Resource.Candidates, start, req.Duration).Scan(&resourceId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", entity.NewErrBookingBlocked(appointment.CodeResourceUnavailable, "every "+req.Resource.Type+" is reserved for the slot")
+	}
+	if err != nil {
+		return "", err
+	}
+	return resourceId, nil
+}
+
+// redeemPromoCode counts a use of the promo code and records the discount of the appointment, the promo code
+// row lock makes concurrent bookings respect max_uses while the window is checked again at the moment of booking
+func (r *BookingAppointment) redeemPromoCode(
+	ctx context.Context,
+	tx pgx.Tx,
+	discount appointment.Discount,
+	appointmentId int64,
+) error {
+	resp, err := tx.Exec(ctx, fmt.Sprintf(`UPDATE %s SET used_count = used_count + 1
+		WHERE id = $1
+		  AND deleted_at IS NULL
+		  AND (max_uses = 0 OR used_count < max_uses)
+		  AND valid_from <= CURRENT_TIMESTAMP
+		  AND (valid_until IS NULL OR valid_until > CURRENT_TIMESTAMP)`, tableNamePromoCodes), discount.PromoCodeId)
+	if err != nil {
+		return err
+	}
+	if resp.RowsAffected() == 0 {
+		return entity.NewErrBookingBlocked(promo_codes.CodePromoCodeExhausted, "the promo code "+discount.PromoCode+" can no longer be used")
+	}
+
+	toSql, args, err := r.db.Sq.Builder.
+		Insert(tableNameAppointmentDiscounts).
+		Columns("appointment_id", "promo_code_id", "promo_code", "original_amount", "discount_amount").
+		Values(appointmentId, discount.PromoCodeId, discount.PromoCode, discount.OriginalAmount, discount.Amount).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, toSql, args...)
+	return err
+}
+
+// storeIdempotencyKey links key to the appointment, an expired key is taken over while
+// a live one makes the whole creation fail with a conflict
+func (r *BookingAppointment) storeIdempotencyKey(
+	ctx context.Context,
+	tx pgx.Tx,
+	key appointment.IdempotencyKey,
+	appointmentId int64,
+) error {
+	toSql, args, err := r.db.Sq.Builder.
+		Insert(tableNameIdempotencyKeys).
+		Columns("idempotency_key", "appointment_id", "expires_at").
+		Values(key.Key, appointmentId, key.ExpiresAt).
+		Suffix(fmt.Sprintf(`ON CONFLICT (idempotency_key) DO UPDATE SET
+			appointment_id = EXCLUDED.appointment_id,
+			expires_at = EXCLUDED.expires_at,
+			created_at = CURRENT_TIMESTAMP
+			WHERE %s.expires_at <= CURRENT_TIMESTAMP`, tableNameIdempotencyKeys)).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	resp, err := tx.Exec(ctx, toSql, args...)
+	if err != nil {
+		return err
+	}
+	if resp.RowsAffected() == 0 {
+		return entity.NewErrConflict("idempotency key")
+	}
+	return nil
+}
+
 func (r *BookingAppointment) GetAppointment(
 	ctx context.Context,
 	req *appointment.FieldValueReq,
@@ -170,6 +421,9 @@ func (r *BookingAppointment) GetAppointment(
 		&upAt,
 		&delAt,
 	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.NewErrNotFound("appointment")
+		}
 		return nil, err
 	}
 
@@ -184,6 +438,73 @@ func (r *BookingAppointment) GetAppointment(
 	return &response, nil
 }
 
+func (r *BookingAppointment) GetAppointmentByIdempotencyKey(
+	ctx context.Context,
+	key string,
+) (*appointment.Appointment, error) {
+	ctx, span := otlp.Start(ctx, serviceNameAppointment, spanNameAppointmentRepo+"GetByIdempotencyKey")
+	defer span.End()
+
+	var (
+		response appointment.Appointment
+		upAt     sql.NullTime
+		delAt    sql.NullTime
+	)
+
+	// the replay answers with the body of the create, the reserved resource and the redeemed promo code included
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColums()).
+		Column(fmt.Sprintf("COALESCE((SELECT resource_id::text FROM %s WHERE appointment_id = %s.id), '')",
+			tableNameAppointmentResources, tableNameAppointment)).
+		Column(fmt.Sprintf("COALESCE((SELECT promo_code FROM %s WHERE appointment_id = %s.id), '')",
+			tableNameAppointmentDiscounts, tableNameAppointment)).
+		Column(fmt.Sprintf("COALESCE((SELECT discount_amount FROM %s WHERE appointment_id = %s.id), 0)",
+			tableNameAppointmentDiscounts, tableNameAppointment)).
+		From(tableNameAppointment).
+		Where(fmt.Sprintf(`id = (
+			SELECT appointment_id FROM %s
+			WHERE idempotency_key = ? AND expires_at > CURRENT_TIMESTAMP)`, tableNameIdempotencyKeys), key).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, toSql, args...).Scan(
+		&response.Id,
+		&response.DepartmentId,
+		&response.DoctorId,
+		&response.PatientId,
+		&response.ServiceId,
+		&response.AppointmentDate,
+		&response.AppointmentTime,
+		&response.Duration,
+		&response.Key,
+		&response.ExpiresAt,
+		&response.PatientProblem,
+		&response.Status,
+		&response.PaymentType,
+		&response.PaymentAmount,
+		&response.CreatedAt,
+		&upAt,
+		&delAt,
+		&response.ResourceId,
+		&response.PromoCode,
+		&response.DiscountAmount,
+	); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if upAt.Valid {
+		response.UpdatedAt = upAt.Time
+	}
+
+	if delAt.Valid {
+		response.DeletedAt = delAt.Time
+	}
+
+	return &response, nil
+}
+
 func (r *BookingAppointment) GetAllAppointment(
 	ctx context.Context,
 	req *appointment.GetAllAppointment,
@@ -400,7 +721,7 @@ func (r *BookingAppointment) UpdateAppointment(
 		SetMap(map[string]interface{}{
 			"department_id":     req.DepartmentId,
 			"patient_id":        req.PatientId,
-			"doctor_id":         req.DoctorId,
+			"doctor_id":         nullString(req.DoctorId),
 			"doctor_service_id": req.ServiceId,
 			"appointment_date":  req.AppointmentDate.String(),
 			"appointment_time":  req.AppointmentTime,
@@ -500,3 +821,158 @@ func (r *BookingAppointment) DeleteAppointment(
 		return &appointment.StatusRes{Status: false}, nil
 	}
 }
+
+// ListDoctorAppointments lists the appointments of the doctor in the order they take place
+func (r *BookingAppointment) ListDoctorAppointments(
+	ctx context.Context,
+	req *appointment.DoctorAppointmentsReq,
+) (*appointment.AppointmentsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameAppointment, spanNameAppointmentRepo+"ListDoctor")
+	defer span.End()
+
+	var (
+		response appointment.AppointmentsType
+		upAt     sql.NullTime
+		delAt    sql.NullTime
+	)
+
+	where := r.db.Sq.And(
+		r.db.Sq.Equal("doctor_id", req.DoctorId),
+		r.db.Sq.Equal("deleted_at", nil),
+		r.db.Sq.Expr("appointment_date + appointment_time >= ?", req.From),
+	)
+	if !req.To.IsZero() {
+		where = append(where, r.db.Sq.Expr("appointment_date + appointment_time < ?", req.To))
+	}
+	if req.Status != "" {
+		where = append(where, r.db.Sq.Equal("status", req.Status))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColums()).
+		From(tableNameAppointment).
+		Where(where).
+		OrderBy("appointment_date", "appointment_time")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	queryCount, argsCount, err := r.db.Sq.Builder.Select("count(*)").From(tableNameAppointment).Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	if err = r.db.QueryRow(ctx, queryCount, argsCount...).Scan(&response.Count); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var res appointment.Appointment
+		if err := rows.Scan(
+			&res.Id,
+			&res.DepartmentId,
+			&res.DoctorId,
+			&res.PatientId,
+			&res.ServiceId,
+			&res.AppointmentDate,
+			&res.AppointmentTime,
+			&res.Duration,
+			&res.Key,
+			&res.ExpiresAt,
+			&res.PatientProblem,
+			&res.Status,
+			&res.PaymentType,
+			&res.PaymentAmount,
+			&res.CreatedAt,
+			&upAt,
+			&delAt,
+		); err != nil {
+			return nil, err
+		}
+
+		if upAt.Valid {
+			res.UpdatedAt = upAt.Time
+		}
+
+		if delAt.Valid {
+			res.DeletedAt = delAt.Time
+		}
+
+		response.Appointments = append(response.Appointments, &res)
+	}
+
+	return &response, rows.Err()
+}
+
+// SetAttendance sets the attendance status of the appointment when it belongs to the doctor
+func (r *BookingAppointment) SetAttendance(
+	ctx context.Context,
+	req *appointment.AttendanceReq,
+) (*appointment.Appointment, error) {
+	ctx, span := otlp.Start(ctx, serviceNameAppointment, spanNameAppointmentRepo+"SetAttendance")
+	defer span.End()
+
+	var (
+		response appointment.Appointment
+		upAt     sql.NullTime
+		delAt    sql.NullTime
+	)
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameAppointment).
+		SetMap(map[string]interface{}{
+			"status":     req.Status,
+			"updated_at": time.Now(),
+		}).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"id":         req.Id,
+			"doctor_id":  req.DoctorId,
+			"deleted_at": nil,
+		})).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColums())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	if err = r.db.QueryRow(ctx, toSql, args...).Scan(
+		&response.Id,
+		&response.DepartmentId,
+		&response.DoctorId,
+		&response.PatientId,
+		&response.ServiceId,
+		&response.AppointmentDate,
+		&response.AppointmentTime,
+		&response.Duration,
+		&response.Key,
+		&response.ExpiresAt,
+		&response.PatientProblem,
+		&response.Status,
+		&response.PaymentType,
+		&response.PaymentAmount,
+		&response.CreatedAt,
+		&upAt,
+		&delAt,
+	); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if upAt.Valid {
+		response.UpdatedAt = upAt.Time
+	}
+
+	if delAt.Valid {
+		response.DeletedAt = delAt.Time
+	}
+
+	return &response, nil
+}
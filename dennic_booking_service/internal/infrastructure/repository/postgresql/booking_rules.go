@@ -0,0 +1,183 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking_service/internal/entity/booking_rules"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNameBookingRules    = "booking_rules"
+	serviceNameBookingRules  = "booking_rules"
+	spanNameBookingRulesRepo = "booking_rules"
+
+	// bookingRulesId is the id of the single row holding the rules
+	bookingRulesId = 1
+)
+
+type BookingRules struct {
+	db *postgres.PostgresDB
+}
+
+func NewBookingRules(db *postgres.PostgresDB) *BookingRules {
+	return &BookingRules{
+		db: db,
+	}
+}
+
+func tableColumBookingRules() string {
+	return `max_active_per_patient,
+			max_active_per_doctor,
+			max_per_day,
+			block_expired_license,
+			created_at,
+			updated_at`
+}
+
+func (r *BookingRules) GetBookingRules(ctx context.Context) (*booking_rules.BookingRules, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBookingRules, spanNameBookingRulesRepo+"Get")
+	defer span.End()
+
+	var (
+		response booking_rules.BookingRules
+		upAt     sql.NullTime
+	)
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumBookingRules()).
+		From(tableNameBookingRules).
+		Where(r.db.Sq.Equal("id", bookingRulesId)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.QueryRow(ctx, toSql, args...).Scan(
+		&response.MaxActivePerPatient,
+		&response.MaxActivePerDoctor,
+		&response.MaxPerDay,
+		&response.BlockExpiredLicense,
+		&response.CreatedAt,
+		&upAt,
+	)
+	// no rules configured yet means no limits
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &booking_rules.BookingRules{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if upAt.Valid {
+		response.UpdatedAt = upAt.Time
+	}
+
+	return &response, nil
+}
+
+func (r *BookingRules) UpdateBookingRules(ctx context.Context, req *booking_rules.UpdateBookingRules) (*booking_rules.BookingRules, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBookingRules, spanNameBookingRulesRepo+"Update")
+	defer span.End()
+
+	var (
+		response booking_rules.BookingRules
+		upAt     sql.NullTime
+	)
+
+	toSql, args, err := r.db.Sq.Builder.
+		Insert(tableNameBookingRules).
+		Columns(
+			"id",
+			"max_active_per_patient",
+			"max_active_per_doctor",
+			"max_per_day",
+			"block_expired_license",
+			"updated_at",
+		).
+		Values(
+			bookingRulesId,
+			req.MaxActivePerPatient,
+			req.MaxActivePerDoctor,
+			req.MaxPerDay,
+			req.BlockExpiredLicense,
+			time.Now(),
+		).
+		Suffix(fmt.Sprintf(`ON CONFLICT (id) DO UPDATE SET
+			max_active_per_patient = EXCLUDED.max_active_per_patient,
+			max_active_per_doctor = EXCLUDED.max_active_per_doctor,
+			max_per_day = EXCLUDED.max_per_day,
+			block_expired_license = EXCLUDED.block_expired_license,
+			updated_at = EXCLUDED.updated_at
+			RETURNING %s`, tableColumBookingRules())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, toSql, args...).Scan(
+		&response.MaxActivePerPatient,
+		&response.MaxActivePerDoctor,
+		&response.MaxPerDay,
+		&response.BlockExpiredLicense,
+		&response.CreatedAt,
+		&upAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if upAt.Valid {
+		response.UpdatedAt = upAt.Time
+	}
+
+	return &response, nil
+}
+
+func (r *BookingRules) CountActiveAppointments(ctx context.Context, req *booking_rules.CountActiveReq) (*booking_rules.ActiveCount, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBookingRules, spanNameBookingRulesRepo+"CountActive")
+	defer span.End()
+
+	return countActiveAppointments(ctx, r.db, req)
+}
+
+// rowQuerier is the pool or a transaction
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// countActiveAppointments counts the active future appointments of the patient,
+// appointments booked under another patient record with the same phone number count too
+func countActiveAppointments(ctx context.Context, q rowQuerier, req *booking_rules.CountActiveReq) (*booking_rules.ActiveCount, error) {
+	var response booking_rules.ActiveCount
+
+	query := fmt.Sprintf(`SELECT
+			count(*),
+			count(*) FILTER (WHERE doctor_id::text = $2),
+			count(*) FILTER (WHERE appointment_date = $3::date)
+		FROM %s
+		WHERE deleted_at IS NULL
+			AND status = 'waiting'
+			AND appointment_date + appointment_time >= LOCALTIMESTAMP
+			AND (patient_id::text = $1 OR patient_id IN (
+				SELECT id FROM %s
+				WHERE deleted_at IS NULL
+					AND phone_number = (SELECT phone_number FROM %s WHERE id::text = $1)
+			))`, tableNameAppointment, tableNamePatients, tableNamePatients)
+
+	if err := q.QueryRow(ctx, query, req.PatientId, req.DoctorId, req.AppointmentDate.String()).Scan(
+		&response.Total,
+		&response.WithDoctor,
+		&response.OnDay,
+	); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,224 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	appointment "booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/doctor_assignment"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	serviceNameDoctorAssignment  = "doctor_assignment"
+	spanNameDoctorAssignmentRepo = "doctor_assignment"
+)
+
+type DoctorAssignment struct {
+	db *postgres.PostgresDB
+}
+
+func NewDoctorAssignment(db *postgres.PostgresDB) *DoctorAssignment {
+	return &DoctorAssignment{
+		db: db,
+	}
+}
+
+func (r *DoctorAssignment) ListUnassignedAppointments(
+	ctx context.Context,
+	req *doctor_assignment.UnassignedReq,
+) ([]*appointment.Appointment, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorAssignment, spanNameDoctorAssignmentRepo+"ListUnassigned")
+	defer span.End()
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColums()).
+		From(tableNameAppointment).
+		Where(r.db.Sq.Equal("doctor_id", nil)).
+		Where(r.db.Sq.Equal("deleted_at", nil)).
+		Where(r.db.Sq.Equal("status", "waiting")).
+		Where("appointment_date >= CURRENT_DATE").
+		Where("appointment_date <= ?", req.Until.String()).
+		OrderBy("appointment_date", "appointment_time", "id")
+
+	if req.AfterId != 0 {
+		toSql = toSql.Where("(appointment_date, appointment_time, id) > (?::date, ?::time, ?)",
+			req.AfterDate.String(), req.AfterTime.Format("15:04:05"), req.AfterId)
+	}
+
+	if req.Limit > 0 {
+		toSql = toSql.Limit(req.Limit)
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAppointments(rows)
+}
+
+// scanAppointments reads every row selected with tableColums and closes rows
+func scanAppointments(rows pgx.Rows) ([]*appointment.Appointment, error) {
+	defer rows.Close()
+
+	var (
+		response []*appointment.Appointment
+		upAt     sql.NullTime
+		delAt    sql.NullTime
+	)
+
+	for rows.Next() {
+		var res appointment.Appointment
+		if err := rows.Scan(
+			&res.Id,
+			&res.DepartmentId,
+			&res.DoctorId,
+			&res.PatientId,
+			&res.ServiceId,
+			&res.AppointmentDate,
+			&res.AppointmentTime,
+			&res.Duration,
+			&res.Key,
+			&res.ExpiresAt,
+			&res.PatientProblem,
+			&res.Status,
+			&res.PaymentType,
+			&res.PaymentAmount,
+			&res.CreatedAt,
+			&upAt,
+			&delAt,
+		); err != nil {
+			return nil, err
+		}
+
+		if upAt.Valid {
+			res.UpdatedAt = upAt.Time
+		}
+
+		if delAt.Valid {
+			res.DeletedAt = delAt.Time
+		}
+
+		response = append(response, &res)
+	}
+
+	return response, rows.Err()
+}
+
+func (r *DoctorAssignment) GetDoctorsLoad(
+	ctx context.Context,
+	req *doctor_assignment.DoctorsLoadReq,
+) ([]*doctor_assignment.DoctorLoad, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorAssignment, spanNameDoctorAssignmentRepo+"Load")
+	defer span.End()
+
+	// $3 and $4 bound the requested slot, duration of an appointment is in minutes
+	query := fmt.Sprintf(`SELECT
+			d.doctor_id,
+			(SELECT count(*) FROM %[1]s b
+				WHERE b.doctor_id::text = d.doctor_id
+					AND b.appointment_date = $2::date
+					AND b.deleted_at IS NULL
+					AND b.status = 'waiting'),
+			EXISTS (SELECT 1 FROM %[1]s b
+				WHERE b.doctor_id::text = d.doctor_id
+					AND b.appointment_date = $2::date
+					AND b.deleted_at IS NULL
+					AND b.status = 'waiting'
+					AND b.appointment_time < $4::time
+					AND b.appointment_time + b.duration * INTERVAL '1 minute' > $3::time),
+			EXISTS (SELECT 1 FROM %[2]s a
+				WHERE a.doctor_id::text = d.doctor_id
+					AND a.doctor_date = $2::date
+					AND a.deleted_at IS NULL
+					AND a.status = 'unavailable'
+					AND a.start_time < $4::time
+					AND a.end_time > $3::time)
+		FROM unnest($1::text[]) AS d(doctor_id)`, tableNameAppointment, tableNameDoctorAvailability)
+
+	rows, err := r.db.Query(ctx, query,
+		req.DoctorIds,
+		req.Date.String(),
+		req.StartTime.Format("15:04:05"),
+		req.EndTime.Format("15:04:05"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var response []*doctor_assignment.DoctorLoad
+	for rows.Next() {
+		var load doctor_assignment.DoctorLoad
+		if err := rows.Scan(
+			&load.DoctorId,
+			&load.DayLoad,
+			&load.Busy,
+			&load.Unavailable,
+		); err != nil {
+			return nil, err
+		}
+		response = append(response, &load)
+	}
+
+	return response, rows.Err()
+}
+
+// AssignDoctor assigns the doctor under the lock of the day of the doctor the bookings take, it reports false when
+// the appointment is assigned already or the doctor has been booked for an overlapping slot since they were picked
+func (r *DoctorAssignment) AssignDoctor(
+	ctx context.Context,
+	req *doctor_assignment.AssignDoctorReq,
+) (bool, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorAssignment, spanNameDoctorAssignmentRepo+"Assign")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameAppointment).
+		SetMap(map[string]interface{}{
+			"doctor_id":         req.DoctorId,
+			"doctor_service_id": req.DoctorServiceId,
+			"updated_at":        time.Now(),
+		}).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"id":         req.AppointmentId,
+			"doctor_id":  nil,
+			"deleted_at": nil,
+		})).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	taken, err := lockDoctorSlot(ctx, tx, req.DoctorId, req.AppointmentDate, req.AppointmentTime, req.Duration)
+	if err != nil || taken {
+		return false, err
+	}
+
+	resp, err := tx.Exec(ctx, toSql, args...)
+	if err != nil {
+		return false, err
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return false, err
+	}
+
+	return resp.RowsAffected() > 0, nil
+}
@@ -365,3 +365,69 @@ func (r *DoctorAvailability) DeleteDoctorAvailability(
 		return &doctor_availability.StatusRes{Status: false}, nil
 	}
 }
+
+// BlockLeave stores a whole day unavailable block for every day of the leave, days already blocked
+// for the leave are skipped so approving a leave again is harmless
+func (r *DoctorAvailability) BlockLeave(
+	ctx context.Context,
+	req *doctor_availability.LeaveBlocks,
+) (*doctor_availability.LeaveBlocksRes, error) {
+	ctx, span := otlp.Start(
+		ctx,
+		serviceNameDoctorAvailability,
+		spanNameDoctorAvailabilityRepo+"BlockLeave",
+	)
+	defer span.End()
+
+	// 24:00 ends the block at midnight, the end of the day
+	query := fmt.Sprintf(`INSERT INTO %s (department_id, doctor_id, doctor_date, start_time, end_time, status, leave_id)
+		SELECT $1, $2, day::date, '00:00:00', '24:00:00', 'unavailable', $3
+		FROM generate_series($4::date, $5::date, INTERVAL '1 day') AS day
+		ON CONFLICT (leave_id, doctor_date) WHERE leave_id IS NOT NULL AND deleted_at IS NULL DO NOTHING`,
+		tableNameDoctorAvailability)
+
+	resp, err := r.db.Exec(ctx, query,
+		req.DepartmentId,
+		req.DoctorId,
+		req.LeaveId,
+		req.StartDate.String(),
+		req.EndDate.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &doctor_availability.LeaveBlocksRes{Count: resp.RowsAffected()}, nil
+}
+
+// ReleaseLeave removes the blocks of a cancelled leave
+func (r *DoctorAvailability) ReleaseLeave(
+	ctx context.Context,
+	req *doctor_availability.LeaveReq,
+) (*doctor_availability.LeaveBlocksRes, error) {
+	ctx, span := otlp.Start(
+		ctx,
+		serviceNameDoctorAvailability,
+		spanNameDoctorAvailabilityRepo+"ReleaseLeave",
+	)
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameDoctorAvailability).
+		Set("deleted_at", time.Now()).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"deleted_at": nil,
+			"leave_id":   req.LeaveId,
+		})).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.db.Exec(ctx, toSql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &doctor_availability.LeaveBlocksRes{Count: resp.RowsAffected()}, nil
+}
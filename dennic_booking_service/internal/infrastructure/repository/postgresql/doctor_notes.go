@@ -3,12 +3,16 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
+	"booking_service/internal/entity"
 	"booking_service/internal/entity/doctor_notes"
 	"booking_service/internal/pkg/otlp"
 	"booking_service/internal/pkg/postgres"
+
+	"github.com/jackc/pgx/v4"
 )
 
 const (
@@ -129,6 +133,9 @@ func (r *DoctorNotes) GetDoctorNotes(
 		&upTime,
 		&delTime,
 	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.NewErrNotFound("doctor note")
+		}
 		return nil, err
 	}
 
@@ -0,0 +1,308 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/doctor_reviews"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNameDoctorReviews    = "doctor_reviews"
+	serviceNameDoctorReviews  = "doctor_reviews"
+	spanNameDoctorReviewsRepo = "doctor_reviews"
+)
+
+type DoctorReviews struct {
+	db *postgres.PostgresDB
+}
+
+func NewDoctorReviews(db *postgres.PostgresDB) *DoctorReviews {
+	return &DoctorReviews{
+		db: db,
+	}
+}
+
+func tableColumDoctorReviews() string {
+	return `id,
+			appointment_id,
+			doctor_id::text,
+			patient_id::text,
+			rating,
+			comment,
+			status,
+			COALESCE(moderation_note, ''),
+			moderated_at,
+			created_at,
+			updated_at,
+			deleted_at`
+}
+
+func scanDoctorReview(row pgx.Row) (*doctor_reviews.Review, error) {
+	var (
+		response doctor_reviews.Review
+		modAt    sql.NullTime
+		upAt     sql.NullTime
+		delAt    sql.NullTime
+	)
+
+	if err := row.Scan(
+		&response.Id,
+		&response.AppointmentId,
+		&response.DoctorId,
+		&response.PatientId,
+		&response.Rating,
+		&response.Comment,
+		&response.Status,
+		&response.ModerationNote,
+		&modAt,
+		&response.CreatedAt,
+		&upAt,
+		&delAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if modAt.Valid {
+		response.ModeratedAt = modAt.Time
+	}
+
+	if upAt.Valid {
+		response.UpdatedAt = upAt.Time
+	}
+
+	if delAt.Valid {
+		response.DeletedAt = delAt.Time
+	}
+
+	return &response, nil
+}
+
+func (r *DoctorReviews) CreateReview(ctx context.Context, req *doctor_reviews.CreateReview) (*doctor_reviews.Review, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviewsRepo+"Create")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Insert(tableNameDoctorReviews).
+		Columns(
+			"appointment_id",
+			"doctor_id",
+			"patient_id",
+			"rating",
+			"comment",
+			"status",
+		).
+		Values(
+			req.AppointmentId,
+			req.DoctorId,
+			req.PatientId,
+			req.Rating,
+			req.Comment,
+			doctor_reviews.StatusPending,
+		).
+		Suffix(fmt.Sprintf("ON CONFLICT (appointment_id) WHERE deleted_at IS NULL DO NOTHING RETURNING %s", tableColumDoctorReviews())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := scanDoctorReview(r.db.QueryRow(ctx, toSql, args...))
+	// the appointment already has a review
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrConflict("review of the appointment")
+	}
+
+	return response, err
+}
+
+func (r *DoctorReviews) GetReview(ctx context.Context, req *doctor_reviews.FieldValueReq) (*doctor_reviews.Review, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviewsRepo+"Get")
+	defer span.End()
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumDoctorReviews()).
+		From(tableNameDoctorReviews)
+
+	if !req.DeleteStatus {
+		toSql = toSql.Where(r.db.Sq.Equal("deleted_at", nil))
+	}
+
+	toSqls, args, err := toSql.Where(r.db.Sq.Equal(req.Field, req.Value)).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := scanDoctorReview(r.db.QueryRow(ctx, toSqls, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("review")
+	}
+
+	return response, err
+}
+
+func (r *DoctorReviews) GetAllReviews(ctx context.Context, req *doctor_reviews.GetAllReviews) (*doctor_reviews.ReviewsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviewsRepo+"List")
+	defer span.End()
+
+	filter := map[string]interface{}{}
+	if req.DoctorId != "" {
+		filter["doctor_id"] = req.DoctorId
+	}
+	if req.PatientId != "" {
+		filter["patient_id"] = req.PatientId
+	}
+	if req.Status != "" {
+		filter["status"] = req.Status
+	}
+	if !req.DeleteStatus {
+		filter["deleted_at"] = nil
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumDoctorReviews()).
+		From(tableNameDoctorReviews).
+		Where(r.db.Sq.EqualMany(filter)).
+		OrderBy("created_at DESC", "id DESC")
+
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	countSql, countArgs, err := r.db.Sq.Builder.
+		Select("count(*)").
+		From(tableNameDoctorReviews).
+		Where(r.db.Sq.EqualMany(filter)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var response doctor_reviews.ReviewsType
+	if err = r.db.QueryRow(ctx, countSql, countArgs...).Scan(&response.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		review, err := scanDoctorReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		response.Reviews = append(response.Reviews, review)
+	}
+
+	return &response, rows.Err()
+}
+
+func (r *DoctorReviews) ModerateReview(ctx context.Context, req *doctor_reviews.ModerateReview) (*doctor_reviews.Review, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviewsRepo+"Moderate")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameDoctorReviews).
+		SetMap(map[string]interface{}{
+			"status":          req.Status,
+			"moderation_note": req.ModerationNote,
+			"moderated_at":    time.Now(),
+			"updated_at":      time.Now(),
+		}).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"id":         req.Id,
+			"deleted_at": nil,
+		})).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColumDoctorReviews())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := scanDoctorReview(r.db.QueryRow(ctx, toSql, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("review")
+	}
+
+	return response, err
+}
+
+func (r *DoctorReviews) DeleteReview(ctx context.Context, req *doctor_reviews.FieldValueReq) (*doctor_reviews.StatusRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviewsRepo+"Delete")
+	defer span.End()
+
+	var toSql sq.Sqlizer = r.db.Sq.Builder.
+		Update(tableNameDoctorReviews).
+		Set("deleted_at", time.Now()).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			req.Field:    req.Value,
+			"deleted_at": nil,
+		}))
+
+	if req.DeleteStatus {
+		toSql = r.db.Sq.Builder.
+			Delete(tableNameDoctorReviews).
+			Where(r.db.Sq.Equal(req.Field, req.Value))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return &doctor_reviews.StatusRes{Status: false}, err
+	}
+
+	resp, err := r.db.Exec(ctx, toSqls, args...)
+	if err != nil {
+		return &doctor_reviews.StatusRes{Status: false}, err
+	}
+
+	return &doctor_reviews.StatusRes{Status: resp.RowsAffected() > 0}, nil
+}
+
+func (r *DoctorReviews) GetDoctorRating(ctx context.Context, doctorId string) (*doctor_reviews.DoctorRating, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviewsRepo+"Rating")
+	defer span.End()
+
+	response := doctor_reviews.DoctorRating{
+		DoctorId: doctorId,
+	}
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select("COALESCE(round(avg(rating), 2), 0)::float8", "count(*)").
+		From(tableNameDoctorReviews).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"doctor_id":  doctorId,
+			"status":     doctor_reviews.StatusApproved,
+			"deleted_at": nil,
+		})).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, toSql, args...).Scan(
+		&response.Rating,
+		&response.ReviewCount,
+	); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -8,26 +8,38 @@ import (
 type IBookingStorage interface {
 	Archive() repository.Archive
 	BookedAppointments() repository.BookedAppointments
+	BookingRules() repository.BookingRules
+	DoctorAssignment() repository.DoctorAssignment
 	DoctorAvailability() repository.DoctorAvailability
 	DoctorNotes() repository.DoctorNotes
+	DoctorReviews() repository.DoctorReviews
 	Patients() repository.Patient
+	Reschedule() repository.Reschedule
 }
 
 type BookingStoragePg struct {
 	archive            repository.Archive
 	bookedAppointments repository.BookedAppointments
+	bookingRules       repository.BookingRules
+	doctorAssignment   repository.DoctorAssignment
 	doctorAvailability repository.DoctorAvailability
 	doctorNotes        repository.DoctorNotes
+	doctorReviews      repository.DoctorReviews
 	patients           repository.Patient
+	reschedule         repository.Reschedule
 }
 
 func NewStorage(db *postgres.PostgresDB) IBookingStorage {
 	return &BookingStoragePg{
 		archive:            NewBookingArchive(db),
 		bookedAppointments: NewBookingAppointment(db),
+		bookingRules:       NewBookingRules(db),
+		doctorAssignment:   NewDoctorAssignment(db),
 		doctorAvailability: NewDoctorAvailability(db),
 		doctorNotes:        NewDoctorNotes(db),
+		doctorReviews:      NewDoctorReviews(db),
 		patients:           NewBookingPatients(db),
+		reschedule:         NewReschedule(db),
 	}
 }
 
@@ -39,6 +51,14 @@ func (s *BookingStoragePg) BookedAppointments() repository.BookedAppointments {
 	return s.bookedAppointments
 }
 
+func (s *BookingStoragePg) BookingRules() repository.BookingRules {
+	return s.bookingRules
+}
+
+func (s *BookingStoragePg) DoctorAssignment() repository.DoctorAssignment {
+	return s.doctorAssignment
+}
+
 func (s *BookingStoragePg) DoctorAvailability() repository.DoctorAvailability {
 	return s.doctorAvailability
 }
@@ -47,6 +67,14 @@ func (s *BookingStoragePg) DoctorNotes() repository.DoctorNotes {
 	return s.doctorNotes
 }
 
+func (s *BookingStoragePg) DoctorReviews() repository.DoctorReviews {
+	return s.doctorReviews
+}
+
 func (s *BookingStoragePg) Patients() repository.Patient {
 	return s.patients
 }
+
+func (s *BookingStoragePg) Reschedule() repository.Reschedule {
+	return s.reschedule
+}
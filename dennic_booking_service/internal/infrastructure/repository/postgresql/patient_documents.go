@@ -0,0 +1,263 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/patient_documents"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNamePatientDocuments    = "patient_documents"
+	serviceNamePatientDocuments  = "patient_documents"
+	spanNamePatientDocumentsRepo = "patient_documents"
+)
+
+type PatientDocuments struct {
+	db *postgres.PostgresDB
+}
+
+func NewPatientDocuments(db *postgres.PostgresDB) *PatientDocuments {
+	return &PatientDocuments{
+		db: db,
+	}
+}
+
+func tableColumPatientDocuments() string {
+	return `id,
+			patient_id,
+			user_id,
+			appointment_id,
+			doctor_note_id,
+			document_type,
+			title,
+			object_name,
+			content_type,
+			size,
+			uploaded_at,
+			deleted_at`
+}
+
+// nullId stores an id 0 as NULL, a document not attached to an appointment or a note
+func nullId(value int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: value, Valid: value != 0}
+}
+
+func scanPatientDocument(row pgx.Row) (*patient_documents.Document, error) {
+	var (
+		response      patient_documents.Document
+		appointmentId sql.NullInt64
+		doctorNoteId  sql.NullInt64
+		delAt         sql.NullTime
+	)
+
+	if err := row.Scan(
+		&response.Id,
+		&response.PatientId,
+		&response.UserId,
+		&appointmentId,
+		&doctorNoteId,
+		&response.DocumentType,
+		&response.Title,
+		&response.ObjectName,
+		&response.ContentType,
+		&response.Size,
+		&response.UploadedAt,
+		&delAt,
+	); err != nil {
+		return nil, err
+	}
+
+	response.AppointmentId = appointmentId.Int64
+	response.DoctorNoteId = doctorNoteId.Int64
+
+	if delAt.Valid {
+		response.DeletedAt = delAt.Time
+	}
+
+	return &response, nil
+}
+
+// requesterCondition restricts the documents to the ones of the user, or to the ones the doctor treats:
+// the documents of the appointments and notes of the doctor, and the unattached documents
+// of the patients having an appointment with the doctor
+func requesterCondition(requester patient_documents.Requester) sq.Sqlizer {
+	if requester.UserId != "" {
+		return sq.Eq{"user_id": requester.UserId}
+	}
+	return sq.Expr(fmt.Sprintf(`(
+		EXISTS (SELECT 1 FROM %[2]s WHERE %[2]s.id = %[1]s.appointment_id AND %[2]s.doctor_id = ?)
+		OR EXISTS (SELECT 1 FROM %[3]s WHERE %[3]s.id = %[1]s.doctor_note_id AND %[3]s.doctor_id = ?)
+		OR (%[1]s.appointment_id IS NULL AND %[1]s.doctor_note_id IS NULL AND EXISTS (
+			SELECT 1 FROM %[2]s WHERE %[2]s.patient_id = %[1]s.patient_id AND %[2]s.doctor_id = ? AND %[2]s.deleted_at IS NULL
+		))
+	)`, tableNamePatientDocuments, tableNameAppointment, tableNameDoctorNotes),
+		requester.DoctorId, requester.DoctorId, requester.DoctorId)
+}
+
+func (r *PatientDocuments) CreateDocument(ctx context.Context, req *patient_documents.CreateDocument) (*patient_documents.Document, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePatientDocuments, spanNamePatientDocumentsRepo+"Create")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Insert(tableNamePatientDocuments).
+		Columns(
+			"patient_id",
+			"user_id",
+			"appointment_id",
+			"doctor_note_id",
+			"document_type",
+			"title",
+			"object_name",
+			"content_type",
+			"size",
+		).
+		Values(
+			req.PatientId,
+			req.UserId,
+			nullId(req.AppointmentId),
+			nullId(req.DoctorNoteId),
+			req.DocumentType,
+			req.Title,
+			req.ObjectName,
+			req.ContentType,
+			req.Size,
+		).
+		Suffix(fmt.Sprintf("ON CONFLICT (object_name) WHERE deleted_at IS NULL DO NOTHING RETURNING %s", tableColumPatientDocuments())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := scanPatientDocument(r.db.QueryRow(ctx, toSql, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrConflict("document")
+	}
+
+	return response, err
+}
+
+// GetDocument returns the document when the requester may reach it, it is not found otherwise
+func (r *PatientDocuments) GetDocument(ctx context.Context, req *patient_documents.DocumentReq) (*patient_documents.Document, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePatientDocuments, spanNamePatientDocumentsRepo+"Get")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumPatientDocuments()).
+		From(tableNamePatientDocuments).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"id":         req.Id,
+			"deleted_at": nil,
+		})).
+		Where(requesterCondition(req.Requester)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := scanPatientDocument(r.db.QueryRow(ctx, toSql, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("document")
+	}
+
+	return response, err
+}
+
+func (r *PatientDocuments) GetAllDocuments(ctx context.Context, req *patient_documents.GetAllDocuments) (*patient_documents.DocumentsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePatientDocuments, spanNamePatientDocumentsRepo+"List")
+	defer span.End()
+
+	filter := map[string]interface{}{
+		"deleted_at": nil,
+	}
+	if req.PatientId != "" {
+		filter["patient_id"] = req.PatientId
+	}
+	if req.AppointmentId != 0 {
+		filter["appointment_id"] = req.AppointmentId
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumPatientDocuments()).
+		From(tableNamePatientDocuments).
+		Where(r.db.Sq.EqualMany(filter)).
+		Where(requesterCondition(req.Requester)).
+		OrderBy("uploaded_at DESC", "id DESC")
+
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	countSql, countArgs, err := r.db.Sq.Builder.
+		Select("count(*)").
+		From(tableNamePatientDocuments).
+		Where(r.db.Sq.EqualMany(filter)).
+		Where(requesterCondition(req.Requester)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var response patient_documents.DocumentsType
+	if err = r.db.QueryRow(ctx, countSql, countArgs...).Scan(&response.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		document, err := scanPatientDocument(rows)
+		if err != nil {
+			return nil, err
+		}
+		response.Documents = append(response.Documents, document)
+	}
+
+	return &response, rows.Err()
+}
+
+// DeleteDocument soft deletes the document of the requester
+func (r *PatientDocuments) DeleteDocument(ctx context.Context, req *patient_documents.DocumentReq) (*patient_documents.StatusRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePatientDocuments, spanNamePatientDocumentsRepo+"Delete")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNamePatientDocuments).
+		Set("deleted_at", time.Now()).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"id":         req.Id,
+			"deleted_at": nil,
+		})).
+		Where(requesterCondition(req.Requester)).
+		ToSql()
+	if err != nil {
+		return &patient_documents.StatusRes{Status: false}, err
+	}
+
+	resp, err := r.db.Exec(ctx, toSql, args...)
+	if err != nil {
+		return &patient_documents.StatusRes{Status: false}, err
+	}
+
+	return &patient_documents.StatusRes{Status: resp.RowsAffected() > 0}, nil
+}
@@ -3,12 +3,16 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
+	"booking_service/internal/entity"
 	"booking_service/internal/entity/patients"
 	"booking_service/internal/pkg/otlp"
 	"booking_service/internal/pkg/postgres"
+
+	"github.com/jackc/pgx/v4"
 )
 
 const (
@@ -158,6 +162,9 @@ func (r *BookingPatients) GetPatient(
 		&upTime,
 		&delTime,
 	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.NewErrNotFound("patient")
+		}
 		return nil, err
 	}
 
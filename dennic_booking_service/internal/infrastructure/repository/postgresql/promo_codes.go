@@ -0,0 +1,283 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/promo_codes"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNamePromoCodes    = "promo_codes"
+	serviceNamePromoCodes  = "promo_codes"
+	spanNamePromoCodesRepo = "promo_codes"
+)
+
+type PromoCodes struct {
+	db *postgres.PostgresDB
+}
+
+func NewPromoCodes(db *postgres.PostgresDB) *PromoCodes {
+	return &PromoCodes{
+		db: db,
+	}
+}
+
+func tableColumPromoCodes() string {
+	return `id,
+			code,
+			kind,
+			value,
+			max_uses,
+			used_count,
+			valid_from,
+			valid_until,
+			created_at,
+			updated_at,
+			deleted_at`
+}
+
+// nullTime stores a zero time as NULL, e.g. a promo code valid without an end
+func nullTime(value time.Time) sql.NullTime {
+	return sql.NullTime{Time: value, Valid: !value.IsZero()}
+}
+
+func scanPromoCode(row pgx.Row) (*promo_codes.PromoCode, error) {
+	var (
+		response promo_codes.PromoCode
+		untilAt  sql.NullTime
+		upAt     sql.NullTime
+		delAt    sql.NullTime
+	)
+
+	if err := row.Scan(
+		&response.Id,
+		&response.Code,
+		&response.Kind,
+		&response.Value,
+		&response.MaxUses,
+		&response.UsedCount,
+		&response.ValidFrom,
+		&untilAt,
+		&response.CreatedAt,
+		&upAt,
+		&delAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if untilAt.Valid {
+		response.ValidUntil = untilAt.Time
+	}
+
+	if upAt.Valid {
+		response.UpdatedAt = upAt.Time
+	}
+
+	if delAt.Valid {
+		response.DeletedAt = delAt.Time
+	}
+
+	return &response, nil
+}
+
+func (r *PromoCodes) CreatePromoCode(ctx context.Context, req *promo_codes.CreatePromoCode) (*promo_codes.PromoCode, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodesRepo+"Create")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Insert(tableNamePromoCodes).
+		Columns(
+			"code",
+			"kind",
+			"value",
+			"max_uses",
+			"valid_from",
+			"valid_until",
+		).
+		Values(
+			req.Code,
+			req.Kind,
+			req.Value,
+			req.MaxUses,
+			req.ValidFrom,
+			nullTime(req.ValidUntil),
+		).
+		Suffix(fmt.Sprintf("ON CONFLICT (upper(code)) WHERE deleted_at IS NULL DO NOTHING RETURNING %s", tableColumPromoCodes())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := scanPromoCode(r.db.QueryRow(ctx, toSql, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrConflict("promo code")
+	}
+
+	return response, err
+}
+
+// GetPromoCode matches the field code case insensitively
+func (r *PromoCodes) GetPromoCode(ctx context.Context, req *promo_codes.FieldValueReq) (*promo_codes.PromoCode, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodesRepo+"Get")
+	defer span.End()
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumPromoCodes()).
+		From(tableNamePromoCodes)
+
+	if !req.DeleteStatus {
+		toSql = toSql.Where(r.db.Sq.Equal("deleted_at", nil))
+	}
+	if req.Field == "code" {
+		toSql = toSql.Where("upper(code) = upper(?)", req.Value).
+			OrderBy("deleted_at DESC NULLS FIRST").
+			Limit(1)
+	} else {
+		toSql = toSql.Where(r.db.Sq.Equal(req.Field, req.Value))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := scanPromoCode(r.db.QueryRow(ctx, toSqls, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("promo code")
+	}
+
+	return response, err
+}
+
+func (r *PromoCodes) GetAllPromoCodes(ctx context.Context, req *promo_codes.GetAllPromoCodes) (*promo_codes.PromoCodesType, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodesRepo+"List")
+	defer span.End()
+
+	filter := map[string]interface{}{}
+	if !req.DeleteStatus {
+		filter["deleted_at"] = nil
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumPromoCodes()).
+		From(tableNamePromoCodes).
+		Where(r.db.Sq.EqualMany(filter)).
+		OrderBy("created_at DESC", "id DESC")
+
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	countSql, countArgs, err := r.db.Sq.Builder.
+		Select("count(*)").
+		From(tableNamePromoCodes).
+		Where(r.db.Sq.EqualMany(filter)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var response promo_codes.PromoCodesType
+	if err = r.db.QueryRow(ctx, countSql, countArgs...).Scan(&response.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		promoCode, err := scanPromoCode(rows)
+		if err != nil {
+			return nil, err
+		}
+		response.PromoCodes = append(response.PromoCodes, promoCode)
+	}
+
+	return &response, rows.Err()
+}
+
+// UpdatePromoCode keeps the code and the usage count, a lowered max_uses stops further redemptions only
+func (r *PromoCodes) UpdatePromoCode(ctx context.Context, req *promo_codes.UpdatePromoCode) (*promo_codes.PromoCode, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodesRepo+"Update")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNamePromoCodes).
+		SetMap(map[string]interface{}{
+			"kind":        req.Kind,
+			"value":       req.Value,
+			"max_uses":    req.MaxUses,
+			"valid_from":  req.ValidFrom,
+			"valid_until": nullTime(req.ValidUntil),
+			"updated_at":  time.Now(),
+		}).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"id":         req.Id,
+			"deleted_at": nil,
+		})).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColumPromoCodes())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := scanPromoCode(r.db.QueryRow(ctx, toSql, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("promo code")
+	}
+
+	return response, err
+}
+
+func (r *PromoCodes) DeletePromoCode(ctx context.Context, req *promo_codes.FieldValueReq) (*promo_codes.StatusRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodesRepo+"Delete")
+	defer span.End()
+
+	var toSql sq.Sqlizer = r.db.Sq.Builder.
+		Update(tableNamePromoCodes).
+		Set("deleted_at", time.Now()).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			req.Field:    req.Value,
+			"deleted_at": nil,
+		}))
+
+	// a promo code applied to an appointment is kept for its discount record
+	if req.DeleteStatus {
+		toSql = r.db.Sq.Builder.
+			Delete(tableNamePromoCodes).
+			Where(r.db.Sq.Equal(req.Field, req.Value)).
+			Where(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE promo_code_id = %s.id)", tableNameAppointmentDiscounts, tableNamePromoCodes))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return &promo_codes.StatusRes{Status: false}, err
+	}
+
+	resp, err := r.db.Exec(ctx, toSqls, args...)
+	if err != nil {
+		return &promo_codes.StatusRes{Status: false}, err
+	}
+
+	return &promo_codes.StatusRes{Status: resp.RowsAffected() > 0}, nil
+}
@@ -0,0 +1,257 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	appointment "booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/reschedule"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+)
+
+const (
+	tableNamePatientNotifications = "patient_notifications"
+	serviceNameReschedule         = "reschedule"
+	spanNameRescheduleRepo        = "reschedule"
+)
+
+type Reschedule struct {
+	db *postgres.PostgresDB
+}
+
+func NewReschedule(db *postgres.PostgresDB) *Reschedule {
+	return &Reschedule{
+		db: db,
+	}
+}
+
+func tableColumNotifications() string {
+	return `id,
+			patient_id,
+			appointment_id,
+			kind,
+			message,
+			created_at,
+			read_at`
+}
+
+// ListAffectedAppointments returns the waiting appointments of the doctor overlapping the range
+func (r *Reschedule) ListAffectedAppointments(
+	ctx context.Context,
+	req *reschedule.AffectedReq,
+) ([]*appointment.Appointment, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReschedule, spanNameRescheduleRepo+"ListAffected")
+	defer span.End()
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColums()).
+		From(tableNameAppointment).
+		Where("doctor_id::text = ?", req.DoctorId).
+		Where(r.db.Sq.Equal("deleted_at", nil)).
+		Where(r.db.Sq.Equal("status", "waiting")).
+		Where("appointment_date + appointment_time < ?::timestamp", req.End.Format("2006-01-02 15:04:05")).
+		Where("appointment_date + appointment_time + duration * INTERVAL '1 minute' > ?::timestamp", req.Start.Format("2006-01-02 15:04:05")).
+		OrderBy("appointment_date", "appointment_time")
+
+	if len(req.AppointmentIds) > 0 {
+		toSql = toSql.Where(r.db.Sq.Equal("id", req.AppointmentIds))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAppointments(rows)
+}
+
+// ListBusyIntervals returns the waiting bookings and unavailable blocks of the doctors on the day
+func (r *Reschedule) ListBusyIntervals(
+	ctx context.Context,
+	req *reschedule.BusyIntervalsReq,
+) ([]*reschedule.BusyInterval, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReschedule, spanNameRescheduleRepo+"ListBusy")
+	defer span.End()
+
+	query := fmt.Sprintf(`SELECT doctor_id::text, appointment_time, appointment_time + duration * INTERVAL '1 minute'
+		FROM %s
+		WHERE doctor_id::text = ANY($1)
+			AND appointment_date = $2::date
+			AND deleted_at IS NULL
+			AND status = 'waiting'
+		UNION ALL
+		SELECT doctor_id::text, start_time, end_time
+		FROM %s
+		WHERE doctor_id::text = ANY($1)
+			AND doctor_date = $2::date
+			AND deleted_at IS NULL
+			AND status = 'unavailable'`, tableNameAppointment, tableNameDoctorAvailability)
+
+	rows, err := r.db.Query(ctx, query, req.DoctorIds, req.Date.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var response []*reschedule.BusyInterval
+	for rows.Next() {
+		var interval reschedule.BusyInterval
+		if err := rows.Scan(
+			&interval.DoctorId,
+			&interval.StartTime,
+			&interval.EndTime,
+		); err != nil {
+			return nil, err
+		}
+		response = append(response, &interval)
+	}
+
+	return response, rows.Err()
+}
+
+// ApplyReschedule moves the found proposals and stores a notification for each moved patient
+// in a single transaction, appointments changed in the meantime are skipped and left not applied
+func (r *Reschedule) ApplyReschedule(ctx context.Context, moves []*reschedule.Move) error {
+	ctx, span := otlp.Start(ctx, serviceNameReschedule, spanNameRescheduleRepo+"Apply")
+	defer span.End()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for _, move := range moves {
+		proposal := move.Proposal
+
+		toSql, args, err := r.db.Sq.Builder.
+			Update(tableNameAppointment).
+			SetMap(map[string]interface{}{
+				"doctor_id":         proposal.NewDoctorId,
+				"doctor_service_id": proposal.NewDoctorServiceId,
+				"appointment_date":  proposal.NewAppointmentDate.String(),
+				"appointment_time":  proposal.NewAppointmentTime.Format("15:04:05"),
+				"updated_at":        time.Now(),
+			}).
+			Where(r.db.Sq.EqualMany(map[string]interface{}{
+				"id":               proposal.AppointmentId,
+				"status":           "waiting",
+				"deleted_at":       nil,
+				"appointment_date": proposal.AppointmentDate.String(),
+			})).
+			Where("doctor_id::text = ?", proposal.DoctorId).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		resp, err := tx.Exec(ctx, toSql, args...)
+		if err != nil {
+			return err
+		}
+		if resp.RowsAffected() == 0 {
+			continue
+		}
+		proposal.Applied = true
+
+		toSql, args, err = r.db.Sq.Builder.
+			Insert(tableNamePatientNotifications).
+			Columns("patient_id", "appointment_id", "kind", "message").
+			Values(
+				move.Notification.PatientId,
+				move.Notification.AppointmentId,
+				move.Notification.Kind,
+				move.Notification.Message,
+			).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *Reschedule) GetPatientNotifications(
+	ctx context.Context,
+	req *reschedule.NotificationsReq,
+) (*reschedule.Notifications, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReschedule, spanNameRescheduleRepo+"ListNotifications")
+	defer span.End()
+
+	var response reschedule.Notifications
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumNotifications()).
+		From(tableNamePatientNotifications).
+		Where(r.db.Sq.Equal("patient_id", req.PatientId)).
+		OrderBy("created_at DESC", "id DESC")
+
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	countSql, countArgs, err := r.db.Sq.Builder.
+		Select("count(*)").
+		From(tableNamePatientNotifications).
+		Where(r.db.Sq.Equal("patient_id", req.PatientId)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, countSql, countArgs...).Scan(&response.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			notification reschedule.Notification
+			readAt       sql.NullTime
+		)
+		if err := rows.Scan(
+			&notification.Id,
+			&notification.PatientId,
+			&notification.AppointmentId,
+			&notification.Kind,
+			&notification.Message,
+			&notification.CreatedAt,
+			&readAt,
+		); err != nil {
+			return nil, err
+		}
+
+		if readAt.Valid {
+			notification.ReadAt = readAt.Time
+		}
+
+		response.Notifications = append(response.Notifications, &notification)
+	}
+
+	return &response, rows.Err()
+}
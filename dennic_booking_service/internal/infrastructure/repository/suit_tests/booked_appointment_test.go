@@ -1,6 +1,7 @@
 package suit_tests
 
 import (
+	"booking_service/internal/entity"
 	"booking_service/internal/entity/booked_appointments"
 	"booking_service/internal/entity/patients"
 	repo "booking_service/internal/infrastructure/repository/postgresql"
@@ -102,6 +103,9 @@ func (s *BookingAppointmentTestSite) TestUserCRUD() {
 	s.Suite.NoError(err)
 	s.Suite.NotNil(getRes)
 	s.Suite.Equal(getRes.Id, createRes.Id)
+	s.Suite.Equal(createRes.ResourceId, getRes.ResourceId)
+	s.Suite.Equal(createRes.PromoCode, getRes.PromoCode)
+	s.Suite.Equal(createRes.DiscountAmount, getRes.DiscountAmount)
 	s.Suite.Equal(getRes.DoctorId, createRes.DoctorId)
 	s.Suite.Equal(getRes.PatientId, createRes.PatientId)
 	s.Suite.Equal(getRes.AppointmentDate, createRes.AppointmentDate)
@@ -173,6 +177,261 @@ func (s *BookingAppointmentTestSite) TestUserCRUD() {
 	s.Suite.Equal(hardDeleteRes.Status, true)
 }
 
+func (s *BookingAppointmentTestSite) TestIdempotencyKey() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patient := &patients.CreatedPatient{
+		Id:             uuid.New().String(),
+		FirstName:      "Husanboy",
+		LastName:       "Gofurov",
+		BirthDate:      date.Today(),
+		Gender:         "male",
+		BloodGroup:     "A+",
+		PhoneNumber:    "+998950230607",
+		City:           "Andijon",
+		Country:        "Uzbekistan",
+		Address:        "Shahrixon",
+		PatientProblem: "Now Problem",
+	}
+	_, err := s.Patient.CreatePatient(ctx, patient)
+	s.Suite.NoError(err)
+
+	appTime, _ := time.Parse("15:04:05", "12:12:12")
+	createReq := booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		DoctorId:        uuid.New().String(),
+		PatientId:       patient.Id,
+		ServiceId:       uuid.New().String(),
+		AppointmentDate: date.Today().Add(1),
+		AppointmentTime: appTime,
+		Duration:        30,
+		Key:             "ABC",
+		ExpiresAt:       time.Now().Add(time.Hour),
+		Status:          "waiting",
+		PaymentType:     "cash",
+		PaymentAmount:   100000,
+		PatientProblem:  "Now Problem",
+		IdempotencyKey: booked_appointments.IdempotencyKey{
+			Key:       uuid.New().String(),
+			ExpiresAt: time.Now().Add(time.Hour),
+		},
+	}
+
+	createRes, err := s.Repository.CreateAppointment(ctx, &createReq)
+	s.Suite.NoError(err)
+	s.Suite.NotNil(createRes)
+
+	getRes, err := s.Repository.GetAppointmentByIdempotencyKey(ctx, createReq.IdempotencyKey.Key)
+	s.Suite.NoError(err)
+	s.Suite.Equal(getRes.Id, createRes.Id)
+
+	_, err = s.Repository.CreateAppointment(ctx, &createReq)
+	s.Suite.Error(err)
+
+	_, err = s.Repository.GetAppointmentByIdempotencyKey(ctx, uuid.New().String())
+	s.Suite.ErrorIs(err, entity.ErrorNotFound)
+
+	var errNotFound *entity.ErrNotFound
+	_, err = s.Repository.GetAppointment(ctx, &booked_appointments.FieldValueReq{
+		Field: "id",
+		Value: "0",
+	})
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	delRes, err := s.Repository.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.Itoa(int(createRes.Id)),
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(delRes.Status, true)
+
+	delPatient, err := s.Patient.DeletePatient(ctx, &patients.FieldValueReq{
+		Field:        "id",
+		Value:        patient.Id,
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(delPatient.Status, true)
+}
+
+func (s *BookingAppointmentTestSite) TestResourceReservation() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patient := &patients.CreatedPatient{
+		Id:             uuid.New().String(),
+		FirstName:      "Husanboy",
+		LastName:       "Gofurov",
+		BirthDate:      date.Today(),
+		Gender:         "male",
+		BloodGroup:     "A+",
+		PhoneNumber:    "+998950230608",
+		City:           "Andijon",
+		Country:        "Uzbekistan",
+		Address:        "Shahrixon",
+		PatientProblem: "Now Problem",
+	}
+	_, err := s.Patient.CreatePatient(ctx, patient)
+	s.Suite.NoError(err)
+
+	firstRoom, secondRoom := uuid.New().String(), uuid.New().String()
+	newReq := func(at string) *booked_appointments.CreateAppointment {
+		appTime, _ := time.Parse("15:04:05", at)
+		return &booked_appointments.CreateAppointment{
+			DepartmentId:    uuid.New().String(),
+			DoctorId:        uuid.New().String(),
+			PatientId:       patient.Id,
+			ServiceId:       uuid.New().String(),
+			AppointmentDate: date.Today().Add(1),
+			AppointmentTime: appTime,
+			Duration:        30,
+			Key:             "ABC",
+			ExpiresAt:       time.Now().Add(time.Hour),
+			Status:          "waiting",
+			PaymentType:     "cash",
+			PaymentAmount:   100000,
+			PatientProblem:  "Now Problem",
+			Resource: booked_appointments.Resource{
+				Type:       "test-" + firstRoom[:8],
+				Candidates: []string{firstRoom, secondRoom},
+			},
+		}
+	}
+
+	// the first free candidate is reserved
+	first, err := s.Repository.CreateAppointment(ctx, newReq("10:00:00"))
+	s.Suite.NoError(err)
+	s.Suite.Equal(firstRoom, first.ResourceId)
+
+	second, err := s.Repository.CreateAppointment(ctx, newReq("10:15:00"))
+	s.Suite.NoError(err)
+	s.Suite.Equal(secondRoom, second.ResourceId)
+
+	// both rooms are reserved for an overlapping slot
+	_, err = s.Repository.CreateAppointment(ctx, newReq("10:20:00"))
+	var errBlocked *entity.ErrBookingBlocked
+	s.Suite.ErrorAs(err, &errBlocked)
+	s.Suite.Equal(booked_appointments.CodeResourceUnavailable, errBlocked.Code)
+
+	// the first room is free again once its appointment is over
+	third, err := s.Repository.CreateAppointment(ctx, newReq("10:30:00"))
+	s.Suite.NoError(err)
+	s.Suite.Equal(firstRoom, third.ResourceId)
+
+	for _, id := range []int64{first.Id, second.Id, third.Id} {
+		_, err = s.Repository.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+			Field:        "id",
+			Value:        strconv.Itoa(int(id)),
+			DeleteStatus: true,
+		})
+		s.Suite.NoError(err)
+	}
+
+	delPatient, err := s.Patient.DeletePatient(ctx, &patients.FieldValueReq{
+		Field:        "id",
+		Value:        patient.Id,
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(delPatient.Status, true)
+}
+
+func (s *BookingAppointmentTestSite) TestDoctorAgenda() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patient := &patients.CreatedPatient{
+		Id:             uuid.New().String(),
+		FirstName:      "Husanboy",
+		LastName:       "Gofurov",
+		BirthDate:      date.Today(),
+		Gender:         "male",
+		BloodGroup:     "A+",
+		PhoneNumber:    "+998950230609",
+		City:           "Andijon",
+		Country:        "Uzbekistan",
+		Address:        "Shahrixon",
+		PatientProblem: "Now Problem",
+	}
+	_, err := s.Patient.CreatePatient(ctx, patient)
+	s.Suite.NoError(err)
+
+	doctorId := uuid.New().String()
+	var created []*booked_appointments.Appointment
+	for _, clock := range []string{"15:00:00", "09:00:00"} {
+		appTime, _ := time.Parse("15:04:05", clock)
+		createRes, err := s.Repository.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+			DepartmentId:    uuid.New().String(),
+			DoctorId:        doctorId,
+			PatientId:       patient.Id,
+			AppointmentDate: date.Today(),
+			AppointmentTime: appTime,
+			Duration:        30,
+			Key:             "ABC",
+			ExpiresAt:       time.Now(),
+			Status:          "waiting",
+			PaymentType:     "cash",
+			PaymentAmount:   100000,
+			PatientProblem:  "Now Problem",
+		})
+		s.Suite.NoError(err)
+		created = append(created, createRes)
+	}
+
+	// the agenda of the day is in the order the appointments take place
+	today := date.Today().UTC()
+	agenda, err := s.Repository.ListDoctorAppointments(ctx, &booked_appointments.DoctorAppointmentsReq{
+		DoctorId: doctorId,
+		From:     today,
+		To:       today.AddDate(0, 0, 1),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), agenda.Count)
+	s.Suite.Equal(created[1].Id, agenda.Appointments[0].Id)
+	s.Suite.Equal(created[0].Id, agenda.Appointments[1].Id)
+
+	attended, err := s.Repository.SetAttendance(ctx, &booked_appointments.AttendanceReq{
+		Id:       created[1].Id,
+		DoctorId: doctorId,
+		Status:   booked_appointments.StatusAttended,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(booked_appointments.StatusAttended, attended.Status)
+
+	// another doctor can not mark the appointment
+	_, err = s.Repository.SetAttendance(ctx, &booked_appointments.AttendanceReq{
+		Id:       created[0].Id,
+		DoctorId: uuid.New().String(),
+		Status:   booked_appointments.StatusNoShow,
+	})
+	s.Suite.ErrorIs(err, entity.ErrorNotFound)
+
+	waiting, err := s.Repository.ListDoctorAppointments(ctx, &booked_appointments.DoctorAppointmentsReq{
+		DoctorId: doctorId,
+		From:     today,
+		Status:   "waiting",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), waiting.Count)
+
+	for _, createRes := range created {
+		_, err = s.Repository.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+			Field:        "id",
+			Value:        strconv.Itoa(int(createRes.Id)),
+			DeleteStatus: true,
+		})
+		s.Suite.NoError(err)
+	}
+	_, err = s.Patient.DeletePatient(ctx, &patients.FieldValueReq{
+		Field:        "id",
+		Value:        patient.Id,
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+}
+
 func (s *BookingAppointmentTestSite) TearDownSuite() {
 	s.CleanUpFunc()
 }
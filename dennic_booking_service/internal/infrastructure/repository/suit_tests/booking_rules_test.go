@@ -0,0 +1,175 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/booking_rules"
+	"booking_service/internal/entity/patients"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+)
+
+type BookingRulesTestSite struct {
+	suite.Suite
+	Repository  *repo.BookingRules
+	Appointment *repo.BookingAppointment
+	Patient     *repo.BookingPatients
+	CleanUpFunc func()
+}
+
+func (s *BookingRulesTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewBookingRules(pgPool)
+	s.Appointment = repo.NewBookingAppointment(pgPool)
+	s.Patient = repo.NewBookingPatients(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *BookingRulesTestSite) TestBookingRules() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	oldRules, err := s.Repository.GetBookingRules(ctx)
+	s.Suite.NoError(err)
+	s.Suite.NotNil(oldRules)
+
+	upReq := &booking_rules.UpdateBookingRules{
+		MaxActivePerPatient: 3,
+		MaxActivePerDoctor:  1,
+		MaxPerDay:           1,
+		BlockExpiredLicense: true,
+	}
+	upRes, err := s.Repository.UpdateBookingRules(ctx, upReq)
+	s.Suite.NoError(err)
+	s.Suite.NotNil(upRes)
+	s.Suite.Equal(upRes.MaxActivePerPatient, upReq.MaxActivePerPatient)
+	s.Suite.Equal(upRes.MaxActivePerDoctor, upReq.MaxActivePerDoctor)
+	s.Suite.Equal(upRes.MaxPerDay, upReq.MaxPerDay)
+	s.Suite.True(upRes.BlockExpiredLicense)
+
+	getRes, err := s.Repository.GetBookingRules(ctx)
+	s.Suite.NoError(err)
+	s.Suite.Equal(getRes.MaxActivePerPatient, upReq.MaxActivePerPatient)
+	s.Suite.Equal(getRes.MaxActivePerDoctor, upReq.MaxActivePerDoctor)
+	s.Suite.Equal(getRes.MaxPerDay, upReq.MaxPerDay)
+	s.Suite.True(getRes.BlockExpiredLicense)
+
+	patient := &patients.CreatedPatient{
+		Id:             uuid.New().String(),
+		FirstName:      "Husanboy",
+		LastName:       "Gofurov",
+		BirthDate:      date.Today(),
+		Gender:         "male",
+		BloodGroup:     "A+",
+		PhoneNumber:    "+998950230606",
+		City:           "Andijon",
+		Country:        "Uzbekistan",
+		Address:        "Shahrixon",
+		PatientProblem: "Now Problem",
+	}
+	_, err = s.Patient.CreatePatient(ctx, patient)
+	s.Suite.NoError(err)
+
+	appDate := date.Today().Add(7)
+	appTime, _ := time.Parse("15:04:05", "12:00:00")
+	doctorId := uuid.New().String()
+	createRes, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		DoctorId:        doctorId,
+		PatientId:       patient.Id,
+		ServiceId:       uuid.New().String(),
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+		Key:             "ABC",
+		ExpiresAt:       time.Now().Add(time.Hour),
+		Status:          "waiting",
+		PaymentType:     "cash",
+		PaymentAmount:   100000,
+		PatientProblem:  "Now Problem",
+	})
+	s.Suite.NoError(err)
+	s.Suite.NotNil(createRes)
+
+	count, err := s.Repository.CountActiveAppointments(ctx, &booking_rules.CountActiveReq{
+		PatientId:       patient.Id,
+		DoctorId:        doctorId,
+		AppointmentDate: appDate,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), count.Total)
+	s.Suite.Equal(int64(1), count.WithDoctor)
+	s.Suite.Equal(int64(1), count.OnDay)
+
+	count, err = s.Repository.CountActiveAppointments(ctx, &booking_rules.CountActiveReq{
+		PatientId:       patient.Id,
+		DoctorId:        uuid.New().String(),
+		AppointmentDate: appDate.Add(1),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), count.Total)
+	s.Suite.Equal(int64(0), count.WithDoctor)
+	s.Suite.Equal(int64(0), count.OnDay)
+
+	// the limits are counted in the transaction of the new appointment
+	_, err = s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		DoctorId:        doctorId,
+		PatientId:       patient.Id,
+		ServiceId:       uuid.New().String(),
+		AppointmentDate: appDate,
+		AppointmentTime: appTime.Add(time.Hour),
+		Duration:        30,
+		Key:             "ABD",
+		ExpiresAt:       time.Now().Add(time.Hour),
+		Status:          "waiting",
+		PaymentType:     "cash",
+		PaymentAmount:   100000,
+		Limits:          booked_appointments.Limits{MaxActivePerDoctor: upReq.MaxActivePerDoctor},
+	})
+	var errLimit *entity.ErrBookingLimit
+	if s.Suite.ErrorAs(err, &errLimit) {
+		s.Suite.Equal(booking_rules.CodeActivePerDoctor, errLimit.Code)
+	}
+
+	delRes, err := s.Appointment.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.Itoa(int(createRes.Id)),
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(delRes.Status, true)
+
+	delPatient, err := s.Patient.DeletePatient(ctx, &patients.FieldValueReq{
+		Field:        "id",
+		Value:        patient.Id,
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(delPatient.Status, true)
+
+	_, err = s.Repository.UpdateBookingRules(ctx, &booking_rules.UpdateBookingRules{
+		MaxActivePerPatient: oldRules.MaxActivePerPatient,
+		MaxActivePerDoctor:  oldRules.MaxActivePerDoctor,
+		MaxPerDay:           oldRules.MaxPerDay,
+		BlockExpiredLicense: oldRules.BlockExpiredLicense,
+	})
+	s.Suite.NoError(err)
+}
+
+func (s *BookingRulesTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestBookingRulesTestSuite(t *testing.T) {
+	suite.Run(t, new(BookingRulesTestSite))
+}
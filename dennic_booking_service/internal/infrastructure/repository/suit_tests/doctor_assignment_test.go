@@ -0,0 +1,215 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/doctor_assignment"
+	"booking_service/internal/entity/patients"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+)
+
+type DoctorAssignmentTestSite struct {
+	suite.Suite
+	Repository  *repo.DoctorAssignment
+	Appointment *repo.BookingAppointment
+	Patient     *repo.BookingPatients
+	CleanUpFunc func()
+}
+
+func (s *DoctorAssignmentTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewDoctorAssignment(pgPool)
+	s.Appointment = repo.NewBookingAppointment(pgPool)
+	s.Patient = repo.NewBookingPatients(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *DoctorAssignmentTestSite) TestDoctorAssignment() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patient := &patients.CreatedPatient{
+		Id:             uuid.New().String(),
+		FirstName:      "Husanboy",
+		LastName:       "Gofurov",
+		BirthDate:      date.Today(),
+		Gender:         "male",
+		BloodGroup:     "A+",
+		PhoneNumber:    "+998950230607",
+		City:           "Andijon",
+		Country:        "Uzbekistan",
+		Address:        "Shahrixon",
+		PatientProblem: "Now Problem",
+	}
+	_, err := s.Patient.CreatePatient(ctx, patient)
+	s.Suite.NoError(err)
+
+	appDate := date.Today().Add(1)
+	appTime, _ := time.Parse("15:04:05", "10:00:00")
+	createRes, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		PatientId:       patient.Id,
+		ServiceId:       uuid.New().String(),
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+		Key:             "ABC",
+		ExpiresAt:       time.Now().Add(time.Hour),
+		Status:          "waiting",
+		PaymentType:     "cash",
+		PaymentAmount:   100000,
+		PatientProblem:  "Now Problem",
+	})
+	s.Suite.NoError(err)
+	s.Suite.NotNil(createRes)
+	s.Suite.Equal(createRes.DoctorId, "")
+
+	unassigned, err := s.Repository.ListUnassignedAppointments(ctx, &doctor_assignment.UnassignedReq{
+		Until: appDate,
+	})
+	s.Suite.NoError(err)
+	var found bool
+	for _, app := range unassigned {
+		if app.Id == createRes.Id {
+			found = true
+		}
+	}
+	s.Suite.True(found)
+
+	doctorId, otherDoctorId := uuid.New().String(), uuid.New().String()
+	serviceId := uuid.New().String()
+	ok, err := s.Repository.AssignDoctor(ctx, &doctor_assignment.AssignDoctorReq{
+		AppointmentId:   createRes.Id,
+		DoctorId:        doctorId,
+		DoctorServiceId: serviceId,
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(ok)
+
+	// an appointment is assigned only once
+	ok, err = s.Repository.AssignDoctor(ctx, &doctor_assignment.AssignDoctorReq{
+		AppointmentId:   createRes.Id,
+		DoctorId:        otherDoctorId,
+		DoctorServiceId: serviceId,
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+	})
+	s.Suite.NoError(err)
+	s.Suite.False(ok)
+
+	getRes, err := s.Appointment.GetAppointment(ctx, &booked_appointments.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.Itoa(int(createRes.Id)),
+		DeleteStatus: false,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(getRes.DoctorId, doctorId)
+	s.Suite.Equal(getRes.ServiceId, serviceId)
+
+	slotStart, _ := time.Parse("15:04:05", "10:15:00")
+	loads, err := s.Repository.GetDoctorsLoad(ctx, &doctor_assignment.DoctorsLoadReq{
+		DoctorIds: []string{doctorId, otherDoctorId},
+		Date:      appDate,
+		StartTime: slotStart,
+		EndTime:   slotStart.Add(30 * time.Minute),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(loads, 2)
+	for _, load := range loads {
+		switch load.DoctorId {
+		case doctorId:
+			s.Suite.Equal(int64(1), load.DayLoad)
+			s.Suite.True(load.Busy)
+		case otherDoctorId:
+			s.Suite.Equal(int64(0), load.DayLoad)
+			s.Suite.False(load.Busy)
+		}
+		s.Suite.False(load.Unavailable)
+	}
+
+	// a doctor booked for an overlapping slot meanwhile is not assigned
+	overlapping, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		PatientId:       patient.Id,
+		ServiceId:       uuid.New().String(),
+		AppointmentDate: appDate,
+		AppointmentTime: slotStart,
+		Duration:        30,
+		Key:             "ABD",
+		ExpiresAt:       time.Now().Add(time.Hour),
+		Status:          "waiting",
+		PaymentType:     "cash",
+		PaymentAmount:   100000,
+		PatientProblem:  "Now Problem",
+	})
+	s.Suite.NoError(err)
+	ok, err = s.Repository.AssignDoctor(ctx, &doctor_assignment.AssignDoctorReq{
+		AppointmentId:   overlapping.Id,
+		DoctorId:        doctorId,
+		DoctorServiceId: serviceId,
+		AppointmentDate: appDate,
+		AppointmentTime: slotStart,
+		Duration:        30,
+	})
+	s.Suite.NoError(err)
+	s.Suite.False(ok)
+
+	// and a booking of the doctor for an overlapping slot is rejected
+	_, err = s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		DoctorId:        doctorId,
+		PatientId:       patient.Id,
+		ServiceId:       serviceId,
+		AppointmentDate: appDate,
+		AppointmentTime: slotStart,
+		Duration:        30,
+		Key:             "ABE",
+		ExpiresAt:       time.Now().Add(time.Hour),
+		Status:          "waiting",
+		PaymentType:     "cash",
+		PaymentAmount:   100000,
+		PatientProblem:  "Now Problem",
+	})
+	var errConflict *entity.ErrConflict
+	s.Suite.ErrorAs(err, &errConflict)
+
+	for _, id := range []int64{createRes.Id, overlapping.Id} {
+		delRes, err := s.Appointment.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+			Field:        "id",
+			Value:        strconv.Itoa(int(id)),
+			DeleteStatus: true,
+		})
+		s.Suite.NoError(err)
+		s.Suite.Equal(delRes.Status, true)
+	}
+
+	delPatient, err := s.Patient.DeletePatient(ctx, &patients.FieldValueReq{
+		Field:        "id",
+		Value:        patient.Id,
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(delPatient.Status, true)
+}
+
+func (s *DoctorAssignmentTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestDoctorAssignmentTestSuite(t *testing.T) {
+	suite.Run(t, new(DoctorAssignmentTestSite))
+}
@@ -121,6 +121,41 @@ func (s *DoctorAvailabilityTestSite) TestUserCRUD() {
 
 }
 
+func (s *DoctorAvailabilityTestSite) TestLeaveBlocks() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	startDate, _ := date.AutoParse("1231-03-01")
+	endDate, _ := date.AutoParse("1231-03-03")
+	req := &doctor_availability.LeaveBlocks{
+		LeaveId:      time.Now().UnixNano(),
+		DepartmentId: uuid.New().String(),
+		DoctorId:     uuid.New().String(),
+		StartDate:    startDate,
+		EndDate:      endDate,
+	}
+
+	blocked, err := s.Repository.BlockLeave(ctx, req)
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(3), blocked.Count)
+
+	// the days are already blocked for the leave
+	blocked, err = s.Repository.BlockLeave(ctx, req)
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(0), blocked.Count)
+
+	released, err := s.Repository.ReleaseLeave(ctx, &doctor_availability.LeaveReq{LeaveId: req.LeaveId})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(3), released.Count)
+
+	_, err = s.Repository.DeleteDoctorAvailability(ctx, &doctor_availability.FieldValueReq{
+		Field:        "leave_id",
+		Value:        strconv.FormatInt(req.LeaveId, 10),
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+}
+
 func (s *DoctorAvailabilityTestSite) TearDownSuite() {
 	s.CleanUpFunc()
 }
@@ -0,0 +1,152 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/doctor_reviews"
+	"booking_service/internal/entity/patients"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+)
+
+type DoctorReviewsTestSite struct {
+	suite.Suite
+	Repository  *repo.DoctorReviews
+	Appointment *repo.BookingAppointment
+	Patient     *repo.BookingPatients
+	CleanUpFunc func()
+}
+
+func (s *DoctorReviewsTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewDoctorReviews(pgPool)
+	s.Appointment = repo.NewBookingAppointment(pgPool)
+	s.Patient = repo.NewBookingPatients(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *DoctorReviewsTestSite) TestReviewModeration() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patientRes, err := s.Patient.CreatePatient(ctx, &patients.CreatedPatient{
+		Id:          uuid.New().String(),
+		FirstName:   "Husan",
+		LastName:    "Gofurov",
+		BirthDate:   date.Today(),
+		Gender:      "male",
+		BloodGroup:  "A+",
+		PhoneNumber: "+998950230605",
+	})
+	s.Suite.NoError(err)
+
+	appDate, _ := date.AutoParse("1221-12-12")
+	appTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 12:12:12")
+	appointmentRes, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		DoctorId:        uuid.New().String(),
+		PatientId:       patientRes.Id,
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+		ExpiresAt:       appTime,
+		Status:          "attended",
+		PaymentType:     "cash",
+		PaymentAmount:   100000,
+	})
+	s.Suite.NoError(err)
+
+	createReq := &doctor_reviews.CreateReview{
+		AppointmentId: appointmentRes.Id,
+		DoctorId:      appointmentRes.DoctorId,
+		PatientId:     patientRes.Id,
+		Rating:        4,
+		Comment:       "Good doctor",
+	}
+	review, err := s.Repository.CreateReview(ctx, createReq)
+	s.Suite.NoError(err)
+	s.Suite.NotNil(review)
+	s.Suite.Equal(createReq.DoctorId, review.DoctorId)
+	s.Suite.Equal(createReq.Rating, review.Rating)
+	s.Suite.Equal(doctor_reviews.StatusPending, review.Status)
+
+	// an appointment can be reviewed once
+	_, err = s.Repository.CreateReview(ctx, createReq)
+	s.Suite.Error(err)
+
+	// pending reviews are not counted
+	rating, err := s.Repository.GetDoctorRating(ctx, review.DoctorId)
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(0), rating.ReviewCount)
+
+	moderated, err := s.Repository.ModerateReview(ctx, &doctor_reviews.ModerateReview{
+		Id:     review.Id,
+		Status: doctor_reviews.StatusApproved,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(doctor_reviews.StatusApproved, moderated.Status)
+	s.Suite.False(moderated.ModeratedAt.IsZero())
+
+	rating, err = s.Repository.GetDoctorRating(ctx, review.DoctorId)
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), rating.ReviewCount)
+	s.Suite.Equal(float64(4), rating.Rating)
+
+	reviews, err := s.Repository.GetAllReviews(ctx, &doctor_reviews.GetAllReviews{
+		Page:     1,
+		Limit:    10,
+		DoctorId: review.DoctorId,
+		Status:   doctor_reviews.StatusApproved,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), reviews.Count)
+
+	deleteRes, err := s.Repository.DeleteReview(ctx, &doctor_reviews.FieldValueReq{
+		Field: "id",
+		Value: strconv.FormatInt(review.Id, 10),
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(deleteRes.Status)
+
+	rating, err = s.Repository.GetDoctorRating(ctx, review.DoctorId)
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(0), rating.ReviewCount)
+
+	deleteRes, err = s.Repository.DeleteReview(ctx, &doctor_reviews.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.FormatInt(review.Id, 10),
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(deleteRes.Status)
+
+	_, err = s.Appointment.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.FormatInt(appointmentRes.Id, 10),
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+
+	_, err = s.Patient.DeletePatient(ctx, &patients.FieldValueReq{
+		Field:        "id",
+		Value:        patientRes.Id,
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+}
+
+func (s *DoctorReviewsTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestDoctorReviewsTestSuite(t *testing.T) {
+	suite.Run(t, new(DoctorReviewsTestSite))
+}
@@ -0,0 +1,185 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/patient_documents"
+	"booking_service/internal/entity/patients"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+)
+
+type PatientDocumentsTestSite struct {
+	suite.Suite
+	Repository  *repo.PatientDocuments
+	Appointment *repo.BookingAppointment
+	Patient     *repo.BookingPatients
+	CleanUpFunc func()
+}
+
+func (s *PatientDocumentsTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewPatientDocuments(pgPool)
+	s.Appointment = repo.NewBookingAppointment(pgPool)
+	s.Patient = repo.NewBookingPatients(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *PatientDocumentsTestSite) TestDocumentAccess() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patientRes, err := s.Patient.CreatePatient(ctx, &patients.CreatedPatient{
+		Id:          uuid.New().String(),
+		FirstName:   "Husan",
+		LastName:    "Gofurov",
+		BirthDate:   date.Today(),
+		Gender:      "male",
+		BloodGroup:  "A+",
+		PhoneNumber: "+998950230605",
+	})
+	s.Suite.NoError(err)
+
+	appDate, _ := date.AutoParse("1221-12-12")
+	appTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 12:12:12")
+	appointmentRes, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		DoctorId:        uuid.New().String(),
+		PatientId:       patientRes.Id,
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+		ExpiresAt:       appTime,
+		Status:          "waiting",
+		PaymentType:     "cash",
+		PaymentAmount:   100000,
+	})
+	s.Suite.NoError(err)
+
+	userId := uuid.New().String()
+	createReq := &patient_documents.CreateDocument{
+		PatientId:     patientRes.Id,
+		UserId:        userId,
+		AppointmentId: appointmentRes.Id,
+		DocumentType:  patient_documents.TypeLabResult,
+		Title:         "Blood test",
+		ObjectName:    userId + "/" + uuid.New().String() + ".pdf",
+		ContentType:   "application/pdf",
+		Size:          2048,
+	}
+	document, err := s.Repository.CreateDocument(ctx, createReq)
+	s.Suite.NoError(err)
+	s.Suite.NotNil(document)
+	s.Suite.Equal(createReq.AppointmentId, document.AppointmentId)
+	s.Suite.Equal(int64(0), document.DoctorNoteId)
+	s.Suite.Equal(createReq.ObjectName, document.ObjectName)
+	s.Suite.False(document.UploadedAt.IsZero())
+
+	// an object is attached once
+	_, err = s.Repository.CreateDocument(ctx, createReq)
+	s.Suite.Error(err)
+
+	// the owner and the doctor of the appointment reach the document
+	for _, requester := range []patient_documents.Requester{{UserId: userId}, {DoctorId: appointmentRes.DoctorId}} {
+		got, err := s.Repository.GetDocument(ctx, &patient_documents.DocumentReq{
+			Id:        document.Id,
+			Requester: requester,
+		})
+		s.Suite.NoError(err)
+		s.Suite.Equal(document.Id, got.Id)
+
+		documents, err := s.Repository.GetAllDocuments(ctx, &patient_documents.GetAllDocuments{
+			AppointmentId: appointmentRes.Id,
+			Page:          1,
+			Limit:         10,
+			Requester:     requester,
+		})
+		s.Suite.NoError(err)
+		s.Suite.Equal(int64(1), documents.Count)
+	}
+
+	// another user or doctor does not
+	for _, requester := range []patient_documents.Requester{{UserId: uuid.New().String()}, {DoctorId: uuid.New().String()}} {
+		_, err = s.Repository.GetDocument(ctx, &patient_documents.DocumentReq{
+			Id:        document.Id,
+			Requester: requester,
+		})
+		s.Suite.Error(err)
+
+		documents, err := s.Repository.GetAllDocuments(ctx, &patient_documents.GetAllDocuments{
+			PatientId: patientRes.Id,
+			Requester: requester,
+		})
+		s.Suite.NoError(err)
+		s.Suite.Equal(int64(0), documents.Count)
+	}
+
+	// an unattached document reaches the doctors the patient has an appointment with
+	unattachedReq := *createReq
+	unattachedReq.AppointmentId = 0
+	unattachedReq.DocumentType = patient_documents.TypeScan
+	unattachedReq.ObjectName = userId + "/" + uuid.New().String() + ".png"
+	unattached, err := s.Repository.CreateDocument(ctx, &unattachedReq)
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(0), unattached.AppointmentId)
+
+	documents, err := s.Repository.GetAllDocuments(ctx, &patient_documents.GetAllDocuments{
+		PatientId: patientRes.Id,
+		Requester: patient_documents.Requester{DoctorId: appointmentRes.DoctorId},
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), documents.Count)
+
+	// only the owner deletes
+	deleteRes, err := s.Repository.DeleteDocument(ctx, &patient_documents.DocumentReq{
+		Id:        document.Id,
+		Requester: patient_documents.Requester{UserId: uuid.New().String()},
+	})
+	s.Suite.NoError(err)
+	s.Suite.False(deleteRes.Status)
+
+	for _, id := range []int64{document.Id, unattached.Id} {
+		deleteRes, err = s.Repository.DeleteDocument(ctx, &patient_documents.DocumentReq{
+			Id:        id,
+			Requester: patient_documents.Requester{UserId: userId},
+		})
+		s.Suite.NoError(err)
+		s.Suite.True(deleteRes.Status)
+	}
+
+	_, err = s.Repository.GetDocument(ctx, &patient_documents.DocumentReq{
+		Id:        document.Id,
+		Requester: patient_documents.Requester{UserId: userId},
+	})
+	s.Suite.Error(err)
+
+	_, err = s.Appointment.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.FormatInt(appointmentRes.Id, 10),
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+
+	_, err = s.Patient.DeletePatient(ctx, &patients.FieldValueReq{
+		Field:        "id",
+		Value:        patientRes.Id,
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+}
+
+func (s *PatientDocumentsTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestPatientDocumentsTestSuite(t *testing.T) {
+	suite.Run(t, new(PatientDocumentsTestSite))
+}
@@ -0,0 +1,205 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/patients"
+	"booking_service/internal/entity/promo_codes"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+)
+
+type PromoCodesTestSite struct {
+	suite.Suite
+	Repository   *repo.PromoCodes
+	Appointments *repo.BookingAppointment
+	Patient      *repo.BookingPatients
+	CleanUpFunc  func()
+}
+
+func (s *PromoCodesTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewPromoCodes(pgPool)
+	s.Appointments = repo.NewBookingAppointment(pgPool)
+	s.Patient = repo.NewBookingPatients(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *PromoCodesTestSite) TestPromoCodeCRUD() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	code := "test-" + uuid.New().String()[:8]
+	promoCode, err := s.Repository.CreatePromoCode(ctx, &promo_codes.CreatePromoCode{
+		Code:      code,
+		Kind:      promo_codes.KindPercent,
+		Value:     10,
+		MaxUses:   5,
+		ValidFrom: time.Now().Add(-time.Hour),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(code, promoCode.Code)
+	s.Suite.True(promoCode.ValidUntil.IsZero())
+
+	// codes are unique whatever the case
+	_, err = s.Repository.CreatePromoCode(ctx, &promo_codes.CreatePromoCode{
+		Code:      strings.ToUpper(code),
+		Kind:      promo_codes.KindFixed,
+		Value:     1000,
+		ValidFrom: time.Now(),
+	})
+	var errConflict *entity.ErrConflict
+	s.Suite.ErrorAs(err, &errConflict)
+
+	getPromoCode, err := s.Repository.GetPromoCode(ctx, &promo_codes.FieldValueReq{
+		Field: "code",
+		Value: strings.ToUpper(code),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(promoCode.Id, getPromoCode.Id)
+
+	validUntil := time.Now().Add(time.Hour * 24).Truncate(time.Second)
+	updPromoCode, err := s.Repository.UpdatePromoCode(ctx, &promo_codes.UpdatePromoCode{
+		Id:         promoCode.Id,
+		Kind:       promo_codes.KindFixed,
+		Value:      5000,
+		MaxUses:    1,
+		ValidFrom:  promoCode.ValidFrom,
+		ValidUntil: validUntil,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(promo_codes.KindFixed, updPromoCode.Kind)
+	s.Suite.Equal(int64(1), updPromoCode.MaxUses)
+	s.Suite.False(updPromoCode.ValidUntil.IsZero())
+
+	list, err := s.Repository.GetAllPromoCodes(ctx, &promo_codes.GetAllPromoCodes{
+		Page:  1,
+		Limit: 10,
+	})
+	s.Suite.NoError(err)
+	s.Suite.NotZero(list.Count)
+
+	delPromoCode, err := s.Repository.DeletePromoCode(ctx, &promo_codes.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.Itoa(int(promoCode.Id)),
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(delPromoCode.Status)
+}
+
+func (s *PromoCodesTestSite) TestPromoCodeRedemption() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patient := &patients.CreatedPatient{
+		Id:             uuid.New().String(),
+		FirstName:      "Husanboy",
+		LastName:       "Gofurov",
+		BirthDate:      date.Today(),
+		Gender:         "male",
+		BloodGroup:     "A+",
+		PhoneNumber:    "+998950230608",
+		City:           "Andijon",
+		Country:        "Uzbekistan",
+		Address:        "Shahrixon",
+		PatientProblem: "Now Problem",
+	}
+	_, err := s.Patient.CreatePatient(ctx, patient)
+	s.Suite.NoError(err)
+
+	promoCode, err := s.Repository.CreatePromoCode(ctx, &promo_codes.CreatePromoCode{
+		Code:      "test-" + uuid.New().String()[:8],
+		Kind:      promo_codes.KindPercent,
+		Value:     10,
+		MaxUses:   1,
+		ValidFrom: time.Now().Add(-time.Hour),
+	})
+	s.Suite.NoError(err)
+
+	newReq := func() *booked_appointments.CreateAppointment {
+		appTime, _ := time.Parse("15:04:05", "10:00:00")
+		discount := promoCode.DiscountAmount(100000)
+		return &booked_appointments.CreateAppointment{
+			DepartmentId:    uuid.New().String(),
+			DoctorId:        uuid.New().String(),
+			PatientId:       patient.Id,
+			ServiceId:       uuid.New().String(),
+			AppointmentDate: date.Today().Add(1),
+			AppointmentTime: appTime,
+			Duration:        30,
+			Key:             "ABC",
+			ExpiresAt:       time.Now().Add(time.Hour),
+			Status:          "waiting",
+			PaymentType:     "cash",
+			PaymentAmount:   100000 - discount,
+			PatientProblem:  "Now Problem",
+			Discount: booked_appointments.Discount{
+				PromoCodeId:    promoCode.Id,
+				PromoCode:      promoCode.Code,
+				OriginalAmount: 100000,
+				Amount:         discount,
+			},
+		}
+	}
+
+	appointment, err := s.Appointments.CreateAppointment(ctx, newReq())
+	s.Suite.NoError(err)
+	s.Suite.Equal(float64(90000), appointment.PaymentAmount)
+	s.Suite.Equal(promoCode.Code, appointment.PromoCode)
+	s.Suite.Equal(float64(10000), appointment.DiscountAmount)
+
+	used, err := s.Repository.GetPromoCode(ctx, &promo_codes.FieldValueReq{
+		Field: "id",
+		Value: strconv.Itoa(int(promoCode.Id)),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), used.UsedCount)
+
+	// the single use is gone and the appointment is not created
+	_, err = s.Appointments.CreateAppointment(ctx, newReq())
+	var errBlocked *entity.ErrBookingBlocked
+	s.Suite.ErrorAs(err, &errBlocked)
+	s.Suite.Equal(promo_codes.CodePromoCodeExhausted, errBlocked.Code)
+
+	_, err = s.Appointments.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.Itoa(int(appointment.Id)),
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+
+	delPromoCode, err := s.Repository.DeletePromoCode(ctx, &promo_codes.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.Itoa(int(promoCode.Id)),
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(delPromoCode.Status)
+
+	delPatient, err := s.Patient.DeletePatient(ctx, &patients.FieldValueReq{
+		Field:        "id",
+		Value:        patient.Id,
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(delPatient.Status, true)
+}
+
+func (s *PromoCodesTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestPromoCodesTestSuite(t *testing.T) {
+	suite.Run(t, new(PromoCodesTestSite))
+}
@@ -0,0 +1,171 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/patients"
+	"booking_service/internal/entity/reschedule"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+)
+
+type RescheduleTestSite struct {
+	suite.Suite
+	Repository  *repo.Reschedule
+	Appointment *repo.BookingAppointment
+	Patient     *repo.BookingPatients
+	CleanUpFunc func()
+}
+
+func (s *RescheduleTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewReschedule(pgPool)
+	s.Appointment = repo.NewBookingAppointment(pgPool)
+	s.Patient = repo.NewBookingPatients(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *RescheduleTestSite) TestReschedule() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patient := &patients.CreatedPatient{
+		Id:             uuid.New().String(),
+		FirstName:      "Husanboy",
+		LastName:       "Gofurov",
+		BirthDate:      date.Today(),
+		Gender:         "male",
+		BloodGroup:     "A+",
+		PhoneNumber:    "+998950230608",
+		City:           "Andijon",
+		Country:        "Uzbekistan",
+		Address:        "Shahrixon",
+		PatientProblem: "Now Problem",
+	}
+	_, err := s.Patient.CreatePatient(ctx, patient)
+	s.Suite.NoError(err)
+
+	appDate := date.Today().Add(3)
+	appTime, _ := time.Parse("15:04:05", "10:00:00")
+	doctorId := uuid.New().String()
+	createRes, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		DoctorId:        doctorId,
+		PatientId:       patient.Id,
+		ServiceId:       uuid.New().String(),
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+		Key:             "ABC",
+		ExpiresAt:       time.Now().Add(time.Hour),
+		Status:          "waiting",
+		PaymentType:     "cash",
+		PaymentAmount:   100000,
+		PatientProblem:  "Now Problem",
+	})
+	s.Suite.NoError(err)
+	s.Suite.NotNil(createRes)
+
+	affected, err := s.Repository.ListAffectedAppointments(ctx, &reschedule.AffectedReq{
+		DoctorId: doctorId,
+		Start:    appDate.UTC(),
+		End:      appDate.Add(1).UTC(),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(affected, 1)
+	s.Suite.Equal(affected[0].Id, createRes.Id)
+
+	// a range ending right when the appointment starts does not affect it
+	affected, err = s.Repository.ListAffectedAppointments(ctx, &reschedule.AffectedReq{
+		DoctorId: doctorId,
+		Start:    appDate.UTC(),
+		End:      appDate.UTC().Add(10 * time.Hour),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(affected, 0)
+
+	busy, err := s.Repository.ListBusyIntervals(ctx, &reschedule.BusyIntervalsReq{
+		DoctorIds: []string{doctorId},
+		Date:      appDate,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(busy, 1)
+	s.Suite.Equal(busy[0].StartTime.Format("15:04:05"), "10:00:00")
+	s.Suite.Equal(busy[0].EndTime.Format("15:04:05"), "10:30:00")
+
+	newDoctorId := uuid.New().String()
+	newTime, _ := time.Parse("15:04:05", "11:00:00")
+	proposal := &reschedule.Proposal{
+		AppointmentId:      createRes.Id,
+		PatientId:          patient.Id,
+		DoctorId:           doctorId,
+		AppointmentDate:    appDate,
+		AppointmentTime:    appTime,
+		NewDoctorId:        newDoctorId,
+		NewDoctorServiceId: uuid.New().String(),
+		NewAppointmentDate: appDate.Add(1),
+		NewAppointmentTime: newTime,
+		Found:              true,
+	}
+	err = s.Repository.ApplyReschedule(ctx, []*reschedule.Move{{
+		Proposal: proposal,
+		Notification: &reschedule.Notification{
+			PatientId:     patient.Id,
+			AppointmentId: createRes.Id,
+			Kind:          reschedule.NotificationRescheduled,
+			Message:       "moved",
+		},
+	}})
+	s.Suite.NoError(err)
+	s.Suite.True(proposal.Applied)
+
+	getRes, err := s.Appointment.GetAppointment(ctx, &booked_appointments.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.Itoa(int(createRes.Id)),
+		DeleteStatus: false,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(getRes.DoctorId, newDoctorId)
+	s.Suite.Equal(getRes.AppointmentDate, appDate.Add(1))
+
+	notifications, err := s.Repository.GetPatientNotifications(ctx, &reschedule.NotificationsReq{
+		PatientId: patient.Id,
+		Page:      1,
+		Limit:     10,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(notifications.Count, int64(1))
+	s.Suite.Equal(notifications.Notifications[0].Kind, reschedule.NotificationRescheduled)
+
+	delRes, err := s.Appointment.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.Itoa(int(createRes.Id)),
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(delRes.Status, true)
+
+	delPatient, err := s.Patient.DeletePatient(ctx, &patients.FieldValueReq{
+		Field:        "id",
+		Value:        patient.Id,
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(delPatient.Status, true)
+}
+
+func (s *RescheduleTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestRescheduleTestSuite(t *testing.T) {
+	suite.Run(t, new(RescheduleTestSite))
+}
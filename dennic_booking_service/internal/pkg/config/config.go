@@ -15,6 +15,20 @@ type Config struct {
 		Timeout string
 	}
 
+	IdempotencyKey struct {
+		Retention string
+	}
+
+	DoctorAssignment struct {
+		Interval  string
+		DaysAhead string
+	}
+
+	HealthcareService struct {
+		Host string
+		Port string
+	}
+
 	DB struct {
 		Host     string
 		Port     string
@@ -46,6 +60,15 @@ func New() *Config {
 	config.LogLevel = getEnv("LOG_LEVEL", "debug")
 	config.RPCPort = getEnv("RPC_PORT", ":9090")
 	config.Context.Timeout = getEnv("CONTEXT_TIMEOUT", "30s")
+	config.IdempotencyKey.Retention = getEnv("IDEMPOTENCY_KEY_RETENTION", "24h")
+
+	// doctor assignment configuration
+	config.DoctorAssignment.Interval = getEnv("DOCTOR_ASSIGNMENT_INTERVAL", "1h")
+	config.DoctorAssignment.DaysAhead = getEnv("DOCTOR_ASSIGNMENT_DAYS_AHEAD", "1")
+
+	// healthcare service configuration
+	config.HealthcareService.Host = getEnv("HEALTHCARE_SERVICE_GRPC_HOST", "dennic_healthcare_service")
+	config.HealthcareService.Port = getEnv("HEALTHCARE_SERVICE_GRPC_PORT", ":9080")
 
 	// db configuration
 	config.DB.Host = getEnv("POSTGRES_HOST", "postgresdb")
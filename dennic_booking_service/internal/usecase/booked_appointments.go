@@ -2,10 +2,19 @@ package usecase
 
 import (
 	_ "booking_service/genproto/booking_service"
+	"booking_service/internal/entity"
 	appointment "booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/booking_rules"
+	"booking_service/internal/entity/doctor_assignment"
+	"booking_service/internal/entity/promo_codes"
 	"booking_service/internal/pkg/otlp"
 	"context"
+	"errors"
+	"strconv"
+	"strings"
 	"time"
+
+	"go.uber.org/zap"
 )
 
 const (
@@ -15,15 +24,29 @@ const (
 
 // BookedAppointmentsUseCase -.
 type BookedAppointmentsUseCase struct {
-	repo       BookedAppointments
-	ctxTimeout time.Duration
+	repo              BookedAppointments
+	rules             BookingRules
+	licenses          DoctorLicenses
+	resources         ResourceDirectory
+	promoCodes        PromoCodes
+	assignment        *DoctorAssignmentUseCase
+	logger            *zap.Logger
+	ctxTimeout        time.Duration
+	idempotencyKeyTTL time.Duration
 }
 
 // NewBookedAppointments -.
-func NewBookedAppointments(r BookedAppointments, ctxTimeout time.Duration) *BookedAppointmentsUseCase {
+func NewBookedAppointments(r BookedAppointments, rules BookingRules, licenses DoctorLicenses, resources ResourceDirectory, promoCodes PromoCodes, assignment *DoctorAssignmentUseCase, logger *zap.Logger, ctxTimeout, idempotencyKeyTTL time.Duration) *BookedAppointmentsUseCase {
 	return &BookedAppointmentsUseCase{
-		repo:       r,
-		ctxTimeout: ctxTimeout,
+		repo:              r,
+		rules:             rules,
+		licenses:          licenses,
+		resources:         resources,
+		promoCodes:        promoCodes,
+		assignment:        assignment,
+		logger:            logger,
+		ctxTimeout:        ctxTimeout,
+		idempotencyKeyTTL: idempotencyKeyTTL,
 	}
 }
 
@@ -34,7 +57,180 @@ func (r *BookedAppointmentsUseCase) CreateAppointment(ctx context.Context, req *
 	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointments+"Create")
 	span.End()
 
-	return r.repo.CreateAppointment(ctx, req)
+	if req.IdempotencyKey.Key != "" {
+		res, err := r.idempotentAppointment(ctx, req)
+		if res != nil || err != nil {
+			return res, err
+		}
+		req.IdempotencyKey.ExpiresAt = time.Now().Add(r.idempotencyKeyTTL)
+	}
+
+	if err := r.assignDoctor(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := r.checkBookingRules(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := r.requireResource(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := r.applyPromoCode(ctx, req); err != nil {
+		return nil, err
+	}
+
+	res, err := r.repo.CreateAppointment(ctx, req)
+
+	// a concurrent request with the same key won the race, return its appointment
+	var errConflict *entity.ErrConflict
+	if req.IdempotencyKey.Key != "" && errors.As(err, &errConflict) {
+		res, err = r.idempotentAppointment(ctx, req)
+		if res == nil && err == nil {
+			err = errConflict
+		}
+	}
+	return res, err
+}
+
+// idempotentAppointment returns the appointment already created with the key of req,
+// or nil when the key is unused or expired
+func (r *BookedAppointmentsUseCase) idempotentAppointment(ctx context.Context, req *appointment.CreateAppointment) (*appointment.Appointment, error) {
+	res, err := r.repo.GetAppointmentByIdempotencyKey(ctx, req.IdempotencyKey.Key)
+	if errors.Is(err, entity.ErrorNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// a key belongs to the patient who used it first
+	if res.PatientId != req.PatientId {
+		return nil, entity.NewErrConflict("idempotency key")
+	}
+	return res, nil
+}
+
+// assignDoctor picks a doctor for a department-level booking, when nobody can be picked
+// the booking stays unassigned and is retried by the assignment job before the appointment day,
+// any other failure fails the booking
+func (r *BookedAppointmentsUseCase) assignDoctor(ctx context.Context, req *appointment.CreateAppointment) error {
+	if r.assignment == nil || req.DoctorId != "" || req.Status != "waiting" || req.DepartmentId == "" || req.ServiceId == "" {
+		return nil
+	}
+
+	doctor, err := r.assignment.PickDoctor(ctx, &doctor_assignment.PickDoctorReq{
+		DepartmentId:    req.DepartmentId,
+		DoctorServiceId: req.ServiceId,
+		AppointmentDate: req.AppointmentDate,
+		AppointmentTime: req.AppointmentTime,
+		Duration:        req.Duration,
+	})
+	var errNotFound *entity.ErrNotFound
+	if errors.As(err, &errNotFound) {
+		r.logger.Info("doctor assignment: no candidate, the booking stays unassigned",
+			zap.String("department_id", req.DepartmentId), zap.String("service_id", req.ServiceId))
+		return nil
+	}
+	if err != nil {
+		r.logger.Error("doctor assignment", zap.String("department_id", req.DepartmentId),
+			zap.String("service_id", req.ServiceId), zap.Error(err))
+		return err
+	}
+
+	req.DoctorId = doctor.DoctorId
+	req.ServiceId = doctor.DoctorServiceId
+	return nil
+}
+
+// requireResource looks up the resource type required by the service of a new waiting appointment,
+// the repository reserves the first candidate free for the slot in the same transaction as the appointment
+func (r *BookedAppointmentsUseCase) requireResource(ctx context.Context, req *appointment.CreateAppointment) error {
+	if r.resources == nil || req.Status != "waiting" || req.ServiceId == "" {
+		return nil
+	}
+
+	resource, err := r.resources.ServiceResources(ctx, req.ServiceId)
+	if err != nil {
+		return err
+	}
+	if resource.Type == "" {
+		return nil
+	}
+	if len(resource.Candidates) == 0 {
+		return entity.NewErrBookingBlocked(appointment.CodeResourceUnavailable, "no "+resource.Type+" is available for the service")
+	}
+
+	req.Resource = *resource
+	return nil
+}
+
+// applyPromoCode takes the discount of the promo code off the payment amount of the new appointment,
+// the repository counts the use in the same transaction as the appointment and rejects a code used up meanwhile
+func (r *BookedAppointmentsUseCase) applyPromoCode(ctx context.Context, req *appointment.CreateAppointment) error {
+	code := strings.TrimSpace(req.PromoCode)
+	if r.promoCodes == nil || code == "" {
+		return nil
+	}
+
+	promoCode, err := r.promoCodes.GetPromoCode(ctx, &promo_codes.FieldValueReq{
+		Field: "code",
+		Value: code,
+	})
+	var errNotFound *entity.ErrNotFound
+	if errors.As(err, &errNotFound) {
+		return entity.NewErrBookingBlocked(promo_codes.CodePromoCodeInvalid, "the promo code "+code+" does not exist")
+	}
+	if err != nil {
+		return err
+	}
+
+	if !promoCode.ValidAt(time.Now()) {
+		return entity.NewErrBookingBlocked(promo_codes.CodePromoCodeInvalid, "the promo code "+promoCode.Code+" is not valid now")
+	}
+	if promoCode.Exhausted() {
+		return entity.NewErrBookingBlocked(promo_codes.CodePromoCodeExhausted, "the promo code "+promoCode.Code+" can no longer be used")
+	}
+
+	req.Discount = appointment.Discount{
+		PromoCodeId:    promoCode.Id,
+		PromoCode:      promoCode.Code,
+		OriginalAmount: req.PaymentAmount,
+		Amount:         promoCode.DiscountAmount(req.PaymentAmount),
+	}
+	req.PaymentAmount -= req.Discount.Amount
+	return nil
+}
+
+// checkBookingRules rejects a new waiting appointment when, if the rules block expired licenses, the license
+// of the doctor has expired by the appointment date. The limits of active appointments are passed on, the
+// repository counts them in the transaction of the appointment
+func (r *BookedAppointmentsUseCase) checkBookingRules(ctx context.Context, req *appointment.CreateAppointment) error {
+	if req.Status != "waiting" {
+		return nil
+	}
+
+	rules, err := r.rules.GetBookingRules(ctx)
+	if err != nil {
+		return err
+	}
+	if rules.BlockExpiredLicense && r.licenses != nil && req.DoctorId != "" {
+		expired, err := r.licenses.LicenseExpired(ctx, req.DoctorId, req.AppointmentDate)
+		if err != nil {
+			return err
+		}
+		if expired {
+			return entity.NewErrBookingBlocked(booking_rules.CodeLicenseExpired, "the license of the doctor has expired")
+		}
+	}
+
+	req.Limits = appointment.Limits{
+		MaxActivePerPatient: rules.MaxActivePerPatient,
+		MaxActivePerDoctor:  rules.MaxActivePerDoctor,
+		MaxPerDay:           rules.MaxPerDay,
+	}
+	return nil
 }
 
 func (r *BookedAppointmentsUseCase) GetAppointment(ctx context.Context, req *appointment.FieldValueReq) (*appointment.Appointment, error) {
@@ -47,6 +243,16 @@ func (r *BookedAppointmentsUseCase) GetAppointment(ctx context.Context, req *app
 	return r.repo.GetAppointment(ctx, req)
 }
 
+func (r *BookedAppointmentsUseCase) GetAppointmentByIdempotencyKey(ctx context.Context, key string) (*appointment.Appointment, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointments+"GetByIdempotencyKey")
+	span.End()
+
+	return r.repo.GetAppointmentByIdempotencyKey(ctx, key)
+}
+
 func (r *BookedAppointmentsUseCase) GetAllAppointment(ctx context.Context, req *appointment.GetAllAppointment) (*appointment.AppointmentsType, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
 	defer cancel()
@@ -87,3 +293,53 @@ func (r *BookedAppointmentsUseCase) DeleteAppointment(ctx context.Context, req *
 
 	return r.repo.DeleteAppointment(ctx, req)
 }
+
+func (r *BookedAppointmentsUseCase) ListDoctorAppointments(ctx context.Context, req *appointment.DoctorAppointmentsReq) (*appointment.AppointmentsType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointments+"ListDoctor")
+	defer span.End()
+
+	if req.DoctorId == "" {
+		return nil, validationError("doctor_id", "doctor_id is required")
+	}
+
+	return r.repo.ListDoctorAppointments(ctx, req)
+}
+
+// SetAttendance marks an appointment of the doctor which is not cancelled and has started attended or no_show
+func (r *BookedAppointmentsUseCase) SetAttendance(ctx context.Context, req *appointment.AttendanceReq) (*appointment.Appointment, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointments+"SetAttendance")
+	defer span.End()
+
+	if req.Status != appointment.StatusAttended && req.Status != appointment.StatusNoShow {
+		return nil, validationError("status", "status must be attended or no_show")
+	}
+
+	res, err := r.repo.GetAppointment(ctx, &appointment.FieldValueReq{
+		Field: "id",
+		Value: strconv.FormatInt(req.Id, 10),
+	})
+	var errNotFound *entity.ErrNotFound
+	if errors.As(err, &errNotFound) {
+		return nil, entity.NewErrNotFound("appointment")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if res.DoctorId != req.DoctorId {
+		return nil, entity.NewErrForbidden("the appointment is of another doctor")
+	}
+	if res.Status == "cancelled" {
+		return nil, validationError("status", "the appointment is cancelled")
+	}
+	if res.AppointmentDate.UTC().Add(clock(res.AppointmentTime)).After(time.Now()) {
+		return nil, validationError("id", "the appointment has not started yet")
+	}
+
+	return r.repo.SetAttendance(ctx, req)
+}
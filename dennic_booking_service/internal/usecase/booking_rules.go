@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"booking_service/internal/entity/booking_rules"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"time"
+)
+
+const (
+	serviceNameBookingRules = "BookingRulesService"
+	spanNameBookingRules    = "BookingRulesUsecase"
+)
+
+// BookingRulesUseCase -.
+type BookingRulesUseCase struct {
+	repo       BookingRules
+	ctxTimeout time.Duration
+}
+
+// NewBookingRules -.
+func NewBookingRules(r BookingRules, ctxTimeout time.Duration) *BookingRulesUseCase {
+	return &BookingRulesUseCase{
+		repo:       r,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+func (r *BookingRulesUseCase) GetBookingRules(ctx context.Context) (*booking_rules.BookingRules, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBookingRules, spanNameBookingRules+"Get")
+	span.End()
+
+	return r.repo.GetBookingRules(ctx)
+}
+
+func (r *BookingRulesUseCase) UpdateBookingRules(ctx context.Context, req *booking_rules.UpdateBookingRules) (*booking_rules.BookingRules, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBookingRules, spanNameBookingRules+"Update")
+	span.End()
+
+	return r.repo.UpdateBookingRules(ctx, req)
+}
+
+func (r *BookingRulesUseCase) CountActiveAppointments(ctx context.Context, req *booking_rules.CountActiveReq) (*booking_rules.ActiveCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBookingRules, spanNameBookingRules+"CountActive")
+	span.End()
+
+	return r.repo.CountActiveAppointments(ctx, req)
+}
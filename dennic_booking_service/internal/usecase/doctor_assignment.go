@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/doctor_assignment"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/rickb777/date"
+)
+
+const (
+	serviceNameDoctorAssignment = "DoctorAssignmentService"
+	spanNameDoctorAssignment    = "DoctorAssignmentUsecase"
+
+	// unassignedBatchSize is the number of pending appointments listed at a time
+	unassignedBatchSize = 100
+)
+
+// DoctorAssignmentUseCase -.
+type DoctorAssignmentUseCase struct {
+	repo       DoctorAssignment
+	doctors    DoctorDirectory
+	ctxTimeout time.Duration
+}
+
+// NewDoctorAssignment -.
+func NewDoctorAssignment(r DoctorAssignment, doctors DoctorDirectory, ctxTimeout time.Duration) *DoctorAssignmentUseCase {
+	return &DoctorAssignmentUseCase{
+		repo:       r,
+		doctors:    doctors,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+// PickDoctor returns the least loaded doctor of the department offering the service,
+// who works during the whole slot and has neither a break, a booking nor an unavailability overlapping it
+func (r *DoctorAssignmentUseCase) PickDoctor(ctx context.Context, req *doctor_assignment.PickDoctorReq) (*doctor_assignment.ServiceDoctor, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorAssignment, spanNameDoctorAssignment+"Pick")
+	defer span.End()
+
+	doctors, err := r.doctors.ListServiceDoctors(ctx, &doctor_assignment.ServiceDoctorsReq{
+		DepartmentId:    req.DepartmentId,
+		DoctorServiceId: req.DoctorServiceId,
+		Date:            req.AppointmentDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	start := clock(req.AppointmentTime)
+	end := start + time.Duration(req.Duration)*time.Minute
+
+	candidates := make(map[string]*doctor_assignment.ServiceDoctor, len(doctors))
+	var doctorIds []string
+	for _, doctor := range doctors {
+		// the slot has to fit in one of the shifts of the doctor and miss its breaks
+		if clock(doctor.StartTime) > start || clock(doctor.FinishTime) < end || inBreak(doctor, start, end) {
+			continue
+		}
+		if _, ok := candidates[doctor.DoctorId]; ok {
+			continue
+		}
+		candidates[doctor.DoctorId] = doctor
+		doctorIds = append(doctorIds, doctor.DoctorId)
+	}
+	if len(doctorIds) == 0 {
+		return nil, entity.NewErrNotFound("available doctor")
+	}
+
+	loads, err := r.repo.GetDoctorsLoad(ctx, &doctor_assignment.DoctorsLoadReq{
+		DoctorIds: doctorIds,
+		Date:      req.AppointmentDate,
+		StartTime: req.AppointmentTime,
+		EndTime:   req.AppointmentTime.Add(time.Duration(req.Duration) * time.Minute),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the doctor with the fewest bookings of the day wins, ties are broken by id to stay deterministic
+	sort.Slice(loads, func(i, j int) bool {
+		if loads[i].DayLoad != loads[j].DayLoad {
+			return loads[i].DayLoad < loads[j].DayLoad
+		}
+		return loads[i].DoctorId < loads[j].DoctorId
+	})
+	for _, load := range loads {
+		if !load.Busy && !load.Unavailable {
+			return candidates[load.DoctorId], nil
+		}
+	}
+
+	return nil, entity.NewErrNotFound("available doctor")
+}
+
+// AssignPending assigns doctors to the waiting department-level appointments up to the given day
+// and returns the number of appointments assigned. It pages through all of them, so the appointments
+// nobody can take yet do not hold back the ones after them
+func (r *DoctorAssignmentUseCase) AssignPending(ctx context.Context, until date.Date) (int, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorAssignment, spanNameDoctorAssignment+"AssignPending")
+	defer span.End()
+
+	req := &doctor_assignment.UnassignedReq{
+		Until: until,
+		Limit: unassignedBatchSize,
+	}
+	var assigned int
+	for {
+		listCtx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+		appointments, err := r.repo.ListUnassignedAppointments(listCtx, req)
+		cancel()
+		if err != nil {
+			return assigned, err
+		}
+
+		for _, appointment := range appointments {
+			doctor, err := r.PickDoctor(ctx, &doctor_assignment.PickDoctorReq{
+				DepartmentId:    appointment.DepartmentId,
+				DoctorServiceId: appointment.ServiceId,
+				AppointmentDate: appointment.AppointmentDate,
+				AppointmentTime: appointment.AppointmentTime,
+				Duration:        appointment.Duration,
+			})
+			// nobody is free yet, the appointment is retried on the next run
+			var errNotFound *entity.ErrNotFound
+			if errors.As(err, &errNotFound) {
+				continue
+			}
+			if err != nil {
+				return assigned, err
+			}
+
+			// false when the doctor was booked meanwhile, the appointment is retried on the next run
+			ok, err := r.assignDoctor(ctx, &doctor_assignment.AssignDoctorReq{
+				AppointmentId:   appointment.Id,
+				DoctorId:        doctor.DoctorId,
+				DoctorServiceId: doctor.DoctorServiceId,
+				AppointmentDate: appointment.AppointmentDate,
+				AppointmentTime: appointment.AppointmentTime,
+				Duration:        appointment.Duration,
+			})
+			if err != nil {
+				return assigned, err
+			}
+			if ok {
+				assigned++
+			}
+		}
+
+		if len(appointments) < unassignedBatchSize {
+			return assigned, nil
+		}
+		last := appointments[len(appointments)-1]
+		req.AfterId = last.Id
+		req.AfterDate = last.AppointmentDate
+		req.AfterTime = last.AppointmentTime
+	}
+}
+
+func (r *DoctorAssignmentUseCase) assignDoctor(ctx context.Context, req *doctor_assignment.AssignDoctorReq) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	return r.repo.AssignDoctor(ctx, req)
+}
+
+// inBreak reports whether [start, end) intersects any break of the doctor
+func inBreak(doctor *doctor_assignment.ServiceDoctor, start, end time.Duration) bool {
+	for _, b := range doctor.Breaks {
+		if clock(b.StartTime) < end && clock(b.FinishTime) > start {
+			return true
+		}
+	}
+	return false
+}
+
+// clock returns the time of day of t
+func clock(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+}
@@ -75,3 +75,23 @@ func (r *BookedDoctorAvailabilityUseCase) DeleteDoctorAvailability(ctx context.C
 
 	return r.Repo.DeleteDoctorAvailability(ctx, req)
 }
+
+func (r *BookedDoctorAvailabilityUseCase) BlockLeave(ctx context.Context, req *doctor_availability.LeaveBlocks) (*doctor_availability.LeaveBlocksRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorAvailability, spanNameDoctorAvailability+"BlockLeave")
+	defer span.End()
+
+	return r.Repo.BlockLeave(ctx, req)
+}
+
+func (r *BookedDoctorAvailabilityUseCase) ReleaseLeave(ctx context.Context, req *doctor_availability.LeaveReq) (*doctor_availability.LeaveBlocksRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorAvailability, spanNameDoctorAvailability+"ReleaseLeave")
+	defer span.End()
+
+	return r.Repo.ReleaseLeave(ctx, req)
+}
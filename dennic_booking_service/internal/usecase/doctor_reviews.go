@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"booking_service/internal/entity"
+	appointment "booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/doctor_reviews"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	serviceNameDoctorReviews = "DoctorReviewsService"
+	spanNameDoctorReviews    = "DoctorReviewsUsecase"
+
+	// reviewableAppointmentStatus is the only appointment status a patient can review
+	reviewableAppointmentStatus = "attended"
+)
+
+// DoctorReviewsUseCase -.
+type DoctorReviewsUseCase struct {
+	repo         DoctorReviews
+	appointments BookedAppointments
+	ratings      DoctorRatings
+	ctxTimeout   time.Duration
+}
+
+// NewDoctorReviews -.
+func NewDoctorReviews(r DoctorReviews, appointments BookedAppointments, ratings DoctorRatings, ctxTimeout time.Duration) *DoctorReviewsUseCase {
+	return &DoctorReviewsUseCase{
+		repo:         r,
+		appointments: appointments,
+		ratings:      ratings,
+		ctxTimeout:   ctxTimeout,
+	}
+}
+
+// CreateReview stores a pending review of an attended appointment of the patient,
+// the doctor is taken from the appointment
+func (r *DoctorReviewsUseCase) CreateReview(ctx context.Context, req *doctor_reviews.CreateReview) (*doctor_reviews.Review, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviews+"Create")
+	defer span.End()
+
+	if req.Rating < doctor_reviews.MinRating || req.Rating > doctor_reviews.MaxRating {
+		return nil, validationError("rating", fmt.Sprintf("rating must be between %d and %d", doctor_reviews.MinRating, doctor_reviews.MaxRating))
+	}
+
+	booked, err := r.appointments.GetAppointment(ctx, &appointment.FieldValueReq{
+		Field: "id",
+		Value: strconv.FormatInt(req.AppointmentId, 10),
+	})
+	var errNotFound *entity.ErrNotFound
+	if errors.As(err, &errNotFound) {
+		return nil, entity.NewErrNotFound("appointment")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if booked.PatientId != req.PatientId {
+		return nil, validationError("appointment_id", "the appointment belongs to another patient")
+	}
+	if booked.Status != reviewableAppointmentStatus {
+		return nil, validationError("appointment_id", "only attended appointments can be reviewed")
+	}
+
+	req.DoctorId = booked.DoctorId
+
+	return r.repo.CreateReview(ctx, req)
+}
+
+func (r *DoctorReviewsUseCase) GetReview(ctx context.Context, req *doctor_reviews.FieldValueReq) (*doctor_reviews.Review, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviews+"Get")
+	defer span.End()
+
+	return r.repo.GetReview(ctx, req)
+}
+
+func (r *DoctorReviewsUseCase) GetAllReviews(ctx context.Context, req *doctor_reviews.GetAllReviews) (*doctor_reviews.ReviewsType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviews+"List")
+	defer span.End()
+
+	return r.repo.GetAllReviews(ctx, req)
+}
+
+// ModerateReview approves or rejects a review and refreshes the rating of its doctor
+func (r *DoctorReviewsUseCase) ModerateReview(ctx context.Context, req *doctor_reviews.ModerateReview) (*doctor_reviews.Review, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviews+"Moderate")
+	defer span.End()
+
+	if req.Status != doctor_reviews.StatusApproved && req.Status != doctor_reviews.StatusRejected {
+		return nil, validationError("status", "status must be approved or rejected")
+	}
+
+	review, err := r.repo.ModerateReview(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.refreshRating(ctx, review.DoctorId); err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// DeleteReview deletes a review and refreshes the rating of its doctor
+func (r *DoctorReviewsUseCase) DeleteReview(ctx context.Context, req *doctor_reviews.FieldValueReq) (*doctor_reviews.StatusRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorReviews, spanNameDoctorReviews+"Delete")
+	defer span.End()
+
+	review, err := r.repo.GetReview(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := r.repo.DeleteReview(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.refreshRating(ctx, review.DoctorId); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// refreshRating recomputes the rating of the doctor from the approved reviews and sends it to the
+// healthcare service, the value is absolute so repeating it after a failure is safe
+func (r *DoctorReviewsUseCase) refreshRating(ctx context.Context, doctorId string) error {
+	rating, err := r.repo.GetDoctorRating(ctx, doctorId)
+	if err != nil {
+		return err
+	}
+
+	if err := r.ratings.SetDoctorRating(ctx, rating); err != nil {
+		return fmt.Errorf("refresh doctor rating: %w", err)
+	}
+
+	return nil
+}
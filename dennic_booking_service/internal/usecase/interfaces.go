@@ -4,10 +4,19 @@ package usecase
 import (
 	"booking_service/internal/entity/archive"
 	appointment "booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/booking_rules"
+	"booking_service/internal/entity/doctor_assignment"
 	"booking_service/internal/entity/doctor_availability"
 	"booking_service/internal/entity/doctor_notes"
+	"booking_service/internal/entity/doctor_reviews"
+	"booking_service/internal/entity/patient_documents"
 	"booking_service/internal/entity/patients"
+	"booking_service/internal/entity/promo_codes"
+	"booking_service/internal/entity/recommendation"
+	"booking_service/internal/entity/reschedule"
 	"context"
+
+	"github.com/rickb777/date"
 )
 
 //go:generate mockgen -source=interfaces.go -destination=./mocks_test.go -package=usecase_test
@@ -27,10 +36,13 @@ type (
 	BookedAppointments interface {
 		CreateAppointment(ctx context.Context, req *appointment.CreateAppointment) (*appointment.Appointment, error)
 		GetAppointment(ctx context.Context, req *appointment.FieldValueReq) (*appointment.Appointment, error)
+		GetAppointmentByIdempotencyKey(ctx context.Context, key string) (*appointment.Appointment, error)
 		GetAllAppointment(ctx context.Context, req *appointment.GetAllAppointment) (*appointment.AppointmentsType, error)
 		GetFilteredAppointments(ctx context.Context, req *appointment.GetFilteredRequest) (*appointment.AppointmentsType, error)
 		UpdateAppointment(ctx context.Context, req *appointment.UpdateAppointment) (*appointment.Appointment, error)
 		DeleteAppointment(ctx context.Context, req *appointment.FieldValueReq) (*appointment.StatusRes, error)
+		ListDoctorAppointments(ctx context.Context, req *appointment.DoctorAppointmentsReq) (*appointment.AppointmentsType, error)
+		SetAttendance(ctx context.Context, req *appointment.AttendanceReq) (*appointment.Appointment, error)
 	}
 	// DoctorNotes -.
 	DoctorNotes interface {
@@ -57,5 +69,87 @@ type (
 		GetAllDoctorAvailability(ctx context.Context, req *doctor_availability.GetAllReq) (*doctor_availability.DoctorAvailabilityType, error)
 		UpdateDoctorAvailability(ctx context.Context, req *doctor_availability.UpdateDoctorAvailability) (*doctor_availability.DoctorAvailability, error)
 		DeleteDoctorAvailability(ctx context.Context, req *doctor_availability.FieldValueReq) (*doctor_availability.StatusRes, error)
+		BlockLeave(ctx context.Context, req *doctor_availability.LeaveBlocks) (*doctor_availability.LeaveBlocksRes, error)
+		ReleaseLeave(ctx context.Context, req *doctor_availability.LeaveReq) (*doctor_availability.LeaveBlocksRes, error)
+	}
+
+	// BookingRules -.
+	BookingRules interface {
+		GetBookingRules(ctx context.Context) (*booking_rules.BookingRules, error)
+		UpdateBookingRules(ctx context.Context, req *booking_rules.UpdateBookingRules) (*booking_rules.BookingRules, error)
+		CountActiveAppointments(ctx context.Context, req *booking_rules.CountActiveReq) (*booking_rules.ActiveCount, error)
+	}
+
+	// DoctorAssignment -.
+	DoctorAssignment interface {
+		ListUnassignedAppointments(ctx context.Context, req *doctor_assignment.UnassignedReq) ([]*appointment.Appointment, error)
+		GetDoctorsLoad(ctx context.Context, req *doctor_assignment.DoctorsLoadReq) ([]*doctor_assignment.DoctorLoad, error)
+		AssignDoctor(ctx context.Context, req *doctor_assignment.AssignDoctorReq) (bool, error)
+	}
+
+	// DoctorDirectory -.
+	DoctorDirectory interface {
+		ListServiceDoctors(ctx context.Context, req *doctor_assignment.ServiceDoctorsReq) ([]*doctor_assignment.ServiceDoctor, error)
+		GetServiceSpecialization(ctx context.Context, doctorServiceId string) (string, error)
+	}
+
+	// Reschedule -.
+	Reschedule interface {
+		ListAffectedAppointments(ctx context.Context, req *reschedule.AffectedReq) ([]*appointment.Appointment, error)
+		ListBusyIntervals(ctx context.Context, req *reschedule.BusyIntervalsReq) ([]*reschedule.BusyInterval, error)
+		ApplyReschedule(ctx context.Context, moves []*reschedule.Move) error
+		GetPatientNotifications(ctx context.Context, req *reschedule.NotificationsReq) (*reschedule.Notifications, error)
+	}
+
+	// DoctorReviews -.
+	DoctorReviews interface {
+		CreateReview(ctx context.Context, req *doctor_reviews.CreateReview) (*doctor_reviews.Review, error)
+		GetReview(ctx context.Context, req *doctor_reviews.FieldValueReq) (*doctor_reviews.Review, error)
+		GetAllReviews(ctx context.Context, req *doctor_reviews.GetAllReviews) (*doctor_reviews.ReviewsType, error)
+		ModerateReview(ctx context.Context, req *doctor_reviews.ModerateReview) (*doctor_reviews.Review, error)
+		DeleteReview(ctx context.Context, req *doctor_reviews.FieldValueReq) (*doctor_reviews.StatusRes, error)
+		GetDoctorRating(ctx context.Context, doctorId string) (*doctor_reviews.DoctorRating, error)
+	}
+
+	// PromoCodes -.
+	PromoCodes interface {
+		CreatePromoCode(ctx context.Context, req *promo_codes.CreatePromoCode) (*promo_codes.PromoCode, error)
+		GetPromoCode(ctx context.Context, req *promo_codes.FieldValueReq) (*promo_codes.PromoCode, error)
+		GetAllPromoCodes(ctx context.Context, req *promo_codes.GetAllPromoCodes) (*promo_codes.PromoCodesType, error)
+		UpdatePromoCode(ctx context.Context, req *promo_codes.UpdatePromoCode) (*promo_codes.PromoCode, error)
+		DeletePromoCode(ctx context.Context, req *promo_codes.FieldValueReq) (*promo_codes.StatusRes, error)
+	}
+
+	// PatientDocuments -.
+	PatientDocuments interface {
+		CreateDocument(ctx context.Context, req *patient_documents.CreateDocument) (*patient_documents.Document, error)
+		GetDocument(ctx context.Context, req *patient_documents.DocumentReq) (*patient_documents.Document, error)
+		GetAllDocuments(ctx context.Context, req *patient_documents.GetAllDocuments) (*patient_documents.DocumentsType, error)
+		DeleteDocument(ctx context.Context, req *patient_documents.DocumentReq) (*patient_documents.StatusRes, error)
+	}
+
+	// DoctorLicenses -.
+	DoctorLicenses interface {
+		LicenseExpired(ctx context.Context, doctorId string, day date.Date) (bool, error)
+	}
+
+	// ResourceDirectory -.
+	ResourceDirectory interface {
+		ServiceResources(ctx context.Context, doctorServiceId string) (*appointment.Resource, error)
+	}
+
+	// DoctorRatings -.
+	DoctorRatings interface {
+		SetDoctorRating(ctx context.Context, req *doctor_reviews.DoctorRating) error
+	}
+
+	// ReasonDirectory -.
+	ReasonDirectory interface {
+		ListReasonDoctors(ctx context.Context, req *recommendation.ReasonDoctorsReq) (*recommendation.ReasonDoctors, error)
+	}
+
+	// BusyIntervals -.
+	BusyIntervals interface {
+		ListBusyIntervals(ctx context.Context, req *reschedule.BusyIntervalsReq) ([]*reschedule.BusyInterval, error)
 	}
 )
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"booking_service/internal/entity"
+	appointment "booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/doctor_notes"
+	"booking_service/internal/entity/patient_documents"
+	"booking_service/internal/entity/patients"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	serviceNamePatientDocuments = "PatientDocumentsService"
+	spanNamePatientDocuments    = "PatientDocumentsUsecase"
+)
+
+// PatientDocumentsUseCase -.
+type PatientDocumentsUseCase struct {
+	repo         PatientDocuments
+	patients     Patient
+	appointments BookedAppointments
+	notes        DoctorNotes
+	ctxTimeout   time.Duration
+}
+
+// NewPatientDocuments -.
+func NewPatientDocuments(r PatientDocuments, patients Patient, appointments BookedAppointments, notes DoctorNotes, ctxTimeout time.Duration) *PatientDocumentsUseCase {
+	return &PatientDocumentsUseCase{
+		repo:         r,
+		patients:     patients,
+		appointments: appointments,
+		notes:        notes,
+		ctxTimeout:   ctxTimeout,
+	}
+}
+
+// validateRequester checks the documents are asked for by either a user or a doctor
+func validateRequester(requester patient_documents.Requester) error {
+	if (requester.UserId == "") == (requester.DoctorId == "") {
+		return validationError("requester", "either user_id or doctor_id is required")
+	}
+	return nil
+}
+
+// CreateDocument attaches an uploaded file to the patient, and to one of their appointments or doctor notes.
+// The user must be one of the patient, the patient records carry no user, so a user is one of the patients
+// of their phone number. The object must be one of the user, the objects of the document bucket are kept
+// under the id of their user
+func (r *PatientDocumentsUseCase) CreateDocument(ctx context.Context, req *patient_documents.CreateDocument) (*patient_documents.Document, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePatientDocuments, spanNamePatientDocuments+"Create")
+	defer span.End()
+
+	req.Title = strings.TrimSpace(req.Title)
+	switch {
+	case req.PatientId == "":
+		return nil, validationError("patient_id", "patient_id is required")
+	case req.UserId == "":
+		return nil, validationError("user_id", "user_id is required")
+	case !slices.Contains(patient_documents.Types, req.DocumentType):
+		return nil, validationError("document_type", "document_type must be one of "+strings.Join(patient_documents.Types, ", "))
+	case req.Title == "":
+		return nil, validationError("title", "title is required")
+	case !strings.HasPrefix(req.ObjectName, req.UserId+"/") || strings.Contains(req.ObjectName, ".."):
+		return nil, validationError("object_name", "the object does not belong to the user")
+	case req.Size <= 0:
+		return nil, validationError("size", "size must be positive")
+	}
+
+	patient, err := r.patients.GetPatient(ctx, &patients.FieldValueReq{
+		Field: "id",
+		Value: req.PatientId,
+	})
+	var errNotFound *entity.ErrNotFound
+	if errors.As(err, &errNotFound) {
+		return nil, entity.NewErrNotFound("patient")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if req.PhoneNumber == "" || strings.TrimSpace(patient.PhoneNumber) != strings.TrimSpace(req.PhoneNumber) {
+		return nil, entity.NewErrForbidden("the patient is not one of the user")
+	}
+
+	if req.AppointmentId != 0 {
+		booked, err := r.appointments.GetAppointment(ctx, &appointment.FieldValueReq{
+			Field: "id",
+			Value: strconv.FormatInt(req.AppointmentId, 10),
+		})
+		if errors.As(err, &errNotFound) {
+			return nil, entity.NewErrNotFound("appointment")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if booked.PatientId != req.PatientId {
+			return nil, validationError("appointment_id", "the appointment belongs to another patient")
+		}
+	}
+
+	if req.DoctorNoteId != 0 {
+		note, err := r.notes.GetDoctorNotes(ctx, &doctor_notes.FieldValueReq{
+			Field: "id",
+			Value: strconv.FormatInt(req.DoctorNoteId, 10),
+		})
+		if errors.As(err, &errNotFound) {
+			return nil, entity.NewErrNotFound("doctor note")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if note.PatientId != req.PatientId {
+			return nil, validationError("doctor_note_id", "the doctor note belongs to another patient")
+		}
+	}
+
+	return r.repo.CreateDocument(ctx, req)
+}
+
+func (r *PatientDocumentsUseCase) GetDocument(ctx context.Context, req *patient_documents.DocumentReq) (*patient_documents.Document, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePatientDocuments, spanNamePatientDocuments+"Get")
+	defer span.End()
+
+	if err := validateRequester(req.Requester); err != nil {
+		return nil, err
+	}
+
+	return r.repo.GetDocument(ctx, req)
+}
+
+func (r *PatientDocumentsUseCase) GetAllDocuments(ctx context.Context, req *patient_documents.GetAllDocuments) (*patient_documents.DocumentsType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePatientDocuments, spanNamePatientDocuments+"List")
+	defer span.End()
+
+	if err := validateRequester(req.Requester); err != nil {
+		return nil, err
+	}
+
+	return r.repo.GetAllDocuments(ctx, req)
+}
+
+// DeleteDocument deletes a document of the user, the doctors only read the documents
+func (r *PatientDocumentsUseCase) DeleteDocument(ctx context.Context, req *patient_documents.DocumentReq) (*patient_documents.StatusRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePatientDocuments, spanNamePatientDocuments+"Delete")
+	defer span.End()
+
+	if req.UserId == "" || req.DoctorId != "" {
+		return nil, validationError("user_id", "only the user who uploaded the document deletes it")
+	}
+
+	return r.repo.DeleteDocument(ctx, req)
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/promo_codes"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	serviceNamePromoCodes = "PromoCodesService"
+	spanNamePromoCodes    = "PromoCodesUsecase"
+)
+
+// PromoCodesUseCase -.
+type PromoCodesUseCase struct {
+	repo       PromoCodes
+	ctxTimeout time.Duration
+}
+
+// NewPromoCodes -.
+func NewPromoCodes(r PromoCodes, ctxTimeout time.Duration) *PromoCodesUseCase {
+	return &PromoCodesUseCase{
+		repo:       r,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+// CreatePromoCode stores the code trimmed, codes are unique whatever the case
+func (r *PromoCodesUseCase) CreatePromoCode(ctx context.Context, req *promo_codes.CreatePromoCode) (*promo_codes.PromoCode, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodes+"Create")
+	defer span.End()
+
+	req.Code = strings.TrimSpace(req.Code)
+	if req.Code == "" {
+		return nil, validationError("code", "code is required")
+	}
+	if err := validatePromoCode(req.Kind, req.Value, req.MaxUses, req.ValidFrom, req.ValidUntil); err != nil {
+		return nil, err
+	}
+
+	return r.repo.CreatePromoCode(ctx, req)
+}
+
+func (r *PromoCodesUseCase) GetPromoCode(ctx context.Context, req *promo_codes.FieldValueReq) (*promo_codes.PromoCode, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodes+"Get")
+	defer span.End()
+
+	return r.repo.GetPromoCode(ctx, req)
+}
+
+func (r *PromoCodesUseCase) GetAllPromoCodes(ctx context.Context, req *promo_codes.GetAllPromoCodes) (*promo_codes.PromoCodesType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodes+"List")
+	defer span.End()
+
+	return r.repo.GetAllPromoCodes(ctx, req)
+}
+
+func (r *PromoCodesUseCase) UpdatePromoCode(ctx context.Context, req *promo_codes.UpdatePromoCode) (*promo_codes.PromoCode, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodes+"Update")
+	defer span.End()
+
+	if err := validatePromoCode(req.Kind, req.Value, req.MaxUses, req.ValidFrom, req.ValidUntil); err != nil {
+		return nil, err
+	}
+
+	return r.repo.UpdatePromoCode(ctx, req)
+}
+
+func (r *PromoCodesUseCase) DeletePromoCode(ctx context.Context, req *promo_codes.FieldValueReq) (*promo_codes.StatusRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePromoCodes, spanNamePromoCodes+"Delete")
+	defer span.End()
+
+	return r.repo.DeletePromoCode(ctx, req)
+}
+
+// validationError is the validation error of a single field
+func validationError(field, description string) error {
+	err := entity.NewErrValidation()
+	err.Err = errors.New(description)
+	err.Errors[field] = description
+	return err
+}
+
+func validatePromoCode(kind string, value float64, maxUses int64, validFrom, validUntil time.Time) error {
+	switch kind {
+	case promo_codes.KindPercent:
+		if value <= 0 || value > 100 {
+			return validationError("value", "a percent value must be above 0 and at most 100")
+		}
+	case promo_codes.KindFixed:
+		if value <= 0 {
+			return validationError("value", "value must be positive")
+		}
+	default:
+		return validationError("kind", "kind must be percent or fixed")
+	}
+	if maxUses < 0 {
+		return validationError("max_uses", "max_uses cannot be negative")
+	}
+	if validFrom.IsZero() {
+		return validationError("valid_from", "valid_from is required")
+	}
+	if !validUntil.IsZero() && !validUntil.After(validFrom) {
+		return validationError("valid_until", "valid_until must be after valid_from")
+	}
+	return nil
+}
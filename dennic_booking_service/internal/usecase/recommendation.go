@@ -0,0 +1,262 @@
+package usecase
+
+import (
+	"booking_service/internal/entity/doctor_reviews"
+	"booking_service/internal/entity/recommendation"
+	"booking_service/internal/entity/reschedule"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/rickb777/date"
+)
+
+const (
+	serviceNameRecommendation = "RecommendationService"
+	spanNameRecommendation    = "RecommendationUsecase"
+
+	// recommendationSlotStep is the granularity of the searched free slots
+	recommendationSlotStep = 15 * time.Minute
+	// defaultRecommendationDaysAhead and maxRecommendationDaysAhead bound how far free slots are searched
+	defaultRecommendationDaysAhead = 14
+	maxRecommendationDaysAhead     = 60
+	defaultRecommendationLimit     = 10
+
+	// the score of a doctor is the weighted sum of how soon, how cheap and how well rated it is,
+	// each part between 0 and 1 relative to the other doctors of the reason
+	slotScoreWeight   = 0.5
+	priceScoreWeight  = 0.25
+	ratingScoreWeight = 0.25
+)
+
+// RecommendationUseCase -.
+type RecommendationUseCase struct {
+	doctors    ReasonDirectory
+	busy       BusyIntervals
+	ctxTimeout time.Duration
+}
+
+// NewRecommendation -.
+func NewRecommendation(doctors ReasonDirectory, busy BusyIntervals, ctxTimeout time.Duration) *RecommendationUseCase {
+	return &RecommendationUseCase{
+		doctors:    doctors,
+		busy:       busy,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+// RecommendDoctors resolves the reason to its specialization and ranks the doctors offering it
+// by their earliest free slot, price and rating, doctors without a free slot in the searched days come last
+func (r *RecommendationUseCase) RecommendDoctors(ctx context.Context, req *recommendation.RecommendReq) (*recommendation.Recommendations, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameRecommendation, spanNameRecommendation+"Recommend")
+	defer span.End()
+
+	reason, err := r.doctors.ListReasonDoctors(ctx, &recommendation.ReasonDoctorsReq{
+		ReasonId: req.ReasonId,
+		Query:    req.Query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	daysAhead := req.DaysAhead
+	if daysAhead <= 0 {
+		daysAhead = defaultRecommendationDaysAhead
+	}
+	if daysAhead > maxRecommendationDaysAhead {
+		daysAhead = maxRecommendationDaysAhead
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultRecommendationLimit
+	}
+
+	var recommendations []*recommendation.Recommendation
+	for _, doctor := range reason.Doctors {
+		price := doctor.OfflinePrice
+		if req.Online {
+			price = doctor.OnlinePrice
+		}
+		recommendations = append(recommendations, &recommendation.Recommendation{
+			Doctor: doctor,
+			Price:  price,
+		})
+	}
+
+	now := naiveNow()
+	if err := r.findSlots(ctx, recommendations, now, daysAhead); err != nil {
+		return nil, err
+	}
+	scoreRecommendations(recommendations, now)
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		a, b := recommendations[i], recommendations[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.Doctor.DoctorId < b.Doctor.DoctorId
+	})
+	if int64(len(recommendations)) > limit {
+		recommendations = recommendations[:limit]
+	}
+
+	return &recommendation.Recommendations{
+		ReasonId:         reason.ReasonId,
+		ReasonName:       reason.ReasonName,
+		SpecializationId: reason.SpecializationId,
+		Recommendations:  recommendations,
+	}, nil
+}
+
+// findSlots walks the days from today and fills the earliest free slot of every doctor within its shifts,
+// the days of an approved leave of the doctor are skipped, busy intervals are loaded once a day for all doctors still without a slot working that day, breaks count as busy
+func (r *RecommendationUseCase) findSlots(ctx context.Context, recommendations []*recommendation.Recommendation, now time.Time, daysAhead int64) error {
+	today := date.NewAt(now)
+	for day := today; !day.After(today.Add(date.PeriodOfDays(daysAhead))); day = day.Add(1) {
+		var (
+			pending   []*recommendation.Recommendation
+			doctorIds []string
+		)
+		for _, rec := range recommendations {
+			if !rec.SlotFound && !onLeave(rec.Doctor, day) && len(workingHoursOn(rec.Doctor, day, false)) > 0 {
+				pending = append(pending, rec)
+				doctorIds = append(doctorIds, rec.Doctor.DoctorId)
+			}
+		}
+		if len(pending) == 0 {
+			continue
+		}
+
+		intervals, err := r.busy.ListBusyIntervals(ctx, &reschedule.BusyIntervalsReq{
+			DoctorIds: doctorIds,
+			Date:      day,
+		})
+		if err != nil {
+			return err
+		}
+		busy := make(map[string][]*reschedule.BusyInterval, len(pending))
+		for _, interval := range intervals {
+			busy[interval.DoctorId] = append(busy[interval.DoctorId], interval)
+		}
+		for _, rec := range pending {
+			for _, hours := range workingHoursOn(rec.Doctor, day, true) {
+				busy[rec.Doctor.DoctorId] = append(busy[rec.Doctor.DoctorId], &reschedule.BusyInterval{
+					DoctorId:  rec.Doctor.DoctorId,
+					StartTime: hours.StartTime,
+					EndTime:   hours.FinishTime,
+				})
+			}
+		}
+
+		for _, rec := range pending {
+			duration := time.Duration(rec.Doctor.Duration) * time.Minute
+			if duration <= 0 {
+				duration = recommendationSlotStep
+			}
+
+			for _, hours := range workingHoursOn(rec.Doctor, day, false) {
+				from := clock(hours.StartTime)
+				if day.Equal(today) && clock(now) > from {
+					// the next step boundary after now
+					from = (clock(now) + recommendationSlotStep - 1).Truncate(recommendationSlotStep)
+				}
+
+				for start := from; start+duration <= clock(hours.FinishTime); start += recommendationSlotStep {
+					if overlaps(busy[rec.Doctor.DoctorId], start, start+duration) {
+						continue
+					}
+					if !rec.SlotFound || start < clock(rec.SlotTime) {
+						rec.SlotFound = true
+						rec.SlotDate = day
+						rec.SlotTime = time.Time{}.Add(start)
+					}
+					break
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// scoreRecommendations scores the sooner slot, the lower price and the higher rating better,
+// the slot and the price relative to the best and the worst of the doctors, the rating out of the maximum
+func scoreRecommendations(recommendations []*recommendation.Recommendation, now time.Time) {
+	var (
+		minWait, maxWait, minPrice, maxPrice float64
+		anySlot                              bool
+	)
+	for i, rec := range recommendations {
+		if i == 0 || rec.Price < minPrice {
+			minPrice = rec.Price
+		}
+		if i == 0 || rec.Price > maxPrice {
+			maxPrice = rec.Price
+		}
+		if !rec.SlotFound {
+			continue
+		}
+		wait := slotWait(rec, now)
+		if !anySlot || wait < minWait {
+			minWait = wait
+		}
+		if !anySlot || wait > maxWait {
+			maxWait = wait
+		}
+		anySlot = true
+	}
+
+	for _, rec := range recommendations {
+		var slotScore float64
+		if rec.SlotFound {
+			slotScore = 1 - relative(slotWait(rec, now), minWait, maxWait)
+		}
+		priceScore := 1 - relative(rec.Price, minPrice, maxPrice)
+		ratingScore := rec.Doctor.Rating / doctor_reviews.MaxRating
+
+		rec.Score = slotScoreWeight*slotScore + priceScoreWeight*priceScore + ratingScoreWeight*ratingScore
+	}
+}
+
+// workingHoursOn returns the shifts, or the breaks when breaks is set, of the doctor in effect on the day
+func workingHoursOn(doctor *recommendation.Doctor, day date.Date, breaks bool) []*recommendation.WorkingHours {
+	var response []*recommendation.WorkingHours
+	for _, hours := range doctor.WorkingHours {
+		if hours.DayOfWeek != day.Weekday() || hours.Break != breaks {
+			continue
+		}
+		if (!hours.EffectiveFrom.IsZero() && day.Before(hours.EffectiveFrom)) ||
+			(!hours.EffectiveTo.IsZero() && day.After(hours.EffectiveTo)) {
+			continue
+		}
+		response = append(response, hours)
+	}
+	return response
+}
+
+// onLeave tells whether an approved leave of the doctor covers the day
+func onLeave(doctor *recommendation.Doctor, day date.Date) bool {
+	for _, leave := range doctor.Leaves {
+		if !day.Before(leave.StartDate) && !day.After(leave.EndDate) {
+			return true
+		}
+	}
+	return false
+}
+
+// slotWait is the number of hours from now to the slot
+func slotWait(rec *recommendation.Recommendation, now time.Time) float64 {
+	return rec.SlotDate.UTC().Add(clock(rec.SlotTime)).Sub(now).Hours()
+}
+
+// relative places the value between the minimum (0) and the maximum (1), equal bounds give 0
+func relative(value, min, max float64) float64 {
+	if max <= min {
+		return 0
+	}
+	return (value - min) / (max - min)
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"booking_service/internal/entity/recommendation"
+	"booking_service/internal/entity/reschedule"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// noBusyIntervals is a day without any appointment
+type noBusyIntervals struct{}
+
+func (noBusyIntervals) ListBusyIntervals(context.Context, *reschedule.BusyIntervalsReq) ([]*reschedule.BusyInterval, error) {
+	return nil, nil
+}
+
+// slotIn is a recommendation with a slot the hours after now, or without a slot when hours is negative
+func slotIn(now time.Time, hours int, price, rating float64) *recommendation.Recommendation {
+	rec := &recommendation.Recommendation{
+		Doctor: &recommendation.Doctor{Rating: rating},
+		Price:  price,
+	}
+	if hours >= 0 {
+		slot := now.Add(time.Duration(hours) * time.Hour)
+		rec.SlotFound = true
+		rec.SlotDate = date.NewAt(slot)
+		rec.SlotTime = time.Time{}.Add(clock(slot))
+	}
+	return rec
+}
+
+func TestScoreRecommendations(t *testing.T) {
+	now := time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		recommendations []*recommendation.Recommendation
+		scores          []float64
+	}{
+		{
+			name:            "a single doctor is the soonest and the cheapest",
+			recommendations: []*recommendation.Recommendation{slotIn(now, 1, 100, 5)},
+			scores:          []float64{1},
+		},
+		{
+			name:            "a doctor without a slot scores only its price and rating",
+			recommendations: []*recommendation.Recommendation{slotIn(now, -1, 100, 0)},
+			scores:          []float64{priceScoreWeight},
+		},
+		{
+			name: "the slot and the price are relative to the other doctors",
+			recommendations: []*recommendation.Recommendation{
+				slotIn(now, 1, 100, 0),
+				slotIn(now, 2, 150, 0),
+				slotIn(now, 3, 200, 0),
+			},
+			scores: []float64{
+				slotScoreWeight + priceScoreWeight,
+				(slotScoreWeight + priceScoreWeight) / 2,
+				0,
+			},
+		},
+		{
+			name: "the rating is out of the maximum rating",
+			recommendations: []*recommendation.Recommendation{
+				slotIn(now, 1, 100, 2.5),
+				slotIn(now, 1, 100, 4),
+			},
+			scores: []float64{
+				slotScoreWeight + priceScoreWeight + ratingScoreWeight/2,
+				slotScoreWeight + priceScoreWeight + ratingScoreWeight*0.8,
+			},
+		},
+		{
+			name: "the soonest slot outweighs a cheaper and better rated doctor",
+			recommendations: []*recommendation.Recommendation{
+				slotIn(now, 1, 200, 0),
+				slotIn(now, 48, 100, 4),
+			},
+			scores: []float64{
+				slotScoreWeight,
+				priceScoreWeight + ratingScoreWeight*0.8,
+			},
+		},
+		{
+			name: "a doctor without a slot comes after the ones with a slot",
+			recommendations: []*recommendation.Recommendation{
+				slotIn(now, 24, 100, 5),
+				slotIn(now, -1, 100, 5),
+			},
+			scores: []float64{
+				slotScoreWeight + priceScoreWeight + ratingScoreWeight,
+				priceScoreWeight + ratingScoreWeight,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scoreRecommendations(tt.recommendations, now)
+			for i, rec := range tt.recommendations {
+				assert.InDelta(t, tt.scores[i], rec.Score, 1e-9, "recommendation %d", i)
+			}
+		})
+	}
+}
+
+func TestFindSlotsSkipsLeaves(t *testing.T) {
+	now := time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC)
+	today := date.NewAt(now)
+	nine, _ := time.Parse("15:04:05", "09:00:00")
+	five, _ := time.Parse("15:04:05", "17:00:00")
+
+	var hours []*recommendation.WorkingHours
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		hours = append(hours, &recommendation.WorkingHours{
+			DayOfWeek:  weekday,
+			StartTime:  nine,
+			FinishTime: five,
+		})
+	}
+
+	tests := []struct {
+		name     string
+		leaves   []*recommendation.Leave
+		slotDate date.Date
+	}{
+		{
+			name:     "no leave",
+			slotDate: today,
+		},
+		{
+			name:     "a leave covering today and the next two days",
+			leaves:   []*recommendation.Leave{{StartDate: today, EndDate: today.Add(2)}},
+			slotDate: today.Add(3),
+		},
+		{
+			name:     "a leave starting later",
+			leaves:   []*recommendation.Leave{{StartDate: today.Add(1), EndDate: today.Add(5)}},
+			slotDate: today,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &recommendation.Recommendation{
+				Doctor: &recommendation.Doctor{
+					DoctorId:     "doctor",
+					Duration:     30,
+					WorkingHours: hours,
+					Leaves:       tt.leaves,
+				},
+			}
+			usecase := &RecommendationUseCase{busy: noBusyIntervals{}}
+
+			require.NoError(t, usecase.findSlots(context.Background(), []*recommendation.Recommendation{rec}, now, 7))
+			require.True(t, rec.SlotFound)
+			assert.Equal(t, tt.slotDate, rec.SlotDate)
+			assert.Equal(t, 9*time.Hour, clock(rec.SlotTime))
+		})
+	}
+}
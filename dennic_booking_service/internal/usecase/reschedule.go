@@ -0,0 +1,384 @@
+package usecase
+
+import (
+	appointment "booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/doctor_assignment"
+	"booking_service/internal/entity/reschedule"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rickb777/date"
+)
+
+const (
+	serviceNameReschedule = "RescheduleService"
+	spanNameReschedule    = "RescheduleUsecase"
+
+	// rescheduleSlotStep is the granularity of the proposed appointment times
+	rescheduleSlotStep = 15 * time.Minute
+	// defaultRescheduleDaysAhead and maxRescheduleDaysAhead bound how far alternatives are searched
+	defaultRescheduleDaysAhead = 14
+	maxRescheduleDaysAhead     = 60
+)
+
+// RescheduleUseCase -.
+type RescheduleUseCase struct {
+	repo       Reschedule
+	doctors    DoctorDirectory
+	ctxTimeout time.Duration
+}
+
+// NewReschedule -.
+func NewReschedule(r Reschedule, doctors DoctorDirectory, ctxTimeout time.Duration) *RescheduleUseCase {
+	return &RescheduleUseCase{
+		repo:       r,
+		doctors:    doctors,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+// ProposeReschedule finds the appointments affected by the unavailable range of the doctor
+// and proposes a new slot for each of them following the strategy, nothing is changed
+func (r *RescheduleUseCase) ProposeReschedule(ctx context.Context, req *reschedule.RescheduleReq) (*reschedule.Proposals, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameReschedule, spanNameReschedule+"Propose")
+	defer span.End()
+
+	return r.propose(ctx, req)
+}
+
+// ApplyReschedule proposes new slots like ProposeReschedule, moves every appointment a slot was found for
+// and notifies its patient
+func (r *RescheduleUseCase) ApplyReschedule(ctx context.Context, req *reschedule.RescheduleReq) (*reschedule.Proposals, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameReschedule, spanNameReschedule+"Apply")
+	defer span.End()
+
+	response, err := r.propose(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var moves []*reschedule.Move
+	for _, proposal := range response.Proposals {
+		if !proposal.Found {
+			continue
+		}
+		moves = append(moves, &reschedule.Move{
+			Proposal:     proposal,
+			Notification: rescheduledNotification(proposal),
+		})
+	}
+	if len(moves) == 0 {
+		return response, nil
+	}
+
+	if err := r.repo.ApplyReschedule(ctx, moves); err != nil {
+		return nil, err
+	}
+
+	for _, move := range moves {
+		if move.Proposal.Applied {
+			response.Applied++
+		}
+	}
+
+	return response, nil
+}
+
+func (r *RescheduleUseCase) GetPatientNotifications(ctx context.Context, req *reschedule.NotificationsReq) (*reschedule.Notifications, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameReschedule, spanNameReschedule+"ListNotifications")
+	defer span.End()
+
+	return r.repo.GetPatientNotifications(ctx, req)
+}
+
+func (r *RescheduleUseCase) propose(ctx context.Context, req *reschedule.RescheduleReq) (*reschedule.Proposals, error) {
+	affected, err := r.repo.ListAffectedAppointments(ctx, &reschedule.AffectedReq{
+		DoctorId:       req.DoctorId,
+		Start:          req.Start,
+		End:            req.End,
+		AppointmentIds: req.AppointmentIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	planner := &slotPlanner{
+		repo:     r.repo,
+		doctors:  r.doctors,
+		req:      req,
+		now:      naiveNow(),
+		reserved: make(map[string][]*reschedule.BusyInterval),
+		listed:   make(map[string][]*doctor_assignment.ServiceDoctor),
+
+		specializations: make(map[string]string),
+	}
+	planner.daysAhead = req.DaysAhead
+	if planner.daysAhead <= 0 {
+		planner.daysAhead = defaultRescheduleDaysAhead
+	}
+	if planner.daysAhead > maxRescheduleDaysAhead {
+		planner.daysAhead = maxRescheduleDaysAhead
+	}
+
+	response := &reschedule.Proposals{
+		Count: int64(len(affected)),
+	}
+	for _, app := range affected {
+		proposal := &reschedule.Proposal{
+			AppointmentId:   app.Id,
+			PatientId:       app.PatientId,
+			DepartmentId:    app.DepartmentId,
+			DoctorId:        app.DoctorId,
+			DoctorServiceId: app.ServiceId,
+			AppointmentDate: app.AppointmentDate,
+			AppointmentTime: app.AppointmentTime,
+			Duration:        app.Duration,
+		}
+		if err := planner.plan(ctx, app, proposal); err != nil {
+			return nil, err
+		}
+		response.Proposals = append(response.Proposals, proposal)
+	}
+
+	return response, nil
+}
+
+// slotPlanner searches free slots for a batch of appointments,
+// slots handed out earlier in the batch are kept in reserved so they are not proposed twice
+type slotPlanner struct {
+	repo      Reschedule
+	doctors   DoctorDirectory
+	req       *reschedule.RescheduleReq
+	daysAhead int64
+	now       time.Time
+	reserved  map[string][]*reschedule.BusyInterval
+	listed    map[string][]*doctor_assignment.ServiceDoctor
+	// specializations holds the specialization of each booked service by its id
+	specializations map[string]string
+}
+
+// plan fills the new doctor and slot of the proposal with the earliest free slot found
+func (p *slotPlanner) plan(ctx context.Context, app *appointment.Appointment, proposal *reschedule.Proposal) error {
+	duration := time.Duration(app.Duration) * time.Minute
+
+	// the same doctor is only free after the range, another doctor may take the original slot
+	notBefore := p.req.End
+	if p.req.Strategy == reschedule.StrategyOtherDoctor {
+		notBefore = app.AppointmentDate.UTC().Add(clock(app.AppointmentTime))
+	}
+	if notBefore.Before(p.now) {
+		notBefore = p.now
+	}
+
+	lastDay := app.AppointmentDate.Add(date.PeriodOfDays(p.daysAhead))
+	for day := date.NewAt(notBefore); !day.After(lastDay); day = day.Add(1) {
+		candidates, err := p.candidates(ctx, app, day)
+		if err != nil {
+			return err
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+
+		busy, err := p.busy(ctx, candidates, day)
+		if err != nil {
+			return err
+		}
+
+		var (
+			best      *doctor_assignment.ServiceDoctor
+			bestStart time.Duration
+		)
+		for _, doctor := range candidates {
+			from := clock(doctor.StartTime)
+			if day.Equal(date.NewAt(notBefore)) && clock(notBefore) > from {
+				from = clock(notBefore)
+			}
+
+			for start := from; start+duration <= clock(doctor.FinishTime); start += rescheduleSlotStep {
+				if overlaps(busy[doctor.DoctorId], start, start+duration) {
+					continue
+				}
+				// the earliest slot wins, on a tie the doctor with fewer bookings of the day
+				if best == nil || start < bestStart ||
+					(start == bestStart && len(busy[doctor.DoctorId]) < len(busy[best.DoctorId])) {
+					best, bestStart = doctor, start
+				}
+				break
+			}
+		}
+		if best == nil {
+			continue
+		}
+
+		proposal.Found = true
+		proposal.NewDoctorId = best.DoctorId
+		proposal.NewDoctorServiceId = best.DoctorServiceId
+		proposal.NewAppointmentDate = day
+		proposal.NewAppointmentTime = time.Time{}.Add(bestStart)
+
+		key := busyKey(best.DoctorId, day)
+		p.reserved[key] = append(p.reserved[key], &reschedule.BusyInterval{
+			DoctorId:  best.DoctorId,
+			StartTime: time.Time{}.Add(bestStart),
+			EndTime:   time.Time{}.Add(bestStart + duration),
+		})
+		return nil
+	}
+
+	return nil
+}
+
+// candidates returns the doctors allowed by the strategy working on the day, sorted by id
+func (p *slotPlanner) candidates(ctx context.Context, app *appointment.Appointment, day date.Date) ([]*doctor_assignment.ServiceDoctor, error) {
+	req := &doctor_assignment.ServiceDoctorsReq{
+		DepartmentId:    app.DepartmentId,
+		DoctorServiceId: app.ServiceId,
+		Date:            day,
+	}
+	// another doctor takes the appointment with a service of its own, any of the specialization the booked service belongs to
+	if p.req.Strategy == reschedule.StrategyOtherDoctor {
+		specializationId, ok := p.specializations[app.ServiceId]
+		if !ok {
+			var err error
+			if specializationId, err = p.doctors.GetServiceSpecialization(ctx, app.ServiceId); err != nil {
+				return nil, err
+			}
+			p.specializations[app.ServiceId] = specializationId
+		}
+		req.SpecializationId = specializationId
+	}
+
+	// the working hours of a doctor depend on the date through their effective range, not only on the weekday
+	key := fmt.Sprintf("%s/%s/%s/%s", app.DepartmentId, app.ServiceId, req.SpecializationId, day)
+	doctors, ok := p.listed[key]
+	if !ok {
+		var err error
+		doctors, err = p.doctors.ListServiceDoctors(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(doctors, func(i, j int) bool {
+			return doctors[i].DoctorId < doctors[j].DoctorId
+		})
+		p.listed[key] = doctors
+	}
+
+	var response []*doctor_assignment.ServiceDoctor
+	for _, doctor := range doctors {
+		sameDoctor := doctor.DoctorId == app.DoctorId
+		if sameDoctor == (p.req.Strategy == reschedule.StrategySameDoctor) {
+			response = append(response, doctor)
+		}
+	}
+	return response, nil
+}
+
+// busy returns the busy intervals of the candidates on the day by doctor id,
+// including their breaks, slots reserved in this batch and the unavailable range itself
+func (p *slotPlanner) busy(ctx context.Context, candidates []*doctor_assignment.ServiceDoctor, day date.Date) (map[string][]*reschedule.BusyInterval, error) {
+	// a doctor with several shifts is a candidate once per shift, its breaks are the same for all of them
+	doctorIds := make([]string, 0, len(candidates))
+	breaks := make(map[string][]*doctor_assignment.Break, len(candidates))
+	for _, doctor := range candidates {
+		if _, ok := breaks[doctor.DoctorId]; ok {
+			continue
+		}
+		doctorIds = append(doctorIds, doctor.DoctorId)
+		breaks[doctor.DoctorId] = doctor.Breaks
+	}
+
+	intervals, err := p.repo.ListBusyIntervals(ctx, &reschedule.BusyIntervalsReq{
+		DoctorIds: doctorIds,
+		Date:      day,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := make(map[string][]*reschedule.BusyInterval, len(candidates))
+	for _, interval := range intervals {
+		response[interval.DoctorId] = append(response[interval.DoctorId], interval)
+	}
+	for _, doctorId := range doctorIds {
+		response[doctorId] = append(response[doctorId], p.reserved[busyKey(doctorId, day)]...)
+		for _, b := range breaks[doctorId] {
+			response[doctorId] = append(response[doctorId], &reschedule.BusyInterval{
+				DoctorId:  doctorId,
+				StartTime: b.StartTime,
+				EndTime:   b.FinishTime,
+			})
+		}
+	}
+
+	// the range may not be stored in doctor_availability yet, e.g. a leave being approved
+	dayStart, dayEnd := day.UTC(), day.Add(1).UTC()
+	if p.req.Start.Before(dayEnd) && p.req.End.After(dayStart) {
+		start, end := time.Duration(0), 24*time.Hour
+		if p.req.Start.After(dayStart) {
+			start = p.req.Start.Sub(dayStart)
+		}
+		if p.req.End.Before(dayEnd) {
+			end = p.req.End.Sub(dayStart)
+		}
+		response[p.req.DoctorId] = append(response[p.req.DoctorId], &reschedule.BusyInterval{
+			DoctorId:  p.req.DoctorId,
+			StartTime: time.Time{}.Add(start),
+			EndTime:   time.Time{}.Add(end),
+		})
+	}
+
+	return response, nil
+}
+
+// overlaps reports whether [start, end) intersects any of the intervals
+func overlaps(intervals []*reschedule.BusyInterval, start, end time.Duration) bool {
+	for _, interval := range intervals {
+		// an interval ending at or past midnight wraps around to the start of the day
+		intervalEnd := clock(interval.EndTime)
+		if intervalEnd <= clock(interval.StartTime) {
+			intervalEnd = 24 * time.Hour
+		}
+		if clock(interval.StartTime) < end && intervalEnd > start {
+			return true
+		}
+	}
+	return false
+}
+
+func busyKey(doctorId string, day date.Date) string {
+	return doctorId + "/" + day.String()
+}
+
+// naiveNow returns the current wall clock time as UTC, the way appointment dates and times are stored
+func naiveNow() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), now.Minute(), now.Second(), 0, time.UTC)
+}
+
+func rescheduledNotification(proposal *reschedule.Proposal) *reschedule.Notification {
+	return &reschedule.Notification{
+		PatientId:     proposal.PatientId,
+		AppointmentId: proposal.AppointmentId,
+		Kind:          reschedule.NotificationRescheduled,
+		Message: fmt.Sprintf(
+			"Your appointment on %s at %s has been moved to %s at %s because the doctor is unavailable.",
+			proposal.AppointmentDate.String(),
+			proposal.AppointmentTime.Format("15:04"),
+			proposal.NewAppointmentDate.String(),
+			proposal.NewAppointmentTime.Format("15:04"),
+		),
+	}
+}
@@ -0,0 +1,12 @@
+CREATE TABLE "booking_rules"(
+                                "id" SMALLINT PRIMARY KEY NOT NULL DEFAULT 1 CHECK ("id" = 1),
+                                "max_active_per_patient" BIGINT NOT NULL DEFAULT 0 CHECK ("max_active_per_patient" >= 0),
+                                "max_active_per_doctor" BIGINT NOT NULL DEFAULT 0 CHECK ("max_active_per_doctor" >= 0),
+                                "max_per_day" BIGINT NOT NULL DEFAULT 0 CHECK ("max_per_day" >= 0),
+                                "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                "updated_at" TIMESTAMP(0) WITHOUT TIME ZONE
+);
+
+INSERT INTO "booking_rules" ("id", "max_active_per_patient", "max_active_per_doctor", "max_per_day") VALUES (1, 5, 2, 2);
+
+CREATE INDEX "booked_appointments_patient_active_idx" ON "booked_appointments" ("patient_id", "appointment_date") WHERE "deleted_at" IS NULL AND "status" = 'waiting';
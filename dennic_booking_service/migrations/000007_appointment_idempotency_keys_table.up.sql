@@ -0,0 +1,8 @@
+CREATE TABLE "appointment_idempotency_keys"(
+                                               "idempotency_key" VARCHAR(255) PRIMARY KEY NOT NULL,
+                                               "appointment_id" INTEGER NOT NULL REFERENCES "booked_appointments" ("id") ON DELETE CASCADE,
+                                               "expires_at" TIMESTAMP(0) WITH TIME ZONE NOT NULL,
+                                               "created_at" TIMESTAMP(0) WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX "appointment_idempotency_keys_expires_at_idx" ON "appointment_idempotency_keys" ("expires_at");
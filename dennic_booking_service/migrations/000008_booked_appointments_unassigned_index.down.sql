@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "booked_appointments_doctor_date_idx";
+DROP INDEX IF EXISTS "booked_appointments_unassigned_idx";
@@ -0,0 +1,5 @@
+CREATE INDEX IF NOT EXISTS "booked_appointments_unassigned_idx" ON "booked_appointments" ("appointment_date", "appointment_time")
+    WHERE "doctor_id" IS NULL AND "deleted_at" IS NULL AND "status" = 'waiting';
+
+CREATE INDEX IF NOT EXISTS "booked_appointments_doctor_date_idx" ON "booked_appointments" ("doctor_id", "appointment_date")
+    WHERE "deleted_at" IS NULL;
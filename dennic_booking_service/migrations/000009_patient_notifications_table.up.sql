@@ -0,0 +1,11 @@
+CREATE TABLE "patient_notifications"(
+                                        "id" SERIAL PRIMARY KEY NOT NULL,
+                                        "patient_id" UUID NOT NULL,
+                                        "appointment_id" INTEGER NOT NULL REFERENCES "booked_appointments" ("id") ON DELETE CASCADE,
+                                        "kind" VARCHAR(64) NOT NULL,
+                                        "message" TEXT NOT NULL,
+                                        "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                        "read_at" TIMESTAMP(0) WITHOUT TIME ZONE
+);
+
+CREATE INDEX "patient_notifications_patient_id_idx" ON "patient_notifications" ("patient_id", "created_at");
@@ -0,0 +1,19 @@
+CREATE TABLE "doctor_reviews"(
+                                 "id" SERIAL PRIMARY KEY NOT NULL,
+                                 "appointment_id" INTEGER NOT NULL REFERENCES "booked_appointments" ("id") ON DELETE CASCADE,
+                                 "doctor_id" UUID NOT NULL,
+                                 "patient_id" UUID NOT NULL,
+                                 "rating" SMALLINT NOT NULL CHECK ("rating" BETWEEN 1 AND 5),
+                                 "comment" TEXT NOT NULL DEFAULT '',
+                                 "status" VARCHAR(255) NOT NULL DEFAULT 'pending' CHECK ("status" IN ('pending', 'approved', 'rejected')),
+                                 "moderation_note" TEXT,
+                                 "moderated_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                                 "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                 "updated_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                                 "deleted_at" TIMESTAMP(0) WITHOUT TIME ZONE
+);
+
+-- one review per appointment
+CREATE UNIQUE INDEX "doctor_reviews_appointment_id_idx" ON "doctor_reviews" ("appointment_id") WHERE "deleted_at" IS NULL;
+
+CREATE INDEX "doctor_reviews_doctor_id_idx" ON "doctor_reviews" ("doctor_id", "status") WHERE "deleted_at" IS NULL;
@@ -0,0 +1,5 @@
+-- unavailable blocks produced by an approved leave of the healthcare service, one per day of the leave
+ALTER TABLE "doctor_availability" ADD COLUMN IF NOT EXISTS "leave_id" BIGINT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "doctor_availability_leave_day_idx" ON "doctor_availability" ("leave_id", "doctor_date")
+    WHERE "leave_id" IS NOT NULL AND "deleted_at" IS NULL;
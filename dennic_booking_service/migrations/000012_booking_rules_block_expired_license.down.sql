@@ -0,0 +1 @@
+ALTER TABLE "booking_rules" DROP COLUMN IF EXISTS "block_expired_license";
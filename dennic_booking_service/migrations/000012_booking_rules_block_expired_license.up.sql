@@ -0,0 +1 @@
+ALTER TABLE "booking_rules" ADD COLUMN IF NOT EXISTS "block_expired_license" BOOLEAN NOT NULL DEFAULT false;
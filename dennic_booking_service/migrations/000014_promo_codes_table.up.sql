@@ -0,0 +1,30 @@
+CREATE TABLE "promo_codes"(
+                              "id" SERIAL PRIMARY KEY NOT NULL,
+                              "code" VARCHAR(50) NOT NULL,
+                              "kind" VARCHAR(20) NOT NULL CHECK ("kind" IN ('percent', 'fixed')),
+                              "value" FLOAT NOT NULL CHECK ("value" > 0),
+                              "max_uses" BIGINT NOT NULL DEFAULT 0 CHECK ("max_uses" >= 0),
+                              "used_count" BIGINT NOT NULL DEFAULT 0,
+                              "valid_from" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+                              "valid_until" TIMESTAMP(0) WITHOUT TIME ZONE,
+                              "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                              "updated_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                              "deleted_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                              CHECK ("kind" <> 'percent' OR "value" <= 100),
+                              CHECK ("valid_until" IS NULL OR "valid_until" > "valid_from")
+);
+
+-- codes are matched case insensitively
+CREATE UNIQUE INDEX "promo_codes_code_idx" ON "promo_codes" (upper("code")) WHERE "deleted_at" IS NULL;
+
+-- the promo code applied to an appointment and the amount it took off the payment
+CREATE TABLE "appointment_discounts"(
+                                        "appointment_id" INTEGER PRIMARY KEY NOT NULL REFERENCES "booked_appointments" ("id") ON DELETE CASCADE,
+                                        "promo_code_id" INTEGER NOT NULL REFERENCES "promo_codes" ("id"),
+                                        "promo_code" VARCHAR(50) NOT NULL,
+                                        "original_amount" FLOAT NOT NULL,
+                                        "discount_amount" FLOAT NOT NULL,
+                                        "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX "appointment_discounts_promo_code_id_idx" ON "appointment_discounts" ("promo_code_id");
@@ -0,0 +1,22 @@
+-- the lab results, scans and other files of a patient, the file itself is an object of the private document bucket
+CREATE TABLE "patient_documents"(
+                                     "id" SERIAL PRIMARY KEY NOT NULL,
+                                     "patient_id" UUID NOT NULL,
+                                     "user_id" UUID NOT NULL,
+                                     "appointment_id" INTEGER REFERENCES "booked_appointments" ("id") ON DELETE SET NULL,
+                                     "doctor_note_id" INTEGER REFERENCES "doctor_notes" ("id") ON DELETE SET NULL,
+                                     "document_type" VARCHAR(20) NOT NULL CHECK ("document_type" IN ('lab_result', 'scan', 'prescription', 'other')),
+                                     "title" VARCHAR(255) NOT NULL,
+                                     "object_name" VARCHAR(255) NOT NULL,
+                                     "content_type" VARCHAR(100) NOT NULL,
+                                     "size" BIGINT NOT NULL CHECK ("size" > 0),
+                                     "uploaded_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                     "deleted_at" TIMESTAMP(0) WITHOUT TIME ZONE
+);
+
+-- an uploaded object is attached once
+CREATE UNIQUE INDEX "patient_documents_object_name_idx" ON "patient_documents" ("object_name") WHERE "deleted_at" IS NULL;
+
+CREATE INDEX "patient_documents_patient_id_idx" ON "patient_documents" ("patient_id") WHERE "deleted_at" IS NULL;
+
+CREATE INDEX "patient_documents_appointment_id_idx" ON "patient_documents" ("appointment_id") WHERE "deleted_at" IS NULL;
@@ -0,0 +1,1065 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: booking_service/booking_rules.proto
+
+package booking_service
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// zero disables a limit, block_expired_license rejects appointments with doctors
+// whose license has expired by the appointment date
+type BookingRules struct {
+	MaxActivePerPatient  int64    `protobuf:"varint,1,opt,name=max_active_per_patient,json=maxActivePerPatient,proto3" json:"max_active_per_patient"`
+	MaxActivePerDoctor   int64    `protobuf:"varint,2,opt,name=max_active_per_doctor,json=maxActivePerDoctor,proto3" json:"max_active_per_doctor"`
+	MaxPerDay            int64    `protobuf:"varint,3,opt,name=max_per_day,json=maxPerDay,proto3" json:"max_per_day"`
+	CreatedAt            string   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	BlockExpiredLicense  bool     `protobuf:"varint,6,opt,name=block_expired_license,json=blockExpiredLicense,proto3" json:"block_expired_license"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BookingRules) Reset()         { *m = BookingRules{} }
+func (m *BookingRules) String() string { return proto.CompactTextString(m) }
+func (*BookingRules) ProtoMessage()    {}
+func (*BookingRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_811bab01ca7520b4, []int{0}
+}
+func (m *BookingRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BookingRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BookingRules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BookingRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BookingRules.Merge(m, src)
+}
+func (m *BookingRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *BookingRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_BookingRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BookingRules proto.InternalMessageInfo
+
+func (m *BookingRules) GetMaxActivePerPatient() int64 {
+	if m != nil {
+		return m.MaxActivePerPatient
+	}
+	return 0
+}
+
+func (m *BookingRules) GetMaxActivePerDoctor() int64 {
+	if m != nil {
+		return m.MaxActivePerDoctor
+	}
+	return 0
+}
+
+func (m *BookingRules) GetMaxPerDay() int64 {
+	if m != nil {
+		return m.MaxPerDay
+	}
+	return 0
+}
+
+func (m *BookingRules) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *BookingRules) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *BookingRules) GetBlockExpiredLicense() bool {
+	if m != nil {
+		return m.BlockExpiredLicense
+	}
+	return false
+}
+
+type GetBookingRulesReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBookingRulesReq) Reset()         { *m = GetBookingRulesReq{} }
+func (m *GetBookingRulesReq) String() string { return proto.CompactTextString(m) }
+func (*GetBookingRulesReq) ProtoMessage()    {}
+func (*GetBookingRulesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_811bab01ca7520b4, []int{1}
+}
+func (m *GetBookingRulesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBookingRulesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBookingRulesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBookingRulesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBookingRulesReq.Merge(m, src)
+}
+func (m *GetBookingRulesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBookingRulesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBookingRulesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBookingRulesReq proto.InternalMessageInfo
+
+type UpdateBookingRulesReq struct {
+	MaxActivePerPatient  int64    `protobuf:"varint,1,opt,name=max_active_per_patient,json=maxActivePerPatient,proto3" json:"max_active_per_patient"`
+	MaxActivePerDoctor   int64    `protobuf:"varint,2,opt,name=max_active_per_doctor,json=maxActivePerDoctor,proto3" json:"max_active_per_doctor"`
+	MaxPerDay            int64    `protobuf:"varint,3,opt,name=max_per_day,json=maxPerDay,proto3" json:"max_per_day"`
+	BlockExpiredLicense  bool     `protobuf:"varint,4,opt,name=block_expired_license,json=blockExpiredLicense,proto3" json:"block_expired_license"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateBookingRulesReq) Reset()         { *m = UpdateBookingRulesReq{} }
+func (m *UpdateBookingRulesReq) String() string { return proto.CompactTextString(m) }
+func (*UpdateBookingRulesReq) ProtoMessage()    {}
+func (*UpdateBookingRulesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_811bab01ca7520b4, []int{2}
+}
+func (m *UpdateBookingRulesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateBookingRulesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateBookingRulesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateBookingRulesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateBookingRulesReq.Merge(m, src)
+}
+func (m *UpdateBookingRulesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateBookingRulesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateBookingRulesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateBookingRulesReq proto.InternalMessageInfo
+
+func (m *UpdateBookingRulesReq) GetMaxActivePerPatient() int64 {
+	if m != nil {
+		return m.MaxActivePerPatient
+	}
+	return 0
+}
+
+func (m *UpdateBookingRulesReq) GetMaxActivePerDoctor() int64 {
+	if m != nil {
+		return m.MaxActivePerDoctor
+	}
+	return 0
+}
+
+func (m *UpdateBookingRulesReq) GetMaxPerDay() int64 {
+	if m != nil {
+		return m.MaxPerDay
+	}
+	return 0
+}
+
+func (m *UpdateBookingRulesReq) GetBlockExpiredLicense() bool {
+	if m != nil {
+		return m.BlockExpiredLicense
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*BookingRules)(nil), "booking_service.BookingRules")
+	proto.RegisterType((*GetBookingRulesReq)(nil), "booking_service.GetBookingRulesReq")
+	proto.RegisterType((*UpdateBookingRulesReq)(nil), "booking_service.UpdateBookingRulesReq")
+}
+
+func init() {
+	proto.RegisterFile("booking_service/booking_rules.proto", fileDescriptor_811bab01ca7520b4)
+}
+
+var fileDescriptor_811bab01ca7520b4 = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0xdf, 0x4a, 0x32, 0x51,
+	0x14, 0xc5, 0xbf, 0xa3, 0x7e, 0x92, 0xbb, 0xc0, 0xd8, 0x66, 0x0c, 0x81, 0x83, 0x28, 0x84, 0x57,
+	0x46, 0xfa, 0x04, 0x4a, 0xd1, 0x4d, 0x17, 0x32, 0xe1, 0x55, 0x17, 0x87, 0xe3, 0xcc, 0x26, 0x06,
+	0xff, 0x9c, 0xe9, 0x78, 0x94, 0xf1, 0x0d, 0x7a, 0x84, 0x1e, 0xa9, 0xee, 0x82, 0x5e, 0x20, 0xec,
+	0x45, 0x62, 0xce, 0x51, 0xb0, 0x99, 0xa4, 0xdb, 0x2e, 0xf7, 0xfe, 0xad, 0xb5, 0x61, 0x2f, 0x16,
+	0x34, 0x47, 0x52, 0x8e, 0xc3, 0xd9, 0x03, 0x9f, 0x93, 0x5a, 0x86, 0x3e, 0x5d, 0x6c, 0x67, 0xb5,
+	0x98, 0xd0, 0xbc, 0x1d, 0x29, 0xa9, 0x25, 0x96, 0x53, 0xa2, 0xc6, 0x53, 0x0e, 0x8e, 0xfa, 0x76,
+	0xe7, 0x25, 0x3a, 0xec, 0xc2, 0xe9, 0x54, 0xc4, 0x5c, 0xf8, 0x3a, 0x5c, 0x12, 0x8f, 0x48, 0xf1,
+	0x48, 0xe8, 0x90, 0x66, 0xda, 0x61, 0x75, 0xd6, 0xca, 0x7b, 0x95, 0xa9, 0x88, 0x7b, 0x06, 0x0e,
+	0x48, 0x0d, 0x2c, 0xc2, 0x4b, 0xa8, 0xa6, 0x4c, 0x81, 0xf4, 0xb5, 0x54, 0x4e, 0xce, 0x78, 0x70,
+	0xd7, 0x73, 0x65, 0x08, 0xba, 0x70, 0x98, 0x58, 0x8c, 0x56, 0xac, 0x9c, 0xbc, 0x11, 0x96, 0xa6,
+	0x22, 0x4e, 0x24, 0x62, 0x85, 0x35, 0x00, 0x5f, 0x91, 0xd0, 0x14, 0x70, 0xa1, 0x9d, 0x42, 0x9d,
+	0xb5, 0x4a, 0x5e, 0x69, 0xb3, 0xe9, 0xe9, 0x04, 0x2f, 0xa2, 0x60, 0x8b, 0xff, 0x5b, 0xbc, 0xd9,
+	0xf4, 0x34, 0x76, 0xa0, 0x3a, 0x9a, 0x48, 0x7f, 0xcc, 0x29, 0x8e, 0x42, 0x45, 0x01, 0x9f, 0x84,
+	0x3e, 0xcd, 0xe6, 0xe4, 0x14, 0xeb, 0xac, 0x75, 0xe0, 0x55, 0x0c, 0xbc, 0xb6, 0xec, 0xd6, 0xa2,
+	0xc6, 0x09, 0xe0, 0x0d, 0xe9, 0xdd, 0x30, 0x3c, 0x7a, 0x6c, 0xbc, 0x33, 0xa8, 0x0e, 0xcd, 0xdd,
+	0x14, 0xf9, 0x33, 0x49, 0xed, 0xfd, 0xb5, 0xb0, 0xf7, 0xd7, 0xce, 0x2b, 0x83, 0xca, 0xee, 0x3f,
+	0x77, 0xb6, 0x0e, 0x38, 0x84, 0x72, 0x2a, 0x03, 0x6c, 0xb6, 0x53, 0x9d, 0x69, 0x67, 0x53, 0x3a,
+	0xab, 0x65, 0x44, 0xdf, 0x6e, 0xdc, 0x03, 0x66, 0x33, 0xc4, 0xf3, 0x8c, 0xe9, 0xc7, 0xa0, 0x7f,
+	0x39, 0xde, 0x3f, 0x7e, 0x59, 0xbb, 0xec, 0x6d, 0xed, 0xb2, 0x8f, 0xb5, 0xcb, 0x9e, 0x3f, 0xdd,
+	0x7f, 0xa3, 0xa2, 0x29, 0x7b, 0xf7, 0x6b, 0x00, 0xd4, 0xd3, 0xff, 0x04, 0x13, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BookingRulesServiceClient is the client API for BookingRulesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BookingRulesServiceClient interface {
+	// bookingRules
+	GetBookingRules(ctx context.Context, in *GetBookingRulesReq, opts ...grpc.CallOption) (*BookingRules, error)
+	UpdateBookingRules(ctx context.Context, in *UpdateBookingRulesReq, opts ...grpc.CallOption) (*BookingRules, error)
+}
+
+type bookingRulesServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBookingRulesServiceClient(cc *grpc.ClientConn) BookingRulesServiceClient {
+	return &bookingRulesServiceClient{cc}
+}
+
+func (c *bookingRulesServiceClient) GetBookingRules(ctx context.Context, in *GetBookingRulesReq, opts ...grpc.CallOption) (*BookingRules, error) {
+	out := new(BookingRules)
+	err := c.cc.Invoke(ctx, "/booking_service.BookingRulesService/GetBookingRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingRulesServiceClient) UpdateBookingRules(ctx context.Context, in *UpdateBookingRulesReq, opts ...grpc.CallOption) (*BookingRules, error) {
+	out := new(BookingRules)
+	err := c.cc.Invoke(ctx, "/booking_service.BookingRulesService/UpdateBookingRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookingRulesServiceServer is the server API for BookingRulesService service.
+type BookingRulesServiceServer interface {
+	// bookingRules
+	GetBookingRules(context.Context, *GetBookingRulesReq) (*BookingRules, error)
+	UpdateBookingRules(context.Context, *UpdateBookingRulesReq) (*BookingRules, error)
+}
+
+// UnimplementedBookingRulesServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedBookingRulesServiceServer struct {
+}
+
+func (*UnimplementedBookingRulesServiceServer) GetBookingRules(ctx context.Context, req *GetBookingRulesReq) (*BookingRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingRules not implemented")
+}
+func (*UnimplementedBookingRulesServiceServer) UpdateBookingRules(ctx context.Context, req *UpdateBookingRulesReq) (*BookingRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBookingRules not implemented")
+}
+
+func RegisterBookingRulesServiceServer(s *grpc.Server, srv BookingRulesServiceServer) {
+	s.RegisterService(&_BookingRulesService_serviceDesc, srv)
+}
+
+func _BookingRulesService_GetBookingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingRulesServiceServer).GetBookingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.BookingRulesService/GetBookingRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingRulesServiceServer).GetBookingRules(ctx, req.(*GetBookingRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingRulesService_UpdateBookingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookingRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingRulesServiceServer).UpdateBookingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.BookingRulesService/UpdateBookingRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingRulesServiceServer).UpdateBookingRules(ctx, req.(*UpdateBookingRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BookingRulesService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "booking_service.BookingRulesService",
+	HandlerType: (*BookingRulesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBookingRules",
+			Handler:    _BookingRulesService_GetBookingRules_Handler,
+		},
+		{
+			MethodName: "UpdateBookingRules",
+			Handler:    _BookingRulesService_UpdateBookingRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking_service/booking_rules.proto",
+}
+
+func (m *BookingRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BookingRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BookingRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BlockExpiredLicense {
+		i--
+		if m.BlockExpiredLicense {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintBookingRules(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintBookingRules(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxPerDay != 0 {
+		i = encodeVarintBookingRules(dAtA, i, uint64(m.MaxPerDay))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxActivePerDoctor != 0 {
+		i = encodeVarintBookingRules(dAtA, i, uint64(m.MaxActivePerDoctor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxActivePerPatient != 0 {
+		i = encodeVarintBookingRules(dAtA, i, uint64(m.MaxActivePerPatient))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBookingRulesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBookingRulesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBookingRulesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateBookingRulesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateBookingRulesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateBookingRulesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BlockExpiredLicense {
+		i--
+		if m.BlockExpiredLicense {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxPerDay != 0 {
+		i = encodeVarintBookingRules(dAtA, i, uint64(m.MaxPerDay))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxActivePerDoctor != 0 {
+		i = encodeVarintBookingRules(dAtA, i, uint64(m.MaxActivePerDoctor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxActivePerPatient != 0 {
+		i = encodeVarintBookingRules(dAtA, i, uint64(m.MaxActivePerPatient))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBookingRules(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBookingRules(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BookingRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxActivePerPatient != 0 {
+		n += 1 + sovBookingRules(uint64(m.MaxActivePerPatient))
+	}
+	if m.MaxActivePerDoctor != 0 {
+		n += 1 + sovBookingRules(uint64(m.MaxActivePerDoctor))
+	}
+	if m.MaxPerDay != 0 {
+		n += 1 + sovBookingRules(uint64(m.MaxPerDay))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovBookingRules(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovBookingRules(uint64(l))
+	}
+	if m.BlockExpiredLicense {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetBookingRulesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateBookingRulesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxActivePerPatient != 0 {
+		n += 1 + sovBookingRules(uint64(m.MaxActivePerPatient))
+	}
+	if m.MaxActivePerDoctor != 0 {
+		n += 1 + sovBookingRules(uint64(m.MaxActivePerDoctor))
+	}
+	if m.MaxPerDay != 0 {
+		n += 1 + sovBookingRules(uint64(m.MaxPerDay))
+	}
+	if m.BlockExpiredLicense {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovBookingRules(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBookingRules(x uint64) (n int) {
+	return sovBookingRules(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BookingRules) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBookingRules
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BookingRules: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BookingRules: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActivePerPatient", wireType)
+			}
+			m.MaxActivePerPatient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookingRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActivePerPatient |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActivePerDoctor", wireType)
+			}
+			m.MaxActivePerDoctor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookingRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActivePerDoctor |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerDay", wireType)
+			}
+			m.MaxPerDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookingRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerDay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookingRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookingRules
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookingRules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookingRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookingRules
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookingRules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockExpiredLicense", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookingRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockExpiredLicense = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBookingRules(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBookingRules
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBookingRulesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBookingRules
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBookingRulesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBookingRulesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBookingRules(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBookingRules
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateBookingRulesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBookingRules
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateBookingRulesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateBookingRulesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActivePerPatient", wireType)
+			}
+			m.MaxActivePerPatient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookingRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActivePerPatient |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActivePerDoctor", wireType)
+			}
+			m.MaxActivePerDoctor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookingRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActivePerDoctor |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerDay", wireType)
+			}
+			m.MaxPerDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookingRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerDay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockExpiredLicense", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookingRules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockExpiredLicense = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBookingRules(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBookingRules
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBookingRules(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBookingRules
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBookingRules
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBookingRules
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBookingRules
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBookingRules
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBookingRules
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBookingRules        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBookingRules          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBookingRules = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,2907 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: booking_service/reschedule.proto
+
+package booking_service
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// RescheduleReq selects the waiting appointments of a doctor overlapping the unavailable range,
+// strategy is either "same_doctor" (later slots of the same doctor)
+// or "other_doctor" (another doctor of the department offering the same service)
+type RescheduleReq struct {
+	DoctorId             string   `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	StartDate            string   `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date"`
+	StartTime            string   `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	EndDate              string   `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date"`
+	EndTime              string   `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time"`
+	Strategy             string   `protobuf:"bytes,6,opt,name=strategy,proto3" json:"strategy"`
+	DaysAhead            int64    `protobuf:"varint,7,opt,name=days_ahead,json=daysAhead,proto3" json:"days_ahead"`
+	AppointmentIds       []int64  `protobuf:"varint,8,rep,packed,name=appointment_ids,json=appointmentIds,proto3" json:"appointment_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RescheduleReq) Reset()         { *m = RescheduleReq{} }
+func (m *RescheduleReq) String() string { return proto.CompactTextString(m) }
+func (*RescheduleReq) ProtoMessage()    {}
+func (*RescheduleReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2898ab8b29a71c2, []int{0}
+}
+func (m *RescheduleReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RescheduleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RescheduleReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RescheduleReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RescheduleReq.Merge(m, src)
+}
+func (m *RescheduleReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RescheduleReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RescheduleReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RescheduleReq proto.InternalMessageInfo
+
+func (m *RescheduleReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *RescheduleReq) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *RescheduleReq) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *RescheduleReq) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *RescheduleReq) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *RescheduleReq) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *RescheduleReq) GetDaysAhead() int64 {
+	if m != nil {
+		return m.DaysAhead
+	}
+	return 0
+}
+
+func (m *RescheduleReq) GetAppointmentIds() []int64 {
+	if m != nil {
+		return m.AppointmentIds
+	}
+	return nil
+}
+
+type RescheduleProposal struct {
+	AppointmentId        int64    `protobuf:"varint,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
+	PatientId            string   `protobuf:"bytes,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	DepartmentId         string   `protobuf:"bytes,3,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	DoctorId             string   `protobuf:"bytes,4,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	DoctorServiceId      string   `protobuf:"bytes,5,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	AppointmentDate      string   `protobuf:"bytes,6,opt,name=appointment_date,json=appointmentDate,proto3" json:"appointment_date"`
+	AppointmentTime      string   `protobuf:"bytes,7,opt,name=appointment_time,json=appointmentTime,proto3" json:"appointment_time"`
+	Duration             int64    `protobuf:"varint,8,opt,name=duration,proto3" json:"duration"`
+	NewDoctorId          string   `protobuf:"bytes,9,opt,name=new_doctor_id,json=newDoctorId,proto3" json:"new_doctor_id"`
+	NewDoctorServiceId   string   `protobuf:"bytes,10,opt,name=new_doctor_service_id,json=newDoctorServiceId,proto3" json:"new_doctor_service_id"`
+	NewAppointmentDate   string   `protobuf:"bytes,11,opt,name=new_appointment_date,json=newAppointmentDate,proto3" json:"new_appointment_date"`
+	NewAppointmentTime   string   `protobuf:"bytes,12,opt,name=new_appointment_time,json=newAppointmentTime,proto3" json:"new_appointment_time"`
+	Found                bool     `protobuf:"varint,13,opt,name=found,proto3" json:"found"`
+	Applied              bool     `protobuf:"varint,14,opt,name=applied,proto3" json:"applied"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RescheduleProposal) Reset()         { *m = RescheduleProposal{} }
+func (m *RescheduleProposal) String() string { return proto.CompactTextString(m) }
+func (*RescheduleProposal) ProtoMessage()    {}
+func (*RescheduleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2898ab8b29a71c2, []int{1}
+}
+func (m *RescheduleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RescheduleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RescheduleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RescheduleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RescheduleProposal.Merge(m, src)
+}
+func (m *RescheduleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RescheduleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RescheduleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RescheduleProposal proto.InternalMessageInfo
+
+func (m *RescheduleProposal) GetAppointmentId() int64 {
+	if m != nil {
+		return m.AppointmentId
+	}
+	return 0
+}
+
+func (m *RescheduleProposal) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *RescheduleProposal) GetDepartmentId() string {
+	if m != nil {
+		return m.DepartmentId
+	}
+	return ""
+}
+
+func (m *RescheduleProposal) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *RescheduleProposal) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *RescheduleProposal) GetAppointmentDate() string {
+	if m != nil {
+		return m.AppointmentDate
+	}
+	return ""
+}
+
+func (m *RescheduleProposal) GetAppointmentTime() string {
+	if m != nil {
+		return m.AppointmentTime
+	}
+	return ""
+}
+
+func (m *RescheduleProposal) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *RescheduleProposal) GetNewDoctorId() string {
+	if m != nil {
+		return m.NewDoctorId
+	}
+	return ""
+}
+
+func (m *RescheduleProposal) GetNewDoctorServiceId() string {
+	if m != nil {
+		return m.NewDoctorServiceId
+	}
+	return ""
+}
+
+func (m *RescheduleProposal) GetNewAppointmentDate() string {
+	if m != nil {
+		return m.NewAppointmentDate
+	}
+	return ""
+}
+
+func (m *RescheduleProposal) GetNewAppointmentTime() string {
+	if m != nil {
+		return m.NewAppointmentTime
+	}
+	return ""
+}
+
+func (m *RescheduleProposal) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *RescheduleProposal) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+type RescheduleProposals struct {
+	Count                int64                 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Applied              int64                 `protobuf:"varint,2,opt,name=applied,proto3" json:"applied"`
+	Proposals            []*RescheduleProposal `protobuf:"bytes,3,rep,name=proposals,proto3" json:"proposals"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RescheduleProposals) Reset()         { *m = RescheduleProposals{} }
+func (m *RescheduleProposals) String() string { return proto.CompactTextString(m) }
+func (*RescheduleProposals) ProtoMessage()    {}
+func (*RescheduleProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2898ab8b29a71c2, []int{2}
+}
+func (m *RescheduleProposals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RescheduleProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RescheduleProposals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RescheduleProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RescheduleProposals.Merge(m, src)
+}
+func (m *RescheduleProposals) XXX_Size() int {
+	return m.Size()
+}
+func (m *RescheduleProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_RescheduleProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RescheduleProposals proto.InternalMessageInfo
+
+func (m *RescheduleProposals) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *RescheduleProposals) GetApplied() int64 {
+	if m != nil {
+		return m.Applied
+	}
+	return 0
+}
+
+func (m *RescheduleProposals) GetProposals() []*RescheduleProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+type PatientNotification struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	PatientId            string   `protobuf:"bytes,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	AppointmentId        int64    `protobuf:"varint,3,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
+	Kind                 string   `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message"`
+	CreatedAt            string   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	ReadAt               string   `protobuf:"bytes,7,opt,name=read_at,json=readAt,proto3" json:"read_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PatientNotification) Reset()         { *m = PatientNotification{} }
+func (m *PatientNotification) String() string { return proto.CompactTextString(m) }
+func (*PatientNotification) ProtoMessage()    {}
+func (*PatientNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2898ab8b29a71c2, []int{3}
+}
+func (m *PatientNotification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PatientNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PatientNotification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PatientNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatientNotification.Merge(m, src)
+}
+func (m *PatientNotification) XXX_Size() int {
+	return m.Size()
+}
+func (m *PatientNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatientNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatientNotification proto.InternalMessageInfo
+
+func (m *PatientNotification) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PatientNotification) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *PatientNotification) GetAppointmentId() int64 {
+	if m != nil {
+		return m.AppointmentId
+	}
+	return 0
+}
+
+func (m *PatientNotification) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *PatientNotification) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *PatientNotification) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *PatientNotification) GetReadAt() string {
+	if m != nil {
+		return m.ReadAt
+	}
+	return ""
+}
+
+type GetPatientNotificationsReq struct {
+	PatientId            string   `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	Page                 int64    `protobuf:"varint,2,opt,name=page,proto3" json:"page"`
+	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPatientNotificationsReq) Reset()         { *m = GetPatientNotificationsReq{} }
+func (m *GetPatientNotificationsReq) String() string { return proto.CompactTextString(m) }
+func (*GetPatientNotificationsReq) ProtoMessage()    {}
+func (*GetPatientNotificationsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2898ab8b29a71c2, []int{4}
+}
+func (m *GetPatientNotificationsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPatientNotificationsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPatientNotificationsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPatientNotificationsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPatientNotificationsReq.Merge(m, src)
+}
+func (m *GetPatientNotificationsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPatientNotificationsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPatientNotificationsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPatientNotificationsReq proto.InternalMessageInfo
+
+func (m *GetPatientNotificationsReq) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *GetPatientNotificationsReq) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *GetPatientNotificationsReq) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type PatientNotifications struct {
+	Count                int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Notifications        []*PatientNotification `protobuf:"bytes,2,rep,name=notifications,proto3" json:"notifications"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *PatientNotifications) Reset()         { *m = PatientNotifications{} }
+func (m *PatientNotifications) String() string { return proto.CompactTextString(m) }
+func (*PatientNotifications) ProtoMessage()    {}
+func (*PatientNotifications) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2898ab8b29a71c2, []int{5}
+}
+func (m *PatientNotifications) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PatientNotifications) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PatientNotifications.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PatientNotifications) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatientNotifications.Merge(m, src)
+}
+func (m *PatientNotifications) XXX_Size() int {
+	return m.Size()
+}
+func (m *PatientNotifications) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatientNotifications.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatientNotifications proto.InternalMessageInfo
+
+func (m *PatientNotifications) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PatientNotifications) GetNotifications() []*PatientNotification {
+	if m != nil {
+		return m.Notifications
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RescheduleReq)(nil), "booking_service.RescheduleReq")
+	proto.RegisterType((*RescheduleProposal)(nil), "booking_service.RescheduleProposal")
+	proto.RegisterType((*RescheduleProposals)(nil), "booking_service.RescheduleProposals")
+	proto.RegisterType((*PatientNotification)(nil), "booking_service.PatientNotification")
+	proto.RegisterType((*GetPatientNotificationsReq)(nil), "booking_service.GetPatientNotificationsReq")
+	proto.RegisterType((*PatientNotifications)(nil), "booking_service.PatientNotifications")
+}
+
+func init() { proto.RegisterFile("booking_service/reschedule.proto", fileDescriptor_b2898ab8b29a71c2) }
+
+var fileDescriptor_b2898ab8b29a71c2 = []byte{
+	// 693 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x5d, 0x6e, 0xd3, 0x4c,
+	0x14, 0xfd, 0x6c, 0xa7, 0x4d, 0x7c, 0xdb, 0x24, 0xed, 0xb4, 0x9f, 0x6a, 0x82, 0x88, 0x22, 0xb7,
+	0x15, 0x01, 0xa4, 0x02, 0x65, 0x05, 0x41, 0x95, 0x50, 0x78, 0x40, 0x95, 0x41, 0x42, 0x3c, 0x59,
+	0xd3, 0xcc, 0x6d, 0x3b, 0x6a, 0x62, 0xbb, 0x9e, 0x09, 0xa5, 0xef, 0x3c, 0xb0, 0x04, 0x36, 0xc0,
+	0x16, 0x58, 0x03, 0x8f, 0xb0, 0x03, 0x54, 0x36, 0x82, 0x3c, 0xe3, 0xbf, 0xc4, 0x09, 0xf4, 0x81,
+	0x37, 0xdf, 0x9f, 0x73, 0x67, 0xce, 0xb9, 0x67, 0x12, 0xe8, 0x9d, 0x84, 0xe1, 0x05, 0x0f, 0xce,
+	0x7c, 0x81, 0xf1, 0x7b, 0x3e, 0xc2, 0xc7, 0x31, 0x8a, 0xd1, 0x39, 0xb2, 0xe9, 0x18, 0x0f, 0xa2,
+	0x38, 0x94, 0x21, 0x69, 0xcf, 0x75, 0xb8, 0x1f, 0x4d, 0x68, 0x7a, 0x79, 0x97, 0x87, 0x97, 0xe4,
+	0x2e, 0xd8, 0x2c, 0x1c, 0xc9, 0x30, 0xf6, 0x39, 0x73, 0x8c, 0x9e, 0xd1, 0xb7, 0xbd, 0x86, 0x4e,
+	0x0c, 0x19, 0xb9, 0x07, 0x20, 0x24, 0x8d, 0xa5, 0xcf, 0xa8, 0x44, 0xc7, 0x54, 0x55, 0x5b, 0x65,
+	0x8e, 0xa8, 0xc4, 0xa2, 0x2c, 0xf9, 0x04, 0x1d, 0xab, 0x54, 0x7e, 0xc3, 0x27, 0x48, 0xee, 0x40,
+	0x03, 0x03, 0xa6, 0xb1, 0x35, 0x55, 0xac, 0x63, 0xc0, 0x14, 0x32, 0x2d, 0x29, 0xdc, 0x4a, 0x5e,
+	0x52, 0xa8, 0x0e, 0x34, 0x84, 0x8c, 0xa9, 0xc4, 0xb3, 0x6b, 0x67, 0x55, 0xdf, 0x27, 0x8b, 0x93,
+	0x03, 0x19, 0xbd, 0x16, 0x3e, 0x3d, 0x47, 0xca, 0x9c, 0x7a, 0xcf, 0xe8, 0x5b, 0x9e, 0x9d, 0x64,
+	0x06, 0x49, 0x82, 0xdc, 0x87, 0x36, 0x8d, 0xa2, 0x90, 0x07, 0x72, 0x82, 0x81, 0xf4, 0x39, 0x13,
+	0x4e, 0xa3, 0x67, 0xf5, 0x2d, 0xaf, 0x55, 0x4a, 0x0f, 0x99, 0x70, 0xbf, 0xd4, 0x80, 0x14, 0x32,
+	0x1c, 0xc7, 0x61, 0x14, 0x0a, 0x3a, 0x26, 0xfb, 0xd0, 0x9a, 0xc5, 0x2b, 0x41, 0x2c, 0xaf, 0x39,
+	0x03, 0x4f, 0x6e, 0x11, 0x51, 0xc9, 0xd3, 0x96, 0x54, 0x95, 0x34, 0x33, 0x64, 0x64, 0x17, 0x9a,
+	0x0c, 0x23, 0x1a, 0xe7, 0x43, 0xb4, 0x30, 0xeb, 0x45, 0x72, 0xc8, 0x66, 0x65, 0xaf, 0xcd, 0xc9,
+	0xfe, 0x10, 0x36, 0xd3, 0x62, 0xba, 0xb7, 0xa4, 0x49, 0xcb, 0xd4, 0xd6, 0x85, 0xd7, 0x3a, 0x3f,
+	0x64, 0xe4, 0x01, 0x6c, 0x94, 0xef, 0xac, 0xc4, 0xd6, 0xb2, 0x95, 0xb5, 0x50, 0xa2, 0xcf, 0xb5,
+	0x2a, 0xf1, 0xeb, 0x95, 0xd6, 0x6c, 0x09, 0x6c, 0x1a, 0x53, 0xc9, 0xc3, 0xc0, 0x69, 0x28, 0x0d,
+	0xf2, 0x98, 0xb8, 0xd0, 0x0c, 0xf0, 0xca, 0x2f, 0xae, 0x6f, 0xab, 0x19, 0x6b, 0x01, 0x5e, 0x1d,
+	0x65, 0x0c, 0x9e, 0xc2, 0xff, 0xa5, 0x9e, 0x12, 0x0b, 0x50, 0xbd, 0x24, 0xef, 0x2d, 0x88, 0x3c,
+	0x81, 0xed, 0x04, 0x52, 0x21, 0xb3, 0x96, 0x23, 0x06, 0x73, 0x7c, 0x16, 0x20, 0x14, 0xa7, 0xf5,
+	0x45, 0x08, 0x45, 0x6b, 0x1b, 0x56, 0x4e, 0xc3, 0x69, 0xc0, 0x9c, 0x66, 0xcf, 0xe8, 0x37, 0x3c,
+	0x1d, 0x10, 0x07, 0xea, 0x34, 0x8a, 0xc6, 0x1c, 0x99, 0xd3, 0x52, 0xf9, 0x2c, 0x74, 0x3f, 0x19,
+	0xb0, 0x55, 0xf5, 0x89, 0x48, 0xe6, 0x8c, 0xc2, 0x69, 0x20, 0x53, 0x7f, 0xe8, 0xa0, 0x3c, 0xc7,
+	0x54, 0xf9, 0x2c, 0x24, 0x03, 0xb0, 0xa3, 0x0c, 0xec, 0x58, 0x3d, 0xab, 0xbf, 0x76, 0xb8, 0x7b,
+	0x30, 0xf7, 0x36, 0x0f, 0xaa, 0x07, 0x79, 0x05, 0xca, 0xfd, 0x61, 0xc0, 0xd6, 0xb1, 0xf6, 0xd8,
+	0xab, 0x50, 0xf2, 0x53, 0x3e, 0xd2, 0xdb, 0x68, 0x81, 0x99, 0xfb, 0xd4, 0xe4, 0x7f, 0x35, 0x67,
+	0xd5, 0xe2, 0xd6, 0x22, 0x8b, 0x13, 0xa8, 0x5d, 0xf0, 0x20, 0x73, 0xa6, 0xfa, 0x4e, 0xe8, 0x4d,
+	0x50, 0x08, 0x7a, 0x96, 0x3f, 0xd9, 0x34, 0x4c, 0xce, 0x1c, 0xc5, 0x48, 0x25, 0x32, 0x9f, 0xca,
+	0xd4, 0x7d, 0x76, 0x9a, 0x19, 0x48, 0xb2, 0x03, 0xf5, 0x18, 0xa9, 0xaa, 0x69, 0xbb, 0xad, 0x26,
+	0xe1, 0x40, 0xba, 0x08, 0x9d, 0x17, 0x28, 0x17, 0xb0, 0x12, 0xc9, 0x2f, 0xd3, 0x2c, 0x13, 0x63,
+	0x9e, 0x09, 0x81, 0x5a, 0x94, 0xdc, 0x45, 0x4b, 0xad, 0xbe, 0x93, 0xbd, 0x8c, 0xf9, 0x84, 0xcb,
+	0x94, 0x94, 0x0e, 0xdc, 0x0f, 0xb0, 0xbd, 0xe8, 0x8c, 0x25, 0x5b, 0x7c, 0x09, 0xcd, 0xa0, 0xdc,
+	0xe6, 0x98, 0x6a, 0x5f, 0x7b, 0x95, 0x7d, 0x2d, 0x98, 0xe9, 0xcd, 0x42, 0x0f, 0xbf, 0x9a, 0xb0,
+	0x59, 0xac, 0x35, 0xf5, 0x3a, 0x79, 0x07, 0x9b, 0x7a, 0xc3, 0x58, 0xd4, 0x48, 0xf7, 0x0f, 0x7e,
+	0xf0, 0xf0, 0xb2, 0xb3, 0x77, 0x0b, 0xbf, 0x08, 0xf2, 0x16, 0xda, 0x83, 0x28, 0x1a, 0x5f, 0xff,
+	0xf3, 0xc1, 0x13, 0xd8, 0x59, 0xb2, 0x2a, 0xf2, 0xa8, 0x32, 0x60, 0xf9, 0x52, 0x3b, 0xfb, 0xb7,
+	0x91, 0x51, 0x3c, 0xdf, 0xf8, 0x76, 0xd3, 0x35, 0xbe, 0xdf, 0x74, 0x8d, 0x9f, 0x37, 0x5d, 0xe3,
+	0xf3, 0xaf, 0xee, 0x7f, 0x27, 0xab, 0xea, 0x1f, 0xed, 0xd9, 0xef, 0x01, 0x00, 0x9f, 0x14, 0xde,
+	0x2b, 0xf5, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RescheduleServiceClient is the client API for RescheduleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RescheduleServiceClient interface {
+	// reschedule
+	ProposeReschedule(ctx context.Context, in *RescheduleReq, opts ...grpc.CallOption) (*RescheduleProposals, error)
+	ApplyReschedule(ctx context.Context, in *RescheduleReq, opts ...grpc.CallOption) (*RescheduleProposals, error)
+	GetPatientNotifications(ctx context.Context, in *GetPatientNotificationsReq, opts ...grpc.CallOption) (*PatientNotifications, error)
+}
+
+type rescheduleServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRescheduleServiceClient(cc *grpc.ClientConn) RescheduleServiceClient {
+	return &rescheduleServiceClient{cc}
+}
+
+func (c *rescheduleServiceClient) ProposeReschedule(ctx context.Context, in *RescheduleReq, opts ...grpc.CallOption) (*RescheduleProposals, error) {
+	out := new(RescheduleProposals)
+	err := c.cc.Invoke(ctx, "/booking_service.RescheduleService/ProposeReschedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rescheduleServiceClient) ApplyReschedule(ctx context.Context, in *RescheduleReq, opts ...grpc.CallOption) (*RescheduleProposals, error) {
+	out := new(RescheduleProposals)
+	err := c.cc.Invoke(ctx, "/booking_service.RescheduleService/ApplyReschedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rescheduleServiceClient) GetPatientNotifications(ctx context.Context, in *GetPatientNotificationsReq, opts ...grpc.CallOption) (*PatientNotifications, error) {
+	out := new(PatientNotifications)
+	err := c.cc.Invoke(ctx, "/booking_service.RescheduleService/GetPatientNotifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RescheduleServiceServer is the server API for RescheduleService service.
+type RescheduleServiceServer interface {
+	// reschedule
+	ProposeReschedule(context.Context, *RescheduleReq) (*RescheduleProposals, error)
+	ApplyReschedule(context.Context, *RescheduleReq) (*RescheduleProposals, error)
+	GetPatientNotifications(context.Context, *GetPatientNotificationsReq) (*PatientNotifications, error)
+}
+
+// UnimplementedRescheduleServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRescheduleServiceServer struct {
+}
+
+func (*UnimplementedRescheduleServiceServer) ProposeReschedule(ctx context.Context, req *RescheduleReq) (*RescheduleProposals, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeReschedule not implemented")
+}
+func (*UnimplementedRescheduleServiceServer) ApplyReschedule(ctx context.Context, req *RescheduleReq) (*RescheduleProposals, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyReschedule not implemented")
+}
+func (*UnimplementedRescheduleServiceServer) GetPatientNotifications(ctx context.Context, req *GetPatientNotificationsReq) (*PatientNotifications, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientNotifications not implemented")
+}
+
+func RegisterRescheduleServiceServer(s *grpc.Server, srv RescheduleServiceServer) {
+	s.RegisterService(&_RescheduleService_serviceDesc, srv)
+}
+
+func _RescheduleService_ProposeReschedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RescheduleServiceServer).ProposeReschedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.RescheduleService/ProposeReschedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RescheduleServiceServer).ProposeReschedule(ctx, req.(*RescheduleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RescheduleService_ApplyReschedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RescheduleServiceServer).ApplyReschedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.RescheduleService/ApplyReschedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RescheduleServiceServer).ApplyReschedule(ctx, req.(*RescheduleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RescheduleService_GetPatientNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPatientNotificationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RescheduleServiceServer).GetPatientNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.RescheduleService/GetPatientNotifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RescheduleServiceServer).GetPatientNotifications(ctx, req.(*GetPatientNotificationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RescheduleService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "booking_service.RescheduleService",
+	HandlerType: (*RescheduleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProposeReschedule",
+			Handler:    _RescheduleService_ProposeReschedule_Handler,
+		},
+		{
+			MethodName: "ApplyReschedule",
+			Handler:    _RescheduleService_ApplyReschedule_Handler,
+		},
+		{
+			MethodName: "GetPatientNotifications",
+			Handler:    _RescheduleService_GetPatientNotifications_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking_service/reschedule.proto",
+}
+
+func (m *RescheduleReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RescheduleReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RescheduleReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AppointmentIds) > 0 {
+		dAtA2 := make([]byte, len(m.AppointmentIds)*10)
+		var j1 int
+		for _, num1 := range m.AppointmentIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintReschedule(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DaysAhead != 0 {
+		i = encodeVarintReschedule(dAtA, i, uint64(m.DaysAhead))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EndTime) > 0 {
+		i -= len(m.EndTime)
+		copy(dAtA[i:], m.EndTime)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.EndTime)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EndDate) > 0 {
+		i -= len(m.EndDate)
+		copy(dAtA[i:], m.EndDate)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.EndDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RescheduleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RescheduleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RescheduleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.NewAppointmentTime) > 0 {
+		i -= len(m.NewAppointmentTime)
+		copy(dAtA[i:], m.NewAppointmentTime)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.NewAppointmentTime)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.NewAppointmentDate) > 0 {
+		i -= len(m.NewAppointmentDate)
+		copy(dAtA[i:], m.NewAppointmentDate)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.NewAppointmentDate)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.NewDoctorServiceId) > 0 {
+		i -= len(m.NewDoctorServiceId)
+		copy(dAtA[i:], m.NewDoctorServiceId)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.NewDoctorServiceId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.NewDoctorId) > 0 {
+		i -= len(m.NewDoctorId)
+		copy(dAtA[i:], m.NewDoctorId)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.NewDoctorId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Duration != 0 {
+		i = encodeVarintReschedule(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AppointmentTime) > 0 {
+		i -= len(m.AppointmentTime)
+		copy(dAtA[i:], m.AppointmentTime)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.AppointmentTime)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AppointmentDate) > 0 {
+		i -= len(m.AppointmentDate)
+		copy(dAtA[i:], m.AppointmentDate)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.AppointmentDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DepartmentId) > 0 {
+		i -= len(m.DepartmentId)
+		copy(dAtA[i:], m.DepartmentId)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.DepartmentId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AppointmentId != 0 {
+		i = encodeVarintReschedule(dAtA, i, uint64(m.AppointmentId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RescheduleProposals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RescheduleProposals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RescheduleProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReschedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Applied != 0 {
+		i = encodeVarintReschedule(dAtA, i, uint64(m.Applied))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintReschedule(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PatientNotification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PatientNotification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PatientNotification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ReadAt) > 0 {
+		i -= len(m.ReadAt)
+		copy(dAtA[i:], m.ReadAt)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.ReadAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AppointmentId != 0 {
+		i = encodeVarintReschedule(dAtA, i, uint64(m.AppointmentId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintReschedule(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPatientNotificationsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPatientNotificationsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPatientNotificationsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintReschedule(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Page != 0 {
+		i = encodeVarintReschedule(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintReschedule(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PatientNotifications) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PatientNotifications) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PatientNotifications) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Notifications) > 0 {
+		for iNdEx := len(m.Notifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Notifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReschedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintReschedule(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReschedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReschedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RescheduleReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.EndDate)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.EndTime)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	if m.DaysAhead != 0 {
+		n += 1 + sovReschedule(uint64(m.DaysAhead))
+	}
+	if len(m.AppointmentIds) > 0 {
+		l = 0
+		for _, e := range m.AppointmentIds {
+			l += sovReschedule(uint64(e))
+		}
+		n += 1 + sovReschedule(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RescheduleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppointmentId != 0 {
+		n += 1 + sovReschedule(uint64(m.AppointmentId))
+	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.DepartmentId)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.AppointmentDate)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.AppointmentTime)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovReschedule(uint64(m.Duration))
+	}
+	l = len(m.NewDoctorId)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.NewDoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.NewAppointmentDate)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.NewAppointmentTime)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	if m.Found {
+		n += 2
+	}
+	if m.Applied {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RescheduleProposals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovReschedule(uint64(m.Count))
+	}
+	if m.Applied != 0 {
+		n += 1 + sovReschedule(uint64(m.Applied))
+	}
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovReschedule(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PatientNotification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovReschedule(uint64(m.Id))
+	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	if m.AppointmentId != 0 {
+		n += 1 + sovReschedule(uint64(m.AppointmentId))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	l = len(m.ReadAt)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPatientNotificationsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovReschedule(uint64(l))
+	}
+	if m.Page != 0 {
+		n += 1 + sovReschedule(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovReschedule(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PatientNotifications) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovReschedule(uint64(m.Count))
+	}
+	if len(m.Notifications) > 0 {
+		for _, e := range m.Notifications {
+			l = e.Size()
+			n += 1 + l + sovReschedule(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovReschedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReschedule(x uint64) (n int) {
+	return sovReschedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RescheduleReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RescheduleReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RescheduleReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaysAhead", wireType)
+			}
+			m.DaysAhead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaysAhead |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReschedule
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AppointmentIds = append(m.AppointmentIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReschedule
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthReschedule
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthReschedule
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AppointmentIds) == 0 {
+					m.AppointmentIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReschedule
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AppointmentIds = append(m.AppointmentIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RescheduleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RescheduleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RescheduleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
+			}
+			m.AppointmentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppointmentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepartmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppointmentDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppointmentTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAppointmentDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAppointmentDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAppointmentTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAppointmentTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Applied = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RescheduleProposals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RescheduleProposals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RescheduleProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			m.Applied = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Applied |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &RescheduleProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PatientNotification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PatientNotification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PatientNotification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
+			}
+			m.AppointmentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppointmentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPatientNotificationsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPatientNotificationsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPatientNotificationsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PatientNotifications) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PatientNotifications: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PatientNotifications: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notifications = append(m.Notifications, &PatientNotification{})
+			if err := m.Notifications[len(m.Notifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReschedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReschedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReschedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReschedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReschedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReschedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReschedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReschedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReschedule = fmt.Errorf("proto: unexpected end of group")
+)
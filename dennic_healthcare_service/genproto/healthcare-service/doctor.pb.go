@@ -280,6 +280,215 @@ func (m *GetReqStrSpec) GetOrderBy() string {
 	return ""
 }
 
+// doctors of a department offering the specialization of doctor_service_id and working on day_of_week,
+// only the hours in effect on date "2006-01-02" are listed, today when empty
+type GetReqServiceDoctors struct {
+	DepartmentId    string `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	DoctorServiceId string `protobuf:"bytes,2,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	DayOfWeek       string `protobuf:"bytes,3,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week"`
+	Date            string `protobuf:"bytes,4,opt,name=date,proto3" json:"date"`
+	// lists the doctors of the specialization itself, doctor_service_id is then ignored
+	SpecializationId     string   `protobuf:"bytes,5,opt,name=specialization_id,json=specializationId,proto3" json:"specialization_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReqServiceDoctors) Reset()         { *m = GetReqServiceDoctors{} }
+func (m *GetReqServiceDoctors) String() string { return proto.CompactTextString(m) }
+func (*GetReqServiceDoctors) ProtoMessage()    {}
+func (*GetReqServiceDoctors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce53f37ef6317b16, []int{3}
+}
+func (m *GetReqServiceDoctors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReqServiceDoctors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReqServiceDoctors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReqServiceDoctors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReqServiceDoctors.Merge(m, src)
+}
+func (m *GetReqServiceDoctors) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReqServiceDoctors) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReqServiceDoctors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReqServiceDoctors proto.InternalMessageInfo
+
+func (m *GetReqServiceDoctors) GetDepartmentId() string {
+	if m != nil {
+		return m.DepartmentId
+	}
+	return ""
+}
+
+func (m *GetReqServiceDoctors) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *GetReqServiceDoctors) GetDayOfWeek() string {
+	if m != nil {
+		return m.DayOfWeek
+	}
+	return ""
+}
+
+func (m *GetReqServiceDoctors) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *GetReqServiceDoctors) GetSpecializationId() string {
+	if m != nil {
+		return m.SpecializationId
+	}
+	return ""
+}
+
+// ServiceDoctor is a shift or a break (kind) of a doctor offering the service
+type ServiceDoctor struct {
+	DoctorId             string   `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	DoctorServiceId      string   `protobuf:"bytes,2,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	StartTime            string   `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	FinishTime           string   `protobuf:"bytes,4,opt,name=finish_time,json=finishTime,proto3" json:"finish_time"`
+	Kind                 string   `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceDoctor) Reset()         { *m = ServiceDoctor{} }
+func (m *ServiceDoctor) String() string { return proto.CompactTextString(m) }
+func (*ServiceDoctor) ProtoMessage()    {}
+func (*ServiceDoctor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce53f37ef6317b16, []int{4}
+}
+func (m *ServiceDoctor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceDoctor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceDoctor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceDoctor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceDoctor.Merge(m, src)
+}
+func (m *ServiceDoctor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceDoctor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceDoctor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceDoctor proto.InternalMessageInfo
+
+func (m *ServiceDoctor) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *ServiceDoctor) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *ServiceDoctor) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *ServiceDoctor) GetFinishTime() string {
+	if m != nil {
+		return m.FinishTime
+	}
+	return ""
+}
+
+func (m *ServiceDoctor) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+type ListServiceDoctors struct {
+	Doctors              []*ServiceDoctor `protobuf:"bytes,1,rep,name=doctors,proto3" json:"doctors"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListServiceDoctors) Reset()         { *m = ListServiceDoctors{} }
+func (m *ListServiceDoctors) String() string { return proto.CompactTextString(m) }
+func (*ListServiceDoctors) ProtoMessage()    {}
+func (*ListServiceDoctors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce53f37ef6317b16, []int{5}
+}
+func (m *ListServiceDoctors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListServiceDoctors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListServiceDoctors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListServiceDoctors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServiceDoctors.Merge(m, src)
+}
+func (m *ListServiceDoctors) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListServiceDoctors) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServiceDoctors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListServiceDoctors proto.InternalMessageInfo
+
+func (m *ListServiceDoctors) GetDoctors() []*ServiceDoctor {
+	if m != nil {
+		return m.Doctors
+	}
+	return nil
+}
+
 type StatusDoctor struct {
 	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -291,7 +500,7 @@ func (m *StatusDoctor) Reset()         { *m = StatusDoctor{} }
 func (m *StatusDoctor) String() string { return proto.CompactTextString(m) }
 func (*StatusDoctor) ProtoMessage()    {}
 func (*StatusDoctor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce53f37ef6317b16, []int{3}
+	return fileDescriptor_ce53f37ef6317b16, []int{6}
 }
 func (m *StatusDoctor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,7 +552,7 @@ func (m *GetAllDoctorS) Reset()         { *m = GetAllDoctorS{} }
 func (m *GetAllDoctorS) String() string { return proto.CompactTextString(m) }
 func (*GetAllDoctorS) ProtoMessage()    {}
 func (*GetAllDoctorS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce53f37ef6317b16, []int{4}
+	return fileDescriptor_ce53f37ef6317b16, []int{7}
 }
 func (m *GetAllDoctorS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -426,7 +635,7 @@ func (m *ListDoctors) Reset()         { *m = ListDoctors{} }
 func (m *ListDoctors) String() string { return proto.CompactTextString(m) }
 func (*ListDoctors) ProtoMessage()    {}
 func (*ListDoctors) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce53f37ef6317b16, []int{5}
+	return fileDescriptor_ce53f37ef6317b16, []int{8}
 }
 func (m *ListDoctors) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +690,7 @@ func (m *ListDoctorsAndHours) Reset()         { *m = ListDoctorsAndHours{} }
 func (m *ListDoctorsAndHours) String() string { return proto.CompactTextString(m) }
 func (*ListDoctorsAndHours) ProtoMessage()    {}
 func (*ListDoctorsAndHours) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce53f37ef6317b16, []int{6}
+	return fileDescriptor_ce53f37ef6317b16, []int{9}
 }
 func (m *ListDoctorsAndHours) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -552,6 +761,10 @@ type DoctorAndDoctorHours struct {
 	UpdatedAt            string        `protobuf:"bytes,25,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	DeletedAt            string        `protobuf:"bytes,26,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
 	Specializations      []*DoctorSpec `protobuf:"bytes,27,rep,name=specializations,proto3" json:"specializations"`
+	Rating               float32       `protobuf:"fixed32,28,opt,name=rating,proto3" json:"rating"`
+	ReviewCount          int64         `protobuf:"varint,29,opt,name=review_count,json=reviewCount,proto3" json:"review_count"`
+	BranchId             string        `protobuf:"bytes,30,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
+	DepartmentIds        []string      `protobuf:"bytes,31,rep,name=department_ids,json=departmentIds,proto3" json:"department_ids"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -561,7 +774,7 @@ func (m *DoctorAndDoctorHours) Reset()         { *m = DoctorAndDoctorHours{} }
 func (m *DoctorAndDoctorHours) String() string { return proto.CompactTextString(m) }
 func (*DoctorAndDoctorHours) ProtoMessage()    {}
 func (*DoctorAndDoctorHours) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce53f37ef6317b16, []int{7}
+	return fileDescriptor_ce53f37ef6317b16, []int{10}
 }
 func (m *DoctorAndDoctorHours) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,41 +992,72 @@ func (m *DoctorAndDoctorHours) GetSpecializations() []*DoctorSpec {
 	return nil
 }
 
+func (m *DoctorAndDoctorHours) GetRating() float32 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+func (m *DoctorAndDoctorHours) GetReviewCount() int64 {
+	if m != nil {
+		return m.ReviewCount
+	}
+	return 0
+}
+
+func (m *DoctorAndDoctorHours) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
+func (m *DoctorAndDoctorHours) GetDepartmentIds() []string {
+	if m != nil {
+		return m.DepartmentIds
+	}
+	return nil
+}
+
 type Doctor struct {
-	Id                   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Order                int32         `protobuf:"varint,2,opt,name=order,proto3" json:"order"`
-	FirstName            string        `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name"`
-	LastName             string        `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name"`
-	ImageUrl             string        `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url"`
-	Gender               string        `protobuf:"bytes,6,opt,name=gender,proto3" json:"gender"`
-	BirthDate            string        `protobuf:"bytes,7,opt,name=birth_date,json=birthDate,proto3" json:"birth_date"`
-	PhoneNumber          string        `protobuf:"bytes,8,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
-	Email                string        `protobuf:"bytes,9,opt,name=email,proto3" json:"email"`
-	Password             string        `protobuf:"bytes,10,opt,name=password,proto3" json:"password"`
-	Address              string        `protobuf:"bytes,11,opt,name=address,proto3" json:"address"`
-	City                 string        `protobuf:"bytes,12,opt,name=city,proto3" json:"city"`
-	Country              string        `protobuf:"bytes,13,opt,name=country,proto3" json:"country"`
-	Salary               float32       `protobuf:"fixed32,14,opt,name=salary,proto3" json:"salary"`
-	Bio                  string        `protobuf:"bytes,15,opt,name=bio,proto3" json:"bio"`
-	StartWorkDate        string        `protobuf:"bytes,16,opt,name=start_work_date,json=startWorkDate,proto3" json:"start_work_date"`
-	EndWorkDate          string        `protobuf:"bytes,17,opt,name=end_work_date,json=endWorkDate,proto3" json:"end_work_date"`
-	WorkYears            int32         `protobuf:"varint,18,opt,name=work_years,json=workYears,proto3" json:"work_years"`
-	DepartmentId         string        `protobuf:"bytes,19,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
-	RoomNumber           int32         `protobuf:"varint,20,opt,name=room_number,json=roomNumber,proto3" json:"room_number"`
-	CreatedAt            string        `protobuf:"bytes,21,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string        `protobuf:"bytes,22,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	DeletedAt            string        `protobuf:"bytes,23,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
-	Specializations      []*DoctorSpec `protobuf:"bytes,24,rep,name=specializations,proto3" json:"specializations"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Id              string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Order           int32         `protobuf:"varint,2,opt,name=order,proto3" json:"order"`
+	FirstName       string        `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name"`
+	LastName        string        `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name"`
+	ImageUrl        string        `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url"`
+	Gender          string        `protobuf:"bytes,6,opt,name=gender,proto3" json:"gender"`
+	BirthDate       string        `protobuf:"bytes,7,opt,name=birth_date,json=birthDate,proto3" json:"birth_date"`
+	PhoneNumber     string        `protobuf:"bytes,8,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
+	Email           string        `protobuf:"bytes,9,opt,name=email,proto3" json:"email"`
+	Password        string        `protobuf:"bytes,10,opt,name=password,proto3" json:"password"`
+	Address         string        `protobuf:"bytes,11,opt,name=address,proto3" json:"address"`
+	City            string        `protobuf:"bytes,12,opt,name=city,proto3" json:"city"`
+	Country         string        `protobuf:"bytes,13,opt,name=country,proto3" json:"country"`
+	Salary          float32       `protobuf:"fixed32,14,opt,name=salary,proto3" json:"salary"`
+	Bio             string        `protobuf:"bytes,15,opt,name=bio,proto3" json:"bio"`
+	StartWorkDate   string        `protobuf:"bytes,16,opt,name=start_work_date,json=startWorkDate,proto3" json:"start_work_date"`
+	EndWorkDate     string        `protobuf:"bytes,17,opt,name=end_work_date,json=endWorkDate,proto3" json:"end_work_date"`
+	WorkYears       int32         `protobuf:"varint,18,opt,name=work_years,json=workYears,proto3" json:"work_years"`
+	DepartmentId    string        `protobuf:"bytes,19,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	RoomNumber      int32         `protobuf:"varint,20,opt,name=room_number,json=roomNumber,proto3" json:"room_number"`
+	CreatedAt       string        `protobuf:"bytes,21,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt       string        `protobuf:"bytes,22,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt       string        `protobuf:"bytes,23,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	Specializations []*DoctorSpec `protobuf:"bytes,24,rep,name=specializations,proto3" json:"specializations"`
+	BranchId        string        `protobuf:"bytes,25,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
+	// department_ids are all the departments of the doctor, department_id is the main one and always among them
+	DepartmentIds        []string `protobuf:"bytes,26,rep,name=department_ids,json=departmentIds,proto3" json:"department_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Doctor) Reset()         { *m = Doctor{} }
 func (m *Doctor) String() string { return proto.CompactTextString(m) }
 func (*Doctor) ProtoMessage()    {}
 func (*Doctor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce53f37ef6317b16, []int{8}
+	return fileDescriptor_ce53f37ef6317b16, []int{11}
 }
 func (m *Doctor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,6 +1254,20 @@ func (m *Doctor) GetSpecializations() []*DoctorSpec {
 	return nil
 }
 
+func (m *Doctor) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
+func (m *Doctor) GetDepartmentIds() []string {
+	if m != nil {
+		return m.DepartmentIds
+	}
+	return nil
+}
+
 type DoctorSpec struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
@@ -1022,7 +1280,7 @@ func (m *DoctorSpec) Reset()         { *m = DoctorSpec{} }
 func (m *DoctorSpec) String() string { return proto.CompactTextString(m) }
 func (*DoctorSpec) ProtoMessage()    {}
 func (*DoctorSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce53f37ef6317b16, []int{9}
+	return fileDescriptor_ce53f37ef6317b16, []int{12}
 }
 func (m *DoctorSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1065,1022 +1323,1100 @@ func (m *DoctorSpec) GetName() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*GetReqStrDoctor)(nil), "healthcare.GetReqStrDoctor")
-	proto.RegisterType((*GetReqStrDep)(nil), "healthcare.GetReqStrDep")
-	proto.RegisterType((*GetReqStrSpec)(nil), "healthcare.GetReqStrSpec")
-	proto.RegisterType((*StatusDoctor)(nil), "healthcare.StatusDoctor")
-	proto.RegisterType((*GetAllDoctorS)(nil), "healthcare.GetAllDoctorS")
-	proto.RegisterType((*ListDoctors)(nil), "healthcare.ListDoctors")
-	proto.RegisterType((*ListDoctorsAndHours)(nil), "healthcare.ListDoctorsAndHours")
-	proto.RegisterType((*DoctorAndDoctorHours)(nil), "healthcare.DoctorAndDoctorHours")
-	proto.RegisterType((*Doctor)(nil), "healthcare.Doctor")
-	proto.RegisterType((*DoctorSpec)(nil), "healthcare.DoctorSpec")
+// rating is the average of the approved reviews of the doctor, set by the booking service
+type DoctorRating struct {
+	DoctorId             string   `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	Rating               float32  `protobuf:"fixed32,2,opt,name=rating,proto3" json:"rating"`
+	ReviewCount          int64    `protobuf:"varint,3,opt,name=review_count,json=reviewCount,proto3" json:"review_count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("healthcare-service/doctor.proto", fileDescriptor_ce53f37ef6317b16) }
-
-var fileDescriptor_ce53f37ef6317b16 = []byte{
-	// 992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0xc6, 0xbf, 0xb1, 0xcb, 0xf6, 0xda, 0xdb, 0xc9, 0x26, 0x6d, 0x2f, 0x71, 0x8c, 0x91, 0x56,
-	0x91, 0x80, 0x80, 0x16, 0x69, 0xcf, 0xd8, 0x1b, 0x04, 0x08, 0x14, 0xc4, 0x98, 0xd5, 0x8a, 0xbd,
-	0x8c, 0xda, 0x9e, 0x76, 0xdc, 0xca, 0xfc, 0xd1, 0xd3, 0x4e, 0x34, 0xbc, 0x04, 0x57, 0x2e, 0x3c,
-	0x0c, 0xe2, 0x82, 0x38, 0x71, 0xe1, 0x8e, 0xc2, 0x8b, 0xa0, 0xae, 0x6e, 0x67, 0x6c, 0xc7, 0x89,
-	0x77, 0x2f, 0x88, 0x03, 0xb7, 0xfe, 0xbe, 0x2a, 0x57, 0x57, 0x55, 0x7f, 0xdd, 0x53, 0x86, 0xa3,
-	0x19, 0x67, 0xbe, 0x9a, 0x4d, 0x98, 0xe4, 0x1f, 0x24, 0x5c, 0x5e, 0x8a, 0x09, 0xff, 0xd0, 0x8b,
-	0x26, 0x2a, 0x92, 0x27, 0xb1, 0x8c, 0x54, 0x44, 0x20, 0x73, 0xe8, 0xbf, 0x82, 0xe6, 0x67, 0x5c,
-	0x39, 0xfc, 0xfb, 0x91, 0x92, 0xa7, 0xe8, 0x44, 0xf6, 0xa0, 0x34, 0x15, 0xdc, 0xf7, 0x68, 0xae,
-	0x97, 0x3b, 0xae, 0x3a, 0x06, 0x68, 0xf6, 0x92, 0xf9, 0x73, 0x4e, 0xf3, 0x86, 0x45, 0x40, 0x1e,
-	0x43, 0x55, 0x24, 0x2e, 0x9b, 0x28, 0x71, 0xc9, 0x69, 0xa1, 0x97, 0x3b, 0xae, 0x38, 0x15, 0x91,
-	0x0c, 0x10, 0xf7, 0x7f, 0xc9, 0x41, 0x3d, 0x0b, 0xce, 0x63, 0xf2, 0x2e, 0x34, 0x3c, 0x1e, 0x33,
-	0xa9, 0x02, 0x1e, 0x2a, 0x57, 0x2c, 0x76, 0xa8, 0x67, 0xe4, 0x17, 0xde, 0x6a, 0xc8, 0xfc, 0x6a,
-	0x48, 0x42, 0xa0, 0x18, 0xb3, 0x73, 0xb3, 0x55, 0xc9, 0xc1, 0xb5, 0xce, 0xcc, 0x17, 0x81, 0x50,
-	0xb4, 0x88, 0xa4, 0x01, 0x59, 0x15, 0xa5, 0x8d, 0x55, 0x94, 0x97, 0xab, 0x68, 0x43, 0x25, 0x92,
-	0x1e, 0x97, 0xee, 0x38, 0xa5, 0x3b, 0x68, 0xd8, 0x41, 0x3c, 0x4c, 0xfb, 0xbf, 0xe7, 0xa0, 0x71,
-	0x53, 0xc3, 0x28, 0xe6, 0x13, 0xf2, 0x1e, 0x3c, 0x4c, 0x62, 0x3e, 0x11, 0xcc, 0x17, 0x3f, 0x30,
-	0x25, 0xa2, 0x30, 0x2b, 0xa4, 0xb5, 0x6a, 0xf8, 0xcf, 0x15, 0xf3, 0x04, 0xea, 0x23, 0xc5, 0xd4,
-	0x3c, 0xb1, 0x27, 0xbd, 0x0f, 0xe5, 0x04, 0x31, 0xe6, 0x5f, 0x71, 0x2c, 0xea, 0xff, 0x6c, 0x8a,
-	0x1e, 0xf8, 0xbe, 0x71, 0x1c, 0xdd, 0xa4, 0xaa, 0xfd, 0x0a, 0xeb, 0xa9, 0xe6, 0x91, 0x5c, 0x4f,
-	0xb5, 0xb0, 0x31, 0xd5, 0xe2, 0x5d, 0xa9, 0x96, 0x56, 0x52, 0x5d, 0x6d, 0x5c, 0x79, 0x4d, 0x58,
-	0xdf, 0x40, 0xed, 0x2b, 0x91, 0x28, 0x93, 0x5c, 0xa2, 0x83, 0x4f, 0xa2, 0x79, 0xa8, 0x6c, 0x76,
-	0x06, 0x90, 0xf7, 0x61, 0xc7, 0xa8, 0x3e, 0xa1, 0xf9, 0x5e, 0xe1, 0xb8, 0xf6, 0x94, 0x9c, 0x64,
-	0xba, 0x3f, 0x31, 0xbf, 0x75, 0x16, 0x2e, 0xfd, 0x18, 0x76, 0x97, 0x42, 0x0e, 0x42, 0xef, 0xf3,
-	0x68, 0x7e, 0x67, 0xe8, 0xe7, 0x50, 0x37, 0xbf, 0x73, 0x67, 0xda, 0xcb, 0xc6, 0xef, 0xdd, 0x8e,
-	0x3f, 0x08, 0x3d, 0xb3, 0xc0, 0x68, 0x4e, 0xcd, 0xcb, 0x40, 0xff, 0xd7, 0x32, 0xec, 0x6d, 0xf2,
-	0x22, 0x0f, 0x20, 0x7f, 0xa3, 0xa8, 0xbc, 0xc0, 0xde, 0x61, 0x57, 0xb0, 0xcf, 0x25, 0xc7, 0x00,
-	0x72, 0x08, 0x30, 0x15, 0x32, 0x51, 0x6e, 0xc8, 0x02, 0x6e, 0x9b, 0x5d, 0x45, 0xe6, 0x8c, 0x05,
-	0x78, 0x31, 0x7d, 0xb6, 0xb0, 0x9a, 0xa6, 0x57, 0x34, 0xb1, 0x30, 0x8a, 0x80, 0x9d, 0x73, 0x77,
-	0x2e, 0x7d, 0xdb, 0xf8, 0x0a, 0x12, 0x2f, 0xa4, 0xaf, 0x45, 0x71, 0xce, 0x43, 0xbd, 0x9f, 0x91,
-	0x95, 0x45, 0x7a, 0xc3, 0xb1, 0x90, 0x6a, 0xe6, 0x7a, 0x4c, 0x71, 0xab, 0xac, 0x2a, 0x32, 0xa7,
-	0x4c, 0x71, 0xf2, 0x0e, 0xd4, 0xe3, 0x59, 0x14, 0x72, 0x37, 0x9c, 0x07, 0x63, 0x2e, 0x69, 0x05,
-	0x1d, 0x6a, 0xc8, 0x9d, 0x21, 0xa5, 0x0b, 0xe1, 0x01, 0x13, 0x3e, 0xad, 0x1a, 0x11, 0x20, 0x20,
-	0x1d, 0xa8, 0xc4, 0x2c, 0x49, 0xae, 0x22, 0xe9, 0x51, 0x30, 0xb9, 0x2c, 0x30, 0xa1, 0xb0, 0xc3,
-	0x3c, 0x4f, 0xf2, 0x24, 0xa1, 0x35, 0xa3, 0x0f, 0x0b, 0xb5, 0x20, 0x27, 0x42, 0xa5, 0xb4, 0x8e,
-	0x34, 0xae, 0xb5, 0x37, 0x9e, 0x8f, 0x4c, 0x69, 0xc3, 0x78, 0x5b, 0x88, 0x42, 0x67, 0x3e, 0x93,
-	0x29, 0x7d, 0xd0, 0xcb, 0x1d, 0xe7, 0x1d, 0x8b, 0x74, 0x4d, 0x89, 0x62, 0x52, 0xb9, 0x4a, 0x04,
-	0x9c, 0x36, 0x4d, 0x4d, 0xc8, 0x7c, 0x2b, 0x02, 0x4e, 0x8e, 0xa0, 0x36, 0x15, 0xa1, 0x48, 0x66,
-	0xc6, 0xde, 0x42, 0x3b, 0x18, 0x0a, 0x1d, 0xba, 0x50, 0xf3, 0x58, 0xea, 0x46, 0x53, 0xf7, 0x8a,
-	0xf3, 0x0b, 0xfa, 0xd0, 0x04, 0xf0, 0x58, 0xfa, 0xf5, 0xf4, 0x25, 0xe7, 0x17, 0xa4, 0x05, 0x85,
-	0xb1, 0x88, 0x28, 0x41, 0x5e, 0x2f, 0xc9, 0x13, 0x68, 0x9a, 0x1d, 0xaf, 0x22, 0x79, 0x61, 0x5a,
-	0xb9, 0x8b, 0xd6, 0x06, 0xd2, 0x2f, 0x23, 0x79, 0x81, 0xed, 0xec, 0x43, 0x83, 0x87, 0xde, 0x92,
-	0xd7, 0x9e, 0xe9, 0x27, 0x0f, 0xbd, 0x1b, 0x9f, 0x43, 0x00, 0xb4, 0xa7, 0x9c, 0xc9, 0x84, 0x3e,
-	0x42, 0x75, 0x54, 0x35, 0xf3, 0x9d, 0x26, 0x6e, 0xbf, 0xb6, 0xfb, 0x1b, 0x5e, 0xdb, 0x23, 0xa8,
-	0xc9, 0x28, 0x0a, 0x16, 0xa7, 0x76, 0x80, 0x41, 0x40, 0x53, 0xf6, 0xd0, 0x0e, 0x01, 0x26, 0x92,
-	0x33, 0xc5, 0x3d, 0x97, 0x29, 0x4a, 0x4d, 0x85, 0x96, 0x19, 0x28, 0x6d, 0x9e, 0xc7, 0xde, 0xc2,
-	0xdc, 0x36, 0x66, 0xcb, 0x18, 0xb3, 0xc7, 0x7d, 0x6e, 0xcd, 0x1d, 0xdb, 0x1f, 0xc3, 0x0c, 0x14,
-	0xf9, 0x04, 0x9a, 0xab, 0x4f, 0x66, 0x42, 0x1f, 0xe3, 0x5d, 0xda, 0xbf, 0x7d, 0x97, 0xf4, 0xe3,
-	0xeb, 0xac, 0xbb, 0xf7, 0xff, 0x2c, 0x41, 0xd9, 0xbe, 0x66, 0xff, 0xdf, 0x9b, 0x7f, 0xed, 0xde,
-	0x58, 0x5d, 0x37, 0xef, 0xd5, 0x75, 0xeb, 0xb5, 0x74, 0xfd, 0x70, 0x9b, 0xae, 0xc9, 0x56, 0x5d,
-	0xef, 0x6e, 0xd7, 0xf5, 0xde, 0x16, 0x5d, 0x3f, 0xba, 0x5f, 0xd7, 0xfb, 0xf7, 0xeb, 0xfa, 0xe0,
-	0x35, 0x74, 0x4d, 0xdf, 0x4c, 0xd7, 0x1f, 0x01, 0x64, 0xe6, 0x5b, 0xd2, 0x26, 0x50, 0x44, 0x81,
-	0x9a, 0x59, 0x0c, 0xd7, 0x4f, 0x7f, 0x2c, 0x42, 0xc3, 0xfe, 0xc4, 0x0c, 0x7d, 0xe4, 0x19, 0xd4,
-	0x9f, 0x63, 0x41, 0xf6, 0x82, 0x6c, 0xf8, 0x00, 0x76, 0x36, 0x70, 0xe4, 0x0c, 0xbf, 0xfe, 0x06,
-	0x0c, 0x53, 0x3d, 0xc5, 0x2c, 0x3b, 0xad, 0x8d, 0x8b, 0x9d, 0xad, 0x9f, 0x3d, 0xf2, 0xe5, 0xea,
-	0x34, 0x91, 0x90, 0xf6, 0x5a, 0xbc, 0x6c, 0xd0, 0xe8, 0x1c, 0x2d, 0x9b, 0x36, 0x7d, 0x91, 0x9f,
-	0x41, 0xfd, 0x05, 0x1e, 0xc3, 0x1b, 0x16, 0xf5, 0x29, 0xd4, 0x4f, 0xf1, 0x7c, 0x2c, 0xbe, 0xb7,
-	0x26, 0xba, 0x6c, 0x5c, 0x19, 0x99, 0xce, 0xa0, 0xbd, 0x94, 0xd5, 0x30, 0x3d, 0x5d, 0x16, 0x1d,
-	0xdd, 0x1c, 0x93, 0xc7, 0x9d, 0x83, 0x3b, 0xca, 0x22, 0xaf, 0xe0, 0xed, 0x0c, 0x0e, 0xd3, 0xd1,
-	0xfa, 0x00, 0xd9, 0xde, 0x18, 0x52, 0xbb, 0x6d, 0x6d, 0xd5, 0xb0, 0xf5, 0xdb, 0x75, 0x37, 0xf7,
-	0xc7, 0x75, 0x37, 0xf7, 0xd7, 0x75, 0x37, 0xf7, 0xd3, 0xdf, 0xdd, 0xb7, 0xc6, 0x65, 0xfc, 0x03,
-	0xf0, 0xf1, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xef, 0xf0, 0xfd, 0x9c, 0x23, 0x0c, 0x00, 0x00,
+func (m *DoctorRating) Reset()         { *m = DoctorRating{} }
+func (m *DoctorRating) String() string { return proto.CompactTextString(m) }
+func (*DoctorRating) ProtoMessage()    {}
+func (*DoctorRating) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce53f37ef6317b16, []int{13}
+}
+func (m *DoctorRating) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorRating) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorRating.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoctorRating) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorRating.Merge(m, src)
+}
+func (m *DoctorRating) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorRating) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorRating.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_DoctorRating proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *DoctorRating) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
 
-// DoctorServiceClient is the client API for DoctorService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DoctorServiceClient interface {
-	CreateDoctor(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error)
-	GetDoctorById(ctx context.Context, in *GetReqStrDoctor, opts ...grpc.CallOption) (*DoctorAndDoctorHours, error)
-	GetAllDoctors(ctx context.Context, in *GetAllDoctorS, opts ...grpc.CallOption) (*ListDoctorsAndHours, error)
-	UpdateDoctor(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error)
-	DeleteDoctor(ctx context.Context, in *GetReqStrDoctor, opts ...grpc.CallOption) (*StatusDoctor, error)
-	ListDoctorsByDepartmentId(ctx context.Context, in *GetReqStrDep, opts ...grpc.CallOption) (*ListDoctors, error)
-	ListDoctorBySpecializationId(ctx context.Context, in *GetReqStrSpec, opts ...grpc.CallOption) (*ListDoctorsAndHours, error)
+func (m *DoctorRating) GetRating() float32 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
 }
 
-type doctorServiceClient struct {
-	cc *grpc.ClientConn
+func (m *DoctorRating) GetReviewCount() int64 {
+	if m != nil {
+		return m.ReviewCount
+	}
+	return 0
 }
 
-func NewDoctorServiceClient(cc *grpc.ClientConn) DoctorServiceClient {
-	return &doctorServiceClient{cc}
+// GetReqReasonDoctors selects a reason by id or, when reason_id is empty, the reason best matching the query
+type GetReqReasonDoctors struct {
+	ReasonId             string   `protobuf:"bytes,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id"`
+	Query                string   `protobuf:"bytes,2,opt,name=query,proto3" json:"query"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *doctorServiceClient) CreateDoctor(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error) {
-	out := new(Doctor)
-	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/CreateDoctor", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetReqReasonDoctors) Reset()         { *m = GetReqReasonDoctors{} }
+func (m *GetReqReasonDoctors) String() string { return proto.CompactTextString(m) }
+func (*GetReqReasonDoctors) ProtoMessage()    {}
+func (*GetReqReasonDoctors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce53f37ef6317b16, []int{14}
+}
+func (m *GetReqReasonDoctors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReqReasonDoctors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReqReasonDoctors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetReqReasonDoctors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReqReasonDoctors.Merge(m, src)
+}
+func (m *GetReqReasonDoctors) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReqReasonDoctors) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReqReasonDoctors.DiscardUnknown(m)
 }
 
-func (c *doctorServiceClient) GetDoctorById(ctx context.Context, in *GetReqStrDoctor, opts ...grpc.CallOption) (*DoctorAndDoctorHours, error) {
-	out := new(DoctorAndDoctorHours)
-	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/GetDoctorById", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetReqReasonDoctors proto.InternalMessageInfo
+
+func (m *GetReqReasonDoctors) GetReasonId() string {
+	if m != nil {
+		return m.ReasonId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *doctorServiceClient) GetAllDoctors(ctx context.Context, in *GetAllDoctorS, opts ...grpc.CallOption) (*ListDoctorsAndHours, error) {
-	out := new(ListDoctorsAndHours)
-	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/GetAllDoctors", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetReqReasonDoctors) GetQuery() string {
+	if m != nil {
+		return m.Query
 	}
-	return out, nil
+	return ""
 }
 
-func (c *doctorServiceClient) UpdateDoctor(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error) {
-	out := new(Doctor)
-	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/UpdateDoctor", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ReasonDoctorHours struct {
+	DayOfWeek            string   `protobuf:"bytes,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week"`
+	StartTime            string   `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	FinishTime           string   `protobuf:"bytes,3,opt,name=finish_time,json=finishTime,proto3" json:"finish_time"`
+	Kind                 string   `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind"`
+	EffectiveFrom        string   `protobuf:"bytes,5,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from"`
+	EffectiveTo          string   `protobuf:"bytes,6,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReasonDoctorHours) Reset()         { *m = ReasonDoctorHours{} }
+func (m *ReasonDoctorHours) String() string { return proto.CompactTextString(m) }
+func (*ReasonDoctorHours) ProtoMessage()    {}
+func (*ReasonDoctorHours) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce53f37ef6317b16, []int{15}
+}
+func (m *ReasonDoctorHours) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReasonDoctorHours) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReasonDoctorHours.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ReasonDoctorHours) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReasonDoctorHours.Merge(m, src)
+}
+func (m *ReasonDoctorHours) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReasonDoctorHours) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReasonDoctorHours.DiscardUnknown(m)
 }
 
-func (c *doctorServiceClient) DeleteDoctor(ctx context.Context, in *GetReqStrDoctor, opts ...grpc.CallOption) (*StatusDoctor, error) {
-	out := new(StatusDoctor)
-	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/DeleteDoctor", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReasonDoctorHours proto.InternalMessageInfo
+
+func (m *ReasonDoctorHours) GetDayOfWeek() string {
+	if m != nil {
+		return m.DayOfWeek
 	}
-	return out, nil
+	return ""
 }
 
-func (c *doctorServiceClient) ListDoctorsByDepartmentId(ctx context.Context, in *GetReqStrDep, opts ...grpc.CallOption) (*ListDoctors, error) {
-	out := new(ListDoctors)
-	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/ListDoctorsByDepartmentId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ReasonDoctorHours) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
 	}
-	return out, nil
+	return ""
 }
 
-func (c *doctorServiceClient) ListDoctorBySpecializationId(ctx context.Context, in *GetReqStrSpec, opts ...grpc.CallOption) (*ListDoctorsAndHours, error) {
-	out := new(ListDoctorsAndHours)
-	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/ListDoctorBySpecializationId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ReasonDoctorHours) GetFinishTime() string {
+	if m != nil {
+		return m.FinishTime
 	}
-	return out, nil
+	return ""
 }
 
-// DoctorServiceServer is the server API for DoctorService service.
-type DoctorServiceServer interface {
-	CreateDoctor(context.Context, *Doctor) (*Doctor, error)
-	GetDoctorById(context.Context, *GetReqStrDoctor) (*DoctorAndDoctorHours, error)
-	GetAllDoctors(context.Context, *GetAllDoctorS) (*ListDoctorsAndHours, error)
-	UpdateDoctor(context.Context, *Doctor) (*Doctor, error)
-	DeleteDoctor(context.Context, *GetReqStrDoctor) (*StatusDoctor, error)
-	ListDoctorsByDepartmentId(context.Context, *GetReqStrDep) (*ListDoctors, error)
-	ListDoctorBySpecializationId(context.Context, *GetReqStrSpec) (*ListDoctorsAndHours, error)
+func (m *ReasonDoctorHours) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
 }
 
-// UnimplementedDoctorServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedDoctorServiceServer struct {
+func (m *ReasonDoctorHours) GetEffectiveFrom() string {
+	if m != nil {
+		return m.EffectiveFrom
+	}
+	return ""
 }
 
-func (*UnimplementedDoctorServiceServer) CreateDoctor(ctx context.Context, req *Doctor) (*Doctor, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDoctor not implemented")
+func (m *ReasonDoctorHours) GetEffectiveTo() string {
+	if m != nil {
+		return m.EffectiveTo
+	}
+	return ""
 }
-func (*UnimplementedDoctorServiceServer) GetDoctorById(ctx context.Context, req *GetReqStrDoctor) (*DoctorAndDoctorHours, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorById not implemented")
+
+// ReasonDoctorLeave is an approved leave of the doctor ending today or later, the "2006-01-02" dates are inclusive
+type ReasonDoctorLeave struct {
+	StartDate            string   `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date"`
+	EndDate              string   `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedDoctorServiceServer) GetAllDoctors(ctx context.Context, req *GetAllDoctorS) (*ListDoctorsAndHours, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllDoctors not implemented")
+
+func (m *ReasonDoctorLeave) Reset()         { *m = ReasonDoctorLeave{} }
+func (m *ReasonDoctorLeave) String() string { return proto.CompactTextString(m) }
+func (*ReasonDoctorLeave) ProtoMessage()    {}
+func (*ReasonDoctorLeave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce53f37ef6317b16, []int{16}
 }
-func (*UnimplementedDoctorServiceServer) UpdateDoctor(ctx context.Context, req *Doctor) (*Doctor, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDoctor not implemented")
+func (m *ReasonDoctorLeave) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedDoctorServiceServer) DeleteDoctor(ctx context.Context, req *GetReqStrDoctor) (*StatusDoctor, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDoctor not implemented")
+func (m *ReasonDoctorLeave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReasonDoctorLeave.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedDoctorServiceServer) ListDoctorsByDepartmentId(ctx context.Context, req *GetReqStrDep) (*ListDoctors, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListDoctorsByDepartmentId not implemented")
+func (m *ReasonDoctorLeave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReasonDoctorLeave.Merge(m, src)
 }
-func (*UnimplementedDoctorServiceServer) ListDoctorBySpecializationId(ctx context.Context, req *GetReqStrSpec) (*ListDoctorsAndHours, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListDoctorBySpecializationId not implemented")
+func (m *ReasonDoctorLeave) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReasonDoctorLeave) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReasonDoctorLeave.DiscardUnknown(m)
 }
 
-func RegisterDoctorServiceServer(s *grpc.Server, srv DoctorServiceServer) {
-	s.RegisterService(&_DoctorService_serviceDesc, srv)
+var xxx_messageInfo_ReasonDoctorLeave proto.InternalMessageInfo
+
+func (m *ReasonDoctorLeave) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
 }
 
-func _DoctorService_CreateDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Doctor)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ReasonDoctorLeave) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
 	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).CreateDoctor(ctx, in)
+	return ""
+}
+
+// ReasonDoctor is a doctor offering a service of the specialization of the reason, duration is in minutes,
+// doctor_service_id and duration are the ones of the service cheapest offline, each price is the lowest of the services
+type ReasonDoctor struct {
+	DoctorId             string               `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	FirstName            string               `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name"`
+	LastName             string               `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name"`
+	ImageUrl             string               `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url"`
+	DepartmentId         string               `protobuf:"bytes,5,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	DoctorServiceId      string               `protobuf:"bytes,6,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	OnlinePrice          float32              `protobuf:"fixed32,7,opt,name=online_price,json=onlinePrice,proto3" json:"online_price"`
+	OfflinePrice         float32              `protobuf:"fixed32,8,opt,name=offline_price,json=offlinePrice,proto3" json:"offline_price"`
+	Duration             int64                `protobuf:"varint,9,opt,name=duration,proto3" json:"duration"`
+	Rating               float32              `protobuf:"fixed32,10,opt,name=rating,proto3" json:"rating"`
+	ReviewCount          int64                `protobuf:"varint,11,opt,name=review_count,json=reviewCount,proto3" json:"review_count"`
+	WorkingHours         []*ReasonDoctorHours `protobuf:"bytes,12,rep,name=working_hours,json=workingHours,proto3" json:"working_hours"`
+	Leaves               []*ReasonDoctorLeave `protobuf:"bytes,13,rep,name=leaves,proto3" json:"leaves"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReasonDoctor) Reset()         { *m = ReasonDoctor{} }
+func (m *ReasonDoctor) String() string { return proto.CompactTextString(m) }
+func (*ReasonDoctor) ProtoMessage()    {}
+func (*ReasonDoctor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce53f37ef6317b16, []int{17}
+}
+func (m *ReasonDoctor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReasonDoctor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReasonDoctor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/healthcare.DoctorService/CreateDoctor",
+}
+func (m *ReasonDoctor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReasonDoctor.Merge(m, src)
+}
+func (m *ReasonDoctor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReasonDoctor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReasonDoctor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReasonDoctor proto.InternalMessageInfo
+
+func (m *ReasonDoctor) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).CreateDoctor(ctx, req.(*Doctor))
+	return ""
+}
+
+func (m *ReasonDoctor) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DoctorService_GetDoctorById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReqStrDoctor)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ReasonDoctor) GetLastName() string {
+	if m != nil {
+		return m.LastName
 	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).GetDoctorById(ctx, in)
+	return ""
+}
+
+func (m *ReasonDoctor) GetImageUrl() string {
+	if m != nil {
+		return m.ImageUrl
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/healthcare.DoctorService/GetDoctorById",
+	return ""
+}
+
+func (m *ReasonDoctor) GetDepartmentId() string {
+	if m != nil {
+		return m.DepartmentId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).GetDoctorById(ctx, req.(*GetReqStrDoctor))
+	return ""
+}
+
+func (m *ReasonDoctor) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DoctorService_GetAllDoctors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllDoctorS)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ReasonDoctor) GetOnlinePrice() float32 {
+	if m != nil {
+		return m.OnlinePrice
 	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).GetAllDoctors(ctx, in)
+	return 0
+}
+
+func (m *ReasonDoctor) GetOfflinePrice() float32 {
+	if m != nil {
+		return m.OfflinePrice
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/healthcare.DoctorService/GetAllDoctors",
+	return 0
+}
+
+func (m *ReasonDoctor) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).GetAllDoctors(ctx, req.(*GetAllDoctorS))
+	return 0
+}
+
+func (m *ReasonDoctor) GetRating() float32 {
+	if m != nil {
+		return m.Rating
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _DoctorService_UpdateDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Doctor)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ReasonDoctor) GetReviewCount() int64 {
+	if m != nil {
+		return m.ReviewCount
 	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).UpdateDoctor(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/healthcare.DoctorService/UpdateDoctor",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).UpdateDoctor(ctx, req.(*Doctor))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _DoctorService_DeleteDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReqStrDoctor)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).DeleteDoctor(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/healthcare.DoctorService/DeleteDoctor",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).DeleteDoctor(ctx, req.(*GetReqStrDoctor))
+func (m *ReasonDoctor) GetWorkingHours() []*ReasonDoctorHours {
+	if m != nil {
+		return m.WorkingHours
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _DoctorService_ListDoctorsByDepartmentId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReqStrDep)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).ListDoctorsByDepartmentId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/healthcare.DoctorService/ListDoctorsByDepartmentId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).ListDoctorsByDepartmentId(ctx, req.(*GetReqStrDep))
+func (m *ReasonDoctor) GetLeaves() []*ReasonDoctorLeave {
+	if m != nil {
+		return m.Leaves
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _DoctorService_ListDoctorBySpecializationId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReqStrSpec)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).ListDoctorBySpecializationId(ctx, in)
+type ReasonDoctors struct {
+	ReasonId             string          `protobuf:"bytes,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id"`
+	ReasonName           string          `protobuf:"bytes,2,opt,name=reason_name,json=reasonName,proto3" json:"reason_name"`
+	SpecializationId     string          `protobuf:"bytes,3,opt,name=specialization_id,json=specializationId,proto3" json:"specialization_id"`
+	Doctors              []*ReasonDoctor `protobuf:"bytes,4,rep,name=doctors,proto3" json:"doctors"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReasonDoctors) Reset()         { *m = ReasonDoctors{} }
+func (m *ReasonDoctors) String() string { return proto.CompactTextString(m) }
+func (*ReasonDoctors) ProtoMessage()    {}
+func (*ReasonDoctors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce53f37ef6317b16, []int{18}
+}
+func (m *ReasonDoctors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReasonDoctors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReasonDoctors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/healthcare.DoctorService/ListDoctorBySpecializationId",
+}
+func (m *ReasonDoctors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReasonDoctors.Merge(m, src)
+}
+func (m *ReasonDoctors) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReasonDoctors) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReasonDoctors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReasonDoctors proto.InternalMessageInfo
+
+func (m *ReasonDoctors) GetReasonId() string {
+	if m != nil {
+		return m.ReasonId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).ListDoctorBySpecializationId(ctx, req.(*GetReqStrSpec))
+	return ""
+}
+
+func (m *ReasonDoctors) GetReasonName() string {
+	if m != nil {
+		return m.ReasonName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _DoctorService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "healthcare.DoctorService",
-	HandlerType: (*DoctorServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateDoctor",
-			Handler:    _DoctorService_CreateDoctor_Handler,
-		},
-		{
-			MethodName: "GetDoctorById",
-			Handler:    _DoctorService_GetDoctorById_Handler,
-		},
-		{
-			MethodName: "GetAllDoctors",
-			Handler:    _DoctorService_GetAllDoctors_Handler,
-		},
-		{
-			MethodName: "UpdateDoctor",
-			Handler:    _DoctorService_UpdateDoctor_Handler,
-		},
-		{
-			MethodName: "DeleteDoctor",
-			Handler:    _DoctorService_DeleteDoctor_Handler,
-		},
-		{
-			MethodName: "ListDoctorsByDepartmentId",
-			Handler:    _DoctorService_ListDoctorsByDepartmentId_Handler,
-		},
-		{
-			MethodName: "ListDoctorBySpecializationId",
-			Handler:    _DoctorService_ListDoctorBySpecializationId_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "healthcare-service/doctor.proto",
+func (m *ReasonDoctors) GetSpecializationId() string {
+	if m != nil {
+		return m.SpecializationId
+	}
+	return ""
 }
 
-func (m *GetReqStrDoctor) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReasonDoctors) GetDoctors() []*ReasonDoctor {
+	if m != nil {
+		return m.Doctors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetReqStrDoctor) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RestoreDoctorReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetReqStrDoctor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IsActive {
-		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *RestoreDoctorReq) Reset()         { *m = RestoreDoctorReq{} }
+func (m *RestoreDoctorReq) String() string { return proto.CompactTextString(m) }
+func (*RestoreDoctorReq) ProtoMessage()    {}
+func (*RestoreDoctorReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce53f37ef6317b16, []int{19}
+}
+func (m *RestoreDoctorReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreDoctorReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreDoctorReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *RestoreDoctorReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreDoctorReq.Merge(m, src)
+}
+func (m *RestoreDoctorReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreDoctorReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreDoctorReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreDoctorReq proto.InternalMessageInfo
+
+func (m *RestoreDoctorReq) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetReqStrDep) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*GetReqStrDoctor)(nil), "healthcare.GetReqStrDoctor")
+	proto.RegisterType((*GetReqStrDep)(nil), "healthcare.GetReqStrDep")
+	proto.RegisterType((*GetReqStrSpec)(nil), "healthcare.GetReqStrSpec")
+	proto.RegisterType((*GetReqServiceDoctors)(nil), "healthcare.GetReqServiceDoctors")
+	proto.RegisterType((*ServiceDoctor)(nil), "healthcare.ServiceDoctor")
+	proto.RegisterType((*ListServiceDoctors)(nil), "healthcare.ListServiceDoctors")
+	proto.RegisterType((*StatusDoctor)(nil), "healthcare.StatusDoctor")
+	proto.RegisterType((*GetAllDoctorS)(nil), "healthcare.GetAllDoctorS")
+	proto.RegisterType((*ListDoctors)(nil), "healthcare.ListDoctors")
+	proto.RegisterType((*ListDoctorsAndHours)(nil), "healthcare.ListDoctorsAndHours")
+	proto.RegisterType((*DoctorAndDoctorHours)(nil), "healthcare.DoctorAndDoctorHours")
+	proto.RegisterType((*Doctor)(nil), "healthcare.Doctor")
+	proto.RegisterType((*DoctorSpec)(nil), "healthcare.DoctorSpec")
+	proto.RegisterType((*DoctorRating)(nil), "healthcare.DoctorRating")
+	proto.RegisterType((*GetReqReasonDoctors)(nil), "healthcare.GetReqReasonDoctors")
+	proto.RegisterType((*ReasonDoctorHours)(nil), "healthcare.ReasonDoctorHours")
+	proto.RegisterType((*ReasonDoctorLeave)(nil), "healthcare.ReasonDoctorLeave")
+	proto.RegisterType((*ReasonDoctor)(nil), "healthcare.ReasonDoctor")
+	proto.RegisterType((*ReasonDoctors)(nil), "healthcare.ReasonDoctors")
+	proto.RegisterType((*RestoreDoctorReq)(nil), "healthcare.RestoreDoctorReq")
+}
+
+func init() { proto.RegisterFile("healthcare-service/doctor.proto", fileDescriptor_ce53f37ef6317b16) }
+
+var fileDescriptor_ce53f37ef6317b16 = []byte{
+	// 1555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0xfe, 0xa9, 0x9b, 0xa5, 0x23, 0x2a, 0xb6, 0xc7, 0x8e, 0x43, 0x29, 0xf1, 0x25, 0xfc, 0xd1,
+	0xc0, 0xe8, 0x25, 0x2d, 0x12, 0x34, 0xeb, 0xda, 0x71, 0x2e, 0x46, 0x53, 0xb7, 0xa5, 0x13, 0x04,
+	0xc9, 0x86, 0x18, 0x8b, 0x23, 0x7b, 0x60, 0x8a, 0x54, 0x86, 0x23, 0x1b, 0xea, 0x93, 0xb4, 0x8b,
+	0xbe, 0x41, 0xd1, 0x77, 0x68, 0x57, 0x41, 0x17, 0x45, 0xfb, 0x06, 0x45, 0xfa, 0x00, 0x7d, 0x85,
+	0x62, 0xce, 0x50, 0xe2, 0x45, 0xb4, 0x64, 0x6f, 0x8a, 0x2e, 0xba, 0xe3, 0xf9, 0xce, 0xd1, 0x99,
+	0x39, 0x97, 0xef, 0xcc, 0x8c, 0x60, 0xf3, 0x84, 0x51, 0x5f, 0x9e, 0x74, 0xa9, 0x60, 0x1f, 0x45,
+	0x4c, 0x9c, 0xf1, 0x2e, 0xfb, 0xd8, 0x0b, 0xbb, 0x32, 0x14, 0x77, 0x07, 0x22, 0x94, 0x21, 0x81,
+	0xc4, 0xc0, 0x7e, 0x0d, 0x8b, 0x4f, 0x98, 0x74, 0xd8, 0x9b, 0x43, 0x29, 0xf6, 0xd0, 0x88, 0xac,
+	0x42, 0xb5, 0xc7, 0x99, 0xef, 0x59, 0xc6, 0x96, 0xb1, 0xdd, 0x70, 0xb4, 0xa0, 0xd0, 0x33, 0xea,
+	0x0f, 0x99, 0x55, 0xd2, 0x28, 0x0a, 0xe4, 0x26, 0x34, 0x78, 0xe4, 0xd2, 0xae, 0xe4, 0x67, 0xcc,
+	0x2a, 0x6f, 0x19, 0xdb, 0x75, 0xa7, 0xce, 0xa3, 0x1d, 0x94, 0xed, 0x9f, 0x0c, 0x30, 0x13, 0xe7,
+	0x6c, 0x40, 0xfe, 0x0f, 0x2d, 0x8f, 0x0d, 0xa8, 0x90, 0x7d, 0x16, 0x48, 0x97, 0x8f, 0x57, 0x30,
+	0x13, 0x70, 0xdf, 0xcb, 0xba, 0x2c, 0x65, 0x5d, 0x12, 0x02, 0x95, 0x01, 0x3d, 0xd6, 0x4b, 0x55,
+	0x1d, 0xfc, 0x56, 0x3b, 0xf3, 0x79, 0x9f, 0x4b, 0xab, 0x82, 0xa0, 0x16, 0x92, 0x28, 0xaa, 0x85,
+	0x51, 0xd4, 0xd2, 0x51, 0xb4, 0xa1, 0x1e, 0x0a, 0x8f, 0x09, 0xf7, 0x68, 0x64, 0x2d, 0xa0, 0x62,
+	0x01, 0xe5, 0xdd, 0x91, 0xfd, 0x8b, 0x01, 0xad, 0x49, 0x0c, 0x87, 0x03, 0xd6, 0x25, 0x1f, 0xc0,
+	0x72, 0x34, 0x60, 0x5d, 0x4e, 0x7d, 0xfe, 0x0d, 0x95, 0x3c, 0x0c, 0x92, 0x40, 0x96, 0xb2, 0x8a,
+	0x7f, 0x5d, 0x30, 0x6f, 0x0d, 0x58, 0x8d, 0x83, 0xd1, 0x7d, 0xa1, 0x2b, 0x1e, 0x5d, 0xae, 0x30,
+	0xef, 0xc3, 0xb2, 0x6e, 0x23, 0x37, 0xee, 0x2a, 0x65, 0xa8, 0xbb, 0x61, 0x51, 0x2b, 0x62, 0xaf,
+	0xfb, 0x1e, 0xd9, 0x80, 0xa6, 0x47, 0x47, 0x6e, 0xd8, 0x73, 0xcf, 0x19, 0x3b, 0xc5, 0x08, 0x1b,
+	0x4e, 0xc3, 0xa3, 0xa3, 0x2f, 0x7b, 0x2f, 0x19, 0x3b, 0x55, 0xa1, 0x7b, 0x54, 0x32, 0x8c, 0xb2,
+	0xe1, 0xe0, 0x77, 0x71, 0x62, 0xab, 0xc5, 0x89, 0xb5, 0x7f, 0x30, 0xa0, 0x95, 0x09, 0x42, 0xa5,
+	0x3a, 0xde, 0xde, 0x64, 0xff, 0x75, 0x0d, 0x5c, 0x71, 0xef, 0xeb, 0x00, 0x91, 0xa4, 0x42, 0xba,
+	0x92, 0xf7, 0xd9, 0x78, 0xeb, 0x88, 0x3c, 0xe7, 0x7d, 0x46, 0x36, 0xa1, 0xd9, 0xe3, 0x01, 0x8f,
+	0x4e, 0xb4, 0x5e, 0x47, 0x00, 0x1a, 0x42, 0x03, 0x02, 0x95, 0x53, 0x1e, 0x8c, 0xb7, 0x8e, 0xdf,
+	0xf6, 0x3e, 0x90, 0x67, 0x3c, 0x92, 0xb9, 0xb4, 0xdf, 0x87, 0x05, 0xbd, 0x78, 0x64, 0x19, 0x5b,
+	0xe5, 0xed, 0xe6, 0xbd, 0xf6, 0xdd, 0x84, 0x9a, 0x77, 0x33, 0xc6, 0xce, 0xd8, 0xd2, 0xbe, 0x03,
+	0xe6, 0xa1, 0xa4, 0x72, 0x18, 0xc5, 0x71, 0xaf, 0x41, 0x2d, 0x42, 0x19, 0x83, 0xae, 0x3b, 0xb1,
+	0x64, 0x7f, 0xaf, 0x3b, 0x77, 0xc7, 0xf7, 0xb5, 0xe1, 0xe1, 0xa4, 0xdf, 0x94, 0x5d, 0x39, 0xdf,
+	0x6f, 0x25, 0x04, 0xf3, 0xfd, 0x56, 0x2e, 0xec, 0xb7, 0xca, 0x45, 0xfd, 0x56, 0xcd, 0xf4, 0x5b,
+	0xb6, 0xfb, 0x6b, 0xb9, 0xe9, 0xf0, 0x35, 0x34, 0x55, 0x4a, 0xc6, 0xb9, 0x58, 0x85, 0x6a, 0x37,
+	0x1c, 0x06, 0x32, 0xde, 0x9d, 0x16, 0xc8, 0x87, 0x49, 0x86, 0x4a, 0x98, 0x21, 0x92, 0xce, 0x50,
+	0x3e, 0x35, 0x03, 0x58, 0x49, 0xb9, 0xdc, 0x09, 0xbc, 0xa7, 0xe1, 0xf0, 0x42, 0xd7, 0x0f, 0xc1,
+	0x8c, 0x5b, 0xe2, 0x24, 0x1c, 0x4e, 0xfc, 0x6f, 0x4d, 0xfb, 0xdf, 0x09, 0x3c, 0xfd, 0x81, 0xde,
+	0x9c, 0xa6, 0x97, 0x08, 0xf6, 0xcf, 0x0b, 0xb0, 0x5a, 0x64, 0x45, 0xae, 0x41, 0x69, 0xd2, 0x86,
+	0x25, 0x8e, 0xb9, 0xc3, 0xac, 0x60, 0x9e, 0xab, 0x8e, 0x16, 0x54, 0xab, 0xf5, 0xb8, 0x88, 0xa4,
+	0x1b, 0xd0, 0xa4, 0xd5, 0x10, 0x39, 0xa0, 0x7d, 0x9c, 0xae, 0x3e, 0x1d, 0x6b, 0x75, 0xd2, 0xeb,
+	0x3e, 0x4d, 0x94, 0xbc, 0x4f, 0x8f, 0x99, 0x3b, 0x14, 0x7e, 0x9c, 0xf8, 0x3a, 0x02, 0x2f, 0x84,
+	0xaf, 0x9a, 0xe2, 0x98, 0x05, 0x6a, 0x3d, 0x3d, 0x1b, 0x62, 0x49, 0x2d, 0x78, 0xc4, 0x85, 0x3c,
+	0x71, 0x91, 0x7d, 0x7a, 0x3c, 0x34, 0x10, 0xd9, 0x53, 0x14, 0xbc, 0x0d, 0xe6, 0xe0, 0x24, 0x0c,
+	0x98, 0x1b, 0x0c, 0xfb, 0x47, 0x4c, 0x58, 0x75, 0x34, 0x68, 0x22, 0x76, 0x80, 0x90, 0x0a, 0x84,
+	0xf5, 0x29, 0xf7, 0xad, 0x86, 0x6e, 0x02, 0x14, 0x48, 0x07, 0xea, 0x03, 0x1a, 0x45, 0xe7, 0xa1,
+	0xf0, 0x2c, 0xd0, 0x7b, 0x19, 0xcb, 0xc4, 0x82, 0x05, 0xea, 0x79, 0x82, 0x45, 0x91, 0xd5, 0xd4,
+	0xfd, 0x11, 0x8b, 0xaa, 0x21, 0xbb, 0x5c, 0x8e, 0x2c, 0x53, 0x33, 0x45, 0x7d, 0x2b, 0x6b, 0xac,
+	0x8f, 0x18, 0x59, 0x2d, 0x6d, 0x1d, 0x8b, 0xd8, 0xe8, 0xd4, 0xa7, 0x62, 0x64, 0x5d, 0xdb, 0x32,
+	0xb6, 0x4b, 0x4e, 0x2c, 0xe5, 0xf8, 0xba, 0x38, 0x87, 0xaf, 0x4b, 0x53, 0x7c, 0xcd, 0xcd, 0xaa,
+	0xe5, 0xfc, 0xac, 0x5a, 0x82, 0xf2, 0x11, 0x0f, 0x2d, 0x82, 0xb8, 0xfa, 0x24, 0x77, 0x60, 0x51,
+	0xaf, 0x78, 0x1e, 0x8a, 0x53, 0x9d, 0xca, 0x15, 0xd4, 0xb6, 0x10, 0x7e, 0x19, 0x8a, 0x53, 0x4c,
+	0xa7, 0x0d, 0x2d, 0x16, 0x78, 0x29, 0xab, 0x55, 0x9d, 0x4f, 0x16, 0x78, 0x13, 0x9b, 0x75, 0x00,
+	0xd4, 0x8f, 0x18, 0x15, 0x91, 0x75, 0x1d, 0xbb, 0xa3, 0xa1, 0x90, 0x57, 0x8c, 0x16, 0x4d, 0xe6,
+	0xb5, 0x82, 0xc9, 0xbc, 0x09, 0x4d, 0x11, 0x86, 0xfd, 0x71, 0xd5, 0x6e, 0xa0, 0x13, 0x50, 0x50,
+	0x5c, 0xb4, 0x75, 0x80, 0xae, 0x60, 0x54, 0x32, 0xcf, 0xa5, 0xd2, 0xb2, 0x74, 0x84, 0x31, 0xb2,
+	0x23, 0x95, 0x7a, 0x38, 0xf0, 0xc6, 0xea, 0xb6, 0x56, 0xc7, 0x88, 0x56, 0x7b, 0xcc, 0x67, 0xb1,
+	0xba, 0x13, 0xe7, 0x47, 0x23, 0x3b, 0x92, 0x7c, 0x06, 0x8b, 0xd9, 0xf1, 0x1c, 0x59, 0x37, 0x91,
+	0x4b, 0x6b, 0xd3, 0x5c, 0x52, 0x27, 0xa8, 0x93, 0x37, 0x57, 0x95, 0x15, 0x54, 0xf2, 0xe0, 0xd8,
+	0xba, 0xa5, 0x2b, 0xab, 0x25, 0xd5, 0x8e, 0x82, 0x9d, 0x71, 0x76, 0xee, 0x6a, 0xfe, 0xae, 0x23,
+	0x7f, 0x9b, 0x1a, 0x7b, 0xa8, 0x20, 0xc5, 0x82, 0x23, 0x41, 0x83, 0xee, 0x89, 0xca, 0xcd, 0x86,
+	0xee, 0x3c, 0x0d, 0xec, 0x7b, 0xe4, 0x3d, 0xb8, 0x96, 0x49, 0x5e, 0x64, 0x6d, 0x6e, 0x95, 0x55,
+	0x99, 0xd2, 0xd9, 0x8b, 0xec, 0xef, 0x6a, 0x50, 0x8b, 0x87, 0xe9, 0x7f, 0xb4, 0xfd, 0xc7, 0x68,
+	0x1b, 0xd3, 0x6a, 0x71, 0x26, 0xad, 0x96, 0x2e, 0x45, 0xab, 0xe5, 0x79, 0xb4, 0x22, 0x73, 0x69,
+	0xb5, 0x32, 0x9f, 0x56, 0xab, 0x73, 0x68, 0x75, 0x7d, 0x36, 0xad, 0xd6, 0x66, 0xd3, 0xea, 0xc6,
+	0x25, 0x68, 0x65, 0x5d, 0x8d, 0x56, 0x19, 0x6e, 0xb4, 0xe7, 0x72, 0xa3, 0x53, 0xc4, 0x8d, 0x4f,
+	0x00, 0x92, 0x25, 0xa6, 0xe8, 0x41, 0xa0, 0x82, 0x4d, 0xae, 0x6f, 0x52, 0xf8, 0x6d, 0xf7, 0xc0,
+	0xd4, 0xbf, 0x70, 0x34, 0x89, 0x67, 0xde, 0xcb, 0x12, 0xe6, 0x97, 0x66, 0x32, 0xbf, 0x3c, 0xc5,
+	0x7c, 0xfb, 0x29, 0xac, 0xe8, 0xbb, 0xac, 0xc3, 0x68, 0x14, 0x06, 0xe3, 0x7b, 0xc4, 0x4d, 0x68,
+	0x08, 0x04, 0x52, 0xcb, 0x69, 0x60, 0x1f, 0xe9, 0xfc, 0x66, 0xc8, 0xc4, 0x68, 0xfc, 0x88, 0x41,
+	0xc1, 0xfe, 0xdd, 0x80, 0xe5, 0xb4, 0x13, 0x7d, 0x82, 0xe7, 0x8e, 0x05, 0x23, 0x7f, 0x2c, 0x64,
+	0x8f, 0x9d, 0xd2, 0x9c, 0x63, 0xa7, 0x7c, 0xe1, 0x35, 0xb1, 0x92, 0x5c, 0x13, 0x55, 0x51, 0x58,
+	0xaf, 0xc7, 0xf0, 0x82, 0xe4, 0xf6, 0x44, 0xd8, 0x8f, 0x27, 0x44, 0x6b, 0x82, 0x3e, 0x16, 0x61,
+	0x5f, 0x65, 0x27, 0x31, 0x93, 0x61, 0x3c, 0x2c, 0x9a, 0x13, 0xec, 0x79, 0x68, 0x7f, 0x91, 0x0d,
+	0xe9, 0x19, 0xa3, 0x67, 0x2c, 0xd9, 0x32, 0xb2, 0xc6, 0x48, 0x6d, 0x19, 0x39, 0xd3, 0x86, 0xba,
+	0xe2, 0x15, 0x2a, 0x75, 0x3c, 0x0b, 0x2c, 0xf0, 0x94, 0xca, 0xfe, 0xab, 0x0c, 0x66, 0xda, 0xdf,
+	0xec, 0xaa, 0x66, 0xe7, 0x63, 0x69, 0xe6, 0x7c, 0x2c, 0xcf, 0x9a, 0x8f, 0x95, 0xdc, 0x7c, 0x9c,
+	0xa2, 0x6d, 0xf5, 0xb2, 0xef, 0x94, 0x5a, 0xf1, 0x5d, 0xff, 0x36, 0x98, 0x61, 0xe0, 0xf3, 0x80,
+	0xb9, 0x03, 0xc1, 0xbb, 0x7a, 0xb4, 0x96, 0x9c, 0xa6, 0xc6, 0xbe, 0x52, 0x90, 0x5a, 0x33, 0xec,
+	0xf5, 0x52, 0x36, 0x75, 0xb4, 0x31, 0x63, 0x50, 0x1b, 0x75, 0xa0, 0xee, 0x0d, 0x05, 0xf2, 0x0e,
+	0x27, 0x6c, 0xd9, 0x99, 0xc8, 0xa9, 0x1e, 0x87, 0x99, 0x3d, 0xde, 0x9c, 0x3e, 0xdd, 0x76, 0xa1,
+	0xa5, 0x66, 0x16, 0x0f, 0x8e, 0xe3, 0x4b, 0xaa, 0x89, 0x13, 0x60, 0x3d, 0x3d, 0x01, 0xa6, 0x3a,
+	0xd7, 0x31, 0xe3, 0xdf, 0xa0, 0x44, 0x3e, 0x85, 0x9a, 0xaf, 0xaa, 0x1f, 0x59, 0xad, 0xd9, 0x3f,
+	0xc6, 0x1e, 0x71, 0x62, 0x63, 0xfb, 0x47, 0x03, 0x5a, 0x57, 0x60, 0x96, 0x9a, 0x95, 0x5a, 0x99,
+	0xaa, 0x39, 0x68, 0x08, 0xeb, 0x5a, 0xf8, 0xba, 0x2b, 0x5f, 0xf0, 0x6c, 0xbe, 0x97, 0x5c, 0xfb,
+	0x2b, 0xb8, 0x69, 0xeb, 0xa2, 0x4d, 0x27, 0x97, 0x7f, 0x1b, 0x96, 0x1c, 0x16, 0xc9, 0x50, 0x8c,
+	0x5f, 0x4c, 0xec, 0x4d, 0x7e, 0x5e, 0xdd, 0xfb, 0xb5, 0x06, 0xad, 0xbd, 0x74, 0x0b, 0x90, 0x07,
+	0x60, 0x3e, 0xc4, 0x81, 0xad, 0x61, 0x52, 0xf0, 0xbe, 0xe8, 0x14, 0x60, 0xe4, 0x00, 0x1f, 0x57,
+	0x5a, 0xd8, 0x1d, 0xa9, 0x97, 0x7e, 0xda, 0x28, 0xf7, 0x97, 0x4a, 0x67, 0xee, 0xab, 0x82, 0x7c,
+	0x9e, 0x7d, 0xac, 0x45, 0xa4, 0x9d, 0xf3, 0x37, 0x51, 0x1d, 0x76, 0x36, 0xd3, 0xaa, 0xa2, 0x07,
+	0xcf, 0x03, 0x30, 0x5f, 0xe0, 0x31, 0x73, 0xc5, 0xa0, 0x1e, 0x81, 0xb9, 0x87, 0xe7, 0xcf, 0x98,
+	0xe4, 0xb3, 0x62, 0xca, 0x94, 0x24, 0xf3, 0x22, 0x7d, 0x02, 0xad, 0x4c, 0x25, 0xc8, 0xad, 0x6c,
+	0xf5, 0xb2, 0x45, 0x9a, 0xe1, 0xe8, 0x00, 0xda, 0xa9, 0xf0, 0x76, 0x47, 0x7b, 0x69, 0x9a, 0x5b,
+	0xc5, 0x9b, 0x63, 0x83, 0xce, 0x8d, 0x0b, 0xf2, 0x43, 0x5e, 0xc3, 0xad, 0x44, 0xdc, 0x1d, 0x1d,
+	0xe6, 0xdb, 0xae, 0x5d, 0xe8, 0x52, 0x99, 0xcd, 0xcf, 0xf9, 0x2b, 0xb8, 0x9e, 0x82, 0x1f, 0x27,
+	0x1d, 0xb6, 0x55, 0xe0, 0x34, 0xf3, 0x37, 0x40, 0x67, 0x23, 0xef, 0x3b, 0xab, 0x27, 0x8f, 0x60,
+	0xf1, 0x90, 0xc9, 0xcc, 0xa1, 0x6a, 0x15, 0x3c, 0x83, 0x51, 0x33, 0x23, 0x9b, 0x0e, 0xac, 0x66,
+	0x77, 0xa8, 0x79, 0x44, 0x36, 0xa7, 0x37, 0x98, 0x21, 0x7e, 0xa7, 0x7d, 0x11, 0xf9, 0xa2, 0xdd,
+	0xa5, 0xb7, 0xef, 0x36, 0x8c, 0xdf, 0xde, 0x6d, 0x18, 0x7f, 0xbc, 0xdb, 0x30, 0xbe, 0xfd, 0x73,
+	0xe3, 0x7f, 0x47, 0x35, 0xfc, 0x8f, 0xf1, 0xfe, 0xdf, 0x03, 0x00, 0xd6, 0x9e, 0x6c, 0x66, 0x86,
+	0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DoctorServiceClient is the client API for DoctorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DoctorServiceClient interface {
+	CreateDoctor(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error)
+	GetDoctorById(ctx context.Context, in *GetReqStrDoctor, opts ...grpc.CallOption) (*DoctorAndDoctorHours, error)
+	GetAllDoctors(ctx context.Context, in *GetAllDoctorS, opts ...grpc.CallOption) (*ListDoctorsAndHours, error)
+	UpdateDoctor(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error)
+	DeleteDoctor(ctx context.Context, in *GetReqStrDoctor, opts ...grpc.CallOption) (*StatusDoctor, error)
+	RestoreDoctor(ctx context.Context, in *RestoreDoctorReq, opts ...grpc.CallOption) (*StatusDoctor, error)
+	ListDoctorsByDepartmentId(ctx context.Context, in *GetReqStrDep, opts ...grpc.CallOption) (*ListDoctors, error)
+	ListDoctorBySpecializationId(ctx context.Context, in *GetReqStrSpec, opts ...grpc.CallOption) (*ListDoctorsAndHours, error)
+	ListDoctorsForService(ctx context.Context, in *GetReqServiceDoctors, opts ...grpc.CallOption) (*ListServiceDoctors, error)
+	SetDoctorRating(ctx context.Context, in *DoctorRating, opts ...grpc.CallOption) (*StatusDoctor, error)
+	ListDoctorsForReason(ctx context.Context, in *GetReqReasonDoctors, opts ...grpc.CallOption) (*ReasonDoctors, error)
+}
+
+type doctorServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDoctorServiceClient(cc *grpc.ClientConn) DoctorServiceClient {
+	return &doctorServiceClient{cc}
+}
+
+func (c *doctorServiceClient) CreateDoctor(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error) {
+	out := new(Doctor)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/CreateDoctor", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetReqStrDep) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *doctorServiceClient) GetDoctorById(ctx context.Context, in *GetReqStrDoctor, opts ...grpc.CallOption) (*DoctorAndDoctorHours, error) {
+	out := new(DoctorAndDoctorHours)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/GetDoctorById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetReqStrDep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.OrderBy) > 0 {
-		i -= len(m.OrderBy)
-		copy(dAtA[i:], m.OrderBy)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.OrderBy)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Limit != 0 {
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Page != 0 {
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.IsActive {
-		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.DepartmentId) > 0 {
-		i -= len(m.DepartmentId)
-		copy(dAtA[i:], m.DepartmentId)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DepartmentId)))
-		i--
-		dAtA[i] = 0xa
+func (c *doctorServiceClient) GetAllDoctors(ctx context.Context, in *GetAllDoctorS, opts ...grpc.CallOption) (*ListDoctorsAndHours, error) {
+	out := new(ListDoctorsAndHours)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/GetAllDoctors", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetReqStrSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *doctorServiceClient) UpdateDoctor(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error) {
+	out := new(Doctor)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/UpdateDoctor", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetReqStrSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *GetReqStrSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.OrderBy) > 0 {
-		i -= len(m.OrderBy)
-		copy(dAtA[i:], m.OrderBy)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.OrderBy)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Limit != 0 {
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Page != 0 {
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.IsActive {
-		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.SpecializationId) > 0 {
-		i -= len(m.SpecializationId)
-		copy(dAtA[i:], m.SpecializationId)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SpecializationId)))
-		i--
-		dAtA[i] = 0xa
+func (c *doctorServiceClient) DeleteDoctor(ctx context.Context, in *GetReqStrDoctor, opts ...grpc.CallOption) (*StatusDoctor, error) {
+	out := new(StatusDoctor)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/DeleteDoctor", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *StatusDoctor) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *doctorServiceClient) RestoreDoctor(ctx context.Context, in *RestoreDoctorReq, opts ...grpc.CallOption) (*StatusDoctor, error) {
+	out := new(StatusDoctor)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/RestoreDoctor", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StatusDoctor) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *StatusDoctor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *doctorServiceClient) ListDoctorsByDepartmentId(ctx context.Context, in *GetReqStrDep, opts ...grpc.CallOption) (*ListDoctors, error) {
+	out := new(ListDoctors)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/ListDoctorsByDepartmentId", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetAllDoctorS) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *doctorServiceClient) ListDoctorBySpecializationId(ctx context.Context, in *GetReqStrSpec, opts ...grpc.CallOption) (*ListDoctorsAndHours, error) {
+	out := new(ListDoctorsAndHours)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/ListDoctorBySpecializationId", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetAllDoctorS) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *doctorServiceClient) ListDoctorsForService(ctx context.Context, in *GetReqServiceDoctors, opts ...grpc.CallOption) (*ListServiceDoctors, error) {
+	out := new(ListServiceDoctors)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/ListDoctorsForService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetAllDoctorS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IsActive {
-		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.OrderBy) > 0 {
-		i -= len(m.OrderBy)
-		copy(dAtA[i:], m.OrderBy)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.OrderBy)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Limit != 0 {
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Page != 0 {
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x8
+func (c *doctorServiceClient) SetDoctorRating(ctx context.Context, in *DoctorRating, opts ...grpc.CallOption) (*StatusDoctor, error) {
+	out := new(StatusDoctor)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/SetDoctorRating", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListDoctors) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *doctorServiceClient) ListDoctorsForReason(ctx context.Context, in *GetReqReasonDoctors, opts ...grpc.CallOption) (*ReasonDoctors, error) {
+	out := new(ReasonDoctors)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorService/ListDoctorsForReason", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListDoctors) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DoctorServiceServer is the server API for DoctorService service.
+type DoctorServiceServer interface {
+	CreateDoctor(context.Context, *Doctor) (*Doctor, error)
+	GetDoctorById(context.Context, *GetReqStrDoctor) (*DoctorAndDoctorHours, error)
+	GetAllDoctors(context.Context, *GetAllDoctorS) (*ListDoctorsAndHours, error)
+	UpdateDoctor(context.Context, *Doctor) (*Doctor, error)
+	DeleteDoctor(context.Context, *GetReqStrDoctor) (*StatusDoctor, error)
+	RestoreDoctor(context.Context, *RestoreDoctorReq) (*StatusDoctor, error)
+	ListDoctorsByDepartmentId(context.Context, *GetReqStrDep) (*ListDoctors, error)
+	ListDoctorBySpecializationId(context.Context, *GetReqStrSpec) (*ListDoctorsAndHours, error)
+	ListDoctorsForService(context.Context, *GetReqServiceDoctors) (*ListServiceDoctors, error)
+	SetDoctorRating(context.Context, *DoctorRating) (*StatusDoctor, error)
+	ListDoctorsForReason(context.Context, *GetReqReasonDoctors) (*ReasonDoctors, error)
 }
 
-func (m *ListDoctors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+// UnimplementedDoctorServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDoctorServiceServer struct {
+}
+
+func (*UnimplementedDoctorServiceServer) CreateDoctor(ctx context.Context, req *Doctor) (*Doctor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDoctor not implemented")
+}
+func (*UnimplementedDoctorServiceServer) GetDoctorById(ctx context.Context, req *GetReqStrDoctor) (*DoctorAndDoctorHours, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorById not implemented")
+}
+func (*UnimplementedDoctorServiceServer) GetAllDoctors(ctx context.Context, req *GetAllDoctorS) (*ListDoctorsAndHours, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllDoctors not implemented")
+}
+func (*UnimplementedDoctorServiceServer) UpdateDoctor(ctx context.Context, req *Doctor) (*Doctor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDoctor not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DeleteDoctor(ctx context.Context, req *GetReqStrDoctor) (*StatusDoctor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDoctor not implemented")
+}
+func (*UnimplementedDoctorServiceServer) RestoreDoctor(ctx context.Context, req *RestoreDoctorReq) (*StatusDoctor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDoctor not implemented")
+}
+func (*UnimplementedDoctorServiceServer) ListDoctorsByDepartmentId(ctx context.Context, req *GetReqStrDep) (*ListDoctors, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDoctorsByDepartmentId not implemented")
+}
+func (*UnimplementedDoctorServiceServer) ListDoctorBySpecializationId(ctx context.Context, req *GetReqStrSpec) (*ListDoctorsAndHours, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDoctorBySpecializationId not implemented")
+}
+func (*UnimplementedDoctorServiceServer) ListDoctorsForService(ctx context.Context, req *GetReqServiceDoctors) (*ListServiceDoctors, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDoctorsForService not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SetDoctorRating(ctx context.Context, req *DoctorRating) (*StatusDoctor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDoctorRating not implemented")
+}
+func (*UnimplementedDoctorServiceServer) ListDoctorsForReason(ctx context.Context, req *GetReqReasonDoctors) (*ReasonDoctors, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDoctorsForReason not implemented")
+}
+
+func RegisterDoctorServiceServer(s *grpc.Server, srv DoctorServiceServer) {
+	s.RegisterService(&_DoctorService_serviceDesc, srv)
+}
+
+func _DoctorService_CreateDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Doctor)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Doctors) > 0 {
-		for iNdEx := len(m.Doctors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Doctors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDoctor(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).CreateDoctor(ctx, in)
 	}
-	if m.Count != 0 {
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorService/CreateDoctor",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).CreateDoctor(ctx, req.(*Doctor))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListDoctorsAndHours) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _DoctorService_GetDoctorById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReqStrDoctor)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListDoctorsAndHours) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).GetDoctorById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorService/GetDoctorById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).GetDoctorById(ctx, req.(*GetReqStrDoctor))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListDoctorsAndHours) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _DoctorService_GetAllDoctors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllDoctorS)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DoctorHours) > 0 {
-		for iNdEx := len(m.DoctorHours) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DoctorHours[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDoctor(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).GetAllDoctors(ctx, in)
 	}
-	if m.Count != 0 {
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorService/GetAllDoctors",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).GetAllDoctors(ctx, req.(*GetAllDoctorS))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DoctorAndDoctorHours) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _DoctorService_UpdateDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Doctor)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).UpdateDoctor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorService/UpdateDoctor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).UpdateDoctor(ctx, req.(*Doctor))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DoctorAndDoctorHours) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _DoctorService_DeleteDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReqStrDoctor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DeleteDoctor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorService/DeleteDoctor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DeleteDoctor(ctx, req.(*GetReqStrDoctor))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DoctorAndDoctorHours) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _DoctorService_RestoreDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDoctorReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Specializations) > 0 {
-		for iNdEx := len(m.Specializations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Specializations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDoctor(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xda
-		}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).RestoreDoctor(ctx, in)
 	}
-	if len(m.DeletedAt) > 0 {
-		i -= len(m.DeletedAt)
-		copy(dAtA[i:], m.DeletedAt)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DeletedAt)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorService/RestoreDoctor",
 	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).RestoreDoctor(ctx, req.(*RestoreDoctorReq))
 	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_ListDoctorsByDepartmentId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReqStrDep)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.RoomNumber != 0 {
-		i = encodeVarintDoctor(dAtA, i, uint64(m.RoomNumber))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb8
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).ListDoctorsByDepartmentId(ctx, in)
 	}
-	if len(m.DepartmentId) > 0 {
-		i -= len(m.DepartmentId)
-		copy(dAtA[i:], m.DepartmentId)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DepartmentId)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
-	}
-	if m.WorkYears != 0 {
-		i = encodeVarintDoctor(dAtA, i, uint64(m.WorkYears))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
-	}
-	if len(m.EndWorkDate) > 0 {
-		i -= len(m.EndWorkDate)
-		copy(dAtA[i:], m.EndWorkDate)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.EndWorkDate)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
-	}
-	if len(m.StartWorkDate) > 0 {
-		i -= len(m.StartWorkDate)
-		copy(dAtA[i:], m.StartWorkDate)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.StartWorkDate)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Bio) > 0 {
-		i -= len(m.Bio)
-		copy(dAtA[i:], m.Bio)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Bio)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorService/ListDoctorsByDepartmentId",
 	}
-	if len(m.DayOfWeek) > 0 {
-		i -= len(m.DayOfWeek)
-		copy(dAtA[i:], m.DayOfWeek)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DayOfWeek)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).ListDoctorsByDepartmentId(ctx, req.(*GetReqStrDep))
 	}
-	if len(m.FinishTime) > 0 {
-		i -= len(m.FinishTime)
-		copy(dAtA[i:], m.FinishTime)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FinishTime)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_ListDoctorBySpecializationId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReqStrSpec)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.StartTime) > 0 {
-		i -= len(m.StartTime)
-		copy(dAtA[i:], m.StartTime)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.StartTime)))
-		i--
-		dAtA[i] = 0x7a
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).ListDoctorBySpecializationId(ctx, in)
 	}
-	if m.Salary != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Salary))))
-		i--
-		dAtA[i] = 0x75
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorService/ListDoctorBySpecializationId",
 	}
-	if len(m.Country) > 0 {
-		i -= len(m.Country)
-		copy(dAtA[i:], m.Country)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Country)))
-		i--
-		dAtA[i] = 0x6a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).ListDoctorBySpecializationId(ctx, req.(*GetReqStrSpec))
 	}
-	if len(m.City) > 0 {
-		i -= len(m.City)
-		copy(dAtA[i:], m.City)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.City)))
-		i--
-		dAtA[i] = 0x62
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_ListDoctorsForService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReqServiceDoctors)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x5a
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).ListDoctorsForService(ctx, in)
 	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x52
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorService/ListDoctorsForService",
 	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x4a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).ListDoctorsForService(ctx, req.(*GetReqServiceDoctors))
 	}
-	if len(m.PhoneNumber) > 0 {
-		i -= len(m.PhoneNumber)
-		copy(dAtA[i:], m.PhoneNumber)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.PhoneNumber)))
-		i--
-		dAtA[i] = 0x42
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SetDoctorRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorRating)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BirthDate) > 0 {
-		i -= len(m.BirthDate)
-		copy(dAtA[i:], m.BirthDate)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.BirthDate)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SetDoctorRating(ctx, in)
 	}
-	if len(m.Gender) > 0 {
-		i -= len(m.Gender)
-		copy(dAtA[i:], m.Gender)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Gender)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorService/SetDoctorRating",
 	}
-	if len(m.ImageUrl) > 0 {
-		i -= len(m.ImageUrl)
-		copy(dAtA[i:], m.ImageUrl)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ImageUrl)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SetDoctorRating(ctx, req.(*DoctorRating))
 	}
-	if len(m.LastName) > 0 {
-		i -= len(m.LastName)
-		copy(dAtA[i:], m.LastName)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LastName)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_ListDoctorsForReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReqReasonDoctors)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.FirstName) > 0 {
-		i -= len(m.FirstName)
-		copy(dAtA[i:], m.FirstName)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FirstName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).ListDoctorsForReason(ctx, in)
 	}
-	if m.Order != 0 {
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Order))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorService/ListDoctorsForReason",
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).ListDoctorsForReason(ctx, req.(*GetReqReasonDoctors))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Doctor) Marshal() (dAtA []byte, err error) {
+var _DoctorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "healthcare.DoctorService",
+	HandlerType: (*DoctorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDoctor",
+			Handler:    _DoctorService_CreateDoctor_Handler,
+		},
+		{
+			MethodName: "GetDoctorById",
+			Handler:    _DoctorService_GetDoctorById_Handler,
+		},
+		{
+			MethodName: "GetAllDoctors",
+			Handler:    _DoctorService_GetAllDoctors_Handler,
+		},
+		{
+			MethodName: "UpdateDoctor",
+			Handler:    _DoctorService_UpdateDoctor_Handler,
+		},
+		{
+			MethodName: "DeleteDoctor",
+			Handler:    _DoctorService_DeleteDoctor_Handler,
+		},
+		{
+			MethodName: "RestoreDoctor",
+			Handler:    _DoctorService_RestoreDoctor_Handler,
+		},
+		{
+			MethodName: "ListDoctorsByDepartmentId",
+			Handler:    _DoctorService_ListDoctorsByDepartmentId_Handler,
+		},
+		{
+			MethodName: "ListDoctorBySpecializationId",
+			Handler:    _DoctorService_ListDoctorBySpecializationId_Handler,
+		},
+		{
+			MethodName: "ListDoctorsForService",
+			Handler:    _DoctorService_ListDoctorsForService_Handler,
+		},
+		{
+			MethodName: "SetDoctorRating",
+			Handler:    _DoctorService_SetDoctorRating_Handler,
+		},
+		{
+			MethodName: "ListDoctorsForReason",
+			Handler:    _DoctorService_ListDoctorsForReason_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "healthcare-service/doctor.proto",
+}
+
+func (m *GetReqStrDoctor) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2090,12 +2426,12 @@ func (m *Doctor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Doctor) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReqStrDoctor) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Doctor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReqStrDoctor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2104,196 +2440,109 @@ func (m *Doctor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Specializations) > 0 {
-		for iNdEx := len(m.Specializations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Specializations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDoctor(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xc2
-		}
-	}
-	if len(m.DeletedAt) > 0 {
-		i -= len(m.DeletedAt)
-		copy(dAtA[i:], m.DeletedAt)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DeletedAt)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
-	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
-	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
-	}
-	if m.RoomNumber != 0 {
-		i = encodeVarintDoctor(dAtA, i, uint64(m.RoomNumber))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
-	}
-	if len(m.DepartmentId) > 0 {
-		i -= len(m.DepartmentId)
-		copy(dAtA[i:], m.DepartmentId)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DepartmentId)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.WorkYears != 0 {
-		i = encodeVarintDoctor(dAtA, i, uint64(m.WorkYears))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
-	}
-	if len(m.EndWorkDate) > 0 {
-		i -= len(m.EndWorkDate)
-		copy(dAtA[i:], m.EndWorkDate)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.EndWorkDate)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
-	if len(m.StartWorkDate) > 0 {
-		i -= len(m.StartWorkDate)
-		copy(dAtA[i:], m.StartWorkDate)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.StartWorkDate)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if len(m.Bio) > 0 {
-		i -= len(m.Bio)
-		copy(dAtA[i:], m.Bio)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Bio)))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if m.Salary != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Salary))))
-		i--
-		dAtA[i] = 0x75
-	}
-	if len(m.Country) > 0 {
-		i -= len(m.Country)
-		copy(dAtA[i:], m.Country)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Country)))
+	if m.IsActive {
 		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.City) > 0 {
-		i -= len(m.City)
-		copy(dAtA[i:], m.City)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.City)))
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x18
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Address)))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Value)))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x12
 	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Password)))
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Field)))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0xa
 	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x4a
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReqStrDep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PhoneNumber) > 0 {
-		i -= len(m.PhoneNumber)
-		copy(dAtA[i:], m.PhoneNumber)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.PhoneNumber)))
-		i--
-		dAtA[i] = 0x42
+	return dAtA[:n], nil
+}
+
+func (m *GetReqStrDep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReqStrDep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.BirthDate) > 0 {
-		i -= len(m.BirthDate)
-		copy(dAtA[i:], m.BirthDate)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.BirthDate)))
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.OrderBy)))
 		i--
 		dAtA[i] = 0x3a
 	}
-	if len(m.Gender) > 0 {
-		i -= len(m.Gender)
-		copy(dAtA[i:], m.Gender)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Gender)))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0x32
 	}
-	if len(m.ImageUrl) > 0 {
-		i -= len(m.ImageUrl)
-		copy(dAtA[i:], m.ImageUrl)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ImageUrl)))
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Field)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.LastName) > 0 {
-		i -= len(m.LastName)
-		copy(dAtA[i:], m.LastName)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LastName)))
+	if m.Limit != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if len(m.FirstName) > 0 {
-		i -= len(m.FirstName)
-		copy(dAtA[i:], m.FirstName)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FirstName)))
+	if m.Page != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.Order != 0 {
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Order))
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+	if len(m.DepartmentId) > 0 {
+		i -= len(m.DepartmentId)
+		copy(dAtA[i:], m.DepartmentId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DepartmentId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DoctorSpec) Marshal() (dAtA []byte, err error) {
+func (m *GetReqStrSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2303,12 +2552,12 @@ func (m *DoctorSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DoctorSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReqStrSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DoctorSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReqStrSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2317,472 +2566,3541 @@ func (m *DoctorSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Name)))
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.OrderBy)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3a
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Value)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintDoctor(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDoctor(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GetReqStrDoctor) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if m.Page != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.IsActive {
-		n += 2
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.SpecializationId) > 0 {
+		i -= len(m.SpecializationId)
+		copy(dAtA[i:], m.SpecializationId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SpecializationId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetReqStrDep) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DepartmentId)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	if m.IsActive {
-		n += 2
-	}
-	if m.Page != 0 {
-		n += 1 + sovDoctor(uint64(m.Page))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovDoctor(uint64(m.Limit))
-	}
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	l = len(m.OrderBy)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetReqServiceDoctors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetReqStrSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetReqServiceDoctors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReqServiceDoctors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SpecializationId)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	if m.IsActive {
-		n += 2
-	}
-	if m.Page != 0 {
-		n += 1 + sovDoctor(uint64(m.Page))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovDoctor(uint64(m.Limit))
+	if len(m.SpecializationId) > 0 {
+		i -= len(m.SpecializationId)
+		copy(dAtA[i:], m.SpecializationId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SpecializationId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.DayOfWeek) > 0 {
+		i -= len(m.DayOfWeek)
+		copy(dAtA[i:], m.DayOfWeek)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DayOfWeek)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.OrderBy)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.DepartmentId) > 0 {
+		i -= len(m.DepartmentId)
+		copy(dAtA[i:], m.DepartmentId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DepartmentId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StatusDoctor) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ServiceDoctor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetAllDoctorS) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ServiceDoctor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceDoctor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Page != 0 {
-		n += 1 + sovDoctor(uint64(m.Page))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovDoctor(uint64(m.Limit))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.FinishTime) > 0 {
+		i -= len(m.FinishTime)
+		copy(dAtA[i:], m.FinishTime)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FinishTime)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.OrderBy)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.IsActive {
-		n += 2
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListDoctors) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListServiceDoctors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServiceDoctors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServiceDoctors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Count != 0 {
-		n += 1 + sovDoctor(uint64(m.Count))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Doctors) > 0 {
-		for _, e := range m.Doctors {
-			l = e.Size()
-			n += 1 + l + sovDoctor(uint64(l))
+		for iNdEx := len(m.Doctors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Doctors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListDoctorsAndHours) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovDoctor(uint64(m.Count))
-	}
-	if len(m.DoctorHours) > 0 {
-		for _, e := range m.DoctorHours {
-			l = e.Size()
-			n += 1 + l + sovDoctor(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StatusDoctor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DoctorAndDoctorHours) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StatusDoctor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusDoctor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	if m.Order != 0 {
-		n += 1 + sovDoctor(uint64(m.Order))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.FirstName)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.LastName)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllDoctorS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ImageUrl)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GetAllDoctorS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllDoctorS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Gender)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.BirthDate)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.PhoneNumber)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if m.Page != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.City)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDoctors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Country)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ListDoctors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDoctors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Salary != 0 {
-		n += 5
+	if len(m.Doctors) > 0 {
+		for iNdEx := len(m.Doctors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Doctors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.StartTime)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.FinishTime)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDoctorsAndHours) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.DayOfWeek)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ListDoctorsAndHours) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDoctorsAndHours) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Bio)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	if len(m.DoctorHours) > 0 {
+		for iNdEx := len(m.DoctorHours) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DoctorHours[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.StartWorkDate)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.EndWorkDate)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorAndDoctorHours) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.WorkYears != 0 {
-		n += 2 + sovDoctor(uint64(m.WorkYears))
-	}
-	l = len(m.DepartmentId)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *DoctorAndDoctorHours) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorAndDoctorHours) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RoomNumber != 0 {
-		n += 2 + sovDoctor(uint64(m.RoomNumber))
+	if len(m.DepartmentIds) > 0 {
+		for iNdEx := len(m.DepartmentIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DepartmentIds[iNdEx])
+			copy(dAtA[i:], m.DepartmentIds[iNdEx])
+			i = encodeVarintDoctor(dAtA, i, uint64(len(m.DepartmentIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
 	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
 	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	if m.ReviewCount != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.ReviewCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
 	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	if m.Rating != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe5
 	}
 	if len(m.Specializations) > 0 {
-		for _, e := range m.Specializations {
-			l = e.Size()
-			n += 2 + l + sovDoctor(uint64(l))
+		for iNdEx := len(m.Specializations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Specializations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Doctor) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	if m.Order != 0 {
-		n += 1 + sovDoctor(uint64(m.Order))
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	l = len(m.FirstName)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	l = len(m.LastName)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	l = len(m.ImageUrl)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if m.RoomNumber != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.RoomNumber))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	l = len(m.Gender)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.DepartmentId) > 0 {
+		i -= len(m.DepartmentId)
+		copy(dAtA[i:], m.DepartmentId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DepartmentId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	l = len(m.BirthDate)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if m.WorkYears != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.WorkYears))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	l = len(m.PhoneNumber)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.EndWorkDate) > 0 {
+		i -= len(m.EndWorkDate)
+		copy(dAtA[i:], m.EndWorkDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.EndWorkDate)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.StartWorkDate) > 0 {
+		i -= len(m.StartWorkDate)
+		copy(dAtA[i:], m.StartWorkDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.StartWorkDate)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.Bio) > 0 {
+		i -= len(m.Bio)
+		copy(dAtA[i:], m.Bio)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Bio)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.DayOfWeek) > 0 {
+		i -= len(m.DayOfWeek)
+		copy(dAtA[i:], m.DayOfWeek)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DayOfWeek)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	l = len(m.City)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.FinishTime) > 0 {
+		i -= len(m.FinishTime)
+		copy(dAtA[i:], m.FinishTime)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FinishTime)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	l = len(m.Country)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x7a
 	}
 	if m.Salary != 0 {
-		n += 5
-	}
-	l = len(m.Bio)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Salary))))
+		i--
+		dAtA[i] = 0x75
 	}
-	l = len(m.StartWorkDate)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	l = len(m.EndWorkDate)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	if len(m.City) > 0 {
+		i -= len(m.City)
+		copy(dAtA[i:], m.City)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.City)))
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.WorkYears != 0 {
-		n += 2 + sovDoctor(uint64(m.WorkYears))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.DepartmentId)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.RoomNumber != 0 {
-		n += 2 + sovDoctor(uint64(m.RoomNumber))
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	if len(m.BirthDate) > 0 {
+		i -= len(m.BirthDate)
+		copy(dAtA[i:], m.BirthDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.BirthDate)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 2 + l + sovDoctor(uint64(l))
+	if len(m.Gender) > 0 {
+		i -= len(m.Gender)
+		copy(dAtA[i:], m.Gender)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Gender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ImageUrl) > 0 {
+		i -= len(m.ImageUrl)
+		copy(dAtA[i:], m.ImageUrl)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ImageUrl)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Order != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Doctor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Doctor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Doctor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DepartmentIds) > 0 {
+		for iNdEx := len(m.DepartmentIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DepartmentIds[iNdEx])
+			copy(dAtA[i:], m.DepartmentIds[iNdEx])
+			i = encodeVarintDoctor(dAtA, i, uint64(len(m.DepartmentIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
 	if len(m.Specializations) > 0 {
-		for _, e := range m.Specializations {
-			l = e.Size()
-			n += 2 + l + sovDoctor(uint64(l))
+		for iNdEx := len(m.Specializations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Specializations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.RoomNumber != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.RoomNumber))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.DepartmentId) > 0 {
+		i -= len(m.DepartmentId)
+		copy(dAtA[i:], m.DepartmentId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DepartmentId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.WorkYears != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.WorkYears))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.EndWorkDate) > 0 {
+		i -= len(m.EndWorkDate)
+		copy(dAtA[i:], m.EndWorkDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.EndWorkDate)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.StartWorkDate) > 0 {
+		i -= len(m.StartWorkDate)
+		copy(dAtA[i:], m.StartWorkDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.StartWorkDate)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Bio) > 0 {
+		i -= len(m.Bio)
+		copy(dAtA[i:], m.Bio)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Bio)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Salary != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Salary))))
+		i--
+		dAtA[i] = 0x75
+	}
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.City) > 0 {
+		i -= len(m.City)
+		copy(dAtA[i:], m.City)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.City)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BirthDate) > 0 {
+		i -= len(m.BirthDate)
+		copy(dAtA[i:], m.BirthDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.BirthDate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Gender) > 0 {
+		i -= len(m.Gender)
+		copy(dAtA[i:], m.Gender)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Gender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ImageUrl) > 0 {
+		i -= len(m.ImageUrl)
+		copy(dAtA[i:], m.ImageUrl)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ImageUrl)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Order != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorRating) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorRating) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorRating) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReviewCount != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.ReviewCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Rating != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReqReasonDoctors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReqReasonDoctors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReqReasonDoctors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReasonId) > 0 {
+		i -= len(m.ReasonId)
+		copy(dAtA[i:], m.ReasonId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ReasonId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReasonDoctorHours) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReasonDoctorHours) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReasonDoctorHours) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EffectiveTo) > 0 {
+		i -= len(m.EffectiveTo)
+		copy(dAtA[i:], m.EffectiveTo)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.EffectiveTo)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EffectiveFrom) > 0 {
+		i -= len(m.EffectiveFrom)
+		copy(dAtA[i:], m.EffectiveFrom)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.EffectiveFrom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FinishTime) > 0 {
+		i -= len(m.FinishTime)
+		copy(dAtA[i:], m.FinishTime)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FinishTime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DayOfWeek) > 0 {
+		i -= len(m.DayOfWeek)
+		copy(dAtA[i:], m.DayOfWeek)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DayOfWeek)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReasonDoctorLeave) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReasonDoctorLeave) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReasonDoctorLeave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EndDate) > 0 {
+		i -= len(m.EndDate)
+		copy(dAtA[i:], m.EndDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.EndDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReasonDoctor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReasonDoctor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReasonDoctor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Leaves) > 0 {
+		for iNdEx := len(m.Leaves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leaves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.WorkingHours) > 0 {
+		for iNdEx := len(m.WorkingHours) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WorkingHours[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ReviewCount != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.ReviewCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Rating != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
+		i--
+		dAtA[i] = 0x55
+	}
+	if m.Duration != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.OfflinePrice != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OfflinePrice))))
+		i--
+		dAtA[i] = 0x45
+	}
+	if m.OnlinePrice != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OnlinePrice))))
+		i--
+		dAtA[i] = 0x3d
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DepartmentId) > 0 {
+		i -= len(m.DepartmentId)
+		copy(dAtA[i:], m.DepartmentId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DepartmentId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ImageUrl) > 0 {
+		i -= len(m.ImageUrl)
+		copy(dAtA[i:], m.ImageUrl)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ImageUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReasonDoctors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReasonDoctors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReasonDoctors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Doctors) > 0 {
+		for iNdEx := len(m.Doctors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Doctors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SpecializationId) > 0 {
+		i -= len(m.SpecializationId)
+		copy(dAtA[i:], m.SpecializationId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SpecializationId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReasonName) > 0 {
+		i -= len(m.ReasonName)
+		copy(dAtA[i:], m.ReasonName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ReasonName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReasonId) > 0 {
+		i -= len(m.ReasonId)
+		copy(dAtA[i:], m.ReasonId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ReasonId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreDoctorReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreDoctorReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreDoctorReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDoctor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDoctor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetReqStrDoctor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReqStrDep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DepartmentId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	if m.Page != 0 {
+		n += 1 + sovDoctor(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDoctor(uint64(m.Limit))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReqStrSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecializationId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	if m.Page != 0 {
+		n += 1 + sovDoctor(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDoctor(uint64(m.Limit))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReqServiceDoctors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DepartmentId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DayOfWeek)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SpecializationId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServiceDoctor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.FinishTime)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListServiceDoctors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Doctors) > 0 {
+		for _, e := range m.Doctors {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusDoctor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAllDoctorS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovDoctor(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDoctor(uint64(m.Limit))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDoctors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if len(m.Doctors) > 0 {
+		for _, e := range m.Doctors {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDoctorsAndHours) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if len(m.DoctorHours) > 0 {
+		for _, e := range m.DoctorHours {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorAndDoctorHours) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Order != 0 {
+		n += 1 + sovDoctor(uint64(m.Order))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ImageUrl)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Gender)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.BirthDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.City)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Salary != 0 {
+		n += 5
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.FinishTime)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DayOfWeek)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Bio)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.StartWorkDate)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.EndWorkDate)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	if m.WorkYears != 0 {
+		n += 2 + sovDoctor(uint64(m.WorkYears))
+	}
+	l = len(m.DepartmentId)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	if m.RoomNumber != 0 {
+		n += 2 + sovDoctor(uint64(m.RoomNumber))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Specializations) > 0 {
+		for _, e := range m.Specializations {
+			l = e.Size()
+			n += 2 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Rating != 0 {
+		n += 6
+	}
+	if m.ReviewCount != 0 {
+		n += 2 + sovDoctor(uint64(m.ReviewCount))
+	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	if len(m.DepartmentIds) > 0 {
+		for _, s := range m.DepartmentIds {
+			l = len(s)
+			n += 2 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Doctor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Order != 0 {
+		n += 1 + sovDoctor(uint64(m.Order))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ImageUrl)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Gender)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.BirthDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.City)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Salary != 0 {
+		n += 5
+	}
+	l = len(m.Bio)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.StartWorkDate)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.EndWorkDate)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	if m.WorkYears != 0 {
+		n += 2 + sovDoctor(uint64(m.WorkYears))
+	}
+	l = len(m.DepartmentId)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	if m.RoomNumber != 0 {
+		n += 2 + sovDoctor(uint64(m.RoomNumber))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Specializations) > 0 {
+		for _, e := range m.Specializations {
+			l = e.Size()
+			n += 2 + l + sovDoctor(uint64(l))
+		}
+	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 2 + l + sovDoctor(uint64(l))
+	}
+	if len(m.DepartmentIds) > 0 {
+		for _, s := range m.DepartmentIds {
+			l = len(s)
+			n += 2 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorRating) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Rating != 0 {
+		n += 5
+	}
+	if m.ReviewCount != 0 {
+		n += 1 + sovDoctor(uint64(m.ReviewCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReqReasonDoctors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReasonId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReasonDoctorHours) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DayOfWeek)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.FinishTime)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.EffectiveFrom)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.EffectiveTo)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReasonDoctorLeave) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.EndDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReasonDoctor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ImageUrl)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DepartmentId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.OnlinePrice != 0 {
+		n += 5
+	}
+	if m.OfflinePrice != 0 {
+		n += 5
+	}
+	if m.Duration != 0 {
+		n += 1 + sovDoctor(uint64(m.Duration))
+	}
+	if m.Rating != 0 {
+		n += 5
+	}
+	if m.ReviewCount != 0 {
+		n += 1 + sovDoctor(uint64(m.ReviewCount))
+	}
+	if len(m.WorkingHours) > 0 {
+		for _, e := range m.WorkingHours {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if len(m.Leaves) > 0 {
+		for _, e := range m.Leaves {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReasonDoctors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReasonId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ReasonName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SpecializationId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Doctors) > 0 {
+		for _, e := range m.Doctors {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreDoctorReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDoctor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDoctor(x uint64) (n int) {
+	return sovDoctor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetReqStrDoctor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReqStrDoctor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReqStrDoctor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReqStrDep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReqStrDep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReqStrDep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepartmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReqStrSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReqStrSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReqStrSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecializationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecializationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReqServiceDoctors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReqServiceDoctors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReqServiceDoctors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepartmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DayOfWeek", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DayOfWeek = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecializationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecializationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceDoctor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceDoctor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceDoctor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinishTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListServiceDoctors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListServiceDoctors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListServiceDoctors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doctors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Doctors = append(m.Doctors, &ServiceDoctor{})
+			if err := m.Doctors[len(m.Doctors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusDoctor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusDoctor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusDoctor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllDoctorS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllDoctorS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllDoctorS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *DoctorSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDoctor(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDoctor(x uint64) (n int) {
-	return sovDoctor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GetReqStrDoctor) Unmarshal(dAtA []byte) error {
+func (m *ListDoctors) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2805,17 +6123,17 @@ func (m *GetReqStrDoctor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReqStrDoctor: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListDoctors: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReqStrDoctor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListDoctors: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -2825,29 +6143,16 @@ func (m *GetReqStrDoctor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Field = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Doctors", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -2857,29 +6162,82 @@ func (m *GetReqStrDoctor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Doctors = append(m.Doctors, &Doctor{})
+			if err := m.Doctors[len(m.Doctors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDoctorsAndHours) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDoctorsAndHours: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDoctorsAndHours: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -2889,12 +6247,45 @@ func (m *GetReqStrDoctor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsActive = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorHours", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorHours = append(m.DoctorHours, &DoctorAndDoctorHours{})
+			if err := m.DoctorHours[len(m.DoctorHours)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctor(dAtA[iNdEx:])
@@ -2917,7 +6308,7 @@ func (m *GetReqStrDoctor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReqStrDep) Unmarshal(dAtA []byte) error {
+func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2940,15 +6331,15 @@ func (m *GetReqStrDep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReqStrDep: wiretype end group for non-group")
+			return fmt.Errorf("proto: DoctorAndDoctorHours: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReqStrDep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DoctorAndDoctorHours: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2976,13 +6367,13 @@ func (m *GetReqStrDep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepartmentId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
-			var v int
+			m.Order = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -2992,17 +6383,16 @@ func (m *GetReqStrDep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Order |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsActive = bool(v != 0)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
 			}
-			m.Page = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -3012,16 +6402,29 @@ func (m *GetReqStrDep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -3031,14 +6434,27 @@ func (m *GetReqStrDep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3066,11 +6482,11 @@ func (m *GetReqStrDep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.ImageUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3098,11 +6514,11 @@ func (m *GetReqStrDep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Gender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BirthDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3130,62 +6546,11 @@ func (m *GetReqStrDep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			m.BirthDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDoctor(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetReqStrSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDoctor
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetReqStrSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReqStrSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecializationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3213,13 +6578,13 @@ func (m *GetReqStrSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecializationId = string(dAtA[iNdEx:postIndex])
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -3229,17 +6594,29 @@ func (m *GetReqStrSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsActive = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
 			}
-			m.Page = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -3249,16 +6626,29 @@ func (m *GetReqStrSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
 			}
-			m.Limit = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -3268,14 +6658,27 @@ func (m *GetReqStrSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3303,11 +6706,11 @@ func (m *GetReqStrSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.City = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3335,11 +6738,22 @@ func (m *GetReqStrSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Country = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 14:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salary", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Salary = float32(math.Float32frombits(v))
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3367,64 +6781,45 @@ func (m *GetReqStrSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			m.StartTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDoctor(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StatusDoctor) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDoctor
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StatusDoctor: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusDoctor: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
 			}
-			var v int
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinishTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DayOfWeek", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -3434,68 +6829,29 @@ func (m *StatusDoctor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDoctor(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetAllDoctorS) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDoctor
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllDoctorS: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllDoctorS: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			m.DayOfWeek = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bio", wireType)
 			}
-			m.Page = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -3505,16 +6861,29 @@ func (m *GetAllDoctorS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
 			}
-			m.Limit = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartWorkDate", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -3524,14 +6893,27 @@ func (m *GetAllDoctorS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartWorkDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndWorkDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3559,11 +6941,30 @@ func (m *GetAllDoctorS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.EndWorkDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkYears", wireType)
+			}
+			m.WorkYears = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkYears |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3591,11 +6992,30 @@ func (m *GetAllDoctorS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.DepartmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomNumber", wireType)
+			}
+			m.RoomNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoomNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3623,13 +7043,13 @@ func (m *GetAllDoctorS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -3639,68 +7059,29 @@ func (m *GetAllDoctorS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsActive = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDoctor(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListDoctors) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDoctor
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListDoctors: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDoctors: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -3710,14 +7091,27 @@ func (m *ListDoctors) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Doctors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specializations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3744,67 +7138,27 @@ func (m *ListDoctors) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Doctors = append(m.Doctors, &Doctor{})
-			if err := m.Doctors[len(m.Doctors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Specializations = append(m.Specializations, &DoctorSpec{})
+			if err := m.Specializations[len(m.Specializations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDoctor(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListDoctorsAndHours) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDoctor
+		case 28:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
 			}
-			if iNdEx >= l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListDoctorsAndHours: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDoctorsAndHours: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rating = float32(math.Float32frombits(v))
+		case 29:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewCount", wireType)
 			}
-			m.Count = 0
+			m.ReviewCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -3814,16 +7168,16 @@ func (m *ListDoctorsAndHours) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				m.ReviewCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorHours", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -3833,25 +7187,55 @@ func (m *ListDoctorsAndHours) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DoctorHours = append(m.DoctorHours, &DoctorAndDoctorHours{})
-			if err := m.DoctorHours[len(m.DoctorHours)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.DepartmentIds = append(m.DepartmentIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3875,7 +7259,7 @@ func (m *ListDoctorsAndHours) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
+func (m *Doctor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3898,10 +7282,10 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoctorAndDoctorHours: wiretype end group for non-group")
+			return fmt.Errorf("proto: Doctor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoctorAndDoctorHours: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Doctor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4209,11 +7593,150 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.City = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salary", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Salary = float32(math.Float32frombits(v))
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartWorkDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4241,11 +7764,11 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.StartWorkDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndWorkDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4273,11 +7796,30 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.City = string(dAtA[iNdEx:postIndex])
+			m.EndWorkDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkYears", wireType)
+			}
+			m.WorkYears = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkYears |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4305,22 +7847,30 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Country = string(dAtA[iNdEx:postIndex])
+			m.DepartmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salary", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomNumber", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.RoomNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoomNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Salary = float32(math.Float32frombits(v))
-		case 15:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4348,11 +7898,11 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartTime = string(dAtA[iNdEx:postIndex])
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4380,11 +7930,11 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinishTime = string(dAtA[iNdEx:postIndex])
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DayOfWeek", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4412,13 +7962,13 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DayOfWeek = string(dAtA[iNdEx:postIndex])
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specializations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -4428,27 +7978,29 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bio = string(dAtA[iNdEx:postIndex])
+			m.Specializations = append(m.Specializations, &DoctorSpec{})
+			if err := m.Specializations[len(m.Specializations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 19:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartWorkDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4476,11 +8028,11 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartWorkDate = string(dAtA[iNdEx:postIndex])
+			m.BranchId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndWorkDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4508,30 +8060,62 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndWorkDate = string(dAtA[iNdEx:postIndex])
+			m.DepartmentIds = append(m.DepartmentIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkYears", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.WorkYears = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDoctor
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkYears |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
 			}
-		case 22:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4559,13 +8143,13 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepartmentId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoomNumber", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.RoomNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -4575,14 +8159,78 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RoomNumber |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 24:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorRating) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorRating: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorRating: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4610,13 +8258,24 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
 			}
-			var stringLen uint64
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rating = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewCount", wireType)
+			}
+			m.ReviewCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -4626,27 +8285,65 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReviewCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 26:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReqReasonDoctors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReqReasonDoctors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReqReasonDoctors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4674,13 +8371,13 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			m.ReasonId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 27:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specializations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -4690,25 +8387,23 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Specializations = append(m.Specializations, &DoctorSpec{})
-			if err := m.Specializations[len(m.Specializations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4732,7 +8427,7 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Doctor) Unmarshal(dAtA []byte) error {
+func (m *ReasonDoctorHours) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4755,15 +8450,15 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Doctor: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReasonDoctorHours: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Doctor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReasonDoctorHours: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DayOfWeek", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4791,13 +8486,13 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.DayOfWeek = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.Order = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -4807,14 +8502,27 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Order |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4842,11 +8550,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirstName = string(dAtA[iNdEx:postIndex])
+			m.FinishTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4874,11 +8582,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastName = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4906,11 +8614,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageUrl = string(dAtA[iNdEx:postIndex])
+			m.EffectiveFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4938,11 +8646,62 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Gender = string(dAtA[iNdEx:postIndex])
+			m.EffectiveTo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReasonDoctorLeave) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReasonDoctorLeave: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReasonDoctorLeave: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BirthDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4970,11 +8729,94 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BirthDate = string(dAtA[iNdEx:postIndex])
+			m.StartDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReasonDoctor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReasonDoctor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReasonDoctor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5002,11 +8844,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5034,11 +8876,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.FirstName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5066,11 +8908,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.LastName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5098,11 +8940,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ImageUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5130,11 +8972,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.City = string(dAtA[iNdEx:postIndex])
+			m.DepartmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5162,11 +9004,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Country = string(dAtA[iNdEx:postIndex])
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 7:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlinePrice", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -5174,12 +9016,23 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			}
 			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
 			iNdEx += 4
-			m.Salary = float32(math.Float32frombits(v))
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bio", wireType)
+			m.OnlinePrice = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfflinePrice", wireType)
 			}
-			var stringLen uint64
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.OfflinePrice = float32(math.Float32frombits(v))
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -5189,29 +9042,27 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Duration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDoctor
+		case 10:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
 			}
-			if postIndex > l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bio = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartWorkDate", wireType)
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rating = float32(math.Float32frombits(v))
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewCount", wireType)
 			}
-			var stringLen uint64
+			m.ReviewCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -5221,29 +9072,16 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReviewCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StartWorkDate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 17:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndWorkDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkingHours", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -5253,48 +9091,31 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndWorkDate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkYears", wireType)
-			}
-			m.WorkYears = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDoctor
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkYears |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.WorkingHours = append(m.WorkingHours, &ReasonDoctorHours{})
+			if err := m.WorkingHours[len(m.WorkingHours)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 19:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaves", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -5304,46 +9125,80 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepartmentId = string(dAtA[iNdEx:postIndex])
+			m.Leaves = append(m.Leaves, &ReasonDoctorLeave{})
+			if err := m.Leaves[len(m.Leaves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoomNumber", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.RoomNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDoctor
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RoomNumber |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
 			}
-		case 21:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReasonDoctors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReasonDoctors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReasonDoctors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5371,11 +9226,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.ReasonId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5403,11 +9258,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.ReasonName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecializationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5435,11 +9290,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			m.SpecializationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 24:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specializations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Doctors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5466,8 +9321,8 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Specializations = append(m.Specializations, &DoctorSpec{})
-			if err := m.Specializations[len(m.Specializations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Doctors = append(m.Doctors, &ReasonDoctor{})
+			if err := m.Doctors[len(m.Doctors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5493,7 +9348,7 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DoctorSpec) Unmarshal(dAtA []byte) error {
+func (m *RestoreDoctorReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5516,10 +9371,10 @@ func (m *DoctorSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoctorSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreDoctorReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoctorSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreDoctorReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5554,38 +9409,6 @@ func (m *DoctorSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDoctor
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctor(dAtA[iNdEx:])
@@ -0,0 +1,3284 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcare-service/doctor_leave.proto
+
+package healthcare
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// kind is one of vacation, sick_leave, conference or other,
+// status is one of pending, approved, rejected or cancelled,
+// start_date and end_date "2006-01-02" are both included
+type DoctorLeave struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	DoctorId             string   `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	DepartmentId         string   `protobuf:"bytes,3,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	Kind                 string   `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind"`
+	StartDate            string   `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date"`
+	EndDate              string   `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date"`
+	Reason               string   `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason"`
+	Status               string   `protobuf:"bytes,8,opt,name=status,proto3" json:"status"`
+	ReviewedBy           string   `protobuf:"bytes,9,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by"`
+	ReviewNote           string   `protobuf:"bytes,10,opt,name=review_note,json=reviewNote,proto3" json:"review_note"`
+	ReviewedAt           string   `protobuf:"bytes,11,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at"`
+	CreatedAt            string   `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorLeave) Reset()         { *m = DoctorLeave{} }
+func (m *DoctorLeave) String() string { return proto.CompactTextString(m) }
+func (*DoctorLeave) ProtoMessage()    {}
+func (*DoctorLeave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3f1ca607e53153, []int{0}
+}
+func (m *DoctorLeave) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorLeave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorLeave.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoctorLeave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorLeave.Merge(m, src)
+}
+func (m *DoctorLeave) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorLeave) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorLeave.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorLeave proto.InternalMessageInfo
+
+func (m *DoctorLeave) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DoctorLeave) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *DoctorLeave) GetDepartmentId() string {
+	if m != nil {
+		return m.DepartmentId
+	}
+	return ""
+}
+
+func (m *DoctorLeave) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *DoctorLeave) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *DoctorLeave) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *DoctorLeave) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DoctorLeave) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DoctorLeave) GetReviewedBy() string {
+	if m != nil {
+		return m.ReviewedBy
+	}
+	return ""
+}
+
+func (m *DoctorLeave) GetReviewNote() string {
+	if m != nil {
+		return m.ReviewNote
+	}
+	return ""
+}
+
+func (m *DoctorLeave) GetReviewedAt() string {
+	if m != nil {
+		return m.ReviewedAt
+	}
+	return ""
+}
+
+func (m *DoctorLeave) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *DoctorLeave) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type DoctorLeaveId struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorLeaveId) Reset()         { *m = DoctorLeaveId{} }
+func (m *DoctorLeaveId) String() string { return proto.CompactTextString(m) }
+func (*DoctorLeaveId) ProtoMessage()    {}
+func (*DoctorLeaveId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3f1ca607e53153, []int{1}
+}
+func (m *DoctorLeaveId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorLeaveId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorLeaveId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoctorLeaveId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorLeaveId.Merge(m, src)
+}
+func (m *DoctorLeaveId) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorLeaveId) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorLeaveId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorLeaveId proto.InternalMessageInfo
+
+func (m *DoctorLeaveId) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// from and to select the leaves touching the range
+type ListDoctorLeavesReq struct {
+	Page                 int64    `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	DoctorId             string   `protobuf:"bytes,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status"`
+	From                 string   `protobuf:"bytes,5,opt,name=from,proto3" json:"from"`
+	To                   string   `protobuf:"bytes,6,opt,name=to,proto3" json:"to"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDoctorLeavesReq) Reset()         { *m = ListDoctorLeavesReq{} }
+func (m *ListDoctorLeavesReq) String() string { return proto.CompactTextString(m) }
+func (*ListDoctorLeavesReq) ProtoMessage()    {}
+func (*ListDoctorLeavesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3f1ca607e53153, []int{2}
+}
+func (m *ListDoctorLeavesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDoctorLeavesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDoctorLeavesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListDoctorLeavesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDoctorLeavesReq.Merge(m, src)
+}
+func (m *ListDoctorLeavesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDoctorLeavesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDoctorLeavesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDoctorLeavesReq proto.InternalMessageInfo
+
+func (m *ListDoctorLeavesReq) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListDoctorLeavesReq) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDoctorLeavesReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *ListDoctorLeavesReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ListDoctorLeavesReq) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ListDoctorLeavesReq) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+type ListDoctorLeaves struct {
+	Leaves               []*DoctorLeave `protobuf:"bytes,1,rep,name=leaves,proto3" json:"leaves"`
+	Count                int64          `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListDoctorLeaves) Reset()         { *m = ListDoctorLeaves{} }
+func (m *ListDoctorLeaves) String() string { return proto.CompactTextString(m) }
+func (*ListDoctorLeaves) ProtoMessage()    {}
+func (*ListDoctorLeaves) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3f1ca607e53153, []int{3}
+}
+func (m *ListDoctorLeaves) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDoctorLeaves) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDoctorLeaves.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListDoctorLeaves) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDoctorLeaves.Merge(m, src)
+}
+func (m *ListDoctorLeaves) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDoctorLeaves) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDoctorLeaves.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDoctorLeaves proto.InternalMessageInfo
+
+func (m *ListDoctorLeaves) GetLeaves() []*DoctorLeave {
+	if m != nil {
+		return m.Leaves
+	}
+	return nil
+}
+
+func (m *ListDoctorLeaves) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// status is approved or rejected
+type ReviewDoctorLeaveReq struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	ReviewedBy           string   `protobuf:"bytes,3,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by"`
+	ReviewNote           string   `protobuf:"bytes,4,opt,name=review_note,json=reviewNote,proto3" json:"review_note"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReviewDoctorLeaveReq) Reset()         { *m = ReviewDoctorLeaveReq{} }
+func (m *ReviewDoctorLeaveReq) String() string { return proto.CompactTextString(m) }
+func (*ReviewDoctorLeaveReq) ProtoMessage()    {}
+func (*ReviewDoctorLeaveReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3f1ca607e53153, []int{4}
+}
+func (m *ReviewDoctorLeaveReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReviewDoctorLeaveReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReviewDoctorLeaveReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReviewDoctorLeaveReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewDoctorLeaveReq.Merge(m, src)
+}
+func (m *ReviewDoctorLeaveReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReviewDoctorLeaveReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewDoctorLeaveReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviewDoctorLeaveReq proto.InternalMessageInfo
+
+func (m *ReviewDoctorLeaveReq) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ReviewDoctorLeaveReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ReviewDoctorLeaveReq) GetReviewedBy() string {
+	if m != nil {
+		return m.ReviewedBy
+	}
+	return ""
+}
+
+func (m *ReviewDoctorLeaveReq) GetReviewNote() string {
+	if m != nil {
+		return m.ReviewNote
+	}
+	return ""
+}
+
+// date defaults to today, every department is listed when department_id is empty
+type AwayDoctorsReq struct {
+	Date                 string   `protobuf:"bytes,1,opt,name=date,proto3" json:"date"`
+	DepartmentId         string   `protobuf:"bytes,2,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AwayDoctorsReq) Reset()         { *m = AwayDoctorsReq{} }
+func (m *AwayDoctorsReq) String() string { return proto.CompactTextString(m) }
+func (*AwayDoctorsReq) ProtoMessage()    {}
+func (*AwayDoctorsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3f1ca607e53153, []int{5}
+}
+func (m *AwayDoctorsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AwayDoctorsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AwayDoctorsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AwayDoctorsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AwayDoctorsReq.Merge(m, src)
+}
+func (m *AwayDoctorsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *AwayDoctorsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AwayDoctorsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AwayDoctorsReq proto.InternalMessageInfo
+
+func (m *AwayDoctorsReq) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *AwayDoctorsReq) GetDepartmentId() string {
+	if m != nil {
+		return m.DepartmentId
+	}
+	return ""
+}
+
+type AwayDoctor struct {
+	DoctorId             string   `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	FirstName            string   `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name"`
+	LastName             string   `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name"`
+	DepartmentId         string   `protobuf:"bytes,4,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	LeaveId              int64    `protobuf:"varint,5,opt,name=leave_id,json=leaveId,proto3" json:"leave_id"`
+	Kind                 string   `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind"`
+	StartDate            string   `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date"`
+	EndDate              string   `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AwayDoctor) Reset()         { *m = AwayDoctor{} }
+func (m *AwayDoctor) String() string { return proto.CompactTextString(m) }
+func (*AwayDoctor) ProtoMessage()    {}
+func (*AwayDoctor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3f1ca607e53153, []int{6}
+}
+func (m *AwayDoctor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AwayDoctor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AwayDoctor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AwayDoctor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AwayDoctor.Merge(m, src)
+}
+func (m *AwayDoctor) XXX_Size() int {
+	return m.Size()
+}
+func (m *AwayDoctor) XXX_DiscardUnknown() {
+	xxx_messageInfo_AwayDoctor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AwayDoctor proto.InternalMessageInfo
+
+func (m *AwayDoctor) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *AwayDoctor) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
+	}
+	return ""
+}
+
+func (m *AwayDoctor) GetLastName() string {
+	if m != nil {
+		return m.LastName
+	}
+	return ""
+}
+
+func (m *AwayDoctor) GetDepartmentId() string {
+	if m != nil {
+		return m.DepartmentId
+	}
+	return ""
+}
+
+func (m *AwayDoctor) GetLeaveId() int64 {
+	if m != nil {
+		return m.LeaveId
+	}
+	return 0
+}
+
+func (m *AwayDoctor) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *AwayDoctor) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *AwayDoctor) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+type ListAwayDoctorsRes struct {
+	Doctors              []*AwayDoctor `protobuf:"bytes,1,rep,name=doctors,proto3" json:"doctors"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListAwayDoctorsRes) Reset()         { *m = ListAwayDoctorsRes{} }
+func (m *ListAwayDoctorsRes) String() string { return proto.CompactTextString(m) }
+func (*ListAwayDoctorsRes) ProtoMessage()    {}
+func (*ListAwayDoctorsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3f1ca607e53153, []int{7}
+}
+func (m *ListAwayDoctorsRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAwayDoctorsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAwayDoctorsRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListAwayDoctorsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAwayDoctorsRes.Merge(m, src)
+}
+func (m *ListAwayDoctorsRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAwayDoctorsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAwayDoctorsRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAwayDoctorsRes proto.InternalMessageInfo
+
+func (m *ListAwayDoctorsRes) GetDoctors() []*AwayDoctor {
+	if m != nil {
+		return m.Doctors
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DoctorLeave)(nil), "healthcare.DoctorLeave")
+	proto.RegisterType((*DoctorLeaveId)(nil), "healthcare.DoctorLeaveId")
+	proto.RegisterType((*ListDoctorLeavesReq)(nil), "healthcare.ListDoctorLeavesReq")
+	proto.RegisterType((*ListDoctorLeaves)(nil), "healthcare.ListDoctorLeaves")
+	proto.RegisterType((*ReviewDoctorLeaveReq)(nil), "healthcare.ReviewDoctorLeaveReq")
+	proto.RegisterType((*AwayDoctorsReq)(nil), "healthcare.AwayDoctorsReq")
+	proto.RegisterType((*AwayDoctor)(nil), "healthcare.AwayDoctor")
+	proto.RegisterType((*ListAwayDoctorsRes)(nil), "healthcare.ListAwayDoctorsRes")
+}
+
+func init() {
+	proto.RegisterFile("healthcare-service/doctor_leave.proto", fileDescriptor_1f3f1ca607e53153)
+}
+
+var fileDescriptor_1f3f1ca607e53153 = []byte{
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x4e, 0x14, 0x41,
+	0x10, 0x76, 0x66, 0x96, 0xfd, 0xa9, 0x01, 0x24, 0x2d, 0xc1, 0x01, 0x65, 0xd9, 0x8c, 0x31, 0xe1,
+	0x22, 0x18, 0xbc, 0x6b, 0x16, 0x88, 0xba, 0x11, 0x39, 0x8c, 0x27, 0x4f, 0x9b, 0x66, 0xbb, 0x90,
+	0x89, 0xb3, 0x33, 0xcb, 0x4c, 0x03, 0xe1, 0xe6, 0x63, 0xf0, 0x34, 0x9e, 0x3d, 0xfa, 0x08, 0x06,
+	0x1f, 0xc1, 0xbb, 0x31, 0x5d, 0xdd, 0xe3, 0xfc, 0xec, 0x2e, 0x26, 0xde, 0xba, 0xeb, 0xfb, 0xba,
+	0xb6, 0xaa, 0xbe, 0xaf, 0x76, 0xe0, 0xe9, 0x19, 0xf2, 0x48, 0x9e, 0x8d, 0x78, 0x8a, 0xcf, 0x32,
+	0x4c, 0x2f, 0xc3, 0x11, 0xee, 0x8a, 0x64, 0x24, 0x93, 0x74, 0x18, 0x21, 0xbf, 0xc4, 0x9d, 0x49,
+	0x9a, 0xc8, 0x84, 0x41, 0x41, 0xf3, 0x7f, 0xdb, 0xe0, 0x1e, 0x12, 0xe5, 0x48, 0x31, 0xd8, 0x32,
+	0xd8, 0xa1, 0xf0, 0xac, 0x9e, 0xb5, 0xed, 0x04, 0x76, 0x28, 0xd8, 0x23, 0xe8, 0x98, 0x0c, 0xa1,
+	0xf0, 0xec, 0x9e, 0xb5, 0xdd, 0x09, 0xda, 0x3a, 0x30, 0x10, 0xec, 0x09, 0x2c, 0x09, 0x9c, 0xf0,
+	0x54, 0x8e, 0x31, 0x96, 0x8a, 0xe0, 0x10, 0x61, 0xb1, 0x08, 0x0e, 0x04, 0x63, 0xd0, 0xf8, 0x1c,
+	0xc6, 0xc2, 0x6b, 0x10, 0x46, 0x67, 0xb6, 0x09, 0x90, 0x49, 0x9e, 0xca, 0xa1, 0xe0, 0x12, 0xbd,
+	0x05, 0x42, 0x3a, 0x14, 0x39, 0xe4, 0x12, 0xd9, 0x3a, 0xb4, 0x31, 0x16, 0x1a, 0x6c, 0x12, 0xd8,
+	0xc2, 0x58, 0x10, 0xb4, 0x06, 0xcd, 0x14, 0x79, 0x96, 0xc4, 0x5e, 0x8b, 0x00, 0x73, 0x53, 0xf1,
+	0x4c, 0x72, 0x79, 0x91, 0x79, 0x6d, 0x1d, 0xd7, 0x37, 0xb6, 0x05, 0x6e, 0x8a, 0x97, 0x21, 0x5e,
+	0xa1, 0x18, 0x9e, 0x5c, 0x7b, 0x1d, 0x02, 0x21, 0x0f, 0xed, 0x5f, 0x17, 0x84, 0x61, 0x9c, 0x48,
+	0xf4, 0xa0, 0x4c, 0x38, 0x4e, 0x24, 0x56, 0x32, 0x70, 0xe9, 0xb9, 0xd5, 0x0c, 0x7d, 0xa9, 0x9a,
+	0x19, 0xa5, 0xc8, 0xa5, 0xc6, 0x17, 0x75, 0x33, 0x26, 0xa2, 0xe1, 0x8b, 0x89, 0xc8, 0xe1, 0x25,
+	0x0d, 0x9b, 0x48, 0x5f, 0xfa, 0x5b, 0xb0, 0x54, 0x9a, 0xff, 0x40, 0xd4, 0x15, 0xf0, 0x6f, 0x2c,
+	0x78, 0x70, 0x14, 0x66, 0xb2, 0xc4, 0xca, 0x02, 0x3c, 0x57, 0x73, 0x9d, 0xf0, 0x4f, 0x68, 0x98,
+	0x74, 0x66, 0xab, 0xb0, 0x10, 0x85, 0xe3, 0x50, 0x92, 0x52, 0x4e, 0xa0, 0x2f, 0x55, 0x0d, 0x9d,
+	0x9a, 0x86, 0xc5, 0xe0, 0x1a, 0x95, 0xc1, 0x31, 0x68, 0x9c, 0xa6, 0xc9, 0xd8, 0x88, 0x43, 0x67,
+	0x55, 0x9a, 0x4c, 0x8c, 0x22, 0xb6, 0x4c, 0xfc, 0x8f, 0xb0, 0x52, 0xaf, 0x8c, 0xed, 0x42, 0x93,
+	0xbc, 0x96, 0x79, 0x56, 0xcf, 0xd9, 0x76, 0xf7, 0x1e, 0xee, 0x14, 0x6e, 0xdb, 0x29, 0x31, 0x03,
+	0x43, 0x53, 0x35, 0x8f, 0x92, 0x8b, 0xf8, 0x6f, 0xcd, 0x74, 0xf1, 0xbf, 0x58, 0xb0, 0x1a, 0xd0,
+	0x8c, 0xcb, 0x6f, 0xf0, 0x7c, 0xca, 0xa0, 0x45, 0xfd, 0xf6, 0x5d, 0xc2, 0x3b, 0xff, 0x12, 0xbe,
+	0x51, 0x17, 0xde, 0x1f, 0xc0, 0x72, 0xff, 0x8a, 0x5f, 0xeb, 0xdf, 0xcf, 0x47, 0x4e, 0x9e, 0xb4,
+	0xf4, 0x4c, 0xd4, 0x79, 0x7a, 0x07, 0xec, 0xe9, 0x1d, 0xf0, 0x7f, 0x59, 0x00, 0x45, 0xae, 0xaa,
+	0x20, 0x56, 0x4d, 0x90, 0x4d, 0x80, 0xd3, 0x30, 0xcd, 0xe4, 0x30, 0xe6, 0x63, 0x34, 0xd9, 0x3a,
+	0x14, 0x39, 0xe6, 0x63, 0x54, 0x6f, 0x23, 0x9e, 0xa3, 0x46, 0xcc, 0x88, 0x1b, 0x70, 0xaa, 0x98,
+	0xc6, 0x8c, 0x85, 0x5c, 0x87, 0x36, 0x8d, 0x5e, 0xe1, 0x0b, 0x34, 0xc7, 0x56, 0x64, 0xbc, 0x97,
+	0xef, 0x6a, 0x73, 0xee, 0xae, 0xb6, 0xee, 0xda, 0xd5, 0x76, 0x65, 0x57, 0xfd, 0xd7, 0xc0, 0x94,
+	0x3d, 0x2a, 0x43, 0xcc, 0xd8, 0x73, 0x68, 0xe9, 0x5e, 0x73, 0x87, 0xac, 0x95, 0x1d, 0x52, 0x90,
+	0x83, 0x9c, 0xb6, 0xf7, 0xd5, 0x01, 0x56, 0x72, 0xc1, 0x07, 0xfd, 0xcf, 0xc6, 0x5e, 0x81, 0x7b,
+	0x40, 0x5b, 0x46, 0x51, 0x36, 0xcf, 0x68, 0x1b, 0xf3, 0x00, 0xf6, 0x12, 0xda, 0x6f, 0x50, 0xea,
+	0xf3, 0xfa, 0x1c, 0xd2, 0x40, 0xcc, 0x7f, 0xff, 0x0e, 0x40, 0xf5, 0x67, 0x8c, 0xbf, 0x55, 0xa6,
+	0xcd, 0x58, 0xd8, 0x8d, 0xc7, 0x77, 0x11, 0xd8, 0x5b, 0x70, 0xb5, 0xdf, 0x75, 0xee, 0x5e, 0x99,
+	0x3c, 0x6b, 0x11, 0xe6, 0x97, 0xd5, 0x07, 0xf7, 0x80, 0xc7, 0x23, 0x8c, 0xfe, 0xbf, 0xb3, 0xf7,
+	0x70, 0xbf, 0xa6, 0x1c, 0xdb, 0x98, 0xad, 0x12, 0x75, 0xd6, 0xad, 0x77, 0x56, 0x95, 0x7c, 0x7f,
+	0xe5, 0xdb, 0x6d, 0xd7, 0xfa, 0x7e, 0xdb, 0xb5, 0x7e, 0xdc, 0x76, 0xad, 0x9b, 0x9f, 0xdd, 0x7b,
+	0x27, 0x4d, 0xfa, 0x12, 0xbd, 0xf8, 0x33, 0x00, 0xcd, 0xc8, 0x5c, 0x67, 0xb2, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DoctorLeaveServiceClient is the client API for DoctorLeaveService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DoctorLeaveServiceClient interface {
+	CreateLeave(ctx context.Context, in *DoctorLeave, opts ...grpc.CallOption) (*DoctorLeave, error)
+	GetLeave(ctx context.Context, in *DoctorLeaveId, opts ...grpc.CallOption) (*DoctorLeave, error)
+	ListLeaves(ctx context.Context, in *ListDoctorLeavesReq, opts ...grpc.CallOption) (*ListDoctorLeaves, error)
+	ReviewLeave(ctx context.Context, in *ReviewDoctorLeaveReq, opts ...grpc.CallOption) (*DoctorLeave, error)
+	CancelLeave(ctx context.Context, in *DoctorLeaveId, opts ...grpc.CallOption) (*DoctorLeave, error)
+	ListAwayDoctors(ctx context.Context, in *AwayDoctorsReq, opts ...grpc.CallOption) (*ListAwayDoctorsRes, error)
+}
+
+type doctorLeaveServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDoctorLeaveServiceClient(cc *grpc.ClientConn) DoctorLeaveServiceClient {
+	return &doctorLeaveServiceClient{cc}
+}
+
+func (c *doctorLeaveServiceClient) CreateLeave(ctx context.Context, in *DoctorLeave, opts ...grpc.CallOption) (*DoctorLeave, error) {
+	out := new(DoctorLeave)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorLeaveService/CreateLeave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorLeaveServiceClient) GetLeave(ctx context.Context, in *DoctorLeaveId, opts ...grpc.CallOption) (*DoctorLeave, error) {
+	out := new(DoctorLeave)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorLeaveService/GetLeave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorLeaveServiceClient) ListLeaves(ctx context.Context, in *ListDoctorLeavesReq, opts ...grpc.CallOption) (*ListDoctorLeaves, error) {
+	out := new(ListDoctorLeaves)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorLeaveService/ListLeaves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorLeaveServiceClient) ReviewLeave(ctx context.Context, in *ReviewDoctorLeaveReq, opts ...grpc.CallOption) (*DoctorLeave, error) {
+	out := new(DoctorLeave)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorLeaveService/ReviewLeave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorLeaveServiceClient) CancelLeave(ctx context.Context, in *DoctorLeaveId, opts ...grpc.CallOption) (*DoctorLeave, error) {
+	out := new(DoctorLeave)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorLeaveService/CancelLeave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorLeaveServiceClient) ListAwayDoctors(ctx context.Context, in *AwayDoctorsReq, opts ...grpc.CallOption) (*ListAwayDoctorsRes, error) {
+	out := new(ListAwayDoctorsRes)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorLeaveService/ListAwayDoctors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DoctorLeaveServiceServer is the server API for DoctorLeaveService service.
+type DoctorLeaveServiceServer interface {
+	CreateLeave(context.Context, *DoctorLeave) (*DoctorLeave, error)
+	GetLeave(context.Context, *DoctorLeaveId) (*DoctorLeave, error)
+	ListLeaves(context.Context, *ListDoctorLeavesReq) (*ListDoctorLeaves, error)
+	ReviewLeave(context.Context, *ReviewDoctorLeaveReq) (*DoctorLeave, error)
+	CancelLeave(context.Context, *DoctorLeaveId) (*DoctorLeave, error)
+	ListAwayDoctors(context.Context, *AwayDoctorsReq) (*ListAwayDoctorsRes, error)
+}
+
+// UnimplementedDoctorLeaveServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDoctorLeaveServiceServer struct {
+}
+
+func (*UnimplementedDoctorLeaveServiceServer) CreateLeave(ctx context.Context, req *DoctorLeave) (*DoctorLeave, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLeave not implemented")
+}
+func (*UnimplementedDoctorLeaveServiceServer) GetLeave(ctx context.Context, req *DoctorLeaveId) (*DoctorLeave, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeave not implemented")
+}
+func (*UnimplementedDoctorLeaveServiceServer) ListLeaves(ctx context.Context, req *ListDoctorLeavesReq) (*ListDoctorLeaves, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeaves not implemented")
+}
+func (*UnimplementedDoctorLeaveServiceServer) ReviewLeave(ctx context.Context, req *ReviewDoctorLeaveReq) (*DoctorLeave, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewLeave not implemented")
+}
+func (*UnimplementedDoctorLeaveServiceServer) CancelLeave(ctx context.Context, req *DoctorLeaveId) (*DoctorLeave, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLeave not implemented")
+}
+func (*UnimplementedDoctorLeaveServiceServer) ListAwayDoctors(ctx context.Context, req *AwayDoctorsReq) (*ListAwayDoctorsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAwayDoctors not implemented")
+}
+
+func RegisterDoctorLeaveServiceServer(s *grpc.Server, srv DoctorLeaveServiceServer) {
+	s.RegisterService(&_DoctorLeaveService_serviceDesc, srv)
+}
+
+func _DoctorLeaveService_CreateLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorLeave)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorLeaveServiceServer).CreateLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorLeaveService/CreateLeave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorLeaveServiceServer).CreateLeave(ctx, req.(*DoctorLeave))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorLeaveService_GetLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorLeaveId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorLeaveServiceServer).GetLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorLeaveService/GetLeave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorLeaveServiceServer).GetLeave(ctx, req.(*DoctorLeaveId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorLeaveService_ListLeaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDoctorLeavesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorLeaveServiceServer).ListLeaves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorLeaveService/ListLeaves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorLeaveServiceServer).ListLeaves(ctx, req.(*ListDoctorLeavesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorLeaveService_ReviewLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewDoctorLeaveReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorLeaveServiceServer).ReviewLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorLeaveService/ReviewLeave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorLeaveServiceServer).ReviewLeave(ctx, req.(*ReviewDoctorLeaveReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorLeaveService_CancelLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorLeaveId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorLeaveServiceServer).CancelLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorLeaveService/CancelLeave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorLeaveServiceServer).CancelLeave(ctx, req.(*DoctorLeaveId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorLeaveService_ListAwayDoctors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AwayDoctorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorLeaveServiceServer).ListAwayDoctors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorLeaveService/ListAwayDoctors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorLeaveServiceServer).ListAwayDoctors(ctx, req.(*AwayDoctorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DoctorLeaveService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "healthcare.DoctorLeaveService",
+	HandlerType: (*DoctorLeaveServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLeave",
+			Handler:    _DoctorLeaveService_CreateLeave_Handler,
+		},
+		{
+			MethodName: "GetLeave",
+			Handler:    _DoctorLeaveService_GetLeave_Handler,
+		},
+		{
+			MethodName: "ListLeaves",
+			Handler:    _DoctorLeaveService_ListLeaves_Handler,
+		},
+		{
+			MethodName: "ReviewLeave",
+			Handler:    _DoctorLeaveService_ReviewLeave_Handler,
+		},
+		{
+			MethodName: "CancelLeave",
+			Handler:    _DoctorLeaveService_CancelLeave_Handler,
+		},
+		{
+			MethodName: "ListAwayDoctors",
+			Handler:    _DoctorLeaveService_ListAwayDoctors_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "healthcare-service/doctor_leave.proto",
+}
+
+func (m *DoctorLeave) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorLeave) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorLeave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ReviewedAt) > 0 {
+		i -= len(m.ReviewedAt)
+		copy(dAtA[i:], m.ReviewedAt)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.ReviewedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ReviewNote) > 0 {
+		i -= len(m.ReviewNote)
+		copy(dAtA[i:], m.ReviewNote)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.ReviewNote)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ReviewedBy) > 0 {
+		i -= len(m.ReviewedBy)
+		copy(dAtA[i:], m.ReviewedBy)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.ReviewedBy)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EndDate) > 0 {
+		i -= len(m.EndDate)
+		copy(dAtA[i:], m.EndDate)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.EndDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DepartmentId) > 0 {
+		i -= len(m.DepartmentId)
+		copy(dAtA[i:], m.DepartmentId)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.DepartmentId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorLeaveId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorLeaveId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorLeaveId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Id != 0 {
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDoctorLeavesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDoctorLeavesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDoctorLeavesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDoctorLeaves) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDoctorLeaves) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDoctorLeaves) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Leaves) > 0 {
+		for iNdEx := len(m.Leaves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leaves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctorLeave(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReviewDoctorLeaveReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReviewDoctorLeaveReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReviewDoctorLeaveReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ReviewNote) > 0 {
+		i -= len(m.ReviewNote)
+		copy(dAtA[i:], m.ReviewNote)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.ReviewNote)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReviewedBy) > 0 {
+		i -= len(m.ReviewedBy)
+		copy(dAtA[i:], m.ReviewedBy)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.ReviewedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AwayDoctorsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AwayDoctorsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AwayDoctorsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DepartmentId) > 0 {
+		i -= len(m.DepartmentId)
+		copy(dAtA[i:], m.DepartmentId)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.DepartmentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AwayDoctor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AwayDoctor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AwayDoctor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EndDate) > 0 {
+		i -= len(m.EndDate)
+		copy(dAtA[i:], m.EndDate)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.EndDate)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LeaveId != 0 {
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(m.LeaveId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DepartmentId) > 0 {
+		i -= len(m.DepartmentId)
+		copy(dAtA[i:], m.DepartmentId)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.DepartmentId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctorLeave(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAwayDoctorsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAwayDoctorsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAwayDoctorsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Doctors) > 0 {
+		for iNdEx := len(m.Doctors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Doctors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctorLeave(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDoctorLeave(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDoctorLeave(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DoctorLeave) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDoctorLeave(uint64(m.Id))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.DepartmentId)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.EndDate)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.ReviewedBy)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.ReviewNote)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.ReviewedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorLeaveId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDoctorLeave(uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDoctorLeavesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovDoctorLeave(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDoctorLeave(uint64(m.Limit))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDoctorLeaves) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Leaves) > 0 {
+		for _, e := range m.Leaves {
+			l = e.Size()
+			n += 1 + l + sovDoctorLeave(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctorLeave(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReviewDoctorLeaveReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDoctorLeave(uint64(m.Id))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.ReviewedBy)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.ReviewNote)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AwayDoctorsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.DepartmentId)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AwayDoctor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.DepartmentId)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	if m.LeaveId != 0 {
+		n += 1 + sovDoctorLeave(uint64(m.LeaveId))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	l = len(m.EndDate)
+	if l > 0 {
+		n += 1 + l + sovDoctorLeave(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAwayDoctorsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Doctors) > 0 {
+		for _, e := range m.Doctors {
+			l = e.Size()
+			n += 1 + l + sovDoctorLeave(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDoctorLeave(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDoctorLeave(x uint64) (n int) {
+	return sovDoctorLeave(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DoctorLeave) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorLeave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorLeave: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorLeave: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepartmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReviewedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewNote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReviewNote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReviewedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorLeave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorLeaveId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorLeave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorLeaveId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorLeaveId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorLeave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDoctorLeavesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorLeave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDoctorLeavesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDoctorLeavesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorLeave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDoctorLeaves) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorLeave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDoctorLeaves: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDoctorLeaves: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leaves = append(m.Leaves, &DoctorLeave{})
+			if err := m.Leaves[len(m.Leaves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorLeave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReviewDoctorLeaveReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorLeave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReviewDoctorLeaveReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReviewDoctorLeaveReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReviewedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewNote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReviewNote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorLeave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AwayDoctorsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorLeave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AwayDoctorsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AwayDoctorsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepartmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorLeave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AwayDoctor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorLeave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AwayDoctor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AwayDoctor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepartmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaveId", wireType)
+			}
+			m.LeaveId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaveId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorLeave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAwayDoctorsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorLeave
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAwayDoctorsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAwayDoctorsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doctors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Doctors = append(m.Doctors, &AwayDoctor{})
+			if err := m.Doctors[len(m.Doctors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorLeave(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorLeave
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDoctorLeave(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDoctorLeave
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDoctorLeave
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDoctorLeave
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDoctorLeave
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDoctorLeave
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDoctorLeave        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDoctorLeave          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDoctorLeave = fmt.Errorf("proto: unexpected end of group")
+)
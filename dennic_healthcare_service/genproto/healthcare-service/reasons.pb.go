@@ -373,48 +373,98 @@ func (m *StatusReasons) GetStatus() bool {
 	return false
 }
 
+type RestoreReasonsReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreReasonsReq) Reset()         { *m = RestoreReasonsReq{} }
+func (m *RestoreReasonsReq) String() string { return proto.CompactTextString(m) }
+func (*RestoreReasonsReq) ProtoMessage()    {}
+func (*RestoreReasonsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a511642d7e1e60c, []int{5}
+}
+func (m *RestoreReasonsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreReasonsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreReasonsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreReasonsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreReasonsReq.Merge(m, src)
+}
+func (m *RestoreReasonsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreReasonsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreReasonsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreReasonsReq proto.InternalMessageInfo
+
+func (m *RestoreReasonsReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GetReqStrReasons)(nil), "healthcare.GetReqStrReasons")
 	proto.RegisterType((*Reasons)(nil), "healthcare.Reasons")
 	proto.RegisterType((*ListReasons)(nil), "healthcare.ListReasons")
 	proto.RegisterType((*GetAllReas)(nil), "healthcare.GetAllReas")
 	proto.RegisterType((*StatusReasons)(nil), "healthcare.StatusReasons")
+	proto.RegisterType((*RestoreReasonsReq)(nil), "healthcare.RestoreReasonsReq")
 }
 
 func init() { proto.RegisterFile("healthcare-service/reasons.proto", fileDescriptor_0a511642d7e1e60c) }
 
 var fileDescriptor_0a511642d7e1e60c = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0x69, 0x9d, 0x38, 0x53, 0x39, 0x0a, 0x0b, 0x02, 0xb7, 0x40, 0x14, 0xf9, 0x42, 0x24,
-	0xd4, 0x20, 0x95, 0x53, 0x6f, 0x24, 0x45, 0x2a, 0x95, 0x38, 0x6d, 0xd4, 0x13, 0x07, 0x6b, 0x6b,
-	0x0f, 0xed, 0x4a, 0x9b, 0x38, 0xac, 0xd7, 0x91, 0xc2, 0x93, 0x20, 0x9e, 0x88, 0x23, 0x07, 0x24,
-	0xae, 0x28, 0xbc, 0x08, 0xf2, 0x78, 0x97, 0xb8, 0x55, 0x54, 0x89, 0x9b, 0xbf, 0x9f, 0x99, 0xec,
-	0x7c, 0x33, 0x81, 0xe1, 0x0d, 0x0a, 0x65, 0x6e, 0x52, 0xa1, 0xf1, 0xb8, 0x40, 0xbd, 0x92, 0x29,
-	0xbe, 0xd6, 0x28, 0x8a, 0x7c, 0x51, 0x8c, 0x97, 0x3a, 0x37, 0x39, 0x83, 0xad, 0x23, 0xfe, 0x08,
-	0xfd, 0x73, 0x34, 0x1c, 0x3f, 0xcf, 0x8c, 0xe6, 0xb5, 0x8b, 0x3d, 0x06, 0xff, 0x93, 0x44, 0x95,
-	0x45, 0xde, 0xd0, 0x1b, 0x75, 0x79, 0x0d, 0x2a, 0x76, 0x25, 0x54, 0x89, 0x51, 0xab, 0x66, 0x09,
-	0xb0, 0x67, 0xd0, 0x95, 0x45, 0x22, 0x52, 0x23, 0x57, 0x18, 0xed, 0x0d, 0xbd, 0x51, 0xc0, 0x03,
-	0x59, 0x4c, 0x08, 0xc7, 0x3f, 0x3d, 0xe8, 0xb8, 0xa6, 0x3d, 0x68, 0x49, 0xd7, 0xb1, 0x25, 0x33,
-	0xc6, 0x60, 0x7f, 0x21, 0xe6, 0xae, 0x1b, 0x7d, 0xb3, 0x57, 0xf0, 0xb0, 0x58, 0x62, 0x2a, 0x85,
-	0x92, 0x5f, 0x84, 0x91, 0xf9, 0x22, 0x91, 0x19, 0x35, 0xed, 0xf2, 0xfe, 0x6d, 0xe1, 0x22, 0xa3,
-	0x5f, 0x9e, 0x8b, 0x6b, 0x4c, 0x4a, 0xad, 0xa2, 0x7d, 0x32, 0x05, 0x44, 0x5c, 0x6a, 0xc5, 0x5e,
-	0x00, 0xa4, 0x1a, 0x85, 0xc1, 0x2c, 0x11, 0x26, 0xf2, 0x49, 0xed, 0x5a, 0x66, 0x62, 0x2a, 0xb9,
-	0x5c, 0x66, 0x4e, 0x6e, 0xd7, 0xb2, 0x65, 0x6a, 0x39, 0x43, 0x85, 0x56, 0xee, 0xd4, 0xb2, 0x65,
-	0x26, 0x26, 0xe6, 0x70, 0xf0, 0x41, 0x16, 0xc6, 0x4d, 0x76, 0x0c, 0x1d, 0x9b, 0x6f, 0xe4, 0x0d,
-	0xf7, 0x46, 0x07, 0x27, 0x8f, 0xc6, 0xdb, 0x80, 0xc7, 0xd6, 0xc5, 0x9d, 0xa7, 0xca, 0x31, 0xcd,
-	0xcb, 0x85, 0xa1, 0xc9, 0x7d, 0x5e, 0x83, 0xf8, 0x9b, 0x07, 0x70, 0x8e, 0x66, 0xa2, 0x54, 0x55,
-	0x50, 0xa5, 0xb3, 0x14, 0xd7, 0x48, 0x79, 0xf9, 0x9c, 0xbe, 0xab, 0x42, 0x25, 0xe7, 0xf2, 0x5f,
-	0x21, 0x81, 0x7b, 0x17, 0xb0, 0xdd, 0xe4, 0xfe, 0xce, 0x4d, 0xfa, 0xcd, 0x4d, 0x1e, 0x42, 0x90,
-	0xeb, 0x0c, 0x75, 0x72, 0xb5, 0xb6, 0x89, 0x74, 0x08, 0x4f, 0xd7, 0xf1, 0x4b, 0x08, 0x67, 0x46,
-	0x98, 0xb2, 0x70, 0x23, 0x3f, 0x81, 0x76, 0x41, 0x04, 0x3d, 0x30, 0xe0, 0x16, 0x9d, 0xfc, 0x6a,
-	0x41, 0xcf, 0x7a, 0x66, 0xf5, 0xe9, 0xb1, 0x53, 0x08, 0xcf, 0x28, 0x77, 0x57, 0xbb, 0x2b, 0x9d,
-	0xa3, 0x5d, 0x24, 0x3b, 0x83, 0x1e, 0xdd, 0x26, 0xa1, 0xe9, 0xfa, 0x22, 0x63, 0xcf, 0x9b, 0xb6,
-	0xbb, 0x77, 0xbb, 0xbb, 0xc9, 0x5b, 0x08, 0xb7, 0xb9, 0xd2, 0xdb, 0xef, 0xf4, 0xb0, 0xd2, 0xd1,
-	0xd3, 0x26, 0xdf, 0xdc, 0xef, 0x29, 0x84, 0x97, 0x74, 0x1a, 0xff, 0x3f, 0xc1, 0x7b, 0x08, 0xdf,
-	0xd1, 0xd9, 0x38, 0xe2, 0xfe, 0x01, 0x0e, 0x9b, 0xea, 0xad, 0xc4, 0xa7, 0xfd, 0xef, 0x9b, 0x81,
-	0xf7, 0x63, 0x33, 0xf0, 0x7e, 0x6f, 0x06, 0xde, 0xd7, 0x3f, 0x83, 0x07, 0x57, 0x6d, 0xfa, 0x33,
-	0xbf, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x6c, 0xf5, 0x63, 0xf0, 0x03, 0x00, 0x00,
+	// 505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0x13, 0x31,
+	0x10, 0x65, 0xf3, 0x9d, 0xa9, 0x12, 0xa5, 0x06, 0xc1, 0xb6, 0xd0, 0x28, 0x5a, 0x0e, 0x44, 0x42,
+	0x0d, 0x52, 0x39, 0xf5, 0x46, 0x52, 0xa4, 0x52, 0xc4, 0xc9, 0x51, 0x4f, 0x1c, 0x22, 0x77, 0x77,
+	0x68, 0x2d, 0x39, 0xd9, 0xd4, 0x76, 0x22, 0x85, 0x5f, 0x82, 0xb8, 0xf2, 0x67, 0x38, 0x72, 0xe0,
+	0x07, 0xa0, 0xf0, 0x47, 0xd0, 0xce, 0xda, 0xe4, 0x43, 0x51, 0xa4, 0xde, 0xfc, 0xde, 0x1b, 0x8f,
+	0x77, 0xde, 0x1b, 0x2d, 0x74, 0xee, 0x50, 0x28, 0x7b, 0x17, 0x0b, 0x8d, 0xa7, 0x06, 0xf5, 0x5c,
+	0xc6, 0xf8, 0x46, 0xa3, 0x30, 0xe9, 0xc4, 0xf4, 0xa6, 0x3a, 0xb5, 0x29, 0x83, 0x55, 0x45, 0xf4,
+	0x19, 0x5a, 0x97, 0x68, 0x39, 0xde, 0x0f, 0xad, 0xe6, 0x79, 0x15, 0x7b, 0x02, 0xe5, 0x2f, 0x12,
+	0x55, 0x12, 0x06, 0x9d, 0xa0, 0x5b, 0xe7, 0x39, 0xc8, 0xd8, 0xb9, 0x50, 0x33, 0x0c, 0x0b, 0x39,
+	0x4b, 0x80, 0x3d, 0x87, 0xba, 0x34, 0x23, 0x11, 0x5b, 0x39, 0xc7, 0xb0, 0xd8, 0x09, 0xba, 0x35,
+	0x5e, 0x93, 0xa6, 0x4f, 0x38, 0xfa, 0x1d, 0x40, 0xd5, 0x37, 0x6d, 0x42, 0x41, 0xfa, 0x8e, 0x05,
+	0x99, 0x30, 0x06, 0xa5, 0x89, 0x18, 0xfb, 0x6e, 0x74, 0x66, 0xaf, 0xe1, 0xd0, 0x4c, 0x31, 0x96,
+	0x42, 0xc9, 0xaf, 0xc2, 0xca, 0x74, 0x32, 0x92, 0x09, 0x35, 0xad, 0xf3, 0xd6, 0xa6, 0x70, 0x95,
+	0xd0, 0xcb, 0x63, 0x71, 0x8b, 0xa3, 0x99, 0x56, 0x61, 0x89, 0x8a, 0x6a, 0x44, 0x5c, 0x6b, 0xc5,
+	0x4e, 0x00, 0x62, 0x8d, 0xc2, 0x62, 0x32, 0x12, 0x36, 0x2c, 0x93, 0x5a, 0x77, 0x4c, 0xdf, 0x66,
+	0xf2, 0x6c, 0x9a, 0x78, 0xb9, 0x92, 0xcb, 0x8e, 0xc9, 0xe5, 0x04, 0x15, 0x3a, 0xb9, 0x9a, 0xcb,
+	0x8e, 0xe9, 0xdb, 0x88, 0xc3, 0xc1, 0x27, 0x69, 0xac, 0x9f, 0xec, 0x14, 0xaa, 0xce, 0xdf, 0x30,
+	0xe8, 0x14, 0xbb, 0x07, 0x67, 0x8f, 0x7b, 0x2b, 0x83, 0x7b, 0xae, 0x8a, 0xfb, 0x9a, 0xcc, 0xc7,
+	0x38, 0x9d, 0x4d, 0x2c, 0x4d, 0x5e, 0xe6, 0x39, 0x88, 0xbe, 0x07, 0x00, 0x97, 0x68, 0xfb, 0x4a,
+	0x65, 0x17, 0x32, 0x77, 0xa6, 0xe2, 0x16, 0xc9, 0xaf, 0x32, 0xa7, 0x73, 0x76, 0x51, 0xc9, 0xb1,
+	0xfc, 0x7f, 0x91, 0xc0, 0xde, 0x00, 0x56, 0x49, 0x96, 0x76, 0x26, 0x59, 0x5e, 0x4f, 0xf2, 0x08,
+	0x6a, 0xa9, 0x4e, 0x50, 0x8f, 0x6e, 0x16, 0xce, 0x91, 0x2a, 0xe1, 0xc1, 0x22, 0x7a, 0x05, 0x8d,
+	0xa1, 0x15, 0x76, 0x66, 0xfc, 0xc8, 0x4f, 0xa1, 0x62, 0x88, 0xa0, 0x0f, 0xac, 0x71, 0x87, 0xa2,
+	0x97, 0x70, 0xc8, 0xd1, 0xd8, 0x54, 0xa3, 0x1f, 0x1b, 0xef, 0xb7, 0x93, 0x3f, 0xfb, 0x51, 0x84,
+	0xa6, 0x93, 0x87, 0xf9, 0x7e, 0xb2, 0x73, 0x68, 0x5c, 0x50, 0x38, 0xfe, 0x81, 0x5d, 0x16, 0x1e,
+	0xef, 0x22, 0xd9, 0x05, 0x34, 0x69, 0x81, 0x09, 0x0d, 0x16, 0x57, 0x09, 0x7b, 0xb1, 0x5e, 0xb6,
+	0xbd, 0xdc, 0xbb, 0x9b, 0xbc, 0x83, 0xc6, 0xca, 0x7c, 0x1a, 0x70, 0xab, 0x87, 0x93, 0x8e, 0x9f,
+	0xad, 0xf3, 0xeb, 0x4b, 0x70, 0x0e, 0x8d, 0x6b, 0xda, 0x9f, 0x87, 0x4f, 0xf0, 0x01, 0x1a, 0xef,
+	0x69, 0xb7, 0x3c, 0xb1, 0x7f, 0x80, 0xa3, 0x75, 0x75, 0x33, 0x96, 0x8f, 0xd0, 0xdc, 0xb4, 0x9f,
+	0x9d, 0x6c, 0x3e, 0xb8, 0x15, 0xcd, 0x9e, 0x5e, 0x83, 0xd6, 0xcf, 0x65, 0x3b, 0xf8, 0xb5, 0x6c,
+	0x07, 0x7f, 0x96, 0xed, 0xe0, 0xdb, 0xdf, 0xf6, 0xa3, 0x9b, 0x0a, 0xfd, 0x3d, 0xde, 0xfe, 0x1b,
+	0x00, 0xe7, 0x56, 0x8b, 0x2e, 0x61, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -434,6 +484,7 @@ type ReasonsServiceClient interface {
 	GetAllReasons(ctx context.Context, in *GetAllReas, opts ...grpc.CallOption) (*ListReasons, error)
 	UpdateReasons(ctx context.Context, in *Reasons, opts ...grpc.CallOption) (*Reasons, error)
 	DeleteReasons(ctx context.Context, in *GetReqStrReasons, opts ...grpc.CallOption) (*StatusReasons, error)
+	RestoreReasons(ctx context.Context, in *RestoreReasonsReq, opts ...grpc.CallOption) (*StatusReasons, error)
 }
 
 type reasonsServiceClient struct {
@@ -489,6 +540,15 @@ func (c *reasonsServiceClient) DeleteReasons(ctx context.Context, in *GetReqStrR
 	return out, nil
 }
 
+func (c *reasonsServiceClient) RestoreReasons(ctx context.Context, in *RestoreReasonsReq, opts ...grpc.CallOption) (*StatusReasons, error) {
+	out := new(StatusReasons)
+	err := c.cc.Invoke(ctx, "/healthcare.ReasonsService/RestoreReasons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReasonsServiceServer is the server API for ReasonsService service.
 type ReasonsServiceServer interface {
 	CreateReasons(context.Context, *Reasons) (*Reasons, error)
@@ -496,6 +556,7 @@ type ReasonsServiceServer interface {
 	GetAllReasons(context.Context, *GetAllReas) (*ListReasons, error)
 	UpdateReasons(context.Context, *Reasons) (*Reasons, error)
 	DeleteReasons(context.Context, *GetReqStrReasons) (*StatusReasons, error)
+	RestoreReasons(context.Context, *RestoreReasonsReq) (*StatusReasons, error)
 }
 
 // UnimplementedReasonsServiceServer can be embedded to have forward compatible implementations.
@@ -517,6 +578,9 @@ func (*UnimplementedReasonsServiceServer) UpdateReasons(ctx context.Context, req
 func (*UnimplementedReasonsServiceServer) DeleteReasons(ctx context.Context, req *GetReqStrReasons) (*StatusReasons, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteReasons not implemented")
 }
+func (*UnimplementedReasonsServiceServer) RestoreReasons(ctx context.Context, req *RestoreReasonsReq) (*StatusReasons, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreReasons not implemented")
+}
 
 func RegisterReasonsServiceServer(s *grpc.Server, srv ReasonsServiceServer) {
 	s.RegisterService(&_ReasonsService_serviceDesc, srv)
@@ -612,6 +676,24 @@ func _ReasonsService_DeleteReasons_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReasonsService_RestoreReasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreReasonsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReasonsServiceServer).RestoreReasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.ReasonsService/RestoreReasons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReasonsServiceServer).RestoreReasons(ctx, req.(*RestoreReasonsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ReasonsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "healthcare.ReasonsService",
 	HandlerType: (*ReasonsServiceServer)(nil),
@@ -636,6 +718,10 @@ var _ReasonsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteReasons",
 			Handler:    _ReasonsService_DeleteReasons_Handler,
 		},
+		{
+			MethodName: "RestoreReasons",
+			Handler:    _ReasonsService_RestoreReasons_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "healthcare-service/reasons.proto",
@@ -919,6 +1005,40 @@ func (m *StatusReasons) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RestoreReasonsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreReasonsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreReasonsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintReasons(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintReasons(dAtA []byte, offset int, v uint64) int {
 	offset -= sovReasons(v)
 	base := offset
@@ -1062,6 +1182,22 @@ func (m *StatusReasons) Size() (n int) {
 	return n
 }
 
+func (m *RestoreReasonsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovReasons(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovReasons(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1858,6 +1994,89 @@ func (m *StatusReasons) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RestoreReasonsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReasons
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreReasonsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreReasonsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReasons
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReasons
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReasons
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReasons(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReasons
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipReasons(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
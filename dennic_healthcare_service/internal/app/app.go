@@ -5,6 +5,7 @@ import (
 	grpc_server "Healthcare_Evrone/internal/delivery/grpc/server"
 	invest_grpc "Healthcare_Evrone/internal/delivery/grpc/services"
 	"Healthcare_Evrone/internal/infrastructure/grpc_service_clients"
+	"Healthcare_Evrone/internal/infrastructure/kafka"
 	repo "Healthcare_Evrone/internal/infrastructure/repository/postgresql"
 	"Healthcare_Evrone/internal/pkg/config"
 	"Healthcare_Evrone/internal/pkg/logger"
@@ -12,7 +13,9 @@ import (
 	"Healthcare_Evrone/internal/pkg/postgres"
 	"Healthcare_Evrone/internal/usecase"
 	"Healthcare_Evrone/internal/usecase/event"
+	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -31,6 +34,7 @@ type App struct {
 	ShutdownOTLP   func() error
 	ServiceClients grpc_service_clients.ServiceClients
 	BrokerProducer event.BrokerProducer
+	StopJobs       context.CancelFunc
 }
 
 func NewApp(cfg *config.Config) (*App, error) {
@@ -40,7 +44,8 @@ func NewApp(cfg *config.Config) (*App, error) {
 		return nil, err
 	}
 
-	//kafkaProducer := kafka.NewProducer(cfg, logger)
+	// kafka producer of the healthcare change events
+	kafkaProducer := kafka.NewProducer(cfg, logger)
 
 	// otlp collector initialization
 	shutdownOTLP, err := otlp.InitOTLPProvider(cfg)
@@ -72,11 +77,12 @@ func NewApp(cfg *config.Config) (*App, error) {
 	)
 
 	return &App{
-		Config:       cfg,
-		Logger:       logger,
-		DB:           db,
-		GrpcServer:   grpcServer,
-		ShutdownOTLP: shutdownOTLP,
+		Config:         cfg,
+		Logger:         logger,
+		DB:             db,
+		GrpcServer:     grpcServer,
+		ShutdownOTLP:   shutdownOTLP,
+		BrokerProducer: kafkaProducer,
 	}, nil
 }
 
@@ -90,6 +96,46 @@ func (a *App) Run() error {
 	if err != nil {
 		return fmt.Errorf("error during parse duration for context timeout : %w", err)
 	}
+
+	// license expiry initialization
+	licenseExpiryInterval, err := time.ParseDuration(a.Config.LicenseExpiry.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse license expiry interval: %w", err)
+	}
+	licenseExpiryWarnDays, err := strconv.Atoi(a.Config.LicenseExpiry.WarnDays)
+	if err != nil {
+		return fmt.Errorf("error during parse license expiry warn days: %w", err)
+	}
+
+	// price schedule initialization
+	priceScheduleInterval, err := time.ParseDuration(a.Config.PriceSchedule.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse price schedule interval: %w", err)
+	}
+
+	// purge initialization
+	purgeInterval, err := time.ParseDuration(a.Config.Purge.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse purge interval: %w", err)
+	}
+	purgeRetention, err := time.ParseDuration(a.Config.Purge.Retention)
+	if err != nil {
+		return fmt.Errorf("error during parse purge retention: %w", err)
+	}
+
+	// outbox initialization
+	outboxInterval, err := time.ParseDuration(a.Config.Outbox.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse outbox interval: %w", err)
+	}
+	outboxBatchSize, err := strconv.ParseUint(a.Config.Outbox.BatchSize, 10, 64)
+	if err != nil {
+		return fmt.Errorf("error during parse outbox batch size: %w", err)
+	}
+	outboxRetention, err := time.ParseDuration(a.Config.Outbox.Retention)
+	if err != nil {
+		return fmt.Errorf("error during parse outbox retention: %w", err)
+	}
 	// Initialize Service Clients
 	serviceClients, err := grpc_service_clients.New(a.Config)
 	if err != nil {
@@ -104,26 +150,78 @@ func (a *App) Run() error {
 	dwh := repo.NewDoctorWorkingHoursRepo(a.DB)
 	ds := repo.NewDoctorServicesRepo(a.DB)
 	reas := repo.NewReasonsRepo(a.DB)
+	search := repo.NewSearchRepo(a.DB)
+	translation := repo.NewTranslationRepo(a.DB)
+	leave := repo.NewDoctorLeaveRepo(a.DB)
+	credential := repo.NewDoctorCredentialRepo(a.DB)
+	branch := repo.NewBranchRepo(a.DB)
+	resource := repo.NewResourceRepo(a.DB)
+	servicePackage := repo.NewServicePackageRepo(a.DB)
+	serviceDiscount := repo.NewServiceDiscountRepo(a.DB)
+	priceHistory := repo.NewPriceHistoryRepo(a.DB)
+	retention := repo.NewRetentionRepo(a.DB)
+	outbox := repo.NewOutboxRepo(a.DB)
 
 	// usecase initialization
+	translationUsecase := usecase.NewTranslation(contextTimeout, translation, a.Config.Language.Fallback)
+	pb.RegisterTranslationServiceServer(a.GrpcServer, invest_grpc.TranslationRPC(a.Logger, translationUsecase))
+
 	doctorWorkingHours := usecase.NewDoctorWorkingHoursService(contextTimeout, dwh)
 	pb.RegisterDoctorWorkingHoursServiceServer(a.GrpcServer, invest_grpc.DoctorWorkingHoursServiceRPC(a.Logger, doctorWorkingHours))
 
 	doctorUsecase := usecase.NewDoctorService(contextTimeout, healthRepo)
 	pb.RegisterDoctorServiceServer(a.GrpcServer, invest_grpc.DoctorRPC(a.Logger, doctorUsecase))
 
-	departmentUsecase := usecase.NewDepartmentService(contextTimeout, department)
+	departmentUsecase := usecase.NewDepartmentService(contextTimeout, department, translationUsecase)
 	pb.RegisterDepartmentServiceServer(a.GrpcServer, invest_grpc.DepartmentRPC(a.Logger, departmentUsecase))
 
-	specializationUsecase := usecase.NewSpecializationService(contextTimeout, specialization)
+	specializationUsecase := usecase.NewSpecializationService(contextTimeout, specialization, translationUsecase)
 	pb.RegisterSpecializationServiceServer(a.GrpcServer, invest_grpc.SpecializationRPC(a.Logger, specializationUsecase, a.BrokerProducer))
 
-	doctorsServicesUsecase := usecase.NewDoctorServices(contextTimeout, ds)
+	doctorsServicesUsecase := usecase.NewDoctorServices(contextTimeout, ds, translationUsecase)
 	pb.RegisterDoctorsServiceServer(a.GrpcServer, invest_grpc.DoctorsServiceRPC(a.Logger, doctorsServicesUsecase))
 
-	reasonsUsecase := usecase.NewReasons(contextTimeout, reas)
+	reasonsUsecase := usecase.NewReasons(contextTimeout, reas, translationUsecase)
 	pb.RegisterReasonsServiceServer(a.GrpcServer, invest_grpc.ReasonsServiceRPC(a.Logger, reasonsUsecase))
 
+	searchUsecase := usecase.NewSearch(contextTimeout, search)
+	pb.RegisterSearchServiceServer(a.GrpcServer, invest_grpc.SearchRPC(a.Logger, searchUsecase))
+
+	leaveBlocks := grpc_service_clients.NewLeaveBlocks(a.ServiceClients.DoctorTimeService())
+	doctorLeaveUsecase := usecase.NewDoctorLeaveService(contextTimeout, leave, leaveBlocks)
+	pb.RegisterDoctorLeaveServiceServer(a.GrpcServer, invest_grpc.DoctorLeaveRPC(a.Logger, doctorLeaveUsecase))
+
+	doctorCredentialUsecase := usecase.NewDoctorCredentialService(contextTimeout, credential)
+	pb.RegisterDoctorCredentialServiceServer(a.GrpcServer, invest_grpc.DoctorCredentialRPC(a.Logger, doctorCredentialUsecase))
+
+	branchUsecase := usecase.NewBranchService(contextTimeout, branch)
+	pb.RegisterBranchServiceServer(a.GrpcServer, invest_grpc.BranchRPC(a.Logger, branchUsecase))
+
+	resourceUsecase := usecase.NewResourceService(contextTimeout, resource)
+	pb.RegisterResourceServiceServer(a.GrpcServer, invest_grpc.ResourceRPC(a.Logger, resourceUsecase))
+
+	servicePackageUsecase := usecase.NewServicePackageService(contextTimeout, servicePackage)
+	pb.RegisterServicePackageServiceServer(a.GrpcServer, invest_grpc.ServicePackageRPC(a.Logger, servicePackageUsecase))
+
+	serviceDiscountUsecase := usecase.NewServiceDiscountService(contextTimeout, serviceDiscount, ds)
+	pb.RegisterServiceDiscountServiceServer(a.GrpcServer, invest_grpc.ServiceDiscountRPC(a.Logger, serviceDiscountUsecase))
+
+	priceHistoryUsecase := usecase.NewPriceHistoryService(contextTimeout, priceHistory)
+	pb.RegisterPriceHistoryServiceServer(a.GrpcServer, invest_grpc.PriceHistoryRPC(a.Logger, priceHistoryUsecase))
+
+	retentionUsecase := usecase.NewRetentionService(contextTimeout, retention)
+
+	outboxUsecase := usecase.NewOutboxService(contextTimeout, outbox, a.BrokerProducer)
+
+	// background jobs
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	a.StopJobs = stopJobs
+	go a.runLicenseExpiry(jobsCtx, doctorCredentialUsecase, licenseExpiryInterval, licenseExpiryWarnDays)
+	go a.runScheduledPrices(jobsCtx, priceHistoryUsecase, priceScheduleInterval)
+	go a.runPurge(jobsCtx, retentionUsecase, purgeInterval, purgeRetention)
+	go a.runOutboxRelay(jobsCtx, outboxUsecase, outboxInterval, outboxBatchSize)
+	go a.runOutboxPurge(jobsCtx, outboxUsecase, purgeInterval, outboxRetention)
+
 	a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
 	if err := grpc_server.Run(a.Config, a.GrpcServer); err != nil {
 		return fmt.Errorf("gRPC fatal to serve grpc server over %s %w", a.Config.RPCPort, err)
@@ -131,7 +229,126 @@ func (a *App) Run() error {
 	return nil
 }
 
+// runLicenseExpiry periodically flags the licenses of the doctors expiring within the next warnDays days
+func (a *App) runLicenseExpiry(ctx context.Context, credentials usecase.DoctorCredentialUseCase, interval time.Duration, warnDays int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		flagged, err := credentials.FlagExpiringLicenses(ctx, warnDays)
+		if err != nil {
+			a.Logger.Error("license expiry", zap.Error(err))
+		}
+		for _, license := range flagged {
+			a.Logger.Warn("license expiring",
+				zap.String("doctor_id", license.DoctorId),
+				zap.String("number", license.Number),
+				zap.String("expiry_date", license.ExpiryDate),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runScheduledPrices periodically applies the scheduled prices which took effect to the doctor services
+func (a *App) runScheduledPrices(ctx context.Context, priceHistory usecase.PriceHistoryUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		applied, err := priceHistory.ApplyDuePrices(ctx)
+		if err != nil {
+			a.Logger.Error("scheduled prices", zap.Error(err))
+		}
+		if applied > 0 {
+			a.Logger.Info("scheduled prices applied", zap.Int64("count", applied))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runPurge periodically hard deletes the healthcare records soft deleted longer than the retention ago
+func (a *App) runPurge(ctx context.Context, retention usecase.RetentionUseCase, interval, period time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := retention.PurgeDeleted(ctx, period)
+		if err != nil {
+			a.Logger.Error("purge deleted", zap.Error(err))
+		}
+		if purged > 0 {
+			a.Logger.Info("deleted records purged", zap.Int64("count", purged))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOutboxRelay periodically publishes the healthcare change events waiting in the outbox,
+// a full batch is followed by the next one at once
+func (a *App) runOutboxRelay(ctx context.Context, outbox usecase.OutboxUseCase, interval time.Duration, batchSize uint64) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		relayed, err := outbox.RelayEvents(ctx, batchSize)
+		if err != nil {
+			a.Logger.Error("outbox relay", zap.Error(err))
+		}
+		if relayed > 0 && uint64(relayed) == batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOutboxPurge periodically deletes the outbox events published longer than the retention ago
+func (a *App) runOutboxPurge(ctx context.Context, outbox usecase.OutboxUseCase, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := outbox.PurgePublished(ctx, retention)
+		if err != nil {
+			a.Logger.Error("outbox purge", zap.Error(err))
+		}
+		if purged > 0 {
+			a.Logger.Info("published events purged", zap.Int64("count", purged))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func (a *App) Stop() {
+	// stop background jobs
+	if a.StopJobs != nil {
+		a.StopJobs()
+	}
 	// close broker producer
 	a.BrokerProducer.Close()
 	// closing client service connections
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"Healthcare_Evrone/internal/pkg/language"
 	"context"
 
 	"go.uber.org/zap"
@@ -28,8 +29,9 @@ func UnaryInterceptorData(logger *zap.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		md, ok := metadata.FromIncomingContext(ctx)
 		if ok {
-			_ = md
-			//...
+			if values := md.Get(language.MetadataKey); len(values) > 0 {
+				ctx = language.NewContext(ctx, language.Parse(values[0]))
+			}
 		}
 		return handler(ctx, req)
 	}
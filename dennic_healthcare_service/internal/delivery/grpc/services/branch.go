@@ -0,0 +1,160 @@
+package services
+
+import (
+	pb "Healthcare_Evrone/genproto/healthcare-service"
+	rpc "Healthcare_Evrone/internal/delivery/grpc"
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/usecase"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type branchRPC struct {
+	logger *zap.Logger
+	branch usecase.BranchUseCase
+}
+
+const (
+	serviceNameBranchDelivery           = "branchDelivery"
+	serviceNameBranchDeliveryRepoPrefix = "branchDelivery"
+)
+
+func BranchRPC(logger *zap.Logger, branchUsecase usecase.BranchUseCase) pb.BranchServiceServer {
+	return &branchRPC{
+		logger,
+		branchUsecase,
+	}
+}
+
+func branchReq(req *pb.Branch) *entity.Branch {
+	return &entity.Branch{
+		Id:           req.Id,
+		Name:         req.Name,
+		Address:      req.Address,
+		City:         req.City,
+		Latitude:     req.Latitude,
+		Longitude:    req.Longitude,
+		OpensAt:      req.OpensAt,
+		ClosesAt:     req.ClosesAt,
+		PhoneNumbers: req.PhoneNumbers,
+	}
+}
+
+func branchToPb(branch *entity.Branch) *pb.Branch {
+	return &pb.Branch{
+		Id:           branch.Id,
+		Name:         branch.Name,
+		Address:      branch.Address,
+		City:         branch.City,
+		Latitude:     branch.Latitude,
+		Longitude:    branch.Longitude,
+		OpensAt:      branch.OpensAt,
+		ClosesAt:     branch.ClosesAt,
+		PhoneNumbers: branch.PhoneNumbers,
+		CreatedAt:    branch.CreatedAt.String(),
+		UpdatedAt:    branch.UpdatedAt.String(),
+	}
+}
+
+func (r branchRPC) CreateBranch(ctx context.Context, req *pb.Branch) (*pb.Branch, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBranchDelivery, serviceNameBranchDeliveryRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateBranch").String(req.Id))
+	defer span.End()
+
+	resp, err := r.branch.CreateBranch(ctx, branchReq(req))
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return branchToPb(resp), nil
+}
+
+func (r branchRPC) GetBranch(ctx context.Context, req *pb.BranchId) (*pb.Branch, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBranchDelivery, serviceNameBranchDeliveryRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetBranch").String(req.Id))
+	defer span.End()
+
+	resp, err := r.branch.GetBranch(ctx, req.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return branchToPb(resp), nil
+}
+
+func (r branchRPC) ListBranches(ctx context.Context, req *pb.ListBranchesReq) (*pb.ListBranchesRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBranchDelivery, serviceNameBranchDeliveryRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListBranches").String(req.City))
+	defer span.End()
+
+	resp, err := r.branch.ListBranches(ctx, &entity.ListBranchesReq{
+		Page:  req.Page,
+		Limit: req.Limit,
+		City:  req.City,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	response := pb.ListBranchesRes{
+		Count: resp.Count,
+	}
+	for i := range resp.Branches {
+		response.Branches = append(response.Branches, branchToPb(&resp.Branches[i]))
+	}
+	return &response, nil
+}
+
+func (r branchRPC) UpdateBranch(ctx context.Context, req *pb.Branch) (*pb.Branch, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBranchDelivery, serviceNameBranchDeliveryRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateBranch").String(req.Id))
+	defer span.End()
+
+	resp, err := r.branch.UpdateBranch(ctx, branchReq(req))
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return branchToPb(resp), nil
+}
+
+func (r branchRPC) DeleteBranch(ctx context.Context, req *pb.BranchId) (*pb.StatusBranch, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBranchDelivery, serviceNameBranchDeliveryRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteBranch").String(req.Id))
+	defer span.End()
+
+	status, err := r.branch.DeleteBranch(ctx, req.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return &pb.StatusBranch{Status: status}, nil
+}
+
+func (r branchRPC) ListBranchesNearby(ctx context.Context, req *pb.BranchesNearbyReq) (*pb.ListNearbyBranches, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBranchDelivery, serviceNameBranchDeliveryRepoPrefix+"List nearby")
+	span.SetAttributes(attribute.Key("latitude").Float64(req.Latitude), attribute.Key("longitude").Float64(req.Longitude))
+	defer span.End()
+
+	resp, err := r.branch.ListBranchesNearby(ctx, &entity.BranchesNearbyReq{
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		RadiusKm:  req.RadiusKm,
+		Limit:     req.Limit,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	var response pb.ListNearbyBranches
+	for i := range resp {
+		response.Branches = append(response.Branches, &pb.NearbyBranch{
+			Branch:     branchToPb(&resp[i].Branch),
+			DistanceKm: resp[i].DistanceKm,
+		})
+	}
+	return &response, nil
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	pb "Healthcare_Evrone/genproto/healthcare-service"
+	rpc "Healthcare_Evrone/internal/delivery/grpc"
 	"Healthcare_Evrone/internal/entity"
 	"Healthcare_Evrone/internal/pkg/config"
 	"Healthcare_Evrone/internal/pkg/minio"
@@ -50,6 +51,7 @@ func (r departmentRPC) CreateDepartment(ctx context.Context, dep *pb.Department)
 		ImageUrl:         reqImageUrl,
 		FloorNumber:      dep.FloorNumber,
 		ShortDescription: dep.ShortDescription,
+		BranchId:         dep.BranchId,
 	}
 
 	resp, err := r.department.CreateDepartment(ctx, &req)
@@ -67,6 +69,7 @@ func (r departmentRPC) CreateDepartment(ctx context.Context, dep *pb.Department)
 		ImageUrl:         respImageUrl,
 		FloorNumber:      resp.FloorNumber,
 		ShortDescription: resp.ShortDescription,
+		BranchId:         resp.BranchId,
 		CreatedAt:        resp.CreatedAt.String(),
 		UpdatedAt:        resp.UpdatedAt.String(),
 		DeletedAt:        resp.DeletedAt.String(),
@@ -91,6 +94,7 @@ func (r departmentRPC) GetDepartmentById(ctx context.Context, get *pb.GetReqStrD
 		ImageUrl:         respImageUrl,
 		FloorNumber:      resp.FloorNumber,
 		ShortDescription: resp.ShortDescription,
+		BranchId:         resp.BranchId,
 		CreatedAt:        resp.CreatedAt.String(),
 		UpdatedAt:        resp.UpdatedAt.String(),
 		DeletedAt:        resp.DeletedAt.String(),
@@ -126,6 +130,7 @@ func (r departmentRPC) GetAllDepartments(ctx context.Context, get *pb.GetAllDepa
 			ImageUrl:         respImageUrl,
 			FloorNumber:      dep.FloorNumber,
 			ShortDescription: dep.ShortDescription,
+			BranchId:         dep.BranchId,
 			CreatedAt:        dep.CreatedAt.String(),
 			UpdatedAt:        dep.UpdatedAt.String(),
 			DeletedAt:        dep.DeletedAt.String(),
@@ -149,6 +154,7 @@ func (r departmentRPC) UpdateDepartment(ctx context.Context, update *pb.Departme
 		ImageUrl:         reqImageUrl,
 		FloorNumber:      update.FloorNumber,
 		ShortDescription: update.ShortDescription,
+		BranchId:         update.BranchId,
 		CreatedAt:        time.Time{},
 		UpdatedAt:        time.Time{},
 		DeletedAt:        time.Time{},
@@ -166,6 +172,7 @@ func (r departmentRPC) UpdateDepartment(ctx context.Context, update *pb.Departme
 		ImageUrl:         respImageUrl,
 		FloorNumber:      resp.FloorNumber,
 		ShortDescription: resp.ShortDescription,
+		BranchId:         resp.BranchId,
 		CreatedAt:        resp.CreatedAt.String(),
 		UpdatedAt:        resp.UpdatedAt.String(),
 		DeletedAt:        resp.DeletedAt.String(),
@@ -184,3 +191,15 @@ func (r departmentRPC) DeleteDepartment(ctx context.Context, del *pb.GetReqStrDe
 	fmt.Println(status)
 	return &pb.StatusDepartment{Status: status}, nil
 }
+
+func (r departmentRPC) RestoreDepartment(ctx context.Context, in *pb.RestoreDepartmentReq) (*pb.StatusDepartment, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDepartmentDelivery, serviceNameDepartmentDeliveryRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreDepartment").String(in.Id))
+	defer span.End()
+
+	status, err := r.department.RestoreDepartment(ctx, in.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+	return &pb.StatusDepartment{Status: status}, nil
+}
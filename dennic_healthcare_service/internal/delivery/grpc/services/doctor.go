@@ -2,16 +2,20 @@ package services
 
 import (
 	pb "Healthcare_Evrone/genproto/healthcare-service"
+	rpc "Healthcare_Evrone/internal/delivery/grpc"
 	"Healthcare_Evrone/internal/entity"
 	"Healthcare_Evrone/internal/pkg/minio"
 	"Healthcare_Evrone/internal/pkg/otlp"
 	"Healthcare_Evrone/internal/usecase"
 	"context"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type doctorRPC struct {
@@ -22,7 +26,6 @@ type doctorRPC struct {
 const (
 	serviceNameDoctorDelivery           = "doctorDelivery"
 	serviceNameDoctorDeliveryRepoPrefix = "doctorDelivery"
-	dayOfWeek                           = "Monday"
 )
 
 func DoctorRPC(logget *zap.Logger, doctorUsecase usecase.DoctorUsecase) pb.DoctorServiceServer {
@@ -59,7 +62,9 @@ func (r doctorRPC) CreateDoctor(ctx context.Context, doctor *pb.Doctor) (*pb.Doc
 		EndWorkDate:   doctor.EndWorkDate,
 		WorkYears:     doctor.WorkYears,
 		DepartmentId:  doctor.DepartmentId,
+		DepartmentIds: doctor.DepartmentIds,
 		RoomNumber:    doctor.RoomNumber,
+		BranchId:      doctor.BranchId,
 		Password:      doctor.Password,
 	}
 
@@ -90,7 +95,9 @@ func (r doctorRPC) CreateDoctor(ctx context.Context, doctor *pb.Doctor) (*pb.Doc
 		EndWorkDate:   resp.EndWorkDate,
 		WorkYears:     resp.WorkYears,
 		DepartmentId:  resp.DepartmentId,
+		DepartmentIds: resp.DepartmentIds,
 		RoomNumber:    resp.RoomNumber,
+		BranchId:      resp.BranchId,
 		CreatedAt:     resp.CreatedAt.String(),
 		UpdatedAt:     resp.UpdatedAt.String(),
 		DeletedAt:     resp.DeletedAt.String(),
@@ -130,18 +137,22 @@ func (r doctorRPC) GetDoctorById(ctx context.Context, str *pb.GetReqStrDoctor) (
 		Salary:          doctor.Salary,
 		StartTime:       doctor.StartTime,
 		FinishTime:      doctor.FinishTime,
-		DayOfWeek:       dayOfWeek,
+		DayOfWeek:       doctor.DayOfWeek,
 		Bio:             doctor.Bio,
 		StartWorkDate:   doctor.StartWorkDate,
 		EndWorkDate:     doctor.EndWorkDate,
 		WorkYears:       doctor.WorkYears,
 		DepartmentId:    doctor.DepartmentId,
+		DepartmentIds:   doctor.DepartmentIds,
 		RoomNumber:      doctor.RoomNumber,
+		BranchId:        doctor.BranchId,
 		Password:        doctor.Password,
 		Specializations: doctorSpec,
 		CreatedAt:       doctor.CreatedAt.String(),
 		UpdatedAt:       doctor.UpdatedAt.String(),
 		DeletedAt:       doctor.DeletedAt.String(),
+		Rating:          doctor.Rating,
+		ReviewCount:     doctor.ReviewCount,
 	}, nil
 }
 
@@ -189,18 +200,22 @@ func (r doctorRPC) GetAllDoctors(ctx context.Context, all *pb.GetAllDoctorS) (*p
 			Salary:          doctor.Salary,
 			StartTime:       doctor.StartTime,
 			FinishTime:      doctor.FinishTime,
-			DayOfWeek:       dayOfWeek,
+			DayOfWeek:       doctor.DayOfWeek,
 			Bio:             doctor.Bio,
 			StartWorkDate:   doctor.StartWorkDate,
 			EndWorkDate:     doctor.EndWorkDate,
 			WorkYears:       doctor.WorkYears,
 			DepartmentId:    doctor.DepartmentId,
 			RoomNumber:      doctor.RoomNumber,
+			BranchId:        doctor.BranchId,
 			Password:        doctor.Password,
 			Specializations: doctorSpec,
 			CreatedAt:       doctor.CreatedAt.String(),
 			UpdatedAt:       doctor.UpdatedAt.String(),
 			DeletedAt:       doctor.DeletedAt.String(),
+
+			Rating:      doctor.Rating,
+			ReviewCount: doctor.ReviewCount,
 		})
 	}
 	doctors.Count = resp.Count
@@ -233,7 +248,9 @@ func (r doctorRPC) UpdateDoctor(ctx context.Context, doctor *pb.Doctor) (*pb.Doc
 		EndWorkDate:   doctor.EndWorkDate,
 		WorkYears:     doctor.WorkYears,
 		DepartmentId:  doctor.DepartmentId,
+		DepartmentIds: doctor.DepartmentIds,
 		RoomNumber:    doctor.RoomNumber,
+		BranchId:      doctor.BranchId,
 		Password:      doctor.Password,
 		UpdatedAt:     time.Now(),
 	}
@@ -263,7 +280,9 @@ func (r doctorRPC) UpdateDoctor(ctx context.Context, doctor *pb.Doctor) (*pb.Doc
 		EndWorkDate:   resp.EndWorkDate,
 		WorkYears:     resp.WorkYears,
 		DepartmentId:  resp.DepartmentId,
+		DepartmentIds: resp.DepartmentIds,
 		RoomNumber:    resp.RoomNumber,
+		BranchId:      resp.BranchId,
 		CreatedAt:     resp.CreatedAt.String(),
 		UpdatedAt:     resp.UpdatedAt.String(),
 		DeletedAt:     resp.DeletedAt.String(),
@@ -284,6 +303,18 @@ func (r doctorRPC) DeleteDoctor(ctx context.Context, str *pb.GetReqStrDoctor) (*
 	return &pb.StatusDoctor{Status: status}, nil
 }
 
+func (r doctorRPC) RestoreDoctor(ctx context.Context, in *pb.RestoreDoctorReq) (*pb.StatusDoctor, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorDelivery, serviceNameDoctorDeliveryRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreDoctor").String(in.Id))
+	defer span.End()
+
+	status, err := r.doctor.RestoreDoctor(ctx, in.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+	return &pb.StatusDoctor{Status: status}, nil
+}
+
 func (r doctorRPC) ListDoctorsByDepartmentId(ctx context.Context, dep *pb.GetReqStrDep) (*pb.ListDoctors, error) {
 	ctx, span := otlp.Start(ctx, serviceNameDoctorDelivery, serviceNameDoctorDeliveryRepoPrefix+"Get all")
 	span.SetAttributes(attribute.Key("ListDoctorsByDepartmentId").String(dep.DepartmentId))
@@ -331,7 +362,9 @@ func (r doctorRPC) ListDoctorsByDepartmentId(ctx context.Context, dep *pb.GetReq
 			EndWorkDate:     doctor.EndWorkDate,
 			WorkYears:       doctor.WorkYears,
 			DepartmentId:    doctor.DepartmentId,
+			DepartmentIds:   doctor.DepartmentIds,
 			RoomNumber:      doctor.RoomNumber,
+			BranchId:        doctor.BranchId,
 			Password:        doctor.Password,
 			Specializations: doctorSpec,
 			CreatedAt:       doctor.CreatedAt.String(),
@@ -391,14 +424,127 @@ func (r doctorRPC) ListDoctorBySpecializationId(ctx context.Context, spec *pb.Ge
 			EndWorkDate:     doctor.EndWorkDate,
 			WorkYears:       doctor.WorkYears,
 			DepartmentId:    doctor.DepartmentId,
+			DepartmentIds:   doctor.DepartmentIds,
 			RoomNumber:      doctor.RoomNumber,
+			BranchId:        doctor.BranchId,
 			Password:        doctor.Password,
 			Specializations: doctorSpec,
 			CreatedAt:       doctor.CreatedAt.String(),
 			UpdatedAt:       doctor.UpdatedAt.String(),
 			DeletedAt:       doctor.DeletedAt.String(),
+
+			Rating:      doctor.Rating,
+			ReviewCount: doctor.ReviewCount,
 		})
 	}
 	doctors.Count += resp.Count
 	return &doctors, nil
 }
+
+func (r doctorRPC) ListDoctorsForService(ctx context.Context, in *pb.GetReqServiceDoctors) (*pb.ListServiceDoctors, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorDelivery, serviceNameDoctorDeliveryRepoPrefix+"List for service")
+	span.SetAttributes(attribute.Key("ListDoctorsForService").String(in.DoctorServiceId))
+	defer span.End()
+
+	resp, err := r.doctor.ListDoctorsForService(ctx, &entity.GetReqServiceDoctors{
+		DepartmentId:     in.DepartmentId,
+		DoctorServiceId:  in.DoctorServiceId,
+		SpecializationId: in.SpecializationId,
+		DayOfWeek:        in.DayOfWeek,
+		Date:             in.Date,
+	})
+	if err != nil {
+		r.logger.Error("Failed to list doctors for service", zap.Error(err))
+		return nil, err
+	}
+
+	var doctors pb.ListServiceDoctors
+	for _, doctor := range resp {
+		doctors.Doctors = append(doctors.Doctors, &pb.ServiceDoctor{
+			DoctorId:        doctor.DoctorId,
+			DoctorServiceId: doctor.DoctorServiceId,
+			StartTime:       doctor.StartTime.Format("15:04:05"),
+			FinishTime:      doctor.FinishTime.Format("15:04:05"),
+			Kind:            doctor.Kind,
+		})
+	}
+
+	return &doctors, nil
+}
+
+func (r doctorRPC) SetDoctorRating(ctx context.Context, in *pb.DoctorRating) (*pb.StatusDoctor, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorDelivery, serviceNameDoctorDeliveryRepoPrefix+"Set rating")
+	span.SetAttributes(attribute.Key("SetDoctorRating").String(in.DoctorId))
+	defer span.End()
+
+	status, err := r.doctor.SetDoctorRating(ctx, &entity.DoctorRating{
+		DoctorId:    in.DoctorId,
+		Rating:      in.Rating,
+		ReviewCount: in.ReviewCount,
+	})
+	if err != nil {
+		r.logger.Error("Failed to set doctor rating", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.StatusDoctor{Status: status}, nil
+}
+
+func (r doctorRPC) ListDoctorsForReason(ctx context.Context, in *pb.GetReqReasonDoctors) (*pb.ReasonDoctors, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorDelivery, serviceNameDoctorDeliveryRepoPrefix+"List for reason")
+	span.SetAttributes(attribute.Key("ListDoctorsForReason").String(in.ReasonId + in.Query))
+	defer span.End()
+
+	if strings.TrimSpace(in.ReasonId) == "" && strings.TrimSpace(in.Query) == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason_id or query is required")
+	}
+
+	resp, err := r.doctor.ListDoctorsForReason(ctx, &entity.GetReqReasonDoctors{
+		ReasonId: strings.TrimSpace(in.ReasonId),
+		Query:    strings.TrimSpace(in.Query),
+	})
+	if err != nil {
+		r.logger.Error("Failed to list doctors for reason", zap.Error(err))
+		return nil, rpc.Error(ctx, err)
+	}
+
+	response := pb.ReasonDoctors{
+		ReasonId:         resp.ReasonId,
+		ReasonName:       resp.ReasonName,
+		SpecializationId: resp.SpecializationId,
+	}
+	for _, doctor := range resp.Doctors {
+		reasonDoctor := &pb.ReasonDoctor{
+			DoctorId:        doctor.DoctorId,
+			FirstName:       doctor.FirstName,
+			LastName:        doctor.LastName,
+			ImageUrl:        doctor.ImageUrl,
+			DepartmentId:    doctor.DepartmentId,
+			DoctorServiceId: doctor.DoctorServiceId,
+			OnlinePrice:     doctor.OnlinePrice,
+			OfflinePrice:    doctor.OfflinePrice,
+			Duration:        doctor.Duration,
+			Rating:          doctor.Rating,
+			ReviewCount:     doctor.ReviewCount,
+		}
+		for _, hours := range doctor.WorkingHours {
+			reasonDoctor.WorkingHours = append(reasonDoctor.WorkingHours, &pb.ReasonDoctorHours{
+				DayOfWeek:     hours.DayOfWeek,
+				StartTime:     hours.StartTime.Format("15:04:05"),
+				FinishTime:    hours.FinishTime.Format("15:04:05"),
+				Kind:          hours.Kind,
+				EffectiveFrom: hours.EffectiveFrom,
+				EffectiveTo:   hours.EffectiveTo,
+			})
+		}
+		for _, leave := range doctor.Leaves {
+			reasonDoctor.Leaves = append(reasonDoctor.Leaves, &pb.ReasonDoctorLeave{
+				StartDate: leave.StartDate,
+				EndDate:   leave.EndDate,
+			})
+		}
+		response.Doctors = append(response.Doctors, reasonDoctor)
+	}
+
+	return &response, nil
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	pb "Healthcare_Evrone/genproto/healthcare-service"
+	rpc "Healthcare_Evrone/internal/delivery/grpc"
 	"Healthcare_Evrone/internal/entity"
 	"Healthcare_Evrone/internal/pkg/otlp"
 	"Healthcare_Evrone/internal/usecase"
@@ -34,26 +35,32 @@ func (r doctorWorkingHoursServiceRPC) CreateDoctorWorkingHours(ctx context.Conte
 	span.SetAttributes(attribute.Key("CreateDoctorWorkingHours").String(string(hours.Id)))
 	defer span.End()
 	req := entity.DoctorWorkingHours{
-		Id:         hours.Id,
-		DoctorId:   hours.DoctorId,
-		DayOfWeek:  hours.DayOfWeek,
-		StartTime:  hours.StartTime,
-		FinishTime: hours.FinishTime,
+		Id:            hours.Id,
+		DoctorId:      hours.DoctorId,
+		DayOfWeek:     hours.DayOfWeek,
+		Kind:          hours.Kind,
+		StartTime:     hours.StartTime,
+		FinishTime:    hours.FinishTime,
+		EffectiveFrom: hours.EffectiveFrom,
+		EffectiveTo:   hours.EffectiveTo,
 	}
 	resp, err := r.doctorWorkingHours.CreateDoctorWorkingHours(ctx, &req)
 	if err != nil {
-		return nil, err
+		return nil, rpc.Error(ctx, err)
 	}
 
 	return &pb.DoctorWorkingHours{
-		Id:         resp.Id,
-		DoctorId:   resp.DoctorId,
-		DayOfWeek:  resp.DayOfWeek,
-		StartTime:  resp.StartTime,
-		FinishTime: resp.FinishTime,
-		CreatedAt:  resp.CreatedAt.String(),
-		UpdatedAt:  resp.UpdatedAt.String(),
-		DeletedAt:  resp.DeletedAt.String(),
+		Id:            resp.Id,
+		DoctorId:      resp.DoctorId,
+		DayOfWeek:     resp.DayOfWeek,
+		StartTime:     resp.StartTime,
+		FinishTime:    resp.FinishTime,
+		CreatedAt:     resp.CreatedAt.String(),
+		UpdatedAt:     resp.UpdatedAt.String(),
+		DeletedAt:     resp.DeletedAt.String(),
+		Kind:          resp.Kind,
+		EffectiveFrom: resp.EffectiveFrom,
+		EffectiveTo:   resp.EffectiveTo,
 	}, nil
 }
 
@@ -71,14 +78,17 @@ func (r doctorWorkingHoursServiceRPC) GetDoctorWorkingHoursById(ctx context.Cont
 		return nil, err
 	}
 	return &pb.DoctorWorkingHours{
-		Id:         dwh.Id,
-		DoctorId:   dwh.DoctorId,
-		DayOfWeek:  dwh.DayOfWeek,
-		StartTime:  dwh.StartTime,
-		FinishTime: dwh.FinishTime,
-		CreatedAt:  dwh.CreatedAt.String(),
-		UpdatedAt:  dwh.UpdatedAt.String(),
-		DeletedAt:  dwh.DeletedAt.String(),
+		Id:            dwh.Id,
+		DoctorId:      dwh.DoctorId,
+		DayOfWeek:     dwh.DayOfWeek,
+		StartTime:     dwh.StartTime,
+		FinishTime:    dwh.FinishTime,
+		CreatedAt:     dwh.CreatedAt.String(),
+		UpdatedAt:     dwh.UpdatedAt.String(),
+		DeletedAt:     dwh.DeletedAt.String(),
+		Kind:          dwh.Kind,
+		EffectiveFrom: dwh.EffectiveFrom,
+		EffectiveTo:   dwh.EffectiveTo,
 	}, nil
 }
 
@@ -100,14 +110,17 @@ func (r doctorWorkingHoursServiceRPC) GetAllDoctorWorkingHours(ctx context.Conte
 	var listDoctorWorkingHours pb.ListDoctorWorkingHours
 	for _, d := range dwh.DoctorWhs {
 		listDoctorWorkingHours.Dwh = append(listDoctorWorkingHours.Dwh, &pb.DoctorWorkingHours{
-			Id:         d.Id,
-			DoctorId:   d.DoctorId,
-			DayOfWeek:  d.DayOfWeek,
-			StartTime:  d.StartTime,
-			FinishTime: d.FinishTime,
-			CreatedAt:  d.CreatedAt.String(),
-			UpdatedAt:  d.UpdatedAt.String(),
-			DeletedAt:  d.DeletedAt.String(),
+			Id:            d.Id,
+			DoctorId:      d.DoctorId,
+			DayOfWeek:     d.DayOfWeek,
+			StartTime:     d.StartTime,
+			FinishTime:    d.FinishTime,
+			CreatedAt:     d.CreatedAt.String(),
+			UpdatedAt:     d.UpdatedAt.String(),
+			DeletedAt:     d.DeletedAt.String(),
+			Kind:          d.Kind,
+			EffectiveFrom: d.EffectiveFrom,
+			EffectiveTo:   d.EffectiveTo,
 		})
 	}
 	listDoctorWorkingHours.Count = dwh.Count
@@ -119,27 +132,33 @@ func (r doctorWorkingHoursServiceRPC) UpdateDoctorWorkingHours(ctx context.Conte
 	span.SetAttributes(attribute.Key("UpdateDoctorWorkingHours").String(string(hours.Id)))
 	defer span.End()
 	resp, err := r.doctorWorkingHours.UpdateDoctorWorkingHours(ctx, &entity.DoctorWorkingHours{
-		Id:         hours.Id,
-		DoctorId:   hours.DoctorId,
-		DayOfWeek:  hours.DayOfWeek,
-		StartTime:  hours.StartTime,
-		FinishTime: hours.FinishTime,
-		CreatedAt:  time.Time{},
-		UpdatedAt:  time.Time{},
-		DeletedAt:  time.Time{},
+		Id:            hours.Id,
+		DoctorId:      hours.DoctorId,
+		DayOfWeek:     hours.DayOfWeek,
+		Kind:          hours.Kind,
+		StartTime:     hours.StartTime,
+		FinishTime:    hours.FinishTime,
+		EffectiveFrom: hours.EffectiveFrom,
+		EffectiveTo:   hours.EffectiveTo,
+		CreatedAt:     time.Time{},
+		UpdatedAt:     time.Time{},
+		DeletedAt:     time.Time{},
 	})
 	if err != nil {
-		return nil, err
+		return nil, rpc.Error(ctx, err)
 	}
 	return &pb.DoctorWorkingHours{
-		Id:         resp.Id,
-		DoctorId:   resp.DoctorId,
-		DayOfWeek:  resp.DayOfWeek,
-		StartTime:  resp.StartTime,
-		FinishTime: resp.FinishTime,
-		CreatedAt:  resp.CreatedAt.String(),
-		UpdatedAt:  resp.UpdatedAt.String(),
-		DeletedAt:  resp.DeletedAt.String(),
+		Id:            resp.Id,
+		DoctorId:      resp.DoctorId,
+		DayOfWeek:     resp.DayOfWeek,
+		StartTime:     resp.StartTime,
+		FinishTime:    resp.FinishTime,
+		CreatedAt:     resp.CreatedAt.String(),
+		UpdatedAt:     resp.UpdatedAt.String(),
+		DeletedAt:     resp.DeletedAt.String(),
+		Kind:          resp.Kind,
+		EffectiveFrom: resp.EffectiveFrom,
+		EffectiveTo:   resp.EffectiveTo,
 	}, nil
 }
 
@@ -0,0 +1,164 @@
+package services
+
+import (
+	pb "Healthcare_Evrone/genproto/healthcare-service"
+	rpc "Healthcare_Evrone/internal/delivery/grpc"
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/usecase"
+	"context"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type doctorCredentialRPC struct {
+	logger           *zap.Logger
+	doctorCredential usecase.DoctorCredentialUseCase
+}
+
+const (
+	serviceNameDoctorCredentialDelivery           = "doctorCredentialDelivery"
+	serviceNameDoctorCredentialDeliveryRepoPrefix = "doctorCredentialDelivery"
+)
+
+func DoctorCredentialRPC(logger *zap.Logger, doctorCredentialUsecase usecase.DoctorCredentialUseCase) pb.DoctorCredentialServiceServer {
+	return &doctorCredentialRPC{
+		logger,
+		doctorCredentialUsecase,
+	}
+}
+
+// doctorCredentialReq maps the request, the document is the object name in the private document bucket,
+// the gateway serves it through presigned urls
+func doctorCredentialReq(req *pb.DoctorCredential) *entity.DoctorCredential {
+	return &entity.DoctorCredential{
+		Id:          req.Id,
+		DoctorId:    req.DoctorId,
+		Type:        req.Type,
+		IssuingBody: req.IssuingBody,
+		Number:      req.Number,
+		IssueDate:   req.IssueDate,
+		ExpiryDate:  req.ExpiryDate,
+		Document:    req.DocumentObjectName,
+	}
+}
+
+func doctorCredentialToPb(credential *entity.DoctorCredential) *pb.DoctorCredential {
+	response := &pb.DoctorCredential{
+		Id:                 credential.Id,
+		DoctorId:           credential.DoctorId,
+		Type:               credential.Type,
+		IssuingBody:        credential.IssuingBody,
+		Number:             credential.Number,
+		IssueDate:          credential.IssueDate,
+		ExpiryDate:         credential.ExpiryDate,
+		DocumentObjectName: credential.Document,
+		CreatedAt:          credential.CreatedAt.String(),
+		UpdatedAt:          credential.UpdatedAt.String(),
+	}
+	if !credential.ExpiryFlaggedAt.IsZero() {
+		response.ExpiryFlaggedAt = credential.ExpiryFlaggedAt.String()
+	}
+	return response
+}
+
+func (r doctorCredentialRPC) CreateCredential(ctx context.Context, req *pb.DoctorCredential) (*pb.DoctorCredential, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorCredentialDelivery, serviceNameDoctorCredentialDeliveryRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateCredential").String(req.DoctorId))
+	defer span.End()
+
+	resp, err := r.doctorCredential.CreateDoctorCredential(ctx, doctorCredentialReq(req))
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return doctorCredentialToPb(resp), nil
+}
+
+func (r doctorCredentialRPC) GetCredential(ctx context.Context, req *pb.DoctorCredentialId) (*pb.DoctorCredential, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorCredentialDelivery, serviceNameDoctorCredentialDeliveryRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetCredential").String(strconv.FormatInt(req.Id, 10)))
+	defer span.End()
+
+	resp, err := r.doctorCredential.GetDoctorCredential(ctx, req.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return doctorCredentialToPb(resp), nil
+}
+
+func (r doctorCredentialRPC) ListCredentials(ctx context.Context, req *pb.ListDoctorCredentialsReq) (*pb.ListDoctorCredentials, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorCredentialDelivery, serviceNameDoctorCredentialDeliveryRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListCredentials").String(req.DoctorId))
+	defer span.End()
+
+	resp, err := r.doctorCredential.ListDoctorCredentials(ctx, &entity.ListDoctorCredentialsReq{
+		Page:     req.Page,
+		Limit:    req.Limit,
+		DoctorId: req.DoctorId,
+		Type:     req.Type,
+		Expiring: req.Expiring,
+		Expired:  req.Expired,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	response := pb.ListDoctorCredentials{
+		Count: resp.Count,
+	}
+	for i := range resp.Credentials {
+		response.Credentials = append(response.Credentials, doctorCredentialToPb(&resp.Credentials[i]))
+	}
+	return &response, nil
+}
+
+func (r doctorCredentialRPC) UpdateCredential(ctx context.Context, req *pb.DoctorCredential) (*pb.DoctorCredential, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorCredentialDelivery, serviceNameDoctorCredentialDeliveryRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateCredential").String(strconv.FormatInt(req.Id, 10)))
+	defer span.End()
+
+	resp, err := r.doctorCredential.UpdateDoctorCredential(ctx, doctorCredentialReq(req))
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return doctorCredentialToPb(resp), nil
+}
+
+func (r doctorCredentialRPC) DeleteCredential(ctx context.Context, req *pb.DoctorCredentialId) (*pb.StatusDoctorCredential, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorCredentialDelivery, serviceNameDoctorCredentialDeliveryRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteCredential").String(strconv.FormatInt(req.Id, 10)))
+	defer span.End()
+
+	status, err := r.doctorCredential.DeleteDoctorCredential(ctx, req.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return &pb.StatusDoctorCredential{Status: status}, nil
+}
+
+func (r doctorCredentialRPC) GetDoctorLicenseStatus(ctx context.Context, req *pb.DoctorLicenseStatusReq) (*pb.DoctorLicenseStatus, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorCredentialDelivery, serviceNameDoctorCredentialDeliveryRepoPrefix+"License status")
+	span.SetAttributes(attribute.Key(req.DoctorId).String(req.Date))
+	defer span.End()
+
+	resp, err := r.doctorCredential.GetDoctorLicenseStatus(ctx, &entity.DoctorLicenseStatusReq{
+		DoctorId: req.DoctorId,
+		Date:     req.Date,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return &pb.DoctorLicenseStatus{
+		DoctorId:   resp.DoctorId,
+		HasLicense: resp.HasLicense,
+		Expired:    resp.Expired,
+		ExpiryDate: resp.ExpiryDate,
+	}, nil
+}
@@ -0,0 +1,170 @@
+package services
+
+import (
+	pb "Healthcare_Evrone/genproto/healthcare-service"
+	rpc "Healthcare_Evrone/internal/delivery/grpc"
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/usecase"
+	"context"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type doctorLeaveRPC struct {
+	logger      *zap.Logger
+	doctorLeave usecase.DoctorLeaveUseCase
+}
+
+const (
+	serviceNameDoctorLeaveDelivery           = "doctorLeaveDelivery"
+	serviceNameDoctorLeaveDeliveryRepoPrefix = "doctorLeaveDelivery"
+)
+
+func DoctorLeaveRPC(logger *zap.Logger, doctorLeaveUsecase usecase.DoctorLeaveUseCase) pb.DoctorLeaveServiceServer {
+	return &doctorLeaveRPC{
+		logger,
+		doctorLeaveUsecase,
+	}
+}
+
+func doctorLeaveToPb(leave *entity.DoctorLeave) *pb.DoctorLeave {
+	response := &pb.DoctorLeave{
+		Id:           leave.Id,
+		DoctorId:     leave.DoctorId,
+		DepartmentId: leave.DepartmentId,
+		Kind:         leave.Kind,
+		StartDate:    leave.StartDate,
+		EndDate:      leave.EndDate,
+		Reason:       leave.Reason,
+		Status:       leave.Status,
+		ReviewedBy:   leave.ReviewedBy,
+		ReviewNote:   leave.ReviewNote,
+		CreatedAt:    leave.CreatedAt.String(),
+		UpdatedAt:    leave.UpdatedAt.String(),
+	}
+	if !leave.ReviewedAt.IsZero() {
+		response.ReviewedAt = leave.ReviewedAt.String()
+	}
+	return response
+}
+
+func (r doctorLeaveRPC) CreateLeave(ctx context.Context, req *pb.DoctorLeave) (*pb.DoctorLeave, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorLeaveDelivery, serviceNameDoctorLeaveDeliveryRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateLeave").String(req.DoctorId))
+	defer span.End()
+
+	resp, err := r.doctorLeave.CreateDoctorLeave(ctx, &entity.DoctorLeave{
+		DoctorId:  req.DoctorId,
+		Kind:      req.Kind,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return doctorLeaveToPb(resp), nil
+}
+
+func (r doctorLeaveRPC) GetLeave(ctx context.Context, req *pb.DoctorLeaveId) (*pb.DoctorLeave, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorLeaveDelivery, serviceNameDoctorLeaveDeliveryRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetLeave").String(strconv.FormatInt(req.Id, 10)))
+	defer span.End()
+
+	resp, err := r.doctorLeave.GetDoctorLeave(ctx, req.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return doctorLeaveToPb(resp), nil
+}
+
+func (r doctorLeaveRPC) ListLeaves(ctx context.Context, req *pb.ListDoctorLeavesReq) (*pb.ListDoctorLeaves, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorLeaveDelivery, serviceNameDoctorLeaveDeliveryRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListLeaves").String(req.DoctorId))
+	defer span.End()
+
+	resp, err := r.doctorLeave.ListDoctorLeaves(ctx, &entity.ListDoctorLeavesReq{
+		Page:     req.Page,
+		Limit:    req.Limit,
+		DoctorId: req.DoctorId,
+		Status:   req.Status,
+		From:     req.From,
+		To:       req.To,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	response := pb.ListDoctorLeaves{
+		Count: resp.Count,
+	}
+	for i := range resp.Leaves {
+		response.Leaves = append(response.Leaves, doctorLeaveToPb(&resp.Leaves[i]))
+	}
+	return &response, nil
+}
+
+func (r doctorLeaveRPC) ReviewLeave(ctx context.Context, req *pb.ReviewDoctorLeaveReq) (*pb.DoctorLeave, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorLeaveDelivery, serviceNameDoctorLeaveDeliveryRepoPrefix+"Review")
+	span.SetAttributes(attribute.Key(req.Status).String(strconv.FormatInt(req.Id, 10)))
+	defer span.End()
+
+	resp, err := r.doctorLeave.ReviewDoctorLeave(ctx, &entity.ReviewDoctorLeaveReq{
+		Id:         req.Id,
+		Status:     req.Status,
+		ReviewedBy: req.ReviewedBy,
+		ReviewNote: req.ReviewNote,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return doctorLeaveToPb(resp), nil
+}
+
+func (r doctorLeaveRPC) CancelLeave(ctx context.Context, req *pb.DoctorLeaveId) (*pb.DoctorLeave, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorLeaveDelivery, serviceNameDoctorLeaveDeliveryRepoPrefix+"Cancel")
+	span.SetAttributes(attribute.Key("CancelLeave").String(strconv.FormatInt(req.Id, 10)))
+	defer span.End()
+
+	resp, err := r.doctorLeave.CancelDoctorLeave(ctx, req.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return doctorLeaveToPb(resp), nil
+}
+
+func (r doctorLeaveRPC) ListAwayDoctors(ctx context.Context, req *pb.AwayDoctorsReq) (*pb.ListAwayDoctorsRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorLeaveDelivery, serviceNameDoctorLeaveDeliveryRepoPrefix+"List away")
+	span.SetAttributes(attribute.Key(req.Date).String(req.DepartmentId))
+	defer span.End()
+
+	resp, err := r.doctorLeave.ListAwayDoctors(ctx, &entity.AwayDoctorsReq{
+		Date:         req.Date,
+		DepartmentId: req.DepartmentId,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	var response pb.ListAwayDoctorsRes
+	for _, doctor := range resp.Doctors {
+		response.Doctors = append(response.Doctors, &pb.AwayDoctor{
+			DoctorId:     doctor.DoctorId,
+			FirstName:    doctor.FirstName,
+			LastName:     doctor.LastName,
+			DepartmentId: doctor.DepartmentId,
+			LeaveId:      doctor.LeaveId,
+			Kind:         doctor.Kind,
+			StartDate:    doctor.StartDate,
+			EndDate:      doctor.EndDate,
+		})
+	}
+	return &response, nil
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	pb "Healthcare_Evrone/genproto/healthcare-service"
+	rpc "Healthcare_Evrone/internal/delivery/grpc"
 	"Healthcare_Evrone/internal/entity"
 	"Healthcare_Evrone/internal/pkg/otlp"
 	"Healthcare_Evrone/internal/usecase"
@@ -39,14 +40,15 @@ func (r doctorServiceRPC) CreateDoctorServices(ctx context.Context, in *pb.Docto
 		return nil, err
 	}
 	req := entity.DoctorServices{
-		Id:               in.Id,
-		Order:            in.DoctorServiceOrder,
-		DoctorId:         in.DoctorId,
-		SpecializationId: in.SpecializationId,
-		OnlinePrice:      in.OnlinePrice,
-		OfflinePrice:     in.OfflinePrice,
-		Name:             in.Name,
-		Duration:         tm,
+		Id:                   in.Id,
+		Order:                in.DoctorServiceOrder,
+		DoctorId:             in.DoctorId,
+		SpecializationId:     in.SpecializationId,
+		OnlinePrice:          in.OnlinePrice,
+		OfflinePrice:         in.OfflinePrice,
+		Name:                 in.Name,
+		Duration:             tm,
+		RequiredResourceType: in.RequiredResourceType,
 	}
 	resp, err := r.doctorServices.CreateDoctorServices(ctx, &req)
 	if err != nil {
@@ -54,17 +56,18 @@ func (r doctorServiceRPC) CreateDoctorServices(ctx context.Context, in *pb.Docto
 	}
 
 	return &pb.DoctorServices{
-		Id:                 resp.Id,
-		DoctorServiceOrder: resp.Order,
-		DoctorId:           resp.DoctorId,
-		SpecializationId:   resp.SpecializationId,
-		OnlinePrice:        resp.OnlinePrice,
-		OfflinePrice:       resp.OfflinePrice,
-		Name:               resp.Name,
-		Duration:           resp.Duration.Format("15:04"),
-		CreatedAt:          resp.CreatedAt.String(),
-		UpdatedAt:          resp.UpdatedAt.String(),
-		DeletedAt:          resp.DeletedAt.String(),
+		Id:                   resp.Id,
+		DoctorServiceOrder:   resp.Order,
+		DoctorId:             resp.DoctorId,
+		SpecializationId:     resp.SpecializationId,
+		OnlinePrice:          resp.OnlinePrice,
+		OfflinePrice:         resp.OfflinePrice,
+		Name:                 resp.Name,
+		Duration:             resp.Duration.Format("15:04"),
+		RequiredResourceType: resp.RequiredResourceType,
+		CreatedAt:            resp.CreatedAt.String(),
+		UpdatedAt:            resp.UpdatedAt.String(),
+		DeletedAt:            resp.DeletedAt.String(),
 	}, nil
 }
 
@@ -81,17 +84,18 @@ func (r doctorServiceRPC) GetDoctorServiceByID(ctx context.Context, str *pb.GetR
 		return nil, err
 	}
 	return &pb.DoctorServices{
-		Id:                 ds.Id,
-		DoctorServiceOrder: ds.Order,
-		DoctorId:           ds.DoctorId,
-		SpecializationId:   ds.SpecializationId,
-		OnlinePrice:        ds.OnlinePrice,
-		OfflinePrice:       ds.OfflinePrice,
-		Name:               ds.Name,
-		Duration:           ds.Duration.Format("15:04"),
-		CreatedAt:          ds.CreatedAt.String(),
-		UpdatedAt:          ds.UpdatedAt.String(),
-		DeletedAt:          ds.DeletedAt.String(),
+		Id:                   ds.Id,
+		DoctorServiceOrder:   ds.Order,
+		DoctorId:             ds.DoctorId,
+		SpecializationId:     ds.SpecializationId,
+		OnlinePrice:          ds.OnlinePrice,
+		OfflinePrice:         ds.OfflinePrice,
+		Name:                 ds.Name,
+		Duration:             ds.Duration.Format("15:04"),
+		RequiredResourceType: ds.RequiredResourceType,
+		CreatedAt:            ds.CreatedAt.String(),
+		UpdatedAt:            ds.UpdatedAt.String(),
+		DeletedAt:            ds.DeletedAt.String(),
 	}, nil
 }
 
@@ -113,17 +117,18 @@ func (r doctorServiceRPC) GetAllDoctorServices(ctx context.Context, all *pb.GetA
 	var listDoctorServices pb.ListDoctorServices
 	for _, d := range doctorService.DoctorServices {
 		listDoctorServices.DoctorServices = append(listDoctorServices.DoctorServices, &pb.DoctorServices{
-			Id:                 d.Id,
-			DoctorServiceOrder: d.Order,
-			DoctorId:           d.DoctorId,
-			SpecializationId:   d.SpecializationId,
-			OnlinePrice:        d.OnlinePrice,
-			OfflinePrice:       d.OfflinePrice,
-			Name:               d.Name,
-			Duration:           d.Duration.Format("15:04"),
-			CreatedAt:          d.CreatedAt.String(),
-			UpdatedAt:          d.UpdatedAt.String(),
-			DeletedAt:          d.DeletedAt.String(),
+			Id:                   d.Id,
+			DoctorServiceOrder:   d.Order,
+			DoctorId:             d.DoctorId,
+			SpecializationId:     d.SpecializationId,
+			OnlinePrice:          d.OnlinePrice,
+			OfflinePrice:         d.OfflinePrice,
+			Name:                 d.Name,
+			Duration:             d.Duration.Format("15:04"),
+			RequiredResourceType: d.RequiredResourceType,
+			CreatedAt:            d.CreatedAt.String(),
+			UpdatedAt:            d.UpdatedAt.String(),
+			DeletedAt:            d.DeletedAt.String(),
 		})
 	}
 	listDoctorServices.Count = int32(doctorService.Count)
@@ -141,31 +146,33 @@ func (r doctorServiceRPC) UpdateDoctorServices(ctx context.Context, services *pb
 	}
 
 	resp, err := r.doctorServices.UpdateDoctorServices(ctx, &entity.DoctorServices{
-		Id:               services.Id,
-		Order:            services.DoctorServiceOrder,
-		DoctorId:         services.DoctorId,
-		SpecializationId: services.SpecializationId,
-		OnlinePrice:      services.OnlinePrice,
-		OfflinePrice:     services.OfflinePrice,
-		Name:             services.Name,
-		Duration:         tm,
+		Id:                   services.Id,
+		Order:                services.DoctorServiceOrder,
+		DoctorId:             services.DoctorId,
+		SpecializationId:     services.SpecializationId,
+		OnlinePrice:          services.OnlinePrice,
+		OfflinePrice:         services.OfflinePrice,
+		Name:                 services.Name,
+		Duration:             tm,
+		RequiredResourceType: services.RequiredResourceType,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.DoctorServices{
-		Id:                 resp.Id,
-		DoctorServiceOrder: resp.Order,
-		DoctorId:           resp.DoctorId,
-		SpecializationId:   resp.SpecializationId,
-		OnlinePrice:        resp.OnlinePrice,
-		OfflinePrice:       resp.OfflinePrice,
-		Name:               resp.Name,
-		Duration:           resp.Duration.Format("15:04:05"),
-		CreatedAt:          resp.CreatedAt.String(),
-		UpdatedAt:          resp.UpdatedAt.String(),
-		DeletedAt:          resp.DeletedAt.String(),
+		Id:                   resp.Id,
+		DoctorServiceOrder:   resp.Order,
+		DoctorId:             resp.DoctorId,
+		SpecializationId:     resp.SpecializationId,
+		OnlinePrice:          resp.OnlinePrice,
+		OfflinePrice:         resp.OfflinePrice,
+		Name:                 resp.Name,
+		Duration:             resp.Duration.Format("15:04:05"),
+		RequiredResourceType: resp.RequiredResourceType,
+		CreatedAt:            resp.CreatedAt.String(),
+		UpdatedAt:            resp.UpdatedAt.String(),
+		DeletedAt:            resp.DeletedAt.String(),
 	}, nil
 }
 
@@ -180,3 +187,15 @@ func (r doctorServiceRPC) DeleteDoctorService(ctx context.Context, str *pb.GetRe
 	}
 	return &pb.Status{Status: status}, nil
 }
+
+func (r doctorServiceRPC) RestoreDoctorService(ctx context.Context, in *pb.RestoreDoctorServiceReq) (*pb.Status, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorServiceDelivery, serviceNameDoctorServiceDeliveryRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreDoctorService").String(in.Id))
+	defer span.End()
+
+	status, err := r.doctorServices.RestoreDoctorService(ctx, in.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+	return &pb.Status{Status: status}, nil
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	pb "Healthcare_Evrone/genproto/healthcare-service"
+	rpc "Healthcare_Evrone/internal/delivery/grpc"
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/usecase"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type priceHistoryRPC struct {
+	logger       *zap.Logger
+	priceHistory usecase.PriceHistoryUseCase
+}
+
+const (
+	serviceNamePriceHistoryDelivery           = "priceHistoryDelivery"
+	serviceNamePriceHistoryDeliveryRepoPrefix = "priceHistoryDelivery"
+)
+
+func PriceHistoryRPC(logger *zap.Logger, priceHistoryUsecase usecase.PriceHistoryUseCase) pb.PriceHistoryServiceServer {
+	return &priceHistoryRPC{
+		logger,
+		priceHistoryUsecase,
+	}
+}
+
+func priceChangeToPb(change *entity.PriceChange) *pb.PriceChange {
+	return &pb.PriceChange{
+		Id:              change.Id,
+		DoctorServiceId: change.DoctorServiceId,
+		OnlinePrice:     change.OnlinePrice,
+		OfflinePrice:    change.OfflinePrice,
+		EffectiveFrom:   change.EffectiveFrom.Format("2006-01-02 15:04:05"),
+		CreatedAt:       change.CreatedAt.String(),
+	}
+}
+
+func (r priceHistoryRPC) SchedulePriceChange(ctx context.Context, req *pb.PriceChange) (*pb.PriceChange, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePriceHistoryDelivery, serviceNamePriceHistoryDeliveryRepoPrefix+"Schedule")
+	span.SetAttributes(attribute.Key(req.DoctorServiceId).String(req.EffectiveFrom))
+	defer span.End()
+
+	resp, err := r.priceHistory.SchedulePriceChange(ctx, &entity.SchedulePriceChangeReq{
+		Id:              req.Id,
+		DoctorServiceId: req.DoctorServiceId,
+		OnlinePrice:     req.OnlinePrice,
+		OfflinePrice:    req.OfflinePrice,
+		EffectiveFrom:   req.EffectiveFrom,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return priceChangeToPb(resp), nil
+}
+
+func (r priceHistoryRPC) ListPriceHistory(ctx context.Context, req *pb.PriceHistoryReq) (*pb.PriceHistory, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePriceHistoryDelivery, serviceNamePriceHistoryDeliveryRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListPriceHistory").String(req.DoctorServiceId))
+	defer span.End()
+
+	resp, err := r.priceHistory.ListPriceHistory(ctx, &entity.PriceHistoryReq{
+		DoctorServiceId: req.DoctorServiceId,
+		Page:            req.Page,
+		Limit:           req.Limit,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	var changes []*pb.PriceChange
+	for i := range resp.PriceChanges {
+		changes = append(changes, priceChangeToPb(&resp.PriceChanges[i]))
+	}
+
+	return &pb.PriceHistory{PriceChanges: changes, Count: resp.Count}, nil
+}
+
+func (r priceHistoryRPC) GetPriceAt(ctx context.Context, req *pb.PriceAtReq) (*pb.PriceChange, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePriceHistoryDelivery, serviceNamePriceHistoryDeliveryRepoPrefix+"Price at")
+	span.SetAttributes(attribute.Key(req.DoctorServiceId).String(req.At))
+	defer span.End()
+
+	resp, err := r.priceHistory.GetPriceAt(ctx, &entity.PriceAtReq{
+		DoctorServiceId: req.DoctorServiceId,
+		At:              req.At,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return priceChangeToPb(resp), nil
+}
+
+func (r priceHistoryRPC) CancelPriceChange(ctx context.Context, req *pb.PriceChangeId) (*pb.StatusPriceChange, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePriceHistoryDelivery, serviceNamePriceHistoryDeliveryRepoPrefix+"Cancel")
+	span.SetAttributes(attribute.Key("CancelPriceChange").String(req.Id))
+	defer span.End()
+
+	status, err := r.priceHistory.CancelPriceChange(ctx, req.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return &pb.StatusPriceChange{Status: status}, nil
+}
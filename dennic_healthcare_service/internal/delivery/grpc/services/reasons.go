@@ -2,6 +2,7 @@ package services
 
 import (
 	pb "Healthcare_Evrone/genproto/healthcare-service"
+	rpc "Healthcare_Evrone/internal/delivery/grpc"
 	"Healthcare_Evrone/internal/entity"
 	"Healthcare_Evrone/internal/pkg/minio"
 	"Healthcare_Evrone/internal/pkg/otlp"
@@ -164,3 +165,15 @@ func (r reasonsServiceRPC) DeleteReasons(ctx context.Context, reasons *pb.GetReq
 	}
 	return &pb.StatusReasons{Status: resp.Status}, nil
 }
+
+func (r reasonsServiceRPC) RestoreReasons(ctx context.Context, in *pb.RestoreReasonsReq) (*pb.StatusReasons, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReasonsDelivery, serviceNameReasonsDeliveryRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreReasons").String(in.Id))
+	defer span.End()
+
+	resp, err := r.reasons.RestoreReasons(ctx, in.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+	return &pb.StatusReasons{Status: resp.Status}, nil
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	pb "Healthcare_Evrone/genproto/healthcare-service"
+	rpc "Healthcare_Evrone/internal/delivery/grpc"
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/usecase"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type resourceRPC struct {
+	logger   *zap.Logger
+	resource usecase.ResourceUseCase
+}
+
+const (
+	serviceNameResourceDelivery           = "resourceDelivery"
+	serviceNameResourceDeliveryRepoPrefix = "resourceDelivery"
+)
+
+func ResourceRPC(logger *zap.Logger, resourceUsecase usecase.ResourceUseCase) pb.ResourceServiceServer {
+	return &resourceRPC{
+		logger,
+		resourceUsecase,
+	}
+}
+
+func resourceReq(req *pb.Resource) *entity.Resource {
+	return &entity.Resource{
+		Id:           req.Id,
+		Name:         req.Name,
+		Kind:         req.Kind,
+		ResourceType: req.ResourceType,
+		BranchId:     req.BranchId,
+	}
+}
+
+func resourceToPb(resource *entity.Resource) *pb.Resource {
+	return &pb.Resource{
+		Id:           resource.Id,
+		Name:         resource.Name,
+		Kind:         resource.Kind,
+		ResourceType: resource.ResourceType,
+		BranchId:     resource.BranchId,
+		CreatedAt:    resource.CreatedAt.String(),
+		UpdatedAt:    resource.UpdatedAt.String(),
+	}
+}
+
+func (r resourceRPC) CreateResource(ctx context.Context, req *pb.Resource) (*pb.Resource, error) {
+	ctx, span := otlp.Start(ctx, serviceNameResourceDelivery, serviceNameResourceDeliveryRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateResource").String(req.Id))
+	defer span.End()
+
+	resp, err := r.resource.CreateResource(ctx, resourceReq(req))
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return resourceToPb(resp), nil
+}
+
+func (r resourceRPC) GetResource(ctx context.Context, req *pb.ResourceId) (*pb.Resource, error) {
+	ctx, span := otlp.Start(ctx, serviceNameResourceDelivery, serviceNameResourceDeliveryRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetResource").String(req.Id))
+	defer span.End()
+
+	resp, err := r.resource.GetResource(ctx, req.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return resourceToPb(resp), nil
+}
+
+func (r resourceRPC) ListResources(ctx context.Context, req *pb.ListResourcesReq) (*pb.ListResourcesRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameResourceDelivery, serviceNameResourceDeliveryRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListResources").String(req.ResourceType))
+	defer span.End()
+
+	resp, err := r.resource.ListResources(ctx, &entity.ListResourcesReq{
+		Page:         req.Page,
+		Limit:        req.Limit,
+		Kind:         req.Kind,
+		ResourceType: req.ResourceType,
+		BranchId:     req.BranchId,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	response := pb.ListResourcesRes{
+		Count: resp.Count,
+	}
+	for i := range resp.Resources {
+		response.Resources = append(response.Resources, resourceToPb(&resp.Resources[i]))
+	}
+	return &response, nil
+}
+
+func (r resourceRPC) UpdateResource(ctx context.Context, req *pb.Resource) (*pb.Resource, error) {
+	ctx, span := otlp.Start(ctx, serviceNameResourceDelivery, serviceNameResourceDeliveryRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateResource").String(req.Id))
+	defer span.End()
+
+	resp, err := r.resource.UpdateResource(ctx, resourceReq(req))
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return resourceToPb(resp), nil
+}
+
+func (r resourceRPC) DeleteResource(ctx context.Context, req *pb.ResourceId) (*pb.StatusResource, error) {
+	ctx, span := otlp.Start(ctx, serviceNameResourceDelivery, serviceNameResourceDeliveryRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteResource").String(req.Id))
+	defer span.End()
+
+	status, err := r.resource.DeleteResource(ctx, req.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return &pb.StatusResource{Status: status}, nil
+}
+
+func (r resourceRPC) ListServiceResources(ctx context.Context, req *pb.ServiceResourcesReq) (*pb.ServiceResources, error) {
+	ctx, span := otlp.Start(ctx, serviceNameResourceDelivery, serviceNameResourceDeliveryRepoPrefix+"List service resources")
+	span.SetAttributes(attribute.Key("doctor_service_id").String(req.DoctorServiceId))
+	defer span.End()
+
+	resp, err := r.resource.ListServiceResources(ctx, req.DoctorServiceId)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return &pb.ServiceResources{
+		ResourceType: resp.ResourceType,
+		ResourceIds:  resp.ResourceIds,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	pb "Healthcare_Evrone/genproto/healthcare-service"
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/minio"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/usecase"
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type searchRPC struct {
+	logger *zap.Logger
+	search usecase.SearchUseCase
+}
+
+const (
+	serviceNameSearchDelivery           = "searchDelivery"
+	serviceNameSearchDeliveryRepoPrefix = "searchDelivery"
+
+	searchDefaultLimit = 10
+	searchMaxLimit     = 100
+)
+
+func SearchRPC(logger *zap.Logger, searchUsecase usecase.SearchUseCase) pb.SearchServiceServer {
+	return &searchRPC{
+		logger,
+		searchUsecase,
+	}
+}
+
+func (r searchRPC) Search(ctx context.Context, req *pb.SearchReq) (*pb.SearchRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameSearchDelivery, serviceNameSearchDeliveryRepoPrefix+"Search")
+	span.SetAttributes(attribute.Key("query").String(req.Query))
+	defer span.End()
+
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	for _, hitType := range req.Types {
+		switch hitType {
+		case entity.SearchTypeDoctor, entity.SearchTypeSpecialization, entity.SearchTypeReason, entity.SearchTypeDepartment:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown search type: %s", hitType)
+		}
+	}
+
+	page, limit := req.Page, req.Limit
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = searchDefaultLimit
+	}
+	if limit > searchMaxLimit {
+		limit = searchMaxLimit
+	}
+
+	res, err := r.search.Search(ctx, &entity.SearchReq{
+		Query: query,
+		Types: req.Types,
+		Page:  page,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response pb.SearchRes
+	for _, hit := range res.Hits {
+		response.Hits = append(response.Hits, &pb.SearchHit{
+			Type:        hit.Type,
+			Id:          hit.Id,
+			Title:       hit.Title,
+			Description: hit.Description,
+			ImageUrl:    minio.AddImageUrl(hit.ImageUrl, searchHitBucket(hit.Type)),
+			ParentId:    hit.ParentId,
+			Score:       hit.Score,
+		})
+	}
+	response.Count = res.Count
+
+	return &response, nil
+}
+
+func searchHitBucket(hitType string) string {
+	switch hitType {
+	case entity.SearchTypeDoctor:
+		return cfg.MinioService.Bucket.Doctor
+	case entity.SearchTypeSpecialization:
+		return cfg.MinioService.Bucket.Specialization
+	case entity.SearchTypeReason:
+		return cfg.MinioService.Bucket.Reasons
+	}
+	return cfg.MinioService.Bucket.Department
+}
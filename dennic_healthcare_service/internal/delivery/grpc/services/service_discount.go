@@ -0,0 +1,153 @@
+package services
+
+import (
+	pb "Healthcare_Evrone/genproto/healthcare-service"
+	rpc "Healthcare_Evrone/internal/delivery/grpc"
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/usecase"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type serviceDiscountRPC struct {
+	logger          *zap.Logger
+	serviceDiscount usecase.ServiceDiscountUseCase
+}
+
+const (
+	serviceNameServiceDiscountDelivery           = "serviceDiscountDelivery"
+	serviceNameServiceDiscountDeliveryRepoPrefix = "serviceDiscountDelivery"
+)
+
+func ServiceDiscountRPC(logger *zap.Logger, serviceDiscountUsecase usecase.ServiceDiscountUseCase) pb.ServiceDiscountServiceServer {
+	return &serviceDiscountRPC{
+		logger,
+		serviceDiscountUsecase,
+	}
+}
+
+func serviceDiscountReq(req *pb.ServiceDiscount) *entity.ServiceDiscount {
+	return &entity.ServiceDiscount{
+		Id:              req.Id,
+		DoctorServiceId: req.DoctorServiceId,
+		Kind:            req.Kind,
+		Value:           req.Value,
+		StartsOn:        req.StartsOn,
+		EndsOn:          req.EndsOn,
+	}
+}
+
+func serviceDiscountToPb(discount *entity.ServiceDiscount) *pb.ServiceDiscount {
+	return &pb.ServiceDiscount{
+		Id:              discount.Id,
+		DoctorServiceId: discount.DoctorServiceId,
+		Kind:            discount.Kind,
+		Value:           discount.Value,
+		StartsOn:        discount.StartsOn,
+		EndsOn:          discount.EndsOn,
+		CreatedAt:       discount.CreatedAt.String(),
+		UpdatedAt:       discount.UpdatedAt.String(),
+	}
+}
+
+func (r serviceDiscountRPC) CreateServiceDiscount(ctx context.Context, req *pb.ServiceDiscount) (*pb.ServiceDiscount, error) {
+	ctx, span := otlp.Start(ctx, serviceNameServiceDiscountDelivery, serviceNameServiceDiscountDeliveryRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateServiceDiscount").String(req.Id))
+	defer span.End()
+
+	resp, err := r.serviceDiscount.CreateServiceDiscount(ctx, serviceDiscountReq(req))
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return serviceDiscountToPb(resp), nil
+}
+
+func (r serviceDiscountRPC) GetServiceDiscount(ctx context.Context, req *pb.ServiceDiscountId) (*pb.ServiceDiscount, error) {
+	ctx, span := otlp.Start(ctx, serviceNameServiceDiscountDelivery, serviceNameServiceDiscountDeliveryRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetServiceDiscount").String(req.Id))
+	defer span.End()
+
+	resp, err := r.serviceDiscount.GetServiceDiscount(ctx, req.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return serviceDiscountToPb(resp), nil
+}
+
+func (r serviceDiscountRPC) ListServiceDiscounts(ctx context.Context, req *pb.ListServiceDiscountsReq) (*pb.ListServiceDiscountsRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameServiceDiscountDelivery, serviceNameServiceDiscountDeliveryRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListServiceDiscounts").String(req.DoctorServiceId))
+	defer span.End()
+
+	resp, err := r.serviceDiscount.ListServiceDiscounts(ctx, &entity.ListServiceDiscountsReq{
+		Page:            req.Page,
+		Limit:           req.Limit,
+		DoctorServiceId: req.DoctorServiceId,
+		ActiveOn:        req.ActiveOn,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	response := pb.ListServiceDiscountsRes{
+		Count: resp.Count,
+	}
+	for i := range resp.ServiceDiscounts {
+		response.ServiceDiscounts = append(response.ServiceDiscounts, serviceDiscountToPb(&resp.ServiceDiscounts[i]))
+	}
+	return &response, nil
+}
+
+func (r serviceDiscountRPC) UpdateServiceDiscount(ctx context.Context, req *pb.ServiceDiscount) (*pb.ServiceDiscount, error) {
+	ctx, span := otlp.Start(ctx, serviceNameServiceDiscountDelivery, serviceNameServiceDiscountDeliveryRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateServiceDiscount").String(req.Id))
+	defer span.End()
+
+	resp, err := r.serviceDiscount.UpdateServiceDiscount(ctx, serviceDiscountReq(req))
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return serviceDiscountToPb(resp), nil
+}
+
+func (r serviceDiscountRPC) DeleteServiceDiscount(ctx context.Context, req *pb.ServiceDiscountId) (*pb.StatusServiceDiscount, error) {
+	ctx, span := otlp.Start(ctx, serviceNameServiceDiscountDelivery, serviceNameServiceDiscountDeliveryRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteServiceDiscount").String(req.Id))
+	defer span.End()
+
+	status, err := r.serviceDiscount.DeleteServiceDiscount(ctx, req.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return &pb.StatusServiceDiscount{Status: status}, nil
+}
+
+func (r serviceDiscountRPC) GetServicePrice(ctx context.Context, req *pb.ServicePriceReq) (*pb.ServicePrice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameServiceDiscountDelivery, serviceNameServiceDiscountDeliveryRepoPrefix+"Get price")
+	span.SetAttributes(attribute.Key("doctor_service_id").String(req.DoctorServiceId))
+	defer span.End()
+
+	resp, err := r.serviceDiscount.GetServicePrice(ctx, &entity.ServicePriceReq{
+		DoctorServiceId: req.DoctorServiceId,
+		Online:          req.Online,
+		Date:            req.Date,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return &pb.ServicePrice{
+		DoctorServiceId: resp.DoctorServiceId,
+		BasePrice:       resp.BasePrice,
+		Discount:        resp.Discount,
+		Price:           resp.Price,
+		DiscountId:      resp.DiscountId,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	pb "Healthcare_Evrone/genproto/healthcare-service"
+	rpc "Healthcare_Evrone/internal/delivery/grpc"
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/usecase"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type servicePackageRPC struct {
+	logger         *zap.Logger
+	servicePackage usecase.ServicePackageUseCase
+}
+
+const (
+	serviceNameServicePackageDelivery           = "servicePackageDelivery"
+	serviceNameServicePackageDeliveryRepoPrefix = "servicePackageDelivery"
+)
+
+func ServicePackageRPC(logger *zap.Logger, servicePackageUsecase usecase.ServicePackageUseCase) pb.ServicePackageServiceServer {
+	return &servicePackageRPC{
+		logger,
+		servicePackageUsecase,
+	}
+}
+
+func servicePackageReq(req *pb.ServicePackage) *entity.ServicePackage {
+	return &entity.ServicePackage{
+		Id:               req.Id,
+		Name:             req.Name,
+		Description:      req.Description,
+		Price:            req.Price,
+		DoctorServiceIds: req.DoctorServiceIds,
+	}
+}
+
+func servicePackageToPb(servicePackage *entity.ServicePackage) *pb.ServicePackage {
+	return &pb.ServicePackage{
+		Id:               servicePackage.Id,
+		Name:             servicePackage.Name,
+		Description:      servicePackage.Description,
+		Price:            servicePackage.Price,
+		DoctorServiceIds: servicePackage.DoctorServiceIds,
+		ItemsPrice:       servicePackage.ItemsPrice,
+		CreatedAt:        servicePackage.CreatedAt.String(),
+		UpdatedAt:        servicePackage.UpdatedAt.String(),
+	}
+}
+
+func (r servicePackageRPC) CreateServicePackage(ctx context.Context, req *pb.ServicePackage) (*pb.ServicePackage, error) {
+	ctx, span := otlp.Start(ctx, serviceNameServicePackageDelivery, serviceNameServicePackageDeliveryRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateServicePackage").String(req.Id))
+	defer span.End()
+
+	resp, err := r.servicePackage.CreateServicePackage(ctx, servicePackageReq(req))
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return servicePackageToPb(resp), nil
+}
+
+func (r servicePackageRPC) GetServicePackage(ctx context.Context, req *pb.ServicePackageId) (*pb.ServicePackage, error) {
+	ctx, span := otlp.Start(ctx, serviceNameServicePackageDelivery, serviceNameServicePackageDeliveryRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetServicePackage").String(req.Id))
+	defer span.End()
+
+	resp, err := r.servicePackage.GetServicePackage(ctx, req.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return servicePackageToPb(resp), nil
+}
+
+func (r servicePackageRPC) ListServicePackages(ctx context.Context, req *pb.ListServicePackagesReq) (*pb.ListServicePackagesRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameServicePackageDelivery, serviceNameServicePackageDeliveryRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListServicePackages").String(req.DoctorServiceId))
+	defer span.End()
+
+	resp, err := r.servicePackage.ListServicePackages(ctx, &entity.ListServicePackagesReq{
+		Page:            req.Page,
+		Limit:           req.Limit,
+		DoctorServiceId: req.DoctorServiceId,
+	})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	response := pb.ListServicePackagesRes{
+		Count: resp.Count,
+	}
+	for i := range resp.ServicePackages {
+		response.ServicePackages = append(response.ServicePackages, servicePackageToPb(&resp.ServicePackages[i]))
+	}
+	return &response, nil
+}
+
+func (r servicePackageRPC) UpdateServicePackage(ctx context.Context, req *pb.ServicePackage) (*pb.ServicePackage, error) {
+	ctx, span := otlp.Start(ctx, serviceNameServicePackageDelivery, serviceNameServicePackageDeliveryRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateServicePackage").String(req.Id))
+	defer span.End()
+
+	resp, err := r.servicePackage.UpdateServicePackage(ctx, servicePackageReq(req))
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return servicePackageToPb(resp), nil
+}
+
+func (r servicePackageRPC) DeleteServicePackage(ctx context.Context, req *pb.ServicePackageId) (*pb.StatusServicePackage, error) {
+	ctx, span := otlp.Start(ctx, serviceNameServicePackageDelivery, serviceNameServicePackageDeliveryRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteServicePackage").String(req.Id))
+	defer span.End()
+
+	status, err := r.servicePackage.DeleteServicePackage(ctx, req.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+
+	return &pb.StatusServicePackage{Status: status}, nil
+}
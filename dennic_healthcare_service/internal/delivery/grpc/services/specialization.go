@@ -2,6 +2,7 @@ package services
 
 import (
 	pb "Healthcare_Evrone/genproto/healthcare-service"
+	rpc "Healthcare_Evrone/internal/delivery/grpc"
 	"Healthcare_Evrone/internal/entity"
 	"Healthcare_Evrone/internal/pkg/minio"
 	"Healthcare_Evrone/internal/pkg/otlp"
@@ -47,6 +48,7 @@ func (r specializationRPC) CreateSpecialization(ctx context.Context, specializat
 		Description:  specializations.Description,
 		DepartmentId: specializations.DepartmentId,
 		ImageUrl:     reqImageUrl,
+		ParentId:     specializations.ParentId,
 	}
 	resp, err := r.specialization.CreateSpecialization(ctx, &req)
 	if err != nil {
@@ -63,6 +65,7 @@ func (r specializationRPC) CreateSpecialization(ctx context.Context, specializat
 		CreatedAt:    resp.CreatedAt.String(),
 		UpdatedAt:    resp.UpdatedAt.String(),
 		DeletedAt:    resp.DeletedAt.String(),
+		ParentId:     resp.ParentId,
 	}, nil
 }
 
@@ -90,6 +93,7 @@ func (r specializationRPC) GetSpecializationById(ctx context.Context, str *pb.Ge
 		CreatedAt:    spec.CreatedAt.String(),
 		UpdatedAt:    spec.UpdatedAt.String(),
 		DeletedAt:    spec.DeletedAt.String(),
+		ParentId:     spec.ParentId,
 	}, nil
 }
 
@@ -105,6 +109,7 @@ func (r specializationRPC) GetAllSpecializations(ctx context.Context, all *pb.Ge
 		OrderBy:      all.OrderBy,
 		IsActive:     all.IsActive,
 		DepartmentId: all.DepartmentId,
+		ParentId:     all.ParentId,
 	})
 	if err != nil {
 		return nil, err
@@ -122,6 +127,7 @@ func (r specializationRPC) GetAllSpecializations(ctx context.Context, all *pb.Ge
 			CreatedAt:    s.CreatedAt.String(),
 			UpdatedAt:    s.UpdatedAt.String(),
 			DeletedAt:    s.DeletedAt.String(),
+			ParentId:     s.ParentId,
 		})
 	}
 	listSpec.Count = specializations.Count
@@ -140,9 +146,10 @@ func (r specializationRPC) UpdateSpecialization(ctx context.Context, in *pb.Spec
 		Description:  in.Description,
 		DepartmentId: in.DepartmentId,
 		ImageUrl:     reqImageUrl,
+		ParentId:     in.ParentId,
 	})
 	if err != nil {
-		return nil, err
+		return nil, rpc.Error(ctx, err)
 	}
 	respImageUrl := minio.AddImageUrl(resp.ImageUrl, cfg.MinioService.Bucket.Specialization)
 	return &pb.Specializations{
@@ -155,6 +162,7 @@ func (r specializationRPC) UpdateSpecialization(ctx context.Context, in *pb.Spec
 		CreatedAt:    resp.CreatedAt.String(),
 		UpdatedAt:    resp.UpdatedAt.String(),
 		DeletedAt:    resp.DeletedAt.String(),
+		ParentId:     resp.ParentId,
 	}, nil
 }
 
@@ -168,3 +176,42 @@ func (r specializationRPC) DeleteSpecialization(ctx context.Context, in *pb.GetR
 	}
 	return &pb.StatusSpecialization{Status: status}, nil
 }
+
+func (r specializationRPC) RestoreSpecialization(ctx context.Context, in *pb.RestoreSpecializationReq) (*pb.StatusSpecialization, error) {
+	ctx, span := otlp.Start(ctx, serviceNameSpecializationDelivery, serviceNameSpecializationDeliveryRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreSpecialization").String(in.Id))
+	defer span.End()
+
+	status, err := r.specialization.RestoreSpecialization(ctx, in.Id)
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+	return &pb.StatusSpecialization{Status: status}, nil
+}
+
+func (r specializationRPC) GetSpecializationTree(ctx context.Context, in *pb.SpecializationTreeReq) (*pb.ListSpecializations, error) {
+	ctx, span := otlp.Start(ctx, serviceNameSpecializationDelivery, serviceNameSpecializationDeliveryRepoPrefix+"Tree")
+	span.SetAttributes(attribute.Key("GetSpecializationTree").String(in.RootId))
+	defer span.End()
+	specializations, err := r.specialization.GetSpecializationTree(ctx, &entity.SpecializationTreeReq{RootId: in.RootId})
+	if err != nil {
+		return nil, rpc.Error(ctx, err)
+	}
+	var listSpec pb.ListSpecializations
+	for _, s := range specializations.Specializations {
+		listSpec.Specializations = append(listSpec.Specializations, &pb.Specializations{
+			Id:           s.ID,
+			Order:        s.Order,
+			Name:         s.Name,
+			Description:  s.Description,
+			DepartmentId: s.DepartmentId,
+			ImageUrl:     minio.AddImageUrl(s.ImageUrl, cfg.MinioService.Bucket.Specialization),
+			CreatedAt:    s.CreatedAt.String(),
+			UpdatedAt:    s.UpdatedAt.String(),
+			DeletedAt:    s.DeletedAt.String(),
+			ParentId:     s.ParentId,
+		})
+	}
+	listSpec.Count = specializations.Count
+	return &listSpec, nil
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	pb "Healthcare_Evrone/genproto/healthcare-service"
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/language"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/usecase"
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type translationRPC struct {
+	logger      *zap.Logger
+	translation usecase.TranslationUseCase
+}
+
+const (
+	serviceNameTranslationDelivery           = "translationDelivery"
+	serviceNameTranslationDeliveryRepoPrefix = "translationDelivery"
+)
+
+func TranslationRPC(logger *zap.Logger, translationUsecase usecase.TranslationUseCase) pb.TranslationServiceServer {
+	return &translationRPC{
+		logger,
+		translationUsecase,
+	}
+}
+
+// validateTranslation checks entity type, language and, when given, field of a translation request
+func validateTranslation(entityType, entityId, lang, field string) error {
+	fields, ok := entity.TranslatableFields[entityType]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown entity type: %s", entityType)
+	}
+	if strings.TrimSpace(entityId) == "" {
+		return status.Error(codes.InvalidArgument, "entity_id is required")
+	}
+	if lang != "" && !language.IsSupported(lang) {
+		return status.Errorf(codes.InvalidArgument, "unsupported language: %s", lang)
+	}
+	if field == "" {
+		return nil
+	}
+	for _, f := range fields {
+		if f == field {
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "%s has no translatable field %s", entityType, field)
+}
+
+func (r translationRPC) SetTranslation(ctx context.Context, req *pb.Translation) (*pb.Translation, error) {
+	ctx, span := otlp.Start(ctx, serviceNameTranslationDelivery, serviceNameTranslationDeliveryRepoPrefix+"Set")
+	span.SetAttributes(attribute.Key(req.EntityType).String(req.EntityId))
+	defer span.End()
+
+	if err := validateTranslation(req.EntityType, req.EntityId, req.Lang, req.Field); err != nil {
+		return nil, err
+	}
+	if req.Lang == "" || req.Field == "" {
+		return nil, status.Error(codes.InvalidArgument, "lang and field are required")
+	}
+	if strings.TrimSpace(req.Value) == "" {
+		return nil, status.Error(codes.InvalidArgument, "value is required")
+	}
+
+	resp, err := r.translation.SetTranslation(ctx, &entity.Translation{
+		EntityType: req.EntityType,
+		EntityId:   req.EntityId,
+		Lang:       req.Lang,
+		Field:      req.Field,
+		Value:      req.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Translation{
+		EntityType: resp.EntityType,
+		EntityId:   resp.EntityId,
+		Lang:       resp.Lang,
+		Field:      resp.Field,
+		Value:      resp.Value,
+		CreatedAt:  resp.CreatedAt.String(),
+		UpdatedAt:  resp.UpdatedAt.String(),
+	}, nil
+}
+
+func (r translationRPC) GetTranslations(ctx context.Context, req *pb.GetTranslationsReq) (*pb.ListTranslations, error) {
+	ctx, span := otlp.Start(ctx, serviceNameTranslationDelivery, serviceNameTranslationDeliveryRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key(req.EntityType).String(req.EntityId))
+	defer span.End()
+
+	if err := validateTranslation(req.EntityType, req.EntityId, req.Lang, ""); err != nil {
+		return nil, err
+	}
+
+	var langs []string
+	if req.Lang != "" {
+		langs = append(langs, req.Lang)
+	}
+	translations, err := r.translation.GetTranslations(ctx, &entity.GetTranslationsReq{
+		EntityType: req.EntityType,
+		EntityIds:  []string{req.EntityId},
+		Langs:      langs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response pb.ListTranslations
+	for _, translation := range translations.Translations {
+		response.Translations = append(response.Translations, &pb.Translation{
+			EntityType: translation.EntityType,
+			EntityId:   translation.EntityId,
+			Lang:       translation.Lang,
+			Field:      translation.Field,
+			Value:      translation.Value,
+			CreatedAt:  translation.CreatedAt.String(),
+			UpdatedAt:  translation.UpdatedAt.String(),
+		})
+	}
+	return &response, nil
+}
+
+func (r translationRPC) DeleteTranslation(ctx context.Context, req *pb.DeleteTranslationReq) (*pb.StatusTranslation, error) {
+	ctx, span := otlp.Start(ctx, serviceNameTranslationDelivery, serviceNameTranslationDeliveryRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key(req.EntityType).String(req.EntityId))
+	defer span.End()
+
+	if err := validateTranslation(req.EntityType, req.EntityId, req.Lang, req.Field); err != nil {
+		return nil, err
+	}
+	if req.Lang == "" {
+		return nil, status.Error(codes.InvalidArgument, "lang is required")
+	}
+
+	resp, err := r.translation.DeleteTranslation(ctx, &entity.DeleteTranslationReq{
+		EntityType: req.EntityType,
+		EntityId:   req.EntityId,
+		Lang:       req.Lang,
+		Field:      req.Field,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StatusTranslation{Status: resp.Status}, nil
+}
@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+// Branch is an address of the clinic, OpensAt and ClosesAt are times of day "15:04"
+type Branch struct {
+	Id           string
+	Name         string
+	Address      string
+	City         string
+	Latitude     float64
+	Longitude    float64
+	OpensAt      string
+	ClosesAt     string
+	PhoneNumbers []string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    time.Time
+}
+
+type ListBranchesReq struct {
+	Page  int64
+	Limit int64
+	City  string
+}
+
+type ListBranches struct {
+	Branches []Branch
+	Count    int64
+}
+
+// BranchesNearbyReq selects the branches within RadiusKm of the coordinates,
+// a zero RadiusKm does not limit the distance and a zero Limit lists every branch
+type BranchesNearbyReq struct {
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64
+	Limit     int64
+}
+
+type NearbyBranch struct {
+	Branch     Branch
+	DistanceKm float64
+}
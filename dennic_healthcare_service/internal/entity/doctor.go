@@ -37,7 +37,9 @@ type Doctor struct {
 	EndWorkDate     string
 	WorkYears       int32
 	DepartmentId    string
+	DepartmentIds   []string
 	RoomNumber      int32
+	BranchId        string
 	Password        string
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
@@ -72,11 +74,15 @@ type DoctorAndDoctorHours struct {
 	EndWorkDate     string
 	WorkYears       int32
 	DepartmentId    string
+	DepartmentIds   []string
 	RoomNumber      int32
 	Password        string
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	DeletedAt       time.Time
+	Rating          float32
+	ReviewCount     int64
+	BranchId        string
 	Specializations []DoctorSpec
 }
 
@@ -104,3 +110,76 @@ type GetReqStrSpec struct {
 	Value            string
 	OrderBy          string
 }
+
+// GetReqServiceDoctors lists the hours in effect on Date "2006-01-02", today when empty,
+// SpecializationId when set lists its doctors in place of the specialization of DoctorServiceId
+type GetReqServiceDoctors struct {
+	DepartmentId     string
+	DoctorServiceId  string
+	SpecializationId string
+	DayOfWeek        string
+	Date             string
+}
+
+// ServiceDoctor is a shift or a break of a doctor offering the service
+type ServiceDoctor struct {
+	DoctorId        string
+	DoctorServiceId string
+	Kind            string
+	StartTime       time.Time
+	FinishTime      time.Time
+}
+
+// DoctorRating is the average of the approved reviews of a doctor kept by the booking service
+type DoctorRating struct {
+	DoctorId    string
+	Rating      float32
+	ReviewCount int64
+}
+
+// GetReqReasonDoctors selects a reason by id or, when the id is empty, the reason best matching the query
+type GetReqReasonDoctors struct {
+	ReasonId string
+	Query    string
+}
+
+// ReasonDoctorHours is a shift or a break of the doctor, the empty effective dates leave the range open
+type ReasonDoctorHours struct {
+	DayOfWeek     string
+	Kind          string
+	StartTime     time.Time
+	FinishTime    time.Time
+	EffectiveFrom string
+	EffectiveTo   string
+}
+
+// ReasonDoctorLeave is an approved leave of the doctor, the dates are inclusive
+type ReasonDoctorLeave struct {
+	StartDate string
+	EndDate   string
+}
+
+// ReasonDoctor is a doctor offering a service of the specialization of a reason, with the service cheapest
+// offline when the doctor has several of them, each price is the lowest one of those services
+type ReasonDoctor struct {
+	DoctorId        string
+	FirstName       string
+	LastName        string
+	ImageUrl        string
+	DepartmentId    string
+	DoctorServiceId string
+	OnlinePrice     float32
+	OfflinePrice    float32
+	Duration        int64
+	Rating          float32
+	ReviewCount     int64
+	WorkingHours    []*ReasonDoctorHours
+	Leaves          []*ReasonDoctorLeave
+}
+
+type ReasonDoctors struct {
+	ReasonId         string
+	ReasonName       string
+	SpecializationId string
+	Doctors          []*ReasonDoctor
+}
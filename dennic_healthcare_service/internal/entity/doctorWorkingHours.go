@@ -2,15 +2,26 @@ package entity
 
 import "time"
 
+// kinds of the working hours, breaks are taken out of the shifts of the same day
+const (
+	WorkingHoursShift = "shift"
+	WorkingHoursBreak = "break"
+)
+
+// DoctorWorkingHours is a shift or a break of a doctor on a weekday, start and finish are times of day "15:04:05",
+// the empty EffectiveFrom/EffectiveTo "2006-01-02" leave the range open on that side
 type DoctorWorkingHours struct {
-	Id         int32
-	DoctorId   string
-	DayOfWeek  string
-	StartTime  string
-	FinishTime string
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	DeletedAt  time.Time
+	Id            int32
+	DoctorId      string
+	DayOfWeek     string
+	Kind          string
+	StartTime     string
+	FinishTime    string
+	EffectiveFrom string
+	EffectiveTo   string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     time.Time
 }
 
 type GetRequest struct {
@@ -24,3 +35,9 @@ type ListDoctorWorkingHours struct {
 	DoctorWhs []DoctorWorkingHours
 	Count     int32
 }
+
+// GetReqDayWorkingHours selects the not deleted shifts and breaks of a doctor on a weekday
+type GetReqDayWorkingHours struct {
+	DoctorId  string
+	DayOfWeek string
+}
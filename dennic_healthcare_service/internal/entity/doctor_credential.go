@@ -0,0 +1,59 @@
+package entity
+
+import "time"
+
+// types of the credentials of a doctor, only licenses expire for the booking
+const (
+	CredentialDiploma     = "diploma"
+	CredentialLicense     = "license"
+	CredentialCertificate = "certificate"
+	CredentialOther       = "other"
+)
+
+// DoctorCredential is a diploma, license or certificate of a doctor, IssueDate and ExpiryDate are "2006-01-02",
+// the empty ExpiryDate never expires and Document is the object name of the uploaded copy in the private document bucket
+type DoctorCredential struct {
+	Id              int64
+	DoctorId        string
+	Type            string
+	IssuingBody     string
+	Number          string
+	IssueDate       string
+	ExpiryDate      string
+	Document        string
+	ExpiryFlaggedAt time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       time.Time
+}
+
+// ListDoctorCredentialsReq filters the credentials by doctor and type, Expiring selects the flagged
+// licenses which have not expired yet and Expired the licenses expired before today
+type ListDoctorCredentialsReq struct {
+	Page     int64
+	Limit    int64
+	DoctorId string
+	Type     string
+	Expiring bool
+	Expired  bool
+}
+
+type ListDoctorCredentials struct {
+	Credentials []DoctorCredential
+	Count       int64
+}
+
+// DoctorLicenseStatusReq checks the licenses of a doctor on Date "2006-01-02"
+type DoctorLicenseStatusReq struct {
+	DoctorId string
+	Date     string
+}
+
+// DoctorLicenseStatus is Expired when the doctor has licenses and none of them is valid on the date,
+// ExpiryDate is the latest expiry of the licenses, empty when one of them never expires
+type DoctorLicenseStatus struct {
+	DoctorId   string
+	HasLicense bool
+	Expired    bool
+	ExpiryDate string
+}
@@ -0,0 +1,81 @@
+package entity
+
+import "time"
+
+// kinds of the leaves of a doctor
+const (
+	LeaveVacation   = "vacation"
+	LeaveSickLeave  = "sick_leave"
+	LeaveConference = "conference"
+	LeaveOther      = "other"
+)
+
+// statuses of a leave, only pending leaves are reviewed and only approved leaves block the booking
+const (
+	LeavePending   = "pending"
+	LeaveApproved  = "approved"
+	LeaveRejected  = "rejected"
+	LeaveCancelled = "cancelled"
+)
+
+// DoctorLeave is a period a doctor is away, StartDate and EndDate "2006-01-02" are both included
+type DoctorLeave struct {
+	Id           int64
+	DoctorId     string
+	DepartmentId string
+	Kind         string
+	StartDate    string
+	EndDate      string
+	Reason       string
+	Status       string
+	ReviewedBy   string
+	ReviewNote   string
+	ReviewedAt   time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    time.Time
+}
+
+// ListDoctorLeavesReq filters the leaves by doctor and status, From and To select the leaves touching the range
+type ListDoctorLeavesReq struct {
+	Page     int64
+	Limit    int64
+	DoctorId string
+	Status   string
+	From     string
+	To       string
+}
+
+type ListDoctorLeaves struct {
+	Leaves []DoctorLeave
+	Count  int64
+}
+
+// ReviewDoctorLeaveReq moves a leave to Status, ReviewedBy is the admin who made the decision
+type ReviewDoctorLeaveReq struct {
+	Id         int64
+	Status     string
+	ReviewedBy string
+	ReviewNote string
+}
+
+// AwayDoctorsReq selects the doctors on an approved leave on Date, of every department when DepartmentId is empty
+type AwayDoctorsReq struct {
+	Date         string
+	DepartmentId string
+}
+
+type AwayDoctor struct {
+	DoctorId     string
+	FirstName    string
+	LastName     string
+	DepartmentId string
+	LeaveId      int64
+	Kind         string
+	StartDate    string
+	EndDate      string
+}
+
+type ListAwayDoctors struct {
+	Doctors []AwayDoctor
+}
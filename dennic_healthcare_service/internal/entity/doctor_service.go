@@ -11,9 +11,11 @@ type DoctorServices struct {
 	OfflinePrice     float32
 	Name             string
 	Duration         time.Time
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
-	DeletedAt        time.Time
+	// RequiredResourceType is reserved for every appointment of the service, empty when none is needed
+	RequiredResourceType string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	DeletedAt            time.Time
 }
 
 type ListDoctorServices struct {
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// OutboxEvent is a catalogue change written in the transaction of the change and published afterwards,
+// Type is healthcare.<entity>.<created|updated|deleted|restored> and Payload the JSON of the changed record
+type OutboxEvent struct {
+	Id          int64
+	Type        string
+	AggregateId string
+	Payload     []byte
+	CreatedAt   time.Time
+}
@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+// PriceChange is a price of a doctor service taking effect at EffectiveFrom, a scheduled change takes effect in the future
+type PriceChange struct {
+	Id              string
+	DoctorServiceId string
+	OnlinePrice     float32
+	OfflinePrice    float32
+	EffectiveFrom   time.Time
+	CreatedAt       time.Time
+}
+
+type PriceHistoryReq struct {
+	DoctorServiceId string
+	Page            int64
+	Limit           int64
+}
+
+type PriceHistory struct {
+	PriceChanges []PriceChange
+	Count        int64
+}
+
+// SchedulePriceChangeReq schedules a price of a doctor service from EffectiveFrom "2006-01-02 15:04:05"
+type SchedulePriceChangeReq struct {
+	Id              string
+	DoctorServiceId string
+	OnlinePrice     float32
+	OfflinePrice    float32
+	EffectiveFrom   string
+}
+
+// PriceAtReq asks the price of a doctor service valid at At "2006-01-02 15:04:05", now when At is empty
+type PriceAtReq struct {
+	DoctorServiceId string
+	At              string
+}
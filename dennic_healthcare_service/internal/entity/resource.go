@@ -0,0 +1,41 @@
+package entity
+
+import "time"
+
+const (
+	ResourceKindRoom      = "room"
+	ResourceKindEquipment = "equipment"
+)
+
+// Resource is a shared room or piece of equipment, ResourceType groups interchangeable resources, e.g. ultrasound.
+// A resource without a branch may be used by the doctors of every branch
+type Resource struct {
+	Id           string
+	Name         string
+	Kind         string
+	ResourceType string
+	BranchId     string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    time.Time
+}
+
+type ListResourcesReq struct {
+	Page         int64
+	Limit        int64
+	Kind         string
+	ResourceType string
+	BranchId     string
+}
+
+type ListResources struct {
+	Resources []Resource
+	Count     int64
+}
+
+// ServiceResources are the resources of the type required by a doctor service usable by its doctor,
+// ResourceType is empty when the service needs no resource
+type ServiceResources struct {
+	ResourceType string
+	ResourceIds  []string
+}
@@ -0,0 +1,30 @@
+package entity
+
+const (
+	SearchTypeDoctor         = "doctor"
+	SearchTypeSpecialization = "specialization"
+	SearchTypeReason         = "reason"
+	SearchTypeDepartment     = "department"
+)
+
+type SearchReq struct {
+	Query string
+	Types []string
+	Page  int32
+	Limit int32
+}
+
+type SearchHit struct {
+	Type        string
+	Id          string
+	Title       string
+	Description string
+	ImageUrl    string
+	ParentId    string
+	Score       float32
+}
+
+type SearchRes struct {
+	Count int32
+	Hits  []SearchHit
+}
@@ -0,0 +1,65 @@
+package entity
+
+import "time"
+
+// kinds of the discounts, percent takes Value percents off the price and fixed takes Value off the price
+const (
+	DiscountPercent = "percent"
+	DiscountFixed   = "fixed"
+)
+
+// ServiceDiscount lowers the price of a doctor service from StartsOn through EndsOn, both "2006-01-02",
+// the empty EndsOn lasts until the discount is deleted
+type ServiceDiscount struct {
+	Id              string
+	DoctorServiceId string
+	Kind            string
+	Value           float64
+	StartsOn        string
+	EndsOn          string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       time.Time
+}
+
+// ListServiceDiscountsReq filters the discounts by doctor service, ActiveOn selects the discounts active on the day
+type ListServiceDiscountsReq struct {
+	Page            int64
+	Limit           int64
+	DoctorServiceId string
+	ActiveOn        string
+}
+
+type ListServiceDiscounts struct {
+	ServiceDiscounts []ServiceDiscount
+	Count            int64
+}
+
+// ServicePriceReq asks the price of a doctor service on the day, Online selects the online price
+type ServicePriceReq struct {
+	DoctorServiceId string
+	Online          bool
+	Date            string
+}
+
+// ServicePrice is the price of a doctor service after the largest discount active on the day,
+// DiscountId is empty when no discount applies
+type ServicePrice struct {
+	DoctorServiceId string
+	BasePrice       float64
+	Discount        float64
+	Price           float64
+	DiscountId      string
+}
+
+// DiscountAmount is the amount the discount takes off the price, never more than the price
+func (d *ServiceDiscount) DiscountAmount(price float64) float64 {
+	amount := d.Value
+	if d.Kind == DiscountPercent {
+		amount = price * d.Value / 100
+	}
+	if amount > price {
+		return price
+	}
+	return amount
+}
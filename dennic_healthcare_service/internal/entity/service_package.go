@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// ServicePackage bundles several doctor services at the package Price,
+// ItemsPrice is the sum of the offline prices of the services bought one by one
+type ServicePackage struct {
+	Id               string
+	Name             string
+	Description      string
+	Price            float64
+	DoctorServiceIds []string
+	ItemsPrice       float64
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        time.Time
+}
+
+// ListServicePackagesReq selects the packages including DoctorServiceId when it is set
+type ListServicePackagesReq struct {
+	Page            int64
+	Limit           int64
+	DoctorServiceId string
+}
+
+type ListServicePackages struct {
+	ServicePackages []ServicePackage
+	Count           int64
+}
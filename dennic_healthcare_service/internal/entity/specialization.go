@@ -9,6 +9,7 @@ type Specialization struct {
 	Description  string
 	DepartmentId string
 	ImageUrl     string
+	ParentId     string
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	DeletedAt    time.Time
@@ -26,5 +27,11 @@ type GetAllSpecializations struct {
 	Value        string
 	OrderBy      string
 	DepartmentId string
+	ParentId     string
 	IsActive     bool
 }
+
+// SpecializationTreeReq selects the specialization RootId with all its descendants, the whole tree when RootId is empty
+type SpecializationTreeReq struct {
+	RootId string
+}
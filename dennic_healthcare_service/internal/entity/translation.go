@@ -0,0 +1,59 @@
+package entity
+
+import "time"
+
+const (
+	TranslationDepartment     = "department"
+	TranslationSpecialization = "specialization"
+	TranslationReason         = "reason"
+	TranslationDoctorService  = "doctor_service"
+)
+
+// TranslatableFields lists the fields of every entity type which can be translated
+var TranslatableFields = map[string][]string{
+	TranslationDepartment:     {"name", "description", "short_description"},
+	TranslationSpecialization: {"name"},
+	TranslationReason:         {"name"},
+	TranslationDoctorService:  {"name"},
+}
+
+type Translation struct {
+	EntityType string
+	EntityId   string
+	Lang       string
+	Field      string
+	Value      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type ListTranslations struct {
+	Translations []Translation
+}
+
+type GetTranslationsReq struct {
+	EntityType string
+	EntityIds  []string
+	Langs      []string
+}
+
+type DeleteTranslationReq struct {
+	EntityType string
+	EntityId   string
+	Lang       string
+	Field      string
+}
+
+type StatusTranslation struct {
+	Status bool
+}
+
+// Localized holds the translated fields of entities by entity id and field name
+type Localized map[string]map[string]string
+
+// Apply overwrites value with the translation of the field when there is one
+func (l Localized) Apply(entityId, field string, value *string) {
+	if translated, ok := l[entityId][field]; ok {
+		*value = translated
+	}
+}
@@ -0,0 +1,36 @@
+package grpc_service_clients
+
+import (
+	"Healthcare_Evrone/genproto/booking_service"
+	"Healthcare_Evrone/internal/entity"
+	"context"
+)
+
+// LeaveBlocks marks the days of the approved leaves unavailable in the booking service
+type LeaveBlocks struct {
+	client booking_service.DoctorTimeServiceClient
+}
+
+func NewLeaveBlocks(client booking_service.DoctorTimeServiceClient) *LeaveBlocks {
+	return &LeaveBlocks{
+		client: client,
+	}
+}
+
+func (l *LeaveBlocks) BlockLeave(ctx context.Context, leave *entity.DoctorLeave) error {
+	_, err := l.client.BlockLeave(ctx, &booking_service.LeaveBlocksReq{
+		LeaveId:      leave.Id,
+		DepartmentId: leave.DepartmentId,
+		DoctorId:     leave.DoctorId,
+		StartDate:    leave.StartDate,
+		EndDate:      leave.EndDate,
+	})
+	return err
+}
+
+func (l *LeaveBlocks) ReleaseLeave(ctx context.Context, leaveId int64) error {
+	_, err := l.client.ReleaseLeave(ctx, &booking_service.ReleaseLeaveReq{
+		LeaveId: leaveId,
+	})
+	return err
+}
@@ -1,26 +1,45 @@
 package grpc_service_clients
 
 import (
+	"Healthcare_Evrone/genproto/booking_service"
 	"Healthcare_Evrone/internal/pkg/config"
+	"fmt"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type ServiceClients interface {
 	// SmsService()
+	DoctorTimeService() booking_service.DoctorTimeServiceClient
 	Close()
 }
 
 type serviceClients struct {
-	services []*grpc.ClientConn
+	doctorTimeService booking_service.DoctorTimeServiceClient
+	services          []*grpc.ClientConn
 }
 
 func New(config *config.Config) (ServiceClients, error) {
+	// dial to booking service
+	connBookingService, err := grpc.Dial(
+		fmt.Sprintf("%s%s", config.BookingService.Host, config.BookingService.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &serviceClients{
-		services: []*grpc.ClientConn{},
+		doctorTimeService: booking_service.NewDoctorTimeServiceClient(connBookingService),
+		services:          []*grpc.ClientConn{connBookingService},
 	}, nil
 }
 
+func (s *serviceClients) DoctorTimeService() booking_service.DoctorTimeServiceClient {
+	return s.doctorTimeService
+}
+
 func (s *serviceClients) Close() {
 	// closing investment service
 	for _, conn := range s.services {
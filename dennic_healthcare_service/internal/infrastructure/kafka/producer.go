@@ -6,63 +6,70 @@ import (
 	"encoding/json"
 	// "Healthcare_Evrone/internal/pkg/otlp"
 	"context"
+	"time"
 
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
 )
 
 type producer struct {
-	logger     *zap.Logger
-	userCreate *kafka.Writer
+	logger *zap.Logger
+	events *kafka.Writer
 }
 
 func NewProducer(config *config.Config, logger *zap.Logger) *producer {
 	return &producer{
 		logger: logger,
-		userCreate: &kafka.Writer{
+		// the writer is synchronous, the outbox marks the events published only once kafka acknowledged them
+		events: &kafka.Writer{
 			Addr:                   kafka.TCP(config.Kafka.Address...),
-			Topic:                  config.Kafka.Topic.Healthcare,
+			Topic:                  config.Kafka.Topic.HealthcareEvents,
 			Balancer:               &kafka.Hash{},
 			RequiredAcks:           kafka.RequireAll,
 			AllowAutoTopicCreation: true,
-			Async:                  true, // make the writer asynchronous
-			Completion: func(messages []kafka.Message, err error) {
-				if err != nil {
-					logger.Error("kafka userCreate", zap.Error(err))
-				}
-				for _, message := range messages {
-					logger.Sugar().Info(
-						"kafka userCreate message",
-						zap.Int("partition", message.Partition),
-						zap.Int64("offset", message.Offset),
-						zap.String("key", string(message.Key)),
-						zap.String("value", string(message.Value)),
-					)
-				}
-			},
 		},
 	}
 }
 
-func (p *producer) buildMessage(key string, value []byte) kafka.Message {
+// eventMessage is the value of a healthcare event message
+type eventMessage struct {
+	Id          int64           `json:"id"`
+	Type        string          `json:"type"`
+	AggregateId string          `json:"aggregate_id"`
+	Payload     json.RawMessage `json:"payload"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+}
+
+func (p *producer) buildMessage(key string, value []byte, headers ...kafka.Header) kafka.Message {
 	return kafka.Message{
-		Key:   []byte(key),
-		Value: value,
+		Key:     []byte(key),
+		Value:   value,
+		Headers: headers,
 	}
 }
 
-func (p *producer) ProduceUserToCreate(ctx context.Context, key string, value *entity.Doctor) error {
-
-	byteValue, err := json.Marshal(&value)
-	if err != nil {
-		return err
+// ProduceEvents writes the events keyed by the changed record, so the events of a record keep their order
+func (p *producer) ProduceEvents(ctx context.Context, events []entity.OutboxEvent) error {
+	messages := make([]kafka.Message, 0, len(events))
+	for _, event := range events {
+		byteValue, err := json.Marshal(&eventMessage{
+			Id:          event.Id,
+			Type:        event.Type,
+			AggregateId: event.AggregateId,
+			Payload:     event.Payload,
+			OccurredAt:  event.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+		messages = append(messages, p.buildMessage(event.AggregateId, byteValue,
+			kafka.Header{Key: "event_type", Value: []byte(event.Type)}))
 	}
-	message := p.buildMessage(key, byteValue)
-	return p.userCreate.WriteMessages(ctx, message)
+	return p.events.WriteMessages(ctx, messages...)
 }
 
 func (p *producer) Close() {
-	if err := p.userCreate.Close(); err != nil {
-		p.logger.Error("error during close writer articleCategoryCreated", zap.Error(err))
+	if err := p.events.Close(); err != nil {
+		p.logger.Error("error during close writer healthcare events", zap.Error(err))
 	}
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+)
+
+type BranchRepository interface {
+	CreateBranch(ctx context.Context, in *entity.Branch) (*entity.Branch, error)
+	GetBranch(ctx context.Context, id string) (*entity.Branch, error)
+	ListBranches(ctx context.Context, in *entity.ListBranchesReq) (*entity.ListBranches, error)
+	UpdateBranch(ctx context.Context, in *entity.Branch) (*entity.Branch, error)
+	DeleteBranch(ctx context.Context, id string) (bool, error)
+	ListBranchesNearby(ctx context.Context, in *entity.BranchesNearbyReq) ([]entity.NearbyBranch, error)
+}
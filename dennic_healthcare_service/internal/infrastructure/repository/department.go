@@ -11,4 +11,5 @@ type DepartmentRepository interface {
 	GetAllDepartments(ctx context.Context, all *entity.GetAll) (*entity.ListDepartments, error)
 	UpdateDepartment(ctx context.Context, up *entity.Department) (*entity.Department, error)
 	DeleteDepartment(ctx context.Context, get *entity.GetReqStr) (bool, error)
+	RestoreDepartment(ctx context.Context, id string) (bool, error)
 }
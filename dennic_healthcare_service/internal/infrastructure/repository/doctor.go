@@ -11,6 +11,10 @@ type Doctor interface {
 	GetAllDoctors(ctx context.Context, all *entity.GetAll) (*entity.ListDoctorsAndHours, error)
 	UpdateDoctor(ctx context.Context, update *entity.Doctor) (*entity.Doctor, error)
 	DeleteDoctor(ctx context.Context, del *entity.GetReqStr) (bool, error)
+	RestoreDoctor(ctx context.Context, id string) (bool, error)
 	ListDoctorsByDepartmentId(ctx context.Context, in *entity.GetReqStrDep) (*entity.ListDoctors, error)
 	ListDoctorBySpecializationId(ctx context.Context, in *entity.GetReqStrSpec) (*entity.ListDoctorsAndHours, error)
+	ListDoctorsForService(ctx context.Context, in *entity.GetReqServiceDoctors) ([]*entity.ServiceDoctor, error)
+	SetDoctorRating(ctx context.Context, in *entity.DoctorRating) (bool, error)
+	ListDoctorsForReason(ctx context.Context, in *entity.GetReqReasonDoctors) (*entity.ReasonDoctors, error)
 }
@@ -11,4 +11,5 @@ type DoctorWorkingHoursRepository interface {
 	GetAllDoctorWorkingHours(ctx context.Context, all *entity.GetAll) (*entity.ListDoctorWorkingHours, error)
 	UpdateDoctorWorkingHours(ctx context.Context, in *entity.DoctorWorkingHours) (*entity.DoctorWorkingHours, error)
 	DeleteDoctorWorkingHours(ctx context.Context, in *entity.GetReqStr) (bool, error)
+	ListDoctorDayWorkingHours(ctx context.Context, in *entity.GetReqDayWorkingHours) ([]entity.DoctorWorkingHours, error)
 }
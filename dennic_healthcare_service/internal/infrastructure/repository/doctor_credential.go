@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+)
+
+type DoctorCredentialRepository interface {
+	CreateDoctorCredential(ctx context.Context, in *entity.DoctorCredential) (*entity.DoctorCredential, error)
+	GetDoctorCredential(ctx context.Context, id int64) (*entity.DoctorCredential, error)
+	ListDoctorCredentials(ctx context.Context, in *entity.ListDoctorCredentialsReq) (*entity.ListDoctorCredentials, error)
+	UpdateDoctorCredential(ctx context.Context, in *entity.DoctorCredential) (*entity.DoctorCredential, error)
+	DeleteDoctorCredential(ctx context.Context, id int64) (bool, error)
+	FlagExpiringLicenses(ctx context.Context, until string) ([]entity.DoctorCredential, error)
+	GetDoctorLicenseStatus(ctx context.Context, in *entity.DoctorLicenseStatusReq) (*entity.DoctorLicenseStatus, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+)
+
+type DoctorLeaveRepository interface {
+	CreateDoctorLeave(ctx context.Context, in *entity.DoctorLeave) (*entity.DoctorLeave, error)
+	GetDoctorLeave(ctx context.Context, id int64) (*entity.DoctorLeave, error)
+	ListDoctorLeaves(ctx context.Context, in *entity.ListDoctorLeavesReq) (*entity.ListDoctorLeaves, error)
+	SetDoctorLeaveStatus(ctx context.Context, in *entity.ReviewDoctorLeaveReq, from ...string) (*entity.DoctorLeave, error)
+	ListAwayDoctors(ctx context.Context, in *entity.AwayDoctorsReq) (*entity.ListAwayDoctors, error)
+}
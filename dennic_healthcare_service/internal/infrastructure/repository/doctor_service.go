@@ -11,4 +11,5 @@ type DoctorServices interface {
 	GetAllDoctorServices(ctx context.Context, all *entity.GetAll) (*entity.ListDoctorServices, error)
 	UpdateDoctorServices(ctx context.Context, in *entity.DoctorServices) (*entity.DoctorServices, error)
 	DeleteDoctorService(ctx context.Context, in *entity.GetReqStr) (bool, error)
+	RestoreDoctorService(ctx context.Context, id string) (bool, error)
 }
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+	"time"
+)
+
+type OutboxRepository interface {
+	RelayEvents(ctx context.Context, limit uint64, publish func(ctx context.Context, events []entity.OutboxEvent) error) (int, error)
+	PurgePublished(ctx context.Context, before time.Time) (int64, error)
+}
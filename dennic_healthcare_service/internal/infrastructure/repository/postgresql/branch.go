@@ -0,0 +1,290 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	branchesTableName       = "branches"
+	serviceBranch           = "branch"
+	serviceBranchRepoPrefix = "branch"
+)
+
+// branchDistanceKm is the haversine distance in kilometres from the point $1 latitude, $2 longitude,
+// LEAST keeps the rounding errors of antipodal points inside the domain of ASIN
+const branchDistanceKm = `6371 * 2 * ASIN(LEAST(1, SQRT(
+		POWER(SIN(RADIANS(latitude - $1) / 2), 2) +
+		COS(RADIANS($1)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - $2) / 2), 2)
+	)))`
+
+type Branch struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewBranchRepo(db *postgres.PostgresDB) *Branch {
+	return &Branch{
+		tableName: branchesTableName,
+		db:        db,
+	}
+}
+
+func (b *Branch) branchSelectQueryPrefix() string {
+	return `id,
+			name,
+			address,
+			city,
+			latitude,
+			longitude,
+			TO_CHAR(opens_at, 'HH24:MI'),
+			TO_CHAR(closes_at, 'HH24:MI'),
+			phone_numbers,
+			created_at,
+			updated_at,
+			deleted_at
+		`
+}
+
+// nullUUID stores an empty reference as NULL, e.g. a department attached to no branch
+func nullUUID(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+func (b *Branch) scanBranch(row pgx.Row, in *entity.Branch, extra ...any) error {
+	var updatedAt, deletedAt sql.NullTime
+	dest := []any{
+		&in.Id,
+		&in.Name,
+		&in.Address,
+		&in.City,
+		&in.Latitude,
+		&in.Longitude,
+		&in.OpensAt,
+		&in.ClosesAt,
+		&in.PhoneNumbers,
+		&in.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return err
+	}
+	if updatedAt.Valid {
+		in.UpdatedAt = updatedAt.Time
+	}
+	if deletedAt.Valid {
+		in.DeletedAt = deletedAt.Time
+	}
+	return nil
+}
+
+func (b *Branch) CreateBranch(ctx context.Context, in *entity.Branch) (*entity.Branch, error) {
+	ctx, span := otlp.Start(ctx, serviceBranch, serviceBranchRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateBranch").String(in.Id))
+	defer span.End()
+
+	data := map[string]any{
+		"id":            in.Id,
+		"name":          in.Name,
+		"address":       in.Address,
+		"city":          in.City,
+		"latitude":      in.Latitude,
+		"longitude":     in.Longitude,
+		"opens_at":      in.OpensAt,
+		"closes_at":     in.ClosesAt,
+		"phone_numbers": in.PhoneNumbers,
+	}
+	query, args, err := b.db.Sq.Builder.Insert(b.tableName).
+		SetMap(data).
+		Suffix(fmt.Sprintf("RETURNING %s", b.branchSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, b.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", b.tableName, "create"))
+	}
+
+	if err = b.scanBranch(b.db.QueryRow(ctx, query, args...), in); err != nil {
+		return nil, b.db.Error(err)
+	}
+	return in, nil
+}
+
+func (b *Branch) GetBranch(ctx context.Context, id string) (*entity.Branch, error) {
+	ctx, span := otlp.Start(ctx, serviceBranch, serviceBranchRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetBranch").String(id))
+	defer span.End()
+
+	query, args, err := b.db.Sq.Builder.Select(b.branchSelectQueryPrefix()).
+		From(b.tableName).
+		Where(b.db.Sq.Equal("id", id)).
+		Where("deleted_at IS NULL").ToSql()
+	if err != nil {
+		return nil, b.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", b.tableName, "get"))
+	}
+
+	var branch entity.Branch
+	if err = b.scanBranch(b.db.QueryRow(ctx, query, args...), &branch); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("branch")
+		}
+		return nil, b.db.Error(err)
+	}
+	return &branch, nil
+}
+
+func (b *Branch) ListBranches(ctx context.Context, in *entity.ListBranchesReq) (*entity.ListBranches, error) {
+	ctx, span := otlp.Start(ctx, serviceBranch, serviceBranchRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListBranches").String(in.City))
+	defer span.End()
+
+	queryBuilder := b.db.Sq.Builder.Select(b.branchSelectQueryPrefix()).
+		From(b.tableName).
+		Where("deleted_at IS NULL")
+	countBuilder := b.db.Sq.Builder.Select("count(*)").
+		From(b.tableName).
+		Where("deleted_at IS NULL")
+	if in.City != "" {
+		queryBuilder = queryBuilder.Where("lower(city) = lower(?)", in.City)
+		countBuilder = countBuilder.Where("lower(city) = lower(?)", in.City)
+	}
+	queryBuilder = queryBuilder.OrderBy("name", "id")
+	if in.Limit > 0 {
+		queryBuilder = queryBuilder.Limit(uint64(in.Limit))
+		if in.Page > 1 {
+			queryBuilder = queryBuilder.Offset(uint64(in.Limit * (in.Page - 1)))
+		}
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, b.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", b.tableName, "list"))
+	}
+	rows, err := b.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, b.db.Error(err)
+	}
+	defer rows.Close()
+
+	var response entity.ListBranches
+	for rows.Next() {
+		var branch entity.Branch
+		if err = b.scanBranch(rows, &branch); err != nil {
+			return nil, b.db.Error(err)
+		}
+		response.Branches = append(response.Branches, branch)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, b.db.Error(err)
+	}
+
+	queryCount, argsCount, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, b.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", b.tableName, "count"))
+	}
+	if err = b.db.QueryRow(ctx, queryCount, argsCount...).Scan(&response.Count); err != nil {
+		return nil, b.db.Error(err)
+	}
+
+	return &response, nil
+}
+
+func (b *Branch) UpdateBranch(ctx context.Context, in *entity.Branch) (*entity.Branch, error) {
+	ctx, span := otlp.Start(ctx, serviceBranch, serviceBranchRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateBranch").String(in.Id))
+	defer span.End()
+
+	data := map[string]any{
+		"name":          in.Name,
+		"address":       in.Address,
+		"city":          in.City,
+		"latitude":      in.Latitude,
+		"longitude":     in.Longitude,
+		"opens_at":      in.OpensAt,
+		"closes_at":     in.ClosesAt,
+		"phone_numbers": in.PhoneNumbers,
+		"updated_at":    time.Now().Add(time.Hour * 5),
+	}
+	query, args, err := b.db.Sq.Builder.Update(b.tableName).
+		SetMap(data).
+		Where(b.db.Sq.Equal("id", in.Id)).
+		Where("deleted_at IS NULL").
+		Suffix(fmt.Sprintf("RETURNING %s", b.branchSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, b.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", b.tableName, "update"))
+	}
+
+	if err = b.scanBranch(b.db.QueryRow(ctx, query, args...), in); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("branch")
+		}
+		return nil, b.db.Error(err)
+	}
+	return in, nil
+}
+
+func (b *Branch) DeleteBranch(ctx context.Context, id string) (bool, error) {
+	ctx, span := otlp.Start(ctx, serviceBranch, serviceBranchRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteBranch").String(id))
+	defer span.End()
+
+	query, args, err := b.db.Sq.Builder.Update(b.tableName).
+		Set("deleted_at", time.Now().Add(time.Hour*5)).
+		Where(b.db.Sq.Equal("id", id)).
+		Where("deleted_at IS NULL").ToSql()
+	if err != nil {
+		return false, b.db.ErrSQLBuild(err, b.tableName+" delete")
+	}
+
+	resp, err := b.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, b.db.Error(err)
+	}
+	return resp.RowsAffected() > 0, nil
+}
+
+// ListBranchesNearby orders the not deleted branches by their distance from the coordinates,
+// the distance is calculated by the database so that only the requested branches leave it
+func (b *Branch) ListBranchesNearby(ctx context.Context, in *entity.BranchesNearbyReq) ([]entity.NearbyBranch, error) {
+	ctx, span := otlp.Start(ctx, serviceBranch, serviceBranchRepoPrefix+"List nearby")
+	span.SetAttributes(attribute.Key("latitude").Float64(in.Latitude), attribute.Key("longitude").Float64(in.Longitude))
+	defer span.End()
+
+	query := fmt.Sprintf(`SELECT %s, distance_km
+		FROM (SELECT *, %s AS distance_km FROM %s WHERE deleted_at IS NULL) AS nearby
+		WHERE $3::float8 = 0 OR distance_km <= $3::float8
+		ORDER BY distance_km, id`, b.branchSelectQueryPrefix(), branchDistanceKm, b.tableName)
+	args := []any{in.Latitude, in.Longitude, in.RadiusKm}
+	if in.Limit > 0 {
+		query += " LIMIT $4"
+		args = append(args, in.Limit)
+	}
+
+	rows, err := b.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, b.db.Error(err)
+	}
+	defer rows.Close()
+
+	var response []entity.NearbyBranch
+	for rows.Next() {
+		var nearby entity.NearbyBranch
+		if err = b.scanBranch(rows, &nearby.Branch, &nearby.DistanceKm); err != nil {
+			return nil, b.db.Error(err)
+		}
+		response = append(response, nearby)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, b.db.Error(err)
+	}
+	return response, nil
+}
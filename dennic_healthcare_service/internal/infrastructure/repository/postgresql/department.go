@@ -39,7 +39,8 @@ func (h *DepartMent) departmentSelectQueryPrefix() string {
 			short_description,
 			created_at,
 			updated_at,
-			deleted_at`
+			deleted_at,
+			COALESCE(branch_id::text, '')`
 }
 
 func (h *DepartMent) CreateDepartment(ctx context.Context, dep *entity.Department) (*entity.Department, error) {
@@ -55,6 +56,7 @@ func (h *DepartMent) CreateDepartment(ctx context.Context, dep *entity.Departmen
 		"image_url":         dep.ImageUrl,
 		"floor_number":      dep.FloorNumber,
 		"short_description": dep.ShortDescription,
+		"branch_id":         nullUUID(dep.BranchId),
 	}
 	query, args, err := h.db.Sq.Builder.
 		Insert(h.tableName).
@@ -67,7 +69,13 @@ func (h *DepartMent) CreateDepartment(ctx context.Context, dep *entity.Departmen
 	}
 	var updatedAt, deletedAt sql.NullTime
 	var resp entity.Department
-	err = h.db.QueryRow(ctx, query, args...).Scan(
+	tx, err := h.db.Begin(ctx)
+	if err != nil {
+		return nil, h.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, query, args...).Scan(
 		&resp.Id,
 		&resp.Order,
 		&resp.Name,
@@ -78,6 +86,7 @@ func (h *DepartMent) CreateDepartment(ctx context.Context, dep *entity.Departmen
 		&resp.CreatedAt,
 		&updatedAt,
 		&deletedAt,
+		&resp.BranchId,
 	)
 	if err != nil {
 		return nil, h.db.Error(err)
@@ -89,6 +98,12 @@ func (h *DepartMent) CreateDepartment(ctx context.Context, dep *entity.Departmen
 		resp.DeletedAt = deletedAt.Time
 	}
 
+	if err = writeEvent(ctx, tx, h.tableName, eventCreated, resp.Id, &resp); err != nil {
+		return nil, h.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, h.db.Error(err)
+	}
 	return &resp, nil
 }
 
@@ -120,6 +135,7 @@ func (p *DepartMent) GetDepartmentById(ctx context.Context, get *entity.GetReqSt
 		&dep.CreatedAt,
 		&updatedAt,
 		&deletedAt,
+		&dep.BranchId,
 	)
 	if updatedAt.Valid {
 		dep.UpdatedAt = updatedAt.Time
@@ -143,10 +159,10 @@ func (p *DepartMent) GetAllDepartments(ctx context.Context, all *entity.GetAll)
 
 	queryBuilder := p.db.Sq.Builder.Select(p.departmentSelectQueryPrefix()).From(departmentTableName)
 	if all.Field != "" {
-		queryBuilder = queryBuilder.Where(fmt.Sprintf(`%s ILIKE '%s'`, all.Field, all.Value+"%"))
+		queryBuilder = queryBuilder.Where(translitPrefix(all.Field, all.Value))
 	}
 	if all.OrderBy != "" {
-		queryBuilder = queryBuilder.OrderBy(all.OrderBy)
+		queryBuilder = queryBuilder.OrderBy(translitOrderBy(all.OrderBy))
 	}
 	countBuilder := p.db.Sq.Builder.Select("count(*)").From(departmentTableName)
 	if !all.IsActive {
@@ -179,6 +195,7 @@ func (p *DepartMent) GetAllDepartments(ctx context.Context, all *entity.GetAll)
 			&dep.CreatedAt,
 			&updatedAt,
 			&deletedAt,
+			&dep.BranchId,
 		)
 		if err != nil {
 			return nil, err
@@ -217,6 +234,7 @@ func (p *DepartMent) UpdateDepartment(ctx context.Context, up *entity.Department
 		"image_url":         up.ImageUrl,
 		"floor_number":      up.FloorNumber,
 		"short_description": up.ShortDescription,
+		"branch_id":         nullUUID(up.BranchId),
 		"updated_at":        time.Now().Add(time.Hour * 5),
 	}
 	query, args, err := p.db.Sq.Builder.Update(p.tableName).SetMap(data).Where(p.db.Sq.Equal("id", up.Id)).Suffix(fmt.Sprintf("RETURNING %s", p.departmentSelectQueryPrefix())).ToSql()
@@ -225,7 +243,13 @@ func (p *DepartMent) UpdateDepartment(ctx context.Context, up *entity.Department
 		return nil, p.db.ErrSQLBuild(err, p.tableName+" update")
 	}
 	var deletedAt sql.NullTime
-	err = p.db.QueryRow(ctx, query, args...).Scan(
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, query, args...).Scan(
 		&up.Id,
 		&up.Order,
 		&up.Name,
@@ -236,6 +260,7 @@ func (p *DepartMent) UpdateDepartment(ctx context.Context, up *entity.Department
 		&up.CreatedAt,
 		&up.UpdatedAt,
 		&deletedAt,
+		&up.BranchId,
 	)
 	if deletedAt.Valid {
 		up.DeletedAt = deletedAt.Time
@@ -243,6 +268,12 @@ func (p *DepartMent) UpdateDepartment(ctx context.Context, up *entity.Department
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
+	if err = writeEvent(ctx, tx, p.tableName, eventUpdated, up.Id, up); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
 	return up, nil
 }
 
@@ -255,28 +286,23 @@ func (p *DepartMent) DeleteDepartment(ctx context.Context, get *entity.GetReqStr
 	data := map[string]any{
 		"deleted_at": time.Now().Add(time.Hour * 5),
 	}
-	var args []interface{}
-	var query string
-	var err error
-	if get.IsActive {
-		query, args, err = p.db.Sq.Builder.Delete(p.tableName).From(p.tableName).
-			Where(p.db.Sq.And(p.db.Sq.Equal(get.Field, get.Value))).ToSql()
-		if err != nil {
-			return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
-		}
-	} else {
-		query, args, err = p.db.Sq.Builder.Update(p.tableName).SetMap(data).
-			Where(p.db.Sq.And(p.db.Sq.Equal(get.Field, get.Value), p.db.Sq.Equal("deleted_at", nil))).ToSql()
-		if err != nil {
-			return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
-		}
-	}
-	resp, err := p.db.Exec(ctx, query, args...)
-	if err != nil {
-		return false, p.db.Error(err)
+	// a soft delete cascades to the rows referencing the deleted one
+	if !get.IsActive {
+		return softDeleteCascade(ctx, p.db, p.tableName, get.Field, get.Value, data)
 	}
-	if resp.RowsAffected() > 0 {
-		return true, nil
+
+	return hardDelete(ctx, p.db, p.tableName, get.Field, get.Value)
+}
+
+// RestoreDepartment restores the soft deleted department together with the rows its delete cascaded to
+func (p *DepartMent) RestoreDepartment(ctx context.Context, id string) (bool, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDepartment, serviceNameDepartmentRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreDepartment").String(id))
+	defer span.End()
+
+	data := map[string]any{
+		"updated_at": time.Now().Add(time.Hour * 5),
 	}
-	return false, nil
+
+	return restoreCascade(ctx, p.db, p.tableName, id, data)
 }
@@ -6,8 +6,11 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v4"
 	_ "github.com/lib/pq"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -18,6 +21,7 @@ import (
 
 const (
 	doctorTableName             = "doctors"
+	doctorDepartmentsTableName  = "doctor_departments"
 	serviceNameDoctor           = "doctors"
 	serviceNameDoctorRepoPrefix = "doctors"
 )
@@ -56,10 +60,26 @@ func (p *DocTor) docTorSelectQueryPrefix() string {
 			department_id,
 			room_number,
 			created_at,
-			updated_at
+			updated_at,
+			COALESCE(branch_id::text, '')
 		`
 }
 
+// doctorTodayHoursJoin gives the listed doctors the hours of today, from the start of the first shift
+// in effect to the end of the last one, NULL when the doctor does not work today
+const doctorTodayHoursJoin = `LATERAL (
+		SELECT MIN(start_time) AS start_time,
+			MAX(finish_time) AS finish_time,
+			TRIM(TO_CHAR(CURRENT_DATE, 'Day')) AS day_of_week
+		FROM doctor_working_hours
+		WHERE doctor_id = d.id
+			AND kind = 'shift'
+			AND deleted_at IS NULL
+			AND day_of_week = TRIM(TO_CHAR(CURRENT_DATE, 'Day'))
+			AND (effective_from IS NULL OR effective_from <= CURRENT_DATE)
+			AND (effective_to IS NULL OR effective_to >= CURRENT_DATE)
+	) dwh ON TRUE`
+
 func (p *DocTor) getDocTorSelectQueryPrefix() string {
 	return `			d.id,                                                            
                         d.doctor_order,
@@ -85,11 +105,66 @@ func (p *DocTor) getDocTorSelectQueryPrefix() string {
                         d.department_id,
                         d.room_number,
                         d.created_at,
-                        d.updated_at
+                        d.updated_at,
+                        d.rating,
+                        d.review_count,
+                        COALESCE(d.branch_id::text, '')
 						
 `
 }
 
+// setDoctorDepartments makes the main department and departmentIds the only departments of the doctor,
+// it returns them main department first
+func setDoctorDepartments(ctx context.Context, tx pgx.Tx, doctorId, departmentId string, departmentIds []string) ([]string, error) {
+	ids := []string{departmentId}
+	for _, id := range departmentIds {
+		if id != "" && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	query := fmt.Sprintf(`DELETE FROM %s WHERE doctor_id = $1 AND department_id <> ALL($2::uuid[])`, doctorDepartmentsTableName)
+	if _, err := tx.Exec(ctx, query, doctorId, ids); err != nil {
+		return nil, err
+	}
+	query = fmt.Sprintf(`INSERT INTO %s (doctor_id, department_id)
+		SELECT $1::uuid, unnest($2::uuid[])
+		ON CONFLICT DO NOTHING`, doctorDepartmentsTableName)
+	if _, err := tx.Exec(ctx, query, doctorId, ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// doctorDepartmentIds returns the departments of the doctor main department first
+func (h *DocTor) doctorDepartmentIds(ctx context.Context, doctorId string) ([]string, error) {
+	query := fmt.Sprintf(`SELECT dd.department_id::text FROM %s dd
+		JOIN %s d ON d.id = dd.doctor_id
+		WHERE dd.doctor_id = $1
+		ORDER BY dd.department_id <> d.department_id, dd.created_at, dd.department_id`, doctorDepartmentsTableName, h.tableName)
+	rows, err := h.db.Query(ctx, query, doctorId)
+	if err != nil {
+		return nil, h.db.Error(err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, h.db.Error(err)
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, h.db.Error(err)
+	}
+	return ids, nil
+}
+
+// doctorInDepartment filters the doctors d belonging to the department bound to the placeholder
+const doctorInDepartment = "EXISTS (SELECT 1 FROM doctor_departments dd WHERE dd.doctor_id = d.id AND dd.department_id = ?)"
+
 func (h *DocTor) CreateDoctor(ctx context.Context, req *entity.Doctor) (*entity.Doctor, error) {
 
 	ctx, span := otlp.Start(ctx, serviceNameDoctor, serviceNameDoctorRepoPrefix+"Create")
@@ -115,6 +190,7 @@ func (h *DocTor) CreateDoctor(ctx context.Context, req *entity.Doctor) (*entity.
 		"work_years":      req.WorkYears,
 		"department_id":   req.DepartmentId,
 		"room_number":     req.RoomNumber,
+		"branch_id":       nullUUID(req.BranchId),
 	}
 	query, args, err := h.db.Sq.Builder.Insert(h.tableName).
 		SetMap(data).Suffix(fmt.Sprintf("RETURNING %s", h.docTorSelectQueryPrefix())).ToSql()
@@ -122,8 +198,15 @@ func (h *DocTor) CreateDoctor(ctx context.Context, req *entity.Doctor) (*entity.
 	if err != nil {
 		return nil, h.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", h.tableName, "create"))
 	}
+
+	tx, err := h.db.Begin(ctx)
+	if err != nil {
+		return nil, h.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
 	var startWorkYear, endWorkYear, updatedAt, birthDate sql.NullTime
-	err = h.db.QueryRow(ctx, query, args...).Scan(
+	err = tx.QueryRow(ctx, query, args...).Scan(
 		&req.Id,
 		&req.Order,
 		&req.FirstName,
@@ -146,6 +229,7 @@ func (h *DocTor) CreateDoctor(ctx context.Context, req *entity.Doctor) (*entity.
 		&req.RoomNumber,
 		&req.CreatedAt,
 		&updatedAt,
+		&req.BranchId,
 	)
 
 	req.BirthDate = birthDate.Time.Format("2006-01-02")
@@ -156,6 +240,18 @@ func (h *DocTor) CreateDoctor(ctx context.Context, req *entity.Doctor) (*entity.
 	if err != nil {
 		return nil, h.db.Error(err)
 	}
+	if req.DepartmentIds, err = setDoctorDepartments(ctx, tx, req.Id, req.DepartmentId, req.DepartmentIds); err != nil {
+		return nil, h.db.Error(err)
+	}
+	// the password hash stays out of the event
+	payload := *req
+	payload.Password = ""
+	if err = writeEvent(ctx, tx, h.tableName, eventCreated, req.Id, &payload); err != nil {
+		return nil, h.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, h.db.Error(err)
+	}
 
 	return req, nil
 }
@@ -168,9 +264,10 @@ func (h *DocTor) GetDoctorById(ctx context.Context, get *entity.GetReqStr) (*ent
 	defer span.End()
 
 	var doctor entity.DoctorAndDoctorHours
-	var startWorkYear, endWorkYear, updatedAt, birthDate, startTime, finishTime sql.NullTime
+	var startWorkYear, endWorkYear, updatedAt, birthDate sql.NullTime
+	var startTime, finishTime *time.Time
 	queryBuilder := h.db.Sq.Builder.Select(h.getDocTorSelectQueryPrefix()).
-		From(h.tableName + " d ").Join("doctor_working_hours dwh ON dwh.doctor_id = d.id")
+		From(h.tableName + " d ").LeftJoin(doctorTodayHoursJoin)
 
 	if !get.IsActive {
 		queryBuilder = queryBuilder.Where("d.deleted_at IS NULL")
@@ -208,15 +305,18 @@ func (h *DocTor) GetDoctorById(ctx context.Context, get *entity.GetReqStr) (*ent
 		&doctor.RoomNumber,
 		&doctor.CreatedAt,
 		&updatedAt,
+		&doctor.Rating,
+		&doctor.ReviewCount,
+		&doctor.BranchId,
 	)
 	if updatedAt.Valid {
 		doctor.UpdatedAt = updatedAt.Time
 	}
-	if startTime.Valid {
-		doctor.StartTime = startTime.Time.Format("15:04")
+	if startTime != nil {
+		doctor.StartTime = startTime.Format("15:04")
 	}
-	if finishTime.Valid {
-		doctor.FinishTime = finishTime.Time.Format("15:04")
+	if finishTime != nil {
+		doctor.FinishTime = finishTime.Format("15:04")
 	}
 	doctor.StartWorkDate = startWorkYear.Time.String()
 	if endWorkYear.Valid {
@@ -228,6 +328,9 @@ func (h *DocTor) GetDoctorById(ctx context.Context, get *entity.GetReqStr) (*ent
 	if err != nil {
 		return nil, err
 	}
+	if doctor.DepartmentIds, err = h.doctorDepartmentIds(ctx, doctor.Id); err != nil {
+		return nil, err
+	}
 
 	querySpecBuilder := h.db.Sq.Builder.Select("s.id, s.name").
 		From("doctor_service ds").Join("doctors d ON d.id = ds.doctor_id").
@@ -267,12 +370,12 @@ func (h *DocTor) GetAllDoctors(ctx context.Context, all *entity.GetAll) (*entity
 	offset := all.Limit * (all.Page - 1)
 
 	queryBuilder := h.db.Sq.Builder.Select(h.getDocTorSelectQueryPrefix()).
-		From(h.tableName + " d ").Join("doctor_working_hours dwh ON dwh.doctor_id = d.id")
+		From(h.tableName + " d ").LeftJoin(doctorTodayHoursJoin)
 	if all.Field != "" {
-		queryBuilder = queryBuilder.Where(fmt.Sprintf(`%s ILIKE '%s'`, all.Field, all.Value+"%"))
+		queryBuilder = queryBuilder.Where(translitPrefix(all.Field, all.Value))
 	}
 	if all.OrderBy != "" {
-		queryBuilder = queryBuilder.OrderBy(all.OrderBy)
+		queryBuilder = queryBuilder.OrderBy(translitOrderBy(all.OrderBy))
 	}
 	countBuilder := h.db.Sq.Builder.Select("count(*)").From(h.tableName)
 	if !all.IsActive {
@@ -292,7 +395,8 @@ func (h *DocTor) GetAllDoctors(ctx context.Context, all *entity.GetAll) (*entity
 	}
 	for rows.Next() {
 		var doctor entity.DoctorAndDoctorHours
-		var startWorkYear, endWorkYear, birthDate, updatedAt, startTime, finishTime sql.NullTime
+		var startWorkYear, endWorkYear, birthDate, updatedAt sql.NullTime
+		var startTime, finishTime *time.Time
 		err = rows.Scan(
 			&doctor.Id,
 			&doctor.Order,
@@ -319,6 +423,9 @@ func (h *DocTor) GetAllDoctors(ctx context.Context, all *entity.GetAll) (*entity
 			&doctor.RoomNumber,
 			&doctor.CreatedAt,
 			&updatedAt,
+			&doctor.Rating,
+			&doctor.ReviewCount,
+			&doctor.BranchId,
 		)
 		if err != nil {
 			return nil, err
@@ -326,11 +433,11 @@ func (h *DocTor) GetAllDoctors(ctx context.Context, all *entity.GetAll) (*entity
 		if updatedAt.Valid {
 			doctor.UpdatedAt = updatedAt.Time
 		}
-		if startTime.Valid {
-			doctor.StartTime = startTime.Time.Format("15:04")
+		if startTime != nil {
+			doctor.StartTime = startTime.Format("15:04")
 		}
-		if finishTime.Valid {
-			doctor.FinishTime = finishTime.Time.Format("15:04")
+		if finishTime != nil {
+			doctor.FinishTime = finishTime.Format("15:04")
 		}
 		doctor.BirthDate = birthDate.Time.Format("2006-01-02")
 		doctor.StartWorkDate = startWorkYear.Time.String()
@@ -404,6 +511,7 @@ func (h *DocTor) UpdateDoctor(ctx context.Context, update *entity.Doctor) (*enti
 		"work_years":      update.WorkYears,
 		"department_id":   update.DepartmentId,
 		"room_number":     update.RoomNumber,
+		"branch_id":       nullUUID(update.BranchId),
 		"updated_at":      time.Now().Add(time.Hour * 5),
 	}
 
@@ -414,8 +522,15 @@ func (h *DocTor) UpdateDoctor(ctx context.Context, update *entity.Doctor) (*enti
 
 		return nil, h.db.ErrSQLBuild(err, h.tableName+" update")
 	}
+
+	tx, err := h.db.Begin(ctx)
+	if err != nil {
+		return nil, h.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
 	var startWorkYear, endWorkYear, birthDate sql.NullTime
-	err = h.db.QueryRow(ctx, query, args...).Scan(
+	err = tx.QueryRow(ctx, query, args...).Scan(
 		&update.Id,
 		&update.Order,
 		&update.FirstName,
@@ -438,6 +553,7 @@ func (h *DocTor) UpdateDoctor(ctx context.Context, update *entity.Doctor) (*enti
 		&update.RoomNumber,
 		&update.CreatedAt,
 		&update.UpdatedAt,
+		&update.BranchId,
 	)
 
 	update.StartWorkDate = startWorkYear.Time.String()
@@ -448,6 +564,18 @@ func (h *DocTor) UpdateDoctor(ctx context.Context, update *entity.Doctor) (*enti
 	if err != nil {
 		return nil, h.db.Error(err)
 	}
+	if update.DepartmentIds, err = setDoctorDepartments(ctx, tx, update.Id, update.DepartmentId, update.DepartmentIds); err != nil {
+		return nil, h.db.Error(err)
+	}
+	// the password hash stays out of the event
+	payload := *update
+	payload.Password = ""
+	if err = writeEvent(ctx, tx, h.tableName, eventUpdated, update.Id, &payload); err != nil {
+		return nil, h.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, h.db.Error(err)
+	}
 
 	return update, nil
 }
@@ -463,31 +591,26 @@ func (h *DocTor) DeleteDoctor(ctx context.Context, del *entity.GetReqStr) (bool,
 		"deleted_at":    time.Now().Add(time.Hour * 5),
 		"end_work_date": time.Now().Add(time.Hour * 5),
 	}
-	var args []interface{}
-	var query string
-	var err error
-	if del.IsActive {
-		query, args, err = h.db.Sq.Builder.Delete(h.tableName).From(h.tableName).
-			Where(h.db.Sq.And(h.db.Sq.Equal(del.Field, del.Value))).ToSql()
-		if err != nil {
-			return false, h.db.ErrSQLBuild(err, h.tableName+" delete")
-		}
-	} else {
-		query, args, err = h.db.Sq.Builder.Update(h.tableName).SetMap(data).
-			Where(h.db.Sq.And(h.db.Sq.Equal(del.Field, del.Value), h.db.Sq.Equal("deleted_at", nil))).ToSql()
-		if err != nil {
-			return false, h.db.ErrSQLBuild(err, h.tableName+" delete")
-		}
-	}
-	resp, err := h.db.Exec(ctx, query, args...)
-	if err != nil {
-		return false, h.db.Error(err)
+	// a soft delete cascades to the rows referencing the deleted one
+	if !del.IsActive {
+		return softDeleteCascade(ctx, h.db, h.tableName, del.Field, del.Value, data)
 	}
-	if resp.RowsAffected() > 0 {
-		return true, nil
+
+	return hardDelete(ctx, h.db, h.tableName, del.Field, del.Value)
+}
+
+// RestoreDoctor restores the soft deleted doctor together with the rows its delete cascaded to
+func (h *DocTor) RestoreDoctor(ctx context.Context, id string) (bool, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctor, serviceNameDoctorRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreDoctor").String(id))
+	defer span.End()
+
+	// the end of work set by the delete is cleared, an end of work planned before is kept
+	data := map[string]any{
+		"end_work_date": h.db.Sq.Expr("CASE WHEN end_work_date::date = deleted_at::date THEN NULL ELSE end_work_date END"),
 	}
 
-	return false, nil
+	return restoreCascade(ctx, h.db, h.tableName, id, data)
 }
 
 func (h *DocTor) ListDoctorsByDepartmentId(ctx context.Context, in *entity.GetReqStrDep) (*entity.ListDoctors, error) {
@@ -498,20 +621,23 @@ func (h *DocTor) ListDoctorsByDepartmentId(ctx context.Context, in *entity.GetRe
 
 	offset := in.Limit * (in.Page - 1)
 
-	queryBuilder := h.db.Sq.Builder.Select(h.docTorSelectQueryPrefix()).From(doctorTableName)
+	// the doctors of every department they work in are listed, not only of the main one
+	queryBuilder := h.db.Sq.Builder.Select(h.docTorSelectQueryPrefix()).From(h.tableName+" d").
+		Where(doctorInDepartment, in.DepartmentId)
 	if in.Field != "" {
-		queryBuilder = queryBuilder.Where(fmt.Sprintf(`%s ILIKE '%s'`, in.Field, in.Value+"%"))
+		queryBuilder = queryBuilder.Where(translitPrefix(in.Field, in.Value))
 	}
 	if in.OrderBy != "" {
-		queryBuilder = queryBuilder.OrderBy(in.OrderBy)
+		queryBuilder = queryBuilder.OrderBy(translitOrderBy(in.OrderBy))
 	}
-	countBuilder := h.db.Sq.Builder.Select("count(*)").From(h.tableName)
+	countBuilder := h.db.Sq.Builder.Select("count(*)").From(h.tableName+" d").
+		Where(doctorInDepartment, in.DepartmentId)
 	if !in.IsActive {
 		countBuilder = countBuilder.Where("deleted_at IS NULL")
 		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
 	}
 
-	queryBuilder = queryBuilder.Where(h.db.Sq.Equal("department_id", in.DepartmentId)).Limit(uint64(in.Limit)).Offset(uint64(offset))
+	queryBuilder = queryBuilder.Limit(uint64(in.Limit)).Offset(uint64(offset))
 	query, args, err := queryBuilder.ToSql()
 
 	if err != nil {
@@ -525,7 +651,7 @@ func (h *DocTor) ListDoctorsByDepartmentId(ctx context.Context, in *entity.GetRe
 	var doctors entity.ListDoctors
 	for rows.Next() {
 		var doctor entity.Doctor
-		var startWorkYear, endWorkYear, birthDate, updatedAt, startTime, finishTime sql.NullTime
+		var startWorkYear, endWorkYear, birthDate, updatedAt sql.NullTime
 		err = rows.Scan(
 			&doctor.Id,
 			&doctor.Order,
@@ -541,8 +667,6 @@ func (h *DocTor) ListDoctorsByDepartmentId(ctx context.Context, in *entity.GetRe
 			&doctor.City,
 			&doctor.Country,
 			&doctor.Salary,
-			&startTime,
-			&finishTime,
 			&doctor.Bio,
 			&startWorkYear,
 			&endWorkYear,
@@ -551,6 +675,7 @@ func (h *DocTor) ListDoctorsByDepartmentId(ctx context.Context, in *entity.GetRe
 			&doctor.RoomNumber,
 			&doctor.CreatedAt,
 			&updatedAt,
+			&doctor.BranchId,
 		)
 		if err != nil {
 			return nil, err
@@ -587,16 +712,19 @@ func (h *DocTor) ListDoctorsByDepartmentId(ctx context.Context, in *entity.GetRe
 				return nil, err
 			}
 			doctor.Specializations = doctorSpec
-			doctors.Doctors = append(doctors.Doctors, doctor)
 		}
+		if doctor.DepartmentIds, err = h.doctorDepartmentIds(ctx, doctor.Id); err != nil {
+			return nil, err
+		}
+		doctors.Doctors = append(doctors.Doctors, doctor)
 	}
 	var count int64
-	queryCount, _, err := countBuilder.ToSql()
+	queryCount, argsCount, err := countBuilder.ToSql()
 	if err != nil {
 		return nil, h.db.Error(err)
 	}
 
-	err = h.db.QueryRow(ctx, queryCount).Scan(&count)
+	err = h.db.QueryRow(ctx, queryCount, argsCount...).Scan(&count)
 	if err != nil {
 		return nil, h.db.Error(err)
 	}
@@ -613,25 +741,26 @@ func (h *DocTor) ListDoctorBySpecializationId(ctx context.Context, in *entity.Ge
 
 	offset := in.Limit * (in.Page - 1)
 
+	// a doctor is listed once when one of its services is of the specialization or of one of its sub-specializations
+	doctorOfSpecialization := fmt.Sprintf(`EXISTS (SELECT 1 FROM doctor_service ds
+		WHERE ds.doctor_id = d.id AND ds.deleted_at IS NULL AND ds.specialization_id IN (%s))`, specializationSubtree)
 	queryBuilder := h.db.Sq.Builder.Select(h.getDocTorSelectQueryPrefix()).
-				From(h.tableName + " d ").
-				Join("doctor_service ds ON ds.doctor_id = d.id").
-				Join("doctor_working_hours dwh ON dwh.doctor_id = d.id")
+		From(h.tableName+" d ").
+		LeftJoin(doctorTodayHoursJoin).
+		Where(doctorOfSpecialization, in.SpecializationId)
 	if in.Field != "" {
-		queryBuilder = queryBuilder.Where(fmt.Sprintf(`%s ILIKE '%s'`, in.Field, in.Value+"%"))
+		queryBuilder = queryBuilder.Where(translitPrefix(in.Field, in.Value))
 	}
 	if in.OrderBy != "" {
-		queryBuilder = queryBuilder.OrderBy(in.OrderBy)
+		queryBuilder = queryBuilder.OrderBy(translitOrderBy(in.OrderBy))
 	}
-	countBuilder := h.db.Sq.Builder.Select("count(*)").From(h.tableName + " d ").
-		Join("doctor_service ds ON ds.doctor_id = d.id").
-		Where(fmt.Sprintf("ds.specialization_id = '%s'", in.SpecializationId))
+	countBuilder := h.db.Sq.Builder.Select("count(*)").From(h.tableName+" d ").
+		Where(doctorOfSpecialization, in.SpecializationId)
 	if !in.IsActive {
 		countBuilder = countBuilder.Where("d.deleted_at IS NULL")
 		queryBuilder = queryBuilder.Where("d.deleted_at IS NULL")
 	}
-	queryBuilder = queryBuilder.Where(h.db.Sq.Equal("ds.specialization_id", in.SpecializationId)).
-		Limit(uint64(in.Limit)).Offset(uint64(offset))
+	queryBuilder = queryBuilder.Limit(uint64(in.Limit)).Offset(uint64(offset))
 
 	query, args, err := queryBuilder.ToSql()
 	if err != nil {
@@ -644,7 +773,8 @@ func (h *DocTor) ListDoctorBySpecializationId(ctx context.Context, in *entity.Ge
 	var doctors entity.ListDoctorsAndHours
 	for rows.Next() {
 		var doctor entity.DoctorAndDoctorHours
-		var startWorkYear, endWorkYear, birthDate, updatedAt, startTime, finishTime sql.NullTime
+		var startWorkYear, endWorkYear, birthDate, updatedAt sql.NullTime
+		var startTime, finishTime *time.Time
 		err = rows.Scan(
 			&doctor.Id,
 			&doctor.Order,
@@ -671,6 +801,9 @@ func (h *DocTor) ListDoctorBySpecializationId(ctx context.Context, in *entity.Ge
 			&doctor.RoomNumber,
 			&doctor.CreatedAt,
 			&updatedAt,
+			&doctor.Rating,
+			&doctor.ReviewCount,
+			&doctor.BranchId,
 		)
 		if err != nil {
 			return nil, err
@@ -683,11 +816,11 @@ func (h *DocTor) ListDoctorBySpecializationId(ctx context.Context, in *entity.Ge
 		if endWorkYear.Valid {
 			doctor.EndWorkDate = endWorkYear.Time.Format("2006-01-02")
 		}
-		if startTime.Valid {
-			doctor.StartTime = startTime.Time.Format("2006-01-02")
+		if startTime != nil {
+			doctor.StartTime = startTime.Format("15:04")
 		}
-		if finishTime.Valid {
-			doctor.FinishTime = finishTime.Time.Format("2006-01-02")
+		if finishTime != nil {
+			doctor.FinishTime = finishTime.Format("15:04")
 		}
 
 		querySpecBuilder := h.db.Sq.Builder.Select("s.id, s.name").
@@ -713,16 +846,19 @@ func (h *DocTor) ListDoctorBySpecializationId(ctx context.Context, in *entity.Ge
 				return nil, err
 			}
 			doctor.Specializations = doctorSpec
-			doctors.Doctors = append(doctors.Doctors, doctor)
 		}
+		if doctor.DepartmentIds, err = h.doctorDepartmentIds(ctx, doctor.Id); err != nil {
+			return nil, err
+		}
+		doctors.Doctors = append(doctors.Doctors, doctor)
 	}
 	var count int64
-	queryCount, _, err := countBuilder.ToSql()
+	queryCount, argsCount, err := countBuilder.ToSql()
 	if err != nil {
 		return nil, h.db.Error(err)
 	}
 
-	err = h.db.QueryRow(ctx, queryCount).Scan(&count)
+	err = h.db.QueryRow(ctx, queryCount, argsCount...).Scan(&count)
 	if err != nil {
 		return nil, h.db.Error(err)
 	}
@@ -730,3 +866,257 @@ func (h *DocTor) ListDoctorBySpecializationId(ctx context.Context, in *entity.Ge
 	doctors.Count = count
 	return &doctors, nil
 }
+
+func (h *DocTor) ListDoctorsForService(ctx context.Context, in *entity.GetReqServiceDoctors) ([]*entity.ServiceDoctor, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctor, serviceNameDoctorRepoPrefix+"List for service")
+	span.SetAttributes(attribute.Key("ListDoctorsForService").String(in.DoctorServiceId))
+
+	defer span.End()
+
+	// a doctor offers the service when one of its own doctor_service rows has the same specialization,
+	// or the requested one, every shift and break in effect on the day is listed
+	queryBuilder := h.db.Sq.Builder.Select("d.id, ds.id, dwh.kind, dwh.start_time, dwh.finish_time").
+		From(h.tableName+" d").
+		Join("doctor_service ds ON ds.doctor_id = d.id AND ds.deleted_at IS NULL").
+		Join("doctor_working_hours dwh ON dwh.doctor_id = d.id AND dwh.deleted_at IS NULL").
+		Where("d.deleted_at IS NULL").
+		Where("(d.end_work_date IS NULL OR d.end_work_date >= CURRENT_DATE)").
+		Where(doctorInDepartment, in.DepartmentId).
+		Where(h.db.Sq.Equal("dwh.day_of_week", in.DayOfWeek)).
+		Where("(dwh.effective_from IS NULL OR dwh.effective_from <= COALESCE(NULLIF(?, '')::date, CURRENT_DATE))", in.Date).
+		Where("(dwh.effective_to IS NULL OR dwh.effective_to >= COALESCE(NULLIF(?, '')::date, CURRENT_DATE))", in.Date).
+		OrderBy("d.id", "dwh.start_time")
+	if in.SpecializationId != "" {
+		queryBuilder = queryBuilder.Where(h.db.Sq.Equal("ds.specialization_id", in.SpecializationId))
+	} else {
+		queryBuilder = queryBuilder.Where("ds.specialization_id = (SELECT specialization_id FROM doctor_service WHERE id = ?)", in.DoctorServiceId)
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, h.db.ErrSQLBuild(err, "doctors for service")
+	}
+
+	rows, err := h.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, h.db.Error(err)
+	}
+	defer rows.Close()
+
+	var doctors []*entity.ServiceDoctor
+	for rows.Next() {
+		var doctor entity.ServiceDoctor
+		if err = rows.Scan(
+			&doctor.DoctorId,
+			&doctor.DoctorServiceId,
+			&doctor.Kind,
+			&doctor.StartTime,
+			&doctor.FinishTime,
+		); err != nil {
+			return nil, h.db.Error(err)
+		}
+		doctors = append(doctors, &doctor)
+	}
+
+	return doctors, rows.Err()
+}
+
+func (h *DocTor) SetDoctorRating(ctx context.Context, in *entity.DoctorRating) (bool, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctor, serviceNameDoctorRepoPrefix+"Set rating")
+	span.SetAttributes(attribute.Key("SetDoctorRating").String(in.DoctorId))
+
+	defer span.End()
+
+	// updated_at is left alone, the rating is not an edit of the doctor
+	query, args, err := h.db.Sq.Builder.Update(h.tableName).
+		SetMap(map[string]interface{}{
+			"rating":       in.Rating,
+			"review_count": in.ReviewCount,
+		}).
+		Where(h.db.Sq.Equal("id", in.DoctorId)).ToSql()
+	if err != nil {
+		return false, h.db.ErrSQLBuild(err, h.tableName+" set rating")
+	}
+
+	tx, err := h.db.Begin(ctx)
+	if err != nil {
+		return false, h.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	resp, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return false, h.db.Error(err)
+	}
+	if resp.RowsAffected() == 0 {
+		return false, nil
+	}
+	// the catalogue shows the rating, its change is published like an edit
+	if err = writeEvent(ctx, tx, h.tableName, eventUpdated, in.DoctorId, in); err != nil {
+		return false, h.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return false, h.db.Error(err)
+	}
+	return true, nil
+}
+
+// ListDoctorsForReason resolves the reason and lists the doctors offering a service of its specialization
+// together with their working hours and leaves, a free text reason is matched like the catalogue search does
+func (h *DocTor) ListDoctorsForReason(ctx context.Context, in *entity.GetReqReasonDoctors) (*entity.ReasonDoctors, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctor, serviceNameDoctorRepoPrefix+"List for reason")
+	span.SetAttributes(attribute.Key("ListDoctorsForReason").String(in.ReasonId + in.Query))
+
+	defer span.End()
+
+	var (
+		response entity.ReasonDoctors
+		row      pgx.Row
+	)
+	if in.ReasonId != "" {
+		row = h.db.QueryRow(ctx, `SELECT id::text, name, specialization_id::text
+			FROM reasons
+			WHERE id::text = $1 AND deleted_at IS NULL`, in.ReasonId)
+	} else {
+		row = h.db.QueryRow(ctx, `SELECT id::text, name, specialization_id::text
+			FROM reasons
+			WHERE deleted_at IS NULL
+				AND (to_tsvector('simple', uz_normalize(name)) @@ websearch_to_tsquery('simple', uz_normalize($1))
+					OR uz_normalize($1) <% uz_normalize(name))
+			ORDER BY ts_rank(to_tsvector('simple', uz_normalize(name)), websearch_to_tsquery('simple', uz_normalize($1)))
+				+ word_similarity(uz_normalize($1), uz_normalize(name)) DESC, name
+			LIMIT 1`, in.Query)
+	}
+	if err := row.Scan(&response.ReasonId, &response.ReasonName, &response.SpecializationId); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("reason")
+		}
+		return nil, h.db.Error(err)
+	}
+
+	// each price is the lowest of the services of the doctor, the service itself is the one cheapest offline,
+	// the services of the sub-specializations count, as for ListDoctorBySpecializationId,
+	// the doctors holding licenses none of which is valid today are left out
+	rows, err := h.db.Query(ctx, fmt.Sprintf(`SELECT
+			d.id::text,
+			d.first_name,
+			d.last_name,
+			COALESCE(d.image_url, ''),
+			d.department_id::text,
+			(array_agg(ds.id::text ORDER BY ds.offline_price, ds.id))[1],
+			min(ds.online_price),
+			min(ds.offline_price),
+			(array_agg((EXTRACT(EPOCH FROM ds.duration::time) / 60)::bigint ORDER BY ds.offline_price, ds.id))[1],
+			d.rating,
+			d.review_count
+		FROM doctors d
+		JOIN doctor_service ds ON ds.doctor_id = d.id AND ds.deleted_at IS NULL
+		WHERE d.deleted_at IS NULL
+			AND (d.end_work_date IS NULL OR d.end_work_date >= CURRENT_DATE)
+			AND ds.specialization_id IN (%s)
+			AND NOT EXISTS (
+				SELECT 1
+				FROM %s c
+				WHERE c.doctor_id = d.id AND c.type = $2 AND c.deleted_at IS NULL
+				HAVING count(*) > 0 AND NOT bool_or(c.expiry_date IS NULL OR c.expiry_date >= CURRENT_DATE)
+			)
+		GROUP BY d.id
+		ORDER BY d.id`, strings.Replace(specializationSubtree, "?", "$1", 1), doctorCredentialsTableName), response.SpecializationId, entity.CredentialLicense)
+	if err != nil {
+		return nil, h.db.Error(err)
+	}
+	defer rows.Close()
+
+	doctorIds := []string{}
+	byId := map[string]*entity.ReasonDoctor{}
+	for rows.Next() {
+		var doctor entity.ReasonDoctor
+		if err = rows.Scan(
+			&doctor.DoctorId,
+			&doctor.FirstName,
+			&doctor.LastName,
+			&doctor.ImageUrl,
+			&doctor.DepartmentId,
+			&doctor.DoctorServiceId,
+			&doctor.OnlinePrice,
+			&doctor.OfflinePrice,
+			&doctor.Duration,
+			&doctor.Rating,
+			&doctor.ReviewCount,
+		); err != nil {
+			return nil, h.db.Error(err)
+		}
+		doctorIds = append(doctorIds, doctor.DoctorId)
+		byId[doctor.DoctorId] = &doctor
+		response.Doctors = append(response.Doctors, &doctor)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, h.db.Error(err)
+	}
+	if len(doctorIds) == 0 {
+		return &response, nil
+	}
+
+	// hours which ended before today are history, the caller picks the ones in effect on a day
+	hours, err := h.db.Query(ctx, `SELECT doctor_id::text, day_of_week, kind, start_time, finish_time,
+			COALESCE(TO_CHAR(effective_from, 'YYYY-MM-DD'), ''),
+			COALESCE(TO_CHAR(effective_to, 'YYYY-MM-DD'), '')
+		FROM doctor_working_hours
+		WHERE doctor_id::text = ANY($1)
+			AND deleted_at IS NULL
+			AND (effective_to IS NULL OR effective_to >= CURRENT_DATE)`, doctorIds)
+	if err != nil {
+		return nil, h.db.Error(err)
+	}
+	defer hours.Close()
+
+	for hours.Next() {
+		var (
+			doctorId string
+			hour     entity.ReasonDoctorHours
+		)
+		if err = hours.Scan(
+			&doctorId,
+			&hour.DayOfWeek,
+			&hour.Kind,
+			&hour.StartTime,
+			&hour.FinishTime,
+			&hour.EffectiveFrom,
+			&hour.EffectiveTo,
+		); err != nil {
+			return nil, h.db.Error(err)
+		}
+		byId[doctorId].WorkingHours = append(byId[doctorId].WorkingHours, &hour)
+	}
+	if err = hours.Err(); err != nil {
+		return nil, h.db.Error(err)
+	}
+
+	// the approved leaves not over yet, the caller skips the days they cover
+	leaves, err := h.db.Query(ctx, fmt.Sprintf(`SELECT doctor_id::text,
+			TO_CHAR(start_date, 'YYYY-MM-DD'),
+			TO_CHAR(end_date, 'YYYY-MM-DD')
+		FROM %s
+		WHERE doctor_id::text = ANY($1)
+			AND status = $2
+			AND deleted_at IS NULL
+			AND end_date >= CURRENT_DATE
+		ORDER BY doctor_id, start_date`, doctorLeavesTableName), doctorIds, entity.LeaveApproved)
+	if err != nil {
+		return nil, h.db.Error(err)
+	}
+	defer leaves.Close()
+
+	for leaves.Next() {
+		var (
+			doctorId string
+			leave    entity.ReasonDoctorLeave
+		)
+		if err = leaves.Scan(&doctorId, &leave.StartDate, &leave.EndDate); err != nil {
+			return nil, h.db.Error(err)
+		}
+		byId[doctorId].Leaves = append(byId[doctorId].Leaves, &leave)
+	}
+
+	return &response, leaves.Err()
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v4"
 	"go.opentelemetry.io/otel/attribute"
 )
 
@@ -34,23 +35,76 @@ func (p *Dwh) doctorWorkingHoursSelectQueryPrefix() string {
 	return `id,
 			doctor_id,
 			day_of_week,
+			kind,
 			start_time,
 			finish_time,
+			effective_from,
+			effective_to,
 			created_at,
 			updated_at,
 			deleted_at
 		`
 }
 
+// scanDoctorWorkingHours reads a row selected with doctorWorkingHoursSelectQueryPrefix
+func (p *Dwh) scanDoctorWorkingHours(row pgx.Row, in *entity.DoctorWorkingHours) error {
+	var (
+		startTime, finishTime                            time.Time
+		effectiveFrom, effectiveTo, updatedAt, deletedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&in.Id,
+		&in.DoctorId,
+		&in.DayOfWeek,
+		&in.Kind,
+		&startTime,
+		&finishTime,
+		&effectiveFrom,
+		&effectiveTo,
+		&in.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+	); err != nil {
+		return err
+	}
+	in.StartTime = startTime.Format("15:04:05")
+	in.FinishTime = finishTime.Format("15:04:05")
+	in.EffectiveFrom, in.EffectiveTo = "", ""
+	if effectiveFrom.Valid {
+		in.EffectiveFrom = effectiveFrom.Time.Format("2006-01-02")
+	}
+	if effectiveTo.Valid {
+		in.EffectiveTo = effectiveTo.Time.Format("2006-01-02")
+	}
+	if updatedAt.Valid {
+		in.UpdatedAt = updatedAt.Time
+	}
+	if deletedAt.Valid {
+		in.DeletedAt = deletedAt.Time
+	}
+	return nil
+}
+
+// nullDate stores an empty date of the working hours as NULL, an open end of the effective range
+func nullDate(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
 func (p Dwh) CreateDoctorWorkingHours(ctx context.Context, in *entity.DoctorWorkingHours) (*entity.DoctorWorkingHours, error) {
 	ctx, span := otlp.Start(ctx, serviceNameDoctorWorkingHours, serviceNameDoctorWorkingHoursRepoPrefix+"Create")
 	span.SetAttributes(attribute.Key("CreateDoctorWorkingHours").String(string(in.Id)))
 	defer span.End()
 	data := map[string]any{
-		"doctor_id":   in.DoctorId,
-		"day_of_week": in.DayOfWeek,
-		"start_time":  in.StartTime,
-		"finish_time": in.FinishTime,
+		"doctor_id":      in.DoctorId,
+		"day_of_week":    in.DayOfWeek,
+		"kind":           in.Kind,
+		"start_time":     in.StartTime,
+		"finish_time":    in.FinishTime,
+		"effective_from": nullDate(in.EffectiveFrom),
+		"effective_to":   nullDate(in.EffectiveTo),
 	}
 	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).
 		Suffix(fmt.Sprintf("RETURNING %s", p.doctorWorkingHoursSelectQueryPrefix())).ToSql()
@@ -58,28 +112,20 @@ func (p Dwh) CreateDoctorWorkingHours(ctx context.Context, in *entity.DoctorWork
 	if err != nil {
 		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
 	}
-	var updatedAt, deletedAt, startTime, finishTime sql.NullTime
-	err = p.db.QueryRow(ctx, query, args...).Scan(
-		&in.Id,
-		&in.DoctorId,
-		&in.DayOfWeek,
-		&startTime,
-		&finishTime,
-		&in.CreatedAt,
-		&updatedAt,
-		&deletedAt,
-	)
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
-	in.StartTime = startTime.Time.Format("15:04:05")
-	in.FinishTime = finishTime.Time.Format("15:04:05")
+	defer tx.Rollback(ctx)
 
-	if updatedAt.Valid {
-		in.UpdatedAt = updatedAt.Time
+	if err = p.scanDoctorWorkingHours(tx.QueryRow(ctx, query, args...), in); err != nil {
+		return nil, p.db.Error(err)
 	}
-	if deletedAt.Valid {
-		in.DeletedAt = deletedAt.Time
+	if err = writeEvent(ctx, tx, p.tableName, eventCreated, fmt.Sprint(in.Id), in); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
 	}
 	return in, nil
 }
@@ -106,28 +152,8 @@ func (p Dwh) GetDoctorWorkingHoursById(ctx context.Context, in *entity.GetReques
 	if err != nil {
 		return nil, err
 	}
-	var updatedAt, deletedAt, startTime, finishTime sql.NullTime
-	err = p.db.QueryRow(ctx, query, args...).Scan(
-		&doctorWorkingHours.Id,
-		&doctorWorkingHours.DoctorId,
-		&doctorWorkingHours.DayOfWeek,
-		&startTime,
-		&finishTime,
-		&doctorWorkingHours.CreatedAt,
-		&updatedAt,
-		&deletedAt,
-	)
-	doctorWorkingHours.StartTime = startTime.Time.Format("15:04:05")
-	doctorWorkingHours.FinishTime = finishTime.Time.Format("15:04:05")
-
-	if updatedAt.Valid {
-		doctorWorkingHours.UpdatedAt = updatedAt.Time
-	}
-	if deletedAt.Valid {
-		doctorWorkingHours.DeletedAt = deletedAt.Time
-	}
-	if err != nil {
-		return nil, err
+	if err = p.scanDoctorWorkingHours(p.db.QueryRow(ctx, query, args...), &doctorWorkingHours); err != nil {
+		return nil, p.db.Error(err)
 	}
 	return &doctorWorkingHours, nil
 }
@@ -143,10 +169,10 @@ func (p Dwh) GetAllDoctorWorkingHours(ctx context.Context, all *entity.GetAll) (
 
 	queryBuilder := p.db.Sq.Builder.Select(p.doctorWorkingHoursSelectQueryPrefix()).From(p.tableName)
 	if all.Field != "" {
-		queryBuilder = queryBuilder.Where(fmt.Sprintf(`%s ILIKE '%s'`, all.Field, all.Value+"%"))
+		queryBuilder = queryBuilder.Where(translitPrefix(all.Field, all.Value))
 	}
 	if all.OrderBy != "" {
-		queryBuilder = queryBuilder.OrderBy(all.OrderBy)
+		queryBuilder = queryBuilder.OrderBy(translitOrderBy(all.OrderBy))
 	}
 	countBuilder := p.db.Sq.Builder.Select("count(*)").From(p.tableName)
 	if !all.IsActive {
@@ -165,27 +191,7 @@ func (p Dwh) GetAllDoctorWorkingHours(ctx context.Context, all *entity.GetAll) (
 	}
 	for rows.Next() {
 		var Dwhour entity.DoctorWorkingHours
-		var deletedAt, updatedAt, startTime, finishTime sql.NullTime
-		err = rows.Scan(
-			&Dwhour.Id,
-			&Dwhour.DoctorId,
-			&Dwhour.DayOfWeek,
-			&startTime,
-			&finishTime,
-			&Dwhour.CreatedAt,
-			&updatedAt,
-			&deletedAt,
-		)
-
-		Dwhour.StartTime = startTime.Time.Format("15:04:05")
-		Dwhour.FinishTime = finishTime.Time.Format("15:04:05")
-		if updatedAt.Valid {
-			Dwhour.UpdatedAt = updatedAt.Time
-		}
-		if deletedAt.Valid {
-			Dwhour.DeletedAt = deletedAt.Time
-		}
-		if err != nil {
+		if err = p.scanDoctorWorkingHours(rows, &Dwhour); err != nil {
 			return nil, err
 		}
 		doctorWorkHour.DoctorWhs = append(doctorWorkHour.DoctorWhs, Dwhour)
@@ -212,12 +218,15 @@ func (p Dwh) UpdateDoctorWorkingHours(ctx context.Context, in *entity.DoctorWork
 	defer span.End()
 
 	data := map[string]any{
-		"id":          in.Id,
-		"doctor_id":   in.DoctorId,
-		"day_of_week": in.DayOfWeek,
-		"start_time":  in.StartTime,
-		"finish_time": in.FinishTime,
-		"updated_at":  time.Now().Add(time.Hour * 5),
+		"id":             in.Id,
+		"doctor_id":      in.DoctorId,
+		"day_of_week":    in.DayOfWeek,
+		"kind":           in.Kind,
+		"start_time":     in.StartTime,
+		"finish_time":    in.FinishTime,
+		"effective_from": nullDate(in.EffectiveFrom),
+		"effective_to":   nullDate(in.EffectiveTo),
+		"updated_at":     time.Now().Add(time.Hour * 5),
 	}
 	query, args, err := p.db.Sq.Builder.Update(p.tableName).
 		SetMap(data).Where(p.db.Sq.Equal("id", in.Id)).Suffix(fmt.Sprintf("RETURNING %s", p.doctorWorkingHoursSelectQueryPrefix())).ToSql()
@@ -225,28 +234,20 @@ func (p Dwh) UpdateDoctorWorkingHours(ctx context.Context, in *entity.DoctorWork
 	if err != nil {
 		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
 	}
-	var updatedAt, deletedAt, startTime, finishTime sql.NullTime
-	err = p.db.QueryRow(ctx, query, args...).Scan(
-		&in.Id,
-		&in.DoctorId,
-		&in.DayOfWeek,
-		&startTime,
-		&finishTime,
-		&in.CreatedAt,
-		&updatedAt,
-		&deletedAt,
-	)
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
-	in.StartTime = startTime.Time.Format("15:04:05")
-	in.FinishTime = finishTime.Time.Format("15:04:05")
+	defer tx.Rollback(ctx)
 
-	if updatedAt.Valid {
-		in.UpdatedAt = updatedAt.Time
+	if err = p.scanDoctorWorkingHours(tx.QueryRow(ctx, query, args...), in); err != nil {
+		return nil, p.db.Error(err)
 	}
-	if deletedAt.Valid {
-		in.DeletedAt = deletedAt.Time
+	if err = writeEvent(ctx, tx, p.tableName, eventUpdated, fmt.Sprint(in.Id), in); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
 	}
 	return in, nil
 }
@@ -261,29 +262,44 @@ func (p Dwh) DeleteDoctorWorkingHours(ctx context.Context, in *entity.GetReqStr)
 	data := map[string]any{
 		"deleted_at": time.Now().Add(time.Hour * 5),
 	}
+	// the hours have no children and their serial ids can not batch a cascade
+	if !in.IsActive {
+		return softDelete(ctx, p.db, p.tableName, in.Field, in.Value, data)
+	}
+	return hardDelete(ctx, p.db, p.tableName, in.Field, in.Value)
+}
 
-	var args []interface{}
-	var query string
-	var err error
-	if in.IsActive {
-		query, args, err = p.db.Sq.Builder.Delete(p.tableName).From(p.tableName).
-			Where(p.db.Sq.And(p.db.Sq.Equal(in.Field, in.Value))).ToSql()
-		if err != nil {
-			return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
-		}
-	} else {
-		query, args, err = p.db.Sq.Builder.Update(p.tableName).SetMap(data).
-			Where(p.db.Sq.And(p.db.Sq.Equal(in.Field, in.Value), p.db.Sq.Equal("deleted_at", nil))).ToSql()
-		if err != nil {
-			return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
-		}
+// ListDoctorDayWorkingHours returns the not deleted shifts and breaks of a doctor on a weekday,
+// whatever their effective range, ordered by start time
+func (p Dwh) ListDoctorDayWorkingHours(ctx context.Context, in *entity.GetReqDayWorkingHours) ([]entity.DoctorWorkingHours, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorWorkingHours, serviceNameDoctorWorkingHoursRepoPrefix+"List day")
+	span.SetAttributes(attribute.Key("ListDoctorDayWorkingHours").String(in.DoctorId))
+
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.Select(p.doctorWorkingHoursSelectQueryPrefix()).From(p.tableName).
+		Where("deleted_at IS NULL").
+		Where(p.db.Sq.Equal("doctor_id", in.DoctorId)).
+		Where(p.db.Sq.Equal("day_of_week", in.DayOfWeek)).
+		OrderBy("start_time", "id").ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" list day")
 	}
-	resp, err := p.db.Exec(ctx, query, args...)
+
+	rows, err := p.db.Query(ctx, query, args...)
 	if err != nil {
-		return false, p.db.Error(err)
+		return nil, p.db.Error(err)
 	}
-	if resp.RowsAffected() > 0 {
-		return true, nil
+	defer rows.Close()
+
+	var response []entity.DoctorWorkingHours
+	for rows.Next() {
+		var hours entity.DoctorWorkingHours
+		if err = p.scanDoctorWorkingHours(rows, &hours); err != nil {
+			return nil, p.db.Error(err)
+		}
+		response = append(response, hours)
 	}
-	return false, nil
+
+	return response, rows.Err()
 }
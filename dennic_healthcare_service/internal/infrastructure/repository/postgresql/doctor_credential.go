@@ -0,0 +1,341 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	doctorCredentialsTableName        = "doctor_credentials"
+	serviceDoctorCredential           = "doctor_credential"
+	serviceDoctorCredentialRepoPrefix = "doctor_credential"
+)
+
+type DoctorCredential struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewDoctorCredentialRepo(db *postgres.PostgresDB) *DoctorCredential {
+	return &DoctorCredential{
+		tableName: doctorCredentialsTableName,
+		db:        db,
+	}
+}
+
+func (c *DoctorCredential) doctorCredentialSelectQueryPrefix() string {
+	return `id,
+			doctor_id,
+			type,
+			issuing_body,
+			number,
+			issue_date,
+			expiry_date,
+			document,
+			expiry_flagged_at,
+			created_at,
+			updated_at,
+			deleted_at
+		`
+}
+
+func (c *DoctorCredential) scanDoctorCredential(row pgx.Row, in *entity.DoctorCredential) error {
+	var (
+		issueDate                                   time.Time
+		expiryDate, flaggedAt, updatedAt, deletedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&in.Id,
+		&in.DoctorId,
+		&in.Type,
+		&in.IssuingBody,
+		&in.Number,
+		&issueDate,
+		&expiryDate,
+		&in.Document,
+		&flaggedAt,
+		&in.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+	); err != nil {
+		return err
+	}
+	in.IssueDate = issueDate.Format("2006-01-02")
+	in.ExpiryDate = ""
+	if expiryDate.Valid {
+		in.ExpiryDate = expiryDate.Time.Format("2006-01-02")
+	}
+	in.ExpiryFlaggedAt = time.Time{}
+	if flaggedAt.Valid {
+		in.ExpiryFlaggedAt = flaggedAt.Time
+	}
+	if updatedAt.Valid {
+		in.UpdatedAt = updatedAt.Time
+	}
+	if deletedAt.Valid {
+		in.DeletedAt = deletedAt.Time
+	}
+	return nil
+}
+
+// CreateDoctorCredential stores a credential of a not deleted doctor, an unknown doctor is not found
+func (c *DoctorCredential) CreateDoctorCredential(ctx context.Context, in *entity.DoctorCredential) (*entity.DoctorCredential, error) {
+	ctx, span := otlp.Start(ctx, serviceDoctorCredential, serviceDoctorCredentialRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateDoctorCredential").String(in.DoctorId))
+	defer span.End()
+
+	doctor := c.db.Sq.Builder.Select("id", "?", "?", "?", "?::date", "?::date", "?").
+		From("doctors").
+		Where(c.db.Sq.Equal("id", in.DoctorId)).
+		Where("deleted_at IS NULL")
+	query, args, err := c.db.Sq.Builder.Insert(c.tableName).
+		Columns("doctor_id", "type", "issuing_body", "number", "issue_date", "expiry_date", "document").
+		Select(doctor).
+		Suffix(fmt.Sprintf("RETURNING %s", c.doctorCredentialSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, c.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", c.tableName, "create"))
+	}
+	// the values of the select list go before the arguments of its where clause
+	args = append([]any{in.Type, in.IssuingBody, in.Number, in.IssueDate, nullDate(in.ExpiryDate), in.Document}, args...)
+
+	if err = c.scanDoctorCredential(c.db.QueryRow(ctx, query, args...), in); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("doctor")
+		}
+		return nil, c.db.Error(err)
+	}
+	return in, nil
+}
+
+func (c *DoctorCredential) GetDoctorCredential(ctx context.Context, id int64) (*entity.DoctorCredential, error) {
+	ctx, span := otlp.Start(ctx, serviceDoctorCredential, serviceDoctorCredentialRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetDoctorCredential").String(strconv.FormatInt(id, 10)))
+	defer span.End()
+
+	query, args, err := c.db.Sq.Builder.Select(c.doctorCredentialSelectQueryPrefix()).
+		From(c.tableName).
+		Where(c.db.Sq.Equal("id", id)).
+		Where("deleted_at IS NULL").ToSql()
+	if err != nil {
+		return nil, c.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", c.tableName, "get"))
+	}
+
+	var credential entity.DoctorCredential
+	if err = c.scanDoctorCredential(c.db.QueryRow(ctx, query, args...), &credential); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("credential")
+		}
+		return nil, c.db.Error(err)
+	}
+	return &credential, nil
+}
+
+// ListDoctorCredentials returns the not deleted credentials, the soonest expiring first
+func (c *DoctorCredential) ListDoctorCredentials(ctx context.Context, in *entity.ListDoctorCredentialsReq) (*entity.ListDoctorCredentials, error) {
+	ctx, span := otlp.Start(ctx, serviceDoctorCredential, serviceDoctorCredentialRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListDoctorCredentials").String(in.DoctorId))
+	defer span.End()
+
+	queryBuilder := c.db.Sq.Builder.Select(c.doctorCredentialSelectQueryPrefix()).
+		From(c.tableName).
+		Where("deleted_at IS NULL")
+	countBuilder := c.db.Sq.Builder.Select("count(*)").
+		From(c.tableName).
+		Where("deleted_at IS NULL")
+	if in.DoctorId != "" {
+		queryBuilder = queryBuilder.Where(c.db.Sq.Equal("doctor_id", in.DoctorId))
+		countBuilder = countBuilder.Where(c.db.Sq.Equal("doctor_id", in.DoctorId))
+	}
+	if in.Type != "" {
+		queryBuilder = queryBuilder.Where(c.db.Sq.Equal("type", in.Type))
+		countBuilder = countBuilder.Where(c.db.Sq.Equal("type", in.Type))
+	}
+	if in.Expiring {
+		queryBuilder = queryBuilder.Where("expiry_flagged_at IS NOT NULL AND expiry_date >= CURRENT_DATE")
+		countBuilder = countBuilder.Where("expiry_flagged_at IS NOT NULL AND expiry_date >= CURRENT_DATE")
+	}
+	if in.Expired {
+		queryBuilder = queryBuilder.Where(c.db.Sq.Equal("type", entity.CredentialLicense)).Where("expiry_date < CURRENT_DATE")
+		countBuilder = countBuilder.Where(c.db.Sq.Equal("type", entity.CredentialLicense)).Where("expiry_date < CURRENT_DATE")
+	}
+	queryBuilder = queryBuilder.OrderBy("expiry_date NULLS LAST", "id")
+	if in.Limit > 0 {
+		queryBuilder = queryBuilder.Limit(uint64(in.Limit))
+		if in.Page > 1 {
+			queryBuilder = queryBuilder.Offset(uint64(in.Limit * (in.Page - 1)))
+		}
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, c.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", c.tableName, "list"))
+	}
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, c.db.Error(err)
+	}
+	defer rows.Close()
+
+	var response entity.ListDoctorCredentials
+	for rows.Next() {
+		var credential entity.DoctorCredential
+		if err = c.scanDoctorCredential(rows, &credential); err != nil {
+			return nil, c.db.Error(err)
+		}
+		response.Credentials = append(response.Credentials, credential)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, c.db.Error(err)
+	}
+
+	queryCount, argsCount, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, c.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", c.tableName, "count"))
+	}
+	if err = c.db.QueryRow(ctx, queryCount, argsCount...).Scan(&response.Count); err != nil {
+		return nil, c.db.Error(err)
+	}
+
+	return &response, nil
+}
+
+// UpdateDoctorCredential replaces the details of a credential, a new expiry date clears the expiry flag
+func (c *DoctorCredential) UpdateDoctorCredential(ctx context.Context, in *entity.DoctorCredential) (*entity.DoctorCredential, error) {
+	ctx, span := otlp.Start(ctx, serviceDoctorCredential, serviceDoctorCredentialRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateDoctorCredential").String(strconv.FormatInt(in.Id, 10)))
+	defer span.End()
+
+	data := map[string]any{
+		"type":              in.Type,
+		"issuing_body":      in.IssuingBody,
+		"number":            in.Number,
+		"issue_date":        in.IssueDate,
+		"expiry_date":       nullDate(in.ExpiryDate),
+		"document":          in.Document,
+		"expiry_flagged_at": sq.Expr("CASE WHEN expiry_date IS DISTINCT FROM ?::date THEN NULL ELSE expiry_flagged_at END", nullDate(in.ExpiryDate)),
+		"updated_at":        time.Now().Add(time.Hour * 5),
+	}
+	query, args, err := c.db.Sq.Builder.Update(c.tableName).
+		SetMap(data).
+		Where(c.db.Sq.Equal("id", in.Id)).
+		Where("deleted_at IS NULL").
+		Suffix(fmt.Sprintf("RETURNING %s", c.doctorCredentialSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, c.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", c.tableName, "update"))
+	}
+
+	if err = c.scanDoctorCredential(c.db.QueryRow(ctx, query, args...), in); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("credential")
+		}
+		return nil, c.db.Error(err)
+	}
+	return in, nil
+}
+
+func (c *DoctorCredential) DeleteDoctorCredential(ctx context.Context, id int64) (bool, error) {
+	ctx, span := otlp.Start(ctx, serviceDoctorCredential, serviceDoctorCredentialRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteDoctorCredential").String(strconv.FormatInt(id, 10)))
+	defer span.End()
+
+	query, args, err := c.db.Sq.Builder.Update(c.tableName).
+		Set("deleted_at", time.Now().Add(time.Hour*5)).
+		Where(c.db.Sq.Equal("id", id)).
+		Where("deleted_at IS NULL").ToSql()
+	if err != nil {
+		return false, c.db.ErrSQLBuild(err, c.tableName+" delete")
+	}
+
+	resp, err := c.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, c.db.Error(err)
+	}
+	return resp.RowsAffected() > 0, nil
+}
+
+// FlagExpiringLicenses flags the not yet flagged licenses expiring on until "2006-01-02" or earlier
+// and returns them, every license is flagged once per expiry date
+func (c *DoctorCredential) FlagExpiringLicenses(ctx context.Context, until string) ([]entity.DoctorCredential, error) {
+	ctx, span := otlp.Start(ctx, serviceDoctorCredential, serviceDoctorCredentialRepoPrefix+"Flag expiring")
+	span.SetAttributes(attribute.Key("FlagExpiringLicenses").String(until))
+	defer span.End()
+
+	query, args, err := c.db.Sq.Builder.Update(c.tableName).
+		Set("expiry_flagged_at", time.Now().Add(time.Hour*5)).
+		Where(c.db.Sq.Equal("type", entity.CredentialLicense)).
+		Where("deleted_at IS NULL").
+		Where("expiry_flagged_at IS NULL").
+		Where("expiry_date <= ?::date", until).
+		Suffix(fmt.Sprintf("RETURNING %s", c.doctorCredentialSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, c.db.ErrSQLBuild(err, c.tableName+" flag expiring")
+	}
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, c.db.Error(err)
+	}
+	defer rows.Close()
+
+	var response []entity.DoctorCredential
+	for rows.Next() {
+		var credential entity.DoctorCredential
+		if err = c.scanDoctorCredential(rows, &credential); err != nil {
+			return nil, c.db.Error(err)
+		}
+		response = append(response, credential)
+	}
+
+	return response, rows.Err()
+}
+
+func (c *DoctorCredential) GetDoctorLicenseStatus(ctx context.Context, in *entity.DoctorLicenseStatusReq) (*entity.DoctorLicenseStatus, error) {
+	ctx, span := otlp.Start(ctx, serviceDoctorCredential, serviceDoctorCredentialRepoPrefix+"License status")
+	span.SetAttributes(attribute.Key(in.DoctorId).String(in.Date))
+	defer span.End()
+
+	query, args, err := c.db.Sq.Builder.Select(
+		"count(*)",
+		"COALESCE(bool_or(expiry_date IS NULL OR expiry_date >= ?::date), false)",
+		"COALESCE(bool_or(expiry_date IS NULL), false)",
+		"max(expiry_date)",
+	).
+		From(c.tableName).
+		Where(c.db.Sq.Equal("doctor_id", in.DoctorId)).
+		Where(c.db.Sq.Equal("type", entity.CredentialLicense)).
+		Where("deleted_at IS NULL").ToSql()
+	if err != nil {
+		return nil, c.db.ErrSQLBuild(err, c.tableName+" license status")
+	}
+	// the date of the select list goes before the arguments of the where clause
+	args = append([]any{in.Date}, args...)
+
+	var (
+		count          int64
+		valid, forever bool
+		expiryDate     sql.NullTime
+	)
+	if err = c.db.QueryRow(ctx, query, args...).Scan(&count, &valid, &forever, &expiryDate); err != nil {
+		return nil, c.db.Error(err)
+	}
+
+	response := entity.DoctorLicenseStatus{
+		DoctorId:   in.DoctorId,
+		HasLicense: count > 0,
+		Expired:    count > 0 && !valid,
+	}
+	if expiryDate.Valid && !forever {
+		response.ExpiryDate = expiryDate.Time.Format("2006-01-02")
+	}
+	return &response, nil
+}
@@ -0,0 +1,314 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	doctorLeavesTableName        = "doctor_leaves"
+	serviceDoctorLeave           = "doctor_leave"
+	serviceDoctorLeaveRepoPrefix = "doctor_leave"
+)
+
+type DoctorLeave struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewDoctorLeaveRepo(db *postgres.PostgresDB) *DoctorLeave {
+	return &DoctorLeave{
+		tableName: doctorLeavesTableName,
+		db:        db,
+	}
+}
+
+// doctorLeaveSelectQueryPrefix selects from the leaves l joined with the doctors d
+func (l *DoctorLeave) doctorLeaveSelectQueryPrefix() string {
+	return `l.id,
+			l.doctor_id,
+			d.department_id,
+			l.kind,
+			l.start_date,
+			l.end_date,
+			l.reason,
+			l.status,
+			l.reviewed_by,
+			l.review_note,
+			l.reviewed_at,
+			l.created_at,
+			l.updated_at,
+			l.deleted_at
+		`
+}
+
+func (l *DoctorLeave) scanDoctorLeave(row pgx.Row, in *entity.DoctorLeave) error {
+	var (
+		startDate, endDate               time.Time
+		reviewedAt, updatedAt, deletedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&in.Id,
+		&in.DoctorId,
+		&in.DepartmentId,
+		&in.Kind,
+		&startDate,
+		&endDate,
+		&in.Reason,
+		&in.Status,
+		&in.ReviewedBy,
+		&in.ReviewNote,
+		&reviewedAt,
+		&in.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+	); err != nil {
+		return err
+	}
+	in.StartDate = startDate.Format("2006-01-02")
+	in.EndDate = endDate.Format("2006-01-02")
+	if reviewedAt.Valid {
+		in.ReviewedAt = reviewedAt.Time
+	}
+	if updatedAt.Valid {
+		in.UpdatedAt = updatedAt.Time
+	}
+	if deletedAt.Valid {
+		in.DeletedAt = deletedAt.Time
+	}
+	return nil
+}
+
+// CreateDoctorLeave stores a pending leave of a not deleted doctor, an unknown doctor is not found
+func (l *DoctorLeave) CreateDoctorLeave(ctx context.Context, in *entity.DoctorLeave) (*entity.DoctorLeave, error) {
+	ctx, span := otlp.Start(ctx, serviceDoctorLeave, serviceDoctorLeaveRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateDoctorLeave").String(in.DoctorId))
+	defer span.End()
+
+	doctor := l.db.Sq.Builder.Select("id", "?", "?::date", "?::date", "?").
+		From("doctors").
+		Where(l.db.Sq.Equal("id", in.DoctorId)).
+		Where("deleted_at IS NULL")
+	query, args, err := l.db.Sq.Builder.Insert(l.tableName).
+		Columns("doctor_id", "kind", "start_date", "end_date", "reason").
+		Select(doctor).
+		Suffix("RETURNING id").ToSql()
+	if err != nil {
+		return nil, l.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", l.tableName, "create"))
+	}
+	// the values of the select list go before the arguments of its where clause
+	args = append([]any{in.Kind, in.StartDate, in.EndDate, in.Reason}, args...)
+
+	var id int64
+	if err = l.db.QueryRow(ctx, query, args...).Scan(&id); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("doctor")
+		}
+		return nil, l.db.Error(err)
+	}
+
+	return l.GetDoctorLeave(ctx, id)
+}
+
+func (l *DoctorLeave) GetDoctorLeave(ctx context.Context, id int64) (*entity.DoctorLeave, error) {
+	ctx, span := otlp.Start(ctx, serviceDoctorLeave, serviceDoctorLeaveRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetDoctorLeave").String(strconv.FormatInt(id, 10)))
+	defer span.End()
+
+	query, args, err := l.db.Sq.Builder.Select(l.doctorLeaveSelectQueryPrefix()).
+		From(l.tableName + " l").
+		Join("doctors d ON d.id = l.doctor_id").
+		Where(l.db.Sq.Equal("l.id", id)).
+		Where("l.deleted_at IS NULL").ToSql()
+	if err != nil {
+		return nil, l.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", l.tableName, "get"))
+	}
+
+	var leave entity.DoctorLeave
+	if err = l.scanDoctorLeave(l.db.QueryRow(ctx, query, args...), &leave); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("leave")
+		}
+		return nil, l.db.Error(err)
+	}
+	return &leave, nil
+}
+
+// ListDoctorLeaves returns the not deleted leaves, the latest first
+func (l *DoctorLeave) ListDoctorLeaves(ctx context.Context, in *entity.ListDoctorLeavesReq) (*entity.ListDoctorLeaves, error) {
+	ctx, span := otlp.Start(ctx, serviceDoctorLeave, serviceDoctorLeaveRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListDoctorLeaves").String(in.DoctorId))
+	defer span.End()
+
+	queryBuilder := l.db.Sq.Builder.Select(l.doctorLeaveSelectQueryPrefix()).
+		From(l.tableName + " l").
+		Join("doctors d ON d.id = l.doctor_id").
+		Where("l.deleted_at IS NULL")
+	countBuilder := l.db.Sq.Builder.Select("count(*)").
+		From(l.tableName + " l").
+		Where("l.deleted_at IS NULL")
+	if in.DoctorId != "" {
+		queryBuilder = queryBuilder.Where(l.db.Sq.Equal("l.doctor_id", in.DoctorId))
+		countBuilder = countBuilder.Where(l.db.Sq.Equal("l.doctor_id", in.DoctorId))
+	}
+	if in.Status != "" {
+		queryBuilder = queryBuilder.Where(l.db.Sq.Equal("l.status", in.Status))
+		countBuilder = countBuilder.Where(l.db.Sq.Equal("l.status", in.Status))
+	}
+	if in.From != "" {
+		queryBuilder = queryBuilder.Where("l.end_date >= ?::date", in.From)
+		countBuilder = countBuilder.Where("l.end_date >= ?::date", in.From)
+	}
+	if in.To != "" {
+		queryBuilder = queryBuilder.Where("l.start_date <= ?::date", in.To)
+		countBuilder = countBuilder.Where("l.start_date <= ?::date", in.To)
+	}
+	queryBuilder = queryBuilder.OrderBy("l.start_date DESC", "l.id DESC")
+	if in.Limit > 0 {
+		queryBuilder = queryBuilder.Limit(uint64(in.Limit))
+		if in.Page > 1 {
+			queryBuilder = queryBuilder.Offset(uint64(in.Limit * (in.Page - 1)))
+		}
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, l.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", l.tableName, "list"))
+	}
+	rows, err := l.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, l.db.Error(err)
+	}
+	defer rows.Close()
+
+	var response entity.ListDoctorLeaves
+	for rows.Next() {
+		var leave entity.DoctorLeave
+		if err = l.scanDoctorLeave(rows, &leave); err != nil {
+			return nil, l.db.Error(err)
+		}
+		response.Leaves = append(response.Leaves, leave)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, l.db.Error(err)
+	}
+
+	queryCount, argsCount, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, l.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", l.tableName, "count"))
+	}
+	if err = l.db.QueryRow(ctx, queryCount, argsCount...).Scan(&response.Count); err != nil {
+		return nil, l.db.Error(err)
+	}
+
+	return &response, nil
+}
+
+// SetDoctorLeaveStatus moves a leave to in.Status when its current status is one of from,
+// the reviewer is recorded when the leave is approved or rejected
+func (l *DoctorLeave) SetDoctorLeaveStatus(ctx context.Context, in *entity.ReviewDoctorLeaveReq, from ...string) (*entity.DoctorLeave, error) {
+	ctx, span := otlp.Start(ctx, serviceDoctorLeave, serviceDoctorLeaveRepoPrefix+"Set status")
+	span.SetAttributes(attribute.Key(in.Status).String(strconv.FormatInt(in.Id, 10)))
+	defer span.End()
+
+	now := time.Now().Add(time.Hour * 5)
+	data := map[string]any{
+		"status":     in.Status,
+		"updated_at": now,
+	}
+	if in.Status == entity.LeaveApproved || in.Status == entity.LeaveRejected {
+		data["reviewed_by"] = in.ReviewedBy
+		data["review_note"] = in.ReviewNote
+		data["reviewed_at"] = now
+	}
+	query, args, err := l.db.Sq.Builder.Update(l.tableName).
+		SetMap(data).
+		Where(l.db.Sq.Equal("id", in.Id)).
+		Where(l.db.Sq.Equal("status", from)).
+		Where("deleted_at IS NULL").
+		Suffix("RETURNING id").ToSql()
+	if err != nil {
+		return nil, l.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", l.tableName, "set status"))
+	}
+
+	var id int64
+	if err = l.db.QueryRow(ctx, query, args...).Scan(&id); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("leave")
+		}
+		return nil, l.db.Error(err)
+	}
+
+	return l.GetDoctorLeave(ctx, id)
+}
+
+// ListAwayDoctors returns the not deleted doctors on an approved leave covering in.Date
+func (l *DoctorLeave) ListAwayDoctors(ctx context.Context, in *entity.AwayDoctorsReq) (*entity.ListAwayDoctors, error) {
+	ctx, span := otlp.Start(ctx, serviceDoctorLeave, serviceDoctorLeaveRepoPrefix+"List away")
+	span.SetAttributes(attribute.Key(in.Date).String(in.DepartmentId))
+	defer span.End()
+
+	queryBuilder := l.db.Sq.Builder.Select(
+		"d.id",
+		"d.first_name",
+		"d.last_name",
+		"d.department_id",
+		"l.id",
+		"l.kind",
+		"l.start_date",
+		"l.end_date",
+	).
+		From(l.tableName+" l").
+		Join("doctors d ON d.id = l.doctor_id").
+		Where(l.db.Sq.Equal("l.status", entity.LeaveApproved)).
+		Where("l.deleted_at IS NULL").
+		Where("d.deleted_at IS NULL").
+		Where("l.start_date <= ?::date AND ?::date <= l.end_date", in.Date, in.Date)
+	if in.DepartmentId != "" {
+		queryBuilder = queryBuilder.Where(l.db.Sq.Equal("d.department_id", in.DepartmentId))
+	}
+
+	query, args, err := queryBuilder.OrderBy("d.department_id", "d.last_name", "d.first_name", "l.id").ToSql()
+	if err != nil {
+		return nil, l.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", l.tableName, "list away"))
+	}
+	rows, err := l.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, l.db.Error(err)
+	}
+	defer rows.Close()
+
+	var response entity.ListAwayDoctors
+	for rows.Next() {
+		var (
+			doctor             entity.AwayDoctor
+			startDate, endDate time.Time
+		)
+		if err = rows.Scan(
+			&doctor.DoctorId,
+			&doctor.FirstName,
+			&doctor.LastName,
+			&doctor.DepartmentId,
+			&doctor.LeaveId,
+			&doctor.Kind,
+			&startDate,
+			&endDate,
+		); err != nil {
+			return nil, l.db.Error(err)
+		}
+		doctor.StartDate = startDate.Format("2006-01-02")
+		doctor.EndDate = endDate.Format("2006-01-02")
+		response.Doctors = append(response.Doctors, doctor)
+	}
+
+	return &response, rows.Err()
+}
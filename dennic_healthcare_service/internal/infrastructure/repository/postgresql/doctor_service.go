@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"github.com/jackc/pgx/v4"
 	"go.opentelemetry.io/otel/attribute"
 	"time"
 )
@@ -41,7 +42,8 @@ func (d Ds) doctorServicesSelectQueryPrefix() string {
 			duration,
 			created_at,
 			updated_at,
-			deleted_at
+			deleted_at,
+			required_resource_type
 		`
 }
 
@@ -52,13 +54,14 @@ func (d Ds) CreateDoctorServices(ctx context.Context, in *entity.DoctorServices)
 	defer span.End()
 
 	data := map[string]any{
-		"id":                in.Id,
-		"doctor_id":         in.DoctorId,
-		"specialization_id": in.SpecializationId,
-		"online_price":      in.OnlinePrice,
-		"offline_price":     in.OfflinePrice,
-		"name":              in.Name,
-		"duration":          in.Duration,
+		"id":                     in.Id,
+		"doctor_id":              in.DoctorId,
+		"specialization_id":      in.SpecializationId,
+		"online_price":           in.OnlinePrice,
+		"offline_price":          in.OfflinePrice,
+		"name":                   in.Name,
+		"duration":               in.Duration,
+		"required_resource_type": in.RequiredResourceType,
 	}
 	query, args, err := d.db.Sq.Builder.Insert(d.tableName).
 		SetMap(data).Suffix(fmt.Sprintf("RETURNING %s", d.doctorServicesSelectQueryPrefix())).ToSql()
@@ -66,8 +69,15 @@ func (d Ds) CreateDoctorServices(ctx context.Context, in *entity.DoctorServices)
 	if err != nil {
 		return nil, d.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", d.tableName, "create"))
 	}
+
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return nil, d.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
 	var updatedAt, deletedAt sql.NullTime
-	err = d.db.QueryRow(ctx, query, args...).Scan(
+	err = tx.QueryRow(ctx, query, args...).Scan(
 		&in.Id,
 		&in.Order,
 		&in.DoctorId,
@@ -79,6 +89,7 @@ func (d Ds) CreateDoctorServices(ctx context.Context, in *entity.DoctorServices)
 		&in.CreatedAt,
 		&updatedAt,
 		&deletedAt,
+		&in.RequiredResourceType,
 	)
 	if updatedAt.Valid {
 		in.UpdatedAt = updatedAt.Time
@@ -90,6 +101,16 @@ func (d Ds) CreateDoctorServices(ctx context.Context, in *entity.DoctorServices)
 	if err != nil {
 		return nil, d.db.Error(err)
 	}
+	if err = writeEvent(ctx, tx, d.tableName, eventCreated, in.Id, in); err != nil {
+		return nil, d.db.Error(err)
+	}
+	// the first prices open the price history of the service
+	if err = recordPrice(ctx, tx, in.Id, in.OnlinePrice, in.OfflinePrice, time.Now().Add(time.Hour*5)); err != nil {
+		return nil, d.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, d.db.Error(err)
+	}
 	return in, nil
 }
 
@@ -122,7 +143,11 @@ func (d Ds) GetDoctorServiceByID(ctx context.Context, in *entity.GetReqStr) (*en
 		&doctorService.CreatedAt,
 		&updatedAt,
 		&deletedAt,
+		&doctorService.RequiredResourceType,
 	)
+	if err == pgx.ErrNoRows {
+		return nil, entity.NewErrNotFound("doctor service")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -145,10 +170,10 @@ func (d Ds) GetAllDoctorServices(ctx context.Context, all *entity.GetAll) (*enti
 
 	queryBuilder := d.db.Sq.Builder.Select(d.doctorServicesSelectQueryPrefix()).From(d.tableName)
 	if all.Field != "" {
-		queryBuilder = queryBuilder.Where(fmt.Sprintf(`%s ILIKE %s`, all.Field, all.Value+"%"))
+		queryBuilder = queryBuilder.Where(translitPrefix(all.Field, all.Value))
 	}
 	if all.OrderBy != "" {
-		queryBuilder = queryBuilder.OrderBy(all.OrderBy)
+		queryBuilder = queryBuilder.OrderBy(translitOrderBy(all.OrderBy))
 	}
 	countBuilder := d.db.Sq.Builder.Select("count(*)").From(d.tableName)
 	if !all.IsActive {
@@ -180,6 +205,7 @@ func (d Ds) GetAllDoctorServices(ctx context.Context, all *entity.GetAll) (*enti
 			&dService.CreatedAt,
 			&updatedAt,
 			&deletedAt,
+			&dService.RequiredResourceType,
 		)
 		if updatedAt.Valid {
 			dService.UpdatedAt = updatedAt.Time
@@ -212,14 +238,16 @@ func (d Ds) UpdateDoctorServices(ctx context.Context, services *entity.DoctorSer
 	span.SetAttributes(attribute.Key("UpdateDoctorServices").String(services.Id))
 	defer span.End()
 
+	now := time.Now().Add(time.Hour * 5)
 	data := map[string]any{
-		"doctor_id":         services.DoctorId,
-		"specialization_id": services.SpecializationId,
-		"online_price":      services.OnlinePrice,
-		"offline_price":     services.OfflinePrice,
-		"name":              services.Name,
-		"duration":          services.Duration,
-		"updated_at":        time.Now().Add(time.Hour * 5),
+		"doctor_id":              services.DoctorId,
+		"specialization_id":      services.SpecializationId,
+		"online_price":           services.OnlinePrice,
+		"offline_price":          services.OfflinePrice,
+		"name":                   services.Name,
+		"duration":               services.Duration,
+		"required_resource_type": services.RequiredResourceType,
+		"updated_at":             now,
 	}
 	query, args, err := d.db.Sq.Builder.Update(d.tableName).
 		SetMap(data).Where(d.db.Sq.Equal("id", services.Id)).
@@ -228,8 +256,15 @@ func (d Ds) UpdateDoctorServices(ctx context.Context, services *entity.DoctorSer
 	if err != nil {
 		return nil, d.db.ErrSQLBuild(err, d.tableName+" update")
 	}
+
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return nil, d.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
 	var deletedAt, updatedAt sql.NullTime
-	err = d.db.QueryRow(ctx, query, args...).Scan(
+	err = tx.QueryRow(ctx, query, args...).Scan(
 		&services.Id,
 		&services.Order,
 		&services.DoctorId,
@@ -241,6 +276,7 @@ func (d Ds) UpdateDoctorServices(ctx context.Context, services *entity.DoctorSer
 		&services.CreatedAt,
 		&updatedAt,
 		&deletedAt,
+		&services.RequiredResourceType,
 	)
 	if updatedAt.Valid {
 		services.UpdatedAt = updatedAt.Time
@@ -251,6 +287,16 @@ func (d Ds) UpdateDoctorServices(ctx context.Context, services *entity.DoctorSer
 	if err != nil {
 		return nil, d.db.Error(err)
 	}
+	if err = writeEvent(ctx, tx, d.tableName, eventUpdated, services.Id, services); err != nil {
+		return nil, d.db.Error(err)
+	}
+	// changed prices take effect now and the previous ones stay in the price history
+	if err = recordPrice(ctx, tx, services.Id, services.OnlinePrice, services.OfflinePrice, now); err != nil {
+		return nil, d.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, d.db.Error(err)
+	}
 	return services, nil
 }
 
@@ -264,28 +310,23 @@ func (d Ds) DeleteDoctorService(ctx context.Context, in *entity.GetReqStr) (bool
 		"deleted_at": time.Now().Add(time.Hour * 5),
 	}
 
-	var args []interface{}
-	var query string
-	var err error
-	if in.IsActive {
-		query, args, err = d.db.Sq.Builder.Delete(d.tableName).From(d.tableName).
-			Where(d.db.Sq.And(d.db.Sq.Equal(in.Field, in.Value))).ToSql()
-		if err != nil {
-			return false, d.db.ErrSQLBuild(err, d.tableName+" delete")
-		}
-	} else {
-		query, args, err = d.db.Sq.Builder.Update(d.tableName).SetMap(data).
-			Where(d.db.Sq.And(d.db.Sq.Equal(in.Field, in.Value), d.db.Sq.Equal("deleted_at", nil))).ToSql()
-		if err != nil {
-			return false, d.db.ErrSQLBuild(err, d.tableName+" delete")
-		}
-	}
-	resp, err := d.db.Exec(ctx, query, args...)
-	if err != nil {
-		return false, d.db.Error(err)
+	// a soft delete cascades to the rows referencing the deleted one
+	if !in.IsActive {
+		return softDeleteCascade(ctx, d.db, d.tableName, in.Field, in.Value, data)
 	}
-	if resp.RowsAffected() > 0 {
-		return true, nil
+
+	return hardDelete(ctx, d.db, d.tableName, in.Field, in.Value)
+}
+
+// RestoreDoctorService restores the soft deleted doctor service together with the rows its delete cascaded to
+func (d Ds) RestoreDoctorService(ctx context.Context, id string) (bool, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorServices, serviceNameDoctorServicesRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreDoctorService").String(id))
+	defer span.End()
+
+	data := map[string]any{
+		"updated_at": time.Now().Add(time.Hour * 5),
 	}
-	return false, nil
+
+	return restoreCascade(ctx, d.db, d.tableName, id, data)
 }
@@ -0,0 +1,222 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	outboxTableName             = "outbox_events"
+	serviceNameOutbox           = "outbox"
+	serviceNameOutboxRepoPrefix = "outbox"
+)
+
+const (
+	eventCreated  = "created"
+	eventUpdated  = "updated"
+	eventDeleted  = "deleted"
+	eventRestored = "restored"
+)
+
+// eventEntities names the entity of the catalogue tables in their event types, the changes of the other tables
+// publish no events
+var eventEntities = map[string]string{
+	departmentTableName:         "department",
+	doctorTableName:             "doctor",
+	specTableName:               "specialization",
+	doctorServicesTableName:     "doctor_service",
+	reasonsTableName:            "reason",
+	doctorWorkingHoursTableName: "working_hours",
+	translationsTableName:       "translation",
+}
+
+// writeEvent writes the healthcare.<entity>.<kind> event of the row id of the table to the outbox in the
+// transaction of the change, so the event is published only when the change is committed
+func writeEvent(ctx context.Context, tx pgx.Tx, table, kind, id string, payload any) error {
+	name, ok := eventEntities[table]
+	if !ok {
+		return nil
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`INSERT INTO %s (event_type, aggregate_id, payload) VALUES ($1, $2, $3)`, outboxTableName)
+	_, err = tx.Exec(ctx, query, fmt.Sprintf("healthcare.%s.%s", name, kind), id, body)
+	return err
+}
+
+// writeIdEvents writes the events of the rows without a record at hand, their payload is the id alone
+func writeIdEvents(ctx context.Context, tx pgx.Tx, table, kind string, ids []string) error {
+	for _, id := range ids {
+		if err := writeEvent(ctx, tx, table, kind, id, map[string]string{"id": id}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hardDelete deletes the rows of the table where field equals value writing their deleted events
+func hardDelete(ctx context.Context, db *postgres.PostgresDB, table, field, value string) (bool, error) {
+	query, args, err := db.Sq.Builder.Delete(table).
+		Where(db.Sq.Equal(field, value)).
+		Suffix("RETURNING id::text").ToSql()
+	if err != nil {
+		return false, db.ErrSQLBuild(err, table+" delete")
+	}
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return false, db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	ids, err := queryIds(ctx, tx, query, args...)
+	if err != nil {
+		return false, db.Error(err)
+	}
+	if err = writeIdEvents(ctx, tx, table, eventDeleted, ids); err != nil {
+		return false, db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return false, db.Error(err)
+	}
+	return len(ids) > 0, nil
+}
+
+// softDelete soft deletes the not deleted rows of the table where field equals value setting data and writing
+// their deleted events, for the rows no other row of the graph references, which need no cascade nor batch
+func softDelete(ctx context.Context, db *postgres.PostgresDB, table, field, value string, data map[string]any) (bool, error) {
+	query, args, err := db.Sq.Builder.Update(table).SetMap(data).
+		Where(db.Sq.And(db.Sq.Equal(field, value), db.Sq.Equal("deleted_at", nil))).
+		Suffix("RETURNING id::text").ToSql()
+	if err != nil {
+		return false, db.ErrSQLBuild(err, table+" delete")
+	}
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return false, db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	ids, err := queryIds(ctx, tx, query, args...)
+	if err != nil {
+		return false, db.Error(err)
+	}
+	if err = writeIdEvents(ctx, tx, table, eventDeleted, ids); err != nil {
+		return false, db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return false, db.Error(err)
+	}
+	return len(ids) > 0, nil
+}
+
+type Outbox struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewOutboxRepo(db *postgres.PostgresDB) *Outbox {
+	return &Outbox{
+		tableName: outboxTableName,
+		db:        db,
+	}
+}
+
+// RelayEvents hands at most limit of the oldest unpublished events to publish and marks them published when it
+// succeeds, a failure is recorded on the events which are handed again on the next relay. The events stay locked
+// while they are published, so several relays share the outbox without publishing an event twice
+func (o *Outbox) RelayEvents(ctx context.Context, limit uint64, publish func(ctx context.Context, events []entity.OutboxEvent) error) (int, error) {
+	ctx, span := otlp.Start(ctx, serviceNameOutbox, serviceNameOutboxRepoPrefix+"Relay")
+	span.SetAttributes(attribute.Key("RelayEvents").String(strconv.FormatUint(limit, 10)))
+	defer span.End()
+
+	query, args, err := o.db.Sq.Builder.Select("id", "event_type", "aggregate_id", "payload", "created_at").
+		From(o.tableName).
+		Where(o.db.Sq.Equal("published_at", nil)).
+		OrderBy("id").Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").ToSql()
+	if err != nil {
+		return 0, o.db.ErrSQLBuild(err, o.tableName+" relay")
+	}
+
+	tx, err := o.db.Begin(ctx)
+	if err != nil {
+		return 0, o.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return 0, o.db.Error(err)
+	}
+	var (
+		events []entity.OutboxEvent
+		ids    []int64
+	)
+	for rows.Next() {
+		var event entity.OutboxEvent
+		if err = rows.Scan(&event.Id, &event.Type, &event.AggregateId, &event.Payload, &event.CreatedAt); err != nil {
+			rows.Close()
+			return 0, o.db.Error(err)
+		}
+		events = append(events, event)
+		ids = append(ids, event.Id)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, o.db.Error(err)
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	if publishErr := publish(ctx, events); publishErr != nil {
+		query = fmt.Sprintf(`UPDATE %s SET attempts = attempts + 1, last_error = $1 WHERE id = ANY($2)`, o.tableName)
+		if _, err = tx.Exec(ctx, query, publishErr.Error(), ids); err != nil {
+			return 0, o.db.Error(err)
+		}
+		if err = tx.Commit(ctx); err != nil {
+			return 0, o.db.Error(err)
+		}
+		return 0, publishErr
+	}
+
+	query = fmt.Sprintf(`UPDATE %s SET published_at = $1, last_error = NULL WHERE id = ANY($2)`, o.tableName)
+	if _, err = tx.Exec(ctx, query, time.Now().Add(time.Hour*5), ids); err != nil {
+		return 0, o.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return 0, o.db.Error(err)
+	}
+	return len(events), nil
+}
+
+// PurgePublished deletes the events published before the moment
+func (o *Outbox) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := otlp.Start(ctx, serviceNameOutbox, serviceNameOutboxRepoPrefix+"Purge")
+	span.SetAttributes(attribute.Key("PurgePublished").String(before.String()))
+	defer span.End()
+
+	query, args, err := o.db.Sq.Builder.Delete(o.tableName).
+		Where("published_at < ?", before).ToSql()
+	if err != nil {
+		return 0, o.db.ErrSQLBuild(err, o.tableName+" purge")
+	}
+	resp, err := o.db.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, o.db.Error(err)
+	}
+	return resp.RowsAffected(), nil
+}
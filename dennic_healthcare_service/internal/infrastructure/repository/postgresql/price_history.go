@@ -0,0 +1,239 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	doctorServicePricesTableName  = "doctor_service_prices"
+	servicePriceHistory           = "priceHistory"
+	servicePriceHistoryRepoPrefix = "priceHistory"
+)
+
+type PriceHistory struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewPriceHistoryRepo(db *postgres.PostgresDB) *PriceHistory {
+	return &PriceHistory{
+		tableName: doctorServicePricesTableName,
+		db:        db,
+	}
+}
+
+func (p *PriceHistory) priceChangeSelectQueryPrefix() string {
+	return `id,
+			doctor_service_id,
+			online_price,
+			offline_price,
+			effective_from,
+			created_at
+		`
+}
+
+func (p *PriceHistory) scanPriceChange(row pgx.Row, in *entity.PriceChange) error {
+	return row.Scan(
+		&in.Id,
+		&in.DoctorServiceId,
+		&in.OnlinePrice,
+		&in.OfflinePrice,
+		&in.EffectiveFrom,
+		&in.CreatedAt,
+	)
+}
+
+// recordPrice stores the prices of the doctor service effective at now unless they already are the prices valid then,
+// it runs in the transaction changing the prices of the service
+func recordPrice(ctx context.Context, tx pgx.Tx, doctorServiceId string, onlinePrice, offlinePrice float32, now time.Time) error {
+	query := fmt.Sprintf(`INSERT INTO %[1]s (id, doctor_service_id, online_price, offline_price, effective_from)
+		SELECT $1::uuid, $2::uuid, $3::float8, $4::float8, $5::timestamp
+		WHERE NOT EXISTS (
+			SELECT 1
+			FROM (
+				SELECT online_price, offline_price
+				FROM %[1]s
+				WHERE doctor_service_id = $2::uuid AND effective_from <= $5::timestamp
+				ORDER BY effective_from DESC
+				LIMIT 1
+			) AS valid
+			WHERE valid.online_price = $3::float8 AND valid.offline_price = $4::float8
+		)
+		ON CONFLICT (doctor_service_id, effective_from) DO UPDATE SET
+			online_price = EXCLUDED.online_price,
+			offline_price = EXCLUDED.offline_price`, doctorServicePricesTableName)
+	_, err := tx.Exec(ctx, query, uuid.NewString(), doctorServiceId, onlinePrice, offlinePrice, now)
+	return err
+}
+
+func (p *PriceHistory) CreatePriceChange(ctx context.Context, in *entity.PriceChange) (*entity.PriceChange, error) {
+	ctx, span := otlp.Start(ctx, servicePriceHistory, servicePriceHistoryRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreatePriceChange").String(in.Id))
+	defer span.End()
+
+	data := map[string]any{
+		"id":                in.Id,
+		"doctor_service_id": in.DoctorServiceId,
+		"online_price":      in.OnlinePrice,
+		"offline_price":     in.OfflinePrice,
+		"effective_from":    in.EffectiveFrom,
+	}
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).
+		SetMap(data).
+		Suffix(fmt.Sprintf("RETURNING %s", p.priceChangeSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
+	}
+
+	if err = p.scanPriceChange(p.db.QueryRow(ctx, query, args...), in); err != nil {
+		return nil, p.db.Error(err)
+	}
+	return in, nil
+}
+
+// ListPriceHistory lists the prices of the doctor service newest first, the scheduled changes included
+func (p *PriceHistory) ListPriceHistory(ctx context.Context, in *entity.PriceHistoryReq) (*entity.PriceHistory, error) {
+	ctx, span := otlp.Start(ctx, servicePriceHistory, servicePriceHistoryRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListPriceHistory").String(in.DoctorServiceId))
+	defer span.End()
+
+	queryBuilder := p.db.Sq.Builder.Select(p.priceChangeSelectQueryPrefix()).
+		From(p.tableName).
+		Where(p.db.Sq.Equal("doctor_service_id", in.DoctorServiceId)).
+		OrderBy("effective_from DESC")
+	if in.Limit > 0 {
+		queryBuilder = queryBuilder.Limit(uint64(in.Limit))
+		if in.Page > 1 {
+			queryBuilder = queryBuilder.Offset(uint64(in.Limit * (in.Page - 1)))
+		}
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "list"))
+	}
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var response entity.PriceHistory
+	for rows.Next() {
+		var priceChange entity.PriceChange
+		if err = p.scanPriceChange(rows, &priceChange); err != nil {
+			return nil, p.db.Error(err)
+		}
+		response.PriceChanges = append(response.PriceChanges, priceChange)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	queryCount, argsCount, err := p.db.Sq.Builder.Select("count(*)").
+		From(p.tableName).
+		Where(p.db.Sq.Equal("doctor_service_id", in.DoctorServiceId)).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "count"))
+	}
+	if err = p.db.QueryRow(ctx, queryCount, argsCount...).Scan(&response.Count); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &response, nil
+}
+
+// GetPriceAt returns the price of the doctor service taking effect last before the moment
+func (p *PriceHistory) GetPriceAt(ctx context.Context, doctorServiceId string, at time.Time) (*entity.PriceChange, error) {
+	ctx, span := otlp.Start(ctx, servicePriceHistory, servicePriceHistoryRepoPrefix+"Get at")
+	span.SetAttributes(attribute.Key("doctor_service_id").String(doctorServiceId))
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.Select(p.priceChangeSelectQueryPrefix()).
+		From(p.tableName).
+		Where(p.db.Sq.Equal("doctor_service_id", doctorServiceId)).
+		Where("effective_from <= ?", at).
+		OrderBy("effective_from DESC").
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get at"))
+	}
+
+	var priceChange entity.PriceChange
+	if err = p.scanPriceChange(p.db.QueryRow(ctx, query, args...), &priceChange); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("price")
+		}
+		return nil, p.db.Error(err)
+	}
+	return &priceChange, nil
+}
+
+// DeleteScheduledPriceChange deletes a price change not yet in effect, the prices already charged stay in the history
+func (p *PriceHistory) DeleteScheduledPriceChange(ctx context.Context, id string, now time.Time) (bool, error) {
+	ctx, span := otlp.Start(ctx, servicePriceHistory, servicePriceHistoryRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteScheduledPriceChange").String(id))
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.Delete(p.tableName).
+		Where(p.db.Sq.Equal("id", id)).
+		Where("effective_from > ?", now).ToSql()
+	if err != nil {
+		return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
+	}
+
+	resp, err := p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, p.db.Error(err)
+	}
+	return resp.RowsAffected() > 0, nil
+}
+
+// ApplyDuePrices copies to the not deleted doctor services the prices which took effect by now,
+// it returns the number of services whose prices changed
+func (p *PriceHistory) ApplyDuePrices(ctx context.Context, now time.Time) (int64, error) {
+	ctx, span := otlp.Start(ctx, servicePriceHistory, servicePriceHistoryRepoPrefix+"Apply")
+	defer span.End()
+
+	query := fmt.Sprintf(`UPDATE %[1]s AS ds SET
+			online_price = valid.online_price,
+			offline_price = valid.offline_price,
+			updated_at = $1
+		FROM (
+			SELECT DISTINCT ON (doctor_service_id) doctor_service_id, online_price, offline_price
+			FROM %[2]s
+			WHERE effective_from <= $1
+			ORDER BY doctor_service_id, effective_from DESC
+		) AS valid
+		WHERE ds.id = valid.doctor_service_id
+		  AND ds.deleted_at IS NULL
+		  AND (ds.online_price <> valid.online_price OR ds.offline_price <> valid.offline_price)
+		RETURNING ds.id::text`, doctorServicesTableName, p.tableName)
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	ids, err := queryIds(ctx, tx, query, now)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	if err = writeIdEvents(ctx, tx, doctorServicesTableName, eventUpdated, ids); err != nil {
+		return 0, p.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return 0, p.db.Error(err)
+	}
+	return int64(len(ids)), nil
+}
@@ -57,7 +57,13 @@ func (r Reasons) CreateReasons(ctx context.Context, in *entity.Reasons) (*entity
 		return nil, r.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", r.tableName, "create"))
 	}
 	var updatedAt, deletedAt sql.NullTime
-	err = r.db.QueryRow(ctx, query, args...).Scan(
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, query, args...).Scan(
 		&in.Id,
 		&in.Name,
 		&in.SpecializationId,
@@ -70,6 +76,12 @@ func (r Reasons) CreateReasons(ctx context.Context, in *entity.Reasons) (*entity
 	if err != nil {
 		return nil, r.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", r.tableName, "create"))
 	}
+	if err = writeEvent(ctx, tx, r.tableName, eventCreated, in.Id, in); err != nil {
+		return nil, r.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
+	}
 	return in, nil
 }
 
@@ -120,7 +132,7 @@ func (r *Reasons) GetAllReasons(ctx context.Context, reas *entity.GetAllReas) (*
 
 	queryBuilder := r.db.Sq.Builder.Select(r.reasonsSelectQueryPrefix()).From(r.tableName)
 	if reas.Field != "" {
-		queryBuilder = queryBuilder.Where(fmt.Sprintf(`%s ILIKE '%s'`, reas.Field, reas.Value+"%"))
+		queryBuilder = queryBuilder.Where(translitPrefix(reas.Field, reas.Value))
 	}
 	countBuilder := r.db.Sq.Builder.Select("count(*)").From(r.tableName)
 	if !reas.IsActive {
@@ -128,7 +140,7 @@ func (r *Reasons) GetAllReasons(ctx context.Context, reas *entity.GetAllReas) (*
 		countBuilder = countBuilder.Where("deleted_at IS NULL")
 	}
 	if reas.OrderBy != "" {
-		queryBuilder = queryBuilder.OrderBy(reas.OrderBy)
+		queryBuilder = queryBuilder.OrderBy(translitOrderBy(reas.OrderBy))
 	}
 	queryBuilder = queryBuilder.Limit(uint64(reas.Limit)).Offset(uint64(offset))
 	query, args, err := queryBuilder.ToSql()
@@ -194,12 +206,19 @@ func (p *Reasons) UpdateReasons(ctx context.Context, reasons *entity.Reasons) (*
 	}
 
 	query, args, err := p.db.Sq.Builder.Update(p.tableName).SetMap(data).
+		Where(p.db.Sq.Equal("id", reasons.Id)).
 		Suffix(fmt.Sprintf("RETURNING %s", p.reasonsSelectQueryPrefix())).ToSql()
 	if err != nil {
 		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "update"))
 	}
 	var updatedAt, deletedAt sql.NullTime
-	err = p.db.QueryRow(ctx, query, args...).Scan(
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, query, args...).Scan(
 		&reasons.Id,
 		&reasons.Name,
 		&reasons.SpecializationId,
@@ -218,6 +237,12 @@ func (p *Reasons) UpdateReasons(ctx context.Context, reasons *entity.Reasons) (*
 	if deletedAt.Valid {
 		reasons.DeletedAt = deletedAt.Time
 	}
+	if err = writeEvent(ctx, tx, p.tableName, eventUpdated, reasons.Id, reasons); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
 	return reasons, nil
 }
 
@@ -229,28 +254,26 @@ func (p *Reasons) DeleteReasons(ctx context.Context, reasons *entity.GetReqStr)
 		"deleted_at": time.Now().Add(time.Hour * 5),
 	}
 
-	var args []interface{}
-	var query string
-	var err error
-	if reasons.IsActive {
-		query, args, err = p.db.Sq.Builder.Delete(p.tableName).From(p.tableName).
-			Where(p.db.Sq.And(p.db.Sq.Equal(reasons.Field, reasons.Value))).ToSql()
-		if err != nil {
-			return &entity.StatusReasons{Status: false}, p.db.ErrSQLBuild(err, p.tableName+" delete")
-		}
-	} else {
-		query, args, err = p.db.Sq.Builder.Update(p.tableName).SetMap(data).
-			Where(p.db.Sq.And(p.db.Sq.Equal(reasons.Field, reasons.Value), p.db.Sq.Equal("deleted_at", nil))).ToSql()
-		if err != nil {
-			return &entity.StatusReasons{Status: false}, p.db.ErrSQLBuild(err, p.tableName+" delete")
-		}
-	}
-	resp, err := p.db.Exec(ctx, query, args...)
-	if err != nil {
-		return &entity.StatusReasons{Status: false}, p.db.Error(err)
+	// a soft delete cascades to the rows referencing the deleted one
+	if !reasons.IsActive {
+		status, err := softDeleteCascade(ctx, p.db, p.tableName, reasons.Field, reasons.Value, data)
+		return &entity.StatusReasons{Status: status}, err
 	}
-	if resp.RowsAffected() > 0 {
-		return &entity.StatusReasons{Status: true}, nil
+
+	status, err := hardDelete(ctx, p.db, p.tableName, reasons.Field, reasons.Value)
+	return &entity.StatusReasons{Status: status}, err
+}
+
+// RestoreReasons restores the soft deleted reason together with the rows its delete cascaded to
+func (p *Reasons) RestoreReasons(ctx context.Context, id string) (*entity.StatusReasons, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorServices, serviceNameDoctorServicesRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreReasons").String(id))
+	defer span.End()
+
+	data := map[string]any{
+		"updated_at": time.Now().Add(time.Hour * 5),
 	}
-	return &entity.StatusReasons{Status: false}, nil
+
+	status, err := restoreCascade(ctx, p.db, p.tableName, id, data)
+	return &entity.StatusReasons{Status: status}, err
 }
@@ -0,0 +1,260 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	resourcesTableName        = "resources"
+	serviceResource           = "resource"
+	serviceResourceRepoPrefix = "resource"
+)
+
+type Resource struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewResourceRepo(db *postgres.PostgresDB) *Resource {
+	return &Resource{
+		tableName: resourcesTableName,
+		db:        db,
+	}
+}
+
+func (r *Resource) resourceSelectQueryPrefix() string {
+	return `id,
+			name,
+			kind,
+			resource_type,
+			COALESCE(branch_id::text, ''),
+			created_at,
+			updated_at,
+			deleted_at
+		`
+}
+
+func (r *Resource) scanResource(row pgx.Row, in *entity.Resource) error {
+	var updatedAt, deletedAt sql.NullTime
+	if err := row.Scan(
+		&in.Id,
+		&in.Name,
+		&in.Kind,
+		&in.ResourceType,
+		&in.BranchId,
+		&in.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+	); err != nil {
+		return err
+	}
+	if updatedAt.Valid {
+		in.UpdatedAt = updatedAt.Time
+	}
+	if deletedAt.Valid {
+		in.DeletedAt = deletedAt.Time
+	}
+	return nil
+}
+
+func (r *Resource) CreateResource(ctx context.Context, in *entity.Resource) (*entity.Resource, error) {
+	ctx, span := otlp.Start(ctx, serviceResource, serviceResourceRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateResource").String(in.Id))
+	defer span.End()
+
+	data := map[string]any{
+		"id":            in.Id,
+		"name":          in.Name,
+		"kind":          in.Kind,
+		"resource_type": in.ResourceType,
+		"branch_id":     nullUUID(in.BranchId),
+	}
+	query, args, err := r.db.Sq.Builder.Insert(r.tableName).
+		SetMap(data).
+		Suffix(fmt.Sprintf("RETURNING %s", r.resourceSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", r.tableName, "create"))
+	}
+
+	if err = r.scanResource(r.db.QueryRow(ctx, query, args...), in); err != nil {
+		return nil, r.db.Error(err)
+	}
+	return in, nil
+}
+
+func (r *Resource) GetResource(ctx context.Context, id string) (*entity.Resource, error) {
+	ctx, span := otlp.Start(ctx, serviceResource, serviceResourceRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetResource").String(id))
+	defer span.End()
+
+	query, args, err := r.db.Sq.Builder.Select(r.resourceSelectQueryPrefix()).
+		From(r.tableName).
+		Where(r.db.Sq.Equal("id", id)).
+		Where("deleted_at IS NULL").ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", r.tableName, "get"))
+	}
+
+	var resource entity.Resource
+	if err = r.scanResource(r.db.QueryRow(ctx, query, args...), &resource); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("resource")
+		}
+		return nil, r.db.Error(err)
+	}
+	return &resource, nil
+}
+
+func (r *Resource) ListResources(ctx context.Context, in *entity.ListResourcesReq) (*entity.ListResources, error) {
+	ctx, span := otlp.Start(ctx, serviceResource, serviceResourceRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListResources").String(in.ResourceType))
+	defer span.End()
+
+	queryBuilder := r.db.Sq.Builder.Select(r.resourceSelectQueryPrefix()).
+		From(r.tableName).
+		Where("deleted_at IS NULL")
+	countBuilder := r.db.Sq.Builder.Select("count(*)").
+		From(r.tableName).
+		Where("deleted_at IS NULL")
+	filters := map[string]string{
+		"kind":          in.Kind,
+		"resource_type": in.ResourceType,
+		"branch_id":     in.BranchId,
+	}
+	for column, value := range filters {
+		if value != "" {
+			queryBuilder = queryBuilder.Where(r.db.Sq.Equal(column, value))
+			countBuilder = countBuilder.Where(r.db.Sq.Equal(column, value))
+		}
+	}
+	queryBuilder = queryBuilder.OrderBy("resource_type", "name", "id")
+	if in.Limit > 0 {
+		queryBuilder = queryBuilder.Limit(uint64(in.Limit))
+		if in.Page > 1 {
+			queryBuilder = queryBuilder.Offset(uint64(in.Limit * (in.Page - 1)))
+		}
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", r.tableName, "list"))
+	}
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer rows.Close()
+
+	var response entity.ListResources
+	for rows.Next() {
+		var resource entity.Resource
+		if err = r.scanResource(rows, &resource); err != nil {
+			return nil, r.db.Error(err)
+		}
+		response.Resources = append(response.Resources, resource)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	queryCount, argsCount, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", r.tableName, "count"))
+	}
+	if err = r.db.QueryRow(ctx, queryCount, argsCount...).Scan(&response.Count); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return &response, nil
+}
+
+func (r *Resource) UpdateResource(ctx context.Context, in *entity.Resource) (*entity.Resource, error) {
+	ctx, span := otlp.Start(ctx, serviceResource, serviceResourceRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateResource").String(in.Id))
+	defer span.End()
+
+	data := map[string]any{
+		"name":          in.Name,
+		"kind":          in.Kind,
+		"resource_type": in.ResourceType,
+		"branch_id":     nullUUID(in.BranchId),
+		"updated_at":    time.Now().Add(time.Hour * 5),
+	}
+	query, args, err := r.db.Sq.Builder.Update(r.tableName).
+		SetMap(data).
+		Where(r.db.Sq.Equal("id", in.Id)).
+		Where("deleted_at IS NULL").
+		Suffix(fmt.Sprintf("RETURNING %s", r.resourceSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", r.tableName, "update"))
+	}
+
+	if err = r.scanResource(r.db.QueryRow(ctx, query, args...), in); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("resource")
+		}
+		return nil, r.db.Error(err)
+	}
+	return in, nil
+}
+
+func (r *Resource) DeleteResource(ctx context.Context, id string) (bool, error) {
+	ctx, span := otlp.Start(ctx, serviceResource, serviceResourceRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteResource").String(id))
+	defer span.End()
+
+	query, args, err := r.db.Sq.Builder.Update(r.tableName).
+		Set("deleted_at", time.Now().Add(time.Hour*5)).
+		Where(r.db.Sq.Equal("id", id)).
+		Where("deleted_at IS NULL").ToSql()
+	if err != nil {
+		return false, r.db.ErrSQLBuild(err, r.tableName+" delete")
+	}
+
+	resp, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, r.db.Error(err)
+	}
+	return resp.RowsAffected() > 0, nil
+}
+
+// ListServiceResources returns the resource type required by the doctor service and the not deleted resources
+// of the type the doctor can use: the resources of the doctor's branch and the ones attached to no branch,
+// a doctor attached to no branch can use every resource of the type
+func (r *Resource) ListServiceResources(ctx context.Context, doctorServiceId string) (*entity.ServiceResources, error) {
+	ctx, span := otlp.Start(ctx, serviceResource, serviceResourceRepoPrefix+"List service resources")
+	span.SetAttributes(attribute.Key("doctor_service_id").String(doctorServiceId))
+	defer span.End()
+
+	query := fmt.Sprintf(`SELECT ds.required_resource_type,
+			ARRAY(
+				SELECT res.id::text
+				FROM %s AS res
+				WHERE res.resource_type = ds.required_resource_type
+				  AND ds.required_resource_type <> ''
+				  AND res.deleted_at IS NULL
+				  AND (res.branch_id IS NULL OR d.branch_id IS NULL OR res.branch_id = d.branch_id)
+				ORDER BY res.name, res.id
+			)
+		FROM %s AS ds
+		LEFT JOIN doctors AS d ON d.id = ds.doctor_id
+		WHERE ds.id = $1 AND ds.deleted_at IS NULL`, r.tableName, doctorServicesTableName)
+
+	var response entity.ServiceResources
+	if err := r.db.QueryRow(ctx, query, doctorServiceId).Scan(&response.ResourceType, &response.ResourceIds); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("doctor service")
+		}
+		return nil, r.db.Error(err)
+	}
+	return &response, nil
+}
@@ -0,0 +1,149 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	serviceSearch           = "search"
+	serviceSearchRepoPrefix = "search"
+)
+
+// searchSource describes how one table takes part in the catalogue search,
+// document and name must stay equal to the expressions of the search indexes
+type searchSource struct {
+	hitType     string
+	table       string
+	title       string
+	description string
+	parentId    string
+	document    string
+	name        string
+}
+
+var searchSources = []searchSource{
+	{
+		hitType:     entity.SearchTypeDoctor,
+		table:       "doctors",
+		title:       "first_name || ' ' || last_name",
+		description: "biography",
+		parentId:    "department_id::text",
+		document:    "to_tsvector('simple', uz_normalize(first_name || ' ' || last_name || ' ' || biography))",
+		name:        "uz_normalize(first_name || ' ' || last_name)",
+	},
+	{
+		hitType:     entity.SearchTypeSpecialization,
+		table:       "specializations",
+		title:       "name",
+		description: "description",
+		parentId:    "department_id::text",
+		document:    "to_tsvector('simple', uz_normalize(name || ' ' || description))",
+		name:        "uz_normalize(name)",
+	},
+	{
+		hitType:     entity.SearchTypeReason,
+		table:       "reasons",
+		title:       "name",
+		description: "''",
+		parentId:    "specialization_id::text",
+		document:    "to_tsvector('simple', uz_normalize(name))",
+		name:        "uz_normalize(name)",
+	},
+	{
+		hitType:     entity.SearchTypeDepartment,
+		table:       "departments",
+		title:       "name",
+		description: "description",
+		parentId:    "''",
+		document:    "to_tsvector('simple', uz_normalize(name || ' ' || short_description || ' ' || description))",
+		name:        "uz_normalize(name)",
+	},
+}
+
+type Search struct {
+	db *postgres.PostgresDB
+}
+
+func NewSearchRepo(db *postgres.PostgresDB) *Search {
+	return &Search{
+		db: db,
+	}
+}
+
+// Search ranks full-text matches over the whole document together with trigram matches
+// over the name, so that both words out of a description and misspelled names are found,
+// documents and the query are compared in their uz_normalize form so that either script matches
+func (s *Search) Search(ctx context.Context, in *entity.SearchReq) (*entity.SearchRes, error) {
+	ctx, span := otlp.Start(ctx, serviceSearch, serviceSearchRepoPrefix+"Search")
+	span.SetAttributes(attribute.Key("query").String(in.Query))
+	defer span.End()
+
+	var queries []string
+	for _, source := range searchSources {
+		if len(in.Types) > 0 && !containsString(in.Types, source.hitType) {
+			continue
+		}
+		queries = append(queries, fmt.Sprintf(`SELECT
+				'%[1]s' AS type,
+				id::text AS id,
+				%[2]s AS title,
+				%[3]s AS description,
+				image_url,
+				%[4]s AS parent_id,
+				ts_rank(%[5]s, websearch_to_tsquery('simple', uz_normalize($1))) + word_similarity(uz_normalize($1), %[6]s) AS score
+			FROM %[7]s
+			WHERE deleted_at IS NULL
+				AND (%[5]s @@ websearch_to_tsquery('simple', uz_normalize($1)) OR uz_normalize($1) <%% %[6]s)`,
+			source.hitType, source.title, source.description, source.parentId, source.document, source.name, source.table))
+	}
+	if len(queries) == 0 {
+		return &entity.SearchRes{}, nil
+	}
+
+	query := fmt.Sprintf(`SELECT type, id, title, description, image_url, parent_id, score, count(*) OVER ()
+		FROM (%s) AS hits
+		ORDER BY score DESC, uz_normalize(title), title
+		LIMIT $2 OFFSET $3`, strings.Join(queries, " UNION ALL "))
+
+	rows, err := s.db.Query(ctx, query, in.Query, in.Limit, in.Limit*(in.Page-1))
+	if err != nil {
+		return nil, s.db.Error(err)
+	}
+	defer rows.Close()
+
+	var response entity.SearchRes
+	for rows.Next() {
+		var hit entity.SearchHit
+		if err := rows.Scan(
+			&hit.Type,
+			&hit.Id,
+			&hit.Title,
+			&hit.Description,
+			&hit.ImageUrl,
+			&hit.ParentId,
+			&hit.Score,
+			&response.Count,
+		); err != nil {
+			return nil, s.db.Error(err)
+		}
+		response.Hits = append(response.Hits, hit)
+	}
+
+	return &response, rows.Err()
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
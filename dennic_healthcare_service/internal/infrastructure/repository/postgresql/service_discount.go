@@ -0,0 +1,239 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	serviceDiscountsTableName        = "service_discounts"
+	serviceServiceDiscount           = "serviceDiscount"
+	serviceServiceDiscountRepoPrefix = "serviceDiscount"
+)
+
+type ServiceDiscount struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewServiceDiscountRepo(db *postgres.PostgresDB) *ServiceDiscount {
+	return &ServiceDiscount{
+		tableName: serviceDiscountsTableName,
+		db:        db,
+	}
+}
+
+func (d *ServiceDiscount) serviceDiscountSelectQueryPrefix() string {
+	return `id,
+			doctor_service_id,
+			kind,
+			value,
+			starts_on,
+			ends_on,
+			created_at,
+			updated_at,
+			deleted_at
+		`
+}
+
+func (d *ServiceDiscount) scanServiceDiscount(row pgx.Row, in *entity.ServiceDiscount) error {
+	var (
+		startsOn                     time.Time
+		endsOn, updatedAt, deletedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&in.Id,
+		&in.DoctorServiceId,
+		&in.Kind,
+		&in.Value,
+		&startsOn,
+		&endsOn,
+		&in.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+	); err != nil {
+		return err
+	}
+	in.StartsOn = startsOn.Format("2006-01-02")
+	in.EndsOn = ""
+	if endsOn.Valid {
+		in.EndsOn = endsOn.Time.Format("2006-01-02")
+	}
+	in.UpdatedAt = time.Time{}
+	if updatedAt.Valid {
+		in.UpdatedAt = updatedAt.Time
+	}
+	if deletedAt.Valid {
+		in.DeletedAt = deletedAt.Time
+	}
+	return nil
+}
+
+// CreateServiceDiscount stores a discount of a doctor service, an unknown doctor service is not found
+func (d *ServiceDiscount) CreateServiceDiscount(ctx context.Context, in *entity.ServiceDiscount) (*entity.ServiceDiscount, error) {
+	ctx, span := otlp.Start(ctx, serviceServiceDiscount, serviceServiceDiscountRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateServiceDiscount").String(in.Id))
+	defer span.End()
+
+	data := map[string]any{
+		"id":                in.Id,
+		"doctor_service_id": in.DoctorServiceId,
+		"kind":              in.Kind,
+		"value":             in.Value,
+		"starts_on":         in.StartsOn,
+		"ends_on":           nullDate(in.EndsOn),
+	}
+	query, args, err := d.db.Sq.Builder.Insert(d.tableName).
+		SetMap(data).
+		Suffix(fmt.Sprintf("RETURNING %s", d.serviceDiscountSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, d.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", d.tableName, "create"))
+	}
+
+	if err = d.scanServiceDiscount(d.db.QueryRow(ctx, query, args...), in); err != nil {
+		return nil, d.db.Error(err)
+	}
+	return in, nil
+}
+
+func (d *ServiceDiscount) GetServiceDiscount(ctx context.Context, id string) (*entity.ServiceDiscount, error) {
+	ctx, span := otlp.Start(ctx, serviceServiceDiscount, serviceServiceDiscountRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetServiceDiscount").String(id))
+	defer span.End()
+
+	query, args, err := d.db.Sq.Builder.Select(d.serviceDiscountSelectQueryPrefix()).
+		From(d.tableName).
+		Where(d.db.Sq.Equal("id", id)).
+		Where("deleted_at IS NULL").ToSql()
+	if err != nil {
+		return nil, d.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", d.tableName, "get"))
+	}
+
+	var discount entity.ServiceDiscount
+	if err = d.scanServiceDiscount(d.db.QueryRow(ctx, query, args...), &discount); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("service discount")
+		}
+		return nil, d.db.Error(err)
+	}
+	return &discount, nil
+}
+
+func (d *ServiceDiscount) ListServiceDiscounts(ctx context.Context, in *entity.ListServiceDiscountsReq) (*entity.ListServiceDiscounts, error) {
+	ctx, span := otlp.Start(ctx, serviceServiceDiscount, serviceServiceDiscountRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListServiceDiscounts").String(in.DoctorServiceId))
+	defer span.End()
+
+	queryBuilder := d.db.Sq.Builder.Select(d.serviceDiscountSelectQueryPrefix()).
+		From(d.tableName).
+		Where("deleted_at IS NULL")
+	countBuilder := d.db.Sq.Builder.Select("count(*)").
+		From(d.tableName).
+		Where("deleted_at IS NULL")
+	if in.DoctorServiceId != "" {
+		queryBuilder = queryBuilder.Where(d.db.Sq.Equal("doctor_service_id", in.DoctorServiceId))
+		countBuilder = countBuilder.Where(d.db.Sq.Equal("doctor_service_id", in.DoctorServiceId))
+	}
+	if in.ActiveOn != "" {
+		queryBuilder = queryBuilder.Where("starts_on <= ?::date AND (ends_on IS NULL OR ends_on >= ?::date)", in.ActiveOn, in.ActiveOn)
+		countBuilder = countBuilder.Where("starts_on <= ?::date AND (ends_on IS NULL OR ends_on >= ?::date)", in.ActiveOn, in.ActiveOn)
+	}
+	queryBuilder = queryBuilder.OrderBy("starts_on DESC", "id")
+	if in.Limit > 0 {
+		queryBuilder = queryBuilder.Limit(uint64(in.Limit))
+		if in.Page > 1 {
+			queryBuilder = queryBuilder.Offset(uint64(in.Limit * (in.Page - 1)))
+		}
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, d.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", d.tableName, "list"))
+	}
+	rows, err := d.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, d.db.Error(err)
+	}
+	defer rows.Close()
+
+	var response entity.ListServiceDiscounts
+	for rows.Next() {
+		var discount entity.ServiceDiscount
+		if err = d.scanServiceDiscount(rows, &discount); err != nil {
+			return nil, d.db.Error(err)
+		}
+		response.ServiceDiscounts = append(response.ServiceDiscounts, discount)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, d.db.Error(err)
+	}
+
+	queryCount, argsCount, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, d.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", d.tableName, "count"))
+	}
+	if err = d.db.QueryRow(ctx, queryCount, argsCount...).Scan(&response.Count); err != nil {
+		return nil, d.db.Error(err)
+	}
+
+	return &response, nil
+}
+
+// UpdateServiceDiscount changes the kind, value and the days of the discount, the doctor service is kept
+func (d *ServiceDiscount) UpdateServiceDiscount(ctx context.Context, in *entity.ServiceDiscount) (*entity.ServiceDiscount, error) {
+	ctx, span := otlp.Start(ctx, serviceServiceDiscount, serviceServiceDiscountRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateServiceDiscount").String(in.Id))
+	defer span.End()
+
+	data := map[string]any{
+		"kind":       in.Kind,
+		"value":      in.Value,
+		"starts_on":  in.StartsOn,
+		"ends_on":    nullDate(in.EndsOn),
+		"updated_at": time.Now().Add(time.Hour * 5),
+	}
+	query, args, err := d.db.Sq.Builder.Update(d.tableName).
+		SetMap(data).
+		Where(d.db.Sq.Equal("id", in.Id)).
+		Where("deleted_at IS NULL").
+		Suffix(fmt.Sprintf("RETURNING %s", d.serviceDiscountSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, d.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", d.tableName, "update"))
+	}
+
+	if err = d.scanServiceDiscount(d.db.QueryRow(ctx, query, args...), in); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, entity.NewErrNotFound("service discount")
+		}
+		return nil, d.db.Error(err)
+	}
+	return in, nil
+}
+
+func (d *ServiceDiscount) DeleteServiceDiscount(ctx context.Context, id string) (bool, error) {
+	ctx, span := otlp.Start(ctx, serviceServiceDiscount, serviceServiceDiscountRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteServiceDiscount").String(id))
+	defer span.End()
+
+	query, args, err := d.db.Sq.Builder.Update(d.tableName).
+		Set("deleted_at", time.Now().Add(time.Hour*5)).
+		Where(d.db.Sq.Equal("id", id)).
+		Where("deleted_at IS NULL").ToSql()
+	if err != nil {
+		return false, d.db.ErrSQLBuild(err, d.tableName+" delete")
+	}
+
+	resp, err := d.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, d.db.Error(err)
+	}
+	return resp.RowsAffected() > 0, nil
+}
@@ -0,0 +1,296 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	servicePackagesTableName        = "service_packages"
+	servicePackageItemsTableName    = "service_package_items"
+	serviceServicePackage           = "servicePackage"
+	serviceServicePackageRepoPrefix = "servicePackage"
+)
+
+// rowQuerier is implemented by both the pool and a transaction
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type ServicePackage struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewServicePackageRepo(db *postgres.PostgresDB) *ServicePackage {
+	return &ServicePackage{
+		tableName: servicePackagesTableName,
+		db:        db,
+	}
+}
+
+// servicePackageSelectQueryPrefix selects a package with its not deleted doctor services
+func (p *ServicePackage) servicePackageSelectQueryPrefix() string {
+	return fmt.Sprintf(`id,
+			name,
+			description,
+			price,
+			ARRAY(
+				SELECT i.doctor_service_id::text
+				FROM %[1]s AS i
+				JOIN %[2]s AS ds ON ds.id = i.doctor_service_id AND ds.deleted_at IS NULL
+				WHERE i.package_id = %[3]s.id
+				ORDER BY ds.name, ds.id
+			),
+			COALESCE((
+				SELECT SUM(ds.offline_price)
+				FROM %[1]s AS i
+				JOIN %[2]s AS ds ON ds.id = i.doctor_service_id AND ds.deleted_at IS NULL
+				WHERE i.package_id = %[3]s.id
+			), 0),
+			created_at,
+			updated_at,
+			deleted_at
+		`, servicePackageItemsTableName, doctorServicesTableName, p.tableName)
+}
+
+func (p *ServicePackage) scanServicePackage(row pgx.Row, in *entity.ServicePackage) error {
+	var updatedAt, deletedAt sql.NullTime
+	if err := row.Scan(
+		&in.Id,
+		&in.Name,
+		&in.Description,
+		&in.Price,
+		&in.DoctorServiceIds,
+		&in.ItemsPrice,
+		&in.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+	); err != nil {
+		return err
+	}
+	in.UpdatedAt = time.Time{}
+	if updatedAt.Valid {
+		in.UpdatedAt = updatedAt.Time
+	}
+	if deletedAt.Valid {
+		in.DeletedAt = deletedAt.Time
+	}
+	return nil
+}
+
+func (p *ServicePackage) getServicePackage(ctx context.Context, q rowQuerier, id string, in *entity.ServicePackage) error {
+	query, args, err := p.db.Sq.Builder.Select(p.servicePackageSelectQueryPrefix()).
+		From(p.tableName).
+		Where(p.db.Sq.Equal("id", id)).
+		Where("deleted_at IS NULL").ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get"))
+	}
+
+	if err = p.scanServicePackage(q.QueryRow(ctx, query, args...), in); err != nil {
+		if err == pgx.ErrNoRows {
+			return entity.NewErrNotFound("service package")
+		}
+		return p.db.Error(err)
+	}
+	return nil
+}
+
+// replaceItems stores the doctor services of the package instead of its current ones
+func (p *ServicePackage) replaceItems(ctx context.Context, tx pgx.Tx, packageId string, doctorServiceIds []string) error {
+	query, args, err := p.db.Sq.Builder.Delete(servicePackageItemsTableName).
+		Where(p.db.Sq.Equal("package_id", packageId)).ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", servicePackageItemsTableName, "delete"))
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	query = fmt.Sprintf(`INSERT INTO %s (package_id, doctor_service_id)
+		SELECT $1, doctor_service_id FROM unnest($2::uuid[]) AS doctor_service_id
+		ON CONFLICT DO NOTHING`, servicePackageItemsTableName)
+	if _, err = tx.Exec(ctx, query, packageId, doctorServiceIds); err != nil {
+		return p.db.Error(err)
+	}
+	return nil
+}
+
+// CreateServicePackage stores the package together with its doctor services, an unknown doctor service is not found
+func (p *ServicePackage) CreateServicePackage(ctx context.Context, in *entity.ServicePackage) (*entity.ServicePackage, error) {
+	ctx, span := otlp.Start(ctx, serviceServicePackage, serviceServicePackageRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateServicePackage").String(in.Id))
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).
+		SetMap(map[string]any{
+			"id":          in.Id,
+			"name":        in.Name,
+			"description": in.Description,
+			"price":       in.Price,
+		}).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if err = p.replaceItems(ctx, tx, in.Id, in.DoctorServiceIds); err != nil {
+		return nil, err
+	}
+	if err = p.getServicePackage(ctx, tx, in.Id, in); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
+	return in, nil
+}
+
+func (p *ServicePackage) GetServicePackage(ctx context.Context, id string) (*entity.ServicePackage, error) {
+	ctx, span := otlp.Start(ctx, serviceServicePackage, serviceServicePackageRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetServicePackage").String(id))
+	defer span.End()
+
+	var servicePackage entity.ServicePackage
+	if err := p.getServicePackage(ctx, p.db, id, &servicePackage); err != nil {
+		return nil, err
+	}
+	return &servicePackage, nil
+}
+
+func (p *ServicePackage) ListServicePackages(ctx context.Context, in *entity.ListServicePackagesReq) (*entity.ListServicePackages, error) {
+	ctx, span := otlp.Start(ctx, serviceServicePackage, serviceServicePackageRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListServicePackages").String(in.DoctorServiceId))
+	defer span.End()
+
+	queryBuilder := p.db.Sq.Builder.Select(p.servicePackageSelectQueryPrefix()).
+		From(p.tableName).
+		Where("deleted_at IS NULL")
+	countBuilder := p.db.Sq.Builder.Select("count(*)").
+		From(p.tableName).
+		Where("deleted_at IS NULL")
+	if in.DoctorServiceId != "" {
+		includes := fmt.Sprintf("EXISTS (SELECT 1 FROM %s AS i WHERE i.package_id = %s.id AND i.doctor_service_id = ?)",
+			servicePackageItemsTableName, p.tableName)
+		queryBuilder = queryBuilder.Where(includes, in.DoctorServiceId)
+		countBuilder = countBuilder.Where(includes, in.DoctorServiceId)
+	}
+	queryBuilder = queryBuilder.OrderBy("name", "id")
+	if in.Limit > 0 {
+		queryBuilder = queryBuilder.Limit(uint64(in.Limit))
+		if in.Page > 1 {
+			queryBuilder = queryBuilder.Offset(uint64(in.Limit * (in.Page - 1)))
+		}
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "list"))
+	}
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var response entity.ListServicePackages
+	for rows.Next() {
+		var servicePackage entity.ServicePackage
+		if err = p.scanServicePackage(rows, &servicePackage); err != nil {
+			return nil, p.db.Error(err)
+		}
+		response.ServicePackages = append(response.ServicePackages, servicePackage)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	queryCount, argsCount, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "count"))
+	}
+	if err = p.db.QueryRow(ctx, queryCount, argsCount...).Scan(&response.Count); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &response, nil
+}
+
+// UpdateServicePackage updates the package and replaces its doctor services
+func (p *ServicePackage) UpdateServicePackage(ctx context.Context, in *entity.ServicePackage) (*entity.ServicePackage, error) {
+	ctx, span := otlp.Start(ctx, serviceServicePackage, serviceServicePackageRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateServicePackage").String(in.Id))
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := p.db.Sq.Builder.Update(p.tableName).
+		SetMap(map[string]any{
+			"name":        in.Name,
+			"description": in.Description,
+			"price":       in.Price,
+			"updated_at":  time.Now().Add(time.Hour * 5),
+		}).
+		Where(p.db.Sq.Equal("id", in.Id)).
+		Where("deleted_at IS NULL").ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "update"))
+	}
+	resp, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	if resp.RowsAffected() == 0 {
+		return nil, entity.NewErrNotFound("service package")
+	}
+	if err = p.replaceItems(ctx, tx, in.Id, in.DoctorServiceIds); err != nil {
+		return nil, err
+	}
+	if err = p.getServicePackage(ctx, tx, in.Id, in); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
+	return in, nil
+}
+
+func (p *ServicePackage) DeleteServicePackage(ctx context.Context, id string) (bool, error) {
+	ctx, span := otlp.Start(ctx, serviceServicePackage, serviceServicePackageRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteServicePackage").String(id))
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.Update(p.tableName).
+		Set("deleted_at", time.Now().Add(time.Hour*5)).
+		Where(p.db.Sq.Equal("id", id)).
+		Where("deleted_at IS NULL").ToSql()
+	if err != nil {
+		return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
+	}
+
+	resp, err := p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, p.db.Error(err)
+	}
+	return resp.RowsAffected() > 0, nil
+}
@@ -0,0 +1,309 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	serviceNameRetention           = "retention"
+	serviceNameRetentionRepoPrefix = "retention"
+)
+
+// softDeleteChild is a table whose rows are soft deleted together with the parent row they reference by column
+type softDeleteChild struct {
+	table  string
+	column string
+}
+
+// softDeleteChildren is the healthcare entity graph followed by a soft delete, the rows deleted by one cascade
+// share delete_batch, the id of the row the cascade started from
+var softDeleteChildren = map[string][]softDeleteChild{
+	departmentTableName: {
+		{table: doctorTableName, column: "department_id"},
+		{table: specTableName, column: "department_id"},
+	},
+	specTableName: {
+		{table: specTableName, column: "parent_id"},
+		{table: reasonsTableName, column: "specialization_id"},
+		{table: doctorServicesTableName, column: "specialization_id"},
+	},
+	doctorTableName: {
+		{table: doctorServicesTableName, column: "doctor_id"},
+		{table: doctorWorkingHoursTableName, column: "doctor_id"},
+		{table: doctorLeavesTableName, column: "doctor_id"},
+		{table: doctorCredentialsTableName, column: "doctor_id"},
+	},
+}
+
+// softDeleteLink is a link table whose rows join a row of the graph to a holder row the cascade does not follow,
+// e.g. a doctor linked to a secondary department. The links have no deleted_at, so a soft delete is rejected
+// while a not deleted holder still links a row of the cascade
+type softDeleteLink struct {
+	table        string
+	column       string
+	holder       string
+	holderColumn string
+	description  string
+}
+
+// softDeleteLinks are the links of the graph tables
+var softDeleteLinks = map[string][]softDeleteLink{
+	departmentTableName: {
+		{
+			table:        doctorDepartmentsTableName,
+			column:       "department_id",
+			holder:       doctorTableName,
+			holderColumn: "doctor_id",
+			description:  "the department is a department of a doctor, remove it from the doctor first",
+		},
+	},
+	doctorServicesTableName: {
+		{
+			table:        servicePackageItemsTableName,
+			column:       "doctor_service_id",
+			holder:       servicePackagesTableName,
+			holderColumn: "package_id",
+			description:  "a doctor service is in a service package, remove it from the package first",
+		},
+	},
+}
+
+// softDeleteTables are the tables of the graph, the children before their parents
+var softDeleteTables = []string{
+	doctorCredentialsTableName,
+	doctorLeavesTableName,
+	doctorWorkingHoursTableName,
+	reasonsTableName,
+	doctorServicesTableName,
+	specTableName,
+	doctorTableName,
+	departmentTableName,
+}
+
+// softDeleteCascade soft deletes the not deleted rows of the table where field equals value setting data,
+// and then the not deleted rows referencing them down the graph, all in one transaction
+func softDeleteCascade(ctx context.Context, db *postgres.PostgresDB, table, field, value string, data map[string]any) (bool, error) {
+	now, ok := data["deleted_at"]
+	if !ok {
+		return false, fmt.Errorf("%s soft delete without deleted_at", table)
+	}
+
+	query, args, err := db.Sq.Builder.Update(table).SetMap(data).
+		Set("delete_batch", db.Sq.Expr("id")).
+		Where(db.Sq.And(db.Sq.Equal(field, value), db.Sq.Equal("deleted_at", nil))).
+		Suffix("RETURNING id::text").ToSql()
+	if err != nil {
+		return false, db.ErrSQLBuild(err, table+" delete")
+	}
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return false, db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	ids, err := queryIds(ctx, tx, query, args...)
+	if err != nil {
+		return false, db.Error(err)
+	}
+	if err = writeIdEvents(ctx, tx, table, eventDeleted, ids); err != nil {
+		return false, db.Error(err)
+	}
+	for _, id := range ids {
+		if err = softDeleteChildrenOf(ctx, tx, table, []string{id}, id, now); err != nil {
+			return false, db.Error(err)
+		}
+		if err = checkSoftDeleteLinks(ctx, db, tx, id); err != nil {
+			return false, err
+		}
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return false, db.Error(err)
+	}
+	return len(ids) > 0, nil
+}
+
+func softDeleteChildrenOf(ctx context.Context, tx pgx.Tx, table string, ids []string, batch string, now any) error {
+	for _, child := range softDeleteChildren[table] {
+		query := fmt.Sprintf(`UPDATE %s SET deleted_at = $1, delete_batch = $2
+			WHERE %s::text = ANY($3) AND deleted_at IS NULL
+			RETURNING id::text`, child.table, child.column)
+		childIds, err := queryIds(ctx, tx, query, now, batch, ids)
+		if err != nil {
+			return err
+		}
+		if len(childIds) == 0 {
+			continue
+		}
+		if err = writeIdEvents(ctx, tx, child.table, eventDeleted, childIds); err != nil {
+			return err
+		}
+		if err = softDeleteChildrenOf(ctx, tx, child.table, childIds, batch, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSoftDeleteLinks rejects the cascade of the batch while a not deleted holder links one of its rows
+func checkSoftDeleteLinks(ctx context.Context, db *postgres.PostgresDB, tx pgx.Tx, batch string) error {
+	for table, links := range softDeleteLinks {
+		for _, link := range links {
+			var linked bool
+			query := fmt.Sprintf(`SELECT EXISTS (
+					SELECT 1 FROM %s l
+					JOIN %s g ON g.id = l.%s
+					JOIN %s h ON h.id = l.%s
+					WHERE g.delete_batch = $1 AND h.deleted_at IS NULL
+				)`, link.table, table, link.column, link.holder, link.holderColumn)
+			if err := tx.QueryRow(ctx, query, batch).Scan(&linked); err != nil {
+				return db.Error(err)
+			}
+			if linked {
+				return softDeleteValidationError(link.column, link.description)
+			}
+		}
+	}
+	return nil
+}
+
+// restoreCascade restores the soft deleted row id of the table with all the rows its cascade deleted, setting data
+// on the restored rows of the table. A row deleted by the cascade of another one and a row whose parent is
+// still deleted can not be restored on their own
+func restoreCascade(ctx context.Context, db *postgres.PostgresDB, table, id string, data map[string]any) (bool, error) {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return false, db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	var batch *string
+	query := fmt.Sprintf(`SELECT delete_batch::text FROM %s WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE`, table)
+	if err = tx.QueryRow(ctx, query, id).Scan(&batch); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, db.Error(err)
+	}
+	if batch != nil && *batch != id {
+		return false, softDeleteValidationError("id", "deleted by the cascade of "+*batch+", restore that record instead")
+	}
+
+	for parent, children := range softDeleteChildren {
+		for _, child := range children {
+			if child.table != table {
+				continue
+			}
+			var parentDeleted bool
+			query = fmt.Sprintf(`SELECT EXISTS (
+					SELECT 1 FROM %s p JOIN %s c ON c.%s = p.id
+					WHERE c.id = $1 AND p.deleted_at IS NOT NULL
+				)`, parent, table, child.column)
+			if err = tx.QueryRow(ctx, query, id).Scan(&parentDeleted); err != nil {
+				return false, db.Error(err)
+			}
+			if parentDeleted {
+				return false, softDeleteValidationError(child.column, child.column+" is deleted, restore it first")
+			}
+		}
+	}
+
+	// the rows deleted before the cascades kept no batch and are restored alone
+	rootQuery, rootArgs, err := db.Sq.Builder.Update(table).SetMap(data).
+		Set("deleted_at", nil).Set("delete_batch", nil).
+		Where(db.Sq.Equal("id", id)).ToSql()
+	if err != nil {
+		return false, db.ErrSQLBuild(err, table+" restore")
+	}
+	if _, err = tx.Exec(ctx, rootQuery, rootArgs...); err != nil {
+		return false, db.Error(err)
+	}
+	if err = writeIdEvents(ctx, tx, table, eventRestored, []string{id}); err != nil {
+		return false, db.Error(err)
+	}
+	if batch != nil {
+		for _, batchTable := range softDeleteTables {
+			query = fmt.Sprintf(`UPDATE %s SET deleted_at = NULL, delete_batch = NULL WHERE delete_batch = $1
+				RETURNING id::text`, batchTable)
+			ids, err := queryIds(ctx, tx, query, *batch)
+			if err != nil {
+				return false, db.Error(err)
+			}
+			if err = writeIdEvents(ctx, tx, batchTable, eventRestored, ids); err != nil {
+				return false, db.Error(err)
+			}
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return false, db.Error(err)
+	}
+	return true, nil
+}
+
+func queryIds(ctx context.Context, tx pgx.Tx, query string, args ...any) ([]string, error) {
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func softDeleteValidationError(field, description string) error {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New(description)
+	errValidation.Errors[field] = description
+	return errValidation
+}
+
+type Retention struct {
+	db *postgres.PostgresDB
+}
+
+func NewRetentionRepo(db *postgres.PostgresDB) *Retention {
+	return &Retention{
+		db: db,
+	}
+}
+
+// PurgeDeleted hard deletes the rows of the entity graph soft deleted before the moment. A row some not deleted
+// row, or some row deleted since the moment, still references is kept: its hard delete would cascade to them
+// before their retention is over
+func (r *Retention) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := otlp.Start(ctx, serviceNameRetention, serviceNameRetentionRepoPrefix+"Purge")
+	span.SetAttributes(attribute.Key("PurgeDeleted").String(before.String()))
+	defer span.End()
+
+	var purged int64
+	for _, table := range softDeleteTables {
+		query := fmt.Sprintf(`DELETE FROM %s t WHERE t.deleted_at < $1`, table)
+		for _, child := range softDeleteChildren[table] {
+			query += fmt.Sprintf(` AND NOT EXISTS (SELECT 1 FROM %s c WHERE c.%s = t.id AND (c.deleted_at IS NULL OR c.deleted_at >= $1))`, child.table, child.column)
+		}
+		resp, err := r.db.Exec(ctx, query, before)
+		if err != nil {
+			return purged, r.db.Error(err)
+		}
+		purged += resp.RowsAffected()
+	}
+	return purged, nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"github.com/jackc/pgx/v4"
 	"go.opentelemetry.io/otel/attribute"
 	"time"
 )
@@ -38,10 +39,44 @@ func (p *Specialization) specializationSelectQueryPrefix() string {
 			image_url,
 			created_at,
 			updated_at,
-			deleted_at
+			deleted_at,
+			COALESCE(parent_id::text, '')
 		`
 }
 
+// specializationSubtree selects the ids of the specialization bound to the placeholder and of all its descendants
+const specializationSubtree = `WITH RECURSIVE subtree AS (
+		SELECT id FROM specializations WHERE id = ?
+		UNION
+		SELECT s.id FROM specializations s JOIN subtree t ON s.parent_id = t.id
+	) SELECT id FROM subtree`
+
+func (p *Specialization) scanSpecialization(row pgx.Row, spec *entity.Specialization) error {
+	var updatedAt, deletedAt sql.NullTime
+	err := row.Scan(
+		&spec.ID,
+		&spec.Order,
+		&spec.Name,
+		&spec.Description,
+		&spec.DepartmentId,
+		&spec.ImageUrl,
+		&spec.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+		&spec.ParentId,
+	)
+	if err != nil {
+		return err
+	}
+	if updatedAt.Valid {
+		spec.UpdatedAt = updatedAt.Time
+	}
+	if deletedAt.Valid {
+		spec.DeletedAt = deletedAt.Time
+	}
+	return nil
+}
+
 func (p *Specialization) CreateSpecialization(ctx context.Context, specialization *entity.Specialization) (*entity.Specialization, error) {
 
 	ctx, span := otlp.Start(ctx, serviceNameSpecialization, serviceNameSpecializationRepoPrefix+"Create")
@@ -54,6 +89,7 @@ func (p *Specialization) CreateSpecialization(ctx context.Context, specializatio
 		"description":   specialization.Description,
 		"department_id": specialization.DepartmentId,
 		"image_url":     specialization.ImageUrl,
+		"parent_id":     nullUUID(specialization.ParentId),
 	}
 	query, args, err := p.db.Sq.Builder.Insert(p.tableName).
 		SetMap(data).Suffix(fmt.Sprintf("RETURNING %s", p.specializationSelectQueryPrefix())).ToSql()
@@ -61,28 +97,22 @@ func (p *Specialization) CreateSpecialization(ctx context.Context, specializatio
 	if err != nil {
 		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
 	}
-	var updatedAt, deletedAt sql.NullTime
-	err = p.db.QueryRow(ctx, query, args...).Scan(
-		&specialization.ID,
-		&specialization.Order,
-		&specialization.Name,
-		&specialization.Description,
-		&specialization.DepartmentId,
-		&specialization.ImageUrl,
-		&specialization.CreatedAt,
-		&updatedAt,
-		&deletedAt,
-	)
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
-	if updatedAt.Valid {
-		specialization.UpdatedAt = updatedAt.Time
-	}
-	if deletedAt.Valid {
-		specialization.DeletedAt = deletedAt.Time
+	defer tx.Rollback(ctx)
+
+	if err = p.scanSpecialization(tx.QueryRow(ctx, query, args...), specialization); err != nil {
+		return nil, p.db.Error(err)
 	}
 
+	if err = writeEvent(ctx, tx, p.tableName, eventCreated, specialization.ID, specialization); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
 	return specialization, nil
 }
 
@@ -102,25 +132,7 @@ func (p *Specialization) GetSpecializationById(ctx context.Context, in *entity.G
 	if err != nil {
 		return nil, err
 	}
-	var updatedAt, deletedAt sql.NullTime
-	err = p.db.QueryRow(ctx, query, args...).Scan(
-		&spec.ID,
-		&spec.Order,
-		&spec.Name,
-		&spec.Description,
-		&spec.DepartmentId,
-		&spec.ImageUrl,
-		&spec.CreatedAt,
-		&updatedAt,
-		&deletedAt,
-	)
-	if updatedAt.Valid {
-		spec.UpdatedAt = updatedAt.Time
-	}
-	if deletedAt.Valid {
-		spec.DeletedAt = deletedAt.Time
-	}
-	if err != nil {
+	if err = p.scanSpecialization(p.db.QueryRow(ctx, query, args...), &spec); err != nil {
 		return nil, err
 	}
 	return &spec, nil
@@ -142,16 +154,20 @@ func (p *Specialization) GetAllSpecializations(ctx context.Context, all *entity.
 		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("department_id", all.DepartmentId))
 		countBuilder = countBuilder.Where(fmt.Sprintf("department_id = '%s'", all.DepartmentId))
 	}
+	if all.ParentId != "" {
+		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("parent_id", all.ParentId))
+		countBuilder = countBuilder.Where(p.db.Sq.Equal("parent_id", all.ParentId))
+	}
 
 	if all.Field != "" {
-		queryBuilder = queryBuilder.Where(fmt.Sprintf(`%s ILIKE '%s'`, all.Field, all.Value+"%"))
+		queryBuilder = queryBuilder.Where(translitPrefix(all.Field, all.Value))
 	}
 	if !all.IsActive {
 		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
 		countBuilder = countBuilder.Where("deleted_at IS NULL")
 	}
 	if all.OrderBy != "" {
-		queryBuilder = queryBuilder.OrderBy(all.OrderBy)
+		queryBuilder = queryBuilder.OrderBy(translitOrderBy(all.OrderBy))
 	}
 	queryBuilder = queryBuilder.Limit(uint64(all.Limit)).Offset(uint64(offset))
 	query, args, err := queryBuilder.ToSql()
@@ -165,37 +181,19 @@ func (p *Specialization) GetAllSpecializations(ctx context.Context, all *entity.
 	}
 	for rows.Next() {
 		var spec entity.Specialization
-		var deletedAt, updatedAt sql.NullTime
-		err = rows.Scan(
-			&spec.ID,
-			&spec.Order,
-			&spec.Name,
-			&spec.Description,
-			&spec.DepartmentId,
-			&spec.ImageUrl,
-			&spec.CreatedAt,
-			&updatedAt,
-			&deletedAt,
-		)
-		if updatedAt.Valid {
-			spec.UpdatedAt = updatedAt.Time
-		}
-		if deletedAt.Valid {
-			spec.DeletedAt = deletedAt.Time
-		}
-		if err != nil {
+		if err = p.scanSpecialization(rows, &spec); err != nil {
 			return nil, err
 		}
 		Specializations.Specializations = append(Specializations.Specializations, spec)
 	}
 	var count int32
-	queryCount, _, err := countBuilder.ToSql()
+	queryCount, argsCount, err := countBuilder.ToSql()
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
 
 	fmt.Println(queryCount)
-	err = p.db.QueryRow(ctx, queryCount).Scan(&count)
+	err = p.db.QueryRow(ctx, queryCount, argsCount...).Scan(&count)
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
@@ -215,6 +213,7 @@ func (p *Specialization) UpdateSpecialization(ctx context.Context, in *entity.Sp
 		"description":   in.Description,
 		"department_id": in.DepartmentId,
 		"image_url":     in.ImageUrl,
+		"parent_id":     nullUUID(in.ParentId),
 		"updated_at":    time.Now().Add(time.Hour * 5),
 	}
 	query, args, err := p.db.Sq.Builder.Update(p.tableName).
@@ -223,24 +222,21 @@ func (p *Specialization) UpdateSpecialization(ctx context.Context, in *entity.Sp
 	if err != nil {
 		return nil, p.db.ErrSQLBuild(err, p.tableName+" update")
 	}
-	var deletedAt sql.NullTime
-	err = p.db.QueryRow(ctx, query, args...).Scan(
-		&in.ID,
-		&in.Order,
-		&in.Name,
-		&in.Description,
-		&in.DepartmentId,
-		&in.ImageUrl,
-		&in.CreatedAt,
-		&in.UpdatedAt,
-		&deletedAt,
-	)
-	if deletedAt.Valid {
-		in.DeletedAt = deletedAt.Time
-	}
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
+	defer tx.Rollback(ctx)
+
+	if err = p.scanSpecialization(tx.QueryRow(ctx, query, args...), in); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if err = writeEvent(ctx, tx, p.tableName, eventUpdated, in.ID, in); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
 	return in, nil
 }
 
@@ -255,28 +251,64 @@ func (p *Specialization) DeleteSpecialization(ctx context.Context, in *entity.Ge
 		"deleted_at": time.Now().Add(time.Hour * 5),
 	}
 
-	var args []interface{}
-	var query string
-	var err error
-	if in.IsActive {
-		query, args, err = p.db.Sq.Builder.Delete(p.tableName).From(p.tableName).
-			Where(p.db.Sq.And(p.db.Sq.Equal(in.Field, in.Value))).ToSql()
-		if err != nil {
-			return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
-		}
-	} else {
-		query, args, err = p.db.Sq.Builder.Update(p.tableName).SetMap(data).
-			Where(p.db.Sq.And(p.db.Sq.Equal(in.Field, in.Value), p.db.Sq.Equal("deleted_at", nil))).ToSql()
-		if err != nil {
-			return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
-		}
+	// a soft delete cascades to the rows referencing the deleted one
+	if !in.IsActive {
+		return softDeleteCascade(ctx, p.db, p.tableName, in.Field, in.Value, data)
 	}
-	resp, err := p.db.Exec(ctx, query, args...)
+
+	return hardDelete(ctx, p.db, p.tableName, in.Field, in.Value)
+}
+
+// RestoreSpecialization restores the soft deleted specialization together with the rows its delete cascaded to
+func (p *Specialization) RestoreSpecialization(ctx context.Context, id string) (bool, error) {
+	ctx, span := otlp.Start(ctx, serviceNameSpecialization, serviceNameSpecializationRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreSpecialization").String(id))
+	defer span.End()
+
+	data := map[string]any{
+		"updated_at": time.Now().Add(time.Hour * 5),
+	}
+
+	return restoreCascade(ctx, p.db, p.tableName, id, data)
+}
+
+// GetSpecializationTree lists the not deleted specialization in.RootId and its descendants, the whole tree
+// when RootId is empty, parents first and siblings by name
+func (p *Specialization) GetSpecializationTree(ctx context.Context, in *entity.SpecializationTreeReq) (*entity.ListSpecializations, error) {
+	ctx, span := otlp.Start(ctx, serviceNameSpecialization, serviceNameSpecializationRepoPrefix+"Tree")
+	span.SetAttributes(attribute.Key("GetSpecializationTree").String(in.RootId))
+	defer span.End()
+
+	root := "parent_id IS NULL"
+	var args []any
+	if in.RootId != "" {
+		root = "id = $1"
+		args = append(args, in.RootId)
+	}
+	query := fmt.Sprintf(`WITH RECURSIVE tree AS (
+			SELECT s.*, 0 AS depth FROM %[1]s s WHERE %[2]s AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.*, t.depth + 1 FROM %[1]s c JOIN tree t ON c.parent_id = t.id WHERE c.deleted_at IS NULL
+		)
+		SELECT %[3]s FROM tree ORDER BY depth, name`, p.tableName, root, p.specializationSelectQueryPrefix())
+
+	rows, err := p.db.Query(ctx, query, args...)
 	if err != nil {
-		return false, p.db.Error(err)
+		return nil, p.db.Error(err)
 	}
-	if resp.RowsAffected() > 0 {
-		return true, nil
+	defer rows.Close()
+
+	var specializations entity.ListSpecializations
+	for rows.Next() {
+		var spec entity.Specialization
+		if err = p.scanSpecialization(rows, &spec); err != nil {
+			return nil, p.db.Error(err)
+		}
+		specializations.Specializations = append(specializations.Specializations, spec)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, p.db.Error(err)
 	}
-	return false, nil
+	specializations.Count = int32(len(specializations.Specializations))
+	return &specializations, nil
 }
@@ -0,0 +1,180 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	translationsTableName        = "translations"
+	serviceTranslation           = "translation"
+	serviceTranslationRepoPrefix = "translation"
+)
+
+type Translation struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewTranslationRepo(db *postgres.PostgresDB) *Translation {
+	return &Translation{
+		tableName: translationsTableName,
+		db:        db,
+	}
+}
+
+func (t *Translation) translationSelectQueryPrefix() string {
+	return `entity_type,
+			entity_id,
+			lang,
+			field,
+			value,
+			created_at,
+			updated_at
+		`
+}
+
+func (t *Translation) SetTranslation(ctx context.Context, in *entity.Translation) (*entity.Translation, error) {
+	ctx, span := otlp.Start(ctx, serviceTranslation, serviceTranslationRepoPrefix+"Set")
+	span.SetAttributes(attribute.Key(in.EntityType).String(in.EntityId))
+	defer span.End()
+
+	query, args, err := t.db.Sq.Builder.Insert(t.tableName).
+		Columns("entity_type", "entity_id", "lang", "field", "value").
+		Values(in.EntityType, in.EntityId, in.Lang, in.Field, in.Value).
+		Suffix(fmt.Sprintf(`ON CONFLICT (entity_type, entity_id, lang, field) DO UPDATE SET
+			value = EXCLUDED.value,
+			updated_at = ?
+			RETURNING %s`, t.translationSelectQueryPrefix()), time.Now().Add(time.Hour*5)).
+		ToSql()
+	if err != nil {
+		return nil, t.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", t.tableName, "set"))
+	}
+
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return nil, t.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		translation entity.Translation
+		updatedAt   sql.NullTime
+	)
+	if err = tx.QueryRow(ctx, query, args...).Scan(
+		&translation.EntityType,
+		&translation.EntityId,
+		&translation.Lang,
+		&translation.Field,
+		&translation.Value,
+		&translation.CreatedAt,
+		&updatedAt,
+	); err != nil {
+		return nil, t.db.Error(err)
+	}
+	if updatedAt.Valid {
+		translation.UpdatedAt = updatedAt.Time
+	}
+	if err = writeEvent(ctx, tx, t.tableName, eventUpdated, translation.EntityId, translation); err != nil {
+		return nil, t.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, t.db.Error(err)
+	}
+	return &translation, nil
+}
+
+func (t *Translation) GetTranslations(ctx context.Context, in *entity.GetTranslationsReq) (*entity.ListTranslations, error) {
+	ctx, span := otlp.Start(ctx, serviceTranslation, serviceTranslationRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetTranslations").String(in.EntityType))
+	defer span.End()
+
+	queryBuilder := t.db.Sq.Builder.Select(t.translationSelectQueryPrefix()).From(t.tableName).
+		Where(t.db.Sq.Equal("entity_type", in.EntityType)).
+		Where(t.db.Sq.Equal("entity_id::text", in.EntityIds))
+	if len(in.Langs) > 0 {
+		queryBuilder = queryBuilder.Where(t.db.Sq.Equal("lang", in.Langs))
+	}
+	query, args, err := queryBuilder.OrderBy("entity_id", "lang", "field").ToSql()
+	if err != nil {
+		return nil, t.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", t.tableName, "get"))
+	}
+
+	rows, err := t.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, t.db.Error(err)
+	}
+	defer rows.Close()
+
+	var translations entity.ListTranslations
+	for rows.Next() {
+		var (
+			translation entity.Translation
+			updatedAt   sql.NullTime
+		)
+		if err := rows.Scan(
+			&translation.EntityType,
+			&translation.EntityId,
+			&translation.Lang,
+			&translation.Field,
+			&translation.Value,
+			&translation.CreatedAt,
+			&updatedAt,
+		); err != nil {
+			return nil, t.db.Error(err)
+		}
+		if updatedAt.Valid {
+			translation.UpdatedAt = updatedAt.Time
+		}
+		translations.Translations = append(translations.Translations, translation)
+	}
+	return &translations, rows.Err()
+}
+
+func (t *Translation) DeleteTranslation(ctx context.Context, in *entity.DeleteTranslationReq) (*entity.StatusTranslation, error) {
+	ctx, span := otlp.Start(ctx, serviceTranslation, serviceTranslationRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key(in.EntityType).String(in.EntityId))
+	defer span.End()
+
+	clauses := map[string]interface{}{
+		"entity_type": in.EntityType,
+		"entity_id":   in.EntityId,
+		"lang":        in.Lang,
+	}
+	// without a field every translated field of the language is removed
+	if in.Field != "" {
+		clauses["field"] = in.Field
+	}
+	query, args, err := t.db.Sq.Builder.Delete(t.tableName).Where(t.db.Sq.EqualMany(clauses)).ToSql()
+	if err != nil {
+		return &entity.StatusTranslation{Status: false}, t.db.ErrSQLBuild(err, t.tableName+" delete")
+	}
+
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return &entity.StatusTranslation{Status: false}, t.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	resp, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return &entity.StatusTranslation{Status: false}, t.db.Error(err)
+	}
+	if resp.RowsAffected() == 0 {
+		return &entity.StatusTranslation{Status: false}, nil
+	}
+	if err = writeEvent(ctx, tx, t.tableName, eventDeleted, in.EntityId, in); err != nil {
+		return &entity.StatusTranslation{Status: false}, t.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return &entity.StatusTranslation{Status: false}, t.db.Error(err)
+	}
+	return &entity.StatusTranslation{Status: true}, nil
+}
@@ -0,0 +1,48 @@
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// translitColumns are the text columns compared and ordered by their uz_normalize form,
+// which maps Uzbek Cyrillic and Russian to Uzbek Latin, lowercases and drops apostrophes
+var translitColumns = map[string]bool{
+	"first_name":        true,
+	"last_name":         true,
+	"name":              true,
+	"description":       true,
+	"short_description": true,
+	"biography":         true,
+	"address":           true,
+	"city":              true,
+	"country":           true,
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// translitPrefix matches rows whose field starts with value written in either script
+func translitPrefix(field, value string) sq.Sqlizer {
+	return sq.Expr(fmt.Sprintf("uz_normalize(%s::text) LIKE uz_normalize(?) || '%%'", field), likeEscaper.Replace(value))
+}
+
+// translitOrderBy orders text columns by their transliterated form first,
+// so that the same name sorts the same way whatever script it was written in
+func translitOrderBy(orderBy string) string {
+	var parts []string
+	for _, part := range strings.Split(orderBy, ",") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 {
+			continue
+		}
+
+		column := fields[0]
+		if translitColumns[column[strings.LastIndex(column, ".")+1:]] {
+			parts = append(parts, strings.Join(append([]string{"uz_normalize(" + column + ")"}, fields[1:]...), " "))
+		}
+		parts = append(parts, strings.Join(fields, " "))
+	}
+	return strings.Join(parts, ", ")
+}
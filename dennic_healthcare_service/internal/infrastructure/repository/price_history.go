@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+	"time"
+)
+
+type PriceHistoryRepository interface {
+	CreatePriceChange(ctx context.Context, in *entity.PriceChange) (*entity.PriceChange, error)
+	ListPriceHistory(ctx context.Context, in *entity.PriceHistoryReq) (*entity.PriceHistory, error)
+	GetPriceAt(ctx context.Context, doctorServiceId string, at time.Time) (*entity.PriceChange, error)
+	DeleteScheduledPriceChange(ctx context.Context, id string, now time.Time) (bool, error)
+	ApplyDuePrices(ctx context.Context, now time.Time) (int64, error)
+}
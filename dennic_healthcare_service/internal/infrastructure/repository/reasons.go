@@ -11,4 +11,5 @@ type ReasonRepository interface {
 	GetAllReasons(context.Context, *entity.GetAllReas) (*entity.ListReasons, error)
 	UpdateReasons(context.Context, *entity.Reasons) (*entity.Reasons, error)
 	DeleteReasons(context.Context, *entity.GetReqStr) (*entity.StatusReasons, error)
+	RestoreReasons(ctx context.Context, id string) (*entity.StatusReasons, error)
 }
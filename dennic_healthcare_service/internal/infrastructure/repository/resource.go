@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+)
+
+type ResourceRepository interface {
+	CreateResource(ctx context.Context, in *entity.Resource) (*entity.Resource, error)
+	GetResource(ctx context.Context, id string) (*entity.Resource, error)
+	ListResources(ctx context.Context, in *entity.ListResourcesReq) (*entity.ListResources, error)
+	UpdateResource(ctx context.Context, in *entity.Resource) (*entity.Resource, error)
+	DeleteResource(ctx context.Context, id string) (bool, error)
+	ListServiceResources(ctx context.Context, doctorServiceId string) (*entity.ServiceResources, error)
+}
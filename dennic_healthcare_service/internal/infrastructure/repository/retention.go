@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+type RetentionRepository interface {
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+)
+
+type SearchRepository interface {
+	Search(ctx context.Context, in *entity.SearchReq) (*entity.SearchRes, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+)
+
+type ServiceDiscountRepository interface {
+	CreateServiceDiscount(ctx context.Context, in *entity.ServiceDiscount) (*entity.ServiceDiscount, error)
+	GetServiceDiscount(ctx context.Context, id string) (*entity.ServiceDiscount, error)
+	ListServiceDiscounts(ctx context.Context, in *entity.ListServiceDiscountsReq) (*entity.ListServiceDiscounts, error)
+	UpdateServiceDiscount(ctx context.Context, in *entity.ServiceDiscount) (*entity.ServiceDiscount, error)
+	DeleteServiceDiscount(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+)
+
+type ServicePackageRepository interface {
+	CreateServicePackage(ctx context.Context, in *entity.ServicePackage) (*entity.ServicePackage, error)
+	GetServicePackage(ctx context.Context, id string) (*entity.ServicePackage, error)
+	ListServicePackages(ctx context.Context, in *entity.ListServicePackagesReq) (*entity.ListServicePackages, error)
+	UpdateServicePackage(ctx context.Context, in *entity.ServicePackage) (*entity.ServicePackage, error)
+	DeleteServicePackage(ctx context.Context, id string) (bool, error)
+}
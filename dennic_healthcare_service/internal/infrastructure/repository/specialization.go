@@ -11,4 +11,6 @@ type SpecializationRepository interface {
 	GetAllSpecializations(ctx context.Context, all *entity.GetAllSpecializations) (*entity.ListSpecializations, error)
 	UpdateSpecialization(ctx context.Context, in *entity.Specialization) (*entity.Specialization, error)
 	DeleteSpecialization(ctx context.Context, in *entity.GetReqStr) (bool, error)
+	RestoreSpecialization(ctx context.Context, id string) (bool, error)
+	GetSpecializationTree(ctx context.Context, in *entity.SpecializationTreeReq) (*entity.ListSpecializations, error)
 }
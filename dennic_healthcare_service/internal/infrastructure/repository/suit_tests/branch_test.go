@@ -0,0 +1,166 @@
+package suit_tests
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	repo "Healthcare_Evrone/internal/infrastructure/repository/postgresql"
+	"Healthcare_Evrone/internal/pkg/config"
+	db "Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"log"
+	"testing"
+	"time"
+)
+
+type BranchTestSuite struct {
+	suite.Suite
+	CleanUpFunc          func()
+	Repository           *repo.Branch
+	RepositoryDepartment *repo.DepartMent
+}
+
+func (s *BranchTestSuite) SetupTest() {
+	pgPool, err := db.New(config.New())
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	s.Repository = repo.NewBranchRepo(pgPool)
+	s.RepositoryDepartment = repo.NewDepartmentRepo(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *BranchTestSuite) TestBranch() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	// Tashkent and Samarkand are about 270 km apart
+	tashkent, err := s.Repository.CreateBranch(ctx, &entity.Branch{
+		Id:           uuid.NewString(),
+		Name:         "Test Tashkent branch",
+		Address:      "Test address",
+		City:         "Test Tashkent",
+		Latitude:     41.2995,
+		Longitude:    69.2401,
+		OpensAt:      "08:00",
+		ClosesAt:     "20:00",
+		PhoneNumbers: []string{"+998711234567", "+998711234568"},
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal("08:00", tashkent.OpensAt)
+	s.Suite.Equal("20:00", tashkent.ClosesAt)
+	s.Suite.Len(tashkent.PhoneNumbers, 2)
+
+	samarkand, err := s.Repository.CreateBranch(ctx, &entity.Branch{
+		Id:        uuid.NewString(),
+		Name:      "Test Samarkand branch",
+		Address:   "Test address",
+		City:      "Test Samarkand",
+		Latitude:  39.6542,
+		Longitude: 66.9597,
+		OpensAt:   "09:00",
+		ClosesAt:  "18:00",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Empty(samarkand.PhoneNumbers)
+
+	branches, err := s.Repository.ListBranches(ctx, &entity.ListBranchesReq{
+		Page:  1,
+		Limit: 10,
+		City:  "test tashkent",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), branches.Count)
+	s.Suite.Equal(tashkent.Id, branches.Branches[0].Id)
+
+	// the nearest branch goes first
+	nearby, err := s.Repository.ListBranchesNearby(ctx, &entity.BranchesNearbyReq{
+		Latitude:  39.65,
+		Longitude: 66.97,
+		RadiusKm:  1000,
+	})
+	s.Suite.NoError(err)
+	var ids []string
+	for _, branch := range nearby {
+		ids = append(ids, branch.Branch.Id)
+	}
+	s.Suite.Contains(ids, samarkand.Id)
+	s.Suite.Contains(ids, tashkent.Id)
+	for i, branch := range nearby {
+		if i > 0 {
+			s.Suite.LessOrEqual(nearby[i-1].DistanceKm, branch.DistanceKm)
+		}
+		switch branch.Branch.Id {
+		case samarkand.Id:
+			s.Suite.Less(branch.DistanceKm, 2.0)
+		case tashkent.Id:
+			s.Suite.InDelta(270, branch.DistanceKm, 20)
+		}
+	}
+
+	// the radius leaves out the far branch
+	nearby, err = s.Repository.ListBranchesNearby(ctx, &entity.BranchesNearbyReq{
+		Latitude:  39.65,
+		Longitude: 66.97,
+		RadiusKm:  50,
+	})
+	s.Suite.NoError(err)
+	for _, branch := range nearby {
+		s.Suite.NotEqual(tashkent.Id, branch.Branch.Id)
+	}
+
+	department, err := s.RepositoryDepartment.CreateDepartment(ctx, &entity.Department{
+		Id:          uuid.NewString(),
+		Name:        "Test name",
+		Description: "Test description",
+		ImageUrl:    "Test imageUrl",
+		FloorNumber: 1,
+		BranchId:    samarkand.Id,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(samarkand.Id, department.BranchId)
+
+	// an unknown branch is rejected
+	_, err = s.RepositoryDepartment.CreateDepartment(ctx, &entity.Department{
+		Id:          uuid.NewString(),
+		Name:        "Test name",
+		Description: "Test description",
+		ImageUrl:    "Test imageUrl",
+		FloorNumber: 1,
+		BranchId:    uuid.NewString(),
+	})
+	s.Suite.Error(err)
+
+	tashkent.ClosesAt = "21:30"
+	tashkent.PhoneNumbers = []string{"+998711234567"}
+	updated, err := s.Repository.UpdateBranch(ctx, tashkent)
+	s.Suite.NoError(err)
+	s.Suite.Equal("21:30", updated.ClosesAt)
+	s.Suite.Len(updated.PhoneNumbers, 1)
+
+	for _, id := range []string{tashkent.Id, samarkand.Id} {
+		status, err := s.Repository.DeleteBranch(ctx, id)
+		s.Suite.NoError(err)
+		s.Suite.True(status)
+
+		_, err = s.Repository.GetBranch(ctx, id)
+		s.Suite.Error(err)
+	}
+
+	deleteDep, err := s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: true,
+	})
+	s.Suite.NotNil(deleteDep)
+	s.Suite.NoError(err)
+}
+
+func (s *BranchTestSuite) TearDownTest() {
+	s.CleanUpFunc()
+}
+
+func TestBranchTestSuite(t *testing.T) {
+	suite.Run(t, new(BranchTestSuite))
+}
@@ -99,10 +99,12 @@ func (s *DoctorWorkingHoursTestSuite) TestDoctorWorkingHoursCrud() {
 	s.Suite.Equal(respDoctor.Password, doctor.Password)
 
 	dwh := &entity.DoctorWorkingHours{
-		DoctorId:   doctor.Id,
-		DayOfWeek:  "Monday",
-		StartTime:  "12-12-12 12:12:12",
-		FinishTime: "12-12-12 12:12:12",
+		DoctorId:      doctor.Id,
+		DayOfWeek:     "Monday",
+		Kind:          entity.WorkingHoursShift,
+		StartTime:     "09:00:00",
+		FinishTime:    "13:00:00",
+		EffectiveFrom: "2024-01-01",
 	}
 	respDoctorService, err := s.Repository.CreateDoctorWorkingHours(ctx, dwh)
 	s.Suite.NoError(err)
@@ -110,8 +112,45 @@ func (s *DoctorWorkingHoursTestSuite) TestDoctorWorkingHoursCrud() {
 	s.Suite.NotNil(respDoctorService.CreatedAt)
 	s.Suite.Equal(respDoctorService.DoctorId, dwh.DoctorId)
 	s.Suite.Equal(respDoctorService.DayOfWeek, dwh.DayOfWeek)
-	s.Suite.Equal(respDoctorService.StartTime, dwh.StartTime)
-	s.Suite.Equal(respDoctorService.StartTime, dwh.StartTime)
+	s.Suite.Equal("09:00:00", respDoctorService.StartTime)
+	s.Suite.Equal("13:00:00", respDoctorService.FinishTime)
+	s.Suite.Equal("2024-01-01", respDoctorService.EffectiveFrom)
+	s.Suite.Equal("", respDoctorService.EffectiveTo)
+
+	// a second shift of the day may not overlap the first one
+	_, err = s.Repository.CreateDoctorWorkingHours(ctx, &entity.DoctorWorkingHours{
+		DoctorId:   doctor.Id,
+		DayOfWeek:  "Monday",
+		Kind:       entity.WorkingHoursShift,
+		StartTime:  "12:00:00",
+		FinishTime: "18:00:00",
+	})
+	s.Suite.Error(err)
+
+	afternoon, err := s.Repository.CreateDoctorWorkingHours(ctx, &entity.DoctorWorkingHours{
+		DoctorId:   doctor.Id,
+		DayOfWeek:  "Monday",
+		Kind:       entity.WorkingHoursShift,
+		StartTime:  "14:00:00",
+		FinishTime: "18:00:00",
+	})
+	s.Suite.NoError(err)
+
+	lunch, err := s.Repository.CreateDoctorWorkingHours(ctx, &entity.DoctorWorkingHours{
+		DoctorId:   doctor.Id,
+		DayOfWeek:  "Monday",
+		Kind:       entity.WorkingHoursBreak,
+		StartTime:  "11:00:00",
+		FinishTime: "11:30:00",
+	})
+	s.Suite.NoError(err)
+
+	dayHours, err := s.Repository.ListDoctorDayWorkingHours(ctx, &entity.GetReqDayWorkingHours{
+		DoctorId:  doctor.Id,
+		DayOfWeek: "Monday",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(dayHours, 3)
 
 	getDoctorWorkingHours, err := s.Repository.GetDoctorWorkingHoursById(ctx, &entity.GetRequest{
 		Field:    "id",
@@ -120,10 +159,11 @@ func (s *DoctorWorkingHoursTestSuite) TestDoctorWorkingHoursCrud() {
 	})
 	s.Suite.NoError(err)
 	s.Suite.NotNil(getDoctorWorkingHours)
-	s.Suite.Equal(respDoctorService.DoctorId, dwh.DoctorId)
-	s.Suite.Equal(respDoctorService.DayOfWeek, dwh.DayOfWeek)
-	s.Suite.Equal(respDoctorService.StartTime, dwh.StartTime)
-	s.Suite.Equal(respDoctorService.StartTime, dwh.StartTime)
+	s.Suite.Equal(respDoctorService.DoctorId, getDoctorWorkingHours.DoctorId)
+	s.Suite.Equal(respDoctorService.DayOfWeek, getDoctorWorkingHours.DayOfWeek)
+	s.Suite.Equal(respDoctorService.StartTime, getDoctorWorkingHours.StartTime)
+	s.Suite.Equal(respDoctorService.FinishTime, getDoctorWorkingHours.FinishTime)
+	s.Suite.Equal(entity.WorkingHoursShift, getDoctorWorkingHours.Kind)
 
 	respAll, err := s.Repository.GetAllDoctorWorkingHours(ctx, &entity.GetAll{
 		Page:     1,
@@ -139,11 +179,14 @@ func (s *DoctorWorkingHoursTestSuite) TestDoctorWorkingHoursCrud() {
 	newUpDayOfWeek := "Sunday"
 
 	updatedDoctorWorkingHours, err := s.Repository.UpdateDoctorWorkingHours(ctx, &entity.DoctorWorkingHours{
-		Id:         dwh.Id,
-		DoctorId:   dwh.DoctorId,
-		DayOfWeek:  newUpDayOfWeek,
-		StartTime:  "12-12-12 12:12:12",
-		FinishTime: "12-12-12 12:12:12",
+		Id:            dwh.Id,
+		DoctorId:      dwh.DoctorId,
+		DayOfWeek:     newUpDayOfWeek,
+		Kind:          entity.WorkingHoursShift,
+		StartTime:     "09:00:00",
+		FinishTime:    "13:00:00",
+		EffectiveFrom: "2024-01-01",
+		EffectiveTo:   "2024-12-31",
 	})
 	s.Suite.NoError(err)
 	s.Suite.NotNil(updatedDoctorWorkingHours)
@@ -151,7 +194,34 @@ func (s *DoctorWorkingHoursTestSuite) TestDoctorWorkingHoursCrud() {
 	s.Suite.Equal(respDoctorService.DoctorId, updatedDoctorWorkingHours.DoctorId)
 	s.Suite.Equal(newUpDayOfWeek, updatedDoctorWorkingHours.DayOfWeek)
 	s.Suite.Equal(respDoctorService.StartTime, updatedDoctorWorkingHours.StartTime)
-	s.Suite.Equal(respDoctorService.StartTime, updatedDoctorWorkingHours.StartTime)
+	s.Suite.Equal("2024-12-31", updatedDoctorWorkingHours.EffectiveTo)
+
+	// a soft deleted break leaves the day and is deleted once
+	for _, deleted := range []bool{true, false} {
+		softDeleted, err := s.Repository.DeleteDoctorWorkingHours(ctx, &entity.GetReqStr{
+			Field:    "id",
+			Value:    cast.ToString(lunch.Id),
+			IsActive: false,
+		})
+		s.Suite.NoError(err)
+		s.Suite.Equal(deleted, softDeleted)
+	}
+	dayHours, err = s.Repository.ListDoctorDayWorkingHours(ctx, &entity.GetReqDayWorkingHours{
+		DoctorId:  doctor.Id,
+		DayOfWeek: "Monday",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(dayHours, 1)
+
+	for _, id := range []int32{afternoon.Id, lunch.Id} {
+		deleted, err := s.Repository.DeleteDoctorWorkingHours(ctx, &entity.GetReqStr{
+			Field:    "id",
+			Value:    cast.ToString(id),
+			IsActive: true,
+		})
+		s.Suite.NoError(err)
+		s.Suite.True(deleted)
+	}
 
 	deleteDoctorWorkingHours, err := s.Repository.DeleteDoctorWorkingHours(ctx, &entity.GetReqStr{
 		Field:    "id",
@@ -0,0 +1,203 @@
+package suit_tests
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	repo "Healthcare_Evrone/internal/infrastructure/repository/postgresql"
+	"Healthcare_Evrone/internal/pkg/config"
+	db "Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"log"
+	"testing"
+	"time"
+)
+
+type DoctorCredentialTestSuite struct {
+	suite.Suite
+	CleanUpFunc          func()
+	Repository           *repo.DoctorCredential
+	RepositoryDoctor     *repo.DocTor
+	RepositoryDepartment *repo.DepartMent
+}
+
+func (s *DoctorCredentialTestSuite) SetupTest() {
+	pgPool, err := db.New(config.New())
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	s.Repository = repo.NewDoctorCredentialRepo(pgPool)
+	s.RepositoryDoctor = repo.NewDoctorRepo(pgPool)
+	s.RepositoryDepartment = repo.NewDepartmentRepo(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *DoctorCredentialTestSuite) TestDoctorCredential() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	department := &entity.Department{
+		Id:          uuid.NewString(),
+		Name:        "Test name",
+		Description: "Test description",
+		ImageUrl:    "Test imageUrl",
+		FloorNumber: 1,
+	}
+	_, err := s.RepositoryDepartment.CreateDepartment(ctx, department)
+	s.Suite.NoError(err)
+
+	doctor := &entity.Doctor{
+		Id:            uuid.NewString(),
+		FirstName:     "Test first name",
+		LastName:      "Test last name",
+		Gender:        "male",
+		BirthDate:     "12-12-12",
+		PhoneNumber:   "Testphonenumber",
+		Email:         "Test credential email",
+		Address:       "Test address",
+		City:          "Test city",
+		Country:       "Test country",
+		Salary:        1.1,
+		Bio:           "Test bio",
+		StartWorkDate: "12-12-12",
+		EndWorkDate:   "12-12-12",
+		WorkYears:     3,
+		DepartmentId:  department.Id,
+		RoomNumber:    1,
+		Password:      "Test password",
+	}
+	_, err = s.RepositoryDoctor.CreateDoctor(ctx, doctor)
+	s.Suite.NoError(err)
+
+	// an unknown doctor has no credentials
+	_, err = s.Repository.CreateDoctorCredential(ctx, &entity.DoctorCredential{
+		DoctorId:    uuid.NewString(),
+		Type:        entity.CredentialDiploma,
+		IssuingBody: "Test university",
+		IssueDate:   "2015-06-30",
+	})
+	s.Suite.Error(err)
+
+	diploma, err := s.Repository.CreateDoctorCredential(ctx, &entity.DoctorCredential{
+		DoctorId:    doctor.Id,
+		Type:        entity.CredentialDiploma,
+		IssuingBody: "Test university",
+		Number:      "D-1",
+		IssueDate:   "2015-06-30",
+		Document:    "diploma.pdf",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(doctor.Id, diploma.DoctorId)
+	s.Suite.Equal("2015-06-30", diploma.IssueDate)
+	s.Suite.Empty(diploma.ExpiryDate)
+	s.Suite.Equal("diploma.pdf", diploma.Document)
+
+	// the doctor has no license yet
+	status, err := s.Repository.GetDoctorLicenseStatus(ctx, &entity.DoctorLicenseStatusReq{
+		DoctorId: doctor.Id,
+		Date:     time.Now().Format("2006-01-02"),
+	})
+	s.Suite.NoError(err)
+	s.Suite.False(status.HasLicense)
+	s.Suite.False(status.Expired)
+
+	expiry := time.Now().AddDate(0, 0, 10).Format("2006-01-02")
+	license, err := s.Repository.CreateDoctorCredential(ctx, &entity.DoctorCredential{
+		DoctorId:    doctor.Id,
+		Type:        entity.CredentialLicense,
+		IssuingBody: "Test ministry",
+		Number:      "L-1",
+		IssueDate:   "2020-01-01",
+		ExpiryDate:  expiry,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(expiry, license.ExpiryDate)
+	s.Suite.True(license.ExpiryFlaggedAt.IsZero())
+
+	flagged, err := s.Repository.FlagExpiringLicenses(ctx, time.Now().AddDate(0, 0, 30).Format("2006-01-02"))
+	s.Suite.NoError(err)
+	var found bool
+	for _, credential := range flagged {
+		if credential.Id == license.Id {
+			found = true
+			s.Suite.False(credential.ExpiryFlaggedAt.IsZero())
+		}
+		s.Suite.NotEqual(diploma.Id, credential.Id)
+	}
+	s.Suite.True(found)
+
+	// a license is flagged once
+	flagged, err = s.Repository.FlagExpiringLicenses(ctx, time.Now().AddDate(0, 0, 30).Format("2006-01-02"))
+	s.Suite.NoError(err)
+	for _, credential := range flagged {
+		s.Suite.NotEqual(license.Id, credential.Id)
+	}
+
+	expiring, err := s.Repository.ListDoctorCredentials(ctx, &entity.ListDoctorCredentialsReq{
+		DoctorId: doctor.Id,
+		Expiring: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), expiring.Count)
+	s.Suite.Equal(license.Id, expiring.Credentials[0].Id)
+
+	status, err = s.Repository.GetDoctorLicenseStatus(ctx, &entity.DoctorLicenseStatusReq{
+		DoctorId: doctor.Id,
+		Date:     time.Now().AddDate(0, 0, 20).Format("2006-01-02"),
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(status.HasLicense)
+	s.Suite.True(status.Expired)
+	s.Suite.Equal(expiry, status.ExpiryDate)
+
+	// the renewed license clears the flag
+	license.ExpiryDate = time.Now().AddDate(5, 0, 0).Format("2006-01-02")
+	renewed, err := s.Repository.UpdateDoctorCredential(ctx, license)
+	s.Suite.NoError(err)
+	s.Suite.True(renewed.ExpiryFlaggedAt.IsZero())
+
+	status, err = s.Repository.GetDoctorLicenseStatus(ctx, &entity.DoctorLicenseStatusReq{
+		DoctorId: doctor.Id,
+		Date:     time.Now().AddDate(0, 0, 20).Format("2006-01-02"),
+	})
+	s.Suite.NoError(err)
+	s.Suite.False(status.Expired)
+
+	deleted, err := s.Repository.DeleteDoctorCredential(ctx, diploma.Id)
+	s.Suite.NoError(err)
+	s.Suite.True(deleted)
+
+	_, err = s.Repository.GetDoctorCredential(ctx, diploma.Id)
+	s.Suite.Error(err)
+
+	list, err := s.Repository.ListDoctorCredentials(ctx, &entity.ListDoctorCredentialsReq{
+		DoctorId: doctor.Id,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), list.Count)
+
+	deleteDoctor, err := s.RepositoryDoctor.DeleteDoctor(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    doctor.Id,
+		IsActive: true,
+	})
+	s.Suite.NotNil(deleteDoctor)
+	s.Suite.NoError(err)
+
+	deleteDep, err := s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: true,
+	})
+	s.Suite.NotNil(deleteDep)
+	s.Suite.NoError(err)
+}
+
+func (s *DoctorCredentialTestSuite) TearDownTest() {
+	s.CleanUpFunc()
+}
+
+func TestDoctorCredentialTestSuite(t *testing.T) {
+	suite.Run(t, new(DoctorCredentialTestSuite))
+}
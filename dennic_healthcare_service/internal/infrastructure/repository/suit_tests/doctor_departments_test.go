@@ -0,0 +1,197 @@
+package suit_tests
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	repo "Healthcare_Evrone/internal/infrastructure/repository/postgresql"
+	"Healthcare_Evrone/internal/pkg/config"
+	db "Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"log"
+	"testing"
+	"time"
+)
+
+type DoctorDepartmentsTestSuite struct {
+	suite.Suite
+	CleanUpFunc              func()
+	RepositoryDoctor         *repo.DocTor
+	RepositoryDoctorService  *repo.Ds
+	RepositorySpecialization *repo.Specialization
+	RepositoryDepartment     *repo.DepartMent
+}
+
+func (s *DoctorDepartmentsTestSuite) SetupTest() {
+	pgPool, err := db.New(config.New())
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	s.RepositoryDoctor = repo.NewDoctorRepo(pgPool)
+	s.RepositoryDoctorService = repo.NewDoctorServicesRepo(pgPool)
+	s.RepositorySpecialization = repo.NewSpecializationRepo(pgPool)
+	s.RepositoryDepartment = repo.NewDepartmentRepo(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *DoctorDepartmentsTestSuite) TestDoctorDepartments() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	var departmentIds []string
+	for _, name := range []string{"Test cardiology", "Test pediatrics"} {
+		department, err := s.RepositoryDepartment.CreateDepartment(ctx, &entity.Department{
+			Id:          uuid.NewString(),
+			Name:        name,
+			Description: "Test description",
+			ImageUrl:    "Test imageUrl",
+			FloorNumber: 1,
+		})
+		s.Suite.NoError(err)
+		departmentIds = append(departmentIds, department.Id)
+	}
+
+	doctor, err := s.RepositoryDoctor.CreateDoctor(ctx, &entity.Doctor{
+		Id:            uuid.NewString(),
+		FirstName:     "Test first name",
+		LastName:      "Test last name",
+		Gender:        "male",
+		BirthDate:     "12-12-12",
+		PhoneNumber:   "Testphonenumber",
+		Email:         "Test email",
+		Address:       "Test address",
+		City:          "Test city",
+		Country:       "Test country",
+		Salary:        1.1,
+		Bio:           "Test bio",
+		StartWorkDate: "12-12-12",
+		EndWorkDate:   "12-12-12",
+		WorkYears:     3,
+		DepartmentId:  departmentIds[0],
+		DepartmentIds: departmentIds[1:],
+		RoomNumber:    1,
+		Password:      "Test password",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(departmentIds, doctor.DepartmentIds)
+
+	// the doctor is listed in both of its departments
+	for _, departmentId := range departmentIds {
+		doctors, err := s.RepositoryDoctor.ListDoctorsByDepartmentId(ctx, &entity.GetReqStrDep{
+			DepartmentId: departmentId,
+			Page:         1,
+			Limit:        10,
+		})
+		s.Suite.NoError(err)
+		s.Suite.Equal(int64(1), doctors.Count)
+		s.Suite.Equal(doctor.Id, doctors.Doctors[0].Id)
+	}
+
+	parent, err := s.RepositorySpecialization.CreateSpecialization(ctx, &entity.Specialization{
+		ID:           uuid.NewString(),
+		Name:         "Test cardiology",
+		Description:  "Test Description",
+		DepartmentId: departmentIds[0],
+	})
+	s.Suite.NoError(err)
+	s.Suite.Empty(parent.ParentId)
+	child, err := s.RepositorySpecialization.CreateSpecialization(ctx, &entity.Specialization{
+		ID:           uuid.NewString(),
+		Name:         "Test pediatric cardiology",
+		Description:  "Test Description",
+		DepartmentId: departmentIds[1],
+		ParentId:     parent.ID,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(parent.ID, child.ParentId)
+
+	tree, err := s.RepositorySpecialization.GetSpecializationTree(ctx, &entity.SpecializationTreeReq{RootId: parent.ID})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int32(2), tree.Count)
+	s.Suite.Equal(parent.ID, tree.Specializations[0].ID)
+	s.Suite.Equal(child.ID, tree.Specializations[1].ID)
+
+	children, err := s.RepositorySpecialization.GetAllSpecializations(ctx, &entity.GetAllSpecializations{
+		Page:     1,
+		Limit:    10,
+		ParentId: parent.ID,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int32(1), children.Count)
+
+	tm, err := time.Parse("15:04", "00:30")
+	s.Suite.NoError(err)
+	doctorService, err := s.RepositoryDoctorService.CreateDoctorServices(ctx, &entity.DoctorServices{
+		Id:               uuid.NewString(),
+		DoctorId:         doctor.Id,
+		SpecializationId: child.ID,
+		OnlinePrice:      100,
+		OfflinePrice:     100,
+		Name:             "Test Name",
+		Duration:         tm,
+	})
+	s.Suite.NoError(err)
+
+	// a doctor of the sub-specialization is listed under the parent specialization too
+	for _, specializationId := range []string{parent.ID, child.ID} {
+		doctors, err := s.RepositoryDoctor.ListDoctorBySpecializationId(ctx, &entity.GetReqStrSpec{
+			SpecializationId: specializationId,
+			Page:             1,
+			Limit:            10,
+		})
+		s.Suite.NoError(err)
+		s.Suite.Equal(int64(1), doctors.Count)
+		s.Suite.Equal(doctor.Id, doctors.Doctors[0].Id)
+	}
+
+	// dropping a department keeps only the main one
+	doctor.DepartmentIds = nil
+	updated, err := s.RepositoryDoctor.UpdateDoctor(ctx, doctor)
+	s.Suite.NoError(err)
+	s.Suite.Equal(departmentIds[:1], updated.DepartmentIds)
+	doctors, err := s.RepositoryDoctor.ListDoctorsByDepartmentId(ctx, &entity.GetReqStrDep{
+		DepartmentId: departmentIds[1],
+		Page:         1,
+		Limit:        10,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(0), doctors.Count)
+
+	_, err = s.RepositoryDoctorService.DeleteDoctorService(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    doctorService.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	for _, id := range []string{child.ID, parent.ID} {
+		_, err = s.RepositorySpecialization.DeleteSpecialization(ctx, &entity.GetReqStr{
+			Field:    "id",
+			Value:    id,
+			IsActive: true,
+		})
+		s.Suite.NoError(err)
+	}
+	_, err = s.RepositoryDoctor.DeleteDoctor(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    doctor.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	for _, id := range departmentIds {
+		_, err = s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+			Field:    "id",
+			Value:    id,
+			IsActive: true,
+		})
+		s.Suite.NoError(err)
+	}
+}
+
+func (s *DoctorDepartmentsTestSuite) TearDownTest() {
+	s.CleanUpFunc()
+}
+
+func TestDoctorDepartmentsTestSuite(t *testing.T) {
+	suite.Run(t, new(DoctorDepartmentsTestSuite))
+}
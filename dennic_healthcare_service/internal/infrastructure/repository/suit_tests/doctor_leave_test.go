@@ -0,0 +1,186 @@
+package suit_tests
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	repo "Healthcare_Evrone/internal/infrastructure/repository/postgresql"
+	"Healthcare_Evrone/internal/pkg/config"
+	db "Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"log"
+	"testing"
+	"time"
+)
+
+type DoctorLeaveTestSuite struct {
+	suite.Suite
+	CleanUpFunc          func()
+	Repository           *repo.DoctorLeave
+	RepositoryDoctor     *repo.DocTor
+	RepositoryDepartment *repo.DepartMent
+}
+
+func (s *DoctorLeaveTestSuite) SetupTest() {
+	pgPool, err := db.New(config.New())
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	s.Repository = repo.NewDoctorLeaveRepo(pgPool)
+	s.RepositoryDoctor = repo.NewDoctorRepo(pgPool)
+	s.RepositoryDepartment = repo.NewDepartmentRepo(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *DoctorLeaveTestSuite) TestDoctorLeave() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	department := &entity.Department{
+		Id:          uuid.NewString(),
+		Name:        "Test name",
+		Description: "Test description",
+		ImageUrl:    "Test imageUrl",
+		FloorNumber: 1,
+	}
+	_, err := s.RepositoryDepartment.CreateDepartment(ctx, department)
+	s.Suite.NoError(err)
+
+	doctor := &entity.Doctor{
+		Id:            uuid.NewString(),
+		FirstName:     "Test first name",
+		LastName:      "Test last name",
+		Gender:        "male",
+		BirthDate:     "12-12-12",
+		PhoneNumber:   "Testphonenumber",
+		Email:         "Test leave email",
+		Address:       "Test address",
+		City:          "Test city",
+		Country:       "Test country",
+		Salary:        1.1,
+		Bio:           "Test bio",
+		StartWorkDate: "12-12-12",
+		EndWorkDate:   "12-12-12",
+		WorkYears:     3,
+		DepartmentId:  department.Id,
+		RoomNumber:    1,
+		Password:      "Test password",
+	}
+	_, err = s.RepositoryDoctor.CreateDoctor(ctx, doctor)
+	s.Suite.NoError(err)
+
+	// an unknown doctor has no leaves
+	_, err = s.Repository.CreateDoctorLeave(ctx, &entity.DoctorLeave{
+		DoctorId:  uuid.NewString(),
+		Kind:      entity.LeaveVacation,
+		StartDate: "2024-07-01",
+		EndDate:   "2024-07-10",
+	})
+	s.Suite.Error(err)
+
+	leave, err := s.Repository.CreateDoctorLeave(ctx, &entity.DoctorLeave{
+		DoctorId:  doctor.Id,
+		Kind:      entity.LeaveVacation,
+		StartDate: "2024-07-01",
+		EndDate:   "2024-07-10",
+		Reason:    "Test reason",
+	})
+	s.Suite.NoError(err)
+	s.Suite.NotNil(leave)
+	s.Suite.Equal(doctor.Id, leave.DoctorId)
+	s.Suite.Equal(department.Id, leave.DepartmentId)
+	s.Suite.Equal(entity.LeavePending, leave.Status)
+	s.Suite.Equal("2024-07-01", leave.StartDate)
+	s.Suite.Equal("2024-07-10", leave.EndDate)
+	s.Suite.True(leave.ReviewedAt.IsZero())
+
+	leaves, err := s.Repository.ListDoctorLeaves(ctx, &entity.ListDoctorLeavesReq{
+		Page:     1,
+		Limit:    10,
+		DoctorId: doctor.Id,
+		From:     "2024-07-05",
+		To:       "2024-07-20",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), leaves.Count)
+	s.Suite.Len(leaves.Leaves, 1)
+
+	// the pending leave does not make the doctor away
+	away, err := s.Repository.ListAwayDoctors(ctx, &entity.AwayDoctorsReq{
+		Date:         "2024-07-05",
+		DepartmentId: department.Id,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Empty(away.Doctors)
+
+	approved, err := s.Repository.SetDoctorLeaveStatus(ctx, &entity.ReviewDoctorLeaveReq{
+		Id:         leave.Id,
+		Status:     entity.LeaveApproved,
+		ReviewedBy: "Test admin",
+		ReviewNote: "Test note",
+	}, entity.LeavePending)
+	s.Suite.NoError(err)
+	s.Suite.Equal(entity.LeaveApproved, approved.Status)
+	s.Suite.Equal("Test admin", approved.ReviewedBy)
+	s.Suite.False(approved.ReviewedAt.IsZero())
+
+	// an approved leave is no longer pending
+	_, err = s.Repository.SetDoctorLeaveStatus(ctx, &entity.ReviewDoctorLeaveReq{
+		Id:     leave.Id,
+		Status: entity.LeaveRejected,
+	}, entity.LeavePending)
+	s.Suite.Error(err)
+
+	away, err = s.Repository.ListAwayDoctors(ctx, &entity.AwayDoctorsReq{
+		Date:         "2024-07-10",
+		DepartmentId: department.Id,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(away.Doctors, 1)
+	s.Suite.Equal(doctor.Id, away.Doctors[0].DoctorId)
+	s.Suite.Equal(leave.Id, away.Doctors[0].LeaveId)
+
+	away, err = s.Repository.ListAwayDoctors(ctx, &entity.AwayDoctorsReq{
+		Date:         "2024-07-11",
+		DepartmentId: department.Id,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Empty(away.Doctors)
+
+	cancelled, err := s.Repository.SetDoctorLeaveStatus(ctx, &entity.ReviewDoctorLeaveReq{
+		Id:     leave.Id,
+		Status: entity.LeaveCancelled,
+	}, entity.LeaveApproved)
+	s.Suite.NoError(err)
+	s.Suite.Equal(entity.LeaveCancelled, cancelled.Status)
+	s.Suite.Equal("Test admin", cancelled.ReviewedBy)
+
+	// the leaves are deleted with the doctor
+	deleteDoctor, err := s.RepositoryDoctor.DeleteDoctor(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    doctor.Id,
+		IsActive: true,
+	})
+	s.Suite.NotNil(deleteDoctor)
+	s.Suite.NoError(err)
+
+	_, err = s.Repository.GetDoctorLeave(ctx, leave.Id)
+	s.Suite.Error(err)
+
+	deleteDep, err := s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: true,
+	})
+	s.Suite.NotNil(deleteDep)
+	s.Suite.NoError(err)
+}
+
+func (s *DoctorLeaveTestSuite) TearDownTest() {
+	s.CleanUpFunc()
+}
+
+func TestDoctorLeaveTestSuite(t *testing.T) {
+	suite.Run(t, new(DoctorLeaveTestSuite))
+}
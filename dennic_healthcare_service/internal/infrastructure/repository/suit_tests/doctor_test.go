@@ -211,6 +211,35 @@ func (s *DoctorTestSuite) TestDoctorCrud() {
 	s.Suite.NoError(err)
 	s.Suite.NotNil(resp)
 
+	rated, err := s.Repository.SetDoctorRating(ctx, &entity.DoctorRating{
+		DoctorId:    doctor.Id,
+		Rating:      4.5,
+		ReviewCount: 2,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(rated)
+
+	_, err = s.Repository.ListDoctorsForReason(ctx, &entity.GetReqReasonDoctors{
+		ReasonId: uuid.NewString(),
+	})
+	s.Suite.Error(err)
+
+	serviceDoctors, err := s.Repository.ListDoctorsForService(ctx, &entity.GetReqServiceDoctors{
+		DepartmentId:    doctor.DepartmentId,
+		DoctorServiceId: uuid.NewString(),
+		DayOfWeek:       dwh.DayOfWeek,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Empty(serviceDoctors)
+
+	serviceDoctors, err = s.Repository.ListDoctorsForService(ctx, &entity.GetReqServiceDoctors{
+		DepartmentId:     doctor.DepartmentId,
+		SpecializationId: uuid.NewString(),
+		DayOfWeek:        dwh.DayOfWeek,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Empty(serviceDoctors)
+
 	deleteDoctor, err := s.Repository.DeleteDoctor(ctx, &entity.GetReqStr{
 		Field:    "id",
 		Value:    doctor.Id,
@@ -0,0 +1,101 @@
+package suit_tests
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	repo "Healthcare_Evrone/internal/infrastructure/repository/postgresql"
+	"Healthcare_Evrone/internal/pkg/config"
+	db "Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"log"
+	"testing"
+	"time"
+)
+
+type OutboxTestSuite struct {
+	suite.Suite
+	CleanUpFunc          func()
+	RepositoryDepartment *repo.DepartMent
+	RepositoryOutbox     *repo.Outbox
+}
+
+func (s *OutboxTestSuite) SetupTest() {
+	pgPool, err := db.New(config.New())
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	s.RepositoryDepartment = repo.NewDepartmentRepo(pgPool)
+	s.RepositoryOutbox = repo.NewOutboxRepo(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *OutboxTestSuite) TestOutbox() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	department, err := s.RepositoryDepartment.CreateDepartment(ctx, &entity.Department{
+		Id:          uuid.NewString(),
+		Name:        "Test cardiology",
+		Description: "Test description",
+		ImageUrl:    "Test imageUrl",
+		FloorNumber: 1,
+	})
+	s.Suite.NoError(err)
+	department.Name = "Test updated cardiology"
+	_, err = s.RepositoryDepartment.UpdateDepartment(ctx, department)
+	s.Suite.NoError(err)
+	status, err := s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(status)
+
+	// a failed publish leaves the events in the outbox
+	_, err = s.RepositoryOutbox.RelayEvents(ctx, 1000, func(ctx context.Context, events []entity.OutboxEvent) error {
+		return errors.New("broker unavailable")
+	})
+	s.Suite.Error(err)
+
+	var types []string
+	relayed, err := s.RepositoryOutbox.RelayEvents(ctx, 1000, func(ctx context.Context, events []entity.OutboxEvent) error {
+		for _, event := range events {
+			if event.AggregateId == department.Id {
+				types = append(types, event.Type)
+			}
+		}
+		return nil
+	})
+	s.Suite.NoError(err)
+	s.Suite.GreaterOrEqual(relayed, 3)
+	s.Suite.Equal([]string{
+		"healthcare.department.created",
+		"healthcare.department.updated",
+		"healthcare.department.deleted",
+	}, types)
+
+	// a published event is not relayed again
+	_, err = s.RepositoryOutbox.RelayEvents(ctx, 1000, func(ctx context.Context, events []entity.OutboxEvent) error {
+		for _, event := range events {
+			s.Suite.NotEqual(department.Id, event.AggregateId)
+		}
+		return nil
+	})
+	s.Suite.NoError(err)
+
+	purged, err := s.RepositoryOutbox.PurgePublished(ctx, time.Now().Add(time.Hour*6))
+	s.Suite.NoError(err)
+	s.Suite.GreaterOrEqual(purged, int64(3))
+}
+
+func (s *OutboxTestSuite) TearDownTest() {
+	s.CleanUpFunc()
+}
+
+func TestOutboxTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxTestSuite))
+}
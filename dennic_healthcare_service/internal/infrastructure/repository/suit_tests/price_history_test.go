@@ -0,0 +1,184 @@
+package suit_tests
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	repo "Healthcare_Evrone/internal/infrastructure/repository/postgresql"
+	"Healthcare_Evrone/internal/pkg/config"
+	db "Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"log"
+	"testing"
+	"time"
+)
+
+type PriceHistoryTestSuite struct {
+	suite.Suite
+	CleanUpFunc              func()
+	Repository               *repo.PriceHistory
+	RepositoryDoctor         *repo.DocTor
+	RepositoryDoctorService  *repo.Ds
+	RepositorySpecialization *repo.Specialization
+	RepositoryDepartment     *repo.DepartMent
+}
+
+func (s *PriceHistoryTestSuite) SetupTest() {
+	pgPool, err := db.New(config.New())
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	s.Repository = repo.NewPriceHistoryRepo(pgPool)
+	s.RepositoryDoctor = repo.NewDoctorRepo(pgPool)
+	s.RepositoryDoctorService = repo.NewDoctorServicesRepo(pgPool)
+	s.RepositorySpecialization = repo.NewSpecializationRepo(pgPool)
+	s.RepositoryDepartment = repo.NewDepartmentRepo(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *PriceHistoryTestSuite) TestPriceHistory() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	department, err := s.RepositoryDepartment.CreateDepartment(ctx, &entity.Department{
+		Id:          uuid.NewString(),
+		Name:        "Test name",
+		Description: "Test description",
+		ImageUrl:    "Test imageUrl",
+		FloorNumber: 1,
+	})
+	s.Suite.NoError(err)
+
+	doctor, err := s.RepositoryDoctor.CreateDoctor(ctx, &entity.Doctor{
+		Id:            uuid.NewString(),
+		FirstName:     "Test first name",
+		LastName:      "Test last name",
+		Gender:        "male",
+		BirthDate:     "12-12-12",
+		PhoneNumber:   "Testphonenumber",
+		Email:         "Test email",
+		Address:       "Test address",
+		City:          "Test city",
+		Country:       "Test country",
+		Salary:        1.1,
+		Bio:           "Test bio",
+		StartWorkDate: "12-12-12",
+		EndWorkDate:   "12-12-12",
+		WorkYears:     3,
+		DepartmentId:  department.Id,
+		RoomNumber:    1,
+		Password:      "Test password",
+	})
+	s.Suite.NoError(err)
+
+	special, err := s.RepositorySpecialization.CreateSpecialization(ctx, &entity.Specialization{
+		ID:           uuid.NewString(),
+		Name:         "Test Name",
+		Description:  "Test Description",
+		DepartmentId: department.Id,
+	})
+	s.Suite.NoError(err)
+
+	tm, err := time.Parse("15:04", "00:30")
+	s.Suite.NoError(err)
+	doctorService, err := s.RepositoryDoctorService.CreateDoctorServices(ctx, &entity.DoctorServices{
+		Id:               uuid.NewString(),
+		DoctorId:         doctor.Id,
+		SpecializationId: special.ID,
+		OnlinePrice:      100,
+		OfflinePrice:     150,
+		Name:             "Test Name",
+		Duration:         tm,
+	})
+	s.Suite.NoError(err)
+
+	// creating the service opens its price history
+	history, err := s.Repository.ListPriceHistory(ctx, &entity.PriceHistoryReq{DoctorServiceId: doctorService.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), history.Count)
+	s.Suite.Equal(float32(100), history.PriceChanges[0].OnlinePrice)
+
+	// an update keeping the prices adds nothing, a price change adds a row
+	_, err = s.RepositoryDoctorService.UpdateDoctorServices(ctx, doctorService)
+	s.Suite.NoError(err)
+	doctorService.OnlinePrice = 120
+	_, err = s.RepositoryDoctorService.UpdateDoctorServices(ctx, doctorService)
+	s.Suite.NoError(err)
+	history, err = s.Repository.ListPriceHistory(ctx, &entity.PriceHistoryReq{DoctorServiceId: doctorService.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), history.Count)
+	s.Suite.Equal(float32(120), history.PriceChanges[0].OnlinePrice)
+
+	now := time.Now().Add(time.Hour * 5)
+	scheduled, err := s.Repository.CreatePriceChange(ctx, &entity.PriceChange{
+		Id:              uuid.NewString(),
+		DoctorServiceId: doctorService.Id,
+		OnlinePrice:     200,
+		OfflinePrice:    250,
+		EffectiveFrom:   now.AddDate(0, 0, 7),
+	})
+	s.Suite.NoError(err)
+
+	price, err := s.Repository.GetPriceAt(ctx, doctorService.Id, now)
+	s.Suite.NoError(err)
+	s.Suite.Equal(float32(120), price.OnlinePrice)
+	price, err = s.Repository.GetPriceAt(ctx, doctorService.Id, now.AddDate(0, 0, 8))
+	s.Suite.NoError(err)
+	s.Suite.Equal(scheduled.Id, price.Id)
+	_, err = s.Repository.GetPriceAt(ctx, doctorService.Id, now.AddDate(-1, 0, 0))
+	s.Suite.Error(err)
+
+	// the scheduled price is applied to the service once it takes effect
+	applied, err := s.Repository.ApplyDuePrices(ctx, now.AddDate(0, 0, 8))
+	s.Suite.NoError(err)
+	s.Suite.GreaterOrEqual(applied, int64(1))
+	doctorServiceUpdated, err := s.RepositoryDoctorService.GetDoctorServiceByID(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    doctorService.Id,
+		IsActive: false,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(float32(200), doctorServiceUpdated.OnlinePrice)
+
+	// a price already in effect can not be cancelled, a scheduled one can
+	status, err := s.Repository.DeleteScheduledPriceChange(ctx, history.PriceChanges[0].Id, now)
+	s.Suite.NoError(err)
+	s.Suite.False(status)
+	status, err = s.Repository.DeleteScheduledPriceChange(ctx, scheduled.Id, now)
+	s.Suite.NoError(err)
+	s.Suite.True(status)
+
+	_, err = s.RepositoryDoctorService.DeleteDoctorService(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    doctorService.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	_, err = s.RepositorySpecialization.DeleteSpecialization(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    special.ID,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	_, err = s.RepositoryDoctor.DeleteDoctor(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    doctor.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	_, err = s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+}
+
+func (s *PriceHistoryTestSuite) TearDownTest() {
+	s.CleanUpFunc()
+}
+
+func TestPriceHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(PriceHistoryTestSuite))
+}
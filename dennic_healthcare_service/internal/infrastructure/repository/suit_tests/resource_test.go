@@ -0,0 +1,235 @@
+package suit_tests
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	repo "Healthcare_Evrone/internal/infrastructure/repository/postgresql"
+	"Healthcare_Evrone/internal/pkg/config"
+	db "Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"log"
+	"testing"
+	"time"
+)
+
+type ResourceTestSuite struct {
+	suite.Suite
+	CleanUpFunc              func()
+	Repository               *repo.Resource
+	RepositoryBranch         *repo.Branch
+	RepositoryDoctor         *repo.DocTor
+	RepositoryDoctorService  *repo.Ds
+	RepositorySpecialization *repo.Specialization
+	RepositoryDepartment     *repo.DepartMent
+}
+
+func (s *ResourceTestSuite) SetupTest() {
+	pgPool, err := db.New(config.New())
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	s.Repository = repo.NewResourceRepo(pgPool)
+	s.RepositoryBranch = repo.NewBranchRepo(pgPool)
+	s.RepositoryDoctor = repo.NewDoctorRepo(pgPool)
+	s.RepositoryDoctorService = repo.NewDoctorServicesRepo(pgPool)
+	s.RepositorySpecialization = repo.NewSpecializationRepo(pgPool)
+	s.RepositoryDepartment = repo.NewDepartmentRepo(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *ResourceTestSuite) TestResource() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	resourceType := "test-" + uuid.NewString()[:8]
+
+	branch, err := s.RepositoryBranch.CreateBranch(ctx, &entity.Branch{
+		Id:        uuid.NewString(),
+		Name:      "Test branch",
+		Address:   "Test address",
+		City:      "Test city",
+		Latitude:  41.2995,
+		Longitude: 69.2401,
+		OpensAt:   "08:00",
+		ClosesAt:  "20:00",
+	})
+	s.Suite.NoError(err)
+	otherBranch, err := s.RepositoryBranch.CreateBranch(ctx, &entity.Branch{
+		Id:        uuid.NewString(),
+		Name:      "Test other branch",
+		Address:   "Test address",
+		City:      "Test city",
+		Latitude:  39.6542,
+		Longitude: 66.9597,
+		OpensAt:   "08:00",
+		ClosesAt:  "20:00",
+	})
+	s.Suite.NoError(err)
+
+	department, err := s.RepositoryDepartment.CreateDepartment(ctx, &entity.Department{
+		Id:          uuid.NewString(),
+		Name:        "Test name",
+		Description: "Test description",
+		ImageUrl:    "Test imageUrl",
+		FloorNumber: 1,
+	})
+	s.Suite.NoError(err)
+
+	doctor, err := s.RepositoryDoctor.CreateDoctor(ctx, &entity.Doctor{
+		Id:            uuid.NewString(),
+		FirstName:     "Test first name",
+		LastName:      "Test last name",
+		Gender:        "male",
+		BirthDate:     "12-12-12",
+		PhoneNumber:   "Testphonenumber",
+		Email:         "Test email",
+		Address:       "Test address",
+		City:          "Test city",
+		Country:       "Test country",
+		Salary:        1.1,
+		Bio:           "Test bio",
+		StartWorkDate: "12-12-12",
+		EndWorkDate:   "12-12-12",
+		WorkYears:     3,
+		DepartmentId:  department.Id,
+		RoomNumber:    1,
+		Password:      "Test password",
+		BranchId:      branch.Id,
+	})
+	s.Suite.NoError(err)
+
+	special, err := s.RepositorySpecialization.CreateSpecialization(ctx, &entity.Specialization{
+		ID:           uuid.NewString(),
+		Name:         "Test Name",
+		Description:  "Test Description",
+		DepartmentId: department.Id,
+	})
+	s.Suite.NoError(err)
+
+	tm, err := time.Parse("15:04", "00:30")
+	s.Suite.NoError(err)
+	doctorService, err := s.RepositoryDoctorService.CreateDoctorServices(ctx, &entity.DoctorServices{
+		Id:                   uuid.NewString(),
+		DoctorId:             doctor.Id,
+		SpecializationId:     special.ID,
+		OnlinePrice:          1,
+		OfflinePrice:         1,
+		Name:                 "Test Name",
+		Duration:             tm,
+		RequiredResourceType: resourceType,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(resourceType, doctorService.RequiredResourceType)
+
+	// no resource of the type exists yet
+	serviceResources, err := s.Repository.ListServiceResources(ctx, doctorService.Id)
+	s.Suite.NoError(err)
+	s.Suite.Equal(resourceType, serviceResources.ResourceType)
+	s.Suite.Empty(serviceResources.ResourceIds)
+
+	sameBranch, err := s.Repository.CreateResource(ctx, &entity.Resource{
+		Id:           uuid.NewString(),
+		Name:         "Test room 1",
+		Kind:         entity.ResourceKindRoom,
+		ResourceType: resourceType,
+		BranchId:     branch.Id,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(branch.Id, sameBranch.BranchId)
+
+	anyBranch, err := s.Repository.CreateResource(ctx, &entity.Resource{
+		Id:           uuid.NewString(),
+		Name:         "Test room 2",
+		Kind:         entity.ResourceKindRoom,
+		ResourceType: resourceType,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Empty(anyBranch.BranchId)
+
+	otherResource, err := s.Repository.CreateResource(ctx, &entity.Resource{
+		Id:           uuid.NewString(),
+		Name:         "Test room 3",
+		Kind:         entity.ResourceKindRoom,
+		ResourceType: resourceType,
+		BranchId:     otherBranch.Id,
+	})
+	s.Suite.NoError(err)
+
+	// the resource of the other branch is not usable by the doctor
+	serviceResources, err = s.Repository.ListServiceResources(ctx, doctorService.Id)
+	s.Suite.NoError(err)
+	s.Suite.Equal([]string{sameBranch.Id, anyBranch.Id}, serviceResources.ResourceIds)
+
+	resources, err := s.Repository.ListResources(ctx, &entity.ListResourcesReq{
+		Page:         1,
+		Limit:        10,
+		ResourceType: resourceType,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(3), resources.Count)
+
+	otherResource.BranchId = ""
+	updated, err := s.Repository.UpdateResource(ctx, otherResource)
+	s.Suite.NoError(err)
+	s.Suite.Empty(updated.BranchId)
+
+	for _, id := range []string{sameBranch.Id, anyBranch.Id, otherResource.Id} {
+		status, err := s.Repository.DeleteResource(ctx, id)
+		s.Suite.NoError(err)
+		s.Suite.True(status)
+
+		_, err = s.Repository.GetResource(ctx, id)
+		s.Suite.Error(err)
+	}
+
+	// a service that needs no resource lists none
+	doctorService.RequiredResourceType = ""
+	_, err = s.RepositoryDoctorService.UpdateDoctorServices(ctx, doctorService)
+	s.Suite.NoError(err)
+	serviceResources, err = s.Repository.ListServiceResources(ctx, doctorService.Id)
+	s.Suite.NoError(err)
+	s.Suite.Empty(serviceResources.ResourceType)
+	s.Suite.Empty(serviceResources.ResourceIds)
+
+	_, err = s.Repository.ListServiceResources(ctx, uuid.NewString())
+	s.Suite.Error(err)
+
+	_, err = s.RepositoryDoctorService.DeleteDoctorService(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    doctorService.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	_, err = s.RepositorySpecialization.DeleteSpecialization(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    special.ID,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	_, err = s.RepositoryDoctor.DeleteDoctor(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    doctor.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	_, err = s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	for _, id := range []string{branch.Id, otherBranch.Id} {
+		_, err = s.RepositoryBranch.DeleteBranch(ctx, id)
+		s.Suite.NoError(err)
+	}
+}
+
+func (s *ResourceTestSuite) TearDownTest() {
+	s.CleanUpFunc()
+}
+
+func TestResourceTestSuite(t *testing.T) {
+	suite.Run(t, new(ResourceTestSuite))
+}
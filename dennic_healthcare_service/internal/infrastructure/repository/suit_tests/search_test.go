@@ -0,0 +1,178 @@
+package suit_tests
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	repo "Healthcare_Evrone/internal/infrastructure/repository/postgresql"
+	"Healthcare_Evrone/internal/pkg/config"
+	db "Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"log"
+	"testing"
+	"time"
+)
+
+type SearchTestSuite struct {
+	suite.Suite
+	CleanUpFunc              func()
+	Repository               *repo.Search
+	RepositoryReasons        *repo.Reasons
+	RepositorySpecialization *repo.Specialization
+	RepositoryDepartment     *repo.DepartMent
+}
+
+func (s *SearchTestSuite) SetupTest() {
+	pgPool, err := db.New(config.New())
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	s.Repository = repo.NewSearchRepo(pgPool)
+	s.RepositoryReasons = repo.NewReasonsRepo(pgPool)
+	s.RepositorySpecialization = repo.NewSpecializationRepo(pgPool)
+	s.RepositoryDepartment = repo.NewDepartmentRepo(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *SearchTestSuite) TestSearch() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	department := &entity.Department{
+		Id:          uuid.NewString(),
+		Name:        "Stomatologiya",
+		Description: "Tish davolash bo'limi",
+		ImageUrl:    "Test imageUrl",
+		FloorNumber: 1,
+	}
+	_, err := s.RepositoryDepartment.CreateDepartment(ctx, department)
+	s.Suite.NoError(err)
+
+	special := &entity.Specialization{
+		ID:           uuid.NewString(),
+		Name:         "Tish shifokori",
+		Description:  "Stomatolog",
+		DepartmentId: department.Id,
+	}
+	_, err = s.RepositorySpecialization.CreateSpecialization(ctx, special)
+	s.Suite.NoError(err)
+
+	reason := &entity.Reasons{
+		Id:               uuid.NewString(),
+		Name:             "Tish og'rig'i",
+		SpecializationId: special.ID,
+	}
+	_, err = s.RepositoryReasons.CreateReasons(ctx, reason)
+	s.Suite.NoError(err)
+
+	// full-text match on the words of the name
+	res, err := s.Repository.Search(ctx, &entity.SearchReq{
+		Query: "tish shifokori",
+		Page:  1,
+		Limit: 10,
+	})
+	s.Suite.NoError(err)
+	s.Suite.NotNil(res)
+	s.Suite.True(hasSearchHit(res, entity.SearchTypeSpecialization, special.ID))
+
+	// trigram match on a misspelled name
+	res, err = s.Repository.Search(ctx, &entity.SearchReq{
+		Query: "stomotologiya",
+		Types: []string{entity.SearchTypeDepartment},
+		Page:  1,
+		Limit: 10,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(hasSearchHit(res, entity.SearchTypeDepartment, department.Id))
+	for _, hit := range res.Hits {
+		s.Suite.Equal(hit.Type, entity.SearchTypeDepartment)
+	}
+
+	_, err = s.RepositoryReasons.DeleteReasons(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    reason.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+
+	_, err = s.RepositorySpecialization.DeleteSpecialization(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    special.ID,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+
+	_, err = s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+}
+
+func (s *SearchTestSuite) TestTransliteratedSearch() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	department := &entity.Department{
+		Id:               uuid.NewString(),
+		Name:             "Жўрахонов клиникаси",
+		Description:      "Test description",
+		ImageUrl:         "Test imageUrl",
+		FloorNumber:      1,
+		ShortDescription: "Test short description",
+	}
+	_, err := s.RepositoryDepartment.CreateDepartment(ctx, department)
+	s.Suite.NoError(err)
+
+	// the cyrillic name is found by its latin spelling
+	res, err := s.Repository.Search(ctx, &entity.SearchReq{
+		Query: "Jo'raxonov",
+		Types: []string{entity.SearchTypeDepartment},
+		Page:  1,
+		Limit: 10,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(hasSearchHit(res, entity.SearchTypeDepartment, department.Id))
+
+	departments, err := s.RepositoryDepartment.GetAllDepartments(ctx, &entity.GetAll{
+		Page:    1,
+		Limit:   10,
+		Field:   "name",
+		Value:   "JOʻRAXONOV",
+		OrderBy: "name",
+	})
+	s.Suite.NoError(err)
+	var found bool
+	for _, dep := range departments.Departments {
+		if dep.Id == department.Id {
+			found = true
+		}
+	}
+	s.Suite.True(found)
+
+	_, err = s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+}
+
+func hasSearchHit(res *entity.SearchRes, hitType, id string) bool {
+	for _, hit := range res.Hits {
+		if hit.Type == hitType && hit.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *SearchTestSuite) TearDownTest() {
+	s.CleanUpFunc()
+}
+
+func TestSearchTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTestSuite))
+}
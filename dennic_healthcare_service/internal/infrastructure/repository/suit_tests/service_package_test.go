@@ -0,0 +1,227 @@
+package suit_tests
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	repo "Healthcare_Evrone/internal/infrastructure/repository/postgresql"
+	"Healthcare_Evrone/internal/pkg/config"
+	db "Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"log"
+	"testing"
+	"time"
+)
+
+type ServicePackageTestSuite struct {
+	suite.Suite
+	CleanUpFunc              func()
+	Repository               *repo.ServicePackage
+	RepositoryDiscount       *repo.ServiceDiscount
+	RepositoryDoctor         *repo.DocTor
+	RepositoryDoctorService  *repo.Ds
+	RepositorySpecialization *repo.Specialization
+	RepositoryDepartment     *repo.DepartMent
+}
+
+func (s *ServicePackageTestSuite) SetupTest() {
+	pgPool, err := db.New(config.New())
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	s.Repository = repo.NewServicePackageRepo(pgPool)
+	s.RepositoryDiscount = repo.NewServiceDiscountRepo(pgPool)
+	s.RepositoryDoctor = repo.NewDoctorRepo(pgPool)
+	s.RepositoryDoctorService = repo.NewDoctorServicesRepo(pgPool)
+	s.RepositorySpecialization = repo.NewSpecializationRepo(pgPool)
+	s.RepositoryDepartment = repo.NewDepartmentRepo(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *ServicePackageTestSuite) TestServicePackage() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	department, err := s.RepositoryDepartment.CreateDepartment(ctx, &entity.Department{
+		Id:          uuid.NewString(),
+		Name:        "Test name",
+		Description: "Test description",
+		ImageUrl:    "Test imageUrl",
+		FloorNumber: 1,
+	})
+	s.Suite.NoError(err)
+
+	doctor, err := s.RepositoryDoctor.CreateDoctor(ctx, &entity.Doctor{
+		Id:            uuid.NewString(),
+		FirstName:     "Test first name",
+		LastName:      "Test last name",
+		Gender:        "male",
+		BirthDate:     "12-12-12",
+		PhoneNumber:   "Testphonenumber",
+		Email:         "Test email",
+		Address:       "Test address",
+		City:          "Test city",
+		Country:       "Test country",
+		Salary:        1.1,
+		Bio:           "Test bio",
+		StartWorkDate: "12-12-12",
+		EndWorkDate:   "12-12-12",
+		WorkYears:     3,
+		DepartmentId:  department.Id,
+		RoomNumber:    1,
+		Password:      "Test password",
+	})
+	s.Suite.NoError(err)
+
+	special, err := s.RepositorySpecialization.CreateSpecialization(ctx, &entity.Specialization{
+		ID:           uuid.NewString(),
+		Name:         "Test Name",
+		Description:  "Test Description",
+		DepartmentId: department.Id,
+	})
+	s.Suite.NoError(err)
+
+	tm, err := time.Parse("15:04", "00:30")
+	s.Suite.NoError(err)
+	var doctorServiceIds []string
+	for _, price := range []float32{100, 250} {
+		doctorService, err := s.RepositoryDoctorService.CreateDoctorServices(ctx, &entity.DoctorServices{
+			Id:               uuid.NewString(),
+			DoctorId:         doctor.Id,
+			SpecializationId: special.ID,
+			OnlinePrice:      price,
+			OfflinePrice:     price,
+			Name:             "Test Name",
+			Duration:         tm,
+		})
+		s.Suite.NoError(err)
+		doctorServiceIds = append(doctorServiceIds, doctorService.Id)
+	}
+
+	servicePackage, err := s.Repository.CreateServicePackage(ctx, &entity.ServicePackage{
+		Id:               uuid.NewString(),
+		Name:             "Test check-up",
+		Description:      "Test description",
+		Price:            300,
+		DoctorServiceIds: doctorServiceIds,
+	})
+	s.Suite.NoError(err)
+	s.Suite.ElementsMatch(doctorServiceIds, servicePackage.DoctorServiceIds)
+	s.Suite.Equal(float64(350), servicePackage.ItemsPrice)
+
+	packages, err := s.Repository.ListServicePackages(ctx, &entity.ListServicePackagesReq{
+		Page:            1,
+		Limit:           10,
+		DoctorServiceId: doctorServiceIds[0],
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), packages.Count)
+	s.Suite.Equal(servicePackage.Id, packages.ServicePackages[0].Id)
+
+	servicePackage.DoctorServiceIds = doctorServiceIds[1:]
+	servicePackage.Price = 200
+	updated, err := s.Repository.UpdateServicePackage(ctx, servicePackage)
+	s.Suite.NoError(err)
+	s.Suite.Equal([]string{doctorServiceIds[1]}, updated.DoctorServiceIds)
+	s.Suite.Equal(float64(250), updated.ItemsPrice)
+
+	// an unknown service is rejected
+	_, err = s.Repository.CreateServicePackage(ctx, &entity.ServicePackage{
+		Id:               uuid.NewString(),
+		Name:             "Test check-up",
+		DoctorServiceIds: []string{doctorServiceIds[0], uuid.NewString()},
+	})
+	s.Suite.Error(err)
+
+	discount, err := s.RepositoryDiscount.CreateServiceDiscount(ctx, &entity.ServiceDiscount{
+		Id:              uuid.NewString(),
+		DoctorServiceId: doctorServiceIds[0],
+		Kind:            entity.DiscountPercent,
+		Value:           10,
+		StartsOn:        "2024-01-01",
+		EndsOn:          "2024-01-31",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal("2024-01-31", discount.EndsOn)
+
+	openEnded, err := s.RepositoryDiscount.CreateServiceDiscount(ctx, &entity.ServiceDiscount{
+		Id:              uuid.NewString(),
+		DoctorServiceId: doctorServiceIds[0],
+		Kind:            entity.DiscountFixed,
+		Value:           30,
+		StartsOn:        "2024-02-01",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Empty(openEnded.EndsOn)
+
+	// only the discount running on the day is listed
+	discounts, err := s.RepositoryDiscount.ListServiceDiscounts(ctx, &entity.ListServiceDiscountsReq{
+		DoctorServiceId: doctorServiceIds[0],
+		ActiveOn:        "2024-03-15",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), discounts.Count)
+	s.Suite.Equal(openEnded.Id, discounts.ServiceDiscounts[0].Id)
+
+	discounts, err = s.RepositoryDiscount.ListServiceDiscounts(ctx, &entity.ListServiceDiscountsReq{
+		DoctorServiceId: doctorServiceIds[0],
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), discounts.Count)
+
+	discount.Value = 15
+	updatedDiscount, err := s.RepositoryDiscount.UpdateServiceDiscount(ctx, discount)
+	s.Suite.NoError(err)
+	s.Suite.Equal(float64(15), updatedDiscount.Value)
+
+	for _, id := range []string{discount.Id, openEnded.Id} {
+		status, err := s.RepositoryDiscount.DeleteServiceDiscount(ctx, id)
+		s.Suite.NoError(err)
+		s.Suite.True(status)
+
+		_, err = s.RepositoryDiscount.GetServiceDiscount(ctx, id)
+		s.Suite.Error(err)
+	}
+
+	status, err := s.Repository.DeleteServicePackage(ctx, servicePackage.Id)
+	s.Suite.NoError(err)
+	s.Suite.True(status)
+	_, err = s.Repository.GetServicePackage(ctx, servicePackage.Id)
+	s.Suite.Error(err)
+
+	for _, id := range doctorServiceIds {
+		_, err = s.RepositoryDoctorService.DeleteDoctorService(ctx, &entity.GetReqStr{
+			Field:    "id",
+			Value:    id,
+			IsActive: true,
+		})
+		s.Suite.NoError(err)
+	}
+	_, err = s.RepositorySpecialization.DeleteSpecialization(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    special.ID,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	_, err = s.RepositoryDoctor.DeleteDoctor(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    doctor.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	_, err = s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+}
+
+func (s *ServicePackageTestSuite) TearDownTest() {
+	s.CleanUpFunc()
+}
+
+func TestServicePackageTestSuite(t *testing.T) {
+	suite.Run(t, new(ServicePackageTestSuite))
+}
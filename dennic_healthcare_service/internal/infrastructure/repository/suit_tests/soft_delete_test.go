@@ -0,0 +1,299 @@
+package suit_tests
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	repo "Healthcare_Evrone/internal/infrastructure/repository/postgresql"
+	"Healthcare_Evrone/internal/pkg/config"
+	db "Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"log"
+	"testing"
+	"time"
+)
+
+type SoftDeleteTestSuite struct {
+	suite.Suite
+	CleanUpFunc              func()
+	RepositoryDoctor         *repo.DocTor
+	RepositorySpecialization *repo.Specialization
+	RepositoryDepartment     *repo.DepartMent
+	RepositoryRetention      *repo.Retention
+}
+
+func (s *SoftDeleteTestSuite) SetupTest() {
+	pgPool, err := db.New(config.New())
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	s.RepositoryDoctor = repo.NewDoctorRepo(pgPool)
+	s.RepositorySpecialization = repo.NewSpecializationRepo(pgPool)
+	s.RepositoryDepartment = repo.NewDepartmentRepo(pgPool)
+	s.RepositoryRetention = repo.NewRetentionRepo(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *SoftDeleteTestSuite) TestSoftDelete() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	department, err := s.RepositoryDepartment.CreateDepartment(ctx, &entity.Department{
+		Id:          uuid.NewString(),
+		Name:        "Test cardiology",
+		Description: "Test description",
+		ImageUrl:    "Test imageUrl",
+		FloorNumber: 1,
+	})
+	s.Suite.NoError(err)
+
+	doctor, err := s.RepositoryDoctor.CreateDoctor(ctx, &entity.Doctor{
+		Id:            uuid.NewString(),
+		FirstName:     "Test first name",
+		LastName:      "Test last name",
+		Gender:        "male",
+		BirthDate:     "12-12-12",
+		PhoneNumber:   "Testphonenumber",
+		Email:         "Test email",
+		Address:       "Test address",
+		City:          "Test city",
+		Country:       "Test country",
+		Salary:        1.1,
+		Bio:           "Test bio",
+		StartWorkDate: "12-12-12",
+		WorkYears:     3,
+		DepartmentId:  department.Id,
+		RoomNumber:    1,
+		Password:      "Test password",
+	})
+	s.Suite.NoError(err)
+
+	specialization, err := s.RepositorySpecialization.CreateSpecialization(ctx, &entity.Specialization{
+		ID:           uuid.NewString(),
+		Name:         "Test cardiology",
+		Description:  "Test Description",
+		DepartmentId: department.Id,
+	})
+	s.Suite.NoError(err)
+
+	// the soft delete of the department cascades to its doctors and specializations
+	status, err := s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: false,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(status)
+	_, err = s.RepositoryDoctor.GetDoctorById(ctx, &entity.GetReqStr{Field: "id", Value: doctor.Id})
+	s.Suite.Error(err)
+	_, err = s.RepositorySpecialization.GetSpecializationById(ctx, &entity.GetReqStr{Field: "id", Value: specialization.ID})
+	s.Suite.Error(err)
+
+	// a row deleted by the cascade is restored only with the department
+	_, err = s.RepositoryDoctor.RestoreDoctor(ctx, doctor.Id)
+	s.Suite.Error(err)
+
+	status, err = s.RepositoryDepartment.RestoreDepartment(ctx, department.Id)
+	s.Suite.NoError(err)
+	s.Suite.True(status)
+	getDoctor, err := s.RepositoryDoctor.GetDoctorById(ctx, &entity.GetReqStr{Field: "id", Value: doctor.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(doctor.Id, getDoctor.Id)
+	getSpecialization, err := s.RepositorySpecialization.GetSpecializationById(ctx, &entity.GetReqStr{Field: "id", Value: specialization.ID})
+	s.Suite.NoError(err)
+	s.Suite.Equal(specialization.ID, getSpecialization.ID)
+
+	// the records soft deleted before the moment are purged, children first
+	status, err = s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: false,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(status)
+	purged, err := s.RepositoryRetention.PurgeDeleted(ctx, time.Now().Add(time.Hour*6))
+	s.Suite.NoError(err)
+	s.Suite.GreaterOrEqual(purged, int64(3))
+	_, err = s.RepositoryDepartment.GetDepartmentById(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: true,
+	})
+	s.Suite.Error(err)
+}
+
+func (s *SoftDeleteTestSuite) TestPurgeKeepsParentsOfRecentlyDeleted() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(5))
+	defer cancel()
+
+	department, err := s.RepositoryDepartment.CreateDepartment(ctx, &entity.Department{
+		Id:          uuid.NewString(),
+		Name:        "Test cardiology",
+		Description: "Test description",
+		ImageUrl:    "Test imageUrl",
+		FloorNumber: 1,
+	})
+	s.Suite.NoError(err)
+
+	// the department is deleted on its own, its doctor is deleted later
+	status, err := s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: false,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(status)
+	departmentDeleted := time.Now().Add(time.Hour * 5)
+
+	doctor, err := s.RepositoryDoctor.CreateDoctor(ctx, &entity.Doctor{
+		Id:            uuid.NewString(),
+		FirstName:     "Test first name",
+		LastName:      "Test last name",
+		Gender:        "male",
+		BirthDate:     "12-12-12",
+		PhoneNumber:   "Testphonenumber",
+		Email:         "Test email",
+		Address:       "Test address",
+		City:          "Test city",
+		Country:       "Test country",
+		Salary:        1.1,
+		Bio:           "Test bio",
+		StartWorkDate: "12-12-12",
+		WorkYears:     3,
+		DepartmentId:  department.Id,
+		RoomNumber:    1,
+		Password:      "Test password",
+	})
+	s.Suite.NoError(err)
+
+	time.Sleep(time.Second)
+	status, err = s.RepositoryDoctor.DeleteDoctor(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    doctor.Id,
+		IsActive: false,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(status)
+
+	// the doctor is in its retention, so its department is kept
+	_, err = s.RepositoryRetention.PurgeDeleted(ctx, departmentDeleted.Add(time.Millisecond*500))
+	s.Suite.NoError(err)
+	_, err = s.RepositoryDepartment.GetDepartmentById(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+
+	_, err = s.RepositoryRetention.PurgeDeleted(ctx, time.Now().Add(time.Hour*6))
+	s.Suite.NoError(err)
+	_, err = s.RepositoryDepartment.GetDepartmentById(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    department.Id,
+		IsActive: true,
+	})
+	s.Suite.Error(err)
+}
+
+func (s *SoftDeleteTestSuite) TestSoftDeleteLinks() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	department, err := s.RepositoryDepartment.CreateDepartment(ctx, &entity.Department{
+		Id:          uuid.NewString(),
+		Name:        "Test cardiology",
+		Description: "Test description",
+		ImageUrl:    "Test imageUrl",
+		FloorNumber: 1,
+	})
+	s.Suite.NoError(err)
+	secondary, err := s.RepositoryDepartment.CreateDepartment(ctx, &entity.Department{
+		Id:          uuid.NewString(),
+		Name:        "Test surgery",
+		Description: "Test description",
+		ImageUrl:    "Test imageUrl",
+		FloorNumber: 2,
+	})
+	s.Suite.NoError(err)
+
+	parent, err := s.RepositorySpecialization.CreateSpecialization(ctx, &entity.Specialization{
+		ID:           uuid.NewString(),
+		Name:         "Test cardiology",
+		Description:  "Test Description",
+		DepartmentId: secondary.Id,
+	})
+	s.Suite.NoError(err)
+	child, err := s.RepositorySpecialization.CreateSpecialization(ctx, &entity.Specialization{
+		ID:           uuid.NewString(),
+		Name:         "Test pediatric cardiology",
+		Description:  "Test Description",
+		DepartmentId: department.Id,
+		ParentId:     parent.ID,
+	})
+	s.Suite.NoError(err)
+
+	// the soft delete of a specialization cascades to its sub-specializations
+	status, err := s.RepositorySpecialization.DeleteSpecialization(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    parent.ID,
+		IsActive: false,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(status)
+	_, err = s.RepositorySpecialization.GetSpecializationById(ctx, &entity.GetReqStr{Field: "id", Value: child.ID})
+	s.Suite.Error(err)
+
+	doctor, err := s.RepositoryDoctor.CreateDoctor(ctx, &entity.Doctor{
+		Id:            uuid.NewString(),
+		FirstName:     "Test first name",
+		LastName:      "Test last name",
+		Gender:        "male",
+		BirthDate:     "12-12-12",
+		PhoneNumber:   "Testphonenumber",
+		Email:         "Test email",
+		Address:       "Test address",
+		City:          "Test city",
+		Country:       "Test country",
+		Salary:        1.1,
+		Bio:           "Test bio",
+		StartWorkDate: "12-12-12",
+		WorkYears:     3,
+		DepartmentId:  department.Id,
+		DepartmentIds: []string{department.Id, secondary.Id},
+		RoomNumber:    1,
+		Password:      "Test password",
+	})
+	s.Suite.NoError(err)
+
+	// a secondary department of a doctor is not deleted while the doctor is
+	_, err = s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    secondary.Id,
+		IsActive: false,
+	})
+	s.Suite.Error(err)
+	_, err = s.RepositoryDepartment.GetDepartmentById(ctx, &entity.GetReqStr{Field: "id", Value: secondary.Id})
+	s.Suite.NoError(err)
+
+	// the primary department deletes the doctor with it, so its links do not hold it
+	for _, id := range []string{department.Id, secondary.Id} {
+		status, err = s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{
+			Field:    "id",
+			Value:    id,
+			IsActive: false,
+		})
+		s.Suite.NoError(err)
+		s.Suite.True(status)
+	}
+	_, err = s.RepositoryDoctor.GetDoctorById(ctx, &entity.GetReqStr{Field: "id", Value: doctor.Id})
+	s.Suite.Error(err)
+}
+
+func (s *SoftDeleteTestSuite) TearDownTest() {
+	s.CleanUpFunc()
+}
+
+func TestSoftDeleteTestSuite(t *testing.T) {
+	suite.Run(t, new(SoftDeleteTestSuite))
+}
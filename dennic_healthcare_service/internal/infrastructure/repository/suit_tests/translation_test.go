@@ -0,0 +1,99 @@
+package suit_tests
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	repo "Healthcare_Evrone/internal/infrastructure/repository/postgresql"
+	"Healthcare_Evrone/internal/pkg/config"
+	db "Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"log"
+	"testing"
+	"time"
+)
+
+type TranslationTestSuite struct {
+	suite.Suite
+	CleanUpFunc func()
+	Repository  *repo.Translation
+}
+
+func (s *TranslationTestSuite) SetupTest() {
+	pgPool, err := db.New(config.New())
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	s.Repository = repo.NewTranslationRepo(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *TranslationTestSuite) TestTranslationCrud() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	departmentId := uuid.NewString()
+
+	translation := &entity.Translation{
+		EntityType: entity.TranslationDepartment,
+		EntityId:   departmentId,
+		Lang:       "ru",
+		Field:      "name",
+		Value:      "Стоматология",
+	}
+	created, err := s.Repository.SetTranslation(ctx, translation)
+	s.Suite.NoError(err)
+	s.Suite.NotNil(created)
+	s.Suite.Equal(translation.Value, created.Value)
+
+	// setting the same field again replaces the value
+	translation.Value = "Детская стоматология"
+	updated, err := s.Repository.SetTranslation(ctx, translation)
+	s.Suite.NoError(err)
+	s.Suite.Equal(translation.Value, updated.Value)
+	s.Suite.False(updated.UpdatedAt.IsZero())
+
+	_, err = s.Repository.SetTranslation(ctx, &entity.Translation{
+		EntityType: entity.TranslationDepartment,
+		EntityId:   departmentId,
+		Lang:       "en",
+		Field:      "name",
+		Value:      "Dentistry",
+	})
+	s.Suite.NoError(err)
+
+	translations, err := s.Repository.GetTranslations(ctx, &entity.GetTranslationsReq{
+		EntityType: entity.TranslationDepartment,
+		EntityIds:  []string{departmentId},
+		Langs:      []string{"ru"},
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(translations.Translations, 1)
+	s.Suite.Equal(translation.Value, translations.Translations[0].Value)
+
+	translations, err = s.Repository.GetTranslations(ctx, &entity.GetTranslationsReq{
+		EntityType: entity.TranslationDepartment,
+		EntityIds:  []string{departmentId},
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(translations.Translations, 2)
+
+	for _, lang := range []string{"ru", "en"} {
+		status, err := s.Repository.DeleteTranslation(ctx, &entity.DeleteTranslationReq{
+			EntityType: entity.TranslationDepartment,
+			EntityId:   departmentId,
+			Lang:       lang,
+		})
+		s.Suite.NoError(err)
+		s.Suite.True(status.Status)
+	}
+}
+
+func (s *TranslationTestSuite) TearDownTest() {
+	s.CleanUpFunc()
+}
+
+func TestTranslationTestSuite(t *testing.T) {
+	suite.Run(t, new(TranslationTestSuite))
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+)
+
+type TranslationRepository interface {
+	SetTranslation(ctx context.Context, in *entity.Translation) (*entity.Translation, error)
+	GetTranslations(ctx context.Context, in *entity.GetTranslationsReq) (*entity.ListTranslations, error)
+	DeleteTranslation(ctx context.Context, in *entity.DeleteTranslationReq) (*entity.StatusTranslation, error)
+}
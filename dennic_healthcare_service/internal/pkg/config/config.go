@@ -25,6 +25,35 @@ type Config struct {
 		Timeout string
 	}
 
+	Language struct {
+		Fallback string
+	}
+
+	BookingService struct {
+		Host string
+		Port string
+	}
+
+	LicenseExpiry struct {
+		Interval string
+		WarnDays string
+	}
+
+	PriceSchedule struct {
+		Interval string
+	}
+
+	Purge struct {
+		Interval  string
+		Retention string
+	}
+
+	Outbox struct {
+		Interval  string
+		BatchSize string
+		Retention string
+	}
+
 	DB struct {
 		Host     string
 		Port     string
@@ -42,7 +71,7 @@ type Config struct {
 	Kafka struct {
 		Address []string
 		Topic   struct {
-			Healthcare string
+			HealthcareEvents string
 		}
 	}
 	MinioService Minio
@@ -58,6 +87,29 @@ func New() *Config {
 	config.RPCPort = getEnv("RPC_PORT", ":9080")
 	config.Context.Timeout = getEnv("CONTEXT_TIMEOUT", "30s")
 
+	// language of the translations used when the requested one is missing
+	config.Language.Fallback = getEnv("LANGUAGE_FALLBACK", "uz")
+
+	// booking service configuration
+	config.BookingService.Host = getEnv("BOOKING_SERVICE_GRPC_HOST", "dennic_booking_service")
+	config.BookingService.Port = getEnv("BOOKING_SERVICE_GRPC_PORT", ":9090")
+
+	// license expiry configuration
+	config.LicenseExpiry.Interval = getEnv("LICENSE_EXPIRY_INTERVAL", "24h")
+	config.LicenseExpiry.WarnDays = getEnv("LICENSE_EXPIRY_WARN_DAYS", "30")
+
+	// price schedule configuration
+	config.PriceSchedule.Interval = getEnv("PRICE_SCHEDULE_INTERVAL", "1m")
+
+	// purge configuration
+	config.Purge.Interval = getEnv("PURGE_INTERVAL", "24h")
+	config.Purge.Retention = getEnv("PURGE_RETENTION", "720h")
+
+	// outbox configuration
+	config.Outbox.Interval = getEnv("OUTBOX_INTERVAL", "1s")
+	config.Outbox.BatchSize = getEnv("OUTBOX_BATCH_SIZE", "100")
+	config.Outbox.Retention = getEnv("OUTBOX_RETENTION", "168h")
+
 	// db configuration
 	config.DB.Host = getEnv("POSTGRES_HOST", "postgresdb")
 	config.DB.Port = getEnv("POSTGRES_PORT", "5432")
@@ -72,7 +124,7 @@ func New() *Config {
 
 	// kafka configuration
 	config.Kafka.Address = strings.Split(getEnv("KAFKA_ADDRESS", "localhost:29092"), ",")
-	config.Kafka.Topic.Healthcare = getEnv("KAFKA_TOPIC_HEALTHCARE_CREATE", "user.created")
+	config.Kafka.Topic.HealthcareEvents = getEnv("KAFKA_TOPIC_HEALTHCARE_EVENTS", "healthcare.events")
 
 	// Minio
 	config.MinioService.Endpoint = getEnv("MINIO_SERVICE_ENDPOINT", "https://minio.dennic.uz")
@@ -0,0 +1,73 @@
+package language
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+const (
+	Uz = "uz"
+	Ru = "ru"
+	En = "en"
+
+	// MetadataKey is the grpc metadata key the gateway forwards the Accept-Language header under
+	MetadataKey = "accept-language"
+)
+
+type ctxKey struct{}
+
+// IsSupported reports whether content can be translated into lang
+func IsSupported(lang string) bool {
+	switch lang {
+	case Uz, Ru, En:
+		return true
+	}
+	return false
+}
+
+// Parse picks the supported language with the highest weight out of an Accept-Language header,
+// regional variants such as "uz-Cyrl" or "ru-RU" count as their base language
+func Parse(header string) string {
+	var (
+		best       string
+		bestWeight float64
+	)
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.ToLower(strings.TrimSpace(fields[0]))
+		if i := strings.IndexAny(tag, "-_"); i >= 0 {
+			tag = tag[:i]
+		}
+		if !IsSupported(tag) {
+			continue
+		}
+
+		weight := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+				if err != nil {
+					q = 0
+				}
+				weight = q
+			}
+		}
+		if weight > bestWeight {
+			best, bestWeight = tag, weight
+		}
+	}
+	return best
+}
+
+// NewContext returns a copy of ctx carrying the requested language
+func NewContext(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, lang)
+}
+
+// FromContext returns the requested language or an empty string when none was requested
+func FromContext(ctx context.Context) string {
+	lang, _ := ctx.Value(ctxKey{}).(string)
+	return lang
+}
@@ -85,6 +85,12 @@ func (p *PostgresDB) Error(err error) error {
 		switch pgErr.Code {
 		case "23505":
 			return entity.ErrorConflict
+		// exclusion constraints reject overlapping ranges, e.g. working hours
+		case "23P01":
+			return entity.NewErrConflict("overlapping range")
+		// foreign keys reject references to missing rows, e.g. the branch of a department
+		case "23503":
+			return entity.NewErrNotFound("referenced row")
 		}
 	}
 
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"context"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	serviceNameBranchUseCase           = "branchUseCase"
+	serviceNameBranchUseCaseRepoPrefix = "branchUseCase"
+)
+
+type BranchUseCase interface {
+	CreateBranch(ctx context.Context, in *entity.Branch) (*entity.Branch, error)
+	GetBranch(ctx context.Context, id string) (*entity.Branch, error)
+	ListBranches(ctx context.Context, in *entity.ListBranchesReq) (*entity.ListBranches, error)
+	UpdateBranch(ctx context.Context, in *entity.Branch) (*entity.Branch, error)
+	DeleteBranch(ctx context.Context, id string) (bool, error)
+	ListBranchesNearby(ctx context.Context, in *entity.BranchesNearbyReq) ([]entity.NearbyBranch, error)
+}
+
+type branchService struct {
+	BaseUseCase
+	repo       repository.BranchRepository
+	ctxTimeout time.Duration
+}
+
+func NewBranchService(ctxTimeout time.Duration, repo repository.BranchRepository) branchService {
+	return branchService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (b branchService) CreateBranch(ctx context.Context, in *entity.Branch) (*entity.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBranchUseCase, serviceNameBranchUseCaseRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateBranch").String(in.Id))
+	defer span.End()
+
+	if err := validateBranch(in); err != nil {
+		return nil, err
+	}
+
+	return b.repo.CreateBranch(ctx, in)
+}
+
+func (b branchService) GetBranch(ctx context.Context, id string) (*entity.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBranchUseCase, serviceNameBranchUseCaseRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetBranch").String(id))
+	defer span.End()
+
+	return b.repo.GetBranch(ctx, id)
+}
+
+func (b branchService) ListBranches(ctx context.Context, in *entity.ListBranchesReq) (*entity.ListBranches, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBranchUseCase, serviceNameBranchUseCaseRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListBranches").String(in.City))
+	defer span.End()
+
+	return b.repo.ListBranches(ctx, in)
+}
+
+func (b branchService) UpdateBranch(ctx context.Context, in *entity.Branch) (*entity.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBranchUseCase, serviceNameBranchUseCaseRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateBranch").String(in.Id))
+	defer span.End()
+
+	if err := validateBranch(in); err != nil {
+		return nil, err
+	}
+
+	return b.repo.UpdateBranch(ctx, in)
+}
+
+func (b branchService) DeleteBranch(ctx context.Context, id string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBranchUseCase, serviceNameBranchUseCaseRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteBranch").String(id))
+	defer span.End()
+
+	return b.repo.DeleteBranch(ctx, id)
+}
+
+func (b branchService) ListBranchesNearby(ctx context.Context, in *entity.BranchesNearbyReq) ([]entity.NearbyBranch, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBranchUseCase, serviceNameBranchUseCaseRepoPrefix+"List nearby")
+	span.SetAttributes(attribute.Key("latitude").Float64(in.Latitude), attribute.Key("longitude").Float64(in.Longitude))
+	defer span.End()
+
+	if err := validateCoordinates(in.Latitude, in.Longitude); err != nil {
+		return nil, err
+	}
+	if in.RadiusKm < 0 {
+		return nil, validationError("radius_km", "radius_km cannot be negative")
+	}
+	if in.Limit < 0 {
+		return nil, validationError("limit", "limit cannot be negative")
+	}
+
+	return b.repo.ListBranchesNearby(ctx, in)
+}
+
+// validateBranch checks the branch and drops the empty phone numbers
+func validateBranch(in *entity.Branch) error {
+	if strings.TrimSpace(in.Name) == "" {
+		return validationError("name", "name is required")
+	}
+	if strings.TrimSpace(in.Address) == "" {
+		return validationError("address", "address is required")
+	}
+	if err := validateCoordinates(in.Latitude, in.Longitude); err != nil {
+		return err
+	}
+	opensAt, ok := parseTimeOfDay(in.OpensAt)
+	if !ok {
+		return validationError("opens_at", "opens_at must be a time of day 15:04")
+	}
+	closesAt, ok := parseTimeOfDay(in.ClosesAt)
+	if !ok {
+		return validationError("closes_at", "closes_at must be a time of day 15:04")
+	}
+	if !opensAt.Before(closesAt) {
+		return validationError("closes_at", "closes_at must be after opens_at")
+	}
+
+	phoneNumbers := []string{}
+	for _, phoneNumber := range in.PhoneNumbers {
+		if phoneNumber = strings.TrimSpace(phoneNumber); phoneNumber != "" {
+			phoneNumbers = append(phoneNumbers, phoneNumber)
+		}
+	}
+	in.PhoneNumbers = phoneNumbers
+	return nil
+}
+
+func validateCoordinates(latitude, longitude float64) error {
+	if latitude < -90 || latitude > 90 {
+		return validationError("latitude", "latitude must be between -90 and 90")
+	}
+	if longitude < -180 || longitude > 180 {
+		return validationError("longitude", "longitude must be between -180 and 180")
+	}
+	return nil
+}
@@ -20,18 +20,21 @@ type DepartmentsUsecase interface {
 	GetAllDepartments(ctx context.Context, all *entity.GetAll) (*entity.ListDepartments, error)
 	UpdateDepartment(ctx context.Context, update *entity.Department) (*entity.Department, error)
 	DeleteDepartment(ctx context.Context, del *entity.GetReqStr) (bool, error)
+	RestoreDepartment(ctx context.Context, id string) (bool, error)
 }
 
 type newsDepService struct {
 	BaseUseCase
-	repo       repository.DepartmentRepository
-	ctxTimeout time.Duration
+	repo        repository.DepartmentRepository
+	translation TranslationUseCase
+	ctxTimeout  time.Duration
 }
 
-func NewDepartmentService(ctxTimeout time.Duration, repo repository.DepartmentRepository) newsDepService {
+func NewDepartmentService(ctxTimeout time.Duration, repo repository.DepartmentRepository, translation TranslationUseCase) newsDepService {
 	return newsDepService{
-		ctxTimeout: ctxTimeout,
-		repo:       repo,
+		ctxTimeout:  ctxTimeout,
+		repo:        repo,
+		translation: translation,
 	}
 }
 
@@ -59,7 +62,18 @@ func (u newsDepService) GetDepartmentById(ctx context.Context, get *entity.GetRe
 
 	defer span.End()
 
-	return u.repo.GetDepartmentById(ctx, get)
+	department, err := u.repo.GetDepartmentById(ctx, get)
+	if err != nil {
+		return nil, err
+	}
+
+	localized, err := u.translation.Localize(ctx, entity.TranslationDepartment, department.Id)
+	if err != nil {
+		return nil, err
+	}
+	localizeDepartment(localized, department)
+
+	return department, nil
 }
 
 func (u newsDepService) GetAllDepartments(ctx context.Context, all *entity.GetAll) (*entity.ListDepartments, error) {
@@ -71,7 +85,30 @@ func (u newsDepService) GetAllDepartments(ctx context.Context, all *entity.GetAl
 
 	defer span.End()
 
-	return u.repo.GetAllDepartments(ctx, all)
+	departments, err := u.repo.GetAllDepartments(ctx, all)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(departments.Departments))
+	for _, department := range departments.Departments {
+		ids = append(ids, department.Id)
+	}
+	localized, err := u.translation.Localize(ctx, entity.TranslationDepartment, ids...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range departments.Departments {
+		localizeDepartment(localized, &departments.Departments[i])
+	}
+
+	return departments, nil
+}
+
+func localizeDepartment(localized entity.Localized, department *entity.Department) {
+	localized.Apply(department.Id, "name", &department.Name)
+	localized.Apply(department.Id, "description", &department.Description)
+	localized.Apply(department.Id, "short_description", &department.ShortDescription)
 }
 
 func (u newsDepService) UpdateDepartment(ctx context.Context, update *entity.Department) (*entity.Department, error) {
@@ -96,3 +133,14 @@ func (u newsDepService) DeleteDepartment(ctx context.Context, del *entity.GetReq
 
 	return u.repo.DeleteDepartment(ctx, del)
 }
+
+func (u newsDepService) RestoreDepartment(ctx context.Context, id string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDepartmentUseCase, serviceNameDepartmentUseCaseRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreDepartment").String(id))
+	defer span.End()
+
+	return u.repo.RestoreDepartment(ctx, id)
+}
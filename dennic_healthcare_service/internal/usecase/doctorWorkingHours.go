@@ -5,8 +5,11 @@ import (
 	"Healthcare_Evrone/internal/infrastructure/repository"
 	"Healthcare_Evrone/internal/pkg/otlp"
 	"context"
-	"go.opentelemetry.io/otel/attribute"
+	"errors"
+	"strconv"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
@@ -42,6 +45,10 @@ func (d dwhService) CreateDoctorWorkingHours(ctx context.Context, in *entity.Doc
 	span.SetAttributes(attribute.Key("CreateDoctorWorkingHours").String(string(in.Id)))
 	defer span.End()
 
+	if err := d.validate(ctx, in); err != nil {
+		return nil, err
+	}
+
 	return d.repo.CreateDoctorWorkingHours(ctx, in)
 }
 
@@ -77,6 +84,10 @@ func (d dwhService) UpdateDoctorWorkingHours(ctx context.Context, in *entity.Doc
 	span.SetAttributes(attribute.Key("UpdateDoctorWorkingHours").String(string(in.Id)))
 	defer span.End()
 
+	if err := d.validate(ctx, in); err != nil {
+		return nil, err
+	}
+
 	return d.repo.UpdateDoctorWorkingHours(ctx, in)
 }
 
@@ -88,5 +99,165 @@ func (d dwhService) DeleteDoctorWorkingHours(ctx context.Context, in *entity.Get
 	span.SetAttributes(attribute.Key("DeleteDoctorWorkingHours").String(in.Value))
 	defer span.End()
 
+	// deleting a shift may leave the breaks of its day outside the shifts, the other fields delete the breaks along
+	if in.Field == "id" {
+		stored, err := d.repo.GetDoctorWorkingHoursById(ctx, &entity.GetRequest{Field: "id", Value: in.Value})
+		if err != nil {
+			return false, err
+		}
+		if stored.Kind == entity.WorkingHoursShift {
+			if err := d.checkBreaksKept(ctx, stored, nil); err != nil {
+				return false, err
+			}
+		}
+	}
+
 	return d.repo.DeleteDoctorWorkingHours(ctx, in)
 }
+
+// validate normalizes the times and dates of the working hours and checks them against the other hours
+// of the doctor on the same weekday: shifts may not overlap each other, neither may breaks,
+// and a break has to lie within a shift in effect for the whole effective range of the break
+func (d dwhService) validate(ctx context.Context, in *entity.DoctorWorkingHours) error {
+	if in.Kind == "" {
+		in.Kind = entity.WorkingHoursShift
+	}
+	if in.Kind != entity.WorkingHoursShift && in.Kind != entity.WorkingHoursBreak {
+		return validationError("kind", "kind must be shift or break")
+	}
+	if _, err := time.Parse("Monday", in.DayOfWeek); err != nil {
+		return validationError("day_of_week", "day_of_week must be an english day name, e.g. Monday")
+	}
+
+	start, ok := parseTimeOfDay(in.StartTime)
+	if !ok {
+		return validationError("start_time", "start_time must be a time of day 15:04 or 15:04:05")
+	}
+	finish, ok := parseTimeOfDay(in.FinishTime)
+	if !ok {
+		return validationError("finish_time", "finish_time must be a time of day 15:04 or 15:04:05")
+	}
+	if !start.Before(finish) {
+		return validationError("finish_time", "finish_time must be after start_time")
+	}
+	in.StartTime, in.FinishTime = start.Format("15:04:05"), finish.Format("15:04:05")
+
+	for field, value := range map[string]string{"effective_from": in.EffectiveFrom, "effective_to": in.EffectiveTo} {
+		if _, err := time.Parse("2006-01-02", value); value != "" && err != nil {
+			return validationError(field, field+" must be a date 2006-01-02")
+		}
+	}
+	// dates of the same layout compare as strings, an empty date is an open end
+	if in.EffectiveFrom != "" && in.EffectiveTo != "" && in.EffectiveFrom > in.EffectiveTo {
+		return validationError("effective_to", "effective_to must not be before effective_from")
+	}
+
+	hours, err := d.repo.ListDoctorDayWorkingHours(ctx, &entity.GetReqDayWorkingHours{
+		DoctorId:  in.DoctorId,
+		DayOfWeek: in.DayOfWeek,
+	})
+	if err != nil {
+		return err
+	}
+
+	var covered bool
+	for _, other := range hours {
+		if other.Id == in.Id {
+			continue
+		}
+		sameTime := other.StartTime < in.FinishTime && in.StartTime < other.FinishTime
+		if other.Kind == in.Kind && sameTime && effectiveOverlap(&other, in) {
+			return validationError("start_time", "the "+in.Kind+" overlaps another "+in.Kind+" of the day")
+		}
+		if in.Kind == entity.WorkingHoursBreak && other.Kind == entity.WorkingHoursShift &&
+			other.StartTime <= in.StartTime && in.FinishTime <= other.FinishTime && effectiveCovers(&other, in) {
+			covered = true
+		}
+	}
+	if in.Kind == entity.WorkingHoursBreak && !covered {
+		return validationError("start_time", "the break must lie within a shift of the day")
+	}
+
+	// moving or shrinking a shift may leave the breaks of its former day outside the shifts
+	if in.Id != 0 {
+		stored, err := d.repo.GetDoctorWorkingHoursById(ctx, &entity.GetRequest{Field: "id", Value: strconv.Itoa(int(in.Id))})
+		if err != nil {
+			return err
+		}
+		if stored.Kind == entity.WorkingHoursShift {
+			if err := d.checkBreaksKept(ctx, stored, in); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkBreaksKept checks that every break of the day of the stored shift still lies within a shift
+// once the stored shift is replaced by the updated hours, or removed when there are none
+func (d dwhService) checkBreaksKept(ctx context.Context, stored, updated *entity.DoctorWorkingHours) error {
+	hours, err := d.repo.ListDoctorDayWorkingHours(ctx, &entity.GetReqDayWorkingHours{
+		DoctorId:  stored.DoctorId,
+		DayOfWeek: stored.DayOfWeek,
+	})
+	if err != nil {
+		return err
+	}
+
+	remaining := make([]entity.DoctorWorkingHours, 0, len(hours))
+	for _, hour := range hours {
+		if hour.Id != stored.Id {
+			remaining = append(remaining, hour)
+		}
+	}
+	if updated != nil && updated.DoctorId == stored.DoctorId && updated.DayOfWeek == stored.DayOfWeek {
+		remaining = append(remaining, *updated)
+	}
+
+	for _, brk := range remaining {
+		if brk.Kind != entity.WorkingHoursBreak {
+			continue
+		}
+		var covered bool
+		for _, shift := range remaining {
+			if shift.Kind == entity.WorkingHoursShift &&
+				shift.StartTime <= brk.StartTime && brk.FinishTime <= shift.FinishTime && effectiveCovers(&shift, &brk) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return validationError("start_time", "the break at "+brk.StartTime+" would lie outside the shifts of the day, move or delete it first")
+		}
+	}
+	return nil
+}
+
+func validationError(field, description string) error {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New(description)
+	errValidation.Errors[field] = description
+	return errValidation
+}
+
+func parseTimeOfDay(value string) (time.Time, bool) {
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// effectiveOverlap reports whether the effective ranges of a and b share a day
+func effectiveOverlap(a, b *entity.DoctorWorkingHours) bool {
+	return (a.EffectiveTo == "" || b.EffectiveFrom == "" || b.EffectiveFrom <= a.EffectiveTo) &&
+		(b.EffectiveTo == "" || a.EffectiveFrom == "" || a.EffectiveFrom <= b.EffectiveTo)
+}
+
+// effectiveCovers reports whether the effective range of a contains the whole range of b
+func effectiveCovers(a, b *entity.DoctorWorkingHours) bool {
+	return (a.EffectiveFrom == "" || (b.EffectiveFrom != "" && a.EffectiveFrom <= b.EffectiveFrom)) &&
+		(a.EffectiveTo == "" || (b.EffectiveTo != "" && b.EffectiveTo <= a.EffectiveTo))
+}
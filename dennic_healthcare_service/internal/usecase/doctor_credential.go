@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	serviceNameDoctorCredentialUseCase           = "doctorCredentialUseCase"
+	serviceNameDoctorCredentialUseCaseRepoPrefix = "doctorCredentialUseCase"
+)
+
+type DoctorCredentialUseCase interface {
+	CreateDoctorCredential(ctx context.Context, in *entity.DoctorCredential) (*entity.DoctorCredential, error)
+	GetDoctorCredential(ctx context.Context, id int64) (*entity.DoctorCredential, error)
+	ListDoctorCredentials(ctx context.Context, in *entity.ListDoctorCredentialsReq) (*entity.ListDoctorCredentials, error)
+	UpdateDoctorCredential(ctx context.Context, in *entity.DoctorCredential) (*entity.DoctorCredential, error)
+	DeleteDoctorCredential(ctx context.Context, id int64) (bool, error)
+	FlagExpiringLicenses(ctx context.Context, warnDays int) ([]entity.DoctorCredential, error)
+	GetDoctorLicenseStatus(ctx context.Context, in *entity.DoctorLicenseStatusReq) (*entity.DoctorLicenseStatus, error)
+}
+
+type doctorCredentialService struct {
+	BaseUseCase
+	repo       repository.DoctorCredentialRepository
+	ctxTimeout time.Duration
+}
+
+func NewDoctorCredentialService(ctxTimeout time.Duration, repo repository.DoctorCredentialRepository) doctorCredentialService {
+	return doctorCredentialService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (d doctorCredentialService) CreateDoctorCredential(ctx context.Context, in *entity.DoctorCredential) (*entity.DoctorCredential, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorCredentialUseCase, serviceNameDoctorCredentialUseCaseRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateDoctorCredential").String(in.DoctorId))
+	defer span.End()
+
+	if err := validateCredential(in); err != nil {
+		return nil, err
+	}
+
+	return d.repo.CreateDoctorCredential(ctx, in)
+}
+
+func (d doctorCredentialService) GetDoctorCredential(ctx context.Context, id int64) (*entity.DoctorCredential, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorCredentialUseCase, serviceNameDoctorCredentialUseCaseRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetDoctorCredential").String(strconv.FormatInt(id, 10)))
+	defer span.End()
+
+	return d.repo.GetDoctorCredential(ctx, id)
+}
+
+func (d doctorCredentialService) ListDoctorCredentials(ctx context.Context, in *entity.ListDoctorCredentialsReq) (*entity.ListDoctorCredentials, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorCredentialUseCase, serviceNameDoctorCredentialUseCaseRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListDoctorCredentials").String(in.DoctorId))
+	defer span.End()
+
+	return d.repo.ListDoctorCredentials(ctx, in)
+}
+
+func (d doctorCredentialService) UpdateDoctorCredential(ctx context.Context, in *entity.DoctorCredential) (*entity.DoctorCredential, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorCredentialUseCase, serviceNameDoctorCredentialUseCaseRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateDoctorCredential").String(strconv.FormatInt(in.Id, 10)))
+	defer span.End()
+
+	if err := validateCredential(in); err != nil {
+		return nil, err
+	}
+
+	return d.repo.UpdateDoctorCredential(ctx, in)
+}
+
+func (d doctorCredentialService) DeleteDoctorCredential(ctx context.Context, id int64) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorCredentialUseCase, serviceNameDoctorCredentialUseCaseRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteDoctorCredential").String(strconv.FormatInt(id, 10)))
+	defer span.End()
+
+	return d.repo.DeleteDoctorCredential(ctx, id)
+}
+
+// FlagExpiringLicenses flags the licenses expiring within warnDays days from today
+func (d doctorCredentialService) FlagExpiringLicenses(ctx context.Context, warnDays int) ([]entity.DoctorCredential, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorCredentialUseCase, serviceNameDoctorCredentialUseCaseRepoPrefix+"Flag expiring")
+	span.SetAttributes(attribute.Key("FlagExpiringLicenses").Int(warnDays))
+	defer span.End()
+
+	until := time.Now().Add(time.Hour*5).AddDate(0, 0, warnDays).Format("2006-01-02")
+	return d.repo.FlagExpiringLicenses(ctx, until)
+}
+
+// GetDoctorLicenseStatus checks the licenses of the doctor on the date, today when the date is empty
+func (d doctorCredentialService) GetDoctorLicenseStatus(ctx context.Context, in *entity.DoctorLicenseStatusReq) (*entity.DoctorLicenseStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorCredentialUseCase, serviceNameDoctorCredentialUseCaseRepoPrefix+"License status")
+	span.SetAttributes(attribute.Key(in.DoctorId).String(in.Date))
+	defer span.End()
+
+	if in.Date == "" {
+		in.Date = time.Now().Add(time.Hour * 5).Format("2006-01-02")
+	}
+	if _, err := time.Parse("2006-01-02", in.Date); err != nil {
+		return nil, validationError("date", "date must be a date 2006-01-02")
+	}
+
+	return d.repo.GetDoctorLicenseStatus(ctx, in)
+}
+
+func validateCredential(in *entity.DoctorCredential) error {
+	switch in.Type {
+	case entity.CredentialDiploma, entity.CredentialLicense, entity.CredentialCertificate, entity.CredentialOther:
+	default:
+		return validationError("type", "type must be diploma, license, certificate or other")
+	}
+	if strings.TrimSpace(in.IssuingBody) == "" {
+		return validationError("issuing_body", "issuing_body is required")
+	}
+	if _, err := time.Parse("2006-01-02", in.IssueDate); err != nil {
+		return validationError("issue_date", "issue_date must be a date 2006-01-02")
+	}
+	if in.ExpiryDate == "" {
+		if in.Type == entity.CredentialLicense {
+			return validationError("expiry_date", "a license must have an expiry_date")
+		}
+		return nil
+	}
+	if _, err := time.Parse("2006-01-02", in.ExpiryDate); err != nil {
+		return validationError("expiry_date", "expiry_date must be a date 2006-01-02")
+	}
+	// dates of the same layout compare as strings
+	if in.ExpiryDate < in.IssueDate {
+		return validationError("expiry_date", "expiry_date must not be before issue_date")
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"context"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	serviceNameDoctorLeaveUseCase           = "doctorLeaveUseCase"
+	serviceNameDoctorLeaveUseCaseRepoPrefix = "doctorLeaveUseCase"
+)
+
+// LeaveBlocker keeps the booking of the doctors in line with their approved leaves,
+// both calls are idempotent
+type LeaveBlocker interface {
+	BlockLeave(ctx context.Context, leave *entity.DoctorLeave) error
+	ReleaseLeave(ctx context.Context, leaveId int64) error
+}
+
+type DoctorLeaveUseCase interface {
+	CreateDoctorLeave(ctx context.Context, in *entity.DoctorLeave) (*entity.DoctorLeave, error)
+	GetDoctorLeave(ctx context.Context, id int64) (*entity.DoctorLeave, error)
+	ListDoctorLeaves(ctx context.Context, in *entity.ListDoctorLeavesReq) (*entity.ListDoctorLeaves, error)
+	ReviewDoctorLeave(ctx context.Context, in *entity.ReviewDoctorLeaveReq) (*entity.DoctorLeave, error)
+	CancelDoctorLeave(ctx context.Context, id int64) (*entity.DoctorLeave, error)
+	ListAwayDoctors(ctx context.Context, in *entity.AwayDoctorsReq) (*entity.ListAwayDoctors, error)
+}
+
+type doctorLeaveService struct {
+	BaseUseCase
+	repo       repository.DoctorLeaveRepository
+	blocker    LeaveBlocker
+	ctxTimeout time.Duration
+}
+
+func NewDoctorLeaveService(ctxTimeout time.Duration, repo repository.DoctorLeaveRepository, blocker LeaveBlocker) doctorLeaveService {
+	return doctorLeaveService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+		blocker:    blocker,
+	}
+}
+
+func (d doctorLeaveService) CreateDoctorLeave(ctx context.Context, in *entity.DoctorLeave) (*entity.DoctorLeave, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorLeaveUseCase, serviceNameDoctorLeaveUseCaseRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateDoctorLeave").String(in.DoctorId))
+	defer span.End()
+
+	switch in.Kind {
+	case entity.LeaveVacation, entity.LeaveSickLeave, entity.LeaveConference, entity.LeaveOther:
+	default:
+		return nil, validationError("kind", "kind must be vacation, sick_leave, conference or other")
+	}
+	for field, value := range map[string]string{"start_date": in.StartDate, "end_date": in.EndDate} {
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return nil, validationError(field, field+" must be a date 2006-01-02")
+		}
+	}
+	// dates of the same layout compare as strings
+	if in.EndDate < in.StartDate {
+		return nil, validationError("end_date", "end_date must not be before start_date")
+	}
+
+	return d.repo.CreateDoctorLeave(ctx, in)
+}
+
+func (d doctorLeaveService) GetDoctorLeave(ctx context.Context, id int64) (*entity.DoctorLeave, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorLeaveUseCase, serviceNameDoctorLeaveUseCaseRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetDoctorLeave").String(strconv.FormatInt(id, 10)))
+	defer span.End()
+
+	return d.repo.GetDoctorLeave(ctx, id)
+}
+
+func (d doctorLeaveService) ListDoctorLeaves(ctx context.Context, in *entity.ListDoctorLeavesReq) (*entity.ListDoctorLeaves, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorLeaveUseCase, serviceNameDoctorLeaveUseCaseRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListDoctorLeaves").String(in.DoctorId))
+	defer span.End()
+
+	for field, value := range map[string]string{"from": in.From, "to": in.To} {
+		if _, err := time.Parse("2006-01-02", value); value != "" && err != nil {
+			return nil, validationError(field, field+" must be a date 2006-01-02")
+		}
+	}
+
+	return d.repo.ListDoctorLeaves(ctx, in)
+}
+
+// ReviewDoctorLeave approves or rejects a pending leave, the days of an approved leave
+// are blocked in the booking before the leave is marked approved
+func (d doctorLeaveService) ReviewDoctorLeave(ctx context.Context, in *entity.ReviewDoctorLeaveReq) (*entity.DoctorLeave, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorLeaveUseCase, serviceNameDoctorLeaveUseCaseRepoPrefix+"Review")
+	span.SetAttributes(attribute.Key(in.Status).String(strconv.FormatInt(in.Id, 10)))
+	defer span.End()
+
+	if in.Status != entity.LeaveApproved && in.Status != entity.LeaveRejected {
+		return nil, validationError("status", "status must be approved or rejected")
+	}
+	leave, err := d.repo.GetDoctorLeave(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if leave.Status != entity.LeavePending {
+		return nil, validationError("status", "the leave is already "+leave.Status)
+	}
+	if in.Status == entity.LeaveRejected {
+		return d.repo.SetDoctorLeaveStatus(ctx, in, entity.LeavePending)
+	}
+
+	if err = d.blocker.BlockLeave(ctx, leave); err != nil {
+		return nil, err
+	}
+	response, err := d.repo.SetDoctorLeaveStatus(ctx, in, entity.LeavePending)
+	if err != nil {
+		// the leave stays pending, so its blocks must not outlive the failed review
+		_ = d.blocker.ReleaseLeave(ctx, leave.Id)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// CancelDoctorLeave withdraws a pending or approved leave, the blocks of an approved leave
+// are released in the booking before the leave is marked cancelled
+func (d doctorLeaveService) CancelDoctorLeave(ctx context.Context, id int64) (*entity.DoctorLeave, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorLeaveUseCase, serviceNameDoctorLeaveUseCaseRepoPrefix+"Cancel")
+	span.SetAttributes(attribute.Key("CancelDoctorLeave").String(strconv.FormatInt(id, 10)))
+	defer span.End()
+
+	leave, err := d.repo.GetDoctorLeave(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	req := &entity.ReviewDoctorLeaveReq{
+		Id:     id,
+		Status: entity.LeaveCancelled,
+	}
+	switch leave.Status {
+	case entity.LeavePending:
+		return d.repo.SetDoctorLeaveStatus(ctx, req, entity.LeavePending)
+	case entity.LeaveApproved:
+	default:
+		return nil, validationError("status", "the leave is already "+leave.Status)
+	}
+
+	if err = d.blocker.ReleaseLeave(ctx, leave.Id); err != nil {
+		return nil, err
+	}
+	response, err := d.repo.SetDoctorLeaveStatus(ctx, req, entity.LeaveApproved)
+	if err != nil {
+		// the leave stays approved, so its days are blocked again
+		_ = d.blocker.BlockLeave(ctx, leave)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ListAwayDoctors lists the doctors on an approved leave on the date, today when the date is empty
+func (d doctorLeaveService) ListAwayDoctors(ctx context.Context, in *entity.AwayDoctorsReq) (*entity.ListAwayDoctors, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorLeaveUseCase, serviceNameDoctorLeaveUseCaseRepoPrefix+"List away")
+	span.SetAttributes(attribute.Key(in.Date).String(in.DepartmentId))
+	defer span.End()
+
+	if in.Date == "" {
+		in.Date = time.Now().Add(time.Hour * 5).Format("2006-01-02")
+	}
+	if _, err := time.Parse("2006-01-02", in.Date); err != nil {
+		return nil, validationError("date", "date must be a date 2006-01-02")
+	}
+
+	return d.repo.ListAwayDoctors(ctx, in)
+}
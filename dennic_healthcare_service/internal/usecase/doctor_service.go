@@ -6,6 +6,7 @@ import (
 	"Healthcare_Evrone/internal/pkg/otlp"
 	"context"
 	"go.opentelemetry.io/otel/attribute"
+	"strings"
 	"time"
 )
 
@@ -20,18 +21,21 @@ type DoctorServices interface {
 	GetAllDoctorServices(ctx context.Context, all *entity.GetAll) (*entity.ListDoctorServices, error)
 	UpdateDoctorServices(ctx context.Context, in *entity.DoctorServices) (*entity.DoctorServices, error)
 	DeleteDoctorService(ctx context.Context, in *entity.GetReqStr) (bool, error)
+	RestoreDoctorService(ctx context.Context, id string) (bool, error)
 }
 
 type newsDoctorSService struct {
 	BaseUseCase
-	repo       repository.DoctorServices
-	ctxTimeout time.Duration
+	repo        repository.DoctorServices
+	translation TranslationUseCase
+	ctxTimeout  time.Duration
 }
 
-func NewDoctorServices(ctxTimeout time.Duration, ds repository.DoctorServices) newsDoctorSService {
+func NewDoctorServices(ctxTimeout time.Duration, ds repository.DoctorServices, translation TranslationUseCase) newsDoctorSService {
 	return newsDoctorSService{
-		ctxTimeout: ctxTimeout,
-		repo:       ds,
+		ctxTimeout:  ctxTimeout,
+		repo:        ds,
+		translation: translation,
 	}
 }
 
@@ -43,6 +47,8 @@ func (u newsDoctorSService) CreateDoctorServices(ctx context.Context, in *entity
 	span.SetAttributes(attribute.Key("CreateDoctorServices").String(in.Id))
 	defer span.End()
 
+	in.RequiredResourceType = strings.ToLower(strings.TrimSpace(in.RequiredResourceType))
+
 	return u.repo.CreateDoctorServices(ctx, in)
 }
 
@@ -54,7 +60,18 @@ func (u newsDoctorSService) GetDoctorServiceByID(ctx context.Context, in *entity
 	span.SetAttributes(attribute.Key(in.Field).String(in.Value))
 	defer span.End()
 
-	return u.repo.GetDoctorServiceByID(ctx, in)
+	service, err := u.repo.GetDoctorServiceByID(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	localized, err := u.translation.Localize(ctx, entity.TranslationDoctorService, service.Id)
+	if err != nil {
+		return nil, err
+	}
+	localized.Apply(service.Id, "name", &service.Name)
+
+	return service, nil
 }
 
 func (u newsDoctorSService) GetAllDoctorServices(ctx context.Context, all *entity.GetAll) (*entity.ListDoctorServices, error) {
@@ -67,7 +84,24 @@ func (u newsDoctorSService) GetAllDoctorServices(ctx context.Context, all *entit
 
 	defer span.End()
 
-	return u.repo.GetAllDoctorServices(ctx, all)
+	services, err := u.repo.GetAllDoctorServices(ctx, all)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(services.DoctorServices))
+	for _, service := range services.DoctorServices {
+		ids = append(ids, service.Id)
+	}
+	localized, err := u.translation.Localize(ctx, entity.TranslationDoctorService, ids...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range services.DoctorServices {
+		localized.Apply(services.DoctorServices[i].Id, "name", &services.DoctorServices[i].Name)
+	}
+
+	return services, nil
 }
 
 func (u newsDoctorSService) UpdateDoctorServices(ctx context.Context, in *entity.DoctorServices) (*entity.DoctorServices, error) {
@@ -78,6 +112,8 @@ func (u newsDoctorSService) UpdateDoctorServices(ctx context.Context, in *entity
 	span.SetAttributes(attribute.Key("doctorService").String(in.Id))
 	defer span.End()
 
+	in.RequiredResourceType = strings.ToLower(strings.TrimSpace(in.RequiredResourceType))
+
 	return u.repo.UpdateDoctorServices(ctx, in)
 }
 
@@ -91,3 +127,14 @@ func (u newsDoctorSService) DeleteDoctorService(ctx context.Context, in *entity.
 
 	return u.repo.DeleteDoctorService(ctx, in)
 }
+
+func (u newsDoctorSService) RestoreDoctorService(ctx context.Context, id string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorServiceUseCase, serviceNameDoctorServiceUseCaseRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreDoctorService").String(id))
+	defer span.End()
+
+	return u.repo.RestoreDoctorService(ctx, id)
+}
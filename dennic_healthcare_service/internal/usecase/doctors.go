@@ -21,8 +21,12 @@ type DoctorUsecase interface {
 	GetAllDoctors(ctx context.Context, all *entity.GetAll) (*entity.ListDoctorsAndHours, error)
 	UpdateDoctor(ctx context.Context, update *entity.Doctor) (*entity.Doctor, error)
 	DeleteDoctor(ctx context.Context, del *entity.GetReqStr) (bool, error)
+	RestoreDoctor(ctx context.Context, id string) (bool, error)
 	ListDoctorsByDepartmentId(ctx context.Context, in *entity.GetReqStrDep) (*entity.ListDoctors, error)
 	ListDoctorBySpecializationId(ctx context.Context, spec *entity.GetReqStrSpec) (*entity.ListDoctorsAndHours, error)
+	ListDoctorsForService(ctx context.Context, in *entity.GetReqServiceDoctors) ([]*entity.ServiceDoctor, error)
+	SetDoctorRating(ctx context.Context, in *entity.DoctorRating) (bool, error)
+	ListDoctorsForReason(ctx context.Context, in *entity.GetReqReasonDoctors) (*entity.ReasonDoctors, error)
 }
 
 type newsService struct {
@@ -95,6 +99,17 @@ func (u newsService) DeleteDoctor(ctx context.Context, del *entity.GetReqStr) (b
 	return u.repo.DeleteDoctor(ctx, del)
 }
 
+func (u newsService) RestoreDoctor(ctx context.Context, id string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorUseCase, serviceNameDoctorUseCaseRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreDoctor").String(id))
+	defer span.End()
+
+	return u.repo.RestoreDoctor(ctx, id)
+}
+
 func (u newsService) ListDoctorsByDepartmentId(ctx context.Context, in *entity.GetReqStrDep) (*entity.ListDoctors, error) {
 	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
 	defer cancel()
@@ -118,3 +133,38 @@ func (u newsService) ListDoctorBySpecializationId(ctx context.Context, in *entit
 
 	return u.repo.ListDoctorBySpecializationId(ctx, in)
 }
+
+func (u newsService) ListDoctorsForService(ctx context.Context, in *entity.GetReqServiceDoctors) ([]*entity.ServiceDoctor, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorUseCase, serviceNameDoctorUseCaseRepoPrefix+"List for service")
+	span.SetAttributes(attribute.Key("ListDoctorsForService").String(in.DoctorServiceId))
+
+	defer span.End()
+
+	return u.repo.ListDoctorsForService(ctx, in)
+}
+
+func (u newsService) SetDoctorRating(ctx context.Context, in *entity.DoctorRating) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorUseCase, serviceNameDoctorUseCaseRepoPrefix+"Set rating")
+	span.SetAttributes(attribute.Key("SetDoctorRating").String(in.DoctorId))
+
+	defer span.End()
+
+	return u.repo.SetDoctorRating(ctx, in)
+}
+
+func (u newsService) ListDoctorsForReason(ctx context.Context, in *entity.GetReqReasonDoctors) (*entity.ReasonDoctors, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorUseCase, serviceNameDoctorUseCaseRepoPrefix+"List for reason")
+	span.SetAttributes(attribute.Key("ListDoctorsForReason").String(in.ReasonId + in.Query))
+	defer span.End()
+
+	return u.repo.ListDoctorsForReason(ctx, in)
+}
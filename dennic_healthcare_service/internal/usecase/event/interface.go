@@ -19,6 +19,6 @@ type BrokerConsumer interface {
 }
 
 type BrokerProducer interface {
-	ProduceContent(ctx context.Context, key string, value *entity.Doctor) error
+	ProduceEvents(ctx context.Context, events []entity.OutboxEvent) error
 	Close()
 }
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/usecase/event"
+	"context"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	serviceNameOutboxUseCase           = "outboxUseCase"
+	serviceNameOutboxUseCaseRepoPrefix = "outboxUseCase"
+)
+
+type OutboxUseCase interface {
+	RelayEvents(ctx context.Context, batchSize uint64) (int, error)
+	PurgePublished(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+type outboxService struct {
+	BaseUseCase
+	repo       repository.OutboxRepository
+	producer   event.BrokerProducer
+	ctxTimeout time.Duration
+}
+
+func NewOutboxService(ctxTimeout time.Duration, repo repository.OutboxRepository, producer event.BrokerProducer) outboxService {
+	return outboxService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+		producer:   producer,
+	}
+}
+
+// RelayEvents publishes at most batchSize of the healthcare change events waiting in the outbox
+func (o outboxService) RelayEvents(ctx context.Context, batchSize uint64) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameOutboxUseCase, serviceNameOutboxUseCaseRepoPrefix+"Relay")
+	span.SetAttributes(attribute.Key("RelayEvents").String(strconv.FormatUint(batchSize, 10)))
+	defer span.End()
+
+	return o.repo.RelayEvents(ctx, batchSize, o.producer.ProduceEvents)
+}
+
+// PurgePublished deletes the events published longer than the retention ago
+func (o outboxService) PurgePublished(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameOutboxUseCase, serviceNameOutboxUseCaseRepoPrefix+"Purge")
+	span.SetAttributes(attribute.Key("PurgePublished").String(retention.String()))
+	defer span.End()
+
+	return o.repo.PurgePublished(ctx, time.Now().Add(time.Hour*5).Add(-retention))
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	serviceNamePriceHistoryUseCase           = "priceHistoryUseCase"
+	serviceNamePriceHistoryUseCaseRepoPrefix = "priceHistoryUseCase"
+)
+
+type PriceHistoryUseCase interface {
+	SchedulePriceChange(ctx context.Context, in *entity.SchedulePriceChangeReq) (*entity.PriceChange, error)
+	ListPriceHistory(ctx context.Context, in *entity.PriceHistoryReq) (*entity.PriceHistory, error)
+	GetPriceAt(ctx context.Context, in *entity.PriceAtReq) (*entity.PriceChange, error)
+	CancelPriceChange(ctx context.Context, id string) (bool, error)
+	ApplyDuePrices(ctx context.Context) (int64, error)
+}
+
+type priceHistoryService struct {
+	BaseUseCase
+	repo       repository.PriceHistoryRepository
+	ctxTimeout time.Duration
+}
+
+func NewPriceHistoryService(ctxTimeout time.Duration, repo repository.PriceHistoryRepository) priceHistoryService {
+	return priceHistoryService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+// SchedulePriceChange schedules a price of the doctor service taking effect in the future
+func (p priceHistoryService) SchedulePriceChange(ctx context.Context, in *entity.SchedulePriceChangeReq) (*entity.PriceChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePriceHistoryUseCase, serviceNamePriceHistoryUseCaseRepoPrefix+"Schedule")
+	span.SetAttributes(attribute.Key(in.DoctorServiceId).String(in.EffectiveFrom))
+	defer span.End()
+
+	if in.DoctorServiceId == "" {
+		return nil, validationError("doctor_service_id", "doctor_service_id is required")
+	}
+	if in.OnlinePrice < 0 || in.OfflinePrice < 0 {
+		return nil, validationError("price", "prices must not be negative")
+	}
+	effectiveFrom, err := time.Parse("2006-01-02 15:04:05", in.EffectiveFrom)
+	if err != nil {
+		return nil, validationError("effective_from", "effective_from must be a time 2006-01-02 15:04:05")
+	}
+	if !effectiveFrom.After(time.Now().Add(time.Hour * 5)) {
+		return nil, validationError("effective_from", "effective_from must be in the future")
+	}
+
+	return p.repo.CreatePriceChange(ctx, &entity.PriceChange{
+		Id:              in.Id,
+		DoctorServiceId: in.DoctorServiceId,
+		OnlinePrice:     in.OnlinePrice,
+		OfflinePrice:    in.OfflinePrice,
+		EffectiveFrom:   effectiveFrom,
+	})
+}
+
+func (p priceHistoryService) ListPriceHistory(ctx context.Context, in *entity.PriceHistoryReq) (*entity.PriceHistory, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePriceHistoryUseCase, serviceNamePriceHistoryUseCaseRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListPriceHistory").String(in.DoctorServiceId))
+	defer span.End()
+
+	return p.repo.ListPriceHistory(ctx, in)
+}
+
+// GetPriceAt returns the price of the doctor service valid at the moment, now when the moment is empty
+func (p priceHistoryService) GetPriceAt(ctx context.Context, in *entity.PriceAtReq) (*entity.PriceChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePriceHistoryUseCase, serviceNamePriceHistoryUseCaseRepoPrefix+"Price at")
+	span.SetAttributes(attribute.Key(in.DoctorServiceId).String(in.At))
+	defer span.End()
+
+	at := time.Now().Add(time.Hour * 5)
+	if in.At != "" {
+		var err error
+		if at, err = time.Parse("2006-01-02 15:04:05", in.At); err != nil {
+			return nil, validationError("at", "at must be a time 2006-01-02 15:04:05")
+		}
+	}
+
+	return p.repo.GetPriceAt(ctx, in.DoctorServiceId, at)
+}
+
+// CancelPriceChange deletes a price change which has not taken effect yet
+func (p priceHistoryService) CancelPriceChange(ctx context.Context, id string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePriceHistoryUseCase, serviceNamePriceHistoryUseCaseRepoPrefix+"Cancel")
+	span.SetAttributes(attribute.Key("CancelPriceChange").String(id))
+	defer span.End()
+
+	return p.repo.DeleteScheduledPriceChange(ctx, id, time.Now().Add(time.Hour*5))
+}
+
+// ApplyDuePrices copies the prices which took effect into doctor_service
+func (p priceHistoryService) ApplyDuePrices(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePriceHistoryUseCase, serviceNamePriceHistoryUseCaseRepoPrefix+"Apply due")
+	defer span.End()
+
+	return p.repo.ApplyDuePrices(ctx, time.Now().Add(time.Hour*5))
+}
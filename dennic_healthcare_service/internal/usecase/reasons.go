@@ -15,12 +15,14 @@ type ReasonUseCase interface {
 	GetAllReasons(context.Context, *entity.GetAllReas) (*entity.ListReasons, error)
 	UpdateReasons(context.Context, *entity.Reasons) (*entity.Reasons, error)
 	DeleteReasons(context.Context, *entity.GetReqStr) (*entity.StatusReasons, error)
+	RestoreReasons(ctx context.Context, id string) (*entity.StatusReasons, error)
 }
 
 type newsReasons struct {
 	BaseUseCase
-	repo       repository.ReasonRepository
-	ctxTimeout time.Duration
+	repo        repository.ReasonRepository
+	translation TranslationUseCase
+	ctxTimeout  time.Duration
 }
 
 const (
@@ -28,10 +30,11 @@ const (
 	serviceNameReasonsUseCaseRepoPrefix = "reasonsUseCase"
 )
 
-func NewReasons(ctxTimeout time.Duration, reasons repository.ReasonRepository) newsReasons {
+func NewReasons(ctxTimeout time.Duration, reasons repository.ReasonRepository, translation TranslationUseCase) newsReasons {
 	return newsReasons{
-		ctxTimeout: ctxTimeout,
-		repo:       reasons,
+		ctxTimeout:  ctxTimeout,
+		repo:        reasons,
+		translation: translation,
 	}
 }
 
@@ -54,7 +57,18 @@ func (n newsReasons) GetReasonsById(ctx context.Context, in *entity.GetReqStr) (
 	span.SetAttributes(attribute.Key(in.Field).String(in.Value))
 	defer span.End()
 
-	return n.repo.GetReasonsById(ctx, in)
+	reasons, err := n.repo.GetReasonsById(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	localized, err := n.translation.Localize(ctx, entity.TranslationReason, reasons.Id)
+	if err != nil {
+		return nil, err
+	}
+	localized.Apply(reasons.Id, "name", &reasons.Name)
+
+	return reasons, nil
 }
 
 func (n newsReasons) GetAllReasons(ctx context.Context, reas *entity.GetAllReas) (*entity.ListReasons, error) {
@@ -65,7 +79,24 @@ func (n newsReasons) GetAllReasons(ctx context.Context, reas *entity.GetAllReas)
 	span.SetAttributes(attribute.Key(reas.Field).String(reas.Value))
 	defer span.End()
 
-	return n.repo.GetAllReasons(ctx, reas)
+	reasons, err := n.repo.GetAllReasons(ctx, reas)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(reasons.Reasons))
+	for _, reason := range reasons.Reasons {
+		ids = append(ids, reason.Id)
+	}
+	localized, err := n.translation.Localize(ctx, entity.TranslationReason, ids...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range reasons.Reasons {
+		localized.Apply(reasons.Reasons[i].Id, "name", &reasons.Reasons[i].Name)
+	}
+
+	return reasons, nil
 }
 
 func (n newsReasons) UpdateReasons(ctx context.Context, reasons *entity.Reasons) (*entity.Reasons, error) {
@@ -89,3 +120,14 @@ func (n newsReasons) DeleteReasons(ctx context.Context, reasons *entity.GetReqSt
 
 	return n.repo.DeleteReasons(ctx, reasons)
 }
+
+func (n newsReasons) RestoreReasons(ctx context.Context, id string) (*entity.StatusReasons, error) {
+	ctx, cancel := context.WithTimeout(ctx, n.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameReasonsUseCase, serviceNameReasonsUseCaseRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreReasons").String(id))
+	defer span.End()
+
+	return n.repo.RestoreReasons(ctx, id)
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"context"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	serviceNameResourceUseCase           = "resourceUseCase"
+	serviceNameResourceUseCaseRepoPrefix = "resourceUseCase"
+)
+
+type ResourceUseCase interface {
+	CreateResource(ctx context.Context, in *entity.Resource) (*entity.Resource, error)
+	GetResource(ctx context.Context, id string) (*entity.Resource, error)
+	ListResources(ctx context.Context, in *entity.ListResourcesReq) (*entity.ListResources, error)
+	UpdateResource(ctx context.Context, in *entity.Resource) (*entity.Resource, error)
+	DeleteResource(ctx context.Context, id string) (bool, error)
+	ListServiceResources(ctx context.Context, doctorServiceId string) (*entity.ServiceResources, error)
+}
+
+type resourceService struct {
+	BaseUseCase
+	repo       repository.ResourceRepository
+	ctxTimeout time.Duration
+}
+
+func NewResourceService(ctxTimeout time.Duration, repo repository.ResourceRepository) resourceService {
+	return resourceService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (r resourceService) CreateResource(ctx context.Context, in *entity.Resource) (*entity.Resource, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameResourceUseCase, serviceNameResourceUseCaseRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateResource").String(in.Id))
+	defer span.End()
+
+	if err := validateResource(in); err != nil {
+		return nil, err
+	}
+
+	return r.repo.CreateResource(ctx, in)
+}
+
+func (r resourceService) GetResource(ctx context.Context, id string) (*entity.Resource, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameResourceUseCase, serviceNameResourceUseCaseRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetResource").String(id))
+	defer span.End()
+
+	return r.repo.GetResource(ctx, id)
+}
+
+func (r resourceService) ListResources(ctx context.Context, in *entity.ListResourcesReq) (*entity.ListResources, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameResourceUseCase, serviceNameResourceUseCaseRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListResources").String(in.ResourceType))
+	defer span.End()
+
+	in.ResourceType = strings.ToLower(strings.TrimSpace(in.ResourceType))
+	return r.repo.ListResources(ctx, in)
+}
+
+func (r resourceService) UpdateResource(ctx context.Context, in *entity.Resource) (*entity.Resource, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameResourceUseCase, serviceNameResourceUseCaseRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateResource").String(in.Id))
+	defer span.End()
+
+	if err := validateResource(in); err != nil {
+		return nil, err
+	}
+
+	return r.repo.UpdateResource(ctx, in)
+}
+
+func (r resourceService) DeleteResource(ctx context.Context, id string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameResourceUseCase, serviceNameResourceUseCaseRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteResource").String(id))
+	defer span.End()
+
+	return r.repo.DeleteResource(ctx, id)
+}
+
+func (r resourceService) ListServiceResources(ctx context.Context, doctorServiceId string) (*entity.ServiceResources, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameResourceUseCase, serviceNameResourceUseCaseRepoPrefix+"List service resources")
+	span.SetAttributes(attribute.Key("doctor_service_id").String(doctorServiceId))
+	defer span.End()
+
+	if doctorServiceId == "" {
+		return nil, validationError("doctor_service_id", "doctor_service_id is required")
+	}
+
+	return r.repo.ListServiceResources(ctx, doctorServiceId)
+}
+
+// validateResource checks the resource, the resource type is kept in lower case
+// so that it matches the type required by the doctor services
+func validateResource(in *entity.Resource) error {
+	if strings.TrimSpace(in.Name) == "" {
+		return validationError("name", "name is required")
+	}
+	if in.Kind != entity.ResourceKindRoom && in.Kind != entity.ResourceKindEquipment {
+		return validationError("kind", "kind must be one of room, equipment")
+	}
+	in.ResourceType = strings.ToLower(strings.TrimSpace(in.ResourceType))
+	if in.ResourceType == "" {
+		return validationError("resource_type", "resource_type is required")
+	}
+	return nil
+}
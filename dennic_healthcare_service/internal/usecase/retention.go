@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	serviceNameRetentionUseCase           = "retentionUseCase"
+	serviceNameRetentionUseCaseRepoPrefix = "retentionUseCase"
+)
+
+type RetentionUseCase interface {
+	PurgeDeleted(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+type retentionService struct {
+	BaseUseCase
+	repo       repository.RetentionRepository
+	ctxTimeout time.Duration
+}
+
+func NewRetentionService(ctxTimeout time.Duration, repo repository.RetentionRepository) retentionService {
+	return retentionService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+// PurgeDeleted hard deletes the healthcare records soft deleted longer than the retention ago
+func (r retentionService) PurgeDeleted(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameRetentionUseCase, serviceNameRetentionUseCaseRepoPrefix+"Purge")
+	span.SetAttributes(attribute.Key("PurgeDeleted").String(retention.String()))
+	defer span.End()
+
+	return r.repo.PurgeDeleted(ctx, time.Now().Add(time.Hour*5).Add(-retention))
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type SearchUseCase interface {
+	Search(ctx context.Context, in *entity.SearchReq) (*entity.SearchRes, error)
+}
+
+type newsSearch struct {
+	BaseUseCase
+	repo       repository.SearchRepository
+	ctxTimeout time.Duration
+}
+
+const (
+	serviceNameSearchUseCase           = "searchUseCase"
+	serviceNameSearchUseCaseRepoPrefix = "searchUseCase"
+)
+
+func NewSearch(ctxTimeout time.Duration, search repository.SearchRepository) newsSearch {
+	return newsSearch{
+		ctxTimeout: ctxTimeout,
+		repo:       search,
+	}
+}
+
+func (n newsSearch) Search(ctx context.Context, in *entity.SearchReq) (*entity.SearchRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, n.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameSearchUseCase, serviceNameSearchUseCaseRepoPrefix+"Search")
+	span.SetAttributes(attribute.Key("query").String(in.Query))
+	defer span.End()
+
+	return n.repo.Search(ctx, in)
+}
@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	serviceNameServiceDiscountUseCase           = "serviceDiscountUseCase"
+	serviceNameServiceDiscountUseCaseRepoPrefix = "serviceDiscountUseCase"
+)
+
+type ServiceDiscountUseCase interface {
+	CreateServiceDiscount(ctx context.Context, in *entity.ServiceDiscount) (*entity.ServiceDiscount, error)
+	GetServiceDiscount(ctx context.Context, id string) (*entity.ServiceDiscount, error)
+	ListServiceDiscounts(ctx context.Context, in *entity.ListServiceDiscountsReq) (*entity.ListServiceDiscounts, error)
+	UpdateServiceDiscount(ctx context.Context, in *entity.ServiceDiscount) (*entity.ServiceDiscount, error)
+	DeleteServiceDiscount(ctx context.Context, id string) (bool, error)
+	GetServicePrice(ctx context.Context, in *entity.ServicePriceReq) (*entity.ServicePrice, error)
+}
+
+type serviceDiscountService struct {
+	BaseUseCase
+	repo           repository.ServiceDiscountRepository
+	doctorServices repository.DoctorServices
+	ctxTimeout     time.Duration
+}
+
+func NewServiceDiscountService(ctxTimeout time.Duration, repo repository.ServiceDiscountRepository, doctorServices repository.DoctorServices) serviceDiscountService {
+	return serviceDiscountService{
+		ctxTimeout:     ctxTimeout,
+		repo:           repo,
+		doctorServices: doctorServices,
+	}
+}
+
+func (s serviceDiscountService) CreateServiceDiscount(ctx context.Context, in *entity.ServiceDiscount) (*entity.ServiceDiscount, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameServiceDiscountUseCase, serviceNameServiceDiscountUseCaseRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateServiceDiscount").String(in.Id))
+	defer span.End()
+
+	if in.DoctorServiceId == "" {
+		return nil, validationError("doctor_service_id", "doctor_service_id is required")
+	}
+	if err := validateServiceDiscount(in); err != nil {
+		return nil, err
+	}
+
+	return s.repo.CreateServiceDiscount(ctx, in)
+}
+
+func (s serviceDiscountService) GetServiceDiscount(ctx context.Context, id string) (*entity.ServiceDiscount, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameServiceDiscountUseCase, serviceNameServiceDiscountUseCaseRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetServiceDiscount").String(id))
+	defer span.End()
+
+	return s.repo.GetServiceDiscount(ctx, id)
+}
+
+func (s serviceDiscountService) ListServiceDiscounts(ctx context.Context, in *entity.ListServiceDiscountsReq) (*entity.ListServiceDiscounts, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameServiceDiscountUseCase, serviceNameServiceDiscountUseCaseRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListServiceDiscounts").String(in.DoctorServiceId))
+	defer span.End()
+
+	if in.ActiveOn != "" {
+		if _, err := time.Parse("2006-01-02", in.ActiveOn); err != nil {
+			return nil, validationError("active_on", "active_on must be a date 2006-01-02")
+		}
+	}
+
+	return s.repo.ListServiceDiscounts(ctx, in)
+}
+
+func (s serviceDiscountService) UpdateServiceDiscount(ctx context.Context, in *entity.ServiceDiscount) (*entity.ServiceDiscount, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameServiceDiscountUseCase, serviceNameServiceDiscountUseCaseRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateServiceDiscount").String(in.Id))
+	defer span.End()
+
+	if err := validateServiceDiscount(in); err != nil {
+		return nil, err
+	}
+
+	return s.repo.UpdateServiceDiscount(ctx, in)
+}
+
+func (s serviceDiscountService) DeleteServiceDiscount(ctx context.Context, id string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameServiceDiscountUseCase, serviceNameServiceDiscountUseCaseRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteServiceDiscount").String(id))
+	defer span.End()
+
+	return s.repo.DeleteServiceDiscount(ctx, id)
+}
+
+// GetServicePrice applies the largest of the discounts active on the day to the price of the doctor service,
+// the day defaults to today
+func (s serviceDiscountService) GetServicePrice(ctx context.Context, in *entity.ServicePriceReq) (*entity.ServicePrice, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameServiceDiscountUseCase, serviceNameServiceDiscountUseCaseRepoPrefix+"Get price")
+	span.SetAttributes(attribute.Key("doctor_service_id").String(in.DoctorServiceId))
+	defer span.End()
+
+	if in.Date == "" {
+		in.Date = time.Now().Add(time.Hour * 5).Format("2006-01-02")
+	}
+	if _, err := time.Parse("2006-01-02", in.Date); err != nil {
+		return nil, validationError("date", "date must be a date 2006-01-02")
+	}
+
+	doctorService, err := s.doctorServices.GetDoctorServiceByID(ctx, &entity.GetReqStr{
+		Field: "id",
+		Value: in.DoctorServiceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	price := &entity.ServicePrice{
+		DoctorServiceId: in.DoctorServiceId,
+		BasePrice:       float64(doctorService.OfflinePrice),
+	}
+	if in.Online {
+		price.BasePrice = float64(doctorService.OnlinePrice)
+	}
+
+	discounts, err := s.repo.ListServiceDiscounts(ctx, &entity.ListServiceDiscountsReq{
+		DoctorServiceId: in.DoctorServiceId,
+		ActiveOn:        in.Date,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range discounts.ServiceDiscounts {
+		discount := &discounts.ServiceDiscounts[i]
+		if amount := discount.DiscountAmount(price.BasePrice); amount > price.Discount {
+			price.Discount = amount
+			price.DiscountId = discount.Id
+		}
+	}
+	price.Price = price.BasePrice - price.Discount
+	return price, nil
+}
+
+func validateServiceDiscount(in *entity.ServiceDiscount) error {
+	switch in.Kind {
+	case entity.DiscountPercent:
+		if in.Value <= 0 || in.Value > 100 {
+			return validationError("value", "a percent discount must be above 0 and at most 100")
+		}
+	case entity.DiscountFixed:
+		if in.Value <= 0 {
+			return validationError("value", "a fixed discount must be above 0")
+		}
+	default:
+		return validationError("kind", "kind must be one of percent, fixed")
+	}
+
+	startsOn, err := time.Parse("2006-01-02", in.StartsOn)
+	if err != nil {
+		return validationError("starts_on", "starts_on must be a date 2006-01-02")
+	}
+	if in.EndsOn != "" {
+		endsOn, err := time.Parse("2006-01-02", in.EndsOn)
+		if err != nil {
+			return validationError("ends_on", "ends_on must be a date 2006-01-02")
+		}
+		if endsOn.Before(startsOn) {
+			return validationError("ends_on", "ends_on cannot be before starts_on")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"context"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	serviceNameServicePackageUseCase           = "servicePackageUseCase"
+	serviceNameServicePackageUseCaseRepoPrefix = "servicePackageUseCase"
+)
+
+type ServicePackageUseCase interface {
+	CreateServicePackage(ctx context.Context, in *entity.ServicePackage) (*entity.ServicePackage, error)
+	GetServicePackage(ctx context.Context, id string) (*entity.ServicePackage, error)
+	ListServicePackages(ctx context.Context, in *entity.ListServicePackagesReq) (*entity.ListServicePackages, error)
+	UpdateServicePackage(ctx context.Context, in *entity.ServicePackage) (*entity.ServicePackage, error)
+	DeleteServicePackage(ctx context.Context, id string) (bool, error)
+}
+
+type servicePackageService struct {
+	BaseUseCase
+	repo       repository.ServicePackageRepository
+	ctxTimeout time.Duration
+}
+
+func NewServicePackageService(ctxTimeout time.Duration, repo repository.ServicePackageRepository) servicePackageService {
+	return servicePackageService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (s servicePackageService) CreateServicePackage(ctx context.Context, in *entity.ServicePackage) (*entity.ServicePackage, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameServicePackageUseCase, serviceNameServicePackageUseCaseRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateServicePackage").String(in.Id))
+	defer span.End()
+
+	if err := validateServicePackage(in); err != nil {
+		return nil, err
+	}
+
+	return s.repo.CreateServicePackage(ctx, in)
+}
+
+func (s servicePackageService) GetServicePackage(ctx context.Context, id string) (*entity.ServicePackage, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameServicePackageUseCase, serviceNameServicePackageUseCaseRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetServicePackage").String(id))
+	defer span.End()
+
+	return s.repo.GetServicePackage(ctx, id)
+}
+
+func (s servicePackageService) ListServicePackages(ctx context.Context, in *entity.ListServicePackagesReq) (*entity.ListServicePackages, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameServicePackageUseCase, serviceNameServicePackageUseCaseRepoPrefix+"List")
+	span.SetAttributes(attribute.Key("ListServicePackages").String(in.DoctorServiceId))
+	defer span.End()
+
+	return s.repo.ListServicePackages(ctx, in)
+}
+
+func (s servicePackageService) UpdateServicePackage(ctx context.Context, in *entity.ServicePackage) (*entity.ServicePackage, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameServicePackageUseCase, serviceNameServicePackageUseCaseRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateServicePackage").String(in.Id))
+	defer span.End()
+
+	if err := validateServicePackage(in); err != nil {
+		return nil, err
+	}
+
+	return s.repo.UpdateServicePackage(ctx, in)
+}
+
+func (s servicePackageService) DeleteServicePackage(ctx context.Context, id string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameServicePackageUseCase, serviceNameServicePackageUseCaseRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteServicePackage").String(id))
+	defer span.End()
+
+	return s.repo.DeleteServicePackage(ctx, id)
+}
+
+// validateServicePackage checks the package and drops the repeated doctor services
+func validateServicePackage(in *entity.ServicePackage) error {
+	if strings.TrimSpace(in.Name) == "" {
+		return validationError("name", "name is required")
+	}
+	if in.Price < 0 {
+		return validationError("price", "price cannot be negative")
+	}
+
+	seen := make(map[string]bool, len(in.DoctorServiceIds))
+	doctorServiceIds := []string{}
+	for _, id := range in.DoctorServiceIds {
+		if id = strings.TrimSpace(id); id != "" && !seen[id] {
+			seen[id] = true
+			doctorServiceIds = append(doctorServiceIds, id)
+		}
+	}
+	if len(doctorServiceIds) < 2 {
+		return validationError("doctor_service_ids", "a package bundles at least two doctor services")
+	}
+	in.DoctorServiceIds = doctorServiceIds
+	return nil
+}
@@ -20,18 +20,22 @@ type SpecializationUsecase interface {
 	GetAllSpecializations(ctx context.Context, all *entity.GetAllSpecializations) (*entity.ListSpecializations, error)
 	UpdateSpecialization(ctx context.Context, in *entity.Specialization) (*entity.Specialization, error)
 	DeleteSpecialization(ctx context.Context, in *entity.GetReqStr) (bool, error)
+	RestoreSpecialization(ctx context.Context, id string) (bool, error)
+	GetSpecializationTree(ctx context.Context, in *entity.SpecializationTreeReq) (*entity.ListSpecializations, error)
 }
 
 type newsSpecService struct {
 	BaseUseCase
-	repo       repository.SpecializationRepository
-	ctxTimeout time.Duration
+	repo        repository.SpecializationRepository
+	translation TranslationUseCase
+	ctxTimeout  time.Duration
 }
 
-func NewSpecializationService(ctxTimeout time.Duration, repo repository.SpecializationRepository) newsSpecService {
+func NewSpecializationService(ctxTimeout time.Duration, repo repository.SpecializationRepository, translation TranslationUseCase) newsSpecService {
 	return newsSpecService{
-		ctxTimeout: ctxTimeout,
-		repo:       repo,
+		ctxTimeout:  ctxTimeout,
+		repo:        repo,
+		translation: translation,
 	}
 }
 
@@ -55,11 +59,22 @@ func (n newsSpecService) GetSpecializationById(ctx context.Context, in *entity.G
 
 	defer span.End()
 
-	return n.repo.GetSpecializationById(ctx, &entity.GetReqStr{
+	specialization, err := n.repo.GetSpecializationById(ctx, &entity.GetReqStr{
 		Field:    in.Field,
 		Value:    in.Value,
 		IsActive: in.IsActive,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	localized, err := n.translation.Localize(ctx, entity.TranslationSpecialization, specialization.ID)
+	if err != nil {
+		return nil, err
+	}
+	localized.Apply(specialization.ID, "name", &specialization.Name)
+
+	return specialization, nil
 }
 
 func (n newsSpecService) GetAllSpecializations(ctx context.Context, all *entity.GetAllSpecializations) (*entity.ListSpecializations, error) {
@@ -71,7 +86,24 @@ func (n newsSpecService) GetAllSpecializations(ctx context.Context, all *entity.
 
 	defer span.End()
 
-	return n.repo.GetAllSpecializations(ctx, all)
+	specializations, err := n.repo.GetAllSpecializations(ctx, all)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(specializations.Specializations))
+	for _, specialization := range specializations.Specializations {
+		ids = append(ids, specialization.ID)
+	}
+	localized, err := n.translation.Localize(ctx, entity.TranslationSpecialization, ids...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range specializations.Specializations {
+		localized.Apply(specializations.Specializations[i].ID, "name", &specializations.Specializations[i].Name)
+	}
+
+	return specializations, nil
 }
 
 func (n newsSpecService) UpdateSpecialization(ctx context.Context, in *entity.Specialization) (*entity.Specialization, error) {
@@ -82,6 +114,19 @@ func (n newsSpecService) UpdateSpecialization(ctx context.Context, in *entity.Sp
 	span.SetAttributes(attribute.String("UpdateSpecialization", in.ID))
 	defer span.End()
 
+	if in.ParentId != "" {
+		// a specialization can not become a sub-specialization of itself or of one of its descendants
+		subtree, err := n.repo.GetSpecializationTree(ctx, &entity.SpecializationTreeReq{RootId: in.ID})
+		if err != nil {
+			return nil, err
+		}
+		for _, specialization := range subtree.Specializations {
+			if specialization.ID == in.ParentId {
+				return nil, validationError("parent_id", "parent_id must not be the specialization or one of its sub-specializations")
+			}
+		}
+	}
+
 	return n.repo.UpdateSpecialization(ctx, in)
 }
 
@@ -96,3 +141,43 @@ func (n newsSpecService) DeleteSpecialization(ctx context.Context, in *entity.Ge
 
 	return n.repo.DeleteSpecialization(ctx, in)
 }
+
+func (n newsSpecService) RestoreSpecialization(ctx context.Context, id string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, n.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameSpecializationUseCase, serviceNameSpecializationUseCaseRepoPrefix+"Restore")
+	span.SetAttributes(attribute.Key("RestoreSpecialization").String(id))
+	defer span.End()
+
+	return n.repo.RestoreSpecialization(ctx, id)
+}
+
+// GetSpecializationTree returns the specialization with its sub-specializations, parents before their children
+func (n newsSpecService) GetSpecializationTree(ctx context.Context, in *entity.SpecializationTreeReq) (*entity.ListSpecializations, error) {
+	ctx, cancel := context.WithTimeout(ctx, n.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameSpecializationUseCase, serviceNameSpecializationUseCaseRepoPrefix+"Tree")
+	span.SetAttributes(attribute.String("GetSpecializationTree", in.RootId))
+	defer span.End()
+
+	specializations, err := n.repo.GetSpecializationTree(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(specializations.Specializations))
+	for _, specialization := range specializations.Specializations {
+		ids = append(ids, specialization.ID)
+	}
+	localized, err := n.translation.Localize(ctx, entity.TranslationSpecialization, ids...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range specializations.Specializations {
+		localized.Apply(specializations.Specializations[i].ID, "name", &specializations.Specializations[i].Name)
+	}
+
+	return specializations, nil
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/language"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	serviceNameTranslationUseCase           = "translationUseCase"
+	serviceNameTranslationUseCaseRepoPrefix = "translationUseCase"
+)
+
+type TranslationUseCase interface {
+	SetTranslation(ctx context.Context, in *entity.Translation) (*entity.Translation, error)
+	GetTranslations(ctx context.Context, in *entity.GetTranslationsReq) (*entity.ListTranslations, error)
+	DeleteTranslation(ctx context.Context, in *entity.DeleteTranslationReq) (*entity.StatusTranslation, error)
+	Localize(ctx context.Context, entityType string, entityIds ...string) (entity.Localized, error)
+}
+
+type newsTranslation struct {
+	BaseUseCase
+	repo       repository.TranslationRepository
+	fallback   string
+	ctxTimeout time.Duration
+}
+
+func NewTranslation(ctxTimeout time.Duration, repo repository.TranslationRepository, fallback string) newsTranslation {
+	return newsTranslation{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+		fallback:   fallback,
+	}
+}
+
+func (n newsTranslation) SetTranslation(ctx context.Context, in *entity.Translation) (*entity.Translation, error) {
+	ctx, cancel := context.WithTimeout(ctx, n.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameTranslationUseCase, serviceNameTranslationUseCaseRepoPrefix+"Set")
+	span.SetAttributes(attribute.Key(in.EntityType).String(in.EntityId))
+	defer span.End()
+
+	return n.repo.SetTranslation(ctx, in)
+}
+
+func (n newsTranslation) GetTranslations(ctx context.Context, in *entity.GetTranslationsReq) (*entity.ListTranslations, error) {
+	ctx, cancel := context.WithTimeout(ctx, n.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameTranslationUseCase, serviceNameTranslationUseCaseRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetTranslations").String(in.EntityType))
+	defer span.End()
+
+	return n.repo.GetTranslations(ctx, in)
+}
+
+func (n newsTranslation) DeleteTranslation(ctx context.Context, in *entity.DeleteTranslationReq) (*entity.StatusTranslation, error) {
+	ctx, cancel := context.WithTimeout(ctx, n.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameTranslationUseCase, serviceNameTranslationUseCaseRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key(in.EntityType).String(in.EntityId))
+	defer span.End()
+
+	return n.repo.DeleteTranslation(ctx, in)
+}
+
+// Localize loads the translations of the entities into the language requested in ctx,
+// fields missing in that language fall back to the fallback language and then to the stored columns
+func (n newsTranslation) Localize(ctx context.Context, entityType string, entityIds ...string) (entity.Localized, error) {
+	ctx, cancel := context.WithTimeout(ctx, n.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameTranslationUseCase, serviceNameTranslationUseCaseRepoPrefix+"Localize")
+	span.SetAttributes(attribute.Key("Localize").String(entityType))
+	defer span.End()
+
+	lang := language.FromContext(ctx)
+
+	// the fallback goes first so that the requested language overwrites it below
+	var langs []string
+	if n.fallback != "" && n.fallback != lang {
+		langs = append(langs, n.fallback)
+	}
+	if lang != "" {
+		langs = append(langs, lang)
+	}
+	if len(langs) == 0 || len(entityIds) == 0 {
+		return entity.Localized{}, nil
+	}
+
+	translations, err := n.repo.GetTranslations(ctx, &entity.GetTranslationsReq{
+		EntityType: entityType,
+		EntityIds:  entityIds,
+		Langs:      langs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	localized := entity.Localized{}
+	for _, lang := range langs {
+		for _, translation := range translations.Translations {
+			if translation.Lang != lang {
+				continue
+			}
+			if localized[translation.EntityId] == nil {
+				localized[translation.EntityId] = map[string]string{}
+			}
+			localized[translation.EntityId][translation.Field] = translation.Value
+		}
+	}
+	return localized, nil
+}
@@ -0,0 +1,22 @@
+DROP INDEX IF EXISTS departments_search_name_idx;
+DROP INDEX IF EXISTS departments_search_document_idx;
+DROP INDEX IF EXISTS reasons_search_name_idx;
+DROP INDEX IF EXISTS reasons_search_document_idx;
+DROP INDEX IF EXISTS specializations_search_name_idx;
+DROP INDEX IF EXISTS specializations_search_document_idx;
+DROP INDEX IF EXISTS doctors_search_name_idx;
+DROP INDEX IF EXISTS doctors_search_document_idx;
+
+CREATE INDEX IF NOT EXISTS doctors_search_document_idx ON doctors USING GIN (to_tsvector('simple', first_name || ' ' || last_name || ' ' || biography));
+CREATE INDEX IF NOT EXISTS doctors_search_name_idx ON doctors USING GIN (lower(first_name || ' ' || last_name) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS specializations_search_document_idx ON specializations USING GIN (to_tsvector('simple', name || ' ' || description));
+CREATE INDEX IF NOT EXISTS specializations_search_name_idx ON specializations USING GIN (lower(name) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS reasons_search_document_idx ON reasons USING GIN (to_tsvector('simple', name));
+CREATE INDEX IF NOT EXISTS reasons_search_name_idx ON reasons USING GIN (lower(name) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS departments_search_document_idx ON departments USING GIN (to_tsvector('simple', name || ' ' || short_description || ' ' || description));
+CREATE INDEX IF NOT EXISTS departments_search_name_idx ON departments USING GIN (lower(name) gin_trgm_ops);
+
+DROP FUNCTION IF EXISTS uz_normalize(TEXT);
@@ -0,0 +1,47 @@
+-- uz_normalize folds Uzbek Cyrillic and Latin spellings of the same word into one form:
+-- lower case Latin without apostrophes, so "Жўраев", "Jo'rayev" and "JOʻRAYEV" all become "jorayev"
+CREATE OR REPLACE FUNCTION uz_normalize(input TEXT) RETURNS TEXT AS $$
+DECLARE
+    result TEXT;
+BEGIN
+    result := lower(translate(input,
+        'АБВГҒДЕЁЖЗИЙКҚЛМНОПРСТУЎФХҲЦЧШЩЪЫЬЭЮЯ',
+        'абвгғдеёжзийкқлмнопрстуўфхҳцчшщъыьэюя'));
+
+    -- е reads as "ye" at the start of a word and after a vowel
+    result := regexp_replace(result, '(^|[^бвгджзйклмнпрстфхцчшщқғҳ])е', '\1ye', 'g');
+
+    result := replace(result, 'ё', 'yo');
+    result := replace(result, 'ю', 'yu');
+    result := replace(result, 'я', 'ya');
+    result := replace(result, 'ц', 'ts');
+    result := replace(result, 'ч', 'ch');
+    result := replace(result, 'ш', 'sh');
+    result := replace(result, 'щ', 'sh');
+
+    RETURN translate(result,
+        'абвгғдежзийкқлмнопрстуўфхҳыэ''ʻʼ`‘’ъь',
+        'abvggdejziykqlmnoprstuofxhie');
+END;
+$$ LANGUAGE plpgsql IMMUTABLE STRICT PARALLEL SAFE;
+
+DROP INDEX IF EXISTS doctors_search_document_idx;
+DROP INDEX IF EXISTS doctors_search_name_idx;
+DROP INDEX IF EXISTS specializations_search_document_idx;
+DROP INDEX IF EXISTS specializations_search_name_idx;
+DROP INDEX IF EXISTS reasons_search_document_idx;
+DROP INDEX IF EXISTS reasons_search_name_idx;
+DROP INDEX IF EXISTS departments_search_document_idx;
+DROP INDEX IF EXISTS departments_search_name_idx;
+
+CREATE INDEX IF NOT EXISTS doctors_search_document_idx ON doctors USING GIN (to_tsvector('simple', uz_normalize(first_name || ' ' || last_name || ' ' || biography)));
+CREATE INDEX IF NOT EXISTS doctors_search_name_idx ON doctors USING GIN (uz_normalize(first_name || ' ' || last_name) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS specializations_search_document_idx ON specializations USING GIN (to_tsvector('simple', uz_normalize(name || ' ' || description)));
+CREATE INDEX IF NOT EXISTS specializations_search_name_idx ON specializations USING GIN (uz_normalize(name) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS reasons_search_document_idx ON reasons USING GIN (to_tsvector('simple', uz_normalize(name)));
+CREATE INDEX IF NOT EXISTS reasons_search_name_idx ON reasons USING GIN (uz_normalize(name) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS departments_search_document_idx ON departments USING GIN (to_tsvector('simple', uz_normalize(name || ' ' || short_description || ' ' || description)));
+CREATE INDEX IF NOT EXISTS departments_search_name_idx ON departments USING GIN (uz_normalize(name) gin_trgm_ops);
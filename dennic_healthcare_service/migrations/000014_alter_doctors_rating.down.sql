@@ -0,0 +1,2 @@
+ALTER TABLE doctors DROP COLUMN IF EXISTS review_count;
+ALTER TABLE doctors DROP COLUMN IF EXISTS rating;
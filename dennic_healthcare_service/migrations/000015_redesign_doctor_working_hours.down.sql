@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS doctor_working_hours_doctor_day_idx;
+
+ALTER TABLE doctor_working_hours
+    DROP CONSTRAINT IF EXISTS doctor_working_hours_overlap_excl,
+    DROP CONSTRAINT IF EXISTS doctor_working_hours_effective_check,
+    DROP CONSTRAINT IF EXISTS doctor_working_hours_time_check,
+    DROP COLUMN IF EXISTS effective_to,
+    DROP COLUMN IF EXISTS effective_from,
+    DROP COLUMN IF EXISTS kind;
+
+ALTER TABLE doctor_working_hours
+    ALTER COLUMN start_time TYPE TIMESTAMP USING DATE '2000-01-01' + start_time,
+    ALTER COLUMN finish_time TYPE TIMESTAMP USING DATE '2000-01-01' + finish_time;
+
+ALTER TABLE doctor_working_hours DROP CONSTRAINT IF EXISTS doctor_working_hours_pkey;
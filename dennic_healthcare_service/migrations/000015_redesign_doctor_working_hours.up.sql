@@ -0,0 +1,70 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE doctor_working_hours ADD PRIMARY KEY (id);
+
+-- the hours are times of day, the date part of the old timestamps carried no meaning
+ALTER TABLE doctor_working_hours
+    ALTER COLUMN start_time TYPE TIME(0) USING start_time::time,
+    ALTER COLUMN finish_time TYPE TIME(0) USING finish_time::time;
+
+-- the hours ending before they start break the time check, they are listed rather than guessed at
+DO $$
+DECLARE
+    offenders TEXT;
+BEGIN
+    SELECT string_agg(format('%s (doctor %s, %s %s-%s)', id, doctor_id, day_of_week, start_time, finish_time), ', ' ORDER BY id)
+    INTO offenders
+    FROM doctor_working_hours
+    WHERE start_time >= finish_time;
+
+    IF offenders IS NOT NULL THEN
+        RAISE EXCEPTION 'doctor_working_hours end before they start, fix or delete them first: %', offenders;
+    END IF;
+END $$;
+
+-- every existing row becomes a shift in effect forever, so the hours of a doctor overlapping on the same weekday
+-- would break the exclusion below, the earliest created ones are kept and the ones overlapping them soft deleted
+DO $$
+DECLARE
+    hours RECORD;
+BEGIN
+    FOR hours IN
+        SELECT id, doctor_id, day_of_week, start_time, finish_time, created_at
+        FROM doctor_working_hours
+        WHERE deleted_at IS NULL
+        ORDER BY created_at, id
+    LOOP
+        IF EXISTS (
+            SELECT 1
+            FROM doctor_working_hours kept
+            WHERE kept.deleted_at IS NULL
+              AND kept.doctor_id = hours.doctor_id
+              AND kept.day_of_week = hours.day_of_week
+              AND (kept.created_at, kept.id) < (hours.created_at, hours.id)
+              AND kept.start_time < hours.finish_time
+              AND hours.start_time < kept.finish_time
+        ) THEN
+            UPDATE doctor_working_hours SET deleted_at = CURRENT_TIMESTAMP + INTERVAL '5 hours' WHERE id = hours.id;
+            RAISE NOTICE 'soft deleted doctor_working_hours % (doctor %, % %-%) overlapping earlier hours',
+                hours.id, hours.doctor_id, hours.day_of_week, hours.start_time, hours.finish_time;
+        END IF;
+    END LOOP;
+END $$;
+
+ALTER TABLE doctor_working_hours
+    ADD COLUMN IF NOT EXISTS kind VARCHAR(20) NOT NULL DEFAULT 'shift' CHECK (kind IN ('shift', 'break')),
+    ADD COLUMN IF NOT EXISTS effective_from DATE,
+    ADD COLUMN IF NOT EXISTS effective_to DATE,
+    ADD CONSTRAINT doctor_working_hours_time_check CHECK (start_time < finish_time),
+    ADD CONSTRAINT doctor_working_hours_effective_check CHECK (effective_from <= effective_to);
+
+-- shifts of a doctor may not overlap on the same weekday while both are in effect, neither may breaks
+ALTER TABLE doctor_working_hours ADD CONSTRAINT doctor_working_hours_overlap_excl EXCLUDE USING gist (
+    doctor_id WITH =,
+    day_of_week WITH =,
+    kind WITH =,
+    daterange(effective_from, effective_to, '[]') WITH &&,
+    tsrange(DATE '2000-01-01' + start_time, DATE '2000-01-01' + finish_time) WITH &&
+) WHERE (deleted_at IS NULL);
+
+CREATE INDEX IF NOT EXISTS doctor_working_hours_doctor_day_idx ON doctor_working_hours (doctor_id, day_of_week) WHERE deleted_at IS NULL;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS doctor_leaves
+(
+    id BIGSERIAL PRIMARY KEY,
+    doctor_id UUID REFERENCES doctors(id) ON DELETE CASCADE NOT NULL,
+    kind VARCHAR(20) CHECK (kind IN ('vacation', 'sick_leave', 'conference', 'other')) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) CHECK (status IN ('pending', 'approved', 'rejected', 'cancelled')) NOT NULL DEFAULT 'pending',
+    reviewed_by VARCHAR(64) NOT NULL DEFAULT '',
+    review_note TEXT NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMP,
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP,
+    deleted_at    TIMESTAMP,
+    CHECK (start_date <= end_date)
+);
+
+CREATE INDEX IF NOT EXISTS doctor_leaves_doctor_idx ON doctor_leaves (doctor_id, start_date) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS doctor_leaves_approved_idx ON doctor_leaves (start_date, end_date) WHERE status = 'approved' AND deleted_at IS NULL;
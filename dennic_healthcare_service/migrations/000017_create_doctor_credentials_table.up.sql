@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS doctor_credentials
+(
+    id BIGSERIAL PRIMARY KEY,
+    doctor_id UUID REFERENCES doctors(id) ON DELETE CASCADE NOT NULL,
+    type VARCHAR(20) CHECK (type IN ('diploma', 'license', 'certificate', 'other')) NOT NULL,
+    issuing_body VARCHAR(200) NOT NULL,
+    number VARCHAR(100) NOT NULL DEFAULT '',
+    issue_date DATE NOT NULL,
+    expiry_date DATE,
+    document VARCHAR(200) NOT NULL DEFAULT '',
+    expiry_flagged_at TIMESTAMP,
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP,
+    deleted_at    TIMESTAMP,
+    CHECK (expiry_date IS NULL OR issue_date <= expiry_date)
+);
+
+CREATE INDEX IF NOT EXISTS doctor_credentials_doctor_idx ON doctor_credentials (doctor_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS doctor_credentials_license_expiry_idx ON doctor_credentials (expiry_date) WHERE type = 'license' AND deleted_at IS NULL;
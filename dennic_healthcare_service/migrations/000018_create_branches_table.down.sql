@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS doctors_branch_idx;
+DROP INDEX IF EXISTS departments_branch_idx;
+
+ALTER TABLE doctors DROP COLUMN IF EXISTS branch_id;
+ALTER TABLE departments DROP COLUMN IF EXISTS branch_id;
+
+DROP TABLE IF EXISTS branches;
@@ -0,0 +1,3 @@
+ALTER TABLE doctor_service DROP COLUMN IF EXISTS required_resource_type;
+
+DROP TABLE IF EXISTS resources;
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS service_packages
+(
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    price FLOAT NOT NULL CHECK (price >= 0),
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP,
+    deleted_at    TIMESTAMP
+);
+
+-- the doctor services bundled into a package
+CREATE TABLE IF NOT EXISTS service_package_items
+(
+    package_id UUID REFERENCES service_packages(id) ON DELETE CASCADE NOT NULL,
+    doctor_service_id UUID REFERENCES doctor_service(id) ON DELETE CASCADE NOT NULL,
+    PRIMARY KEY (package_id, doctor_service_id)
+);
+
+-- percent discounts take value percents off the price, fixed ones take value off the price,
+-- a discount applies from starts_on through ends_on, without ends_on it lasts until it is deleted
+CREATE TABLE IF NOT EXISTS service_discounts
+(
+    id UUID PRIMARY KEY,
+    doctor_service_id UUID REFERENCES doctor_service(id) ON DELETE CASCADE NOT NULL,
+    kind VARCHAR(20) CHECK (kind IN ('percent', 'fixed')) NOT NULL,
+    value FLOAT NOT NULL CHECK (value > 0),
+    starts_on DATE NOT NULL,
+    ends_on DATE,
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP,
+    deleted_at    TIMESTAMP,
+    CHECK (kind <> 'percent' OR value <= 100),
+    CHECK (ends_on IS NULL OR starts_on <= ends_on)
+);
+
+CREATE INDEX IF NOT EXISTS service_discounts_doctor_service_id_idx ON service_discounts (doctor_service_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS specializations_parent_idx;
+
+ALTER TABLE specializations DROP CONSTRAINT IF EXISTS specializations_parent_check;
+ALTER TABLE specializations DROP COLUMN IF EXISTS parent_id;
+
+DROP INDEX IF EXISTS doctor_departments_department_idx;
+
+DROP TABLE IF EXISTS doctor_departments;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS doctor_departments
+(
+    doctor_id UUID REFERENCES doctors(id) ON DELETE CASCADE NOT NULL,
+    department_id UUID REFERENCES departments(id) ON DELETE CASCADE NOT NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (doctor_id, department_id)
+);
+
+CREATE INDEX IF NOT EXISTS doctor_departments_department_idx ON doctor_departments (department_id);
+
+-- the department of every doctor becomes the first of its departments
+INSERT INTO doctor_departments (doctor_id, department_id)
+SELECT id, department_id FROM doctors
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE specializations ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES specializations(id) ON DELETE SET NULL;
+ALTER TABLE specializations ADD CONSTRAINT specializations_parent_check CHECK (parent_id <> id);
+
+CREATE INDEX IF NOT EXISTS specializations_parent_idx ON specializations (parent_id);
@@ -0,0 +1,8 @@
+-- the doctors created before the login stored their passwords in plaintext, they are hashed with bcrypt
+-- at the cost of the gateway, pgcrypto writes the $2a$ hashes the gateway compares against
+CREATE EXTENSION IF NOT EXISTS pgcrypto;
+
+UPDATE doctors
+SET password = crypt(password, gen_salt('bf', 14))
+WHERE password <> ''
+  AND password !~ '^\$2[aby]\$';
@@ -226,6 +226,7 @@ type Department struct {
 	CreatedAt            string   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string   `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	DeletedAt            string   `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	BranchId             string   `protobuf:"bytes,11,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -334,6 +335,13 @@ func (m *Department) GetDeletedAt() string {
 	return ""
 }
 
+func (m *Department) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
 type GetReqStrDepartment struct {
 	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
@@ -397,12 +405,60 @@ func (m *GetReqStrDepartment) GetIsActive() bool {
 	return false
 }
 
+type RestoreDepartmentReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreDepartmentReq) Reset()         { *m = RestoreDepartmentReq{} }
+func (m *RestoreDepartmentReq) String() string { return proto.CompactTextString(m) }
+func (*RestoreDepartmentReq) ProtoMessage()    {}
+func (*RestoreDepartmentReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28b27ef028e04df4, []int{5}
+}
+func (m *RestoreDepartmentReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreDepartmentReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreDepartmentReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreDepartmentReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreDepartmentReq.Merge(m, src)
+}
+func (m *RestoreDepartmentReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreDepartmentReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreDepartmentReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreDepartmentReq proto.InternalMessageInfo
+
+func (m *RestoreDepartmentReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GetAllDepartment)(nil), "healthcare.GetAllDepartment")
 	proto.RegisterType((*ListDepartments)(nil), "healthcare.ListDepartments")
 	proto.RegisterType((*StatusDepartment)(nil), "healthcare.StatusDepartment")
 	proto.RegisterType((*Department)(nil), "healthcare.Department")
 	proto.RegisterType((*GetReqStrDepartment)(nil), "healthcare.GetReqStrDepartment")
+	proto.RegisterType((*RestoreDepartmentReq)(nil), "healthcare.RestoreDepartmentReq")
 }
 
 func init() {
@@ -410,41 +466,43 @@ func init() {
 }
 
 var fileDescriptor_28b27ef028e04df4 = []byte{
-	// 536 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xfe, 0xed, 0x34, 0xa9, 0x33, 0xf9, 0x05, 0xce, 0x82, 0x2a, 0xd3, 0x42, 0x08, 0xe1, 0x12,
-	0x81, 0x08, 0x52, 0xb9, 0x70, 0x4d, 0x88, 0x14, 0x21, 0x55, 0x95, 0x70, 0xd4, 0x2b, 0xd6, 0xc6,
-	0x9e, 0x36, 0x2b, 0x6d, 0xe2, 0xb0, 0xbb, 0x8e, 0x94, 0x77, 0xe0, 0x01, 0x38, 0xf2, 0x0c, 0x3c,
-	0x05, 0x47, 0x1e, 0x01, 0x85, 0x17, 0x41, 0x1e, 0x9b, 0x7a, 0x13, 0xa5, 0xe2, 0xc0, 0xcd, 0xf3,
-	0x7d, 0xa3, 0xd9, 0xf9, 0xbe, 0xfd, 0xd6, 0xf0, 0x7c, 0x8e, 0x5c, 0x9a, 0x79, 0xcc, 0x15, 0xbe,
-	0xd2, 0xa8, 0xd6, 0x22, 0xc6, 0xd7, 0x09, 0xae, 0xb8, 0x32, 0x0b, 0x5c, 0x9a, 0xc1, 0x4a, 0xa5,
-	0x26, 0x65, 0x50, 0x35, 0xf5, 0xbe, 0x3a, 0xe0, 0x4f, 0xd0, 0x0c, 0xa5, 0x1c, 0xdf, 0xb6, 0x31,
-	0x06, 0x47, 0x2b, 0x7e, 0x83, 0x81, 0xd3, 0x75, 0xfa, 0xb5, 0x90, 0xbe, 0xd9, 0x43, 0xa8, 0x4b,
-	0xb1, 0x10, 0x26, 0x70, 0x09, 0x2c, 0x8a, 0x1c, 0xbd, 0x16, 0x28, 0x93, 0xa0, 0xd6, 0x75, 0xfa,
-	0xcd, 0xb0, 0x28, 0x72, 0x74, 0xcd, 0x65, 0x86, 0xc1, 0x51, 0x81, 0x52, 0xc1, 0x1e, 0x81, 0x97,
-	0xaa, 0x04, 0x55, 0x34, 0xdb, 0x04, 0x75, 0x22, 0x8e, 0xa9, 0x1e, 0x6d, 0xd8, 0x19, 0x34, 0x85,
-	0x8e, 0x78, 0x6c, 0xc4, 0x1a, 0x83, 0x46, 0xd7, 0xe9, 0x7b, 0xa1, 0x27, 0xf4, 0x90, 0xea, 0x1e,
-	0x87, 0xfb, 0x17, 0x42, 0x9b, 0x6a, 0x3f, 0x9d, 0x1f, 0x10, 0xa7, 0xd9, 0xd2, 0x94, 0x1b, 0x16,
-	0x05, 0x7b, 0x0b, 0xad, 0x4a, 0xab, 0x0e, 0xdc, 0x6e, 0xad, 0xdf, 0x3a, 0x3f, 0x19, 0x54, 0x6a,
-	0x07, 0xd5, 0x8c, 0xd0, 0x6e, 0xed, 0xbd, 0x00, 0x7f, 0x6a, 0xb8, 0xc9, 0xb4, 0x65, 0xc2, 0x09,
-	0x34, 0x34, 0x61, 0x74, 0x88, 0x17, 0x96, 0x55, 0xef, 0x9b, 0x0b, 0x60, 0xb5, 0xdd, 0x03, 0x57,
-	0x24, 0xd4, 0xd2, 0x0c, 0x5d, 0x41, 0xda, 0x49, 0x15, 0xf9, 0x54, 0x0f, 0x8b, 0x22, 0x77, 0x74,
-	0xc9, 0x17, 0x58, 0xda, 0x44, 0xdf, 0xac, 0x9b, 0xaf, 0xab, 0x63, 0x25, 0x56, 0x46, 0xa4, 0xcb,
-	0xd2, 0x2b, 0x1b, 0x22, 0x5b, 0x16, 0xfc, 0x06, 0xa3, 0x4c, 0xc9, 0xd2, 0x32, 0x8f, 0x80, 0x2b,
-	0x25, 0xd9, 0x33, 0xf8, 0xff, 0x5a, 0xa6, 0xa9, 0x8a, 0x96, 0xd9, 0x62, 0x86, 0x8a, 0x6c, 0xab,
-	0x87, 0x2d, 0xc2, 0x2e, 0x09, 0x62, 0x2f, 0xa1, 0xad, 0xe7, 0xa9, 0x32, 0x91, 0x7d, 0xce, 0x31,
-	0xcd, 0xf1, 0x89, 0x18, 0x5b, 0x87, 0x3d, 0x01, 0x88, 0x15, 0x72, 0x83, 0x49, 0xc4, 0x4d, 0xe0,
-	0x51, 0x57, 0xb3, 0x44, 0x86, 0x26, 0xa7, 0xb3, 0x55, 0xf2, 0x87, 0x6e, 0x16, 0x74, 0x89, 0x14,
-	0x74, 0x82, 0x12, 0x4b, 0x1a, 0x0a, 0xba, 0x44, 0x86, 0xa6, 0xf7, 0x11, 0x1e, 0x4c, 0xd0, 0x84,
-	0xf8, 0x69, 0x6a, 0x94, 0x65, 0xde, 0x6d, 0x7c, 0x9c, 0x83, 0xf1, 0x71, 0xed, 0xf8, 0xec, 0x64,
-	0xa4, 0xb6, 0x9b, 0x91, 0xf3, 0xcf, 0x35, 0x68, 0x57, 0x73, 0xa7, 0x45, 0xf2, 0xd9, 0x08, 0xfc,
-	0x77, 0x24, 0xc0, 0xbe, 0xd6, 0xc3, 0x79, 0x38, 0xbd, 0x03, 0x67, 0x17, 0xd0, 0x9e, 0xa0, 0x15,
-	0xbe, 0xd1, 0xe6, 0x7d, 0xc2, 0x9e, 0xda, 0xcd, 0x07, 0x84, 0xdd, 0x39, 0xed, 0x92, 0xa6, 0xed,
-	0xbc, 0x36, 0xcd, 0x1e, 0xef, 0x4d, 0xdb, 0xa1, 0x4f, 0xcf, 0x6c, 0x76, 0xff, 0x21, 0x8c, 0xc0,
-	0xbf, 0xa2, 0x3b, 0xf8, 0x07, 0x85, 0x1f, 0xc0, 0x1f, 0xd3, 0x45, 0x59, 0xd8, 0x5f, 0x05, 0xee,
-	0xec, 0xbc, 0xff, 0x76, 0x46, 0xfe, 0xf7, 0x6d, 0xc7, 0xf9, 0xb1, 0xed, 0x38, 0x3f, 0xb7, 0x1d,
-	0xe7, 0xcb, 0xaf, 0xce, 0x7f, 0xb3, 0x06, 0xfd, 0x7a, 0xde, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff,
-	0xfe, 0xd9, 0x4f, 0x2d, 0xa1, 0x04, 0x00, 0x00,
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xc5, 0x4e, 0xd3, 0x3a, 0x13, 0x04, 0xce, 0x52, 0x55, 0xa6, 0x85, 0x60, 0x82, 0x84, 0x22,
+	0x10, 0x45, 0x2a, 0x17, 0xae, 0x09, 0x95, 0xaa, 0x4a, 0x55, 0x25, 0x1c, 0xf5, 0x8a, 0xb5, 0xb1,
+	0xa7, 0xcd, 0x4a, 0x8e, 0x9d, 0xee, 0xae, 0x23, 0xe5, 0x4f, 0x38, 0xf2, 0x39, 0xdc, 0xe0, 0x13,
+	0x20, 0xfc, 0x08, 0xf2, 0xd8, 0xd4, 0x9b, 0x34, 0x85, 0x03, 0x37, 0xcf, 0x7b, 0x93, 0xd9, 0x7d,
+	0x6f, 0xde, 0x06, 0x5e, 0x4c, 0x90, 0x27, 0x7a, 0x12, 0x71, 0x89, 0x6f, 0x14, 0xca, 0xb9, 0x88,
+	0xf0, 0x6d, 0x8c, 0x33, 0x2e, 0xf5, 0x14, 0x53, 0x7d, 0x38, 0x93, 0x99, 0xce, 0x18, 0xd4, 0x4d,
+	0xbd, 0x2f, 0x16, 0xb8, 0x27, 0xa8, 0x07, 0x49, 0x72, 0x7c, 0xd3, 0xc6, 0x18, 0x6c, 0xcd, 0xf8,
+	0x15, 0x7a, 0x96, 0x6f, 0xf5, 0x1b, 0x01, 0x7d, 0xb3, 0x5d, 0x68, 0x26, 0x62, 0x2a, 0xb4, 0x67,
+	0x13, 0x58, 0x16, 0x05, 0x7a, 0x29, 0x30, 0x89, 0xbd, 0x86, 0x6f, 0xf5, 0x5b, 0x41, 0x59, 0x14,
+	0xe8, 0x9c, 0x27, 0x39, 0x7a, 0x5b, 0x25, 0x4a, 0x05, 0x7b, 0x0c, 0x4e, 0x26, 0x63, 0x94, 0xe1,
+	0x78, 0xe1, 0x35, 0x89, 0xd8, 0xa1, 0x7a, 0xb8, 0x60, 0x07, 0xd0, 0x12, 0x2a, 0xe4, 0x91, 0x16,
+	0x73, 0xf4, 0xb6, 0x7d, 0xab, 0xef, 0x04, 0x8e, 0x50, 0x03, 0xaa, 0x7b, 0x1c, 0x1e, 0x9e, 0x09,
+	0xa5, 0xeb, 0xfb, 0xa9, 0xe2, 0x80, 0x28, 0xcb, 0x53, 0x5d, 0xdd, 0xb0, 0x2c, 0xd8, 0x7b, 0x68,
+	0xd7, 0x5a, 0x95, 0x67, 0xfb, 0x8d, 0x7e, 0xfb, 0x68, 0xef, 0xb0, 0x56, 0x7b, 0x58, 0xcf, 0x08,
+	0xcc, 0xd6, 0xde, 0x2b, 0x70, 0x47, 0x9a, 0xeb, 0x5c, 0x19, 0x26, 0xec, 0xc1, 0xb6, 0x22, 0x8c,
+	0x0e, 0x71, 0x82, 0xaa, 0xea, 0x7d, 0xb3, 0x01, 0x8c, 0xb6, 0x07, 0x60, 0x8b, 0x98, 0x5a, 0x5a,
+	0x81, 0x2d, 0x48, 0x3b, 0xa9, 0x22, 0x9f, 0x9a, 0x41, 0x59, 0x14, 0x8e, 0xa6, 0x7c, 0x8a, 0x95,
+	0x4d, 0xf4, 0xcd, 0xfc, 0xe2, 0xba, 0x2a, 0x92, 0x62, 0xa6, 0x45, 0x96, 0x56, 0x5e, 0x99, 0x10,
+	0xd9, 0x32, 0xe5, 0x57, 0x18, 0xe6, 0x32, 0xa9, 0x2c, 0x73, 0x08, 0xb8, 0x90, 0x09, 0x7b, 0x0e,
+	0xf7, 0x2f, 0x93, 0x2c, 0x93, 0x61, 0x9a, 0x4f, 0xc7, 0x28, 0xc9, 0xb6, 0x66, 0xd0, 0x26, 0xec,
+	0x9c, 0x20, 0xf6, 0x1a, 0x3a, 0x6a, 0x92, 0x49, 0x1d, 0x9a, 0xe7, 0xec, 0xd0, 0x1c, 0x97, 0x88,
+	0x63, 0xe3, 0xb0, 0xa7, 0x00, 0x91, 0x44, 0xae, 0x31, 0x0e, 0xb9, 0xf6, 0x1c, 0xea, 0x6a, 0x55,
+	0xc8, 0x40, 0x17, 0x74, 0x3e, 0x8b, 0xff, 0xd0, 0xad, 0x92, 0xae, 0x90, 0x92, 0x8e, 0x31, 0xc1,
+	0x8a, 0x86, 0x92, 0xae, 0x90, 0x81, 0x2e, 0x94, 0x8c, 0x25, 0x4f, 0xa3, 0x49, 0x28, 0x62, 0xaf,
+	0x5d, 0x2a, 0x29, 0x81, 0xd3, 0xb8, 0xf7, 0x09, 0x1e, 0x9d, 0xa0, 0x0e, 0xf0, 0x7a, 0xa4, 0xa5,
+	0xe1, 0xec, 0x4d, 0xb6, 0xac, 0x8d, 0xd9, 0xb2, 0xcd, 0x6c, 0xad, 0x04, 0xa8, 0xb1, 0x16, 0xa0,
+	0x97, 0xb0, 0x1b, 0xa0, 0xd2, 0x99, 0x44, 0x63, 0xff, 0x78, 0xbd, 0xbe, 0xba, 0xa3, 0x9f, 0x0d,
+	0xe8, 0xd4, 0x1d, 0xa3, 0xf2, 0xf9, 0xb0, 0x21, 0xb8, 0x1f, 0xc8, 0x05, 0x33, 0x1b, 0x9b, 0x43,
+	0xb5, 0x7f, 0x07, 0xce, 0xce, 0xa0, 0x73, 0x82, 0x46, 0x82, 0x87, 0x8b, 0xd3, 0x98, 0x3d, 0x33,
+	0x9b, 0x37, 0x18, 0x70, 0xe7, 0xb4, 0x73, 0xe8, 0xac, 0x3f, 0x59, 0xc5, 0x9e, 0xac, 0x4d, 0x5b,
+	0xa1, 0xf7, 0x0f, 0x4c, 0x76, 0xfd, 0x35, 0x0d, 0xc1, 0xbd, 0xa0, 0x45, 0xfe, 0x87, 0xc2, 0x8f,
+	0xe0, 0x1e, 0xd3, 0xb6, 0x0d, 0xec, 0x9f, 0x02, 0x57, 0xee, 0x7c, 0xeb, 0x01, 0x8e, 0xa0, 0x73,
+	0x6b, 0x6d, 0xcc, 0x37, 0x7f, 0xb2, 0x69, 0xab, 0x7f, 0x1f, 0x3a, 0x74, 0xbf, 0x2e, 0xbb, 0xd6,
+	0xf7, 0x65, 0xd7, 0xfa, 0xb1, 0xec, 0x5a, 0x9f, 0x7f, 0x75, 0xef, 0x8d, 0xb7, 0xe9, 0x4f, 0xf1,
+	0xdd, 0xef, 0x01, 0x00, 0xa1, 0xa0, 0x5c, 0xff, 0x3b, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -464,6 +522,7 @@ type DepartmentServiceClient interface {
 	GetAllDepartments(ctx context.Context, in *GetAllDepartment, opts ...grpc.CallOption) (*ListDepartments, error)
 	UpdateDepartment(ctx context.Context, in *Department, opts ...grpc.CallOption) (*Department, error)
 	DeleteDepartment(ctx context.Context, in *GetReqStrDepartment, opts ...grpc.CallOption) (*StatusDepartment, error)
+	RestoreDepartment(ctx context.Context, in *RestoreDepartmentReq, opts ...grpc.CallOption) (*StatusDepartment, error)
 }
 
 type departmentServiceClient struct {
@@ -519,6 +578,15 @@ func (c *departmentServiceClient) DeleteDepartment(ctx context.Context, in *GetR
 	return out, nil
 }
 
+func (c *departmentServiceClient) RestoreDepartment(ctx context.Context, in *RestoreDepartmentReq, opts ...grpc.CallOption) (*StatusDepartment, error) {
+	out := new(StatusDepartment)
+	err := c.cc.Invoke(ctx, "/healthcare.DepartmentService/RestoreDepartment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DepartmentServiceServer is the server API for DepartmentService service.
 type DepartmentServiceServer interface {
 	CreateDepartment(context.Context, *Department) (*Department, error)
@@ -526,6 +594,7 @@ type DepartmentServiceServer interface {
 	GetAllDepartments(context.Context, *GetAllDepartment) (*ListDepartments, error)
 	UpdateDepartment(context.Context, *Department) (*Department, error)
 	DeleteDepartment(context.Context, *GetReqStrDepartment) (*StatusDepartment, error)
+	RestoreDepartment(context.Context, *RestoreDepartmentReq) (*StatusDepartment, error)
 }
 
 // UnimplementedDepartmentServiceServer can be embedded to have forward compatible implementations.
@@ -547,6 +616,9 @@ func (*UnimplementedDepartmentServiceServer) UpdateDepartment(ctx context.Contex
 func (*UnimplementedDepartmentServiceServer) DeleteDepartment(ctx context.Context, req *GetReqStrDepartment) (*StatusDepartment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDepartment not implemented")
 }
+func (*UnimplementedDepartmentServiceServer) RestoreDepartment(ctx context.Context, req *RestoreDepartmentReq) (*StatusDepartment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDepartment not implemented")
+}
 
 func RegisterDepartmentServiceServer(s *grpc.Server, srv DepartmentServiceServer) {
 	s.RegisterService(&_DepartmentService_serviceDesc, srv)
@@ -642,6 +714,24 @@ func _DepartmentService_DeleteDepartment_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DepartmentService_RestoreDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDepartmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).RestoreDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DepartmentService/RestoreDepartment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).RestoreDepartment(ctx, req.(*RestoreDepartmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DepartmentService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "healthcare.DepartmentService",
 	HandlerType: (*DepartmentServiceServer)(nil),
@@ -666,6 +756,10 @@ var _DepartmentService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDepartment",
 			Handler:    _DepartmentService_DeleteDepartment_Handler,
 		},
+		{
+			MethodName: "RestoreDepartment",
+			Handler:    _DepartmentService_RestoreDepartment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "healthcare-service/department.proto",
@@ -846,6 +940,13 @@ func (m *Department) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDepartment(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.DeletedAt) > 0 {
 		i -= len(m.DeletedAt)
 		copy(dAtA[i:], m.DeletedAt)
@@ -966,6 +1067,40 @@ func (m *GetReqStrDepartment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RestoreDepartmentReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreDepartmentReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreDepartmentReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDepartment(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDepartment(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDepartment(v)
 	base := offset
@@ -1090,6 +1225,10 @@ func (m *Department) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDepartment(uint64(l))
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovDepartment(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1119,6 +1258,22 @@ func (m *GetReqStrDepartment) Size() (n int) {
 	return n
 }
 
+func (m *RestoreDepartmentReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDepartment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovDepartment(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1828,6 +1983,38 @@ func (m *Department) Unmarshal(dAtA []byte) error {
 			}
 			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepartment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDepartment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDepartment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDepartment(dAtA[iNdEx:])
@@ -1985,6 +2172,89 @@ func (m *GetReqStrDepartment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RestoreDepartmentReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDepartment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreDepartmentReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreDepartmentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepartment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDepartment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDepartment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDepartment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDepartment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDepartment(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,2612 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcare-service/doctor_credential.proto
+
+package healthcare
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// type is one of diploma, license, certificate or other, issue_date and expiry_date are "2006-01-02",
+// document_object_name is the uploaded copy in the private document bucket, expiry_flagged_at is set once the license expires within the warning period
+type DoctorCredential struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	DoctorId             string   `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type"`
+	IssuingBody          string   `protobuf:"bytes,4,opt,name=issuing_body,json=issuingBody,proto3" json:"issuing_body"`
+	Number               string   `protobuf:"bytes,5,opt,name=number,proto3" json:"number"`
+	IssueDate            string   `protobuf:"bytes,6,opt,name=issue_date,json=issueDate,proto3" json:"issue_date"`
+	ExpiryDate           string   `protobuf:"bytes,7,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date"`
+	DocumentObjectName   string   `protobuf:"bytes,8,opt,name=document_object_name,json=documentObjectName,proto3" json:"document_object_name"`
+	ExpiryFlaggedAt      string   `protobuf:"bytes,9,opt,name=expiry_flagged_at,json=expiryFlaggedAt,proto3" json:"expiry_flagged_at"`
+	CreatedAt            string   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorCredential) Reset()         { *m = DoctorCredential{} }
+func (m *DoctorCredential) String() string { return proto.CompactTextString(m) }
+func (*DoctorCredential) ProtoMessage()    {}
+func (*DoctorCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14a3efa033a8a644, []int{0}
+}
+func (m *DoctorCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoctorCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorCredential.Merge(m, src)
+}
+func (m *DoctorCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorCredential proto.InternalMessageInfo
+
+func (m *DoctorCredential) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DoctorCredential) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *DoctorCredential) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DoctorCredential) GetIssuingBody() string {
+	if m != nil {
+		return m.IssuingBody
+	}
+	return ""
+}
+
+func (m *DoctorCredential) GetNumber() string {
+	if m != nil {
+		return m.Number
+	}
+	return ""
+}
+
+func (m *DoctorCredential) GetIssueDate() string {
+	if m != nil {
+		return m.IssueDate
+	}
+	return ""
+}
+
+func (m *DoctorCredential) GetExpiryDate() string {
+	if m != nil {
+		return m.ExpiryDate
+	}
+	return ""
+}
+
+func (m *DoctorCredential) GetDocumentObjectName() string {
+	if m != nil {
+		return m.DocumentObjectName
+	}
+	return ""
+}
+
+func (m *DoctorCredential) GetExpiryFlaggedAt() string {
+	if m != nil {
+		return m.ExpiryFlaggedAt
+	}
+	return ""
+}
+
+func (m *DoctorCredential) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *DoctorCredential) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type DoctorCredentialId struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorCredentialId) Reset()         { *m = DoctorCredentialId{} }
+func (m *DoctorCredentialId) String() string { return proto.CompactTextString(m) }
+func (*DoctorCredentialId) ProtoMessage()    {}
+func (*DoctorCredentialId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14a3efa033a8a644, []int{1}
+}
+func (m *DoctorCredentialId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorCredentialId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorCredentialId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoctorCredentialId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorCredentialId.Merge(m, src)
+}
+func (m *DoctorCredentialId) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorCredentialId) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorCredentialId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorCredentialId proto.InternalMessageInfo
+
+func (m *DoctorCredentialId) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// expiring selects the flagged licenses which have not expired yet, expired the licenses expired before today
+type ListDoctorCredentialsReq struct {
+	Page                 int64    `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	DoctorId             string   `protobuf:"bytes,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	Type                 string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type"`
+	Expiring             bool     `protobuf:"varint,5,opt,name=expiring,proto3" json:"expiring"`
+	Expired              bool     `protobuf:"varint,6,opt,name=expired,proto3" json:"expired"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDoctorCredentialsReq) Reset()         { *m = ListDoctorCredentialsReq{} }
+func (m *ListDoctorCredentialsReq) String() string { return proto.CompactTextString(m) }
+func (*ListDoctorCredentialsReq) ProtoMessage()    {}
+func (*ListDoctorCredentialsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14a3efa033a8a644, []int{2}
+}
+func (m *ListDoctorCredentialsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDoctorCredentialsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDoctorCredentialsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListDoctorCredentialsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDoctorCredentialsReq.Merge(m, src)
+}
+func (m *ListDoctorCredentialsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDoctorCredentialsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDoctorCredentialsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDoctorCredentialsReq proto.InternalMessageInfo
+
+func (m *ListDoctorCredentialsReq) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListDoctorCredentialsReq) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDoctorCredentialsReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *ListDoctorCredentialsReq) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ListDoctorCredentialsReq) GetExpiring() bool {
+	if m != nil {
+		return m.Expiring
+	}
+	return false
+}
+
+func (m *ListDoctorCredentialsReq) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+type ListDoctorCredentials struct {
+	Credentials          []*DoctorCredential `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials"`
+	Count                int64               `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListDoctorCredentials) Reset()         { *m = ListDoctorCredentials{} }
+func (m *ListDoctorCredentials) String() string { return proto.CompactTextString(m) }
+func (*ListDoctorCredentials) ProtoMessage()    {}
+func (*ListDoctorCredentials) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14a3efa033a8a644, []int{3}
+}
+func (m *ListDoctorCredentials) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDoctorCredentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDoctorCredentials.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListDoctorCredentials) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDoctorCredentials.Merge(m, src)
+}
+func (m *ListDoctorCredentials) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDoctorCredentials) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDoctorCredentials.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDoctorCredentials proto.InternalMessageInfo
+
+func (m *ListDoctorCredentials) GetCredentials() []*DoctorCredential {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+func (m *ListDoctorCredentials) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type StatusDoctorCredential struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusDoctorCredential) Reset()         { *m = StatusDoctorCredential{} }
+func (m *StatusDoctorCredential) String() string { return proto.CompactTextString(m) }
+func (*StatusDoctorCredential) ProtoMessage()    {}
+func (*StatusDoctorCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14a3efa033a8a644, []int{4}
+}
+func (m *StatusDoctorCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusDoctorCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusDoctorCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusDoctorCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusDoctorCredential.Merge(m, src)
+}
+func (m *StatusDoctorCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusDoctorCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusDoctorCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusDoctorCredential proto.InternalMessageInfo
+
+func (m *StatusDoctorCredential) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+// date defaults to today
+type DoctorLicenseStatusReq struct {
+	DoctorId             string   `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	Date                 string   `protobuf:"bytes,2,opt,name=date,proto3" json:"date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorLicenseStatusReq) Reset()         { *m = DoctorLicenseStatusReq{} }
+func (m *DoctorLicenseStatusReq) String() string { return proto.CompactTextString(m) }
+func (*DoctorLicenseStatusReq) ProtoMessage()    {}
+func (*DoctorLicenseStatusReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14a3efa033a8a644, []int{5}
+}
+func (m *DoctorLicenseStatusReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorLicenseStatusReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorLicenseStatusReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoctorLicenseStatusReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorLicenseStatusReq.Merge(m, src)
+}
+func (m *DoctorLicenseStatusReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorLicenseStatusReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorLicenseStatusReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorLicenseStatusReq proto.InternalMessageInfo
+
+func (m *DoctorLicenseStatusReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *DoctorLicenseStatusReq) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+// expired is set when the doctor has licenses and none of them is valid on the date
+type DoctorLicenseStatus struct {
+	DoctorId             string   `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	HasLicense           bool     `protobuf:"varint,2,opt,name=has_license,json=hasLicense,proto3" json:"has_license"`
+	Expired              bool     `protobuf:"varint,3,opt,name=expired,proto3" json:"expired"`
+	ExpiryDate           string   `protobuf:"bytes,4,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorLicenseStatus) Reset()         { *m = DoctorLicenseStatus{} }
+func (m *DoctorLicenseStatus) String() string { return proto.CompactTextString(m) }
+func (*DoctorLicenseStatus) ProtoMessage()    {}
+func (*DoctorLicenseStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14a3efa033a8a644, []int{6}
+}
+func (m *DoctorLicenseStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorLicenseStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorLicenseStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoctorLicenseStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorLicenseStatus.Merge(m, src)
+}
+func (m *DoctorLicenseStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorLicenseStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorLicenseStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorLicenseStatus proto.InternalMessageInfo
+
+func (m *DoctorLicenseStatus) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *DoctorLicenseStatus) GetHasLicense() bool {
+	if m != nil {
+		return m.HasLicense
+	}
+	return false
+}
+
+func (m *DoctorLicenseStatus) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+func (m *DoctorLicenseStatus) GetExpiryDate() string {
+	if m != nil {
+		return m.ExpiryDate
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DoctorCredential)(nil), "healthcare.DoctorCredential")
+	proto.RegisterType((*DoctorCredentialId)(nil), "healthcare.DoctorCredentialId")
+	proto.RegisterType((*ListDoctorCredentialsReq)(nil), "healthcare.ListDoctorCredentialsReq")
+	proto.RegisterType((*ListDoctorCredentials)(nil), "healthcare.ListDoctorCredentials")
+	proto.RegisterType((*StatusDoctorCredential)(nil), "healthcare.StatusDoctorCredential")
+	proto.RegisterType((*DoctorLicenseStatusReq)(nil), "healthcare.DoctorLicenseStatusReq")
+	proto.RegisterType((*DoctorLicenseStatus)(nil), "healthcare.DoctorLicenseStatus")
+}
+
+func init() {
+	proto.RegisterFile("healthcare-service/doctor_credential.proto", fileDescriptor_14a3efa033a8a644)
+}
+
+var fileDescriptor_14a3efa033a8a644 = []byte{
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xfd, 0x1c, 0xa7, 0xa9, 0x73, 0xfd, 0x41, 0xc3, 0x50, 0x82, 0x15, 0x20, 0x6d, 0xad, 0x2e,
+	0xaa, 0x4a, 0x94, 0xaa, 0xec, 0x91, 0xfa, 0x23, 0xaa, 0x48, 0xa5, 0x48, 0xae, 0x60, 0x81, 0x84,
+	0xac, 0x89, 0xe7, 0x92, 0x0c, 0xf2, 0x4f, 0xb0, 0xc7, 0x88, 0xbc, 0x04, 0x6b, 0x24, 0x1e, 0x81,
+	0x17, 0x61, 0xc9, 0x23, 0xa0, 0xb2, 0xe3, 0x29, 0x90, 0xef, 0xb8, 0x8d, 0xeb, 0xb6, 0xa9, 0x90,
+	0xd8, 0xcd, 0x3d, 0xe7, 0xcc, 0x95, 0xef, 0xb9, 0x67, 0x64, 0xd8, 0x1c, 0x23, 0x0f, 0xd5, 0x38,
+	0xe0, 0x29, 0x3e, 0xce, 0x30, 0xfd, 0x28, 0x03, 0x7c, 0x22, 0x92, 0x40, 0x25, 0xa9, 0x1f, 0xa4,
+	0x28, 0x30, 0x56, 0x92, 0x87, 0x5b, 0x93, 0x34, 0x51, 0x09, 0x83, 0x99, 0xd6, 0xfd, 0xdd, 0x80,
+	0xce, 0x01, 0xe9, 0xf6, 0xcf, 0x65, 0xec, 0x36, 0x34, 0xa4, 0x70, 0x8c, 0x55, 0x63, 0xc3, 0xf4,
+	0x1a, 0x52, 0xb0, 0x07, 0xd0, 0x2e, 0x7b, 0x49, 0xe1, 0x34, 0x56, 0x8d, 0x8d, 0xb6, 0x67, 0x69,
+	0x60, 0x20, 0x18, 0x83, 0xa6, 0x9a, 0x4e, 0xd0, 0x31, 0x09, 0xa7, 0x33, 0x5b, 0x83, 0xff, 0x65,
+	0x96, 0xe5, 0x32, 0x1e, 0xf9, 0xc3, 0x44, 0x4c, 0x9d, 0x26, 0x71, 0x76, 0x89, 0xed, 0x25, 0x62,
+	0xca, 0xba, 0xd0, 0x8a, 0xf3, 0x68, 0x88, 0xa9, 0xb3, 0x40, 0x64, 0x59, 0xb1, 0x47, 0x00, 0x85,
+	0x0c, 0x7d, 0xc1, 0x15, 0x3a, 0x2d, 0xe2, 0xda, 0x84, 0x1c, 0x70, 0x85, 0x6c, 0x05, 0x6c, 0xfc,
+	0x34, 0x91, 0xe9, 0x54, 0xf3, 0x8b, 0xc4, 0x83, 0x86, 0x48, 0xb0, 0x0d, 0xcb, 0x22, 0x09, 0xf2,
+	0x08, 0x63, 0xe5, 0x27, 0xc3, 0xf7, 0x18, 0x28, 0x3f, 0xe6, 0x11, 0x3a, 0x16, 0x29, 0xd9, 0x19,
+	0xf7, 0x92, 0xa8, 0x63, 0x1e, 0x21, 0xdb, 0x84, 0x3b, 0x65, 0xcb, 0x77, 0x21, 0x1f, 0x8d, 0x50,
+	0xf8, 0x5c, 0x39, 0x6d, 0x92, 0x2f, 0x69, 0xe2, 0xb9, 0xc6, 0x77, 0x55, 0xf1, 0x75, 0x41, 0x8a,
+	0x5c, 0x69, 0x11, 0xe8, 0xaf, 0x2b, 0x11, 0x4d, 0xe7, 0x13, 0x71, 0x46, 0xdb, 0x9a, 0x2e, 0x91,
+	0x5d, 0xe5, 0xae, 0x03, 0xab, 0x7b, 0x3d, 0x10, 0x75, 0xb7, 0xdd, 0x6f, 0x06, 0x38, 0x47, 0x32,
+	0x53, 0x75, 0x69, 0xe6, 0xe1, 0x87, 0xc2, 0xed, 0x09, 0x1f, 0x61, 0x29, 0xa7, 0x33, 0x5b, 0x86,
+	0x85, 0x50, 0x46, 0x52, 0xd1, 0x6a, 0x4c, 0x4f, 0x17, 0x17, 0x97, 0x66, 0x5e, 0xb3, 0xb4, 0x66,
+	0x65, 0x69, 0x3d, 0xb0, 0x68, 0x5c, 0x19, 0x8f, 0x68, 0x27, 0x96, 0x77, 0x5e, 0x33, 0x07, 0x16,
+	0xe9, 0x8c, 0x82, 0x56, 0x62, 0x79, 0x67, 0xa5, 0x1b, 0xc1, 0xbd, 0x2b, 0x3f, 0x96, 0x3d, 0x03,
+	0x7b, 0x96, 0xbc, 0xcc, 0x31, 0x56, 0xcd, 0x0d, 0x7b, 0xe7, 0xe1, 0xd6, 0x2c, 0x7b, 0x5b, 0xf5,
+	0x3b, 0x5e, 0xf5, 0x42, 0x31, 0x55, 0x90, 0xe4, 0xf1, 0xf9, 0x54, 0x54, 0xb8, 0xdb, 0xd0, 0x3d,
+	0x51, 0x5c, 0xe5, 0xd9, 0xa5, 0xd0, 0x76, 0xa1, 0x95, 0x11, 0x43, 0xde, 0x58, 0x5e, 0x59, 0xb9,
+	0x03, 0xe8, 0x6a, 0xed, 0x91, 0x0c, 0x30, 0xce, 0x50, 0x5f, 0x2f, 0xbc, 0xbc, 0xe0, 0x90, 0x71,
+	0xd9, 0x21, 0x4a, 0x98, 0x8e, 0x3b, 0x9d, 0xdd, 0xcf, 0x06, 0xdc, 0xbd, 0xa2, 0xd7, 0xfc, 0x46,
+	0x2b, 0x60, 0x8f, 0x79, 0xe6, 0x87, 0xfa, 0x06, 0xf5, 0xb3, 0x3c, 0x18, 0xf3, 0xac, 0xec, 0x51,
+	0xf5, 0xd6, 0xbc, 0xe0, 0x6d, 0x3d, 0xec, 0xcd, 0x7a, 0xd8, 0x77, 0xbe, 0x36, 0xe1, 0x7e, 0xdd,
+	0x88, 0x13, 0xfd, 0xfa, 0xd9, 0x31, 0x74, 0xf6, 0x29, 0x98, 0x33, 0x8a, 0xcd, 0xb5, 0xbf, 0x37,
+	0x97, 0x65, 0x2f, 0xe0, 0xd6, 0x21, 0xaa, 0x0a, 0xd0, 0x9f, 0x27, 0x1f, 0x88, 0x1b, 0xda, 0xbd,
+	0x81, 0xa5, 0x22, 0x37, 0xd5, 0xc4, 0xac, 0x57, 0x2f, 0x5c, 0xf7, 0x02, 0x7a, 0x6b, 0x37, 0xaa,
+	0x8a, 0xd1, 0x5f, 0xd1, 0xa3, 0xfb, 0x47, 0xa3, 0xbf, 0x86, 0xce, 0x01, 0x86, 0xa8, 0xf0, 0x2f,
+	0xa6, 0x77, 0xab, 0xfc, 0x35, 0x91, 0x7d, 0x0b, 0xdd, 0x43, 0x54, 0x57, 0x25, 0xca, 0xbd, 0xdc,
+	0xbd, 0x1e, 0xdf, 0xde, 0xca, 0x0d, 0x9a, 0xbd, 0xce, 0xf7, 0xd3, 0xbe, 0xf1, 0xe3, 0xb4, 0x6f,
+	0xfc, 0x3c, 0xed, 0x1b, 0x5f, 0x7e, 0xf5, 0xff, 0x1b, 0xb6, 0xe8, 0x07, 0xf0, 0xf4, 0xcf, 0x00,
+	0x6c, 0xf5, 0x97, 0xbc, 0x2e, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DoctorCredentialServiceClient is the client API for DoctorCredentialService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DoctorCredentialServiceClient interface {
+	CreateCredential(ctx context.Context, in *DoctorCredential, opts ...grpc.CallOption) (*DoctorCredential, error)
+	GetCredential(ctx context.Context, in *DoctorCredentialId, opts ...grpc.CallOption) (*DoctorCredential, error)
+	ListCredentials(ctx context.Context, in *ListDoctorCredentialsReq, opts ...grpc.CallOption) (*ListDoctorCredentials, error)
+	UpdateCredential(ctx context.Context, in *DoctorCredential, opts ...grpc.CallOption) (*DoctorCredential, error)
+	DeleteCredential(ctx context.Context, in *DoctorCredentialId, opts ...grpc.CallOption) (*StatusDoctorCredential, error)
+	GetDoctorLicenseStatus(ctx context.Context, in *DoctorLicenseStatusReq, opts ...grpc.CallOption) (*DoctorLicenseStatus, error)
+}
+
+type doctorCredentialServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDoctorCredentialServiceClient(cc *grpc.ClientConn) DoctorCredentialServiceClient {
+	return &doctorCredentialServiceClient{cc}
+}
+
+func (c *doctorCredentialServiceClient) CreateCredential(ctx context.Context, in *DoctorCredential, opts ...grpc.CallOption) (*DoctorCredential, error) {
+	out := new(DoctorCredential)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorCredentialService/CreateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorCredentialServiceClient) GetCredential(ctx context.Context, in *DoctorCredentialId, opts ...grpc.CallOption) (*DoctorCredential, error) {
+	out := new(DoctorCredential)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorCredentialService/GetCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorCredentialServiceClient) ListCredentials(ctx context.Context, in *ListDoctorCredentialsReq, opts ...grpc.CallOption) (*ListDoctorCredentials, error) {
+	out := new(ListDoctorCredentials)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorCredentialService/ListCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorCredentialServiceClient) UpdateCredential(ctx context.Context, in *DoctorCredential, opts ...grpc.CallOption) (*DoctorCredential, error) {
+	out := new(DoctorCredential)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorCredentialService/UpdateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorCredentialServiceClient) DeleteCredential(ctx context.Context, in *DoctorCredentialId, opts ...grpc.CallOption) (*StatusDoctorCredential, error) {
+	out := new(StatusDoctorCredential)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorCredentialService/DeleteCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorCredentialServiceClient) GetDoctorLicenseStatus(ctx context.Context, in *DoctorLicenseStatusReq, opts ...grpc.CallOption) (*DoctorLicenseStatus, error) {
+	out := new(DoctorLicenseStatus)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorCredentialService/GetDoctorLicenseStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DoctorCredentialServiceServer is the server API for DoctorCredentialService service.
+type DoctorCredentialServiceServer interface {
+	CreateCredential(context.Context, *DoctorCredential) (*DoctorCredential, error)
+	GetCredential(context.Context, *DoctorCredentialId) (*DoctorCredential, error)
+	ListCredentials(context.Context, *ListDoctorCredentialsReq) (*ListDoctorCredentials, error)
+	UpdateCredential(context.Context, *DoctorCredential) (*DoctorCredential, error)
+	DeleteCredential(context.Context, *DoctorCredentialId) (*StatusDoctorCredential, error)
+	GetDoctorLicenseStatus(context.Context, *DoctorLicenseStatusReq) (*DoctorLicenseStatus, error)
+}
+
+// UnimplementedDoctorCredentialServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDoctorCredentialServiceServer struct {
+}
+
+func (*UnimplementedDoctorCredentialServiceServer) CreateCredential(ctx context.Context, req *DoctorCredential) (*DoctorCredential, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (*UnimplementedDoctorCredentialServiceServer) GetCredential(ctx context.Context, req *DoctorCredentialId) (*DoctorCredential, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCredential not implemented")
+}
+func (*UnimplementedDoctorCredentialServiceServer) ListCredentials(ctx context.Context, req *ListDoctorCredentialsReq) (*ListDoctorCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentials not implemented")
+}
+func (*UnimplementedDoctorCredentialServiceServer) UpdateCredential(ctx context.Context, req *DoctorCredential) (*DoctorCredential, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+}
+func (*UnimplementedDoctorCredentialServiceServer) DeleteCredential(ctx context.Context, req *DoctorCredentialId) (*StatusDoctorCredential, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (*UnimplementedDoctorCredentialServiceServer) GetDoctorLicenseStatus(ctx context.Context, req *DoctorLicenseStatusReq) (*DoctorLicenseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorLicenseStatus not implemented")
+}
+
+func RegisterDoctorCredentialServiceServer(s *grpc.Server, srv DoctorCredentialServiceServer) {
+	s.RegisterService(&_DoctorCredentialService_serviceDesc, srv)
+}
+
+func _DoctorCredentialService_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorCredentialServiceServer).CreateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorCredentialService/CreateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorCredentialServiceServer).CreateCredential(ctx, req.(*DoctorCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorCredentialService_GetCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorCredentialId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorCredentialServiceServer).GetCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorCredentialService/GetCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorCredentialServiceServer).GetCredential(ctx, req.(*DoctorCredentialId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorCredentialService_ListCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDoctorCredentialsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorCredentialServiceServer).ListCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorCredentialService/ListCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorCredentialServiceServer).ListCredentials(ctx, req.(*ListDoctorCredentialsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorCredentialService_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorCredentialServiceServer).UpdateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorCredentialService/UpdateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorCredentialServiceServer).UpdateCredential(ctx, req.(*DoctorCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorCredentialService_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorCredentialId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorCredentialServiceServer).DeleteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorCredentialService/DeleteCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorCredentialServiceServer).DeleteCredential(ctx, req.(*DoctorCredentialId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorCredentialService_GetDoctorLicenseStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorLicenseStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorCredentialServiceServer).GetDoctorLicenseStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorCredentialService/GetDoctorLicenseStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorCredentialServiceServer).GetDoctorLicenseStatus(ctx, req.(*DoctorLicenseStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DoctorCredentialService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "healthcare.DoctorCredentialService",
+	HandlerType: (*DoctorCredentialServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCredential",
+			Handler:    _DoctorCredentialService_CreateCredential_Handler,
+		},
+		{
+			MethodName: "GetCredential",
+			Handler:    _DoctorCredentialService_GetCredential_Handler,
+		},
+		{
+			MethodName: "ListCredentials",
+			Handler:    _DoctorCredentialService_ListCredentials_Handler,
+		},
+		{
+			MethodName: "UpdateCredential",
+			Handler:    _DoctorCredentialService_UpdateCredential_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _DoctorCredentialService_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "GetDoctorLicenseStatus",
+			Handler:    _DoctorCredentialService_GetDoctorLicenseStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "healthcare-service/doctor_credential.proto",
+}
+
+func (m *DoctorCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ExpiryFlaggedAt) > 0 {
+		i -= len(m.ExpiryFlaggedAt)
+		copy(dAtA[i:], m.ExpiryFlaggedAt)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.ExpiryFlaggedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.DocumentObjectName) > 0 {
+		i -= len(m.DocumentObjectName)
+		copy(dAtA[i:], m.DocumentObjectName)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.DocumentObjectName)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExpiryDate) > 0 {
+		i -= len(m.ExpiryDate)
+		copy(dAtA[i:], m.ExpiryDate)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.ExpiryDate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.IssueDate) > 0 {
+		i -= len(m.IssueDate)
+		copy(dAtA[i:], m.IssueDate)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.IssueDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Number) > 0 {
+		i -= len(m.Number)
+		copy(dAtA[i:], m.Number)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.Number)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IssuingBody) > 0 {
+		i -= len(m.IssuingBody)
+		copy(dAtA[i:], m.IssuingBody)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.IssuingBody)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorCredentialId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorCredentialId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorCredentialId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Id != 0 {
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDoctorCredentialsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDoctorCredentialsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDoctorCredentialsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Expiring {
+		i--
+		if m.Expiring {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDoctorCredentials) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDoctorCredentials) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDoctorCredentials) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Credentials) > 0 {
+		for iNdEx := len(m.Credentials) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credentials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctorCredential(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusDoctorCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusDoctorCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusDoctorCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorLicenseStatusReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorLicenseStatusReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorLicenseStatusReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorLicenseStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorLicenseStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorLicenseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExpiryDate) > 0 {
+		i -= len(m.ExpiryDate)
+		copy(dAtA[i:], m.ExpiryDate)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.ExpiryDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HasLicense {
+		i--
+		if m.HasLicense {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctorCredential(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDoctorCredential(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDoctorCredential(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DoctorCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDoctorCredential(uint64(m.Id))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	l = len(m.IssuingBody)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	l = len(m.Number)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	l = len(m.IssueDate)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	l = len(m.ExpiryDate)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	l = len(m.DocumentObjectName)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	l = len(m.ExpiryFlaggedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorCredentialId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDoctorCredential(uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDoctorCredentialsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovDoctorCredential(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDoctorCredential(uint64(m.Limit))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	if m.Expiring {
+		n += 2
+	}
+	if m.Expired {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDoctorCredentials) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credentials) > 0 {
+		for _, e := range m.Credentials {
+			l = e.Size()
+			n += 1 + l + sovDoctorCredential(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctorCredential(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusDoctorCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorLicenseStatusReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorLicenseStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	if m.HasLicense {
+		n += 2
+	}
+	if m.Expired {
+		n += 2
+	}
+	l = len(m.ExpiryDate)
+	if l > 0 {
+		n += 1 + l + sovDoctorCredential(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDoctorCredential(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDoctorCredential(x uint64) (n int) {
+	return sovDoctorCredential(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DoctorCredential) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorCredential
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorCredential: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuingBody", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuingBody = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Number = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssueDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssueDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiryDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryFlaggedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiryFlaggedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorCredential(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorCredentialId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorCredential
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorCredentialId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorCredentialId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorCredential(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDoctorCredentialsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorCredential
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDoctorCredentialsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDoctorCredentialsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiring", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expiring = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorCredential(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDoctorCredentials) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorCredential
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDoctorCredentials: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDoctorCredentials: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credentials = append(m.Credentials, &DoctorCredential{})
+			if err := m.Credentials[len(m.Credentials)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorCredential(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusDoctorCredential) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorCredential
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusDoctorCredential: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusDoctorCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorCredential(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorLicenseStatusReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorCredential
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorLicenseStatusReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorLicenseStatusReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorCredential(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorLicenseStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorCredential
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorLicenseStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorLicenseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasLicense", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasLicense = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiryDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorCredential(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorCredential
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDoctorCredential(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDoctorCredential
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDoctorCredential
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDoctorCredential
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDoctorCredential
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDoctorCredential
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDoctorCredential        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDoctorCredential          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDoctorCredential = fmt.Errorf("proto: unexpected end of group")
+)
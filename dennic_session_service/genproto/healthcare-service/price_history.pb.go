@@ -0,0 +1,1813 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcare-service/price_history.proto
+
+package healthcare
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// effective_from is "2006-01-02 15:04:05", a scheduled change has an effective_from in the future
+type PriceChange struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	DoctorServiceId      string   `protobuf:"bytes,2,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	OnlinePrice          float32  `protobuf:"fixed32,3,opt,name=online_price,json=onlinePrice,proto3" json:"online_price"`
+	OfflinePrice         float32  `protobuf:"fixed32,4,opt,name=offline_price,json=offlinePrice,proto3" json:"offline_price"`
+	EffectiveFrom        string   `protobuf:"bytes,5,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from"`
+	CreatedAt            string   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PriceChange) Reset()         { *m = PriceChange{} }
+func (m *PriceChange) String() string { return proto.CompactTextString(m) }
+func (*PriceChange) ProtoMessage()    {}
+func (*PriceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8cd2f614d884e7c, []int{0}
+}
+func (m *PriceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceChange.Merge(m, src)
+}
+func (m *PriceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceChange proto.InternalMessageInfo
+
+func (m *PriceChange) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PriceChange) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *PriceChange) GetOnlinePrice() float32 {
+	if m != nil {
+		return m.OnlinePrice
+	}
+	return 0
+}
+
+func (m *PriceChange) GetOfflinePrice() float32 {
+	if m != nil {
+		return m.OfflinePrice
+	}
+	return 0
+}
+
+func (m *PriceChange) GetEffectiveFrom() string {
+	if m != nil {
+		return m.EffectiveFrom
+	}
+	return ""
+}
+
+func (m *PriceChange) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+type PriceChangeId struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PriceChangeId) Reset()         { *m = PriceChangeId{} }
+func (m *PriceChangeId) String() string { return proto.CompactTextString(m) }
+func (*PriceChangeId) ProtoMessage()    {}
+func (*PriceChangeId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8cd2f614d884e7c, []int{1}
+}
+func (m *PriceChangeId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceChangeId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceChangeId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceChangeId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceChangeId.Merge(m, src)
+}
+func (m *PriceChangeId) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceChangeId) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceChangeId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceChangeId proto.InternalMessageInfo
+
+func (m *PriceChangeId) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// PriceHistoryReq lists the prices of a service newest first, scheduled changes included
+type PriceHistoryReq struct {
+	DoctorServiceId      string   `protobuf:"bytes,1,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	Page                 int64    `protobuf:"varint,2,opt,name=page,proto3" json:"page"`
+	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PriceHistoryReq) Reset()         { *m = PriceHistoryReq{} }
+func (m *PriceHistoryReq) String() string { return proto.CompactTextString(m) }
+func (*PriceHistoryReq) ProtoMessage()    {}
+func (*PriceHistoryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8cd2f614d884e7c, []int{2}
+}
+func (m *PriceHistoryReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceHistoryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceHistoryReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceHistoryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceHistoryReq.Merge(m, src)
+}
+func (m *PriceHistoryReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceHistoryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceHistoryReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceHistoryReq proto.InternalMessageInfo
+
+func (m *PriceHistoryReq) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *PriceHistoryReq) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *PriceHistoryReq) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type PriceHistory struct {
+	PriceChanges         []*PriceChange `protobuf:"bytes,1,rep,name=price_changes,json=priceChanges,proto3" json:"price_changes"`
+	Count                int64          `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PriceHistory) Reset()         { *m = PriceHistory{} }
+func (m *PriceHistory) String() string { return proto.CompactTextString(m) }
+func (*PriceHistory) ProtoMessage()    {}
+func (*PriceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8cd2f614d884e7c, []int{3}
+}
+func (m *PriceHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceHistory.Merge(m, src)
+}
+func (m *PriceHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceHistory proto.InternalMessageInfo
+
+func (m *PriceHistory) GetPriceChanges() []*PriceChange {
+	if m != nil {
+		return m.PriceChanges
+	}
+	return nil
+}
+
+func (m *PriceHistory) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// PriceAtReq asks for the price valid at the moment at "2006-01-02 15:04:05", now when empty
+type PriceAtReq struct {
+	DoctorServiceId      string   `protobuf:"bytes,1,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	At                   string   `protobuf:"bytes,2,opt,name=at,proto3" json:"at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PriceAtReq) Reset()         { *m = PriceAtReq{} }
+func (m *PriceAtReq) String() string { return proto.CompactTextString(m) }
+func (*PriceAtReq) ProtoMessage()    {}
+func (*PriceAtReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8cd2f614d884e7c, []int{4}
+}
+func (m *PriceAtReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceAtReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceAtReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceAtReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceAtReq.Merge(m, src)
+}
+func (m *PriceAtReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceAtReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceAtReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceAtReq proto.InternalMessageInfo
+
+func (m *PriceAtReq) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *PriceAtReq) GetAt() string {
+	if m != nil {
+		return m.At
+	}
+	return ""
+}
+
+type StatusPriceChange struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusPriceChange) Reset()         { *m = StatusPriceChange{} }
+func (m *StatusPriceChange) String() string { return proto.CompactTextString(m) }
+func (*StatusPriceChange) ProtoMessage()    {}
+func (*StatusPriceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8cd2f614d884e7c, []int{5}
+}
+func (m *StatusPriceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusPriceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusPriceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusPriceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusPriceChange.Merge(m, src)
+}
+func (m *StatusPriceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusPriceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusPriceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusPriceChange proto.InternalMessageInfo
+
+func (m *StatusPriceChange) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*PriceChange)(nil), "healthcare.PriceChange")
+	proto.RegisterType((*PriceChangeId)(nil), "healthcare.PriceChangeId")
+	proto.RegisterType((*PriceHistoryReq)(nil), "healthcare.PriceHistoryReq")
+	proto.RegisterType((*PriceHistory)(nil), "healthcare.PriceHistory")
+	proto.RegisterType((*PriceAtReq)(nil), "healthcare.PriceAtReq")
+	proto.RegisterType((*StatusPriceChange)(nil), "healthcare.StatusPriceChange")
+}
+
+func init() {
+	proto.RegisterFile("healthcare-service/price_history.proto", fileDescriptor_b8cd2f614d884e7c)
+}
+
+var fileDescriptor_b8cd2f614d884e7c = []byte{
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x66, 0x9d, 0x36, 0xa2, 0x93, 0x9f, 0x36, 0x5b, 0x54, 0x4c, 0x50, 0x43, 0x30, 0x02, 0x45,
+	0x20, 0x82, 0x54, 0xae, 0x70, 0x28, 0x95, 0x68, 0x23, 0x81, 0x84, 0x9c, 0x07, 0xb0, 0xb6, 0xbb,
+	0x93, 0x78, 0x25, 0xc7, 0x1b, 0xd6, 0x93, 0x4a, 0xbc, 0x09, 0x6f, 0xc2, 0x2b, 0x70, 0xe4, 0xc6,
+	0x15, 0x85, 0x17, 0x41, 0x5d, 0x5b, 0x64, 0x21, 0xf5, 0xa1, 0x37, 0xcf, 0x37, 0x9f, 0xe7, 0x9b,
+	0xef, 0x1b, 0x1b, 0x9e, 0xa5, 0x28, 0x32, 0x4a, 0xa5, 0xb0, 0xf8, 0xb2, 0x40, 0x7b, 0xa5, 0x25,
+	0xbe, 0x5a, 0x5a, 0x2d, 0x31, 0x49, 0x75, 0x41, 0xc6, 0x7e, 0x19, 0x2f, 0xad, 0x21, 0xc3, 0x61,
+	0xc3, 0x8b, 0x7e, 0x32, 0x68, 0x7d, 0xba, 0xe6, 0x9c, 0xa5, 0x22, 0x9f, 0x23, 0xef, 0x42, 0xa0,
+	0x55, 0xc8, 0x86, 0x6c, 0xb4, 0x17, 0x07, 0x5a, 0xf1, 0xe7, 0xd0, 0x53, 0x46, 0x92, 0xb1, 0x49,
+	0x35, 0x31, 0xd1, 0x2a, 0x0c, 0x5c, 0x7b, 0xbf, 0x6c, 0x4c, 0x4b, 0x7c, 0xa2, 0xf8, 0x63, 0x68,
+	0x9b, 0x3c, 0xd3, 0x39, 0x26, 0x4e, 0x35, 0x6c, 0x0c, 0xd9, 0x28, 0x88, 0x5b, 0x25, 0xe6, 0x44,
+	0xf8, 0x13, 0xe8, 0x98, 0xd9, 0xcc, 0xe3, 0xec, 0x38, 0x4e, 0xbb, 0x02, 0x4b, 0xd2, 0x53, 0xe8,
+	0xe2, 0x6c, 0x86, 0x92, 0xf4, 0x15, 0x26, 0x33, 0x6b, 0x16, 0xe1, 0xae, 0x13, 0xec, 0xfc, 0x45,
+	0xdf, 0x5b, 0xb3, 0xe0, 0xc7, 0x00, 0xd2, 0xa2, 0x20, 0x54, 0x89, 0xa0, 0xb0, 0xe9, 0x28, 0x7b,
+	0x15, 0x72, 0x4a, 0xd1, 0x23, 0xe8, 0x78, 0xc6, 0x26, 0xea, 0x7f, 0x6b, 0xd1, 0x1c, 0xf6, 0x1d,
+	0xe1, 0xa2, 0x0c, 0x27, 0xc6, 0xcf, 0x37, 0xbb, 0x65, 0x37, 0xbb, 0xe5, 0xb0, 0xb3, 0x14, 0x73,
+	0x74, 0x61, 0x34, 0x62, 0xf7, 0xcc, 0xef, 0xc1, 0x6e, 0xa6, 0x17, 0x9a, 0x9c, 0xf5, 0x46, 0x5c,
+	0x16, 0xd1, 0x25, 0xb4, 0x7d, 0x21, 0xfe, 0x06, 0x3a, 0xe5, 0x59, 0xa4, 0x5b, 0xad, 0x08, 0xd9,
+	0xb0, 0x31, 0x6a, 0x9d, 0xdc, 0x1f, 0x6f, 0xee, 0x32, 0xf6, 0x56, 0x8f, 0xdb, 0xcb, 0x4d, 0x51,
+	0x5c, 0x6b, 0x48, 0xb3, 0xca, 0xa9, 0x12, 0x2e, 0x8b, 0xe8, 0x02, 0xc0, 0xbd, 0x72, 0x4a, 0xb7,
+	0xf5, 0xd1, 0x85, 0x40, 0x50, 0x75, 0xd2, 0x40, 0x50, 0xf4, 0x02, 0x7a, 0x53, 0x12, 0xb4, 0x2a,
+	0xfc, 0xcf, 0xe2, 0x08, 0x9a, 0x85, 0x03, 0xdd, 0x94, 0xbb, 0x71, 0x55, 0x9d, 0x7c, 0x0b, 0xe0,
+	0xd0, 0xf7, 0x56, 0x8d, 0xe5, 0xe7, 0x70, 0x38, 0x95, 0x29, 0xaa, 0x55, 0x86, 0xfe, 0x98, 0x3a,
+	0x8b, 0xfd, 0xba, 0x06, 0x9f, 0xc0, 0xc1, 0x07, 0x5d, 0xd0, 0x3f, 0xf9, 0x3d, 0xdc, 0x22, 0x6f,
+	0x4e, 0xd8, 0x0f, 0xeb, 0x9a, 0xfc, 0x2d, 0xc0, 0x39, 0x52, 0x95, 0x12, 0x3f, 0xda, 0xe2, 0xb9,
+	0xe8, 0xea, 0x37, 0xf9, 0x08, 0xbd, 0x33, 0x91, 0x4b, 0xcc, 0x7c, 0xf0, 0x41, 0x0d, 0x7b, 0xa2,
+	0xfa, 0xc7, 0x7e, 0x6b, 0x2b, 0xd1, 0x77, 0x07, 0xdf, 0xd7, 0x03, 0xf6, 0x63, 0x3d, 0x60, 0xbf,
+	0xd6, 0x03, 0xf6, 0xf5, 0xf7, 0xe0, 0xce, 0x65, 0xd3, 0xfd, 0x9d, 0xaf, 0xff, 0x0c, 0x00, 0x46,
+	0xd4, 0xa4, 0xed, 0xc7, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PriceHistoryServiceClient is the client API for PriceHistoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PriceHistoryServiceClient interface {
+	SchedulePriceChange(ctx context.Context, in *PriceChange, opts ...grpc.CallOption) (*PriceChange, error)
+	ListPriceHistory(ctx context.Context, in *PriceHistoryReq, opts ...grpc.CallOption) (*PriceHistory, error)
+	GetPriceAt(ctx context.Context, in *PriceAtReq, opts ...grpc.CallOption) (*PriceChange, error)
+	CancelPriceChange(ctx context.Context, in *PriceChangeId, opts ...grpc.CallOption) (*StatusPriceChange, error)
+}
+
+type priceHistoryServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPriceHistoryServiceClient(cc *grpc.ClientConn) PriceHistoryServiceClient {
+	return &priceHistoryServiceClient{cc}
+}
+
+func (c *priceHistoryServiceClient) SchedulePriceChange(ctx context.Context, in *PriceChange, opts ...grpc.CallOption) (*PriceChange, error) {
+	out := new(PriceChange)
+	err := c.cc.Invoke(ctx, "/healthcare.PriceHistoryService/SchedulePriceChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceHistoryServiceClient) ListPriceHistory(ctx context.Context, in *PriceHistoryReq, opts ...grpc.CallOption) (*PriceHistory, error) {
+	out := new(PriceHistory)
+	err := c.cc.Invoke(ctx, "/healthcare.PriceHistoryService/ListPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceHistoryServiceClient) GetPriceAt(ctx context.Context, in *PriceAtReq, opts ...grpc.CallOption) (*PriceChange, error) {
+	out := new(PriceChange)
+	err := c.cc.Invoke(ctx, "/healthcare.PriceHistoryService/GetPriceAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceHistoryServiceClient) CancelPriceChange(ctx context.Context, in *PriceChangeId, opts ...grpc.CallOption) (*StatusPriceChange, error) {
+	out := new(StatusPriceChange)
+	err := c.cc.Invoke(ctx, "/healthcare.PriceHistoryService/CancelPriceChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PriceHistoryServiceServer is the server API for PriceHistoryService service.
+type PriceHistoryServiceServer interface {
+	SchedulePriceChange(context.Context, *PriceChange) (*PriceChange, error)
+	ListPriceHistory(context.Context, *PriceHistoryReq) (*PriceHistory, error)
+	GetPriceAt(context.Context, *PriceAtReq) (*PriceChange, error)
+	CancelPriceChange(context.Context, *PriceChangeId) (*StatusPriceChange, error)
+}
+
+// UnimplementedPriceHistoryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPriceHistoryServiceServer struct {
+}
+
+func (*UnimplementedPriceHistoryServiceServer) SchedulePriceChange(ctx context.Context, req *PriceChange) (*PriceChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePriceChange not implemented")
+}
+func (*UnimplementedPriceHistoryServiceServer) ListPriceHistory(ctx context.Context, req *PriceHistoryReq) (*PriceHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceHistory not implemented")
+}
+func (*UnimplementedPriceHistoryServiceServer) GetPriceAt(ctx context.Context, req *PriceAtReq) (*PriceChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceAt not implemented")
+}
+func (*UnimplementedPriceHistoryServiceServer) CancelPriceChange(ctx context.Context, req *PriceChangeId) (*StatusPriceChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPriceChange not implemented")
+}
+
+func RegisterPriceHistoryServiceServer(s *grpc.Server, srv PriceHistoryServiceServer) {
+	s.RegisterService(&_PriceHistoryService_serviceDesc, srv)
+}
+
+func _PriceHistoryService_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceHistoryServiceServer).SchedulePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.PriceHistoryService/SchedulePriceChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceHistoryServiceServer).SchedulePriceChange(ctx, req.(*PriceChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceHistoryService_ListPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceHistoryServiceServer).ListPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.PriceHistoryService/ListPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceHistoryServiceServer).ListPriceHistory(ctx, req.(*PriceHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceHistoryService_GetPriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceAtReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceHistoryServiceServer).GetPriceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.PriceHistoryService/GetPriceAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceHistoryServiceServer).GetPriceAt(ctx, req.(*PriceAtReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceHistoryService_CancelPriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceChangeId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceHistoryServiceServer).CancelPriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.PriceHistoryService/CancelPriceChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceHistoryServiceServer).CancelPriceChange(ctx, req.(*PriceChangeId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PriceHistoryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "healthcare.PriceHistoryService",
+	HandlerType: (*PriceHistoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _PriceHistoryService_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "ListPriceHistory",
+			Handler:    _PriceHistoryService_ListPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetPriceAt",
+			Handler:    _PriceHistoryService_GetPriceAt_Handler,
+		},
+		{
+			MethodName: "CancelPriceChange",
+			Handler:    _PriceHistoryService_CancelPriceChange_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "healthcare-service/price_history.proto",
+}
+
+func (m *PriceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintPriceHistory(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EffectiveFrom) > 0 {
+		i -= len(m.EffectiveFrom)
+		copy(dAtA[i:], m.EffectiveFrom)
+		i = encodeVarintPriceHistory(dAtA, i, uint64(len(m.EffectiveFrom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OfflinePrice != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OfflinePrice))))
+		i--
+		dAtA[i] = 0x25
+	}
+	if m.OnlinePrice != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OnlinePrice))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintPriceHistory(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPriceHistory(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceChangeId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceChangeId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceChangeId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPriceHistory(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceHistoryReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceHistoryReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceHistoryReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintPriceHistory(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Page != 0 {
+		i = encodeVarintPriceHistory(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintPriceHistory(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintPriceHistory(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PriceChanges) > 0 {
+		for iNdEx := len(m.PriceChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPriceHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceAtReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceAtReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceAtReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.At) > 0 {
+		i -= len(m.At)
+		copy(dAtA[i:], m.At)
+		i = encodeVarintPriceHistory(dAtA, i, uint64(len(m.At)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintPriceHistory(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusPriceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusPriceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusPriceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPriceHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPriceHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PriceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPriceHistory(uint64(l))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovPriceHistory(uint64(l))
+	}
+	if m.OnlinePrice != 0 {
+		n += 5
+	}
+	if m.OfflinePrice != 0 {
+		n += 5
+	}
+	l = len(m.EffectiveFrom)
+	if l > 0 {
+		n += 1 + l + sovPriceHistory(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovPriceHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PriceChangeId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPriceHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PriceHistoryReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovPriceHistory(uint64(l))
+	}
+	if m.Page != 0 {
+		n += 1 + sovPriceHistory(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPriceHistory(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PriceHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceChanges) > 0 {
+		for _, e := range m.PriceChanges {
+			l = e.Size()
+			n += 1 + l + sovPriceHistory(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovPriceHistory(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PriceAtReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovPriceHistory(uint64(l))
+	}
+	l = len(m.At)
+	if l > 0 {
+		n += 1 + l + sovPriceHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusPriceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPriceHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPriceHistory(x uint64) (n int) {
+	return sovPriceHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PriceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlinePrice", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.OnlinePrice = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfflinePrice", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.OfflinePrice = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceChangeId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceChangeId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceChangeId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceHistoryReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceHistoryReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceHistoryReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceChanges = append(m.PriceChanges, &PriceChange{})
+			if err := m.PriceChanges[len(m.PriceChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceAtReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceAtReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceAtReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.At = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusPriceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusPriceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusPriceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPriceHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPriceHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPriceHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPriceHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPriceHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPriceHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPriceHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPriceHistory = fmt.Errorf("proto: unexpected end of group")
+)
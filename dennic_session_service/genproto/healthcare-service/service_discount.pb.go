@@ -0,0 +1,2426 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcare-service/service_discount.proto
+
+package healthcare
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// kind is one of percent or fixed, the discount applies from starts_on through ends_on "2006-01-02",
+// an empty ends_on lasts until the discount is deleted
+type ServiceDiscount struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	DoctorServiceId      string   `protobuf:"bytes,2,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	Kind                 string   `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind"`
+	Value                float64  `protobuf:"fixed64,4,opt,name=value,proto3" json:"value"`
+	StartsOn             string   `protobuf:"bytes,5,opt,name=starts_on,json=startsOn,proto3" json:"starts_on"`
+	EndsOn               string   `protobuf:"bytes,6,opt,name=ends_on,json=endsOn,proto3" json:"ends_on"`
+	CreatedAt            string   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceDiscount) Reset()         { *m = ServiceDiscount{} }
+func (m *ServiceDiscount) String() string { return proto.CompactTextString(m) }
+func (*ServiceDiscount) ProtoMessage()    {}
+func (*ServiceDiscount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9dbd41a1586f8a8e, []int{0}
+}
+func (m *ServiceDiscount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceDiscount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceDiscount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceDiscount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceDiscount.Merge(m, src)
+}
+func (m *ServiceDiscount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceDiscount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceDiscount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceDiscount proto.InternalMessageInfo
+
+func (m *ServiceDiscount) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ServiceDiscount) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *ServiceDiscount) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ServiceDiscount) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *ServiceDiscount) GetStartsOn() string {
+	if m != nil {
+		return m.StartsOn
+	}
+	return ""
+}
+
+func (m *ServiceDiscount) GetEndsOn() string {
+	if m != nil {
+		return m.EndsOn
+	}
+	return ""
+}
+
+func (m *ServiceDiscount) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *ServiceDiscount) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type ServiceDiscountId struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceDiscountId) Reset()         { *m = ServiceDiscountId{} }
+func (m *ServiceDiscountId) String() string { return proto.CompactTextString(m) }
+func (*ServiceDiscountId) ProtoMessage()    {}
+func (*ServiceDiscountId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9dbd41a1586f8a8e, []int{1}
+}
+func (m *ServiceDiscountId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceDiscountId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceDiscountId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceDiscountId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceDiscountId.Merge(m, src)
+}
+func (m *ServiceDiscountId) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceDiscountId) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceDiscountId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceDiscountId proto.InternalMessageInfo
+
+func (m *ServiceDiscountId) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// active_on selects the discounts active on the day
+type ListServiceDiscountsReq struct {
+	Page                 int64    `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	DoctorServiceId      string   `protobuf:"bytes,3,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	ActiveOn             string   `protobuf:"bytes,4,opt,name=active_on,json=activeOn,proto3" json:"active_on"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListServiceDiscountsReq) Reset()         { *m = ListServiceDiscountsReq{} }
+func (m *ListServiceDiscountsReq) String() string { return proto.CompactTextString(m) }
+func (*ListServiceDiscountsReq) ProtoMessage()    {}
+func (*ListServiceDiscountsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9dbd41a1586f8a8e, []int{2}
+}
+func (m *ListServiceDiscountsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListServiceDiscountsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListServiceDiscountsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListServiceDiscountsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServiceDiscountsReq.Merge(m, src)
+}
+func (m *ListServiceDiscountsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListServiceDiscountsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServiceDiscountsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListServiceDiscountsReq proto.InternalMessageInfo
+
+func (m *ListServiceDiscountsReq) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListServiceDiscountsReq) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListServiceDiscountsReq) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *ListServiceDiscountsReq) GetActiveOn() string {
+	if m != nil {
+		return m.ActiveOn
+	}
+	return ""
+}
+
+type ListServiceDiscountsRes struct {
+	ServiceDiscounts     []*ServiceDiscount `protobuf:"bytes,1,rep,name=service_discounts,json=serviceDiscounts,proto3" json:"service_discounts"`
+	Count                int64              `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ListServiceDiscountsRes) Reset()         { *m = ListServiceDiscountsRes{} }
+func (m *ListServiceDiscountsRes) String() string { return proto.CompactTextString(m) }
+func (*ListServiceDiscountsRes) ProtoMessage()    {}
+func (*ListServiceDiscountsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9dbd41a1586f8a8e, []int{3}
+}
+func (m *ListServiceDiscountsRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListServiceDiscountsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListServiceDiscountsRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListServiceDiscountsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServiceDiscountsRes.Merge(m, src)
+}
+func (m *ListServiceDiscountsRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListServiceDiscountsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServiceDiscountsRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListServiceDiscountsRes proto.InternalMessageInfo
+
+func (m *ListServiceDiscountsRes) GetServiceDiscounts() []*ServiceDiscount {
+	if m != nil {
+		return m.ServiceDiscounts
+	}
+	return nil
+}
+
+func (m *ListServiceDiscountsRes) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type StatusServiceDiscount struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusServiceDiscount) Reset()         { *m = StatusServiceDiscount{} }
+func (m *StatusServiceDiscount) String() string { return proto.CompactTextString(m) }
+func (*StatusServiceDiscount) ProtoMessage()    {}
+func (*StatusServiceDiscount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9dbd41a1586f8a8e, []int{4}
+}
+func (m *StatusServiceDiscount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusServiceDiscount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusServiceDiscount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusServiceDiscount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusServiceDiscount.Merge(m, src)
+}
+func (m *StatusServiceDiscount) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusServiceDiscount) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusServiceDiscount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusServiceDiscount proto.InternalMessageInfo
+
+func (m *StatusServiceDiscount) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+// date defaults to today, online selects the online price
+type ServicePriceReq struct {
+	DoctorServiceId      string   `protobuf:"bytes,1,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	Online               bool     `protobuf:"varint,2,opt,name=online,proto3" json:"online"`
+	Date                 string   `protobuf:"bytes,3,opt,name=date,proto3" json:"date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServicePriceReq) Reset()         { *m = ServicePriceReq{} }
+func (m *ServicePriceReq) String() string { return proto.CompactTextString(m) }
+func (*ServicePriceReq) ProtoMessage()    {}
+func (*ServicePriceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9dbd41a1586f8a8e, []int{5}
+}
+func (m *ServicePriceReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServicePriceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServicePriceReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServicePriceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServicePriceReq.Merge(m, src)
+}
+func (m *ServicePriceReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServicePriceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServicePriceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServicePriceReq proto.InternalMessageInfo
+
+func (m *ServicePriceReq) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *ServicePriceReq) GetOnline() bool {
+	if m != nil {
+		return m.Online
+	}
+	return false
+}
+
+func (m *ServicePriceReq) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+// price is base_price after the largest discount active on the day, discount_id is empty without a discount
+type ServicePrice struct {
+	DoctorServiceId      string   `protobuf:"bytes,1,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	BasePrice            float64  `protobuf:"fixed64,2,opt,name=base_price,json=basePrice,proto3" json:"base_price"`
+	Discount             float64  `protobuf:"fixed64,3,opt,name=discount,proto3" json:"discount"`
+	Price                float64  `protobuf:"fixed64,4,opt,name=price,proto3" json:"price"`
+	DiscountId           string   `protobuf:"bytes,5,opt,name=discount_id,json=discountId,proto3" json:"discount_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServicePrice) Reset()         { *m = ServicePrice{} }
+func (m *ServicePrice) String() string { return proto.CompactTextString(m) }
+func (*ServicePrice) ProtoMessage()    {}
+func (*ServicePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9dbd41a1586f8a8e, []int{6}
+}
+func (m *ServicePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServicePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServicePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServicePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServicePrice.Merge(m, src)
+}
+func (m *ServicePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServicePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServicePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServicePrice proto.InternalMessageInfo
+
+func (m *ServicePrice) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *ServicePrice) GetBasePrice() float64 {
+	if m != nil {
+		return m.BasePrice
+	}
+	return 0
+}
+
+func (m *ServicePrice) GetDiscount() float64 {
+	if m != nil {
+		return m.Discount
+	}
+	return 0
+}
+
+func (m *ServicePrice) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ServicePrice) GetDiscountId() string {
+	if m != nil {
+		return m.DiscountId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ServiceDiscount)(nil), "healthcare.ServiceDiscount")
+	proto.RegisterType((*ServiceDiscountId)(nil), "healthcare.ServiceDiscountId")
+	proto.RegisterType((*ListServiceDiscountsReq)(nil), "healthcare.ListServiceDiscountsReq")
+	proto.RegisterType((*ListServiceDiscountsRes)(nil), "healthcare.ListServiceDiscountsRes")
+	proto.RegisterType((*StatusServiceDiscount)(nil), "healthcare.StatusServiceDiscount")
+	proto.RegisterType((*ServicePriceReq)(nil), "healthcare.ServicePriceReq")
+	proto.RegisterType((*ServicePrice)(nil), "healthcare.ServicePrice")
+}
+
+func init() {
+	proto.RegisterFile("healthcare-service/service_discount.proto", fileDescriptor_9dbd41a1586f8a8e)
+}
+
+var fileDescriptor_9dbd41a1586f8a8e = []byte{
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x6e, 0x12, 0x41,
+	0x14, 0x76, 0x80, 0x52, 0x38, 0x35, 0x52, 0x26, 0x85, 0x6e, 0x20, 0x20, 0x6e, 0x6f, 0xd0, 0x44,
+	0x9a, 0xd4, 0x27, 0xa8, 0x36, 0xb1, 0x24, 0x26, 0xd4, 0x6d, 0xbc, 0xf0, 0x0a, 0xa7, 0x3b, 0x13,
+	0x3b, 0x71, 0xdd, 0xc5, 0x9d, 0x81, 0xc4, 0x77, 0xf0, 0x5e, 0x1f, 0xc2, 0x07, 0xf1, 0xd2, 0x47,
+	0x30, 0x78, 0xe9, 0x4b, 0x98, 0x39, 0xb3, 0x0b, 0x75, 0xb2, 0x10, 0x9b, 0xf4, 0x8a, 0xf9, 0xce,
+	0x77, 0x7e, 0xf6, 0x7c, 0xf3, 0x0d, 0xf0, 0xf8, 0x5a, 0xb0, 0x48, 0x5f, 0x87, 0x2c, 0x15, 0x4f,
+	0x95, 0x48, 0x17, 0x32, 0x14, 0xc7, 0xd9, 0xef, 0x94, 0x4b, 0x15, 0x26, 0xf3, 0x58, 0x8f, 0x66,
+	0x69, 0xa2, 0x13, 0x0a, 0xeb, 0x54, 0xff, 0x0f, 0x81, 0xc6, 0xa5, 0x4d, 0x3b, 0xcb, 0xb2, 0xe8,
+	0x03, 0x28, 0x49, 0xee, 0x91, 0x01, 0x19, 0xd6, 0x83, 0x92, 0xe4, 0xf4, 0x09, 0x34, 0x79, 0x12,
+	0xea, 0x24, 0x9d, 0xe6, 0x0d, 0x25, 0xf7, 0x4a, 0x48, 0x37, 0x2c, 0x91, 0x75, 0x18, 0x73, 0x4a,
+	0xa1, 0xf2, 0x41, 0xc6, 0xdc, 0x2b, 0x23, 0x8d, 0x67, 0x7a, 0x00, 0x3b, 0x0b, 0x16, 0xcd, 0x85,
+	0x57, 0x19, 0x90, 0x21, 0x09, 0x2c, 0xa0, 0x5d, 0xa8, 0x2b, 0xcd, 0x52, 0xad, 0xa6, 0x49, 0xec,
+	0xed, 0x60, 0x7a, 0xcd, 0x06, 0x26, 0x31, 0x3d, 0x84, 0x5d, 0x11, 0x73, 0xa4, 0xaa, 0x48, 0x55,
+	0x0d, 0x9c, 0xc4, 0xb4, 0x07, 0x10, 0xa6, 0x82, 0x69, 0xc1, 0xa7, 0x4c, 0x7b, 0xbb, 0xc8, 0xd5,
+	0xb3, 0xc8, 0xa9, 0x36, 0xf4, 0x7c, 0xc6, 0x73, 0xba, 0x66, 0xe9, 0x2c, 0x72, 0xaa, 0xfd, 0x23,
+	0x68, 0x3a, 0xcb, 0x8e, 0xb9, 0xbb, 0xae, 0xff, 0x85, 0xc0, 0xe1, 0x2b, 0xa9, 0xb4, 0x93, 0xa9,
+	0x02, 0xf1, 0xc9, 0xac, 0x37, 0x63, 0xef, 0x05, 0x66, 0x97, 0x03, 0x3c, 0x9b, 0xf5, 0x22, 0xf9,
+	0x51, 0x6a, 0x94, 0xa4, 0x1c, 0x58, 0x50, 0x2c, 0x5a, 0xb9, 0x58, 0xb4, 0x2e, 0xd4, 0x59, 0xa8,
+	0xe5, 0x42, 0x98, 0x7d, 0x2b, 0x56, 0x0a, 0x1b, 0x98, 0xc4, 0xfe, 0xe7, 0x4d, 0x5f, 0xa3, 0xe8,
+	0x39, 0x34, 0xdd, 0x2b, 0x56, 0x1e, 0x19, 0x94, 0x87, 0x7b, 0x27, 0xdd, 0xd1, 0xfa, 0x92, 0x47,
+	0x4e, 0x6d, 0xb0, 0xaf, 0x9c, 0x66, 0x66, 0x07, 0x3c, 0xe5, 0x3b, 0x20, 0xf0, 0x8f, 0xa1, 0x75,
+	0xa9, 0x99, 0x9e, 0x2b, 0xd7, 0x21, 0x6d, 0xa8, 0x2a, 0x24, 0x50, 0x88, 0x5a, 0x90, 0x21, 0x5f,
+	0xae, 0xcc, 0x74, 0x91, 0xca, 0x50, 0x18, 0xc5, 0x0a, 0x75, 0x20, 0xc5, 0x3a, 0xb4, 0xa1, 0x9a,
+	0xc4, 0x91, 0x8c, 0x05, 0x7e, 0x46, 0x2d, 0xc8, 0x90, 0x51, 0xdd, 0xdc, 0x60, 0x6e, 0x2a, 0x73,
+	0xf6, 0xbf, 0x13, 0xb8, 0x7f, 0x73, 0xd6, 0xad, 0x06, 0xf5, 0x00, 0xae, 0x98, 0x12, 0xd3, 0x99,
+	0xa9, 0xc4, 0x61, 0x24, 0xa8, 0x9b, 0x88, 0x6d, 0xd5, 0x81, 0x5a, 0xae, 0x27, 0xce, 0x24, 0xc1,
+	0x0a, 0x1b, 0xa5, 0x6c, 0x55, 0x66, 0x66, 0x04, 0xf4, 0x21, 0xec, 0xe5, 0x19, 0x66, 0xac, 0xb5,
+	0x33, 0xf0, 0x95, 0xc9, 0x4e, 0xbe, 0x56, 0xa0, 0xed, 0xa8, 0x98, 0x41, 0xfa, 0x1a, 0x5a, 0x2f,
+	0xd0, 0xc0, 0xae, 0xca, 0xdb, 0xee, 0xb0, 0xb3, 0x8d, 0xa4, 0x17, 0x40, 0x5f, 0x0a, 0xd7, 0x32,
+	0xb4, 0xb7, 0xa5, 0x64, 0xcc, 0xb7, 0x77, 0x7c, 0x07, 0x07, 0x45, 0x2e, 0xa4, 0x47, 0x37, 0x8b,
+	0x36, 0xbc, 0x9a, 0xce, 0x7f, 0x24, 0x29, 0x23, 0xc3, 0x1b, 0x7c, 0xa8, 0x77, 0x27, 0xc3, 0x5b,
+	0x68, 0x9d, 0x89, 0x48, 0x68, 0x71, 0x4b, 0x25, 0x1e, 0xfd, 0x43, 0x17, 0xbe, 0x80, 0x73, 0x68,
+	0xac, 0x15, 0xb6, 0xae, 0x29, 0xfa, 0x94, 0xfc, 0x19, 0x74, 0xbc, 0x4d, 0xe4, 0xf3, 0xfd, 0x1f,
+	0xcb, 0x3e, 0xf9, 0xb9, 0xec, 0x93, 0x5f, 0xcb, 0x3e, 0xf9, 0xf6, 0xbb, 0x7f, 0xef, 0xaa, 0x8a,
+	0x7f, 0xd3, 0xcf, 0xfe, 0x0e, 0x00, 0x70, 0x35, 0xcf, 0xbc, 0xd3, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceDiscountServiceClient is the client API for ServiceDiscountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceDiscountServiceClient interface {
+	CreateServiceDiscount(ctx context.Context, in *ServiceDiscount, opts ...grpc.CallOption) (*ServiceDiscount, error)
+	GetServiceDiscount(ctx context.Context, in *ServiceDiscountId, opts ...grpc.CallOption) (*ServiceDiscount, error)
+	ListServiceDiscounts(ctx context.Context, in *ListServiceDiscountsReq, opts ...grpc.CallOption) (*ListServiceDiscountsRes, error)
+	UpdateServiceDiscount(ctx context.Context, in *ServiceDiscount, opts ...grpc.CallOption) (*ServiceDiscount, error)
+	DeleteServiceDiscount(ctx context.Context, in *ServiceDiscountId, opts ...grpc.CallOption) (*StatusServiceDiscount, error)
+	GetServicePrice(ctx context.Context, in *ServicePriceReq, opts ...grpc.CallOption) (*ServicePrice, error)
+}
+
+type serviceDiscountServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewServiceDiscountServiceClient(cc *grpc.ClientConn) ServiceDiscountServiceClient {
+	return &serviceDiscountServiceClient{cc}
+}
+
+func (c *serviceDiscountServiceClient) CreateServiceDiscount(ctx context.Context, in *ServiceDiscount, opts ...grpc.CallOption) (*ServiceDiscount, error) {
+	out := new(ServiceDiscount)
+	err := c.cc.Invoke(ctx, "/healthcare.ServiceDiscountService/CreateServiceDiscount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceDiscountServiceClient) GetServiceDiscount(ctx context.Context, in *ServiceDiscountId, opts ...grpc.CallOption) (*ServiceDiscount, error) {
+	out := new(ServiceDiscount)
+	err := c.cc.Invoke(ctx, "/healthcare.ServiceDiscountService/GetServiceDiscount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceDiscountServiceClient) ListServiceDiscounts(ctx context.Context, in *ListServiceDiscountsReq, opts ...grpc.CallOption) (*ListServiceDiscountsRes, error) {
+	out := new(ListServiceDiscountsRes)
+	err := c.cc.Invoke(ctx, "/healthcare.ServiceDiscountService/ListServiceDiscounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceDiscountServiceClient) UpdateServiceDiscount(ctx context.Context, in *ServiceDiscount, opts ...grpc.CallOption) (*ServiceDiscount, error) {
+	out := new(ServiceDiscount)
+	err := c.cc.Invoke(ctx, "/healthcare.ServiceDiscountService/UpdateServiceDiscount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceDiscountServiceClient) DeleteServiceDiscount(ctx context.Context, in *ServiceDiscountId, opts ...grpc.CallOption) (*StatusServiceDiscount, error) {
+	out := new(StatusServiceDiscount)
+	err := c.cc.Invoke(ctx, "/healthcare.ServiceDiscountService/DeleteServiceDiscount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceDiscountServiceClient) GetServicePrice(ctx context.Context, in *ServicePriceReq, opts ...grpc.CallOption) (*ServicePrice, error) {
+	out := new(ServicePrice)
+	err := c.cc.Invoke(ctx, "/healthcare.ServiceDiscountService/GetServicePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceDiscountServiceServer is the server API for ServiceDiscountService service.
+type ServiceDiscountServiceServer interface {
+	CreateServiceDiscount(context.Context, *ServiceDiscount) (*ServiceDiscount, error)
+	GetServiceDiscount(context.Context, *ServiceDiscountId) (*ServiceDiscount, error)
+	ListServiceDiscounts(context.Context, *ListServiceDiscountsReq) (*ListServiceDiscountsRes, error)
+	UpdateServiceDiscount(context.Context, *ServiceDiscount) (*ServiceDiscount, error)
+	DeleteServiceDiscount(context.Context, *ServiceDiscountId) (*StatusServiceDiscount, error)
+	GetServicePrice(context.Context, *ServicePriceReq) (*ServicePrice, error)
+}
+
+// UnimplementedServiceDiscountServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceDiscountServiceServer struct {
+}
+
+func (*UnimplementedServiceDiscountServiceServer) CreateServiceDiscount(ctx context.Context, req *ServiceDiscount) (*ServiceDiscount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceDiscount not implemented")
+}
+func (*UnimplementedServiceDiscountServiceServer) GetServiceDiscount(ctx context.Context, req *ServiceDiscountId) (*ServiceDiscount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceDiscount not implemented")
+}
+func (*UnimplementedServiceDiscountServiceServer) ListServiceDiscounts(ctx context.Context, req *ListServiceDiscountsReq) (*ListServiceDiscountsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceDiscounts not implemented")
+}
+func (*UnimplementedServiceDiscountServiceServer) UpdateServiceDiscount(ctx context.Context, req *ServiceDiscount) (*ServiceDiscount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServiceDiscount not implemented")
+}
+func (*UnimplementedServiceDiscountServiceServer) DeleteServiceDiscount(ctx context.Context, req *ServiceDiscountId) (*StatusServiceDiscount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceDiscount not implemented")
+}
+func (*UnimplementedServiceDiscountServiceServer) GetServicePrice(ctx context.Context, req *ServicePriceReq) (*ServicePrice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServicePrice not implemented")
+}
+
+func RegisterServiceDiscountServiceServer(s *grpc.Server, srv ServiceDiscountServiceServer) {
+	s.RegisterService(&_ServiceDiscountService_serviceDesc, srv)
+}
+
+func _ServiceDiscountService_CreateServiceDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceDiscount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceDiscountServiceServer).CreateServiceDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.ServiceDiscountService/CreateServiceDiscount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceDiscountServiceServer).CreateServiceDiscount(ctx, req.(*ServiceDiscount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceDiscountService_GetServiceDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceDiscountId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceDiscountServiceServer).GetServiceDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.ServiceDiscountService/GetServiceDiscount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceDiscountServiceServer).GetServiceDiscount(ctx, req.(*ServiceDiscountId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceDiscountService_ListServiceDiscounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceDiscountsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceDiscountServiceServer).ListServiceDiscounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.ServiceDiscountService/ListServiceDiscounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceDiscountServiceServer).ListServiceDiscounts(ctx, req.(*ListServiceDiscountsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceDiscountService_UpdateServiceDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceDiscount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceDiscountServiceServer).UpdateServiceDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.ServiceDiscountService/UpdateServiceDiscount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceDiscountServiceServer).UpdateServiceDiscount(ctx, req.(*ServiceDiscount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceDiscountService_DeleteServiceDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceDiscountId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceDiscountServiceServer).DeleteServiceDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.ServiceDiscountService/DeleteServiceDiscount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceDiscountServiceServer).DeleteServiceDiscount(ctx, req.(*ServiceDiscountId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceDiscountService_GetServicePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServicePriceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceDiscountServiceServer).GetServicePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.ServiceDiscountService/GetServicePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceDiscountServiceServer).GetServicePrice(ctx, req.(*ServicePriceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ServiceDiscountService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "healthcare.ServiceDiscountService",
+	HandlerType: (*ServiceDiscountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateServiceDiscount",
+			Handler:    _ServiceDiscountService_CreateServiceDiscount_Handler,
+		},
+		{
+			MethodName: "GetServiceDiscount",
+			Handler:    _ServiceDiscountService_GetServiceDiscount_Handler,
+		},
+		{
+			MethodName: "ListServiceDiscounts",
+			Handler:    _ServiceDiscountService_ListServiceDiscounts_Handler,
+		},
+		{
+			MethodName: "UpdateServiceDiscount",
+			Handler:    _ServiceDiscountService_UpdateServiceDiscount_Handler,
+		},
+		{
+			MethodName: "DeleteServiceDiscount",
+			Handler:    _ServiceDiscountService_DeleteServiceDiscount_Handler,
+		},
+		{
+			MethodName: "GetServicePrice",
+			Handler:    _ServiceDiscountService_GetServicePrice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "healthcare-service/service_discount.proto",
+}
+
+func (m *ServiceDiscount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceDiscount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceDiscount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EndsOn) > 0 {
+		i -= len(m.EndsOn)
+		copy(dAtA[i:], m.EndsOn)
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(len(m.EndsOn)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StartsOn) > 0 {
+		i -= len(m.StartsOn)
+		copy(dAtA[i:], m.StartsOn)
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(len(m.StartsOn)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceDiscountId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceDiscountId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceDiscountId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServiceDiscountsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServiceDiscountsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServiceDiscountsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ActiveOn) > 0 {
+		i -= len(m.ActiveOn)
+		copy(dAtA[i:], m.ActiveOn)
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(len(m.ActiveOn)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServiceDiscountsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServiceDiscountsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServiceDiscountsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ServiceDiscounts) > 0 {
+		for iNdEx := len(m.ServiceDiscounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServiceDiscounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceDiscount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusServiceDiscount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusServiceDiscount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusServiceDiscount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServicePriceReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServicePriceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServicePriceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Online {
+		i--
+		if m.Online {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServicePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServicePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServicePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DiscountId) > 0 {
+		i -= len(m.DiscountId)
+		copy(dAtA[i:], m.DiscountId)
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(len(m.DiscountId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Discount != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Discount))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.BasePrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.BasePrice))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintServiceDiscount(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintServiceDiscount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovServiceDiscount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ServiceDiscount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovServiceDiscount(uint64(l))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovServiceDiscount(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovServiceDiscount(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	l = len(m.StartsOn)
+	if l > 0 {
+		n += 1 + l + sovServiceDiscount(uint64(l))
+	}
+	l = len(m.EndsOn)
+	if l > 0 {
+		n += 1 + l + sovServiceDiscount(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovServiceDiscount(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovServiceDiscount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServiceDiscountId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovServiceDiscount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListServiceDiscountsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovServiceDiscount(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovServiceDiscount(uint64(m.Limit))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovServiceDiscount(uint64(l))
+	}
+	l = len(m.ActiveOn)
+	if l > 0 {
+		n += 1 + l + sovServiceDiscount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListServiceDiscountsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ServiceDiscounts) > 0 {
+		for _, e := range m.ServiceDiscounts {
+			l = e.Size()
+			n += 1 + l + sovServiceDiscount(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovServiceDiscount(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusServiceDiscount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServicePriceReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovServiceDiscount(uint64(l))
+	}
+	if m.Online {
+		n += 2
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovServiceDiscount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServicePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovServiceDiscount(uint64(l))
+	}
+	if m.BasePrice != 0 {
+		n += 9
+	}
+	if m.Discount != 0 {
+		n += 9
+	}
+	if m.Price != 0 {
+		n += 9
+	}
+	l = len(m.DiscountId)
+	if l > 0 {
+		n += 1 + l + sovServiceDiscount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovServiceDiscount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozServiceDiscount(x uint64) (n int) {
+	return sovServiceDiscount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ServiceDiscount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceDiscount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceDiscount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartsOn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndsOn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceDiscount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceDiscountId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceDiscount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceDiscountId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceDiscountId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceDiscount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListServiceDiscountsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceDiscount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListServiceDiscountsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListServiceDiscountsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveOn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceDiscount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListServiceDiscountsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceDiscount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListServiceDiscountsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListServiceDiscountsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceDiscounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceDiscounts = append(m.ServiceDiscounts, &ServiceDiscount{})
+			if err := m.ServiceDiscounts[len(m.ServiceDiscounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceDiscount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusServiceDiscount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceDiscount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusServiceDiscount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusServiceDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceDiscount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServicePriceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceDiscount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServicePriceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServicePriceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Online", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Online = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceDiscount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServicePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceDiscount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServicePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServicePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.BasePrice = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Discount = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiscountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceDiscount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServiceDiscount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipServiceDiscount(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowServiceDiscount
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowServiceDiscount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthServiceDiscount
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupServiceDiscount
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthServiceDiscount
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthServiceDiscount        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowServiceDiscount          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupServiceDiscount = fmt.Errorf("proto: unexpected end of group")
+)
@@ -27,15 +27,17 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type Specializations struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Order                int32    `protobuf:"varint,2,opt,name=order,proto3" json:"order"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
-	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description"`
-	DepartmentId         string   `protobuf:"bytes,5,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
-	ImageUrl             string   `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url"`
-	CreatedAt            string   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	DeletedAt            string   `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Order        int32  `protobuf:"varint,2,opt,name=order,proto3" json:"order"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	Description  string `protobuf:"bytes,4,opt,name=description,proto3" json:"description"`
+	DepartmentId string `protobuf:"bytes,5,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	ImageUrl     string `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url"`
+	CreatedAt    string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt    string `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt    string `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	// parent_id is the specialization this one is a sub-specialization of, empty for a top level one
+	ParentId             string   `protobuf:"bytes,10,opt,name=parent_id,json=parentId,proto3" json:"parent_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -137,6 +139,13 @@ func (m *Specializations) GetDeletedAt() string {
 	return ""
 }
 
+func (m *Specializations) GetParentId() string {
+	if m != nil {
+		return m.ParentId
+	}
+	return ""
+}
+
 type GetReqStrSpecialization struct {
 	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
@@ -303,13 +312,15 @@ func (m *StatusSpecialization) GetStatus() bool {
 }
 
 type GetAllSpecialization struct {
-	Page                 int32    `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
-	IsActive             bool     `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active"`
-	Field                string   `protobuf:"bytes,4,opt,name=field,proto3" json:"field"`
-	Value                string   `protobuf:"bytes,5,opt,name=value,proto3" json:"value"`
-	OrderBy              string   `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
-	DepartmentId         string   `protobuf:"bytes,7,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	Page         int32  `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit        int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	IsActive     bool   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	Field        string `protobuf:"bytes,4,opt,name=field,proto3" json:"field"`
+	Value        string `protobuf:"bytes,5,opt,name=value,proto3" json:"value"`
+	OrderBy      string `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
+	DepartmentId string `protobuf:"bytes,7,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	// parent_id lists the direct sub-specializations of the specialization
+	ParentId             string   `protobuf:"bytes,8,opt,name=parent_id,json=parentId,proto3" json:"parent_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -397,12 +408,117 @@ func (m *GetAllSpecialization) GetDepartmentId() string {
 	return ""
 }
 
+func (m *GetAllSpecialization) GetParentId() string {
+	if m != nil {
+		return m.ParentId
+	}
+	return ""
+}
+
+// SpecializationTreeReq selects the specialization root_id with all its sub-specializations,
+// the whole tree when root_id is empty, parents are listed before their children
+type SpecializationTreeReq struct {
+	RootId               string   `protobuf:"bytes,1,opt,name=root_id,json=rootId,proto3" json:"root_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpecializationTreeReq) Reset()         { *m = SpecializationTreeReq{} }
+func (m *SpecializationTreeReq) String() string { return proto.CompactTextString(m) }
+func (*SpecializationTreeReq) ProtoMessage()    {}
+func (*SpecializationTreeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8df9db489869b4c3, []int{5}
+}
+func (m *SpecializationTreeReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecializationTreeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecializationTreeReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpecializationTreeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecializationTreeReq.Merge(m, src)
+}
+func (m *SpecializationTreeReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecializationTreeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecializationTreeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecializationTreeReq proto.InternalMessageInfo
+
+func (m *SpecializationTreeReq) GetRootId() string {
+	if m != nil {
+		return m.RootId
+	}
+	return ""
+}
+
+type RestoreSpecializationReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreSpecializationReq) Reset()         { *m = RestoreSpecializationReq{} }
+func (m *RestoreSpecializationReq) String() string { return proto.CompactTextString(m) }
+func (*RestoreSpecializationReq) ProtoMessage()    {}
+func (*RestoreSpecializationReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8df9db489869b4c3, []int{6}
+}
+func (m *RestoreSpecializationReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreSpecializationReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreSpecializationReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreSpecializationReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreSpecializationReq.Merge(m, src)
+}
+func (m *RestoreSpecializationReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreSpecializationReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreSpecializationReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreSpecializationReq proto.InternalMessageInfo
+
+func (m *RestoreSpecializationReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Specializations)(nil), "healthcare.Specializations")
 	proto.RegisterType((*GetReqStrSpecialization)(nil), "healthcare.GetReqStrSpecialization")
 	proto.RegisterType((*ListSpecializations)(nil), "healthcare.ListSpecializations")
 	proto.RegisterType((*StatusSpecialization)(nil), "healthcare.StatusSpecialization")
 	proto.RegisterType((*GetAllSpecialization)(nil), "healthcare.GetAllSpecialization")
+	proto.RegisterType((*SpecializationTreeReq)(nil), "healthcare.SpecializationTreeReq")
+	proto.RegisterType((*RestoreSpecializationReq)(nil), "healthcare.RestoreSpecializationReq")
 }
 
 func init() {
@@ -410,40 +526,45 @@ func init() {
 }
 
 var fileDescriptor_8df9db489869b4c3 = []byte{
-	// 527 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xd1, 0x8e, 0xd2, 0x4c,
-	0x14, 0xfe, 0x0b, 0x14, 0xca, 0xd9, 0x5f, 0xd7, 0x8c, 0x5d, 0xad, 0xbb, 0x11, 0x09, 0x7b, 0x21,
-	0x37, 0x62, 0xb2, 0x3e, 0x01, 0xa8, 0x21, 0x9b, 0x78, 0xb1, 0x29, 0xd9, 0x0b, 0x4d, 0x0c, 0x19,
-	0x3a, 0xc7, 0xdd, 0x49, 0x06, 0xa8, 0x33, 0x53, 0x12, 0x7c, 0x06, 0x1f, 0xc0, 0x47, 0xd2, 0x3b,
-	0x7d, 0x03, 0x83, 0x2f, 0x62, 0x7a, 0x5a, 0x02, 0x2d, 0x48, 0xbc, 0xf0, 0xae, 0xdf, 0xf7, 0x9d,
-	0x39, 0xcc, 0xf9, 0xce, 0x37, 0xc0, 0xd3, 0x5b, 0xe4, 0xca, 0xde, 0x46, 0x5c, 0xe3, 0x33, 0x83,
-	0x7a, 0x21, 0x23, 0x7c, 0x6e, 0x62, 0x8c, 0x24, 0x57, 0xf2, 0x13, 0xb7, 0x72, 0x3e, 0xeb, 0xc5,
-	0x7a, 0x6e, 0xe7, 0x0c, 0x36, 0x85, 0x9d, 0xcf, 0x15, 0x38, 0x1e, 0x15, 0x8a, 0x0c, 0xbb, 0x0b,
-	0x15, 0x29, 0x02, 0xa7, 0xed, 0x74, 0x9b, 0x61, 0x45, 0x0a, 0xe6, 0x83, 0x3b, 0xd7, 0x02, 0x75,
-	0x50, 0x69, 0x3b, 0x5d, 0x37, 0xcc, 0x00, 0x63, 0x50, 0x9b, 0xf1, 0x29, 0x06, 0x55, 0xaa, 0xa3,
-	0x6f, 0xd6, 0x86, 0x23, 0x81, 0x26, 0xd2, 0x32, 0x4e, 0x3b, 0x05, 0x35, 0x92, 0xb6, 0x29, 0x76,
-	0x0e, 0x77, 0x04, 0xc6, 0x5c, 0xdb, 0x29, 0xce, 0xec, 0x58, 0x8a, 0xc0, 0xa5, 0x9a, 0xff, 0x37,
-	0xe4, 0xa5, 0x60, 0x67, 0xd0, 0x94, 0x53, 0x7e, 0x83, 0xe3, 0x44, 0xab, 0xa0, 0x4e, 0x05, 0x1e,
-	0x11, 0xd7, 0x5a, 0xb1, 0xc7, 0x00, 0x91, 0x46, 0x6e, 0x51, 0x8c, 0xb9, 0x0d, 0x1a, 0xa4, 0x36,
-	0x73, 0xa6, 0x6f, 0x53, 0x39, 0x89, 0xc5, 0x5a, 0xf6, 0x32, 0x39, 0x67, 0x32, 0x59, 0xa0, 0xc2,
-	0x5c, 0x6e, 0x66, 0x72, 0xce, 0xf4, 0x6d, 0x67, 0x02, 0x0f, 0x87, 0x68, 0x43, 0xfc, 0x38, 0xb2,
-	0xba, 0x68, 0x4b, 0xea, 0xc2, 0x07, 0x89, 0x6a, 0x6d, 0x4c, 0x06, 0x52, 0x76, 0xc1, 0x55, 0x82,
-	0xe4, 0x4d, 0x33, 0xcc, 0x00, 0x0d, 0x60, 0xc6, 0x3c, 0xb2, 0x72, 0x91, 0x19, 0xe4, 0x85, 0x9e,
-	0x34, 0x7d, 0xc2, 0x1d, 0x0d, 0xf7, 0xdf, 0x48, 0x63, 0xcb, 0xae, 0xfb, 0xe0, 0x46, 0xf3, 0x64,
-	0x66, 0xa9, 0xbf, 0x1b, 0x66, 0x80, 0xbd, 0x86, 0xe3, 0xe2, 0x0e, 0x4d, 0x50, 0x69, 0x57, 0xbb,
-	0x47, 0x17, 0x67, 0xbd, 0xcd, 0x16, 0x7b, 0xa5, 0x5e, 0x61, 0xf9, 0x4c, 0xa7, 0x07, 0xfe, 0xc8,
-	0x72, 0x9b, 0x98, 0xd2, 0x50, 0x0f, 0xa0, 0x6e, 0x88, 0xa7, 0x5f, 0xf5, 0xc2, 0x1c, 0x75, 0xbe,
-	0x39, 0xe0, 0x0f, 0xd1, 0xf6, 0x95, 0x2a, 0x1d, 0x60, 0x50, 0x8b, 0xf9, 0x0d, 0xe6, 0x97, 0xa4,
-	0xef, 0xf4, 0xe6, 0x4a, 0x4e, 0xa5, 0x5d, 0xe7, 0x83, 0xc0, 0x41, 0x0f, 0x36, 0x66, 0xd6, 0xf6,
-	0x9a, 0xe9, 0x6e, 0x9b, 0xf9, 0x08, 0x3c, 0x4a, 0xdc, 0x78, 0xb2, 0xcc, 0xc3, 0xd0, 0x20, 0x3c,
-	0x58, 0xee, 0xa6, 0xa9, 0xb1, 0x9b, 0xa6, 0x8b, 0x1f, 0x55, 0x38, 0x29, 0x4e, 0x31, 0xca, 0x5e,
-	0x07, 0xbb, 0x02, 0xff, 0x25, 0x05, 0xa7, 0x34, 0xe4, 0x21, 0x6f, 0x4f, 0x0f, 0x89, 0xec, 0x2d,
-	0x9c, 0x0c, 0xb1, 0xb4, 0xda, 0xc1, 0xf2, 0x52, 0xb0, 0xf3, 0xed, 0x53, 0x7f, 0x88, 0xd8, 0xe1,
-	0xd6, 0xef, 0xa8, 0xf5, 0xce, 0x46, 0x0c, 0x6b, 0x97, 0x5a, 0xef, 0x94, 0x9c, 0x3e, 0xd9, 0xae,
-	0xd8, 0x97, 0xbd, 0x2b, 0xf0, 0xaf, 0xe9, 0x89, 0xfc, 0x33, 0x23, 0xde, 0x83, 0xff, 0x8a, 0x5e,
-	0x55, 0xa9, 0xe3, 0x5f, 0xf9, 0x50, 0x98, 0x68, 0x5f, 0x6e, 0x07, 0xf7, 0xbe, 0xae, 0x5a, 0xce,
-	0xf7, 0x55, 0xcb, 0xf9, 0xb9, 0x6a, 0x39, 0x5f, 0x7e, 0xb5, 0xfe, 0x9b, 0xd4, 0xe9, 0xbf, 0xed,
-	0xc5, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x07, 0xce, 0x44, 0xdc, 0x06, 0x05, 0x00, 0x00,
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x51, 0x6e, 0xd3, 0x40,
+	0x10, 0xc5, 0x69, 0x9c, 0x38, 0x53, 0xa0, 0x68, 0x71, 0xa8, 0x69, 0x45, 0x08, 0x2e, 0x12, 0x15,
+	0x12, 0x01, 0x95, 0x13, 0xa4, 0x80, 0xaa, 0x48, 0x7c, 0x54, 0x0e, 0xfd, 0x00, 0x84, 0xac, 0xad,
+	0x77, 0x68, 0x57, 0x72, 0x62, 0x77, 0x77, 0x53, 0xa9, 0x5c, 0x82, 0x5f, 0xae, 0xc0, 0x4d, 0xf8,
+	0xe4, 0x08, 0xa8, 0x48, 0x9c, 0x03, 0x79, 0xd7, 0x55, 0xe2, 0xad, 0x1b, 0xfa, 0xc1, 0x9f, 0x67,
+	0xde, 0xcc, 0xec, 0xee, 0x7b, 0x6f, 0x64, 0x78, 0x72, 0x8c, 0x34, 0x55, 0xc7, 0x09, 0x15, 0xf8,
+	0x4c, 0xa2, 0x38, 0xe5, 0x09, 0x3e, 0x97, 0x39, 0x26, 0x9c, 0xa6, 0xfc, 0x0b, 0x55, 0x3c, 0x9b,
+	0x0e, 0x72, 0x91, 0xa9, 0x8c, 0xc0, 0xbc, 0x30, 0xfc, 0xde, 0x80, 0xb5, 0x71, 0xa5, 0x48, 0x92,
+	0xdb, 0xd0, 0xe0, 0x2c, 0x70, 0xfa, 0xce, 0x76, 0x27, 0x6a, 0x70, 0x46, 0x7c, 0x70, 0x33, 0xc1,
+	0x50, 0x04, 0x8d, 0xbe, 0xb3, 0xed, 0x46, 0x26, 0x20, 0x04, 0x9a, 0x53, 0x3a, 0xc1, 0x60, 0x45,
+	0xd7, 0xe9, 0x6f, 0xd2, 0x87, 0x55, 0x86, 0x32, 0x11, 0x3c, 0x2f, 0x26, 0x05, 0x4d, 0x0d, 0x2d,
+	0xa6, 0xc8, 0x16, 0xdc, 0x62, 0x98, 0x53, 0xa1, 0x26, 0x38, 0x55, 0x31, 0x67, 0x81, 0xab, 0x6b,
+	0x6e, 0xce, 0x93, 0x23, 0x46, 0x36, 0xa1, 0xc3, 0x27, 0xf4, 0x08, 0xe3, 0x99, 0x48, 0x83, 0x96,
+	0x2e, 0xf0, 0x74, 0xe2, 0x40, 0xa4, 0xe4, 0x01, 0x40, 0x22, 0x90, 0x2a, 0x64, 0x31, 0x55, 0x41,
+	0x5b, 0xa3, 0x9d, 0x32, 0x33, 0x54, 0x05, 0x3c, 0xcb, 0xd9, 0x05, 0xec, 0x19, 0xb8, 0xcc, 0x18,
+	0x98, 0x61, 0x8a, 0x25, 0xdc, 0x31, 0x70, 0x99, 0x19, 0xaa, 0xe2, 0xe4, 0x9c, 0x8a, 0xf2, 0x6a,
+	0x60, 0x4e, 0x36, 0x89, 0x11, 0x0b, 0x0f, 0x61, 0x7d, 0x0f, 0x55, 0x84, 0x27, 0x63, 0x25, 0xaa,
+	0x9c, 0x15, 0x14, 0x7d, 0xe6, 0x98, 0x5e, 0xb0, 0x66, 0x82, 0x22, 0x7b, 0x4a, 0xd3, 0x19, 0x6a,
+	0xe2, 0x3a, 0x91, 0x09, 0xf4, 0xeb, 0x64, 0x4c, 0x13, 0xc5, 0x4f, 0x0d, 0x7b, 0x5e, 0xe4, 0x71,
+	0x39, 0xd4, 0x71, 0x28, 0xe0, 0xee, 0x5b, 0x2e, 0x95, 0x2d, 0x89, 0x0f, 0x6e, 0x92, 0xcd, 0xa6,
+	0x4a, 0xcf, 0x77, 0x23, 0x13, 0x90, 0x37, 0xb0, 0x56, 0x15, 0x58, 0x06, 0x8d, 0xfe, 0xca, 0xf6,
+	0xea, 0xce, 0xe6, 0x60, 0x2e, 0xf1, 0xc0, 0x9a, 0x15, 0xd9, 0x3d, 0xe1, 0x00, 0xfc, 0xb1, 0xa2,
+	0x6a, 0x26, 0xad, 0x47, 0xdd, 0x83, 0x96, 0xd4, 0x79, 0x7d, 0xaa, 0x17, 0x95, 0x51, 0xf8, 0xc7,
+	0x01, 0x7f, 0x0f, 0xd5, 0x30, 0x4d, 0xad, 0x06, 0x02, 0xcd, 0x9c, 0x1e, 0x61, 0x79, 0x49, 0xfd,
+	0x5d, 0xdc, 0x3c, 0xe5, 0x13, 0xae, 0x2e, 0xcc, 0xa3, 0x83, 0xa5, 0x1c, 0xcc, 0xc9, 0x6c, 0xd6,
+	0x92, 0xe9, 0x2e, 0x92, 0x79, 0x1f, 0x3c, 0x6d, 0xc7, 0xf8, 0xf0, 0xac, 0x74, 0x4a, 0x5b, 0xc7,
+	0xbb, 0x67, 0x97, 0xad, 0xd6, 0xae, 0xb7, 0xda, 0x5c, 0x70, 0xcf, 0x12, 0xfc, 0x05, 0x74, 0xab,
+	0x2f, 0x7c, 0x27, 0x10, 0x23, 0x3c, 0x21, 0xeb, 0xd0, 0x16, 0x59, 0xa6, 0x7b, 0x8c, 0xe0, 0xad,
+	0x22, 0x1c, 0xb1, 0xf0, 0x29, 0x04, 0x11, 0x4a, 0x95, 0x09, 0xac, 0x36, 0x16, 0x4d, 0xd6, 0x5a,
+	0xed, 0x7c, 0x75, 0xed, 0xf1, 0x63, 0xb3, 0xb5, 0x64, 0x1f, 0xfc, 0x57, 0xda, 0xd0, 0x16, 0xbf,
+	0xcb, 0x64, 0xdd, 0x58, 0x06, 0x92, 0xf7, 0xd0, 0xdd, 0x43, 0xcb, 0x55, 0xbb, 0x67, 0x23, 0x46,
+	0xb6, 0x16, 0xbb, 0xae, 0x70, 0xf7, 0xf2, 0xd1, 0x1f, 0xa0, 0x5b, 0x67, 0x06, 0x49, 0xfa, 0xd6,
+	0xe8, 0x4b, 0x25, 0x1b, 0x0f, 0x17, 0x2b, 0xea, 0x6c, 0xbf, 0x0f, 0xfe, 0x81, 0x5e, 0xdd, 0xff,
+	0x46, 0xc4, 0x27, 0xf0, 0x5f, 0xeb, 0x6d, 0xb7, 0x26, 0x5e, 0x8b, 0x87, 0xca, 0x8b, 0x6a, 0x57,
+	0x26, 0x86, 0x6e, 0xad, 0xfe, 0xe4, 0xf1, 0x62, 0xeb, 0x55, 0x16, 0xb9, 0xc6, 0x01, 0x1f, 0x6b,
+	0x84, 0x2c, 0x5c, 0x49, 0x1e, 0x5d, 0xfd, 0xea, 0xd2, 0xb5, 0xff, 0xa4, 0x7b, 0xf7, 0xce, 0x8f,
+	0xf3, 0x9e, 0xf3, 0xf3, 0xbc, 0xe7, 0xfc, 0x3a, 0xef, 0x39, 0xdf, 0x7e, 0xf7, 0x6e, 0x1c, 0xb6,
+	0xf4, 0x1f, 0xe3, 0xe5, 0xdf, 0x01, 0x00, 0x28, 0x6b, 0xd3, 0x0e, 0x5c, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -463,6 +584,8 @@ type SpecializationServiceClient interface {
 	GetAllSpecializations(ctx context.Context, in *GetAllSpecialization, opts ...grpc.CallOption) (*ListSpecializations, error)
 	UpdateSpecialization(ctx context.Context, in *Specializations, opts ...grpc.CallOption) (*Specializations, error)
 	DeleteSpecialization(ctx context.Context, in *GetReqStrSpecialization, opts ...grpc.CallOption) (*StatusSpecialization, error)
+	RestoreSpecialization(ctx context.Context, in *RestoreSpecializationReq, opts ...grpc.CallOption) (*StatusSpecialization, error)
+	GetSpecializationTree(ctx context.Context, in *SpecializationTreeReq, opts ...grpc.CallOption) (*ListSpecializations, error)
 }
 
 type specializationServiceClient struct {
@@ -518,6 +641,24 @@ func (c *specializationServiceClient) DeleteSpecialization(ctx context.Context,
 	return out, nil
 }
 
+func (c *specializationServiceClient) RestoreSpecialization(ctx context.Context, in *RestoreSpecializationReq, opts ...grpc.CallOption) (*StatusSpecialization, error) {
+	out := new(StatusSpecialization)
+	err := c.cc.Invoke(ctx, "/healthcare.SpecializationService/RestoreSpecialization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *specializationServiceClient) GetSpecializationTree(ctx context.Context, in *SpecializationTreeReq, opts ...grpc.CallOption) (*ListSpecializations, error) {
+	out := new(ListSpecializations)
+	err := c.cc.Invoke(ctx, "/healthcare.SpecializationService/GetSpecializationTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SpecializationServiceServer is the server API for SpecializationService service.
 type SpecializationServiceServer interface {
 	CreateSpecialization(context.Context, *Specializations) (*Specializations, error)
@@ -525,6 +666,8 @@ type SpecializationServiceServer interface {
 	GetAllSpecializations(context.Context, *GetAllSpecialization) (*ListSpecializations, error)
 	UpdateSpecialization(context.Context, *Specializations) (*Specializations, error)
 	DeleteSpecialization(context.Context, *GetReqStrSpecialization) (*StatusSpecialization, error)
+	RestoreSpecialization(context.Context, *RestoreSpecializationReq) (*StatusSpecialization, error)
+	GetSpecializationTree(context.Context, *SpecializationTreeReq) (*ListSpecializations, error)
 }
 
 // UnimplementedSpecializationServiceServer can be embedded to have forward compatible implementations.
@@ -546,6 +689,12 @@ func (*UnimplementedSpecializationServiceServer) UpdateSpecialization(ctx contex
 func (*UnimplementedSpecializationServiceServer) DeleteSpecialization(ctx context.Context, req *GetReqStrSpecialization) (*StatusSpecialization, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSpecialization not implemented")
 }
+func (*UnimplementedSpecializationServiceServer) RestoreSpecialization(ctx context.Context, req *RestoreSpecializationReq) (*StatusSpecialization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSpecialization not implemented")
+}
+func (*UnimplementedSpecializationServiceServer) GetSpecializationTree(ctx context.Context, req *SpecializationTreeReq) (*ListSpecializations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpecializationTree not implemented")
+}
 
 func RegisterSpecializationServiceServer(s *grpc.Server, srv SpecializationServiceServer) {
 	s.RegisterService(&_SpecializationService_serviceDesc, srv)
@@ -641,6 +790,42 @@ func _SpecializationService_DeleteSpecialization_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SpecializationService_RestoreSpecialization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSpecializationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpecializationServiceServer).RestoreSpecialization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.SpecializationService/RestoreSpecialization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpecializationServiceServer).RestoreSpecialization(ctx, req.(*RestoreSpecializationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpecializationService_GetSpecializationTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecializationTreeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpecializationServiceServer).GetSpecializationTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.SpecializationService/GetSpecializationTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpecializationServiceServer).GetSpecializationTree(ctx, req.(*SpecializationTreeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SpecializationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "healthcare.SpecializationService",
 	HandlerType: (*SpecializationServiceServer)(nil),
@@ -665,6 +850,14 @@ var _SpecializationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSpecialization",
 			Handler:    _SpecializationService_DeleteSpecialization_Handler,
 		},
+		{
+			MethodName: "RestoreSpecialization",
+			Handler:    _SpecializationService_RestoreSpecialization_Handler,
+		},
+		{
+			MethodName: "GetSpecializationTree",
+			Handler:    _SpecializationService_GetSpecializationTree_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "healthcare-service/specialization.proto",
@@ -694,6 +887,13 @@ func (m *Specializations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintSpecialization(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.DeletedAt) > 0 {
 		i -= len(m.DeletedAt)
 		copy(dAtA[i:], m.DeletedAt)
@@ -916,6 +1116,13 @@ func (m *GetAllSpecialization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintSpecialization(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.DepartmentId) > 0 {
 		i -= len(m.DepartmentId)
 		copy(dAtA[i:], m.DepartmentId)
@@ -967,6 +1174,74 @@ func (m *GetAllSpecialization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SpecializationTreeReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecializationTreeReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecializationTreeReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RootId) > 0 {
+		i -= len(m.RootId)
+		copy(dAtA[i:], m.RootId)
+		i = encodeVarintSpecialization(dAtA, i, uint64(len(m.RootId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreSpecializationReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreSpecializationReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreSpecializationReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintSpecialization(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSpecialization(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSpecialization(v)
 	base := offset
@@ -1019,6 +1294,10 @@ func (m *Specializations) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSpecialization(uint64(l))
 	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovSpecialization(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1115,6 +1394,42 @@ func (m *GetAllSpecialization) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSpecialization(uint64(l))
 	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovSpecialization(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpecializationTreeReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootId)
+	if l > 0 {
+		n += 1 + l + sovSpecialization(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreSpecializationReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSpecialization(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1431,6 +1746,38 @@ func (m *Specializations) Unmarshal(dAtA []byte) error {
 			}
 			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecialization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecialization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecialization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecialization(dAtA[iNdEx:])
@@ -1978,6 +2325,204 @@ func (m *GetAllSpecialization) Unmarshal(dAtA []byte) error {
 			}
 			m.DepartmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecialization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecialization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecialization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecialization(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpecialization
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpecializationTreeReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecialization
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecializationTreeReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecializationTreeReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecialization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecialization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecialization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecialization(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpecialization
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreSpecializationReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecialization
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreSpecializationReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreSpecializationReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecialization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecialization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecialization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecialization(dAtA[iNdEx:])
@@ -28,23 +28,28 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type Appointment struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	DepartmentId         string   `protobuf:"bytes,2,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
-	DoctorId             string   `protobuf:"bytes,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
-	PatientId            string   `protobuf:"bytes,4,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
-	DoctorServiceId      string   `protobuf:"bytes,5,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
-	AppointmentDate      string   `protobuf:"bytes,6,opt,name=appointment_date,json=appointmentDate,proto3" json:"appointment_date"`
-	AppointmentTime      string   `protobuf:"bytes,7,opt,name=appointment_time,json=appointmentTime,proto3" json:"appointment_time"`
-	Duration             int64    `protobuf:"varint,8,opt,name=duration,proto3" json:"duration"`
-	Key                  string   `protobuf:"bytes,9,opt,name=key,proto3" json:"key"`
-	ExpiresAt            string   `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at"`
-	PatientProblem       string   `protobuf:"bytes,11,opt,name=patient_problem,json=patientProblem,proto3" json:"patient_problem"`
-	Status               string   `protobuf:"bytes,12,opt,name=status,proto3" json:"status"`
-	PaymentType          string   `protobuf:"bytes,13,opt,name=payment_type,json=paymentType,proto3" json:"payment_type"`
-	PaymentAmount        float32  `protobuf:"fixed32,14,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount"`
-	CreatedAt            string   `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	DeletedAt            string   `protobuf:"bytes,17,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	Id              int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	DepartmentId    string  `protobuf:"bytes,2,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	DoctorId        string  `protobuf:"bytes,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	PatientId       string  `protobuf:"bytes,4,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	DoctorServiceId string  `protobuf:"bytes,5,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	AppointmentDate string  `protobuf:"bytes,6,opt,name=appointment_date,json=appointmentDate,proto3" json:"appointment_date"`
+	AppointmentTime string  `protobuf:"bytes,7,opt,name=appointment_time,json=appointmentTime,proto3" json:"appointment_time"`
+	Duration        int64   `protobuf:"varint,8,opt,name=duration,proto3" json:"duration"`
+	Key             string  `protobuf:"bytes,9,opt,name=key,proto3" json:"key"`
+	ExpiresAt       string  `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at"`
+	PatientProblem  string  `protobuf:"bytes,11,opt,name=patient_problem,json=patientProblem,proto3" json:"patient_problem"`
+	Status          string  `protobuf:"bytes,12,opt,name=status,proto3" json:"status"`
+	PaymentType     string  `protobuf:"bytes,13,opt,name=payment_type,json=paymentType,proto3" json:"payment_type"`
+	PaymentAmount   float32 `protobuf:"fixed32,14,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount"`
+	CreatedAt       string  `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt       string  `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt       string  `protobuf:"bytes,17,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	// resource_id is the room or equipment reserved for the appointment, set when the appointment is created
+	ResourceId string `protobuf:"bytes,18,opt,name=resource_id,json=resourceId,proto3" json:"resource_id"`
+	// promo_code applied when the appointment was created and the discount it took off payment_amount
+	PromoCode            string   `protobuf:"bytes,19,opt,name=promo_code,json=promoCode,proto3" json:"promo_code"`
+	DiscountAmount       float32  `protobuf:"fixed32,20,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -202,6 +207,27 @@ func (m *Appointment) GetDeletedAt() string {
 	return ""
 }
 
+func (m *Appointment) GetResourceId() string {
+	if m != nil {
+		return m.ResourceId
+	}
+	return ""
+}
+
+func (m *Appointment) GetPromoCode() string {
+	if m != nil {
+		return m.PromoCode
+	}
+	return ""
+}
+
+func (m *Appointment) GetDiscountAmount() float32 {
+	if m != nil {
+		return m.DiscountAmount
+	}
+	return 0
+}
+
 type Appointments struct {
 	Count                int64          `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
 	Appointments         []*Appointment `protobuf:"bytes,2,rep,name=appointments,proto3" json:"appointments"`
@@ -258,19 +284,23 @@ func (m *Appointments) GetAppointments() []*Appointment {
 }
 
 type CreateAppointmentReq struct {
-	DepartmentId         string   `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
-	DoctorId             string   `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
-	PatientId            string   `protobuf:"bytes,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
-	DoctorServiceId      string   `protobuf:"bytes,4,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
-	AppointmentDate      string   `protobuf:"bytes,5,opt,name=appointment_date,json=appointmentDate,proto3" json:"appointment_date"`
-	AppointmentTime      string   `protobuf:"bytes,6,opt,name=appointment_time,json=appointmentTime,proto3" json:"appointment_time"`
-	Duration             int64    `protobuf:"varint,7,opt,name=duration,proto3" json:"duration"`
-	Key                  string   `protobuf:"bytes,8,opt,name=key,proto3" json:"key"`
-	ExpiresAt            string   `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at"`
-	PatientProblem       string   `protobuf:"bytes,10,opt,name=patient_problem,json=patientProblem,proto3" json:"patient_problem"`
-	Status               string   `protobuf:"bytes,11,opt,name=status,proto3" json:"status"`
-	PaymentType          string   `protobuf:"bytes,12,opt,name=payment_type,json=paymentType,proto3" json:"payment_type"`
-	PaymentAmount        float32  `protobuf:"fixed32,13,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount"`
+	DepartmentId    string  `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	DoctorId        string  `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	PatientId       string  `protobuf:"bytes,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	DoctorServiceId string  `protobuf:"bytes,4,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	AppointmentDate string  `protobuf:"bytes,5,opt,name=appointment_date,json=appointmentDate,proto3" json:"appointment_date"`
+	AppointmentTime string  `protobuf:"bytes,6,opt,name=appointment_time,json=appointmentTime,proto3" json:"appointment_time"`
+	Duration        int64   `protobuf:"varint,7,opt,name=duration,proto3" json:"duration"`
+	Key             string  `protobuf:"bytes,8,opt,name=key,proto3" json:"key"`
+	ExpiresAt       string  `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at"`
+	PatientProblem  string  `protobuf:"bytes,10,opt,name=patient_problem,json=patientProblem,proto3" json:"patient_problem"`
+	Status          string  `protobuf:"bytes,11,opt,name=status,proto3" json:"status"`
+	PaymentType     string  `protobuf:"bytes,12,opt,name=payment_type,json=paymentType,proto3" json:"payment_type"`
+	PaymentAmount   float32 `protobuf:"fixed32,13,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount"`
+	// repeated requests with the same key return the appointment created by the first one
+	IdempotencyKey string `protobuf:"bytes,14,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key"`
+	// promo_code takes its discount off payment_amount
+	PromoCode            string   `protobuf:"bytes,15,opt,name=promo_code,json=promoCode,proto3" json:"promo_code"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -400,6 +430,20 @@ func (m *CreateAppointmentReq) GetPaymentAmount() float32 {
 	return 0
 }
 
+func (m *CreateAppointmentReq) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+func (m *CreateAppointmentReq) GetPromoCode() string {
+	if m != nil {
+		return m.PromoCode
+	}
+	return ""
+}
+
 type UpdateAppointmentReq struct {
 	DepartmentId         string   `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
 	DoctorId             string   `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
@@ -851,6 +895,159 @@ func (m *GetFilteredRequest) GetStatus() string {
 	return ""
 }
 
+// DoctorAppointmentsReq lists the appointments of the doctor starting from from, before to when it is set,
+// both formatted 2006-01-02 15:04:05
+type DoctorAppointmentsReq struct {
+	DoctorId             string   `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to"`
+	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status"`
+	Page                 uint64   `protobuf:"varint,5,opt,name=page,proto3" json:"page"`
+	Limit                uint64   `protobuf:"varint,6,opt,name=limit,proto3" json:"limit"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorAppointmentsReq) Reset()         { *m = DoctorAppointmentsReq{} }
+func (m *DoctorAppointmentsReq) String() string { return proto.CompactTextString(m) }
+func (*DoctorAppointmentsReq) ProtoMessage()    {}
+func (*DoctorAppointmentsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ede99e18a76dc86, []int{8}
+}
+func (m *DoctorAppointmentsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorAppointmentsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorAppointmentsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoctorAppointmentsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorAppointmentsReq.Merge(m, src)
+}
+func (m *DoctorAppointmentsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorAppointmentsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorAppointmentsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorAppointmentsReq proto.InternalMessageInfo
+
+func (m *DoctorAppointmentsReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *DoctorAppointmentsReq) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *DoctorAppointmentsReq) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *DoctorAppointmentsReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DoctorAppointmentsReq) GetPage() uint64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *DoctorAppointmentsReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// MarkAttendanceReq marks an appointment of the doctor attended or no_show
+type MarkAttendanceReq struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	DoctorId             string   `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarkAttendanceReq) Reset()         { *m = MarkAttendanceReq{} }
+func (m *MarkAttendanceReq) String() string { return proto.CompactTextString(m) }
+func (*MarkAttendanceReq) ProtoMessage()    {}
+func (*MarkAttendanceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ede99e18a76dc86, []int{9}
+}
+func (m *MarkAttendanceReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkAttendanceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkAttendanceReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkAttendanceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkAttendanceReq.Merge(m, src)
+}
+func (m *MarkAttendanceReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkAttendanceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkAttendanceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkAttendanceReq proto.InternalMessageInfo
+
+func (m *MarkAttendanceReq) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MarkAttendanceReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *MarkAttendanceReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Appointment)(nil), "booking_service.Appointment")
 	proto.RegisterType((*Appointments)(nil), "booking_service.Appointments")
@@ -860,6 +1057,8 @@ func init() {
 	proto.RegisterType((*DeleteAppointmentStatus)(nil), "booking_service.DeleteAppointmentStatus")
 	proto.RegisterType((*GetAllAppointmentsReq)(nil), "booking_service.GetAllAppointmentsReq")
 	proto.RegisterType((*GetFilteredRequest)(nil), "booking_service.GetFilteredRequest")
+	proto.RegisterType((*DoctorAppointmentsReq)(nil), "booking_service.DoctorAppointmentsReq")
+	proto.RegisterType((*MarkAttendanceReq)(nil), "booking_service.MarkAttendanceReq")
 }
 
 func init() {
@@ -867,56 +1066,67 @@ func init() {
 }
 
 var fileDescriptor_8ede99e18a76dc86 = []byte{
-	// 772 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0x5d, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x71, 0x92, 0xe6, 0x63, 0xf2, 0xbd, 0x2a, 0xd4, 0x2d, 0x34, 0x0a, 0xae, 0x0a, 0x29,
-	0x0f, 0x45, 0x94, 0x0b, 0x90, 0x52, 0xb5, 0xca, 0x1b, 0x72, 0x0b, 0x02, 0x24, 0x14, 0x39, 0xd9,
-	0x69, 0x59, 0xd5, 0x89, 0x5d, 0x7b, 0x53, 0x91, 0x9b, 0x70, 0x01, 0x8e, 0xc0, 0x0b, 0x27, 0xe0,
-	0x0d, 0xb8, 0x01, 0x2a, 0xe7, 0x40, 0x42, 0xfb, 0xd1, 0xd6, 0x8d, 0xdd, 0x24, 0x48, 0x3c, 0x20,
-	0xc4, 0x5b, 0xe6, 0x3f, 0xff, 0xdd, 0xcc, 0xce, 0xfe, 0x3c, 0x36, 0x6c, 0xf4, 0x3c, 0xef, 0x98,
-	0x0d, 0x8f, 0xba, 0x21, 0x06, 0xa7, 0xac, 0x8f, 0x0f, 0x45, 0x8c, 0xb4, 0xeb, 0xf8, 0xbe, 0xc7,
-	0x86, 0x7c, 0x80, 0x43, 0x1e, 0x6e, 0xfa, 0x81, 0xc7, 0x3d, 0x52, 0x9d, 0xb0, 0x5a, 0x1f, 0x33,
-	0x50, 0x6c, 0x5f, 0xfa, 0x48, 0x05, 0x52, 0x8c, 0x9a, 0x46, 0xd3, 0x68, 0xa5, 0xed, 0x14, 0xa3,
-	0x64, 0x0d, 0xca, 0x14, 0x7d, 0x27, 0x90, 0xd9, 0x2e, 0xa3, 0x66, 0xaa, 0x69, 0xb4, 0x0a, 0x76,
-	0xe9, 0x52, 0xec, 0x50, 0x72, 0x1b, 0x0a, 0xd4, 0xeb, 0x73, 0x2f, 0x10, 0x86, 0xb4, 0x34, 0xe4,
-	0x95, 0xd0, 0xa1, 0x64, 0x15, 0xc0, 0x77, 0x38, 0xd3, 0xcb, 0x33, 0x32, 0x5b, 0xd0, 0x4a, 0x87,
-	0x92, 0x07, 0x50, 0xd7, 0x6b, 0x75, 0x49, 0xc2, 0xb5, 0x20, 0x5d, 0x55, 0x95, 0xd8, 0x57, 0x7a,
-	0x87, 0x92, 0x0d, 0xa8, 0x45, 0xce, 0xd4, 0xa5, 0x0e, 0x47, 0x33, 0xab, 0xac, 0x11, 0x7d, 0xc7,
-	0xe1, 0x38, 0x69, 0xe5, 0x6c, 0x80, 0x66, 0x2e, 0x66, 0x3d, 0x60, 0x03, 0x24, 0x2b, 0x90, 0xa7,
-	0xa3, 0xc0, 0xe1, 0xcc, 0x1b, 0x9a, 0x79, 0x79, 0xf0, 0x8b, 0x98, 0xd4, 0x20, 0x7d, 0x8c, 0x63,
-	0xb3, 0x20, 0x57, 0x8a, 0x9f, 0xe2, 0x38, 0xf8, 0xce, 0x67, 0x01, 0x86, 0x5d, 0x87, 0x9b, 0xa0,
-	0x8e, 0xa3, 0x95, 0x36, 0x27, 0xf7, 0xa1, 0x7a, 0x7e, 0x5a, 0x3f, 0xf0, 0x7a, 0x2e, 0x0e, 0xcc,
-	0xa2, 0xf4, 0x54, 0xb4, 0xfc, 0x4c, 0xa9, 0xe4, 0x16, 0x64, 0x43, 0xee, 0xf0, 0x51, 0x68, 0x96,
-	0x64, 0x5e, 0x47, 0xe4, 0x2e, 0x94, 0x7c, 0x67, 0xac, 0x8a, 0x1e, 0xfb, 0x68, 0x96, 0x65, 0xb6,
-	0xa8, 0xb5, 0x83, 0xb1, 0x8f, 0x64, 0x1d, 0x2a, 0xe7, 0x16, 0x67, 0xe0, 0x8d, 0x86, 0xdc, 0xac,
-	0x34, 0x8d, 0x56, 0xca, 0x2e, 0x6b, 0xb5, 0x2d, 0x45, 0x51, 0x69, 0x3f, 0x40, 0x87, 0x0b, 0x12,
-	0xb8, 0x59, 0x55, 0x95, 0x6a, 0xa5, 0x2d, 0xd3, 0x23, 0x9f, 0x9e, 0xa7, 0x6b, 0x2a, 0xad, 0x15,
-	0x95, 0xa6, 0xe8, 0xa2, 0x4e, 0xd7, 0x55, 0x5a, 0x2b, 0x6d, 0x6e, 0x1d, 0x42, 0x29, 0x82, 0x4d,
-	0x48, 0x16, 0x61, 0xa1, 0x2f, 0x4b, 0x51, 0xe8, 0xa8, 0x80, 0x3c, 0x81, 0x52, 0x14, 0x42, 0x33,
-	0xd5, 0x4c, 0xb7, 0x8a, 0x5b, 0x77, 0x36, 0x27, 0x28, 0xdc, 0x8c, 0x6c, 0x65, 0x5f, 0x59, 0x61,
-	0x7d, 0x4b, 0xc3, 0xe2, 0x53, 0x59, 0x73, 0xd4, 0x83, 0x27, 0x71, 0x30, 0x8d, 0x59, 0x60, 0xa6,
-	0xa6, 0x82, 0x99, 0x9e, 0x0b, 0xcc, 0xcc, 0xfc, 0x60, 0x2e, 0xcc, 0x0f, 0x66, 0x76, 0x36, 0x98,
-	0xb9, 0x64, 0x30, 0xf3, 0xd7, 0x81, 0x59, 0x98, 0x03, 0x4c, 0x98, 0x01, 0x66, 0x71, 0x2a, 0x98,
-	0xa5, 0x79, 0xc0, 0x2c, 0x27, 0x80, 0x69, 0xfd, 0x4c, 0xc3, 0xe2, 0x73, 0x09, 0xda, 0xff, 0x3b,
-	0xfd, 0x47, 0xee, 0x54, 0x3c, 0xff, 0x87, 0x0c, 0x5d, 0x2a, 0x47, 0x51, 0xc1, 0x56, 0x81, 0x50,
-	0x4f, 0x1d, 0x77, 0x84, 0x7a, 0xfa, 0xa8, 0xc0, 0xea, 0x83, 0x19, 0xb9, 0xf8, 0x5d, 0xe1, 0x7c,
-	0x21, 0x12, 0x02, 0x81, 0x8b, 0x7d, 0x8c, 0xc4, 0x7d, 0x52, 0x91, 0x7d, 0x04, 0x09, 0x2c, 0xec,
-	0x3a, 0x7d, 0xce, 0x4e, 0x51, 0xde, 0x75, 0xde, 0xce, 0xb3, 0xb0, 0x2d, 0x63, 0xeb, 0x11, 0x2c,
-	0xed, 0xc8, 0x69, 0x15, 0xf9, 0xab, 0x7d, 0x75, 0xea, 0xcb, 0x6e, 0x18, 0x72, 0x91, 0x8e, 0xac,
-	0x0f, 0x06, 0xdc, 0xdc, 0x43, 0xde, 0x76, 0xdd, 0xe8, 0x68, 0xfb, 0x93, 0x55, 0x11, 0x02, 0x19,
-	0xdf, 0x39, 0x42, 0xc9, 0x5c, 0xc6, 0x96, 0xbf, 0xc5, 0x36, 0x2e, 0x1b, 0x30, 0x2e, 0xe9, 0xca,
-	0xd8, 0x2a, 0x20, 0xcb, 0x90, 0xf7, 0x02, 0x8a, 0x41, 0xb7, 0x37, 0xd6, 0x2c, 0xe5, 0x64, 0xbc,
-	0x3d, 0xb6, 0x3e, 0x19, 0x40, 0xf6, 0x90, 0xef, 0x32, 0x97, 0x63, 0x80, 0xd4, 0xc6, 0x93, 0x11,
-	0x86, 0xfc, 0xef, 0x2a, 0x32, 0xd2, 0xe4, 0x5c, 0x14, 0xb9, 0xad, 0x2f, 0x19, 0x58, 0xde, 0x96,
-	0xdf, 0x27, 0xd1, 0x26, 0xeb, 0xc7, 0x8e, 0xbc, 0x84, 0x7a, 0x6c, 0xda, 0x93, 0xf5, 0xd8, 0xfb,
-	0x22, 0xe9, 0x8d, 0xb0, 0x32, 0xf5, 0xb5, 0x42, 0x5e, 0x41, 0x45, 0xdc, 0x6d, 0x44, 0xd9, 0x98,
-	0xe6, 0xbf, 0x42, 0xe5, 0x8c, 0xad, 0x5f, 0x43, 0x3d, 0x86, 0x0d, 0xb9, 0x17, 0x5b, 0x92, 0x88,
-	0xd6, 0xca, 0xea, 0xb4, 0xad, 0x43, 0xd1, 0x90, 0xd8, 0xa8, 0x4c, 0x68, 0x48, 0xd2, 0x38, 0x9d,
-	0x51, 0xf5, 0x5b, 0xa8, 0xc7, 0x1e, 0x90, 0xdf, 0xe9, 0x49, 0x2b, 0x66, 0xbd, 0xee, 0x79, 0x7b,
-	0x03, 0x4b, 0x11, 0x5c, 0xaf, 0x1c, 0x6f, 0x2d, 0xa9, 0x4b, 0x13, 0x60, 0xcf, 0x68, 0xd1, 0x76,
-	0xed, 0xf3, 0x59, 0xc3, 0xf8, 0x7a, 0xd6, 0x30, 0xbe, 0x9f, 0x35, 0x8c, 0xf7, 0x3f, 0x1a, 0x37,
-	0x7a, 0x59, 0xf9, 0xb1, 0xfb, 0xf8, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbb, 0x9a, 0x3e, 0x4b,
-	0x19, 0x0b, 0x00, 0x00,
+	// 948 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x66, 0xfd, 0x17, 0xfb, 0xd8, 0xb1, 0xe3, 0xc1, 0x6d, 0xb7, 0x81, 0x86, 0xb0, 0x55, 0xa9,
+	0xc3, 0x45, 0x10, 0xe5, 0x05, 0x70, 0x1a, 0xb5, 0x8a, 0x00, 0x09, 0x6d, 0x0b, 0x2a, 0x48, 0x68,
+	0xb5, 0xd9, 0x39, 0x29, 0xa3, 0x78, 0x3d, 0xdb, 0xd9, 0x71, 0x84, 0xef, 0x79, 0x08, 0xe0, 0x82,
+	0x3b, 0x5e, 0x82, 0x27, 0xe0, 0x92, 0x47, 0x40, 0xe1, 0x39, 0x90, 0xd0, 0xfc, 0xb8, 0x1e, 0x7b,
+	0xb7, 0xb6, 0x91, 0xb8, 0x40, 0xa8, 0x77, 0x3e, 0xdf, 0x39, 0x33, 0x7b, 0xbe, 0x33, 0xdf, 0xb7,
+	0xb3, 0x86, 0xa3, 0x73, 0xce, 0x2f, 0xd9, 0xe4, 0x79, 0x94, 0xa3, 0xb8, 0x62, 0x09, 0x7e, 0xa0,
+	0x62, 0xa4, 0x51, 0x9c, 0x65, 0x9c, 0x4d, 0x64, 0x8a, 0x13, 0x99, 0x1f, 0x67, 0x82, 0x4b, 0x4e,
+	0x7a, 0x2b, 0xa5, 0xc1, 0xcf, 0x75, 0x68, 0x8f, 0x16, 0x75, 0xa4, 0x0b, 0x15, 0x46, 0x7d, 0xef,
+	0xd0, 0x1b, 0x56, 0xc3, 0x0a, 0xa3, 0xe4, 0x2e, 0xec, 0x52, 0xcc, 0x62, 0xa1, 0xb3, 0x11, 0xa3,
+	0x7e, 0xe5, 0xd0, 0x1b, 0xb6, 0xc2, 0xce, 0x02, 0x3c, 0xa3, 0xe4, 0x2d, 0x68, 0x51, 0x9e, 0x48,
+	0x2e, 0x54, 0x41, 0x55, 0x17, 0x34, 0x0d, 0x70, 0x46, 0xc9, 0x1d, 0x80, 0x2c, 0x96, 0xcc, 0x2e,
+	0xaf, 0xe9, 0x6c, 0xcb, 0x22, 0x67, 0x94, 0xbc, 0x0f, 0x7d, 0xbb, 0xd6, 0xb6, 0xa4, 0xaa, 0xea,
+	0xba, 0xaa, 0x67, 0x12, 0x4f, 0x0c, 0x7e, 0x46, 0xc9, 0x11, 0xec, 0x39, 0x9c, 0x22, 0x1a, 0x4b,
+	0xf4, 0x1b, 0xa6, 0xd4, 0xc1, 0x4f, 0x63, 0x89, 0xab, 0xa5, 0x92, 0xa5, 0xe8, 0xef, 0x14, 0x4a,
+	0x9f, 0xb2, 0x14, 0xc9, 0x3e, 0x34, 0xe9, 0x54, 0xc4, 0x92, 0xf1, 0x89, 0xdf, 0xd4, 0xc4, 0x5f,
+	0xc6, 0x64, 0x0f, 0xaa, 0x97, 0x38, 0xf3, 0x5b, 0x7a, 0xa5, 0xfa, 0xa9, 0xe8, 0xe0, 0x77, 0x19,
+	0x13, 0x98, 0x47, 0xb1, 0xf4, 0xc1, 0xd0, 0xb1, 0xc8, 0x48, 0x92, 0xfb, 0xd0, 0x9b, 0xb3, 0xcd,
+	0x04, 0x3f, 0x1f, 0x63, 0xea, 0xb7, 0x75, 0x4d, 0xd7, 0xc2, 0x9f, 0x1b, 0x94, 0xdc, 0x84, 0x46,
+	0x2e, 0x63, 0x39, 0xcd, 0xfd, 0x8e, 0xce, 0xdb, 0x88, 0xbc, 0x0b, 0x9d, 0x2c, 0x9e, 0x99, 0xa6,
+	0x67, 0x19, 0xfa, 0xbb, 0x3a, 0xdb, 0xb6, 0xd8, 0xd3, 0x59, 0x86, 0xe4, 0x1e, 0x74, 0xe7, 0x25,
+	0x71, 0xca, 0xa7, 0x13, 0xe9, 0x77, 0x0f, 0xbd, 0x61, 0x25, 0xdc, 0xb5, 0xe8, 0x48, 0x83, 0xaa,
+	0xd3, 0x44, 0x60, 0x2c, 0x95, 0x12, 0xa4, 0xdf, 0x33, 0x9d, 0x5a, 0x64, 0xa4, 0xd3, 0xd3, 0x8c,
+	0xce, 0xd3, 0x7b, 0x26, 0x6d, 0x11, 0x93, 0xa6, 0x38, 0x46, 0x9b, 0xee, 0x9b, 0xb4, 0x45, 0x46,
+	0x92, 0xbc, 0x03, 0x6d, 0x81, 0x39, 0x9f, 0x0a, 0x73, 0x60, 0x44, 0xe7, 0x61, 0x0e, 0xd9, 0x63,
+	0x17, 0x3c, 0xe5, 0x51, 0xc2, 0x29, 0xfa, 0x6f, 0xda, 0x63, 0x57, 0xc8, 0x43, 0x4e, 0x51, 0xcd,
+	0x89, 0xb2, 0x3c, 0xe1, 0xd3, 0x05, 0x89, 0x81, 0x26, 0xd1, 0x9d, 0xc3, 0x86, 0x45, 0x70, 0x01,
+	0x1d, 0x47, 0x9f, 0x39, 0x19, 0x40, 0x5d, 0xa7, 0xad, 0x46, 0x4d, 0x40, 0x3e, 0x86, 0x8e, 0xab,
+	0x76, 0xbf, 0x72, 0x58, 0x1d, 0xb6, 0x1f, 0xbc, 0x7d, 0xbc, 0x22, 0xf7, 0x63, 0x67, 0xab, 0x70,
+	0x69, 0x45, 0xf0, 0x63, 0x0d, 0x06, 0x0f, 0xf5, 0x70, 0xdc, 0x1a, 0x7c, 0x51, 0x74, 0x80, 0xb7,
+	0xc9, 0x01, 0x95, 0xb5, 0x0e, 0xa8, 0x6e, 0xe5, 0x80, 0xda, 0xf6, 0x0e, 0xa8, 0x6f, 0xef, 0x80,
+	0xc6, 0x66, 0x07, 0xec, 0x94, 0x3b, 0xa0, 0xf9, 0x2a, 0x07, 0xb4, 0xb6, 0x70, 0x00, 0x6c, 0x70,
+	0x40, 0x7b, 0xad, 0x03, 0x3a, 0xdb, 0x38, 0x60, 0xb7, 0xcc, 0x01, 0xf7, 0xa1, 0xc7, 0x28, 0xa6,
+	0x19, 0x97, 0x38, 0x49, 0x66, 0x91, 0xe2, 0xd1, 0x35, 0xad, 0x38, 0xf0, 0x27, 0x86, 0x92, 0x23,
+	0xd6, 0xde, 0x8a, 0x58, 0x83, 0xbf, 0xaa, 0x30, 0xf8, 0x22, 0xa3, 0xaf, 0xb5, 0xf1, 0x3f, 0xd2,
+	0xc6, 0x00, 0xea, 0x17, 0x0c, 0xc7, 0xd4, 0x2a, 0xc2, 0x04, 0x0a, 0xbd, 0x8a, 0xc7, 0xd3, 0xb9,
+	0x06, 0x4c, 0x10, 0x24, 0xe0, 0x3b, 0x07, 0xff, 0x48, 0x55, 0x7e, 0xa9, 0x12, 0x4a, 0x02, 0x2f,
+	0xf7, 0xf1, 0x4a, 0xf7, 0xa9, 0x38, 0xfb, 0x28, 0x25, 0xb0, 0x3c, 0x8a, 0x13, 0xc9, 0xae, 0x50,
+	0x9f, 0x75, 0x33, 0x6c, 0xb2, 0x7c, 0xa4, 0xe3, 0xe0, 0x43, 0xb8, 0x75, 0xaa, 0x5f, 0xaf, 0xce,
+	0xa3, 0x9e, 0x18, 0xd6, 0x8b, 0x69, 0x78, 0x7a, 0x91, 0x8d, 0x82, 0x5f, 0x3c, 0xb8, 0xf1, 0x18,
+	0xe5, 0x68, 0x3c, 0x76, 0xd6, 0xe4, 0xff, 0x66, 0x57, 0x84, 0x40, 0x2d, 0x8b, 0x9f, 0xa3, 0xd6,
+	0x5c, 0x2d, 0xd4, 0xbf, 0xd5, 0x36, 0x63, 0x96, 0x32, 0xa9, 0xd5, 0x55, 0x0b, 0x4d, 0x40, 0x6e,
+	0x43, 0x93, 0x0b, 0x8a, 0x22, 0x3a, 0x9f, 0x59, 0x2d, 0xed, 0xe8, 0xf8, 0x64, 0x16, 0xfc, 0xea,
+	0x01, 0x79, 0x8c, 0xf2, 0x11, 0x1b, 0x4b, 0x14, 0x48, 0x43, 0x7c, 0x31, 0xc5, 0x5c, 0xfe, 0xb7,
+	0x9a, 0x74, 0x86, 0xbc, 0xe3, 0x4a, 0x2e, 0xf8, 0xc9, 0x83, 0x1b, 0xa7, 0xda, 0x6a, 0xab, 0x43,
+	0x5e, 0x32, 0xb6, 0xb7, 0x62, 0x6c, 0x02, 0xb5, 0x0b, 0xc1, 0x53, 0xcb, 0x42, 0xff, 0x56, 0x1f,
+	0x57, 0x92, 0x5b, 0x93, 0x57, 0x24, 0x77, 0x1e, 0x59, 0x5b, 0x52, 0xf9, 0x9c, 0x4f, 0xbd, 0x8c,
+	0x4f, 0xc3, 0xe1, 0x13, 0x3c, 0x83, 0xfe, 0x67, 0xb1, 0xb8, 0x1c, 0x49, 0x89, 0x13, 0x1a, 0x4f,
+	0x12, 0x2d, 0xc9, 0xd5, 0x6f, 0xb8, 0xb5, 0x2f, 0xa0, 0x45, 0x0f, 0x55, 0xb7, 0x87, 0x07, 0xdf,
+	0x37, 0xe0, 0xf6, 0x89, 0xfe, 0x8e, 0x74, 0x69, 0xdb, 0xb7, 0x0d, 0x79, 0x06, 0xfd, 0xc2, 0x65,
+	0x49, 0xee, 0x15, 0xae, 0xdb, 0xb2, 0x0b, 0x75, 0x7f, 0xed, 0xad, 0x4c, 0xbe, 0x82, 0xae, 0x92,
+	0xb4, 0x83, 0x1c, 0xad, 0xab, 0x5f, 0x32, 0xe3, 0x86, 0xad, 0xbf, 0x86, 0x7e, 0xc1, 0x2d, 0xe4,
+	0xbd, 0xc2, 0x92, 0x52, 0x47, 0xed, 0xdf, 0x59, 0xb7, 0x75, 0xae, 0x06, 0x52, 0xb8, 0x21, 0x4a,
+	0x06, 0x52, 0x76, 0x8b, 0x6c, 0xe8, 0xfa, 0x5b, 0xe8, 0x17, 0xde, 0x0b, 0xff, 0x64, 0x26, 0xc3,
+	0x42, 0xe9, 0xab, 0x5e, 0x33, 0xdf, 0xc0, 0x2d, 0xc7, 0xa5, 0x4b, 0xf4, 0xee, 0x96, 0x4d, 0x69,
+	0xc5, 0xcf, 0x9b, 0x46, 0x14, 0xc1, 0xcd, 0x4f, 0x59, 0x2e, 0x8b, 0x5e, 0x2a, 0x39, 0x83, 0x52,
+	0xc3, 0x6d, 0x7a, 0x40, 0x08, 0xdd, 0x65, 0x33, 0x90, 0xa0, 0xb0, 0xa0, 0xe0, 0x96, 0xf5, 0xd3,
+	0x3f, 0xd9, 0xfb, 0xed, 0xfa, 0xc0, 0xfb, 0xfd, 0xfa, 0xc0, 0xfb, 0xe3, 0xfa, 0xc0, 0xfb, 0xe1,
+	0xcf, 0x83, 0x37, 0xce, 0x1b, 0xfa, 0x9f, 0xd4, 0x47, 0x7f, 0x0f, 0x00, 0xaa, 0x17, 0x5f, 0x66,
+	0x76, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -938,6 +1148,8 @@ type BookedAppointmentsServiceClient interface {
 	UpdateAppointment(ctx context.Context, in *UpdateAppointmentReq, opts ...grpc.CallOption) (*Appointment, error)
 	DeleteAppointment(ctx context.Context, in *AppointmentFieldValueReq, opts ...grpc.CallOption) (*DeleteAppointmentStatus, error)
 	GetFilteredAppointments(ctx context.Context, in *GetFilteredRequest, opts ...grpc.CallOption) (*Appointments, error)
+	ListDoctorAppointments(ctx context.Context, in *DoctorAppointmentsReq, opts ...grpc.CallOption) (*Appointments, error)
+	MarkAttendance(ctx context.Context, in *MarkAttendanceReq, opts ...grpc.CallOption) (*Appointment, error)
 }
 
 type bookedAppointmentsServiceClient struct {
@@ -1002,6 +1214,24 @@ func (c *bookedAppointmentsServiceClient) GetFilteredAppointments(ctx context.Co
 	return out, nil
 }
 
+func (c *bookedAppointmentsServiceClient) ListDoctorAppointments(ctx context.Context, in *DoctorAppointmentsReq, opts ...grpc.CallOption) (*Appointments, error) {
+	out := new(Appointments)
+	err := c.cc.Invoke(ctx, "/booking_service.BookedAppointmentsService/ListDoctorAppointments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookedAppointmentsServiceClient) MarkAttendance(ctx context.Context, in *MarkAttendanceReq, opts ...grpc.CallOption) (*Appointment, error) {
+	out := new(Appointment)
+	err := c.cc.Invoke(ctx, "/booking_service.BookedAppointmentsService/MarkAttendance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookedAppointmentsServiceServer is the server API for BookedAppointmentsService service.
 type BookedAppointmentsServiceServer interface {
 	// bookedAppointments
@@ -1011,6 +1241,8 @@ type BookedAppointmentsServiceServer interface {
 	UpdateAppointment(context.Context, *UpdateAppointmentReq) (*Appointment, error)
 	DeleteAppointment(context.Context, *AppointmentFieldValueReq) (*DeleteAppointmentStatus, error)
 	GetFilteredAppointments(context.Context, *GetFilteredRequest) (*Appointments, error)
+	ListDoctorAppointments(context.Context, *DoctorAppointmentsReq) (*Appointments, error)
+	MarkAttendance(context.Context, *MarkAttendanceReq) (*Appointment, error)
 }
 
 // UnimplementedBookedAppointmentsServiceServer can be embedded to have forward compatible implementations.
@@ -1035,6 +1267,12 @@ func (*UnimplementedBookedAppointmentsServiceServer) DeleteAppointment(ctx conte
 func (*UnimplementedBookedAppointmentsServiceServer) GetFilteredAppointments(ctx context.Context, req *GetFilteredRequest) (*Appointments, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFilteredAppointments not implemented")
 }
+func (*UnimplementedBookedAppointmentsServiceServer) ListDoctorAppointments(ctx context.Context, req *DoctorAppointmentsReq) (*Appointments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDoctorAppointments not implemented")
+}
+func (*UnimplementedBookedAppointmentsServiceServer) MarkAttendance(ctx context.Context, req *MarkAttendanceReq) (*Appointment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAttendance not implemented")
+}
 
 func RegisterBookedAppointmentsServiceServer(s *grpc.Server, srv BookedAppointmentsServiceServer) {
 	s.RegisterService(&_BookedAppointmentsService_serviceDesc, srv)
@@ -1148,6 +1386,42 @@ func _BookedAppointmentsService_GetFilteredAppointments_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookedAppointmentsService_ListDoctorAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorAppointmentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookedAppointmentsServiceServer).ListDoctorAppointments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.BookedAppointmentsService/ListDoctorAppointments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookedAppointmentsServiceServer).ListDoctorAppointments(ctx, req.(*DoctorAppointmentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookedAppointmentsService_MarkAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAttendanceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookedAppointmentsServiceServer).MarkAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.BookedAppointmentsService/MarkAttendance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookedAppointmentsServiceServer).MarkAttendance(ctx, req.(*MarkAttendanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _BookedAppointmentsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "booking_service.BookedAppointmentsService",
 	HandlerType: (*BookedAppointmentsServiceServer)(nil),
@@ -1176,6 +1450,14 @@ var _BookedAppointmentsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFilteredAppointments",
 			Handler:    _BookedAppointmentsService_GetFilteredAppointments_Handler,
 		},
+		{
+			MethodName: "ListDoctorAppointments",
+			Handler:    _BookedAppointmentsService_ListDoctorAppointments_Handler,
+		},
+		{
+			MethodName: "MarkAttendance",
+			Handler:    _BookedAppointmentsService_MarkAttendance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "booking_service/booked_appointments.proto",
@@ -1205,6 +1487,32 @@ func (m *Appointment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DiscountAmount != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.DiscountAmount))))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa5
+	}
+	if len(m.PromoCode) > 0 {
+		i -= len(m.PromoCode)
+		copy(dAtA[i:], m.PromoCode)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.PromoCode)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.ResourceId) > 0 {
+		i -= len(m.ResourceId)
+		copy(dAtA[i:], m.ResourceId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.ResourceId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
 	if len(m.DeletedAt) > 0 {
 		i -= len(m.DeletedAt)
 		copy(dAtA[i:], m.DeletedAt)
@@ -1396,6 +1704,20 @@ func (m *CreateAppointmentReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PromoCode) > 0 {
+		i -= len(m.PromoCode)
+		copy(dAtA[i:], m.PromoCode)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.PromoCode)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.IdempotencyKey) > 0 {
+		i -= len(m.IdempotencyKey)
+		copy(dAtA[i:], m.IdempotencyKey)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.PaymentAmount != 0 {
 		i -= 4
 		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.PaymentAmount))))
@@ -1847,40 +2169,151 @@ func (m *GetFilteredRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBookedAppointments(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBookedAppointments(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DoctorAppointmentsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Appointment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DoctorAppointmentsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorAppointmentsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovBookedAppointments(uint64(m.Id))
-	}
-	l = len(m.DepartmentId)
-	if l > 0 {
-		n += 1 + l + sovBookedAppointments(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.DoctorId)
-	if l > 0 {
-		n += 1 + l + sovBookedAppointments(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.PatientId)
-	if l > 0 {
-		n += 1 + l + sovBookedAppointments(uint64(l))
+	if m.Page != 0 {
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.DoctorServiceId)
-	if l > 0 {
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkAttendanceReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkAttendanceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkAttendanceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBookedAppointments(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBookedAppointments(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Appointment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBookedAppointments(uint64(m.Id))
+	}
+	l = len(m.DepartmentId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
 		n += 1 + l + sovBookedAppointments(uint64(l))
 	}
 	l = len(m.AppointmentDate)
@@ -1929,6 +2362,17 @@ func (m *Appointment) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovBookedAppointments(uint64(l))
 	}
+	l = len(m.ResourceId)
+	if l > 0 {
+		n += 2 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.PromoCode)
+	if l > 0 {
+		n += 2 + l + sovBookedAppointments(uint64(l))
+	}
+	if m.DiscountAmount != 0 {
+		n += 6
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2012,6 +2456,14 @@ func (m *CreateAppointmentReq) Size() (n int) {
 	if m.PaymentAmount != 0 {
 		n += 5
 	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.PromoCode)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2196,6 +2648,63 @@ func (m *GetFilteredRequest) Size() (n int) {
 	return n
 }
 
+func (m *DoctorAppointmentsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	if m.Page != 0 {
+		n += 1 + sovBookedAppointments(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovBookedAppointments(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MarkAttendanceReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBookedAppointments(uint64(m.Id))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovBookedAppointments(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2728,6 +3237,81 @@ func (m *Appointment) Unmarshal(dAtA []byte) error {
 			}
 			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromoCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscountAmount", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.DiscountAmount = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
@@ -3265,16 +3849,80 @@ func (m *CreateAppointmentReq) Unmarshal(dAtA []byte) error {
 			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
 			iNdEx += 4
 			m.PaymentAmount = float32(math.Float32frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBookedAppointments
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromoCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
@@ -4432,6 +5080,357 @@ func (m *GetFilteredRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DoctorAppointmentsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBookedAppointments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorAppointmentsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorAppointmentsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkAttendanceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBookedAppointments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkAttendanceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkAttendanceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBookedAppointments(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
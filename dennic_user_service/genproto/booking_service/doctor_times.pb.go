@@ -587,6 +587,181 @@ func (m *GetAllDoctorTimesReq) GetOrderBy() string {
 	return ""
 }
 
+// LeaveBlocksReq blocks every day from start_date to end_date "2006-01-02" of an approved leave
+type LeaveBlocksReq struct {
+	LeaveId              int64    `protobuf:"varint,1,opt,name=leave_id,json=leaveId,proto3" json:"leave_id"`
+	DepartmentId         string   `protobuf:"bytes,2,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	DoctorId             string   `protobuf:"bytes,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	StartDate            string   `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date"`
+	EndDate              string   `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaveBlocksReq) Reset()         { *m = LeaveBlocksReq{} }
+func (m *LeaveBlocksReq) String() string { return proto.CompactTextString(m) }
+func (*LeaveBlocksReq) ProtoMessage()    {}
+func (*LeaveBlocksReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a87a3b7fa39be7cd, []int{7}
+}
+func (m *LeaveBlocksReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaveBlocksReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaveBlocksReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LeaveBlocksReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveBlocksReq.Merge(m, src)
+}
+func (m *LeaveBlocksReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaveBlocksReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveBlocksReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaveBlocksReq proto.InternalMessageInfo
+
+func (m *LeaveBlocksReq) GetLeaveId() int64 {
+	if m != nil {
+		return m.LeaveId
+	}
+	return 0
+}
+
+func (m *LeaveBlocksReq) GetDepartmentId() string {
+	if m != nil {
+		return m.DepartmentId
+	}
+	return ""
+}
+
+func (m *LeaveBlocksReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *LeaveBlocksReq) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *LeaveBlocksReq) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+type ReleaseLeaveReq struct {
+	LeaveId              int64    `protobuf:"varint,1,opt,name=leave_id,json=leaveId,proto3" json:"leave_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseLeaveReq) Reset()         { *m = ReleaseLeaveReq{} }
+func (m *ReleaseLeaveReq) String() string { return proto.CompactTextString(m) }
+func (*ReleaseLeaveReq) ProtoMessage()    {}
+func (*ReleaseLeaveReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a87a3b7fa39be7cd, []int{8}
+}
+func (m *ReleaseLeaveReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseLeaveReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseLeaveReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseLeaveReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseLeaveReq.Merge(m, src)
+}
+func (m *ReleaseLeaveReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseLeaveReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseLeaveReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseLeaveReq proto.InternalMessageInfo
+
+func (m *ReleaseLeaveReq) GetLeaveId() int64 {
+	if m != nil {
+		return m.LeaveId
+	}
+	return 0
+}
+
+// LeaveBlocksRes is the number of blocks created or removed
+type LeaveBlocksRes struct {
+	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaveBlocksRes) Reset()         { *m = LeaveBlocksRes{} }
+func (m *LeaveBlocksRes) String() string { return proto.CompactTextString(m) }
+func (*LeaveBlocksRes) ProtoMessage()    {}
+func (*LeaveBlocksRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a87a3b7fa39be7cd, []int{9}
+}
+func (m *LeaveBlocksRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaveBlocksRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaveBlocksRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LeaveBlocksRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveBlocksRes.Merge(m, src)
+}
+func (m *LeaveBlocksRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaveBlocksRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveBlocksRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaveBlocksRes proto.InternalMessageInfo
+
+func (m *LeaveBlocksRes) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DoctorTime)(nil), "booking_service.DoctorTime")
 	proto.RegisterType((*DoctorTimes)(nil), "booking_service.DoctorTimes")
@@ -595,6 +770,9 @@ func init() {
 	proto.RegisterType((*DoctorTimeFieldValueReq)(nil), "booking_service.DoctorTimeFieldValueReq")
 	proto.RegisterType((*DoctorTimeDeleteStatus)(nil), "booking_service.DoctorTimeDeleteStatus")
 	proto.RegisterType((*GetAllDoctorTimesReq)(nil), "booking_service.GetAllDoctorTimesReq")
+	proto.RegisterType((*LeaveBlocksReq)(nil), "booking_service.LeaveBlocksReq")
+	proto.RegisterType((*ReleaseLeaveReq)(nil), "booking_service.ReleaseLeaveReq")
+	proto.RegisterType((*LeaveBlocksRes)(nil), "booking_service.LeaveBlocksRes")
 }
 
 func init() {
@@ -602,44 +780,50 @@ func init() {
 }
 
 var fileDescriptor_a87a3b7fa39be7cd = []byte{
-	// 586 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xfe, 0xad, 0xed, 0x24, 0xce, 0xa4, 0xfd, 0x91, 0x6e, 0xa3, 0x62, 0x1a, 0x08, 0x91, 0x01,
-	0x91, 0x53, 0x40, 0xe5, 0x8e, 0x94, 0x12, 0x51, 0xf5, 0xea, 0xd2, 0x8a, 0x9b, 0xe5, 0x78, 0x87,
-	0x6a, 0x85, 0x13, 0x07, 0x7b, 0x13, 0xa9, 0x6f, 0xc2, 0x03, 0x70, 0xe6, 0x39, 0x10, 0x27, 0xce,
-	0x9c, 0x50, 0x78, 0x07, 0xce, 0xc8, 0xbb, 0x8e, 0xec, 0xfc, 0xe9, 0x46, 0x20, 0x6e, 0x99, 0xf9,
-	0xc6, 0xb3, 0xf3, 0x7d, 0xdf, 0x8c, 0x02, 0xee, 0x28, 0x8e, 0xdf, 0xf3, 0xc9, 0xb5, 0x9f, 0x62,
-	0x32, 0xe7, 0x21, 0x3e, 0x63, 0x71, 0x28, 0xe2, 0xc4, 0x17, 0x7c, 0x8c, 0x69, 0x7f, 0x9a, 0xc4,
-	0x22, 0xa6, 0x77, 0xd6, 0x6a, 0xdc, 0xcf, 0x06, 0xc0, 0x50, 0xd6, 0xbd, 0xe1, 0x63, 0xa4, 0xff,
-	0x83, 0xc1, 0x99, 0x43, 0xba, 0xa4, 0x67, 0x7a, 0x06, 0x67, 0xf4, 0x11, 0xec, 0x33, 0x9c, 0x06,
-	0x89, 0x18, 0xe3, 0x44, 0xf8, 0x9c, 0x39, 0x46, 0x97, 0xf4, 0xea, 0xde, 0x5e, 0x91, 0x3c, 0x67,
-	0xb4, 0x0d, 0xf5, 0xfc, 0x29, 0xce, 0x1c, 0x53, 0x16, 0xd8, 0x2a, 0x71, 0xce, 0xe8, 0x43, 0x68,
-	0xe4, 0x20, 0x0b, 0x04, 0x3a, 0x96, 0x84, 0x41, 0xa5, 0x86, 0x81, 0x40, 0xfa, 0x00, 0x20, 0x15,
-	0x41, 0x22, 0xe4, 0x9c, 0x4e, 0x45, 0xe2, 0x75, 0x99, 0x91, 0x13, 0xdd, 0x03, 0x1b, 0x27, 0x4c,
-	0x81, 0x55, 0x09, 0xd6, 0x70, 0xc2, 0x24, 0x74, 0x04, 0xd5, 0x54, 0x04, 0x62, 0x96, 0x3a, 0x35,
-	0x09, 0xe4, 0x51, 0xd6, 0x31, 0x4c, 0x30, 0x10, 0xc8, 0xfc, 0x40, 0x38, 0xb6, 0xea, 0x98, 0x67,
-	0x06, 0x22, 0x83, 0x67, 0x53, 0xb6, 0x84, 0xeb, 0x0a, 0xce, 0x33, 0x0a, 0x66, 0x18, 0x61, 0x0e,
-	0x83, 0x82, 0xf3, 0xcc, 0x40, 0xb8, 0x21, 0x34, 0x0a, 0xbd, 0x52, 0xda, 0x82, 0x4a, 0x18, 0xcf,
-	0x26, 0x22, 0xd7, 0x4c, 0x05, 0xf4, 0x25, 0xec, 0x95, 0xc5, 0x77, 0x8c, 0xae, 0xd9, 0x6b, 0x9c,
-	0xb4, 0xfb, 0x6b, 0xea, 0xf7, 0x8b, 0x4e, 0x5e, 0xae, 0x92, 0xec, 0xea, 0x7e, 0x25, 0x70, 0xf8,
-	0x4a, 0x0e, 0x5c, 0xaa, 0xc0, 0x0f, 0x9b, 0x76, 0x90, 0x5d, 0x76, 0x18, 0x7a, 0x3b, 0xcc, 0x1d,
-	0x76, 0x58, 0x3a, 0x3b, 0x2a, 0xb7, 0xd9, 0x51, 0x2d, 0xdb, 0xe1, 0xfe, 0x22, 0x70, 0x78, 0x29,
-	0xe5, 0x5d, 0x25, 0xd3, 0x82, 0xca, 0x3b, 0x8e, 0xd1, 0x92, 0x84, 0x0a, 0xb2, 0xec, 0x3c, 0x88,
-	0x66, 0x98, 0x4f, 0xae, 0x82, 0x4d, 0xe2, 0xe6, 0x2e, 0xe2, 0x96, 0x9e, 0x78, 0x65, 0x07, 0xf1,
-	0xaa, 0x8e, 0x78, 0xed, 0x36, 0xe2, 0xf6, 0x0a, 0xf1, 0x11, 0xdc, 0x2d, 0x18, 0xbf, 0xce, 0xd8,
-	0x5d, 0x65, 0x64, 0xfe, 0x94, 0x7b, 0x1b, 0xea, 0x3c, 0xf5, 0x83, 0x50, 0xf0, 0xb9, 0x32, 0xcc,
-	0xf6, 0x6c, 0x9e, 0x0e, 0x64, 0xec, 0x3e, 0x87, 0xa3, 0xe2, 0x8d, 0xa1, 0xdc, 0xd2, 0x0b, 0x75,
-	0x05, 0xc5, 0x54, 0x44, 0x7e, 0xb3, 0x9c, 0xea, 0x13, 0x81, 0xd6, 0x19, 0x8a, 0x41, 0x14, 0x95,
-	0xf6, 0xf8, 0x5f, 0xce, 0x44, 0x29, 0x58, 0xd3, 0xe0, 0x5a, 0x2d, 0x8f, 0xe5, 0xc9, 0xdf, 0x59,
-	0x9b, 0x88, 0x8f, 0xb9, 0x90, 0xc2, 0x5b, 0x9e, 0x0a, 0x32, 0x51, 0xe3, 0x84, 0x61, 0xe2, 0x8f,
-	0x6e, 0x96, 0xc7, 0x2d, 0xe3, 0xd3, 0x9b, 0x93, 0xef, 0x26, 0x1c, 0x14, 0x03, 0x5e, 0xa8, 0x83,
-	0xa1, 0x97, 0xd0, 0x5c, 0xbf, 0x0b, 0xfa, 0x78, 0xe3, 0xac, 0xb6, 0x9c, 0xce, 0xb1, 0xee, 0xf8,
-	0xe8, 0x15, 0xec, 0x9f, 0xa1, 0x28, 0x25, 0x7a, 0x9a, 0xea, 0x15, 0x27, 0xf5, 0x7d, 0xdf, 0xc2,
-	0xc1, 0x86, 0xd4, 0xf4, 0xc9, 0xc6, 0x17, 0xdb, 0xec, 0x38, 0xbe, 0xaf, 0x69, 0x9c, 0x66, 0x42,
-	0xac, 0xdf, 0xd4, 0x16, 0x21, 0xb6, 0x9c, 0x9d, 0x7e, 0x60, 0x84, 0xa6, 0x5a, 0xa2, 0xbf, 0xd2,
-	0xe2, 0xa9, 0xa6, 0xb2, 0xbc, 0x9b, 0xa7, 0xcd, 0x2f, 0x8b, 0x0e, 0xf9, 0xb6, 0xe8, 0x90, 0x1f,
-	0x8b, 0x0e, 0xf9, 0xf8, 0xb3, 0xf3, 0xdf, 0xa8, 0x2a, 0xff, 0x9f, 0x5e, 0xfc, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0xc0, 0x6c, 0x43, 0xa0, 0xc5, 0x06, 0x00, 0x00,
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xfe, 0x39, 0x71, 0x12, 0x67, 0xd2, 0xbf, 0xdb, 0xaa, 0x3f, 0xb7, 0x85, 0x34, 0x32, 0xff,
+	0x72, 0x40, 0x05, 0x95, 0x3b, 0x52, 0x4a, 0x45, 0x55, 0x09, 0x21, 0xe1, 0xd2, 0x8a, 0x9b, 0xb5,
+	0xf1, 0x0e, 0xd5, 0xaa, 0x4e, 0x1c, 0xbc, 0x9b, 0x48, 0x7d, 0x13, 0x1e, 0x00, 0x89, 0x1b, 0xcf,
+	0x81, 0x38, 0xf1, 0x08, 0xa8, 0xbc, 0x03, 0x37, 0x24, 0xe4, 0x5d, 0xa7, 0x76, 0xe2, 0xc4, 0x11,
+	0xa8, 0xb7, 0xcc, 0x7c, 0x33, 0xe3, 0xf9, 0xe6, 0x9b, 0x1d, 0x05, 0x9c, 0x6e, 0x18, 0x5e, 0xf2,
+	0xfe, 0x85, 0x27, 0x30, 0x1a, 0x71, 0x1f, 0x9f, 0xb0, 0xd0, 0x97, 0x61, 0xe4, 0x49, 0xde, 0x43,
+	0xb1, 0x3f, 0x88, 0x42, 0x19, 0x92, 0xd5, 0xa9, 0x18, 0xe7, 0x4b, 0x09, 0xe0, 0x48, 0xc5, 0xbd,
+	0xe5, 0x3d, 0x24, 0x2b, 0x50, 0xe2, 0xcc, 0x36, 0x5a, 0x46, 0xbb, 0xec, 0x96, 0x38, 0x23, 0xf7,
+	0x60, 0x99, 0xe1, 0x80, 0x46, 0xb2, 0x87, 0x7d, 0xe9, 0x71, 0x66, 0x97, 0x5a, 0x46, 0xbb, 0xee,
+	0x2e, 0xa5, 0xce, 0x13, 0x46, 0x76, 0xa1, 0x9e, 0x7c, 0x8a, 0x33, 0xbb, 0xac, 0x02, 0x2c, 0xed,
+	0x38, 0x61, 0x64, 0x0f, 0x1a, 0x09, 0xc8, 0xa8, 0x44, 0xdb, 0x54, 0x30, 0x68, 0xd7, 0x11, 0x95,
+	0x48, 0xee, 0x02, 0x08, 0x49, 0x23, 0xa9, 0xfa, 0xb4, 0x2b, 0x0a, 0xaf, 0x2b, 0x8f, 0xea, 0x68,
+	0x1b, 0x2c, 0xec, 0x33, 0x0d, 0x56, 0x15, 0x58, 0xc3, 0x3e, 0x53, 0xd0, 0x16, 0x54, 0x85, 0xa4,
+	0x72, 0x28, 0xec, 0x9a, 0x02, 0x12, 0x2b, 0xae, 0xe8, 0x47, 0x48, 0x25, 0x32, 0x8f, 0x4a, 0xdb,
+	0xd2, 0x15, 0x13, 0x4f, 0x47, 0xc6, 0xf0, 0x70, 0xc0, 0xc6, 0x70, 0x5d, 0xc3, 0x89, 0x47, 0xc3,
+	0x0c, 0x03, 0x4c, 0x60, 0xd0, 0x70, 0xe2, 0xe9, 0x48, 0xc7, 0x87, 0x46, 0x3a, 0x2f, 0x41, 0x36,
+	0xa1, 0xe2, 0x87, 0xc3, 0xbe, 0x4c, 0x66, 0xa6, 0x0d, 0xf2, 0x1c, 0x96, 0xb2, 0xc3, 0xb7, 0x4b,
+	0xad, 0x72, 0xbb, 0x71, 0xb0, 0xbb, 0x3f, 0x35, 0xfd, 0xfd, 0xb4, 0x92, 0xdb, 0x60, 0x37, 0xbf,
+	0x85, 0xf3, 0xcd, 0x80, 0x8d, 0x17, 0xaa, 0xe1, 0x4c, 0x04, 0x7e, 0xc8, 0xcb, 0x61, 0x2c, 0x92,
+	0xa3, 0x54, 0x2c, 0x47, 0x79, 0x81, 0x1c, 0x66, 0x91, 0x1c, 0x95, 0x79, 0x72, 0x54, 0xb3, 0x72,
+	0x38, 0xbf, 0x0c, 0xd8, 0x38, 0x1b, 0xb0, 0x1c, 0x99, 0x4d, 0xa8, 0xbc, 0xe7, 0x18, 0x8c, 0x49,
+	0x68, 0x23, 0xf6, 0x8e, 0x68, 0x30, 0xc4, 0xa4, 0x73, 0x6d, 0xe4, 0x89, 0x97, 0x17, 0x11, 0x37,
+	0x8b, 0x89, 0x57, 0x16, 0x10, 0xaf, 0x16, 0x11, 0xaf, 0xcd, 0x23, 0x6e, 0x4d, 0x10, 0xef, 0xc2,
+	0xff, 0x29, 0xe3, 0x97, 0x31, 0xbb, 0xf3, 0x98, 0xcc, 0xdf, 0x72, 0xdf, 0x85, 0x3a, 0x17, 0x1e,
+	0xf5, 0x25, 0x1f, 0x69, 0xc1, 0x2c, 0xd7, 0xe2, 0xa2, 0xa3, 0x6c, 0xe7, 0x29, 0x6c, 0xa5, 0xdf,
+	0x38, 0x52, 0x5b, 0x7a, 0xaa, 0x5f, 0x41, 0xda, 0x95, 0xa1, 0x72, 0xc6, 0x5d, 0x7d, 0x32, 0x60,
+	0xf3, 0x18, 0x65, 0x27, 0x08, 0xd2, 0x44, 0x71, 0x9b, 0x3d, 0x11, 0x02, 0xe6, 0x80, 0x5e, 0xe8,
+	0xe5, 0x31, 0x5d, 0xf5, 0x3b, 0x2e, 0x13, 0xf0, 0x1e, 0x97, 0x6a, 0xf0, 0xa6, 0xab, 0x8d, 0x78,
+	0xa8, 0x61, 0xc4, 0x30, 0xf2, 0xba, 0x57, 0xe3, 0xc7, 0xad, 0xec, 0xc3, 0x2b, 0xe7, 0xb3, 0x01,
+	0x2b, 0xaf, 0x90, 0x8e, 0xf0, 0x30, 0x08, 0xfd, 0x4b, 0xd5, 0xe0, 0x36, 0x58, 0x41, 0xec, 0xf1,
+	0x6e, 0x4e, 0x54, 0x4d, 0xd9, 0x27, 0xb7, 0x71, 0xa7, 0x6e, 0xe4, 0xcf, 0x9c, 0x29, 0x2d, 0xbf,
+	0xda, 0x8e, 0x44, 0xfe, 0xcc, 0xee, 0xc4, 0xf2, 0xc7, 0x90, 0xf3, 0x18, 0x56, 0x5d, 0x0c, 0x90,
+	0x0a, 0x54, 0xfd, 0x16, 0x77, 0xea, 0x3c, 0x9c, 0xa2, 0x35, 0xe7, 0x84, 0x1c, 0xfc, 0x36, 0x61,
+	0x3d, 0x15, 0xe8, 0x54, 0x1f, 0x0c, 0x72, 0x06, 0x6b, 0xd3, 0x77, 0x81, 0xdc, 0xcf, 0x9d, 0x95,
+	0x19, 0xa7, 0x63, 0xa7, 0xe8, 0xf8, 0x90, 0x73, 0x58, 0x3e, 0x46, 0x99, 0x71, 0xb4, 0x0b, 0xa2,
+	0x27, 0x36, 0xb9, 0xb8, 0xee, 0x3b, 0x58, 0xcf, 0xad, 0x1a, 0x79, 0x90, 0xcb, 0x98, 0xb5, 0x8e,
+	0x3b, 0x77, 0x0a, 0x0a, 0x8b, 0x78, 0x10, 0xd3, 0x37, 0x65, 0xc6, 0x20, 0x66, 0x9c, 0x9d, 0xe2,
+	0x86, 0x11, 0xd6, 0xf4, 0x23, 0xfa, 0xa7, 0x59, 0x3c, 0x2a, 0x88, 0x9c, 0x78, 0x9b, 0xaf, 0x01,
+	0x94, 0xfe, 0x6a, 0x13, 0xc8, 0x5e, 0x2e, 0x6d, 0x72, 0xf1, 0x77, 0x16, 0x04, 0x08, 0xf2, 0x06,
+	0x96, 0xb2, 0x2b, 0x48, 0x5a, 0xb9, 0x84, 0xa9, 0x0d, 0x5d, 0x58, 0xf2, 0x70, 0xed, 0xeb, 0x75,
+	0xd3, 0xf8, 0x7e, 0xdd, 0x34, 0x7e, 0x5c, 0x37, 0x8d, 0x8f, 0x3f, 0x9b, 0xff, 0x75, 0xab, 0xea,
+	0x2f, 0xc4, 0xb3, 0x3f, 0x03, 0x00, 0xcf, 0x7e, 0x81, 0xe2, 0x68, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -660,6 +844,9 @@ type DoctorTimeServiceClient interface {
 	GetAllDoctorTimes(ctx context.Context, in *GetAllDoctorTimesReq, opts ...grpc.CallOption) (*DoctorTimes, error)
 	UpdateDoctorTime(ctx context.Context, in *UpdateDoctorTimeReq, opts ...grpc.CallOption) (*DoctorTime, error)
 	DeleteDoctorTime(ctx context.Context, in *DoctorTimeFieldValueReq, opts ...grpc.CallOption) (*DoctorTimeDeleteStatus, error)
+	// leave
+	BlockLeave(ctx context.Context, in *LeaveBlocksReq, opts ...grpc.CallOption) (*LeaveBlocksRes, error)
+	ReleaseLeave(ctx context.Context, in *ReleaseLeaveReq, opts ...grpc.CallOption) (*LeaveBlocksRes, error)
 }
 
 type doctorTimeServiceClient struct {
@@ -715,6 +902,24 @@ func (c *doctorTimeServiceClient) DeleteDoctorTime(ctx context.Context, in *Doct
 	return out, nil
 }
 
+func (c *doctorTimeServiceClient) BlockLeave(ctx context.Context, in *LeaveBlocksReq, opts ...grpc.CallOption) (*LeaveBlocksRes, error) {
+	out := new(LeaveBlocksRes)
+	err := c.cc.Invoke(ctx, "/booking_service.DoctorTimeService/BlockLeave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorTimeServiceClient) ReleaseLeave(ctx context.Context, in *ReleaseLeaveReq, opts ...grpc.CallOption) (*LeaveBlocksRes, error) {
+	out := new(LeaveBlocksRes)
+	err := c.cc.Invoke(ctx, "/booking_service.DoctorTimeService/ReleaseLeave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DoctorTimeServiceServer is the server API for DoctorTimeService service.
 type DoctorTimeServiceServer interface {
 	// doctorTime
@@ -723,6 +928,9 @@ type DoctorTimeServiceServer interface {
 	GetAllDoctorTimes(context.Context, *GetAllDoctorTimesReq) (*DoctorTimes, error)
 	UpdateDoctorTime(context.Context, *UpdateDoctorTimeReq) (*DoctorTime, error)
 	DeleteDoctorTime(context.Context, *DoctorTimeFieldValueReq) (*DoctorTimeDeleteStatus, error)
+	// leave
+	BlockLeave(context.Context, *LeaveBlocksReq) (*LeaveBlocksRes, error)
+	ReleaseLeave(context.Context, *ReleaseLeaveReq) (*LeaveBlocksRes, error)
 }
 
 // UnimplementedDoctorTimeServiceServer can be embedded to have forward compatible implementations.
@@ -744,6 +952,12 @@ func (*UnimplementedDoctorTimeServiceServer) UpdateDoctorTime(ctx context.Contex
 func (*UnimplementedDoctorTimeServiceServer) DeleteDoctorTime(ctx context.Context, req *DoctorTimeFieldValueReq) (*DoctorTimeDeleteStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDoctorTime not implemented")
 }
+func (*UnimplementedDoctorTimeServiceServer) BlockLeave(ctx context.Context, req *LeaveBlocksReq) (*LeaveBlocksRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockLeave not implemented")
+}
+func (*UnimplementedDoctorTimeServiceServer) ReleaseLeave(ctx context.Context, req *ReleaseLeaveReq) (*LeaveBlocksRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLeave not implemented")
+}
 
 func RegisterDoctorTimeServiceServer(s *grpc.Server, srv DoctorTimeServiceServer) {
 	s.RegisterService(&_DoctorTimeService_serviceDesc, srv)
@@ -839,6 +1053,42 @@ func _DoctorTimeService_DeleteDoctorTime_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DoctorTimeService_BlockLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveBlocksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorTimeServiceServer).BlockLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.DoctorTimeService/BlockLeave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorTimeServiceServer).BlockLeave(ctx, req.(*LeaveBlocksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorTimeService_ReleaseLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLeaveReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorTimeServiceServer).ReleaseLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.DoctorTimeService/ReleaseLeave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorTimeServiceServer).ReleaseLeave(ctx, req.(*ReleaseLeaveReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DoctorTimeService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "booking_service.DoctorTimeService",
 	HandlerType: (*DoctorTimeServiceServer)(nil),
@@ -863,6 +1113,14 @@ var _DoctorTimeService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDoctorTime",
 			Handler:    _DoctorTimeService_DeleteDoctorTime_Handler,
 		},
+		{
+			MethodName: "BlockLeave",
+			Handler:    _DoctorTimeService_BlockLeave_Handler,
+		},
+		{
+			MethodName: "ReleaseLeave",
+			Handler:    _DoctorTimeService_ReleaseLeave_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "booking_service/doctor_times.proto",
@@ -1317,79 +1575,203 @@ func (m *GetAllDoctorTimesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDoctorTimes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDoctorTimes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LeaveBlocksReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *DoctorTime) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *LeaveBlocksReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaveBlocksReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovDoctorTimes(uint64(m.Id))
-	}
-	l = len(m.DepartmentId)
-	if l > 0 {
-		n += 1 + l + sovDoctorTimes(uint64(l))
-	}
-	l = len(m.DoctorId)
-	if l > 0 {
-		n += 1 + l + sovDoctorTimes(uint64(l))
-	}
-	l = len(m.DoctorDate)
-	if l > 0 {
-		n += 1 + l + sovDoctorTimes(uint64(l))
-	}
-	l = len(m.StartTime)
-	if l > 0 {
-		n += 1 + l + sovDoctorTimes(uint64(l))
-	}
-	l = len(m.EndTime)
-	if l > 0 {
-		n += 1 + l + sovDoctorTimes(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovDoctorTimes(uint64(l))
+	if len(m.EndDate) > 0 {
+		i -= len(m.EndDate)
+		copy(dAtA[i:], m.EndDate)
+		i = encodeVarintDoctorTimes(dAtA, i, uint64(len(m.EndDate)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovDoctorTimes(uint64(l))
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintDoctorTimes(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovDoctorTimes(uint64(l))
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctorTimes(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 1 + l + sovDoctorTimes(uint64(l))
+	if len(m.DepartmentId) > 0 {
+		i -= len(m.DepartmentId)
+		copy(dAtA[i:], m.DepartmentId)
+		i = encodeVarintDoctorTimes(dAtA, i, uint64(len(m.DepartmentId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.LeaveId != 0 {
+		i = encodeVarintDoctorTimes(dAtA, i, uint64(m.LeaveId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DoctorTimes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovDoctorTimes(uint64(m.Count))
+func (m *ReleaseLeaveReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DoctorTimes) > 0 {
-		for _, e := range m.DoctorTimes {
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseLeaveReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseLeaveReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LeaveId != 0 {
+		i = encodeVarintDoctorTimes(dAtA, i, uint64(m.LeaveId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaveBlocksRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaveBlocksRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaveBlocksRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctorTimes(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDoctorTimes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDoctorTimes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DoctorTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDoctorTimes(uint64(m.Id))
+	}
+	l = len(m.DepartmentId)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
+	l = len(m.DoctorDate)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
+	l = len(m.EndTime)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorTimes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovDoctorTimes(uint64(m.Count))
+	}
+	if len(m.DoctorTimes) > 0 {
+		for _, e := range m.DoctorTimes {
 			l = e.Size()
 			n += 1 + l + sovDoctorTimes(uint64(l))
 		}
@@ -1551,6 +1933,67 @@ func (m *GetAllDoctorTimesReq) Size() (n int) {
 	return n
 }
 
+func (m *LeaveBlocksReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaveId != 0 {
+		n += 1 + sovDoctorTimes(uint64(m.LeaveId))
+	}
+	l = len(m.DepartmentId)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
+	l = len(m.EndDate)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReleaseLeaveReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaveId != 0 {
+		n += 1 + sovDoctorTimes(uint64(m.LeaveId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LeaveBlocksRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovDoctorTimes(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovDoctorTimes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2980,6 +3423,344 @@ func (m *GetAllDoctorTimesReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LeaveBlocksReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorTimes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaveBlocksReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaveBlocksReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaveId", wireType)
+			}
+			m.LeaveId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorTimes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaveId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorTimes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepartmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorTimes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorTimes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorTimes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorTimes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseLeaveReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorTimes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseLeaveReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseLeaveReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaveId", wireType)
+			}
+			m.LeaveId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorTimes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaveId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorTimes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaveBlocksRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorTimes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaveBlocksRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaveBlocksRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorTimes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorTimes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDoctorTimes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,2794 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: booking_service/patient_documents.proto
+
+package booking_service
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// PatientDocument is a lab result, scan, prescription or other file of a patient, document_type is one of
+// "lab_result", "scan", "prescription", "other". The file is the object object_name of the private document bucket,
+// user_id is the user who uploaded it. appointment_id and doctor_note_id are 0 when it is not attached to them
+type PatientDocument struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	PatientId            string   `protobuf:"bytes,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	AppointmentId        int64    `protobuf:"varint,4,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
+	DoctorNoteId         int64    `protobuf:"varint,5,opt,name=doctor_note_id,json=doctorNoteId,proto3" json:"doctor_note_id"`
+	DocumentType         string   `protobuf:"bytes,6,opt,name=document_type,json=documentType,proto3" json:"document_type"`
+	Title                string   `protobuf:"bytes,7,opt,name=title,proto3" json:"title"`
+	ObjectName           string   `protobuf:"bytes,8,opt,name=object_name,json=objectName,proto3" json:"object_name"`
+	ContentType          string   `protobuf:"bytes,9,opt,name=content_type,json=contentType,proto3" json:"content_type"`
+	SizeBytes            int64    `protobuf:"varint,10,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes"`
+	UploadedAt           string   `protobuf:"bytes,11,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PatientDocument) Reset()         { *m = PatientDocument{} }
+func (m *PatientDocument) String() string { return proto.CompactTextString(m) }
+func (*PatientDocument) ProtoMessage()    {}
+func (*PatientDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2da8f8965533e64, []int{0}
+}
+func (m *PatientDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PatientDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PatientDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PatientDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatientDocument.Merge(m, src)
+}
+func (m *PatientDocument) XXX_Size() int {
+	return m.Size()
+}
+func (m *PatientDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatientDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatientDocument proto.InternalMessageInfo
+
+func (m *PatientDocument) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PatientDocument) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *PatientDocument) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *PatientDocument) GetAppointmentId() int64 {
+	if m != nil {
+		return m.AppointmentId
+	}
+	return 0
+}
+
+func (m *PatientDocument) GetDoctorNoteId() int64 {
+	if m != nil {
+		return m.DoctorNoteId
+	}
+	return 0
+}
+
+func (m *PatientDocument) GetDocumentType() string {
+	if m != nil {
+		return m.DocumentType
+	}
+	return ""
+}
+
+func (m *PatientDocument) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PatientDocument) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *PatientDocument) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *PatientDocument) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *PatientDocument) GetUploadedAt() string {
+	if m != nil {
+		return m.UploadedAt
+	}
+	return ""
+}
+
+type PatientDocuments struct {
+	Count                int64              `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	PatientDocuments     []*PatientDocument `protobuf:"bytes,2,rep,name=patient_documents,json=patientDocuments,proto3" json:"patient_documents"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *PatientDocuments) Reset()         { *m = PatientDocuments{} }
+func (m *PatientDocuments) String() string { return proto.CompactTextString(m) }
+func (*PatientDocuments) ProtoMessage()    {}
+func (*PatientDocuments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2da8f8965533e64, []int{1}
+}
+func (m *PatientDocuments) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PatientDocuments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PatientDocuments.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PatientDocuments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatientDocuments.Merge(m, src)
+}
+func (m *PatientDocuments) XXX_Size() int {
+	return m.Size()
+}
+func (m *PatientDocuments) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatientDocuments.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatientDocuments proto.InternalMessageInfo
+
+func (m *PatientDocuments) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PatientDocuments) GetPatientDocuments() []*PatientDocument {
+	if m != nil {
+		return m.PatientDocuments
+	}
+	return nil
+}
+
+type CreatePatientDocumentReq struct {
+	PatientId     string `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	AppointmentId int64  `protobuf:"varint,3,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
+	DoctorNoteId  int64  `protobuf:"varint,4,opt,name=doctor_note_id,json=doctorNoteId,proto3" json:"doctor_note_id"`
+	DocumentType  string `protobuf:"bytes,5,opt,name=document_type,json=documentType,proto3" json:"document_type"`
+	Title         string `protobuf:"bytes,6,opt,name=title,proto3" json:"title"`
+	ObjectName    string `protobuf:"bytes,7,opt,name=object_name,json=objectName,proto3" json:"object_name"`
+	ContentType   string `protobuf:"bytes,8,opt,name=content_type,json=contentType,proto3" json:"content_type"`
+	SizeBytes     int64  `protobuf:"varint,9,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes"`
+	// phone_number is the phone of the user, the user attaches documents to the patients of their phone number only
+	PhoneNumber          string   `protobuf:"bytes,10,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreatePatientDocumentReq) Reset()         { *m = CreatePatientDocumentReq{} }
+func (m *CreatePatientDocumentReq) String() string { return proto.CompactTextString(m) }
+func (*CreatePatientDocumentReq) ProtoMessage()    {}
+func (*CreatePatientDocumentReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2da8f8965533e64, []int{2}
+}
+func (m *CreatePatientDocumentReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreatePatientDocumentReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreatePatientDocumentReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreatePatientDocumentReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePatientDocumentReq.Merge(m, src)
+}
+func (m *CreatePatientDocumentReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreatePatientDocumentReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePatientDocumentReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePatientDocumentReq proto.InternalMessageInfo
+
+func (m *CreatePatientDocumentReq) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *CreatePatientDocumentReq) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *CreatePatientDocumentReq) GetAppointmentId() int64 {
+	if m != nil {
+		return m.AppointmentId
+	}
+	return 0
+}
+
+func (m *CreatePatientDocumentReq) GetDoctorNoteId() int64 {
+	if m != nil {
+		return m.DoctorNoteId
+	}
+	return 0
+}
+
+func (m *CreatePatientDocumentReq) GetDocumentType() string {
+	if m != nil {
+		return m.DocumentType
+	}
+	return ""
+}
+
+func (m *CreatePatientDocumentReq) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CreatePatientDocumentReq) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *CreatePatientDocumentReq) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *CreatePatientDocumentReq) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *CreatePatientDocumentReq) GetPhoneNumber() string {
+	if m != nil {
+		return m.PhoneNumber
+	}
+	return ""
+}
+
+// PatientDocumentRequester is either the user who uploaded the documents or a doctor treating the patient
+type PatientDocumentRequester struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	DoctorId             string   `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PatientDocumentRequester) Reset()         { *m = PatientDocumentRequester{} }
+func (m *PatientDocumentRequester) String() string { return proto.CompactTextString(m) }
+func (*PatientDocumentRequester) ProtoMessage()    {}
+func (*PatientDocumentRequester) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2da8f8965533e64, []int{3}
+}
+func (m *PatientDocumentRequester) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PatientDocumentRequester) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PatientDocumentRequester.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PatientDocumentRequester) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatientDocumentRequester.Merge(m, src)
+}
+func (m *PatientDocumentRequester) XXX_Size() int {
+	return m.Size()
+}
+func (m *PatientDocumentRequester) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatientDocumentRequester.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatientDocumentRequester proto.InternalMessageInfo
+
+func (m *PatientDocumentRequester) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *PatientDocumentRequester) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+type PatientDocumentReq struct {
+	Id                   int64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Requester            *PatientDocumentRequester `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *PatientDocumentReq) Reset()         { *m = PatientDocumentReq{} }
+func (m *PatientDocumentReq) String() string { return proto.CompactTextString(m) }
+func (*PatientDocumentReq) ProtoMessage()    {}
+func (*PatientDocumentReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2da8f8965533e64, []int{4}
+}
+func (m *PatientDocumentReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PatientDocumentReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PatientDocumentReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PatientDocumentReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatientDocumentReq.Merge(m, src)
+}
+func (m *PatientDocumentReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PatientDocumentReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatientDocumentReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatientDocumentReq proto.InternalMessageInfo
+
+func (m *PatientDocumentReq) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PatientDocumentReq) GetRequester() *PatientDocumentRequester {
+	if m != nil {
+		return m.Requester
+	}
+	return nil
+}
+
+type GetAllPatientDocumentsReq struct {
+	PatientId            string                    `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	AppointmentId        int64                     `protobuf:"varint,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
+	Page                 uint64                    `protobuf:"varint,3,opt,name=page,proto3" json:"page"`
+	Limit                uint64                    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit"`
+	Requester            *PatientDocumentRequester `protobuf:"bytes,5,opt,name=requester,proto3" json:"requester"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetAllPatientDocumentsReq) Reset()         { *m = GetAllPatientDocumentsReq{} }
+func (m *GetAllPatientDocumentsReq) String() string { return proto.CompactTextString(m) }
+func (*GetAllPatientDocumentsReq) ProtoMessage()    {}
+func (*GetAllPatientDocumentsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2da8f8965533e64, []int{5}
+}
+func (m *GetAllPatientDocumentsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllPatientDocumentsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllPatientDocumentsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAllPatientDocumentsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllPatientDocumentsReq.Merge(m, src)
+}
+func (m *GetAllPatientDocumentsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllPatientDocumentsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllPatientDocumentsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllPatientDocumentsReq proto.InternalMessageInfo
+
+func (m *GetAllPatientDocumentsReq) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *GetAllPatientDocumentsReq) GetAppointmentId() int64 {
+	if m != nil {
+		return m.AppointmentId
+	}
+	return 0
+}
+
+func (m *GetAllPatientDocumentsReq) GetPage() uint64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *GetAllPatientDocumentsReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetAllPatientDocumentsReq) GetRequester() *PatientDocumentRequester {
+	if m != nil {
+		return m.Requester
+	}
+	return nil
+}
+
+type DeletePatientDocumentStatus struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePatientDocumentStatus) Reset()         { *m = DeletePatientDocumentStatus{} }
+func (m *DeletePatientDocumentStatus) String() string { return proto.CompactTextString(m) }
+func (*DeletePatientDocumentStatus) ProtoMessage()    {}
+func (*DeletePatientDocumentStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2da8f8965533e64, []int{6}
+}
+func (m *DeletePatientDocumentStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletePatientDocumentStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletePatientDocumentStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeletePatientDocumentStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePatientDocumentStatus.Merge(m, src)
+}
+func (m *DeletePatientDocumentStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletePatientDocumentStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePatientDocumentStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePatientDocumentStatus proto.InternalMessageInfo
+
+func (m *DeletePatientDocumentStatus) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*PatientDocument)(nil), "booking_service.PatientDocument")
+	proto.RegisterType((*PatientDocuments)(nil), "booking_service.PatientDocuments")
+	proto.RegisterType((*CreatePatientDocumentReq)(nil), "booking_service.CreatePatientDocumentReq")
+	proto.RegisterType((*PatientDocumentRequester)(nil), "booking_service.PatientDocumentRequester")
+	proto.RegisterType((*PatientDocumentReq)(nil), "booking_service.PatientDocumentReq")
+	proto.RegisterType((*GetAllPatientDocumentsReq)(nil), "booking_service.GetAllPatientDocumentsReq")
+	proto.RegisterType((*DeletePatientDocumentStatus)(nil), "booking_service.DeletePatientDocumentStatus")
+}
+
+func init() {
+	proto.RegisterFile("booking_service/patient_documents.proto", fileDescriptor_b2da8f8965533e64)
+}
+
+var fileDescriptor_b2da8f8965533e64 = []byte{
+	// 620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xdd, 0x4e, 0xd4, 0x50,
+	0x10, 0xb6, 0xdd, 0x1f, 0xb6, 0xb3, 0xcb, 0x8f, 0x27, 0x02, 0x15, 0xc2, 0xba, 0x2c, 0x1a, 0xd1,
+	0x18, 0x4c, 0x30, 0x3e, 0x00, 0x48, 0x42, 0xf6, 0x42, 0x42, 0x8a, 0x77, 0x5e, 0x34, 0xdd, 0x3d,
+	0x13, 0x2c, 0xb6, 0x3d, 0xb5, 0x9d, 0x6a, 0xf0, 0x49, 0x7c, 0x0d, 0xdf, 0xc2, 0x4b, 0x2f, 0x7c,
+	0x00, 0xb2, 0xc6, 0xf7, 0x30, 0x3d, 0x6d, 0x11, 0x4e, 0x97, 0x6d, 0x13, 0xef, 0x3a, 0xdf, 0x99,
+	0x33, 0x73, 0xe6, 0xfb, 0xbe, 0x49, 0xe1, 0xe9, 0x58, 0x88, 0x8f, 0x6e, 0x70, 0x6e, 0xc7, 0x18,
+	0x7d, 0x76, 0x27, 0xf8, 0x32, 0x74, 0xc8, 0xc5, 0x80, 0x6c, 0x2e, 0x26, 0x89, 0x8f, 0x01, 0xc5,
+	0x7b, 0x61, 0x24, 0x48, 0xb0, 0x65, 0x25, 0x71, 0xf8, 0x47, 0x87, 0xe5, 0xd3, 0x2c, 0xf9, 0x28,
+	0xcf, 0x65, 0x4b, 0xa0, 0xbb, 0xdc, 0xd4, 0x06, 0xda, 0x6e, 0xc3, 0xd2, 0x5d, 0xce, 0xb6, 0x00,
+	0x8a, 0x7a, 0x2e, 0x37, 0xf5, 0x81, 0xb6, 0x6b, 0x58, 0x46, 0x8e, 0x8c, 0x38, 0x5b, 0x87, 0x85,
+	0x24, 0xc6, 0x28, 0x3d, 0x6b, 0xc8, 0xb3, 0x76, 0x1a, 0x8e, 0x38, 0x7b, 0x02, 0x4b, 0x4e, 0x18,
+	0x0a, 0x37, 0x20, 0x3f, 0xbf, 0xdb, 0x94, 0x35, 0x17, 0x6f, 0xa0, 0x23, 0xce, 0x1e, 0xc3, 0x12,
+	0x17, 0x13, 0x12, 0x91, 0x1d, 0x08, 0xc2, 0x34, 0xad, 0x25, 0xd3, 0x7a, 0x19, 0x7a, 0x22, 0x08,
+	0x47, 0x9c, 0xed, 0xc0, 0x62, 0x31, 0x8c, 0x4d, 0x97, 0x21, 0x9a, 0x6d, 0xd9, 0xab, 0x57, 0x80,
+	0xef, 0x2e, 0x43, 0x64, 0x0f, 0xa0, 0x45, 0x2e, 0x79, 0x68, 0x2e, 0xc8, 0xc3, 0x2c, 0x60, 0x8f,
+	0xa0, 0x2b, 0xc6, 0x17, 0x38, 0x21, 0x3b, 0x70, 0x7c, 0x34, 0x3b, 0xf2, 0x0c, 0x32, 0xe8, 0xc4,
+	0xf1, 0x91, 0x6d, 0x43, 0x6f, 0x22, 0x02, 0xba, 0x2e, 0x6d, 0xc8, 0x8c, 0x6e, 0x8e, 0xc9, 0xca,
+	0x5b, 0x00, 0xb1, 0xfb, 0x15, 0xed, 0xf1, 0x25, 0x61, 0x6c, 0x82, 0x7c, 0xa0, 0x91, 0x22, 0x87,
+	0x29, 0x90, 0xb6, 0x48, 0x42, 0x4f, 0x38, 0x1c, 0xb9, 0xed, 0x90, 0xd9, 0xcd, 0x5a, 0x14, 0xd0,
+	0x01, 0x0d, 0xbf, 0xc0, 0x8a, 0x42, 0x73, 0x9c, 0xbe, 0x76, 0x22, 0x92, 0x80, 0x72, 0xaa, 0xb3,
+	0x80, 0xbd, 0x85, 0xfb, 0x25, 0xf5, 0x4c, 0x7d, 0xd0, 0xd8, 0xed, 0xee, 0x0f, 0xf6, 0x14, 0xf9,
+	0xf6, 0x94, 0x9a, 0xd6, 0x4a, 0xa8, 0x34, 0x19, 0x5e, 0xe9, 0x60, 0xbe, 0x89, 0xd0, 0x21, 0x54,
+	0x73, 0xf1, 0x93, 0xa2, 0xac, 0x36, 0x47, 0x59, 0xbd, 0x42, 0xd9, 0x46, 0x3d, 0x65, 0x9b, 0x75,
+	0x94, 0x6d, 0xcd, 0x53, 0xb6, 0x3d, 0x47, 0xd9, 0x85, 0x4a, 0x65, 0x3b, 0x55, 0xca, 0x1a, 0xaa,
+	0xb2, 0xdb, 0xd0, 0x0b, 0x3f, 0x88, 0x00, 0xed, 0x20, 0xf1, 0xc7, 0x18, 0x49, 0xe9, 0x0d, 0xab,
+	0x2b, 0xb1, 0x13, 0x09, 0x0d, 0x4f, 0xc1, 0x2c, 0x73, 0x9b, 0x60, 0x4c, 0x18, 0xdd, 0xa4, 0x50,
+	0xbb, 0x45, 0xe1, 0x26, 0x18, 0x39, 0x37, 0xd7, 0xec, 0x76, 0x32, 0x60, 0xc4, 0x87, 0x3e, 0xb0,
+	0x19, 0x6a, 0xa9, 0x7b, 0x79, 0x0c, 0x46, 0x54, 0x34, 0x92, 0x25, 0xba, 0xfb, 0xcf, 0x2a, 0x1d,
+	0x52, 0x5c, 0xb0, 0xfe, 0xdd, 0x1d, 0xfe, 0xd2, 0xe0, 0xe1, 0x31, 0xd2, 0x81, 0xe7, 0xa9, 0x1e,
+	0xad, 0x61, 0x92, 0xb2, 0x17, 0xf4, 0x59, 0x5e, 0x60, 0xd0, 0x0c, 0x9d, 0x73, 0x94, 0x46, 0x69,
+	0x5a, 0xf2, 0x3b, 0x15, 0xd5, 0x73, 0x7d, 0x97, 0xa4, 0x2d, 0x9a, 0x56, 0x16, 0xdc, 0x1e, 0xab,
+	0xf5, 0x1f, 0x63, 0xbd, 0x86, 0xcd, 0x23, 0xf4, 0xb0, 0xe4, 0xfc, 0x33, 0x72, 0x28, 0x89, 0xd9,
+	0x1a, 0xb4, 0x63, 0xf9, 0x25, 0x67, 0xea, 0x58, 0x79, 0xb4, 0xff, 0xbd, 0x01, 0xeb, 0x2a, 0x0f,
+	0x67, 0x59, 0x5b, 0xc6, 0x61, 0x75, 0xe6, 0x32, 0xb1, 0xf2, 0x0b, 0xef, 0x5a, 0xba, 0x8d, 0xca,
+	0x2d, 0x66, 0xef, 0x81, 0x1d, 0x23, 0xa9, 0xe8, 0x4e, 0x0d, 0x12, 0x6a, 0x14, 0x3f, 0x87, 0xb5,
+	0xd9, 0x5a, 0xb3, 0xe7, 0xa5, 0xbb, 0x77, 0x9a, 0x62, 0x63, 0xbb, 0xaa, 0x4f, 0xcc, 0x2e, 0x60,
+	0x75, 0x26, 0xfd, 0xf5, 0x06, 0x79, 0x51, 0x4a, 0x9a, 0xa3, 0xe5, 0xe1, 0xca, 0x8f, 0x69, 0x5f,
+	0xfb, 0x39, 0xed, 0x6b, 0x57, 0xd3, 0xbe, 0xf6, 0xed, 0x77, 0xff, 0xde, 0xb8, 0x2d, 0x7f, 0x78,
+	0xaf, 0xfe, 0x0e, 0x00, 0xd8, 0xf6, 0x9a, 0x98, 0x1b, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PatientDocumentsServiceClient is the client API for PatientDocumentsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PatientDocumentsServiceClient interface {
+	// patientDocuments
+	CreatePatientDocument(ctx context.Context, in *CreatePatientDocumentReq, opts ...grpc.CallOption) (*PatientDocument, error)
+	GetPatientDocument(ctx context.Context, in *PatientDocumentReq, opts ...grpc.CallOption) (*PatientDocument, error)
+	GetAllPatientDocuments(ctx context.Context, in *GetAllPatientDocumentsReq, opts ...grpc.CallOption) (*PatientDocuments, error)
+	DeletePatientDocument(ctx context.Context, in *PatientDocumentReq, opts ...grpc.CallOption) (*DeletePatientDocumentStatus, error)
+}
+
+type patientDocumentsServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPatientDocumentsServiceClient(cc *grpc.ClientConn) PatientDocumentsServiceClient {
+	return &patientDocumentsServiceClient{cc}
+}
+
+func (c *patientDocumentsServiceClient) CreatePatientDocument(ctx context.Context, in *CreatePatientDocumentReq, opts ...grpc.CallOption) (*PatientDocument, error) {
+	out := new(PatientDocument)
+	err := c.cc.Invoke(ctx, "/booking_service.PatientDocumentsService/CreatePatientDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientDocumentsServiceClient) GetPatientDocument(ctx context.Context, in *PatientDocumentReq, opts ...grpc.CallOption) (*PatientDocument, error) {
+	out := new(PatientDocument)
+	err := c.cc.Invoke(ctx, "/booking_service.PatientDocumentsService/GetPatientDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientDocumentsServiceClient) GetAllPatientDocuments(ctx context.Context, in *GetAllPatientDocumentsReq, opts ...grpc.CallOption) (*PatientDocuments, error) {
+	out := new(PatientDocuments)
+	err := c.cc.Invoke(ctx, "/booking_service.PatientDocumentsService/GetAllPatientDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientDocumentsServiceClient) DeletePatientDocument(ctx context.Context, in *PatientDocumentReq, opts ...grpc.CallOption) (*DeletePatientDocumentStatus, error) {
+	out := new(DeletePatientDocumentStatus)
+	err := c.cc.Invoke(ctx, "/booking_service.PatientDocumentsService/DeletePatientDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatientDocumentsServiceServer is the server API for PatientDocumentsService service.
+type PatientDocumentsServiceServer interface {
+	// patientDocuments
+	CreatePatientDocument(context.Context, *CreatePatientDocumentReq) (*PatientDocument, error)
+	GetPatientDocument(context.Context, *PatientDocumentReq) (*PatientDocument, error)
+	GetAllPatientDocuments(context.Context, *GetAllPatientDocumentsReq) (*PatientDocuments, error)
+	DeletePatientDocument(context.Context, *PatientDocumentReq) (*DeletePatientDocumentStatus, error)
+}
+
+// UnimplementedPatientDocumentsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPatientDocumentsServiceServer struct {
+}
+
+func (*UnimplementedPatientDocumentsServiceServer) CreatePatientDocument(ctx context.Context, req *CreatePatientDocumentReq) (*PatientDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePatientDocument not implemented")
+}
+func (*UnimplementedPatientDocumentsServiceServer) GetPatientDocument(ctx context.Context, req *PatientDocumentReq) (*PatientDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientDocument not implemented")
+}
+func (*UnimplementedPatientDocumentsServiceServer) GetAllPatientDocuments(ctx context.Context, req *GetAllPatientDocumentsReq) (*PatientDocuments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPatientDocuments not implemented")
+}
+func (*UnimplementedPatientDocumentsServiceServer) DeletePatientDocument(ctx context.Context, req *PatientDocumentReq) (*DeletePatientDocumentStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePatientDocument not implemented")
+}
+
+func RegisterPatientDocumentsServiceServer(s *grpc.Server, srv PatientDocumentsServiceServer) {
+	s.RegisterService(&_PatientDocumentsService_serviceDesc, srv)
+}
+
+func _PatientDocumentsService_CreatePatientDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePatientDocumentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientDocumentsServiceServer).CreatePatientDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PatientDocumentsService/CreatePatientDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientDocumentsServiceServer).CreatePatientDocument(ctx, req.(*CreatePatientDocumentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientDocumentsService_GetPatientDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatientDocumentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientDocumentsServiceServer).GetPatientDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PatientDocumentsService/GetPatientDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientDocumentsServiceServer).GetPatientDocument(ctx, req.(*PatientDocumentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientDocumentsService_GetAllPatientDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllPatientDocumentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientDocumentsServiceServer).GetAllPatientDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PatientDocumentsService/GetAllPatientDocuments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientDocumentsServiceServer).GetAllPatientDocuments(ctx, req.(*GetAllPatientDocumentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientDocumentsService_DeletePatientDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatientDocumentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientDocumentsServiceServer).DeletePatientDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PatientDocumentsService/DeletePatientDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientDocumentsServiceServer).DeletePatientDocument(ctx, req.(*PatientDocumentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PatientDocumentsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "booking_service.PatientDocumentsService",
+	HandlerType: (*PatientDocumentsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePatientDocument",
+			Handler:    _PatientDocumentsService_CreatePatientDocument_Handler,
+		},
+		{
+			MethodName: "GetPatientDocument",
+			Handler:    _PatientDocumentsService_GetPatientDocument_Handler,
+		},
+		{
+			MethodName: "GetAllPatientDocuments",
+			Handler:    _PatientDocumentsService_GetAllPatientDocuments_Handler,
+		},
+		{
+			MethodName: "DeletePatientDocument",
+			Handler:    _PatientDocumentsService_DeletePatientDocument_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking_service/patient_documents.proto",
+}
+
+func (m *PatientDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PatientDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PatientDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UploadedAt) > 0 {
+		i -= len(m.UploadedAt)
+		copy(dAtA[i:], m.UploadedAt)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.UploadedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DocumentType) > 0 {
+		i -= len(m.DocumentType)
+		copy(dAtA[i:], m.DocumentType)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.DocumentType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DoctorNoteId != 0 {
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(m.DoctorNoteId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AppointmentId != 0 {
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(m.AppointmentId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PatientDocuments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PatientDocuments) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PatientDocuments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PatientDocuments) > 0 {
+		for iNdEx := len(m.PatientDocuments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PatientDocuments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPatientDocuments(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreatePatientDocumentReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePatientDocumentReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatePatientDocumentReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DocumentType) > 0 {
+		i -= len(m.DocumentType)
+		copy(dAtA[i:], m.DocumentType)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.DocumentType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DoctorNoteId != 0 {
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(m.DoctorNoteId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AppointmentId != 0 {
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(m.AppointmentId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PatientDocumentRequester) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PatientDocumentRequester) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PatientDocumentRequester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PatientDocumentReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PatientDocumentReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PatientDocumentReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Requester != nil {
+		{
+			size, err := m.Requester.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPatientDocuments(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllPatientDocumentsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllPatientDocumentsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllPatientDocumentsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Requester != nil {
+		{
+			size, err := m.Requester.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPatientDocuments(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Page != 0 {
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppointmentId != 0 {
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(m.AppointmentId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintPatientDocuments(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeletePatientDocumentStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePatientDocumentStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePatientDocumentStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPatientDocuments(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPatientDocuments(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PatientDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPatientDocuments(uint64(m.Id))
+	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	if m.AppointmentId != 0 {
+		n += 1 + sovPatientDocuments(uint64(m.AppointmentId))
+	}
+	if m.DoctorNoteId != 0 {
+		n += 1 + sovPatientDocuments(uint64(m.DoctorNoteId))
+	}
+	l = len(m.DocumentType)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPatientDocuments(uint64(m.SizeBytes))
+	}
+	l = len(m.UploadedAt)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PatientDocuments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovPatientDocuments(uint64(m.Count))
+	}
+	if len(m.PatientDocuments) > 0 {
+		for _, e := range m.PatientDocuments {
+			l = e.Size()
+			n += 1 + l + sovPatientDocuments(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreatePatientDocumentReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	if m.AppointmentId != 0 {
+		n += 1 + sovPatientDocuments(uint64(m.AppointmentId))
+	}
+	if m.DoctorNoteId != 0 {
+		n += 1 + sovPatientDocuments(uint64(m.DoctorNoteId))
+	}
+	l = len(m.DocumentType)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPatientDocuments(uint64(m.SizeBytes))
+	}
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PatientDocumentRequester) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PatientDocumentReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPatientDocuments(uint64(m.Id))
+	}
+	if m.Requester != nil {
+		l = m.Requester.Size()
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAllPatientDocumentsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	if m.AppointmentId != 0 {
+		n += 1 + sovPatientDocuments(uint64(m.AppointmentId))
+	}
+	if m.Page != 0 {
+		n += 1 + sovPatientDocuments(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPatientDocuments(uint64(m.Limit))
+	}
+	if m.Requester != nil {
+		l = m.Requester.Size()
+		n += 1 + l + sovPatientDocuments(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletePatientDocumentStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPatientDocuments(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPatientDocuments(x uint64) (n int) {
+	return sovPatientDocuments(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PatientDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPatientDocuments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PatientDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PatientDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
+			}
+			m.AppointmentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppointmentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorNoteId", wireType)
+			}
+			m.DoctorNoteId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DoctorNoteId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPatientDocuments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PatientDocuments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPatientDocuments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PatientDocuments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PatientDocuments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientDocuments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientDocuments = append(m.PatientDocuments, &PatientDocument{})
+			if err := m.PatientDocuments[len(m.PatientDocuments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPatientDocuments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreatePatientDocumentReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPatientDocuments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePatientDocumentReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePatientDocumentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
+			}
+			m.AppointmentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppointmentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorNoteId", wireType)
+			}
+			m.DoctorNoteId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DoctorNoteId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPatientDocuments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PatientDocumentRequester) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPatientDocuments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PatientDocumentRequester: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PatientDocumentRequester: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPatientDocuments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PatientDocumentReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPatientDocuments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PatientDocumentReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PatientDocumentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Requester == nil {
+				m.Requester = &PatientDocumentRequester{}
+			}
+			if err := m.Requester.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPatientDocuments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllPatientDocumentsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPatientDocuments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllPatientDocumentsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllPatientDocumentsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
+			}
+			m.AppointmentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppointmentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Requester == nil {
+				m.Requester = &PatientDocumentRequester{}
+			}
+			if err := m.Requester.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPatientDocuments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePatientDocumentStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPatientDocuments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePatientDocumentStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePatientDocumentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPatientDocuments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPatientDocuments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPatientDocuments(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPatientDocuments
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPatientDocuments
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPatientDocuments
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPatientDocuments
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPatientDocuments
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPatientDocuments        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPatientDocuments          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPatientDocuments = fmt.Errorf("proto: unexpected end of group")
+)
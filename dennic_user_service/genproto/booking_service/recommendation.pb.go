@@ -0,0 +1,1680 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: booking_service/recommendation.proto
+
+package booking_service
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// RecommendDoctorsReq selects a reason by id or, when reason_id is empty, the reason best matching the query,
+// online ranks by the online price instead of the offline one
+type RecommendDoctorsReq struct {
+	ReasonId             string   `protobuf:"bytes,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id"`
+	Query                string   `protobuf:"bytes,2,opt,name=query,proto3" json:"query"`
+	Online               bool     `protobuf:"varint,3,opt,name=online,proto3" json:"online"`
+	DaysAhead            int64    `protobuf:"varint,4,opt,name=days_ahead,json=daysAhead,proto3" json:"days_ahead"`
+	Limit                int64    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecommendDoctorsReq) Reset()         { *m = RecommendDoctorsReq{} }
+func (m *RecommendDoctorsReq) String() string { return proto.CompactTextString(m) }
+func (*RecommendDoctorsReq) ProtoMessage()    {}
+func (*RecommendDoctorsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a589c054aa51041, []int{0}
+}
+func (m *RecommendDoctorsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecommendDoctorsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecommendDoctorsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecommendDoctorsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecommendDoctorsReq.Merge(m, src)
+}
+func (m *RecommendDoctorsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecommendDoctorsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecommendDoctorsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecommendDoctorsReq proto.InternalMessageInfo
+
+func (m *RecommendDoctorsReq) GetReasonId() string {
+	if m != nil {
+		return m.ReasonId
+	}
+	return ""
+}
+
+func (m *RecommendDoctorsReq) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *RecommendDoctorsReq) GetOnline() bool {
+	if m != nil {
+		return m.Online
+	}
+	return false
+}
+
+func (m *RecommendDoctorsReq) GetDaysAhead() int64 {
+	if m != nil {
+		return m.DaysAhead
+	}
+	return 0
+}
+
+func (m *RecommendDoctorsReq) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// DoctorRecommendation is a ranked doctor, slot_found is false when the doctor has no free slot in the searched days
+type DoctorRecommendation struct {
+	DoctorId             string   `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	FirstName            string   `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name"`
+	LastName             string   `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name"`
+	ImageUrl             string   `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url"`
+	DepartmentId         string   `protobuf:"bytes,5,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	DoctorServiceId      string   `protobuf:"bytes,6,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	Price                float64  `protobuf:"fixed64,7,opt,name=price,proto3" json:"price"`
+	Duration             int64    `protobuf:"varint,8,opt,name=duration,proto3" json:"duration"`
+	Rating               float64  `protobuf:"fixed64,9,opt,name=rating,proto3" json:"rating"`
+	ReviewCount          int64    `protobuf:"varint,10,opt,name=review_count,json=reviewCount,proto3" json:"review_count"`
+	SlotFound            bool     `protobuf:"varint,11,opt,name=slot_found,json=slotFound,proto3" json:"slot_found"`
+	SlotDate             string   `protobuf:"bytes,12,opt,name=slot_date,json=slotDate,proto3" json:"slot_date"`
+	SlotTime             string   `protobuf:"bytes,13,opt,name=slot_time,json=slotTime,proto3" json:"slot_time"`
+	Score                float64  `protobuf:"fixed64,14,opt,name=score,proto3" json:"score"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorRecommendation) Reset()         { *m = DoctorRecommendation{} }
+func (m *DoctorRecommendation) String() string { return proto.CompactTextString(m) }
+func (*DoctorRecommendation) ProtoMessage()    {}
+func (*DoctorRecommendation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a589c054aa51041, []int{1}
+}
+func (m *DoctorRecommendation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorRecommendation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorRecommendation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoctorRecommendation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorRecommendation.Merge(m, src)
+}
+func (m *DoctorRecommendation) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorRecommendation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorRecommendation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorRecommendation proto.InternalMessageInfo
+
+func (m *DoctorRecommendation) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *DoctorRecommendation) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
+	}
+	return ""
+}
+
+func (m *DoctorRecommendation) GetLastName() string {
+	if m != nil {
+		return m.LastName
+	}
+	return ""
+}
+
+func (m *DoctorRecommendation) GetImageUrl() string {
+	if m != nil {
+		return m.ImageUrl
+	}
+	return ""
+}
+
+func (m *DoctorRecommendation) GetDepartmentId() string {
+	if m != nil {
+		return m.DepartmentId
+	}
+	return ""
+}
+
+func (m *DoctorRecommendation) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *DoctorRecommendation) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *DoctorRecommendation) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *DoctorRecommendation) GetRating() float64 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+func (m *DoctorRecommendation) GetReviewCount() int64 {
+	if m != nil {
+		return m.ReviewCount
+	}
+	return 0
+}
+
+func (m *DoctorRecommendation) GetSlotFound() bool {
+	if m != nil {
+		return m.SlotFound
+	}
+	return false
+}
+
+func (m *DoctorRecommendation) GetSlotDate() string {
+	if m != nil {
+		return m.SlotDate
+	}
+	return ""
+}
+
+func (m *DoctorRecommendation) GetSlotTime() string {
+	if m != nil {
+		return m.SlotTime
+	}
+	return ""
+}
+
+func (m *DoctorRecommendation) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type DoctorRecommendations struct {
+	ReasonId             string                  `protobuf:"bytes,1,opt,name=reason_id,json=reasonId,proto3" json:"reason_id"`
+	ReasonName           string                  `protobuf:"bytes,2,opt,name=reason_name,json=reasonName,proto3" json:"reason_name"`
+	SpecializationId     string                  `protobuf:"bytes,3,opt,name=specialization_id,json=specializationId,proto3" json:"specialization_id"`
+	Recommendations      []*DoctorRecommendation `protobuf:"bytes,4,rep,name=recommendations,proto3" json:"recommendations"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *DoctorRecommendations) Reset()         { *m = DoctorRecommendations{} }
+func (m *DoctorRecommendations) String() string { return proto.CompactTextString(m) }
+func (*DoctorRecommendations) ProtoMessage()    {}
+func (*DoctorRecommendations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a589c054aa51041, []int{2}
+}
+func (m *DoctorRecommendations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorRecommendations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorRecommendations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoctorRecommendations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorRecommendations.Merge(m, src)
+}
+func (m *DoctorRecommendations) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorRecommendations) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorRecommendations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorRecommendations proto.InternalMessageInfo
+
+func (m *DoctorRecommendations) GetReasonId() string {
+	if m != nil {
+		return m.ReasonId
+	}
+	return ""
+}
+
+func (m *DoctorRecommendations) GetReasonName() string {
+	if m != nil {
+		return m.ReasonName
+	}
+	return ""
+}
+
+func (m *DoctorRecommendations) GetSpecializationId() string {
+	if m != nil {
+		return m.SpecializationId
+	}
+	return ""
+}
+
+func (m *DoctorRecommendations) GetRecommendations() []*DoctorRecommendation {
+	if m != nil {
+		return m.Recommendations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RecommendDoctorsReq)(nil), "booking_service.RecommendDoctorsReq")
+	proto.RegisterType((*DoctorRecommendation)(nil), "booking_service.DoctorRecommendation")
+	proto.RegisterType((*DoctorRecommendations)(nil), "booking_service.DoctorRecommendations")
+}
+
+func init() {
+	proto.RegisterFile("booking_service/recommendation.proto", fileDescriptor_6a589c054aa51041)
+}
+
+var fileDescriptor_6a589c054aa51041 = []byte{
+	// 514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x51, 0x6e, 0x13, 0x31,
+	0x10, 0x86, 0x31, 0x69, 0x42, 0x76, 0x92, 0x92, 0xd4, 0xb4, 0xc8, 0x2a, 0x22, 0x84, 0x50, 0x50,
+	0x04, 0x52, 0x90, 0xca, 0x09, 0x80, 0x0a, 0x29, 0x2f, 0x20, 0x2d, 0xf0, 0xbc, 0xb8, 0xeb, 0x69,
+	0xb0, 0xd8, 0xb5, 0x53, 0xdb, 0x29, 0x0a, 0xf7, 0x40, 0xe2, 0x2a, 0xdc, 0x80, 0x47, 0x24, 0x2e,
+	0x80, 0xc2, 0x45, 0x90, 0xed, 0x6d, 0xd3, 0x84, 0x08, 0xf5, 0x2d, 0xf3, 0xfd, 0x33, 0xeb, 0x7f,
+	0x66, 0x32, 0x70, 0x70, 0xac, 0xf5, 0x27, 0xa9, 0x26, 0x99, 0x45, 0x73, 0x26, 0x73, 0x7c, 0x6a,
+	0x30, 0xd7, 0x65, 0x89, 0x4a, 0x70, 0x27, 0xb5, 0x1a, 0x4d, 0x8d, 0x76, 0x9a, 0x76, 0xd6, 0xb2,
+	0x06, 0x5f, 0x09, 0xdc, 0x4a, 0xcf, 0x33, 0x8f, 0x74, 0xee, 0xb4, 0xb1, 0x29, 0x9e, 0xd2, 0x3b,
+	0x90, 0x18, 0xe4, 0x56, 0xab, 0x4c, 0x0a, 0x46, 0xfa, 0x64, 0x98, 0xa4, 0xcd, 0x08, 0xc6, 0x82,
+	0xee, 0x42, 0xfd, 0x74, 0x86, 0x66, 0xce, 0xae, 0x07, 0x21, 0x06, 0xf4, 0x36, 0x34, 0xb4, 0x2a,
+	0xa4, 0x42, 0x56, 0xeb, 0x93, 0x61, 0x33, 0xad, 0x22, 0x7a, 0x17, 0x40, 0xf0, 0xb9, 0xcd, 0xf8,
+	0x47, 0xe4, 0x82, 0x6d, 0xf5, 0xc9, 0xb0, 0x96, 0x26, 0x9e, 0x3c, 0xf7, 0xc0, 0x7f, 0xac, 0x90,
+	0xa5, 0x74, 0xac, 0x1e, 0x94, 0x18, 0x0c, 0xbe, 0xd7, 0x60, 0x37, 0xda, 0x49, 0x57, 0xfa, 0xf0,
+	0xc6, 0x44, 0xe0, 0x97, 0x8c, 0x45, 0x30, 0x16, 0xfe, 0xa9, 0x13, 0x69, 0xac, 0xcb, 0x14, 0x2f,
+	0xb1, 0x72, 0x97, 0x04, 0xf2, 0x9a, 0x97, 0xe8, 0x6b, 0x0b, 0x7e, 0xae, 0xd6, 0x62, 0x6d, 0xc1,
+	0x97, 0xa2, 0x2c, 0xf9, 0x04, 0xb3, 0x99, 0x29, 0x82, 0xcb, 0x24, 0x6d, 0x06, 0xf0, 0xde, 0x14,
+	0xf4, 0x01, 0x6c, 0x0b, 0x9c, 0x72, 0xe3, 0x4a, 0x54, 0xce, 0xbf, 0x5c, 0x0f, 0x09, 0xed, 0x25,
+	0x1c, 0x0b, 0xfa, 0x18, 0x76, 0x2a, 0x6b, 0xd5, 0x74, 0x7d, 0x62, 0x23, 0x24, 0x76, 0xa2, 0xf0,
+	0x36, 0xf2, 0x38, 0xc2, 0xa9, 0x91, 0x39, 0xb2, 0x1b, 0x7d, 0x32, 0x24, 0x69, 0x0c, 0xe8, 0x3e,
+	0x34, 0xc5, 0xcc, 0x84, 0x46, 0x59, 0x33, 0x8c, 0xe3, 0x22, 0xf6, 0xe3, 0xf5, 0xbf, 0xd4, 0x84,
+	0x25, 0xa1, 0xa4, 0x8a, 0xe8, 0x7d, 0x68, 0x1b, 0x3c, 0x93, 0xf8, 0x39, 0xcb, 0xf5, 0x4c, 0x39,
+	0x06, 0xa1, 0xae, 0x15, 0xd9, 0x4b, 0x8f, 0xfc, 0x58, 0x6c, 0xa1, 0x5d, 0x76, 0xa2, 0x67, 0x4a,
+	0xb0, 0x56, 0xd8, 0x4e, 0xe2, 0xc9, 0x2b, 0x0f, 0x7c, 0xe7, 0x41, 0x16, 0xdc, 0x21, 0x6b, 0xc7,
+	0xce, 0x3d, 0x38, 0xe2, 0x0e, 0x2f, 0x44, 0x27, 0x4b, 0x64, 0xdb, 0x4b, 0xf1, 0x9d, 0x2c, 0xd1,
+	0x77, 0x61, 0x73, 0x6d, 0x90, 0xdd, 0x8c, 0x5d, 0x84, 0x60, 0xf0, 0x8b, 0xc0, 0xde, 0xa6, 0xdd,
+	0xd9, 0xff, 0xff, 0xab, 0xee, 0x41, 0xab, 0x12, 0x2f, 0x6d, 0x0f, 0x22, 0x0a, 0x1b, 0x7a, 0x02,
+	0x3b, 0x76, 0x8a, 0xb9, 0xe4, 0x85, 0xfc, 0x12, 0x3e, 0xe8, 0xbf, 0x12, 0xd7, 0xd8, 0x5d, 0x15,
+	0xc6, 0x82, 0xbe, 0x81, 0xce, 0xea, 0x05, 0x58, 0xb6, 0xd5, 0xaf, 0x0d, 0x5b, 0x87, 0x0f, 0x47,
+	0x6b, 0x37, 0x30, 0xda, 0xe4, 0x35, 0x5d, 0xaf, 0x3e, 0x9c, 0xc3, 0xde, 0x6a, 0x4a, 0xb5, 0x4c,
+	0xfa, 0x01, 0xba, 0xeb, 0x17, 0x44, 0x0f, 0xfe, 0x79, 0x64, 0xc3, 0x91, 0xed, 0x3f, 0xba, 0x92,
+	0x15, 0xfb, 0xa2, 0xfb, 0x63, 0xd1, 0x23, 0x3f, 0x17, 0x3d, 0xf2, 0x7b, 0xd1, 0x23, 0xdf, 0xfe,
+	0xf4, 0xae, 0x1d, 0x37, 0xc2, 0x39, 0x3f, 0xfb, 0x3b, 0x00, 0x06, 0x07, 0x64, 0x69, 0xf6, 0x03,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RecommendationServiceClient is the client API for RecommendationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RecommendationServiceClient interface {
+	// recommendation
+	RecommendDoctors(ctx context.Context, in *RecommendDoctorsReq, opts ...grpc.CallOption) (*DoctorRecommendations, error)
+}
+
+type recommendationServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRecommendationServiceClient(cc *grpc.ClientConn) RecommendationServiceClient {
+	return &recommendationServiceClient{cc}
+}
+
+func (c *recommendationServiceClient) RecommendDoctors(ctx context.Context, in *RecommendDoctorsReq, opts ...grpc.CallOption) (*DoctorRecommendations, error) {
+	out := new(DoctorRecommendations)
+	err := c.cc.Invoke(ctx, "/booking_service.RecommendationService/RecommendDoctors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecommendationServiceServer is the server API for RecommendationService service.
+type RecommendationServiceServer interface {
+	// recommendation
+	RecommendDoctors(context.Context, *RecommendDoctorsReq) (*DoctorRecommendations, error)
+}
+
+// UnimplementedRecommendationServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRecommendationServiceServer struct {
+}
+
+func (*UnimplementedRecommendationServiceServer) RecommendDoctors(ctx context.Context, req *RecommendDoctorsReq) (*DoctorRecommendations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendDoctors not implemented")
+}
+
+func RegisterRecommendationServiceServer(s *grpc.Server, srv RecommendationServiceServer) {
+	s.RegisterService(&_RecommendationService_serviceDesc, srv)
+}
+
+func _RecommendationService_RecommendDoctors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendDoctorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecommendationServiceServer).RecommendDoctors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.RecommendationService/RecommendDoctors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecommendationServiceServer).RecommendDoctors(ctx, req.(*RecommendDoctorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RecommendationService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "booking_service.RecommendationService",
+	HandlerType: (*RecommendationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecommendDoctors",
+			Handler:    _RecommendationService_RecommendDoctors_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking_service/recommendation.proto",
+}
+
+func (m *RecommendDoctorsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecommendDoctorsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecommendDoctorsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintRecommendation(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DaysAhead != 0 {
+		i = encodeVarintRecommendation(dAtA, i, uint64(m.DaysAhead))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Online {
+		i--
+		if m.Online {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintRecommendation(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReasonId) > 0 {
+		i -= len(m.ReasonId)
+		copy(dAtA[i:], m.ReasonId)
+		i = encodeVarintRecommendation(dAtA, i, uint64(len(m.ReasonId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorRecommendation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorRecommendation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorRecommendation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Score != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i--
+		dAtA[i] = 0x71
+	}
+	if len(m.SlotTime) > 0 {
+		i -= len(m.SlotTime)
+		copy(dAtA[i:], m.SlotTime)
+		i = encodeVarintRecommendation(dAtA, i, uint64(len(m.SlotTime)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.SlotDate) > 0 {
+		i -= len(m.SlotDate)
+		copy(dAtA[i:], m.SlotDate)
+		i = encodeVarintRecommendation(dAtA, i, uint64(len(m.SlotDate)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.SlotFound {
+		i--
+		if m.SlotFound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ReviewCount != 0 {
+		i = encodeVarintRecommendation(dAtA, i, uint64(m.ReviewCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Rating != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Rating))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.Duration != 0 {
+		i = encodeVarintRecommendation(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintRecommendation(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DepartmentId) > 0 {
+		i -= len(m.DepartmentId)
+		copy(dAtA[i:], m.DepartmentId)
+		i = encodeVarintRecommendation(dAtA, i, uint64(len(m.DepartmentId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ImageUrl) > 0 {
+		i -= len(m.ImageUrl)
+		copy(dAtA[i:], m.ImageUrl)
+		i = encodeVarintRecommendation(dAtA, i, uint64(len(m.ImageUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintRecommendation(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintRecommendation(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintRecommendation(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorRecommendations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorRecommendations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorRecommendations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Recommendations) > 0 {
+		for iNdEx := len(m.Recommendations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recommendations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecommendation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SpecializationId) > 0 {
+		i -= len(m.SpecializationId)
+		copy(dAtA[i:], m.SpecializationId)
+		i = encodeVarintRecommendation(dAtA, i, uint64(len(m.SpecializationId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReasonName) > 0 {
+		i -= len(m.ReasonName)
+		copy(dAtA[i:], m.ReasonName)
+		i = encodeVarintRecommendation(dAtA, i, uint64(len(m.ReasonName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReasonId) > 0 {
+		i -= len(m.ReasonId)
+		copy(dAtA[i:], m.ReasonId)
+		i = encodeVarintRecommendation(dAtA, i, uint64(len(m.ReasonId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecommendation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecommendation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RecommendDoctorsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReasonId)
+	if l > 0 {
+		n += 1 + l + sovRecommendation(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRecommendation(uint64(l))
+	}
+	if m.Online {
+		n += 2
+	}
+	if m.DaysAhead != 0 {
+		n += 1 + sovRecommendation(uint64(m.DaysAhead))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovRecommendation(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorRecommendation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovRecommendation(uint64(l))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovRecommendation(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovRecommendation(uint64(l))
+	}
+	l = len(m.ImageUrl)
+	if l > 0 {
+		n += 1 + l + sovRecommendation(uint64(l))
+	}
+	l = len(m.DepartmentId)
+	if l > 0 {
+		n += 1 + l + sovRecommendation(uint64(l))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovRecommendation(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 9
+	}
+	if m.Duration != 0 {
+		n += 1 + sovRecommendation(uint64(m.Duration))
+	}
+	if m.Rating != 0 {
+		n += 9
+	}
+	if m.ReviewCount != 0 {
+		n += 1 + sovRecommendation(uint64(m.ReviewCount))
+	}
+	if m.SlotFound {
+		n += 2
+	}
+	l = len(m.SlotDate)
+	if l > 0 {
+		n += 1 + l + sovRecommendation(uint64(l))
+	}
+	l = len(m.SlotTime)
+	if l > 0 {
+		n += 1 + l + sovRecommendation(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorRecommendations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReasonId)
+	if l > 0 {
+		n += 1 + l + sovRecommendation(uint64(l))
+	}
+	l = len(m.ReasonName)
+	if l > 0 {
+		n += 1 + l + sovRecommendation(uint64(l))
+	}
+	l = len(m.SpecializationId)
+	if l > 0 {
+		n += 1 + l + sovRecommendation(uint64(l))
+	}
+	if len(m.Recommendations) > 0 {
+		for _, e := range m.Recommendations {
+			l = e.Size()
+			n += 1 + l + sovRecommendation(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRecommendation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecommendation(x uint64) (n int) {
+	return sovRecommendation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RecommendDoctorsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecommendation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecommendDoctorsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecommendDoctorsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReasonId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Online", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Online = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaysAhead", wireType)
+			}
+			m.DaysAhead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaysAhead |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecommendation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorRecommendation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecommendation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorRecommendation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorRecommendation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepartmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Rating = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewCount", wireType)
+			}
+			m.ReviewCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReviewCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotFound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SlotFound = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlotDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlotTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecommendation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorRecommendations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecommendation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorRecommendations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorRecommendations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReasonId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReasonName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecializationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecializationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recommendations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recommendations = append(m.Recommendations, &DoctorRecommendation{})
+			if err := m.Recommendations[len(m.Recommendations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecommendation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecommendation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRecommendation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRecommendation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecommendation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRecommendation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRecommendation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRecommendation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRecommendation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRecommendation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRecommendation = fmt.Errorf("proto: unexpected end of group")
+)
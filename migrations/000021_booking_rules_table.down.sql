@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS booked_appointments_patient_active_idx;
+DROP TABLE IF EXISTS booking_rules;
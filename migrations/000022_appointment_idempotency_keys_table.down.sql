@@ -0,0 +1 @@
+DROP TABLE IF EXISTS appointment_idempotency_keys;
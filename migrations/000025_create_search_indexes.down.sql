@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS departments_search_name_idx;
+DROP INDEX IF EXISTS departments_search_document_idx;
+DROP INDEX IF EXISTS reasons_search_name_idx;
+DROP INDEX IF EXISTS reasons_search_document_idx;
+DROP INDEX IF EXISTS specializations_search_name_idx;
+DROP INDEX IF EXISTS specializations_search_document_idx;
+DROP INDEX IF EXISTS doctors_search_name_idx;
+DROP INDEX IF EXISTS doctors_search_document_idx;
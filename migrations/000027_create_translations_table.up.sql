@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS translations
+(
+    entity_type VARCHAR(32) CHECK (entity_type IN ('department', 'specialization', 'reason', 'doctor_service')) NOT NULL,
+    entity_id UUID NOT NULL,
+    lang VARCHAR(2) CHECK (lang IN ('uz', 'ru', 'en')) NOT NULL,
+    field VARCHAR(32) NOT NULL,
+    value TEXT NOT NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP + INTERVAL '5 hours'),
+    updated_at    TIMESTAMP,
+    PRIMARY KEY (entity_type, entity_id, lang, field)
+);
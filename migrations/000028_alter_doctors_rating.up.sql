@@ -0,0 +1,2 @@
+ALTER TABLE doctors ADD COLUMN IF NOT EXISTS rating NUMERIC(3, 2) NOT NULL DEFAULT 0;
+ALTER TABLE doctors ADD COLUMN IF NOT EXISTS review_count INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "doctor_availability_leave_day_idx";
+
+ALTER TABLE "doctor_availability" DROP COLUMN IF EXISTS "leave_id";
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS branches
+(
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    address VARCHAR(255) NOT NULL,
+    city VARCHAR(100) NOT NULL DEFAULT '',
+    latitude DOUBLE PRECISION NOT NULL CHECK (latitude BETWEEN -90 AND 90),
+    longitude DOUBLE PRECISION NOT NULL CHECK (longitude BETWEEN -180 AND 180),
+    opens_at TIME NOT NULL,
+    closes_at TIME NOT NULL,
+    phone_numbers TEXT[] NOT NULL DEFAULT '{}',
+    created_at    TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP + INTERVAL '5 hours'),
+    updated_at    TIMESTAMP,
+    deleted_at    TIMESTAMP,
+    CHECK (opens_at < closes_at)
+);
+
+ALTER TABLE departments ADD COLUMN IF NOT EXISTS branch_id UUID REFERENCES branches(id) ON DELETE SET NULL;
+ALTER TABLE doctors ADD COLUMN IF NOT EXISTS branch_id UUID REFERENCES branches(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS departments_branch_idx ON departments (branch_id);
+CREATE INDEX IF NOT EXISTS doctors_branch_idx ON doctors (branch_id);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS resources
+(
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    kind VARCHAR(20) CHECK (kind IN ('room', 'equipment')) NOT NULL,
+    resource_type VARCHAR(50) NOT NULL,
+    branch_id UUID REFERENCES branches(id) ON DELETE SET NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP + INTERVAL '5 hours'),
+    updated_at    TIMESTAMP,
+    deleted_at    TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS resources_type_idx ON resources (resource_type) WHERE deleted_at IS NULL;
+
+-- every appointment of the service reserves a resource of the type, empty when none is needed
+ALTER TABLE doctor_service ADD COLUMN IF NOT EXISTS required_resource_type VARCHAR(50) NOT NULL DEFAULT '';
@@ -0,0 +1,9 @@
+-- the room or equipment reserved for an appointment, the reservation holds while the appointment is waiting
+CREATE TABLE "appointment_resources"(
+                                        "appointment_id" INTEGER PRIMARY KEY NOT NULL REFERENCES "booked_appointments" ("id") ON DELETE CASCADE,
+                                        "resource_id" UUID NOT NULL,
+                                        "resource_type" VARCHAR(50) NOT NULL,
+                                        "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX "appointment_resources_resource_id_idx" ON "appointment_resources" ("resource_id");
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS service_discounts;
+
+DROP TABLE IF EXISTS service_package_items;
+
+DROP TABLE IF EXISTS service_packages;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "appointment_discounts";
+DROP TABLE IF EXISTS "promo_codes";
@@ -0,0 +1,18 @@
+-- the prices of a doctor service with the moment each takes effect, the row effective last before a moment
+-- holds the price valid at that moment, doctor_service keeps the price valid now
+CREATE TABLE IF NOT EXISTS doctor_service_prices
+(
+    id UUID PRIMARY KEY,
+    doctor_service_id UUID REFERENCES doctor_service(id) ON DELETE CASCADE NOT NULL,
+    online_price FLOAT NOT NULL CHECK (online_price >= 0),
+    offline_price FLOAT NOT NULL CHECK (offline_price >= 0),
+    effective_from TIMESTAMP NOT NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP + INTERVAL '5 hours'),
+    UNIQUE (doctor_service_id, effective_from)
+);
+
+-- the current prices open the history of the existing services
+INSERT INTO doctor_service_prices (id, doctor_service_id, online_price, offline_price, effective_from)
+SELECT id, id, online_price, offline_price, created_at
+FROM doctor_service
+ON CONFLICT DO NOTHING;
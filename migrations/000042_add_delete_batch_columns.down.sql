@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS doctor_credentials_delete_batch_idx;
+DROP INDEX IF EXISTS doctor_leaves_delete_batch_idx;
+DROP INDEX IF EXISTS doctor_working_hours_delete_batch_idx;
+DROP INDEX IF EXISTS doctor_service_delete_batch_idx;
+DROP INDEX IF EXISTS reasons_delete_batch_idx;
+DROP INDEX IF EXISTS specializations_delete_batch_idx;
+DROP INDEX IF EXISTS doctors_delete_batch_idx;
+DROP INDEX IF EXISTS departments_delete_batch_idx;
+
+ALTER TABLE doctor_credentials DROP COLUMN IF EXISTS delete_batch;
+ALTER TABLE doctor_leaves DROP COLUMN IF EXISTS delete_batch;
+ALTER TABLE doctor_working_hours DROP COLUMN IF EXISTS delete_batch;
+ALTER TABLE doctor_service DROP COLUMN IF EXISTS delete_batch;
+ALTER TABLE reasons DROP COLUMN IF EXISTS delete_batch;
+ALTER TABLE specializations DROP COLUMN IF EXISTS delete_batch;
+ALTER TABLE doctors DROP COLUMN IF EXISTS delete_batch;
+ALTER TABLE departments DROP COLUMN IF EXISTS delete_batch;
@@ -0,0 +1,18 @@
+-- delete_batch is the id of the row whose soft delete cascaded to the row, restoring that row restores the batch
+ALTER TABLE departments ADD COLUMN IF NOT EXISTS delete_batch UUID;
+ALTER TABLE doctors ADD COLUMN IF NOT EXISTS delete_batch UUID;
+ALTER TABLE specializations ADD COLUMN IF NOT EXISTS delete_batch UUID;
+ALTER TABLE reasons ADD COLUMN IF NOT EXISTS delete_batch UUID;
+ALTER TABLE doctor_service ADD COLUMN IF NOT EXISTS delete_batch UUID;
+ALTER TABLE doctor_working_hours ADD COLUMN IF NOT EXISTS delete_batch UUID;
+ALTER TABLE doctor_leaves ADD COLUMN IF NOT EXISTS delete_batch UUID;
+ALTER TABLE doctor_credentials ADD COLUMN IF NOT EXISTS delete_batch UUID;
+
+CREATE INDEX IF NOT EXISTS departments_delete_batch_idx ON departments (delete_batch) WHERE delete_batch IS NOT NULL;
+CREATE INDEX IF NOT EXISTS doctors_delete_batch_idx ON doctors (delete_batch) WHERE delete_batch IS NOT NULL;
+CREATE INDEX IF NOT EXISTS specializations_delete_batch_idx ON specializations (delete_batch) WHERE delete_batch IS NOT NULL;
+CREATE INDEX IF NOT EXISTS reasons_delete_batch_idx ON reasons (delete_batch) WHERE delete_batch IS NOT NULL;
+CREATE INDEX IF NOT EXISTS doctor_service_delete_batch_idx ON doctor_service (delete_batch) WHERE delete_batch IS NOT NULL;
+CREATE INDEX IF NOT EXISTS doctor_working_hours_delete_batch_idx ON doctor_working_hours (delete_batch) WHERE delete_batch IS NOT NULL;
+CREATE INDEX IF NOT EXISTS doctor_leaves_delete_batch_idx ON doctor_leaves (delete_batch) WHERE delete_batch IS NOT NULL;
+CREATE INDEX IF NOT EXISTS doctor_credentials_delete_batch_idx ON doctor_credentials (delete_batch) WHERE delete_batch IS NOT NULL;
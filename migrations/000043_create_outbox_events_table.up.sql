@@ -0,0 +1,15 @@
+-- outbox_events are the catalogue change events written in the transaction of the change,
+-- the relay publishes them to kafka and sets published_at
+CREATE TABLE IF NOT EXISTS outbox_events
+(
+    id           BIGSERIAL PRIMARY KEY,
+    event_type   VARCHAR(100) NOT NULL,
+    aggregate_id VARCHAR(64)  NOT NULL,
+    payload      JSONB        NOT NULL,
+    created_at   TIMESTAMP    NOT NULL DEFAULT (CURRENT_TIMESTAMP + INTERVAL '5 hours'),
+    published_at TIMESTAMP,
+    attempts     INT          NOT NULL DEFAULT 0,
+    last_error   TEXT
+);
+
+CREATE INDEX IF NOT EXISTS outbox_events_pending_idx ON outbox_events (id) WHERE published_at IS NULL;
@@ -0,0 +1 @@
+-- the hashed passwords are not turned back into plaintext